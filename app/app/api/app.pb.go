@@ -1031,6 +1031,100 @@ func (x *WithdrawReply) GetStatus() string {
 	return ""
 }
 
+type WithdrawCancelRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SendBody *WithdrawCancelRequest_SendBody `protobuf:"bytes,1,opt,name=send_body,json=sendBody,proto3" json:"send_body,omitempty"`
+}
+
+func (x *WithdrawCancelRequest) Reset() {
+	*x = WithdrawCancelRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_app_app_api_app_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WithdrawCancelRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WithdrawCancelRequest) ProtoMessage() {}
+
+func (x *WithdrawCancelRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_app_app_api_app_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WithdrawCancelRequest.ProtoReflect.Descriptor instead.
+func (*WithdrawCancelRequest) Descriptor() ([]byte, []int) {
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *WithdrawCancelRequest) GetSendBody() *WithdrawCancelRequest_SendBody {
+	if x != nil {
+		return x.SendBody
+	}
+	return nil
+}
+
+type WithdrawCancelReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status string `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+}
+
+func (x *WithdrawCancelReply) Reset() {
+	*x = WithdrawCancelReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_app_app_api_app_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WithdrawCancelReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WithdrawCancelReply) ProtoMessage() {}
+
+func (x *WithdrawCancelReply) ProtoReflect() protoreflect.Message {
+	mi := &file_app_app_api_app_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WithdrawCancelReply.ProtoReflect.Descriptor instead.
+func (*WithdrawCancelReply) Descriptor() ([]byte, []int) {
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *WithdrawCancelReply) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
 type AdminRewardListRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -1043,7 +1137,7 @@ type AdminRewardListRequest struct {
 func (x *AdminRewardListRequest) Reset() {
 	*x = AdminRewardListRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_app_app_api_app_proto_msgTypes[18]
+		mi := &file_app_app_api_app_proto_msgTypes[20]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1056,7 +1150,7 @@ func (x *AdminRewardListRequest) String() string {
 func (*AdminRewardListRequest) ProtoMessage() {}
 
 func (x *AdminRewardListRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_app_app_api_app_proto_msgTypes[18]
+	mi := &file_app_app_api_app_proto_msgTypes[20]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1069,7 +1163,7 @@ func (x *AdminRewardListRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AdminRewardListRequest.ProtoReflect.Descriptor instead.
 func (*AdminRewardListRequest) Descriptor() ([]byte, []int) {
-	return file_app_app_api_app_proto_rawDescGZIP(), []int{18}
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{20}
 }
 
 func (x *AdminRewardListRequest) GetPage() int64 {
@@ -1098,7 +1192,7 @@ type AdminRewardListReply struct {
 func (x *AdminRewardListReply) Reset() {
 	*x = AdminRewardListReply{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_app_app_api_app_proto_msgTypes[19]
+		mi := &file_app_app_api_app_proto_msgTypes[21]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1111,7 +1205,7 @@ func (x *AdminRewardListReply) String() string {
 func (*AdminRewardListReply) ProtoMessage() {}
 
 func (x *AdminRewardListReply) ProtoReflect() protoreflect.Message {
-	mi := &file_app_app_api_app_proto_msgTypes[19]
+	mi := &file_app_app_api_app_proto_msgTypes[21]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1124,7 +1218,7 @@ func (x *AdminRewardListReply) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AdminRewardListReply.ProtoReflect.Descriptor instead.
 func (*AdminRewardListReply) Descriptor() ([]byte, []int) {
-	return file_app_app_api_app_proto_rawDescGZIP(), []int{19}
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{21}
 }
 
 func (x *AdminRewardListReply) GetRewards() []*AdminRewardListReply_List {
@@ -1153,7 +1247,7 @@ type AdminRewardBnbListRequest struct {
 func (x *AdminRewardBnbListRequest) Reset() {
 	*x = AdminRewardBnbListRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_app_app_api_app_proto_msgTypes[20]
+		mi := &file_app_app_api_app_proto_msgTypes[22]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1166,7 +1260,7 @@ func (x *AdminRewardBnbListRequest) String() string {
 func (*AdminRewardBnbListRequest) ProtoMessage() {}
 
 func (x *AdminRewardBnbListRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_app_app_api_app_proto_msgTypes[20]
+	mi := &file_app_app_api_app_proto_msgTypes[22]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1179,7 +1273,7 @@ func (x *AdminRewardBnbListRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AdminRewardBnbListRequest.ProtoReflect.Descriptor instead.
 func (*AdminRewardBnbListRequest) Descriptor() ([]byte, []int) {
-	return file_app_app_api_app_proto_rawDescGZIP(), []int{20}
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{22}
 }
 
 func (x *AdminRewardBnbListRequest) GetPage() int64 {
@@ -1208,7 +1302,7 @@ type AdminRewardBnbListReply struct {
 func (x *AdminRewardBnbListReply) Reset() {
 	*x = AdminRewardBnbListReply{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_app_app_api_app_proto_msgTypes[21]
+		mi := &file_app_app_api_app_proto_msgTypes[23]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1221,7 +1315,7 @@ func (x *AdminRewardBnbListReply) String() string {
 func (*AdminRewardBnbListReply) ProtoMessage() {}
 
 func (x *AdminRewardBnbListReply) ProtoReflect() protoreflect.Message {
-	mi := &file_app_app_api_app_proto_msgTypes[21]
+	mi := &file_app_app_api_app_proto_msgTypes[23]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1234,7 +1328,7 @@ func (x *AdminRewardBnbListReply) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AdminRewardBnbListReply.ProtoReflect.Descriptor instead.
 func (*AdminRewardBnbListReply) Descriptor() ([]byte, []int) {
-	return file_app_app_api_app_proto_rawDescGZIP(), []int{21}
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{23}
 }
 
 func (x *AdminRewardBnbListReply) GetRewards() []*AdminRewardBnbListReply_List {
@@ -1260,7 +1354,7 @@ type CheckAdminUserAreaRequest struct {
 func (x *CheckAdminUserAreaRequest) Reset() {
 	*x = CheckAdminUserAreaRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_app_app_api_app_proto_msgTypes[22]
+		mi := &file_app_app_api_app_proto_msgTypes[24]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1273,7 +1367,7 @@ func (x *CheckAdminUserAreaRequest) String() string {
 func (*CheckAdminUserAreaRequest) ProtoMessage() {}
 
 func (x *CheckAdminUserAreaRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_app_app_api_app_proto_msgTypes[22]
+	mi := &file_app_app_api_app_proto_msgTypes[24]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1286,7 +1380,7 @@ func (x *CheckAdminUserAreaRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CheckAdminUserAreaRequest.ProtoReflect.Descriptor instead.
 func (*CheckAdminUserAreaRequest) Descriptor() ([]byte, []int) {
-	return file_app_app_api_app_proto_rawDescGZIP(), []int{22}
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{24}
 }
 
 type CheckAdminUserAreaReply struct {
@@ -1298,7 +1392,7 @@ type CheckAdminUserAreaReply struct {
 func (x *CheckAdminUserAreaReply) Reset() {
 	*x = CheckAdminUserAreaReply{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_app_app_api_app_proto_msgTypes[23]
+		mi := &file_app_app_api_app_proto_msgTypes[25]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1311,7 +1405,7 @@ func (x *CheckAdminUserAreaReply) String() string {
 func (*CheckAdminUserAreaReply) ProtoMessage() {}
 
 func (x *CheckAdminUserAreaReply) ProtoReflect() protoreflect.Message {
-	mi := &file_app_app_api_app_proto_msgTypes[23]
+	mi := &file_app_app_api_app_proto_msgTypes[25]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1324,7 +1418,7 @@ func (x *CheckAdminUserAreaReply) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CheckAdminUserAreaReply.ProtoReflect.Descriptor instead.
 func (*CheckAdminUserAreaReply) Descriptor() ([]byte, []int) {
-	return file_app_app_api_app_proto_rawDescGZIP(), []int{23}
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{25}
 }
 
 type CheckAndInsertLocationsRecommendUserRequest struct {
@@ -1336,7 +1430,7 @@ type CheckAndInsertLocationsRecommendUserRequest struct {
 func (x *CheckAndInsertLocationsRecommendUserRequest) Reset() {
 	*x = CheckAndInsertLocationsRecommendUserRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_app_app_api_app_proto_msgTypes[24]
+		mi := &file_app_app_api_app_proto_msgTypes[26]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1349,7 +1443,7 @@ func (x *CheckAndInsertLocationsRecommendUserRequest) String() string {
 func (*CheckAndInsertLocationsRecommendUserRequest) ProtoMessage() {}
 
 func (x *CheckAndInsertLocationsRecommendUserRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_app_app_api_app_proto_msgTypes[24]
+	mi := &file_app_app_api_app_proto_msgTypes[26]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1362,7 +1456,7 @@ func (x *CheckAndInsertLocationsRecommendUserRequest) ProtoReflect() protoreflec
 
 // Deprecated: Use CheckAndInsertLocationsRecommendUserRequest.ProtoReflect.Descriptor instead.
 func (*CheckAndInsertLocationsRecommendUserRequest) Descriptor() ([]byte, []int) {
-	return file_app_app_api_app_proto_rawDescGZIP(), []int{24}
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{26}
 }
 
 type CheckAndInsertLocationsRecommendUserReply struct {
@@ -1374,7 +1468,7 @@ type CheckAndInsertLocationsRecommendUserReply struct {
 func (x *CheckAndInsertLocationsRecommendUserReply) Reset() {
 	*x = CheckAndInsertLocationsRecommendUserReply{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_app_app_api_app_proto_msgTypes[25]
+		mi := &file_app_app_api_app_proto_msgTypes[27]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1387,7 +1481,7 @@ func (x *CheckAndInsertLocationsRecommendUserReply) String() string {
 func (*CheckAndInsertLocationsRecommendUserReply) ProtoMessage() {}
 
 func (x *CheckAndInsertLocationsRecommendUserReply) ProtoReflect() protoreflect.Message {
-	mi := &file_app_app_api_app_proto_msgTypes[25]
+	mi := &file_app_app_api_app_proto_msgTypes[27]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1400,7 +1494,7 @@ func (x *CheckAndInsertLocationsRecommendUserReply) ProtoReflect() protoreflect.
 
 // Deprecated: Use CheckAndInsertLocationsRecommendUserReply.ProtoReflect.Descriptor instead.
 func (*CheckAndInsertLocationsRecommendUserReply) Descriptor() ([]byte, []int) {
-	return file_app_app_api_app_proto_rawDescGZIP(), []int{25}
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{27}
 }
 
 type UploadRecommendUserRequest struct {
@@ -1412,7 +1506,7 @@ type UploadRecommendUserRequest struct {
 func (x *UploadRecommendUserRequest) Reset() {
 	*x = UploadRecommendUserRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_app_app_api_app_proto_msgTypes[26]
+		mi := &file_app_app_api_app_proto_msgTypes[28]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1425,7 +1519,7 @@ func (x *UploadRecommendUserRequest) String() string {
 func (*UploadRecommendUserRequest) ProtoMessage() {}
 
 func (x *UploadRecommendUserRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_app_app_api_app_proto_msgTypes[26]
+	mi := &file_app_app_api_app_proto_msgTypes[28]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1438,7 +1532,7 @@ func (x *UploadRecommendUserRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UploadRecommendUserRequest.ProtoReflect.Descriptor instead.
 func (*UploadRecommendUserRequest) Descriptor() ([]byte, []int) {
-	return file_app_app_api_app_proto_rawDescGZIP(), []int{26}
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{28}
 }
 
 type UploadRecommendUserReply struct {
@@ -1450,7 +1544,7 @@ type UploadRecommendUserReply struct {
 func (x *UploadRecommendUserReply) Reset() {
 	*x = UploadRecommendUserReply{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_app_app_api_app_proto_msgTypes[27]
+		mi := &file_app_app_api_app_proto_msgTypes[29]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1463,7 +1557,7 @@ func (x *UploadRecommendUserReply) String() string {
 func (*UploadRecommendUserReply) ProtoMessage() {}
 
 func (x *UploadRecommendUserReply) ProtoReflect() protoreflect.Message {
-	mi := &file_app_app_api_app_proto_msgTypes[27]
+	mi := &file_app_app_api_app_proto_msgTypes[29]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1476,7 +1570,7 @@ func (x *UploadRecommendUserReply) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UploadRecommendUserReply.ProtoReflect.Descriptor instead.
 func (*UploadRecommendUserReply) Descriptor() ([]byte, []int) {
-	return file_app_app_api_app_proto_rawDescGZIP(), []int{27}
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{29}
 }
 
 type AdminUserListRequest struct {
@@ -1493,7 +1587,7 @@ type AdminUserListRequest struct {
 func (x *AdminUserListRequest) Reset() {
 	*x = AdminUserListRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_app_app_api_app_proto_msgTypes[28]
+		mi := &file_app_app_api_app_proto_msgTypes[30]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1506,7 +1600,7 @@ func (x *AdminUserListRequest) String() string {
 func (*AdminUserListRequest) ProtoMessage() {}
 
 func (x *AdminUserListRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_app_app_api_app_proto_msgTypes[28]
+	mi := &file_app_app_api_app_proto_msgTypes[30]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1519,7 +1613,7 @@ func (x *AdminUserListRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AdminUserListRequest.ProtoReflect.Descriptor instead.
 func (*AdminUserListRequest) Descriptor() ([]byte, []int) {
-	return file_app_app_api_app_proto_rawDescGZIP(), []int{28}
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{30}
 }
 
 func (x *AdminUserListRequest) GetPage() int64 {
@@ -1562,7 +1656,7 @@ type AdminUserListReply struct {
 func (x *AdminUserListReply) Reset() {
 	*x = AdminUserListReply{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_app_app_api_app_proto_msgTypes[29]
+		mi := &file_app_app_api_app_proto_msgTypes[31]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1575,7 +1669,7 @@ func (x *AdminUserListReply) String() string {
 func (*AdminUserListReply) ProtoMessage() {}
 
 func (x *AdminUserListReply) ProtoReflect() protoreflect.Message {
-	mi := &file_app_app_api_app_proto_msgTypes[29]
+	mi := &file_app_app_api_app_proto_msgTypes[31]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1588,7 +1682,7 @@ func (x *AdminUserListReply) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AdminUserListReply.ProtoReflect.Descriptor instead.
 func (*AdminUserListReply) Descriptor() ([]byte, []int) {
-	return file_app_app_api_app_proto_rawDescGZIP(), []int{29}
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{31}
 }
 
 func (x *AdminUserListReply) GetUsers() []*AdminUserListReply_UserList {
@@ -1617,7 +1711,7 @@ type AdminLocationListRequest struct {
 func (x *AdminLocationListRequest) Reset() {
 	*x = AdminLocationListRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_app_app_api_app_proto_msgTypes[30]
+		mi := &file_app_app_api_app_proto_msgTypes[32]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1630,7 +1724,7 @@ func (x *AdminLocationListRequest) String() string {
 func (*AdminLocationListRequest) ProtoMessage() {}
 
 func (x *AdminLocationListRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_app_app_api_app_proto_msgTypes[30]
+	mi := &file_app_app_api_app_proto_msgTypes[32]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1643,7 +1737,7 @@ func (x *AdminLocationListRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AdminLocationListRequest.ProtoReflect.Descriptor instead.
 func (*AdminLocationListRequest) Descriptor() ([]byte, []int) {
-	return file_app_app_api_app_proto_rawDescGZIP(), []int{30}
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{32}
 }
 
 func (x *AdminLocationListRequest) GetPage() int64 {
@@ -1672,7 +1766,7 @@ type AdminLocationListReply struct {
 func (x *AdminLocationListReply) Reset() {
 	*x = AdminLocationListReply{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_app_app_api_app_proto_msgTypes[31]
+		mi := &file_app_app_api_app_proto_msgTypes[33]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1685,7 +1779,7 @@ func (x *AdminLocationListReply) String() string {
 func (*AdminLocationListReply) ProtoMessage() {}
 
 func (x *AdminLocationListReply) ProtoReflect() protoreflect.Message {
-	mi := &file_app_app_api_app_proto_msgTypes[31]
+	mi := &file_app_app_api_app_proto_msgTypes[33]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1698,7 +1792,7 @@ func (x *AdminLocationListReply) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AdminLocationListReply.ProtoReflect.Descriptor instead.
 func (*AdminLocationListReply) Descriptor() ([]byte, []int) {
-	return file_app_app_api_app_proto_rawDescGZIP(), []int{31}
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{33}
 }
 
 func (x *AdminLocationListReply) GetLocations() []*AdminLocationListReply_LocationList {
@@ -1727,7 +1821,7 @@ type AdminLocationAllListRequest struct {
 func (x *AdminLocationAllListRequest) Reset() {
 	*x = AdminLocationAllListRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_app_app_api_app_proto_msgTypes[32]
+		mi := &file_app_app_api_app_proto_msgTypes[34]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1740,7 +1834,7 @@ func (x *AdminLocationAllListRequest) String() string {
 func (*AdminLocationAllListRequest) ProtoMessage() {}
 
 func (x *AdminLocationAllListRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_app_app_api_app_proto_msgTypes[32]
+	mi := &file_app_app_api_app_proto_msgTypes[34]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1753,7 +1847,7 @@ func (x *AdminLocationAllListRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AdminLocationAllListRequest.ProtoReflect.Descriptor instead.
 func (*AdminLocationAllListRequest) Descriptor() ([]byte, []int) {
-	return file_app_app_api_app_proto_rawDescGZIP(), []int{32}
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{34}
 }
 
 func (x *AdminLocationAllListRequest) GetPage() int64 {
@@ -1782,7 +1876,7 @@ type AdminLocationAllListReply struct {
 func (x *AdminLocationAllListReply) Reset() {
 	*x = AdminLocationAllListReply{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_app_app_api_app_proto_msgTypes[33]
+		mi := &file_app_app_api_app_proto_msgTypes[35]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1795,7 +1889,7 @@ func (x *AdminLocationAllListReply) String() string {
 func (*AdminLocationAllListReply) ProtoMessage() {}
 
 func (x *AdminLocationAllListReply) ProtoReflect() protoreflect.Message {
-	mi := &file_app_app_api_app_proto_msgTypes[33]
+	mi := &file_app_app_api_app_proto_msgTypes[35]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1808,7 +1902,7 @@ func (x *AdminLocationAllListReply) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AdminLocationAllListReply.ProtoReflect.Descriptor instead.
 func (*AdminLocationAllListReply) Descriptor() ([]byte, []int) {
-	return file_app_app_api_app_proto_rawDescGZIP(), []int{33}
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{35}
 }
 
 func (x *AdminLocationAllListReply) GetLocations() []*AdminLocationAllListReply_LocationList {
@@ -1838,7 +1932,7 @@ type AdminWithdrawListRequest struct {
 func (x *AdminWithdrawListRequest) Reset() {
 	*x = AdminWithdrawListRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_app_app_api_app_proto_msgTypes[34]
+		mi := &file_app_app_api_app_proto_msgTypes[36]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1851,7 +1945,7 @@ func (x *AdminWithdrawListRequest) String() string {
 func (*AdminWithdrawListRequest) ProtoMessage() {}
 
 func (x *AdminWithdrawListRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_app_app_api_app_proto_msgTypes[34]
+	mi := &file_app_app_api_app_proto_msgTypes[36]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1864,7 +1958,7 @@ func (x *AdminWithdrawListRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AdminWithdrawListRequest.ProtoReflect.Descriptor instead.
 func (*AdminWithdrawListRequest) Descriptor() ([]byte, []int) {
-	return file_app_app_api_app_proto_rawDescGZIP(), []int{34}
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{36}
 }
 
 func (x *AdminWithdrawListRequest) GetPage() int64 {
@@ -1900,7 +1994,7 @@ type AdminWithdrawListReply struct {
 func (x *AdminWithdrawListReply) Reset() {
 	*x = AdminWithdrawListReply{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_app_app_api_app_proto_msgTypes[35]
+		mi := &file_app_app_api_app_proto_msgTypes[37]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1913,7 +2007,7 @@ func (x *AdminWithdrawListReply) String() string {
 func (*AdminWithdrawListReply) ProtoMessage() {}
 
 func (x *AdminWithdrawListReply) ProtoReflect() protoreflect.Message {
-	mi := &file_app_app_api_app_proto_msgTypes[35]
+	mi := &file_app_app_api_app_proto_msgTypes[37]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1926,7 +2020,7 @@ func (x *AdminWithdrawListReply) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AdminWithdrawListReply.ProtoReflect.Descriptor instead.
 func (*AdminWithdrawListReply) Descriptor() ([]byte, []int) {
-	return file_app_app_api_app_proto_rawDescGZIP(), []int{35}
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{37}
 }
 
 func (x *AdminWithdrawListReply) GetWithdraw() []*AdminWithdrawListReply_List {
@@ -1952,7 +2046,7 @@ type AdminWithdrawRequest struct {
 func (x *AdminWithdrawRequest) Reset() {
 	*x = AdminWithdrawRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_app_app_api_app_proto_msgTypes[36]
+		mi := &file_app_app_api_app_proto_msgTypes[38]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1965,7 +2059,7 @@ func (x *AdminWithdrawRequest) String() string {
 func (*AdminWithdrawRequest) ProtoMessage() {}
 
 func (x *AdminWithdrawRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_app_app_api_app_proto_msgTypes[36]
+	mi := &file_app_app_api_app_proto_msgTypes[38]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1978,7 +2072,7 @@ func (x *AdminWithdrawRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AdminWithdrawRequest.ProtoReflect.Descriptor instead.
 func (*AdminWithdrawRequest) Descriptor() ([]byte, []int) {
-	return file_app_app_api_app_proto_rawDescGZIP(), []int{36}
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{38}
 }
 
 type AdminWithdrawReply struct {
@@ -1990,7 +2084,7 @@ type AdminWithdrawReply struct {
 func (x *AdminWithdrawReply) Reset() {
 	*x = AdminWithdrawReply{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_app_app_api_app_proto_msgTypes[37]
+		mi := &file_app_app_api_app_proto_msgTypes[39]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2003,7 +2097,7 @@ func (x *AdminWithdrawReply) String() string {
 func (*AdminWithdrawReply) ProtoMessage() {}
 
 func (x *AdminWithdrawReply) ProtoReflect() protoreflect.Message {
-	mi := &file_app_app_api_app_proto_msgTypes[37]
+	mi := &file_app_app_api_app_proto_msgTypes[39]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2016,7 +2110,7 @@ func (x *AdminWithdrawReply) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AdminWithdrawReply.ProtoReflect.Descriptor instead.
 func (*AdminWithdrawReply) Descriptor() ([]byte, []int) {
-	return file_app_app_api_app_proto_rawDescGZIP(), []int{37}
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{39}
 }
 
 type AdminWithdrawDoingToRewardedRequest struct {
@@ -2028,7 +2122,7 @@ type AdminWithdrawDoingToRewardedRequest struct {
 func (x *AdminWithdrawDoingToRewardedRequest) Reset() {
 	*x = AdminWithdrawDoingToRewardedRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_app_app_api_app_proto_msgTypes[38]
+		mi := &file_app_app_api_app_proto_msgTypes[40]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2041,7 +2135,7 @@ func (x *AdminWithdrawDoingToRewardedRequest) String() string {
 func (*AdminWithdrawDoingToRewardedRequest) ProtoMessage() {}
 
 func (x *AdminWithdrawDoingToRewardedRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_app_app_api_app_proto_msgTypes[38]
+	mi := &file_app_app_api_app_proto_msgTypes[40]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2054,7 +2148,7 @@ func (x *AdminWithdrawDoingToRewardedRequest) ProtoReflect() protoreflect.Messag
 
 // Deprecated: Use AdminWithdrawDoingToRewardedRequest.ProtoReflect.Descriptor instead.
 func (*AdminWithdrawDoingToRewardedRequest) Descriptor() ([]byte, []int) {
-	return file_app_app_api_app_proto_rawDescGZIP(), []int{38}
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{40}
 }
 
 type AdminWithdrawDoingToRewardedReply struct {
@@ -2066,7 +2160,7 @@ type AdminWithdrawDoingToRewardedReply struct {
 func (x *AdminWithdrawDoingToRewardedReply) Reset() {
 	*x = AdminWithdrawDoingToRewardedReply{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_app_app_api_app_proto_msgTypes[39]
+		mi := &file_app_app_api_app_proto_msgTypes[41]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2079,7 +2173,7 @@ func (x *AdminWithdrawDoingToRewardedReply) String() string {
 func (*AdminWithdrawDoingToRewardedReply) ProtoMessage() {}
 
 func (x *AdminWithdrawDoingToRewardedReply) ProtoReflect() protoreflect.Message {
-	mi := &file_app_app_api_app_proto_msgTypes[39]
+	mi := &file_app_app_api_app_proto_msgTypes[41]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2092,7 +2186,7 @@ func (x *AdminWithdrawDoingToRewardedReply) ProtoReflect() protoreflect.Message
 
 // Deprecated: Use AdminWithdrawDoingToRewardedReply.ProtoReflect.Descriptor instead.
 func (*AdminWithdrawDoingToRewardedReply) Descriptor() ([]byte, []int) {
-	return file_app_app_api_app_proto_rawDescGZIP(), []int{39}
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{41}
 }
 
 type AdminWithdrawEthRequest struct {
@@ -2104,7 +2198,7 @@ type AdminWithdrawEthRequest struct {
 func (x *AdminWithdrawEthRequest) Reset() {
 	*x = AdminWithdrawEthRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_app_app_api_app_proto_msgTypes[40]
+		mi := &file_app_app_api_app_proto_msgTypes[42]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2117,7 +2211,7 @@ func (x *AdminWithdrawEthRequest) String() string {
 func (*AdminWithdrawEthRequest) ProtoMessage() {}
 
 func (x *AdminWithdrawEthRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_app_app_api_app_proto_msgTypes[40]
+	mi := &file_app_app_api_app_proto_msgTypes[42]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2130,7 +2224,7 @@ func (x *AdminWithdrawEthRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AdminWithdrawEthRequest.ProtoReflect.Descriptor instead.
 func (*AdminWithdrawEthRequest) Descriptor() ([]byte, []int) {
-	return file_app_app_api_app_proto_rawDescGZIP(), []int{40}
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{42}
 }
 
 type AdminWithdrawEthReply struct {
@@ -2142,7 +2236,7 @@ type AdminWithdrawEthReply struct {
 func (x *AdminWithdrawEthReply) Reset() {
 	*x = AdminWithdrawEthReply{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_app_app_api_app_proto_msgTypes[41]
+		mi := &file_app_app_api_app_proto_msgTypes[43]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2155,7 +2249,7 @@ func (x *AdminWithdrawEthReply) String() string {
 func (*AdminWithdrawEthReply) ProtoMessage() {}
 
 func (x *AdminWithdrawEthReply) ProtoReflect() protoreflect.Message {
-	mi := &file_app_app_api_app_proto_msgTypes[41]
+	mi := &file_app_app_api_app_proto_msgTypes[43]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2168,7 +2262,7 @@ func (x *AdminWithdrawEthReply) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AdminWithdrawEthReply.ProtoReflect.Descriptor instead.
 func (*AdminWithdrawEthReply) Descriptor() ([]byte, []int) {
-	return file_app_app_api_app_proto_rawDescGZIP(), []int{41}
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{43}
 }
 
 type AdminFeeRequest struct {
@@ -2180,7 +2274,7 @@ type AdminFeeRequest struct {
 func (x *AdminFeeRequest) Reset() {
 	*x = AdminFeeRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_app_app_api_app_proto_msgTypes[42]
+		mi := &file_app_app_api_app_proto_msgTypes[44]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2193,7 +2287,7 @@ func (x *AdminFeeRequest) String() string {
 func (*AdminFeeRequest) ProtoMessage() {}
 
 func (x *AdminFeeRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_app_app_api_app_proto_msgTypes[42]
+	mi := &file_app_app_api_app_proto_msgTypes[44]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2206,7 +2300,7 @@ func (x *AdminFeeRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AdminFeeRequest.ProtoReflect.Descriptor instead.
 func (*AdminFeeRequest) Descriptor() ([]byte, []int) {
-	return file_app_app_api_app_proto_rawDescGZIP(), []int{42}
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{44}
 }
 
 type AdminFeeReply struct {
@@ -2218,7 +2312,7 @@ type AdminFeeReply struct {
 func (x *AdminFeeReply) Reset() {
 	*x = AdminFeeReply{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_app_app_api_app_proto_msgTypes[43]
+		mi := &file_app_app_api_app_proto_msgTypes[45]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2231,7 +2325,7 @@ func (x *AdminFeeReply) String() string {
 func (*AdminFeeReply) ProtoMessage() {}
 
 func (x *AdminFeeReply) ProtoReflect() protoreflect.Message {
-	mi := &file_app_app_api_app_proto_msgTypes[43]
+	mi := &file_app_app_api_app_proto_msgTypes[45]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2244,7 +2338,7 @@ func (x *AdminFeeReply) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AdminFeeReply.ProtoReflect.Descriptor instead.
 func (*AdminFeeReply) Descriptor() ([]byte, []int) {
-	return file_app_app_api_app_proto_rawDescGZIP(), []int{43}
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{45}
 }
 
 type AdminDailyFeeRequest struct {
@@ -2258,7 +2352,7 @@ type AdminDailyFeeRequest struct {
 func (x *AdminDailyFeeRequest) Reset() {
 	*x = AdminDailyFeeRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_app_app_api_app_proto_msgTypes[44]
+		mi := &file_app_app_api_app_proto_msgTypes[46]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2271,7 +2365,7 @@ func (x *AdminDailyFeeRequest) String() string {
 func (*AdminDailyFeeRequest) ProtoMessage() {}
 
 func (x *AdminDailyFeeRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_app_app_api_app_proto_msgTypes[44]
+	mi := &file_app_app_api_app_proto_msgTypes[46]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2284,7 +2378,7 @@ func (x *AdminDailyFeeRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AdminDailyFeeRequest.ProtoReflect.Descriptor instead.
 func (*AdminDailyFeeRequest) Descriptor() ([]byte, []int) {
-	return file_app_app_api_app_proto_rawDescGZIP(), []int{44}
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{46}
 }
 
 func (x *AdminDailyFeeRequest) GetDay() int32 {
@@ -2303,7 +2397,7 @@ type AdminDailyFeeReply struct {
 func (x *AdminDailyFeeReply) Reset() {
 	*x = AdminDailyFeeReply{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_app_app_api_app_proto_msgTypes[45]
+		mi := &file_app_app_api_app_proto_msgTypes[47]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2316,7 +2410,7 @@ func (x *AdminDailyFeeReply) String() string {
 func (*AdminDailyFeeReply) ProtoMessage() {}
 
 func (x *AdminDailyFeeReply) ProtoReflect() protoreflect.Message {
-	mi := &file_app_app_api_app_proto_msgTypes[45]
+	mi := &file_app_app_api_app_proto_msgTypes[47]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2329,7 +2423,7 @@ func (x *AdminDailyFeeReply) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AdminDailyFeeReply.ProtoReflect.Descriptor instead.
 func (*AdminDailyFeeReply) Descriptor() ([]byte, []int) {
-	return file_app_app_api_app_proto_rawDescGZIP(), []int{45}
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{47}
 }
 
 type AdminAllRequest struct {
@@ -2341,7 +2435,7 @@ type AdminAllRequest struct {
 func (x *AdminAllRequest) Reset() {
 	*x = AdminAllRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_app_app_api_app_proto_msgTypes[46]
+		mi := &file_app_app_api_app_proto_msgTypes[48]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2354,7 +2448,7 @@ func (x *AdminAllRequest) String() string {
 func (*AdminAllRequest) ProtoMessage() {}
 
 func (x *AdminAllRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_app_app_api_app_proto_msgTypes[46]
+	mi := &file_app_app_api_app_proto_msgTypes[48]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2367,7 +2461,7 @@ func (x *AdminAllRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AdminAllRequest.ProtoReflect.Descriptor instead.
 func (*AdminAllRequest) Descriptor() ([]byte, []int) {
-	return file_app_app_api_app_proto_rawDescGZIP(), []int{46}
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{48}
 }
 
 type AdminAllReply struct {
@@ -2396,7 +2490,7 @@ type AdminAllReply struct {
 func (x *AdminAllReply) Reset() {
 	*x = AdminAllReply{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_app_app_api_app_proto_msgTypes[47]
+		mi := &file_app_app_api_app_proto_msgTypes[49]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2409,7 +2503,7 @@ func (x *AdminAllReply) String() string {
 func (*AdminAllReply) ProtoMessage() {}
 
 func (x *AdminAllReply) ProtoReflect() protoreflect.Message {
-	mi := &file_app_app_api_app_proto_msgTypes[47]
+	mi := &file_app_app_api_app_proto_msgTypes[49]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2422,7 +2516,7 @@ func (x *AdminAllReply) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AdminAllReply.ProtoReflect.Descriptor instead.
 func (*AdminAllReply) Descriptor() ([]byte, []int) {
-	return file_app_app_api_app_proto_rawDescGZIP(), []int{47}
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{49}
 }
 
 func (x *AdminAllReply) GetTodayTotalUser() int64 {
@@ -2548,7 +2642,7 @@ type AdminUserRecommendRequest struct {
 func (x *AdminUserRecommendRequest) Reset() {
 	*x = AdminUserRecommendRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_app_app_api_app_proto_msgTypes[48]
+		mi := &file_app_app_api_app_proto_msgTypes[50]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2561,7 +2655,7 @@ func (x *AdminUserRecommendRequest) String() string {
 func (*AdminUserRecommendRequest) ProtoMessage() {}
 
 func (x *AdminUserRecommendRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_app_app_api_app_proto_msgTypes[48]
+	mi := &file_app_app_api_app_proto_msgTypes[50]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2574,7 +2668,7 @@ func (x *AdminUserRecommendRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AdminUserRecommendRequest.ProtoReflect.Descriptor instead.
 func (*AdminUserRecommendRequest) Descriptor() ([]byte, []int) {
-	return file_app_app_api_app_proto_rawDescGZIP(), []int{48}
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{50}
 }
 
 func (x *AdminUserRecommendRequest) GetUserId() int64 {
@@ -2595,7 +2689,7 @@ type AdminUserRecommendReply struct {
 func (x *AdminUserRecommendReply) Reset() {
 	*x = AdminUserRecommendReply{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_app_app_api_app_proto_msgTypes[49]
+		mi := &file_app_app_api_app_proto_msgTypes[51]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2608,7 +2702,7 @@ func (x *AdminUserRecommendReply) String() string {
 func (*AdminUserRecommendReply) ProtoMessage() {}
 
 func (x *AdminUserRecommendReply) ProtoReflect() protoreflect.Message {
-	mi := &file_app_app_api_app_proto_msgTypes[49]
+	mi := &file_app_app_api_app_proto_msgTypes[51]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2621,7 +2715,7 @@ func (x *AdminUserRecommendReply) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AdminUserRecommendReply.ProtoReflect.Descriptor instead.
 func (*AdminUserRecommendReply) Descriptor() ([]byte, []int) {
-	return file_app_app_api_app_proto_rawDescGZIP(), []int{49}
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{51}
 }
 
 func (x *AdminUserRecommendReply) GetUsers() []*AdminUserRecommendReply_List {
@@ -2643,7 +2737,7 @@ type AdminMonthRecommendRequest struct {
 func (x *AdminMonthRecommendRequest) Reset() {
 	*x = AdminMonthRecommendRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_app_app_api_app_proto_msgTypes[50]
+		mi := &file_app_app_api_app_proto_msgTypes[52]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2656,7 +2750,7 @@ func (x *AdminMonthRecommendRequest) String() string {
 func (*AdminMonthRecommendRequest) ProtoMessage() {}
 
 func (x *AdminMonthRecommendRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_app_app_api_app_proto_msgTypes[50]
+	mi := &file_app_app_api_app_proto_msgTypes[52]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2669,7 +2763,7 @@ func (x *AdminMonthRecommendRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AdminMonthRecommendRequest.ProtoReflect.Descriptor instead.
 func (*AdminMonthRecommendRequest) Descriptor() ([]byte, []int) {
-	return file_app_app_api_app_proto_rawDescGZIP(), []int{50}
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{52}
 }
 
 func (x *AdminMonthRecommendRequest) GetAddress() string {
@@ -2698,7 +2792,7 @@ type AdminMonthRecommendReply struct {
 func (x *AdminMonthRecommendReply) Reset() {
 	*x = AdminMonthRecommendReply{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_app_app_api_app_proto_msgTypes[51]
+		mi := &file_app_app_api_app_proto_msgTypes[53]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2711,7 +2805,7 @@ func (x *AdminMonthRecommendReply) String() string {
 func (*AdminMonthRecommendReply) ProtoMessage() {}
 
 func (x *AdminMonthRecommendReply) ProtoReflect() protoreflect.Message {
-	mi := &file_app_app_api_app_proto_msgTypes[51]
+	mi := &file_app_app_api_app_proto_msgTypes[53]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2724,7 +2818,7 @@ func (x *AdminMonthRecommendReply) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AdminMonthRecommendReply.ProtoReflect.Descriptor instead.
 func (*AdminMonthRecommendReply) Descriptor() ([]byte, []int) {
-	return file_app_app_api_app_proto_rawDescGZIP(), []int{51}
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{53}
 }
 
 func (x *AdminMonthRecommendReply) GetUsers() []*AdminMonthRecommendReply_List {
@@ -2753,7 +2847,7 @@ type AdminConfigRequest struct {
 func (x *AdminConfigRequest) Reset() {
 	*x = AdminConfigRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_app_app_api_app_proto_msgTypes[52]
+		mi := &file_app_app_api_app_proto_msgTypes[54]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2766,7 +2860,7 @@ func (x *AdminConfigRequest) String() string {
 func (*AdminConfigRequest) ProtoMessage() {}
 
 func (x *AdminConfigRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_app_app_api_app_proto_msgTypes[52]
+	mi := &file_app_app_api_app_proto_msgTypes[54]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2779,7 +2873,7 @@ func (x *AdminConfigRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AdminConfigRequest.ProtoReflect.Descriptor instead.
 func (*AdminConfigRequest) Descriptor() ([]byte, []int) {
-	return file_app_app_api_app_proto_rawDescGZIP(), []int{52}
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{54}
 }
 
 func (x *AdminConfigRequest) GetUserId() int64 {
@@ -2808,7 +2902,7 @@ type AdminConfigReply struct {
 func (x *AdminConfigReply) Reset() {
 	*x = AdminConfigReply{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_app_app_api_app_proto_msgTypes[53]
+		mi := &file_app_app_api_app_proto_msgTypes[55]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2821,7 +2915,7 @@ func (x *AdminConfigReply) String() string {
 func (*AdminConfigReply) ProtoMessage() {}
 
 func (x *AdminConfigReply) ProtoReflect() protoreflect.Message {
-	mi := &file_app_app_api_app_proto_msgTypes[53]
+	mi := &file_app_app_api_app_proto_msgTypes[55]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2834,7 +2928,7 @@ func (x *AdminConfigReply) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AdminConfigReply.ProtoReflect.Descriptor instead.
 func (*AdminConfigReply) Descriptor() ([]byte, []int) {
-	return file_app_app_api_app_proto_rawDescGZIP(), []int{53}
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{55}
 }
 
 func (x *AdminConfigReply) GetConfig() []*AdminConfigReply_List {
@@ -2860,7 +2954,7 @@ type AdminListRequest struct {
 func (x *AdminListRequest) Reset() {
 	*x = AdminListRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_app_app_api_app_proto_msgTypes[54]
+		mi := &file_app_app_api_app_proto_msgTypes[56]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2873,7 +2967,7 @@ func (x *AdminListRequest) String() string {
 func (*AdminListRequest) ProtoMessage() {}
 
 func (x *AdminListRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_app_app_api_app_proto_msgTypes[54]
+	mi := &file_app_app_api_app_proto_msgTypes[56]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2886,7 +2980,7 @@ func (x *AdminListRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AdminListRequest.ProtoReflect.Descriptor instead.
 func (*AdminListRequest) Descriptor() ([]byte, []int) {
-	return file_app_app_api_app_proto_rawDescGZIP(), []int{54}
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{56}
 }
 
 type AdminListReply struct {
@@ -2900,7 +2994,7 @@ type AdminListReply struct {
 func (x *AdminListReply) Reset() {
 	*x = AdminListReply{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_app_app_api_app_proto_msgTypes[55]
+		mi := &file_app_app_api_app_proto_msgTypes[57]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2913,7 +3007,7 @@ func (x *AdminListReply) String() string {
 func (*AdminListReply) ProtoMessage() {}
 
 func (x *AdminListReply) ProtoReflect() protoreflect.Message {
-	mi := &file_app_app_api_app_proto_msgTypes[55]
+	mi := &file_app_app_api_app_proto_msgTypes[57]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2926,7 +3020,7 @@ func (x *AdminListReply) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AdminListReply.ProtoReflect.Descriptor instead.
 func (*AdminListReply) Descriptor() ([]byte, []int) {
-	return file_app_app_api_app_proto_rawDescGZIP(), []int{55}
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{57}
 }
 
 func (x *AdminListReply) GetAccount() []*AdminListReply_List {
@@ -2945,7 +3039,7 @@ type AuthListRequest struct {
 func (x *AuthListRequest) Reset() {
 	*x = AuthListRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_app_app_api_app_proto_msgTypes[56]
+		mi := &file_app_app_api_app_proto_msgTypes[58]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2958,7 +3052,7 @@ func (x *AuthListRequest) String() string {
 func (*AuthListRequest) ProtoMessage() {}
 
 func (x *AuthListRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_app_app_api_app_proto_msgTypes[56]
+	mi := &file_app_app_api_app_proto_msgTypes[58]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2971,7 +3065,7 @@ func (x *AuthListRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AuthListRequest.ProtoReflect.Descriptor instead.
 func (*AuthListRequest) Descriptor() ([]byte, []int) {
-	return file_app_app_api_app_proto_rawDescGZIP(), []int{56}
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{58}
 }
 
 type AuthListReply struct {
@@ -2985,7 +3079,7 @@ type AuthListReply struct {
 func (x *AuthListReply) Reset() {
 	*x = AuthListReply{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_app_app_api_app_proto_msgTypes[57]
+		mi := &file_app_app_api_app_proto_msgTypes[59]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2998,7 +3092,7 @@ func (x *AuthListReply) String() string {
 func (*AuthListReply) ProtoMessage() {}
 
 func (x *AuthListReply) ProtoReflect() protoreflect.Message {
-	mi := &file_app_app_api_app_proto_msgTypes[57]
+	mi := &file_app_app_api_app_proto_msgTypes[59]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3011,7 +3105,7 @@ func (x *AuthListReply) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AuthListReply.ProtoReflect.Descriptor instead.
 func (*AuthListReply) Descriptor() ([]byte, []int) {
-	return file_app_app_api_app_proto_rawDescGZIP(), []int{57}
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{59}
 }
 
 func (x *AuthListReply) GetAuth() []*AuthListReply_List {
@@ -3032,7 +3126,7 @@ type UserAuthListRequest struct {
 func (x *UserAuthListRequest) Reset() {
 	*x = UserAuthListRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_app_app_api_app_proto_msgTypes[58]
+		mi := &file_app_app_api_app_proto_msgTypes[60]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3045,7 +3139,7 @@ func (x *UserAuthListRequest) String() string {
 func (*UserAuthListRequest) ProtoMessage() {}
 
 func (x *UserAuthListRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_app_app_api_app_proto_msgTypes[58]
+	mi := &file_app_app_api_app_proto_msgTypes[60]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3058,7 +3152,7 @@ func (x *UserAuthListRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UserAuthListRequest.ProtoReflect.Descriptor instead.
 func (*UserAuthListRequest) Descriptor() ([]byte, []int) {
-	return file_app_app_api_app_proto_rawDescGZIP(), []int{58}
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{60}
 }
 
 func (x *UserAuthListRequest) GetAdminId() int64 {
@@ -3079,7 +3173,7 @@ type UserAuthListReply struct {
 func (x *UserAuthListReply) Reset() {
 	*x = UserAuthListReply{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_app_app_api_app_proto_msgTypes[59]
+		mi := &file_app_app_api_app_proto_msgTypes[61]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3092,7 +3186,7 @@ func (x *UserAuthListReply) String() string {
 func (*UserAuthListReply) ProtoMessage() {}
 
 func (x *UserAuthListReply) ProtoReflect() protoreflect.Message {
-	mi := &file_app_app_api_app_proto_msgTypes[59]
+	mi := &file_app_app_api_app_proto_msgTypes[61]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3105,7 +3199,7 @@ func (x *UserAuthListReply) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UserAuthListReply.ProtoReflect.Descriptor instead.
 func (*UserAuthListReply) Descriptor() ([]byte, []int) {
-	return file_app_app_api_app_proto_rawDescGZIP(), []int{59}
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{61}
 }
 
 func (x *UserAuthListReply) GetAuth() []*UserAuthListReply_List {
@@ -3124,7 +3218,7 @@ type MyAuthListRequest struct {
 func (x *MyAuthListRequest) Reset() {
 	*x = MyAuthListRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_app_app_api_app_proto_msgTypes[60]
+		mi := &file_app_app_api_app_proto_msgTypes[62]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3137,7 +3231,7 @@ func (x *MyAuthListRequest) String() string {
 func (*MyAuthListRequest) ProtoMessage() {}
 
 func (x *MyAuthListRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_app_app_api_app_proto_msgTypes[60]
+	mi := &file_app_app_api_app_proto_msgTypes[62]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3150,7 +3244,7 @@ func (x *MyAuthListRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use MyAuthListRequest.ProtoReflect.Descriptor instead.
 func (*MyAuthListRequest) Descriptor() ([]byte, []int) {
-	return file_app_app_api_app_proto_rawDescGZIP(), []int{60}
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{62}
 }
 
 type MyAuthListReply struct {
@@ -3165,7 +3259,7 @@ type MyAuthListReply struct {
 func (x *MyAuthListReply) Reset() {
 	*x = MyAuthListReply{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_app_app_api_app_proto_msgTypes[61]
+		mi := &file_app_app_api_app_proto_msgTypes[63]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3178,7 +3272,7 @@ func (x *MyAuthListReply) String() string {
 func (*MyAuthListReply) ProtoMessage() {}
 
 func (x *MyAuthListReply) ProtoReflect() protoreflect.Message {
-	mi := &file_app_app_api_app_proto_msgTypes[61]
+	mi := &file_app_app_api_app_proto_msgTypes[63]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3191,7 +3285,7 @@ func (x *MyAuthListReply) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use MyAuthListReply.ProtoReflect.Descriptor instead.
 func (*MyAuthListReply) Descriptor() ([]byte, []int) {
-	return file_app_app_api_app_proto_rawDescGZIP(), []int{61}
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{63}
 }
 
 func (x *MyAuthListReply) GetAuth() []*MyAuthListReply_List {
@@ -3219,7 +3313,7 @@ type AdminConfigUpdateRequest struct {
 func (x *AdminConfigUpdateRequest) Reset() {
 	*x = AdminConfigUpdateRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_app_app_api_app_proto_msgTypes[62]
+		mi := &file_app_app_api_app_proto_msgTypes[64]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3232,7 +3326,7 @@ func (x *AdminConfigUpdateRequest) String() string {
 func (*AdminConfigUpdateRequest) ProtoMessage() {}
 
 func (x *AdminConfigUpdateRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_app_app_api_app_proto_msgTypes[62]
+	mi := &file_app_app_api_app_proto_msgTypes[64]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3245,7 +3339,7 @@ func (x *AdminConfigUpdateRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AdminConfigUpdateRequest.ProtoReflect.Descriptor instead.
 func (*AdminConfigUpdateRequest) Descriptor() ([]byte, []int) {
-	return file_app_app_api_app_proto_rawDescGZIP(), []int{62}
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{64}
 }
 
 func (x *AdminConfigUpdateRequest) GetSendBody() *AdminConfigUpdateRequest_SendBody {
@@ -3264,7 +3358,7 @@ type AdminConfigUpdateReply struct {
 func (x *AdminConfigUpdateReply) Reset() {
 	*x = AdminConfigUpdateReply{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_app_app_api_app_proto_msgTypes[63]
+		mi := &file_app_app_api_app_proto_msgTypes[65]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3277,7 +3371,7 @@ func (x *AdminConfigUpdateReply) String() string {
 func (*AdminConfigUpdateReply) ProtoMessage() {}
 
 func (x *AdminConfigUpdateReply) ProtoReflect() protoreflect.Message {
-	mi := &file_app_app_api_app_proto_msgTypes[63]
+	mi := &file_app_app_api_app_proto_msgTypes[65]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3290,7 +3384,7 @@ func (x *AdminConfigUpdateReply) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AdminConfigUpdateReply.ProtoReflect.Descriptor instead.
 func (*AdminConfigUpdateReply) Descriptor() ([]byte, []int) {
-	return file_app_app_api_app_proto_rawDescGZIP(), []int{63}
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{65}
 }
 
 type AdminVipUpdateRequest struct {
@@ -3304,7 +3398,7 @@ type AdminVipUpdateRequest struct {
 func (x *AdminVipUpdateRequest) Reset() {
 	*x = AdminVipUpdateRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_app_app_api_app_proto_msgTypes[64]
+		mi := &file_app_app_api_app_proto_msgTypes[66]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3317,7 +3411,7 @@ func (x *AdminVipUpdateRequest) String() string {
 func (*AdminVipUpdateRequest) ProtoMessage() {}
 
 func (x *AdminVipUpdateRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_app_app_api_app_proto_msgTypes[64]
+	mi := &file_app_app_api_app_proto_msgTypes[66]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3330,7 +3424,7 @@ func (x *AdminVipUpdateRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AdminVipUpdateRequest.ProtoReflect.Descriptor instead.
 func (*AdminVipUpdateRequest) Descriptor() ([]byte, []int) {
-	return file_app_app_api_app_proto_rawDescGZIP(), []int{64}
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{66}
 }
 
 func (x *AdminVipUpdateRequest) GetSendBody() *AdminVipUpdateRequest_SendBody {
@@ -3349,7 +3443,7 @@ type AdminVipUpdateReply struct {
 func (x *AdminVipUpdateReply) Reset() {
 	*x = AdminVipUpdateReply{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_app_app_api_app_proto_msgTypes[65]
+		mi := &file_app_app_api_app_proto_msgTypes[67]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3362,7 +3456,7 @@ func (x *AdminVipUpdateReply) String() string {
 func (*AdminVipUpdateReply) ProtoMessage() {}
 
 func (x *AdminVipUpdateReply) ProtoReflect() protoreflect.Message {
-	mi := &file_app_app_api_app_proto_msgTypes[65]
+	mi := &file_app_app_api_app_proto_msgTypes[67]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3375,7 +3469,7 @@ func (x *AdminVipUpdateReply) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AdminVipUpdateReply.ProtoReflect.Descriptor instead.
 func (*AdminVipUpdateReply) Descriptor() ([]byte, []int) {
-	return file_app_app_api_app_proto_rawDescGZIP(), []int{65}
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{67}
 }
 
 type AdminUndoUpdateRequest struct {
@@ -3389,7 +3483,7 @@ type AdminUndoUpdateRequest struct {
 func (x *AdminUndoUpdateRequest) Reset() {
 	*x = AdminUndoUpdateRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_app_app_api_app_proto_msgTypes[66]
+		mi := &file_app_app_api_app_proto_msgTypes[68]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3402,7 +3496,7 @@ func (x *AdminUndoUpdateRequest) String() string {
 func (*AdminUndoUpdateRequest) ProtoMessage() {}
 
 func (x *AdminUndoUpdateRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_app_app_api_app_proto_msgTypes[66]
+	mi := &file_app_app_api_app_proto_msgTypes[68]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3415,7 +3509,7 @@ func (x *AdminUndoUpdateRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AdminUndoUpdateRequest.ProtoReflect.Descriptor instead.
 func (*AdminUndoUpdateRequest) Descriptor() ([]byte, []int) {
-	return file_app_app_api_app_proto_rawDescGZIP(), []int{66}
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{68}
 }
 
 func (x *AdminUndoUpdateRequest) GetSendBody() *AdminUndoUpdateRequest_SendBody {
@@ -3434,7 +3528,7 @@ type AdminUndoUpdateReply struct {
 func (x *AdminUndoUpdateReply) Reset() {
 	*x = AdminUndoUpdateReply{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_app_app_api_app_proto_msgTypes[67]
+		mi := &file_app_app_api_app_proto_msgTypes[69]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3447,7 +3541,7 @@ func (x *AdminUndoUpdateReply) String() string {
 func (*AdminUndoUpdateReply) ProtoMessage() {}
 
 func (x *AdminUndoUpdateReply) ProtoReflect() protoreflect.Message {
-	mi := &file_app_app_api_app_proto_msgTypes[67]
+	mi := &file_app_app_api_app_proto_msgTypes[69]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3460,7 +3554,7 @@ func (x *AdminUndoUpdateReply) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AdminUndoUpdateReply.ProtoReflect.Descriptor instead.
 func (*AdminUndoUpdateReply) Descriptor() ([]byte, []int) {
-	return file_app_app_api_app_proto_rawDescGZIP(), []int{67}
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{69}
 }
 
 type AdminAreaLevelUpdateRequest struct {
@@ -3474,7 +3568,7 @@ type AdminAreaLevelUpdateRequest struct {
 func (x *AdminAreaLevelUpdateRequest) Reset() {
 	*x = AdminAreaLevelUpdateRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_app_app_api_app_proto_msgTypes[68]
+		mi := &file_app_app_api_app_proto_msgTypes[70]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3487,7 +3581,7 @@ func (x *AdminAreaLevelUpdateRequest) String() string {
 func (*AdminAreaLevelUpdateRequest) ProtoMessage() {}
 
 func (x *AdminAreaLevelUpdateRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_app_app_api_app_proto_msgTypes[68]
+	mi := &file_app_app_api_app_proto_msgTypes[70]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3500,7 +3594,7 @@ func (x *AdminAreaLevelUpdateRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AdminAreaLevelUpdateRequest.ProtoReflect.Descriptor instead.
 func (*AdminAreaLevelUpdateRequest) Descriptor() ([]byte, []int) {
-	return file_app_app_api_app_proto_rawDescGZIP(), []int{68}
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{70}
 }
 
 func (x *AdminAreaLevelUpdateRequest) GetSendBody() *AdminAreaLevelUpdateRequest_SendBody {
@@ -3519,7 +3613,7 @@ type AdminAreaLevelUpdateReply struct {
 func (x *AdminAreaLevelUpdateReply) Reset() {
 	*x = AdminAreaLevelUpdateReply{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_app_app_api_app_proto_msgTypes[69]
+		mi := &file_app_app_api_app_proto_msgTypes[71]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3532,7 +3626,7 @@ func (x *AdminAreaLevelUpdateReply) String() string {
 func (*AdminAreaLevelUpdateReply) ProtoMessage() {}
 
 func (x *AdminAreaLevelUpdateReply) ProtoReflect() protoreflect.Message {
-	mi := &file_app_app_api_app_proto_msgTypes[69]
+	mi := &file_app_app_api_app_proto_msgTypes[71]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3545,7 +3639,7 @@ func (x *AdminAreaLevelUpdateReply) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AdminAreaLevelUpdateReply.ProtoReflect.Descriptor instead.
 func (*AdminAreaLevelUpdateReply) Descriptor() ([]byte, []int) {
-	return file_app_app_api_app_proto_rawDescGZIP(), []int{69}
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{71}
 }
 
 type AdminLocationInsertRequest struct {
@@ -3559,7 +3653,7 @@ type AdminLocationInsertRequest struct {
 func (x *AdminLocationInsertRequest) Reset() {
 	*x = AdminLocationInsertRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_app_app_api_app_proto_msgTypes[70]
+		mi := &file_app_app_api_app_proto_msgTypes[72]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3572,7 +3666,7 @@ func (x *AdminLocationInsertRequest) String() string {
 func (*AdminLocationInsertRequest) ProtoMessage() {}
 
 func (x *AdminLocationInsertRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_app_app_api_app_proto_msgTypes[70]
+	mi := &file_app_app_api_app_proto_msgTypes[72]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3585,7 +3679,7 @@ func (x *AdminLocationInsertRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AdminLocationInsertRequest.ProtoReflect.Descriptor instead.
 func (*AdminLocationInsertRequest) Descriptor() ([]byte, []int) {
-	return file_app_app_api_app_proto_rawDescGZIP(), []int{70}
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{72}
 }
 
 func (x *AdminLocationInsertRequest) GetSendBody() *AdminLocationInsertRequest_SendBody {
@@ -3599,12 +3693,14 @@ type AdminLocationInsertReply struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	ProposalId int64 `protobuf:"varint,1,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty"`
 }
 
 func (x *AdminLocationInsertReply) Reset() {
 	*x = AdminLocationInsertReply{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_app_app_api_app_proto_msgTypes[71]
+		mi := &file_app_app_api_app_proto_msgTypes[73]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3617,7 +3713,7 @@ func (x *AdminLocationInsertReply) String() string {
 func (*AdminLocationInsertReply) ProtoMessage() {}
 
 func (x *AdminLocationInsertReply) ProtoReflect() protoreflect.Message {
-	mi := &file_app_app_api_app_proto_msgTypes[71]
+	mi := &file_app_app_api_app_proto_msgTypes[73]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3630,7 +3726,14 @@ func (x *AdminLocationInsertReply) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AdminLocationInsertReply.ProtoReflect.Descriptor instead.
 func (*AdminLocationInsertReply) Descriptor() ([]byte, []int) {
-	return file_app_app_api_app_proto_rawDescGZIP(), []int{71}
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{73}
+}
+
+func (x *AdminLocationInsertReply) GetProposalId() int64 {
+	if x != nil {
+		return x.ProposalId
+	}
+	return 0
 }
 
 type AdminBalanceUpdateRequest struct {
@@ -3644,7 +3747,7 @@ type AdminBalanceUpdateRequest struct {
 func (x *AdminBalanceUpdateRequest) Reset() {
 	*x = AdminBalanceUpdateRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_app_app_api_app_proto_msgTypes[72]
+		mi := &file_app_app_api_app_proto_msgTypes[74]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3657,7 +3760,7 @@ func (x *AdminBalanceUpdateRequest) String() string {
 func (*AdminBalanceUpdateRequest) ProtoMessage() {}
 
 func (x *AdminBalanceUpdateRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_app_app_api_app_proto_msgTypes[72]
+	mi := &file_app_app_api_app_proto_msgTypes[74]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3670,7 +3773,7 @@ func (x *AdminBalanceUpdateRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AdminBalanceUpdateRequest.ProtoReflect.Descriptor instead.
 func (*AdminBalanceUpdateRequest) Descriptor() ([]byte, []int) {
-	return file_app_app_api_app_proto_rawDescGZIP(), []int{72}
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{74}
 }
 
 func (x *AdminBalanceUpdateRequest) GetSendBody() *AdminBalanceUpdateRequest_SendBody {
@@ -3684,12 +3787,15 @@ type AdminBalanceUpdateReply struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	ProposalId int64 `protobuf:"varint,1,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty"`
+	RecordId   int64 `protobuf:"varint,2,opt,name=record_id,json=recordId,proto3" json:"record_id,omitempty"`
 }
 
 func (x *AdminBalanceUpdateReply) Reset() {
 	*x = AdminBalanceUpdateReply{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_app_app_api_app_proto_msgTypes[73]
+		mi := &file_app_app_api_app_proto_msgTypes[75]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3702,7 +3808,7 @@ func (x *AdminBalanceUpdateReply) String() string {
 func (*AdminBalanceUpdateReply) ProtoMessage() {}
 
 func (x *AdminBalanceUpdateReply) ProtoReflect() protoreflect.Message {
-	mi := &file_app_app_api_app_proto_msgTypes[73]
+	mi := &file_app_app_api_app_proto_msgTypes[75]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3715,7 +3821,21 @@ func (x *AdminBalanceUpdateReply) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AdminBalanceUpdateReply.ProtoReflect.Descriptor instead.
 func (*AdminBalanceUpdateReply) Descriptor() ([]byte, []int) {
-	return file_app_app_api_app_proto_rawDescGZIP(), []int{73}
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{75}
+}
+
+func (x *AdminBalanceUpdateReply) GetProposalId() int64 {
+	if x != nil {
+		return x.ProposalId
+	}
+	return 0
+}
+
+func (x *AdminBalanceUpdateReply) GetRecordId() int64 {
+	if x != nil {
+		return x.RecordId
+	}
+	return 0
 }
 
 type AuthAdminCreateRequest struct {
@@ -3729,7 +3849,7 @@ type AuthAdminCreateRequest struct {
 func (x *AuthAdminCreateRequest) Reset() {
 	*x = AuthAdminCreateRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_app_app_api_app_proto_msgTypes[74]
+		mi := &file_app_app_api_app_proto_msgTypes[76]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3742,7 +3862,7 @@ func (x *AuthAdminCreateRequest) String() string {
 func (*AuthAdminCreateRequest) ProtoMessage() {}
 
 func (x *AuthAdminCreateRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_app_app_api_app_proto_msgTypes[74]
+	mi := &file_app_app_api_app_proto_msgTypes[76]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3755,7 +3875,7 @@ func (x *AuthAdminCreateRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AuthAdminCreateRequest.ProtoReflect.Descriptor instead.
 func (*AuthAdminCreateRequest) Descriptor() ([]byte, []int) {
-	return file_app_app_api_app_proto_rawDescGZIP(), []int{74}
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{76}
 }
 
 func (x *AuthAdminCreateRequest) GetSendBody() *AuthAdminCreateRequest_SendBody {
@@ -3774,7 +3894,7 @@ type AuthAdminCreateReply struct {
 func (x *AuthAdminCreateReply) Reset() {
 	*x = AuthAdminCreateReply{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_app_app_api_app_proto_msgTypes[75]
+		mi := &file_app_app_api_app_proto_msgTypes[77]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3787,7 +3907,7 @@ func (x *AuthAdminCreateReply) String() string {
 func (*AuthAdminCreateReply) ProtoMessage() {}
 
 func (x *AuthAdminCreateReply) ProtoReflect() protoreflect.Message {
-	mi := &file_app_app_api_app_proto_msgTypes[75]
+	mi := &file_app_app_api_app_proto_msgTypes[77]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3800,7 +3920,7 @@ func (x *AuthAdminCreateReply) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AuthAdminCreateReply.ProtoReflect.Descriptor instead.
 func (*AuthAdminCreateReply) Descriptor() ([]byte, []int) {
-	return file_app_app_api_app_proto_rawDescGZIP(), []int{75}
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{77}
 }
 
 type AuthAdminDeleteRequest struct {
@@ -3814,7 +3934,7 @@ type AuthAdminDeleteRequest struct {
 func (x *AuthAdminDeleteRequest) Reset() {
 	*x = AuthAdminDeleteRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_app_app_api_app_proto_msgTypes[76]
+		mi := &file_app_app_api_app_proto_msgTypes[78]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3827,7 +3947,7 @@ func (x *AuthAdminDeleteRequest) String() string {
 func (*AuthAdminDeleteRequest) ProtoMessage() {}
 
 func (x *AuthAdminDeleteRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_app_app_api_app_proto_msgTypes[76]
+	mi := &file_app_app_api_app_proto_msgTypes[78]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3840,7 +3960,7 @@ func (x *AuthAdminDeleteRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AuthAdminDeleteRequest.ProtoReflect.Descriptor instead.
 func (*AuthAdminDeleteRequest) Descriptor() ([]byte, []int) {
-	return file_app_app_api_app_proto_rawDescGZIP(), []int{76}
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{78}
 }
 
 func (x *AuthAdminDeleteRequest) GetSendBody() *AuthAdminDeleteRequest_SendBody {
@@ -3859,7 +3979,7 @@ type AuthAdminDeleteReply struct {
 func (x *AuthAdminDeleteReply) Reset() {
 	*x = AuthAdminDeleteReply{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_app_app_api_app_proto_msgTypes[77]
+		mi := &file_app_app_api_app_proto_msgTypes[79]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3872,7 +3992,7 @@ func (x *AuthAdminDeleteReply) String() string {
 func (*AuthAdminDeleteReply) ProtoMessage() {}
 
 func (x *AuthAdminDeleteReply) ProtoReflect() protoreflect.Message {
-	mi := &file_app_app_api_app_proto_msgTypes[77]
+	mi := &file_app_app_api_app_proto_msgTypes[79]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3885,7 +4005,7 @@ func (x *AuthAdminDeleteReply) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AuthAdminDeleteReply.ProtoReflect.Descriptor instead.
 func (*AuthAdminDeleteReply) Descriptor() ([]byte, []int) {
-	return file_app_app_api_app_proto_rawDescGZIP(), []int{77}
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{79}
 }
 
 type CheckAndInsertRecommendAreaRequest struct {
@@ -3897,7 +4017,7 @@ type CheckAndInsertRecommendAreaRequest struct {
 func (x *CheckAndInsertRecommendAreaRequest) Reset() {
 	*x = CheckAndInsertRecommendAreaRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_app_app_api_app_proto_msgTypes[78]
+		mi := &file_app_app_api_app_proto_msgTypes[80]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3910,7 +4030,7 @@ func (x *CheckAndInsertRecommendAreaRequest) String() string {
 func (*CheckAndInsertRecommendAreaRequest) ProtoMessage() {}
 
 func (x *CheckAndInsertRecommendAreaRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_app_app_api_app_proto_msgTypes[78]
+	mi := &file_app_app_api_app_proto_msgTypes[80]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3923,7 +4043,7 @@ func (x *CheckAndInsertRecommendAreaRequest) ProtoReflect() protoreflect.Message
 
 // Deprecated: Use CheckAndInsertRecommendAreaRequest.ProtoReflect.Descriptor instead.
 func (*CheckAndInsertRecommendAreaRequest) Descriptor() ([]byte, []int) {
-	return file_app_app_api_app_proto_rawDescGZIP(), []int{78}
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{80}
 }
 
 type CheckAndInsertRecommendAreaReply struct {
@@ -3935,7 +4055,7 @@ type CheckAndInsertRecommendAreaReply struct {
 func (x *CheckAndInsertRecommendAreaReply) Reset() {
 	*x = CheckAndInsertRecommendAreaReply{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_app_app_api_app_proto_msgTypes[79]
+		mi := &file_app_app_api_app_proto_msgTypes[81]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3948,7 +4068,7 @@ func (x *CheckAndInsertRecommendAreaReply) String() string {
 func (*CheckAndInsertRecommendAreaReply) ProtoMessage() {}
 
 func (x *CheckAndInsertRecommendAreaReply) ProtoReflect() protoreflect.Message {
-	mi := &file_app_app_api_app_proto_msgTypes[79]
+	mi := &file_app_app_api_app_proto_msgTypes[81]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3961,7 +4081,7 @@ func (x *CheckAndInsertRecommendAreaReply) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CheckAndInsertRecommendAreaReply.ProtoReflect.Descriptor instead.
 func (*CheckAndInsertRecommendAreaReply) Descriptor() ([]byte, []int) {
-	return file_app_app_api_app_proto_rawDescGZIP(), []int{79}
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{81}
 }
 
 type AdminDailyRecommendRewardRequest struct {
@@ -3975,7 +4095,7 @@ type AdminDailyRecommendRewardRequest struct {
 func (x *AdminDailyRecommendRewardRequest) Reset() {
 	*x = AdminDailyRecommendRewardRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_app_app_api_app_proto_msgTypes[80]
+		mi := &file_app_app_api_app_proto_msgTypes[82]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3988,7 +4108,7 @@ func (x *AdminDailyRecommendRewardRequest) String() string {
 func (*AdminDailyRecommendRewardRequest) ProtoMessage() {}
 
 func (x *AdminDailyRecommendRewardRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_app_app_api_app_proto_msgTypes[80]
+	mi := &file_app_app_api_app_proto_msgTypes[82]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4001,7 +4121,7 @@ func (x *AdminDailyRecommendRewardRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AdminDailyRecommendRewardRequest.ProtoReflect.Descriptor instead.
 func (*AdminDailyRecommendRewardRequest) Descriptor() ([]byte, []int) {
-	return file_app_app_api_app_proto_rawDescGZIP(), []int{80}
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{82}
 }
 
 func (x *AdminDailyRecommendRewardRequest) GetDay() int32 {
@@ -4020,7 +4140,7 @@ type AdminDailyRecommendRewardReply struct {
 func (x *AdminDailyRecommendRewardReply) Reset() {
 	*x = AdminDailyRecommendRewardReply{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_app_app_api_app_proto_msgTypes[81]
+		mi := &file_app_app_api_app_proto_msgTypes[83]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4033,7 +4153,7 @@ func (x *AdminDailyRecommendRewardReply) String() string {
 func (*AdminDailyRecommendRewardReply) ProtoMessage() {}
 
 func (x *AdminDailyRecommendRewardReply) ProtoReflect() protoreflect.Message {
-	mi := &file_app_app_api_app_proto_msgTypes[81]
+	mi := &file_app_app_api_app_proto_msgTypes[83]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4046,7 +4166,7 @@ func (x *AdminDailyRecommendRewardReply) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AdminDailyRecommendRewardReply.ProtoReflect.Descriptor instead.
 func (*AdminDailyRecommendRewardReply) Descriptor() ([]byte, []int) {
-	return file_app_app_api_app_proto_rawDescGZIP(), []int{81}
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{83}
 }
 
 type AdminDailyRecommendTopRewardRequest struct {
@@ -4060,7 +4180,7 @@ type AdminDailyRecommendTopRewardRequest struct {
 func (x *AdminDailyRecommendTopRewardRequest) Reset() {
 	*x = AdminDailyRecommendTopRewardRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_app_app_api_app_proto_msgTypes[82]
+		mi := &file_app_app_api_app_proto_msgTypes[84]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4073,7 +4193,7 @@ func (x *AdminDailyRecommendTopRewardRequest) String() string {
 func (*AdminDailyRecommendTopRewardRequest) ProtoMessage() {}
 
 func (x *AdminDailyRecommendTopRewardRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_app_app_api_app_proto_msgTypes[82]
+	mi := &file_app_app_api_app_proto_msgTypes[84]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4086,7 +4206,7 @@ func (x *AdminDailyRecommendTopRewardRequest) ProtoReflect() protoreflect.Messag
 
 // Deprecated: Use AdminDailyRecommendTopRewardRequest.ProtoReflect.Descriptor instead.
 func (*AdminDailyRecommendTopRewardRequest) Descriptor() ([]byte, []int) {
-	return file_app_app_api_app_proto_rawDescGZIP(), []int{82}
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{84}
 }
 
 func (x *AdminDailyRecommendTopRewardRequest) GetDay() int32 {
@@ -4105,7 +4225,7 @@ type AdminDailyRecommendTopRewardReply struct {
 func (x *AdminDailyRecommendTopRewardReply) Reset() {
 	*x = AdminDailyRecommendTopRewardReply{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_app_app_api_app_proto_msgTypes[83]
+		mi := &file_app_app_api_app_proto_msgTypes[85]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4118,7 +4238,7 @@ func (x *AdminDailyRecommendTopRewardReply) String() string {
 func (*AdminDailyRecommendTopRewardReply) ProtoMessage() {}
 
 func (x *AdminDailyRecommendTopRewardReply) ProtoReflect() protoreflect.Message {
-	mi := &file_app_app_api_app_proto_msgTypes[83]
+	mi := &file_app_app_api_app_proto_msgTypes[85]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4131,7 +4251,7 @@ func (x *AdminDailyRecommendTopRewardReply) ProtoReflect() protoreflect.Message
 
 // Deprecated: Use AdminDailyRecommendTopRewardReply.ProtoReflect.Descriptor instead.
 func (*AdminDailyRecommendTopRewardReply) Descriptor() ([]byte, []int) {
-	return file_app_app_api_app_proto_rawDescGZIP(), []int{83}
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{85}
 }
 
 type AdminDailyWithdrawRewardRequest struct {
@@ -4145,7 +4265,7 @@ type AdminDailyWithdrawRewardRequest struct {
 func (x *AdminDailyWithdrawRewardRequest) Reset() {
 	*x = AdminDailyWithdrawRewardRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_app_app_api_app_proto_msgTypes[84]
+		mi := &file_app_app_api_app_proto_msgTypes[86]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4158,7 +4278,7 @@ func (x *AdminDailyWithdrawRewardRequest) String() string {
 func (*AdminDailyWithdrawRewardRequest) ProtoMessage() {}
 
 func (x *AdminDailyWithdrawRewardRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_app_app_api_app_proto_msgTypes[84]
+	mi := &file_app_app_api_app_proto_msgTypes[86]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4171,7 +4291,7 @@ func (x *AdminDailyWithdrawRewardRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AdminDailyWithdrawRewardRequest.ProtoReflect.Descriptor instead.
 func (*AdminDailyWithdrawRewardRequest) Descriptor() ([]byte, []int) {
-	return file_app_app_api_app_proto_rawDescGZIP(), []int{84}
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{86}
 }
 
 func (x *AdminDailyWithdrawRewardRequest) GetDay() int32 {
@@ -4190,7 +4310,7 @@ type AdminDailyWithdrawRewardReply struct {
 func (x *AdminDailyWithdrawRewardReply) Reset() {
 	*x = AdminDailyWithdrawRewardReply{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_app_app_api_app_proto_msgTypes[85]
+		mi := &file_app_app_api_app_proto_msgTypes[87]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4203,7 +4323,7 @@ func (x *AdminDailyWithdrawRewardReply) String() string {
 func (*AdminDailyWithdrawRewardReply) ProtoMessage() {}
 
 func (x *AdminDailyWithdrawRewardReply) ProtoReflect() protoreflect.Message {
-	mi := &file_app_app_api_app_proto_msgTypes[85]
+	mi := &file_app_app_api_app_proto_msgTypes[87]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4216,7 +4336,7 @@ func (x *AdminDailyWithdrawRewardReply) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AdminDailyWithdrawRewardReply.ProtoReflect.Descriptor instead.
 func (*AdminDailyWithdrawRewardReply) Descriptor() ([]byte, []int) {
-	return file_app_app_api_app_proto_rawDescGZIP(), []int{85}
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{87}
 }
 
 type FixRewardRequest struct {
@@ -4231,7 +4351,7 @@ type FixRewardRequest struct {
 func (x *FixRewardRequest) Reset() {
 	*x = FixRewardRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_app_app_api_app_proto_msgTypes[86]
+		mi := &file_app_app_api_app_proto_msgTypes[88]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4244,7 +4364,7 @@ func (x *FixRewardRequest) String() string {
 func (*FixRewardRequest) ProtoMessage() {}
 
 func (x *FixRewardRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_app_app_api_app_proto_msgTypes[86]
+	mi := &file_app_app_api_app_proto_msgTypes[88]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4257,7 +4377,7 @@ func (x *FixRewardRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FixRewardRequest.ProtoReflect.Descriptor instead.
 func (*FixRewardRequest) Descriptor() ([]byte, []int) {
-	return file_app_app_api_app_proto_rawDescGZIP(), []int{86}
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{88}
 }
 
 func (x *FixRewardRequest) GetId1() int64 {
@@ -4283,7 +4403,7 @@ type FixRewardReply struct {
 func (x *FixRewardReply) Reset() {
 	*x = FixRewardReply{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_app_app_api_app_proto_msgTypes[87]
+		mi := &file_app_app_api_app_proto_msgTypes[89]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4296,7 +4416,7 @@ func (x *FixRewardReply) String() string {
 func (*FixRewardReply) ProtoMessage() {}
 
 func (x *FixRewardReply) ProtoReflect() protoreflect.Message {
-	mi := &file_app_app_api_app_proto_msgTypes[87]
+	mi := &file_app_app_api_app_proto_msgTypes[89]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4309,7 +4429,7 @@ func (x *FixRewardReply) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FixRewardReply.ProtoReflect.Descriptor instead.
 func (*FixRewardReply) Descriptor() ([]byte, []int) {
-	return file_app_app_api_app_proto_rawDescGZIP(), []int{87}
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{89}
 }
 
 type FixLocationsRequest struct {
@@ -4321,7 +4441,7 @@ type FixLocationsRequest struct {
 func (x *FixLocationsRequest) Reset() {
 	*x = FixLocationsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_app_app_api_app_proto_msgTypes[88]
+		mi := &file_app_app_api_app_proto_msgTypes[90]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4334,7 +4454,7 @@ func (x *FixLocationsRequest) String() string {
 func (*FixLocationsRequest) ProtoMessage() {}
 
 func (x *FixLocationsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_app_app_api_app_proto_msgTypes[88]
+	mi := &file_app_app_api_app_proto_msgTypes[90]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4347,7 +4467,7 @@ func (x *FixLocationsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FixLocationsRequest.ProtoReflect.Descriptor instead.
 func (*FixLocationsRequest) Descriptor() ([]byte, []int) {
-	return file_app_app_api_app_proto_rawDescGZIP(), []int{88}
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{90}
 }
 
 type FixLocationsReply struct {
@@ -4359,7 +4479,7 @@ type FixLocationsReply struct {
 func (x *FixLocationsReply) Reset() {
 	*x = FixLocationsReply{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_app_app_api_app_proto_msgTypes[89]
+		mi := &file_app_app_api_app_proto_msgTypes[91]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4372,7 +4492,7 @@ func (x *FixLocationsReply) String() string {
 func (*FixLocationsReply) ProtoMessage() {}
 
 func (x *FixLocationsReply) ProtoReflect() protoreflect.Message {
-	mi := &file_app_app_api_app_proto_msgTypes[89]
+	mi := &file_app_app_api_app_proto_msgTypes[91]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4385,7 +4505,7 @@ func (x *FixLocationsReply) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FixLocationsReply.ProtoReflect.Descriptor instead.
 func (*FixLocationsReply) Descriptor() ([]byte, []int) {
-	return file_app_app_api_app_proto_rawDescGZIP(), []int{89}
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{91}
 }
 
 type AdminLoginRequest struct {
@@ -4399,7 +4519,7 @@ type AdminLoginRequest struct {
 func (x *AdminLoginRequest) Reset() {
 	*x = AdminLoginRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_app_app_api_app_proto_msgTypes[90]
+		mi := &file_app_app_api_app_proto_msgTypes[92]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4412,7 +4532,7 @@ func (x *AdminLoginRequest) String() string {
 func (*AdminLoginRequest) ProtoMessage() {}
 
 func (x *AdminLoginRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_app_app_api_app_proto_msgTypes[90]
+	mi := &file_app_app_api_app_proto_msgTypes[92]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4425,7 +4545,7 @@ func (x *AdminLoginRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AdminLoginRequest.ProtoReflect.Descriptor instead.
 func (*AdminLoginRequest) Descriptor() ([]byte, []int) {
-	return file_app_app_api_app_proto_rawDescGZIP(), []int{90}
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{92}
 }
 
 func (x *AdminLoginRequest) GetSendBody() *AdminLoginRequest_SendBody {
@@ -4446,7 +4566,7 @@ type AdminLoginReply struct {
 func (x *AdminLoginReply) Reset() {
 	*x = AdminLoginReply{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_app_app_api_app_proto_msgTypes[91]
+		mi := &file_app_app_api_app_proto_msgTypes[93]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4459,7 +4579,7 @@ func (x *AdminLoginReply) String() string {
 func (*AdminLoginReply) ProtoMessage() {}
 
 func (x *AdminLoginReply) ProtoReflect() protoreflect.Message {
-	mi := &file_app_app_api_app_proto_msgTypes[91]
+	mi := &file_app_app_api_app_proto_msgTypes[93]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4472,7 +4592,7 @@ func (x *AdminLoginReply) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AdminLoginReply.ProtoReflect.Descriptor instead.
 func (*AdminLoginReply) Descriptor() ([]byte, []int) {
-	return file_app_app_api_app_proto_rawDescGZIP(), []int{91}
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{93}
 }
 
 func (x *AdminLoginReply) GetToken() string {
@@ -4493,7 +4613,7 @@ type AdminChangePasswordRequest struct {
 func (x *AdminChangePasswordRequest) Reset() {
 	*x = AdminChangePasswordRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_app_app_api_app_proto_msgTypes[92]
+		mi := &file_app_app_api_app_proto_msgTypes[94]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4506,7 +4626,7 @@ func (x *AdminChangePasswordRequest) String() string {
 func (*AdminChangePasswordRequest) ProtoMessage() {}
 
 func (x *AdminChangePasswordRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_app_app_api_app_proto_msgTypes[92]
+	mi := &file_app_app_api_app_proto_msgTypes[94]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4519,7 +4639,7 @@ func (x *AdminChangePasswordRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AdminChangePasswordRequest.ProtoReflect.Descriptor instead.
 func (*AdminChangePasswordRequest) Descriptor() ([]byte, []int) {
-	return file_app_app_api_app_proto_rawDescGZIP(), []int{92}
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{94}
 }
 
 func (x *AdminChangePasswordRequest) GetSendBody() *AdminChangePasswordRequest_SendBody {
@@ -4538,7 +4658,7 @@ type AdminChangePasswordReply struct {
 func (x *AdminChangePasswordReply) Reset() {
 	*x = AdminChangePasswordReply{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_app_app_api_app_proto_msgTypes[93]
+		mi := &file_app_app_api_app_proto_msgTypes[95]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4551,7 +4671,7 @@ func (x *AdminChangePasswordReply) String() string {
 func (*AdminChangePasswordReply) ProtoMessage() {}
 
 func (x *AdminChangePasswordReply) ProtoReflect() protoreflect.Message {
-	mi := &file_app_app_api_app_proto_msgTypes[93]
+	mi := &file_app_app_api_app_proto_msgTypes[95]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4564,7 +4684,7 @@ func (x *AdminChangePasswordReply) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AdminChangePasswordReply.ProtoReflect.Descriptor instead.
 func (*AdminChangePasswordReply) Descriptor() ([]byte, []int) {
-	return file_app_app_api_app_proto_rawDescGZIP(), []int{93}
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{95}
 }
 
 type AdminCreateAccountRequest struct {
@@ -4578,7 +4698,7 @@ type AdminCreateAccountRequest struct {
 func (x *AdminCreateAccountRequest) Reset() {
 	*x = AdminCreateAccountRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_app_app_api_app_proto_msgTypes[94]
+		mi := &file_app_app_api_app_proto_msgTypes[96]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4591,7 +4711,7 @@ func (x *AdminCreateAccountRequest) String() string {
 func (*AdminCreateAccountRequest) ProtoMessage() {}
 
 func (x *AdminCreateAccountRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_app_app_api_app_proto_msgTypes[94]
+	mi := &file_app_app_api_app_proto_msgTypes[96]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4604,7 +4724,7 @@ func (x *AdminCreateAccountRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AdminCreateAccountRequest.ProtoReflect.Descriptor instead.
 func (*AdminCreateAccountRequest) Descriptor() ([]byte, []int) {
-	return file_app_app_api_app_proto_rawDescGZIP(), []int{94}
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{96}
 }
 
 func (x *AdminCreateAccountRequest) GetSendBody() *AdminCreateAccountRequest_SendBody {
@@ -4623,7 +4743,7 @@ type AdminCreateAccountReply struct {
 func (x *AdminCreateAccountReply) Reset() {
 	*x = AdminCreateAccountReply{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_app_app_api_app_proto_msgTypes[95]
+		mi := &file_app_app_api_app_proto_msgTypes[97]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4636,7 +4756,7 @@ func (x *AdminCreateAccountReply) String() string {
 func (*AdminCreateAccountReply) ProtoMessage() {}
 
 func (x *AdminCreateAccountReply) ProtoReflect() protoreflect.Message {
-	mi := &file_app_app_api_app_proto_msgTypes[95]
+	mi := &file_app_app_api_app_proto_msgTypes[97]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4649,35 +4769,36 @@ func (x *AdminCreateAccountReply) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AdminCreateAccountReply.ProtoReflect.Descriptor instead.
 func (*AdminCreateAccountReply) Descriptor() ([]byte, []int) {
-	return file_app_app_api_app_proto_rawDescGZIP(), []int{95}
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{97}
 }
 
-type EthAuthorizeRequest_SendBody struct {
+type AdminAuditLogListRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
-	Code    string `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`
+	Page      int64  `protobuf:"varint,1,opt,name=page,proto3" json:"page,omitempty"`
+	AdminId   int64  `protobuf:"varint,2,opt,name=admin_id,json=adminId,proto3" json:"admin_id,omitempty"`
+	Operation string `protobuf:"bytes,3,opt,name=operation,proto3" json:"operation,omitempty"`
 }
 
-func (x *EthAuthorizeRequest_SendBody) Reset() {
-	*x = EthAuthorizeRequest_SendBody{}
+func (x *AdminAuditLogListRequest) Reset() {
+	*x = AdminAuditLogListRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_app_app_api_app_proto_msgTypes[96]
+		mi := &file_app_app_api_app_proto_msgTypes[98]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *EthAuthorizeRequest_SendBody) String() string {
+func (x *AdminAuditLogListRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*EthAuthorizeRequest_SendBody) ProtoMessage() {}
+func (*AdminAuditLogListRequest) ProtoMessage() {}
 
-func (x *EthAuthorizeRequest_SendBody) ProtoReflect() protoreflect.Message {
-	mi := &file_app_app_api_app_proto_msgTypes[96]
+func (x *AdminAuditLogListRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_app_app_api_app_proto_msgTypes[98]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4688,53 +4809,58 @@ func (x *EthAuthorizeRequest_SendBody) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use EthAuthorizeRequest_SendBody.ProtoReflect.Descriptor instead.
-func (*EthAuthorizeRequest_SendBody) Descriptor() ([]byte, []int) {
-	return file_app_app_api_app_proto_rawDescGZIP(), []int{0, 0}
+// Deprecated: Use AdminAuditLogListRequest.ProtoReflect.Descriptor instead.
+func (*AdminAuditLogListRequest) Descriptor() ([]byte, []int) {
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{98}
 }
 
-func (x *EthAuthorizeRequest_SendBody) GetAddress() string {
+func (x *AdminAuditLogListRequest) GetPage() int64 {
 	if x != nil {
-		return x.Address
+		return x.Page
 	}
-	return ""
+	return 0
 }
 
-func (x *EthAuthorizeRequest_SendBody) GetCode() string {
+func (x *AdminAuditLogListRequest) GetAdminId() int64 {
 	if x != nil {
-		return x.Code
+		return x.AdminId
+	}
+	return 0
+}
+
+func (x *AdminAuditLogListRequest) GetOperation() string {
+	if x != nil {
+		return x.Operation
 	}
 	return ""
 }
 
-type RewardListReply_List struct {
+type AdminAuditLogListReply struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	CreatedAt      string `protobuf:"bytes,1,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	Amount         string `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount,omitempty"`
-	LocationStatus string `protobuf:"bytes,3,opt,name=locationStatus,proto3" json:"locationStatus,omitempty"`
-	Type           string `protobuf:"bytes,4,opt,name=type,proto3" json:"type,omitempty"`
+	Logs  []*AdminAuditLogListReply_List `protobuf:"bytes,1,rep,name=logs,proto3" json:"logs,omitempty"`
+	Count int64                          `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
 }
 
-func (x *RewardListReply_List) Reset() {
-	*x = RewardListReply_List{}
+func (x *AdminAuditLogListReply) Reset() {
+	*x = AdminAuditLogListReply{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_app_app_api_app_proto_msgTypes[97]
+		mi := &file_app_app_api_app_proto_msgTypes[99]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *RewardListReply_List) String() string {
+func (x *AdminAuditLogListReply) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RewardListReply_List) ProtoMessage() {}
+func (*AdminAuditLogListReply) ProtoMessage() {}
 
-func (x *RewardListReply_List) ProtoReflect() protoreflect.Message {
-	mi := &file_app_app_api_app_proto_msgTypes[97]
+func (x *AdminAuditLogListReply) ProtoReflect() protoreflect.Message {
+	mi := &file_app_app_api_app_proto_msgTypes[99]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4745,67 +4871,51 @@ func (x *RewardListReply_List) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RewardListReply_List.ProtoReflect.Descriptor instead.
-func (*RewardListReply_List) Descriptor() ([]byte, []int) {
-	return file_app_app_api_app_proto_rawDescGZIP(), []int{7, 0}
-}
-
-func (x *RewardListReply_List) GetCreatedAt() string {
-	if x != nil {
-		return x.CreatedAt
-	}
-	return ""
-}
-
-func (x *RewardListReply_List) GetAmount() string {
-	if x != nil {
-		return x.Amount
-	}
-	return ""
+// Deprecated: Use AdminAuditLogListReply.ProtoReflect.Descriptor instead.
+func (*AdminAuditLogListReply) Descriptor() ([]byte, []int) {
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{99}
 }
 
-func (x *RewardListReply_List) GetLocationStatus() string {
+func (x *AdminAuditLogListReply) GetLogs() []*AdminAuditLogListReply_List {
 	if x != nil {
-		return x.LocationStatus
+		return x.Logs
 	}
-	return ""
+	return nil
 }
 
-func (x *RewardListReply_List) GetType() string {
+func (x *AdminAuditLogListReply) GetCount() int64 {
 	if x != nil {
-		return x.Type
+		return x.Count
 	}
-	return ""
+	return 0
 }
 
-type RecommendRewardListReply_List struct {
+type AdminProposalListRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	CreatedAt string `protobuf:"bytes,1,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	Amount    string `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount,omitempty"`
-	Type      string `protobuf:"bytes,4,opt,name=type,proto3" json:"type,omitempty"`
-	Reason    string `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	Page   int64  `protobuf:"varint,1,opt,name=page,proto3" json:"page,omitempty"`
+	Status string `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
 }
 
-func (x *RecommendRewardListReply_List) Reset() {
-	*x = RecommendRewardListReply_List{}
+func (x *AdminProposalListRequest) Reset() {
+	*x = AdminProposalListRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_app_app_api_app_proto_msgTypes[98]
+		mi := &file_app_app_api_app_proto_msgTypes[100]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *RecommendRewardListReply_List) String() string {
+func (x *AdminProposalListRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RecommendRewardListReply_List) ProtoMessage() {}
+func (*AdminProposalListRequest) ProtoMessage() {}
 
-func (x *RecommendRewardListReply_List) ProtoReflect() protoreflect.Message {
-	mi := &file_app_app_api_app_proto_msgTypes[98]
+func (x *AdminProposalListRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_app_app_api_app_proto_msgTypes[100]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4816,65 +4926,51 @@ func (x *RecommendRewardListReply_List) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RecommendRewardListReply_List.ProtoReflect.Descriptor instead.
-func (*RecommendRewardListReply_List) Descriptor() ([]byte, []int) {
-	return file_app_app_api_app_proto_rawDescGZIP(), []int{9, 0}
-}
-
-func (x *RecommendRewardListReply_List) GetCreatedAt() string {
-	if x != nil {
-		return x.CreatedAt
-	}
-	return ""
-}
-
-func (x *RecommendRewardListReply_List) GetAmount() string {
-	if x != nil {
-		return x.Amount
-	}
-	return ""
+// Deprecated: Use AdminProposalListRequest.ProtoReflect.Descriptor instead.
+func (*AdminProposalListRequest) Descriptor() ([]byte, []int) {
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{100}
 }
 
-func (x *RecommendRewardListReply_List) GetType() string {
+func (x *AdminProposalListRequest) GetPage() int64 {
 	if x != nil {
-		return x.Type
+		return x.Page
 	}
-	return ""
+	return 0
 }
 
-func (x *RecommendRewardListReply_List) GetReason() string {
+func (x *AdminProposalListRequest) GetStatus() string {
 	if x != nil {
-		return x.Reason
+		return x.Status
 	}
 	return ""
 }
 
-type FeeRewardListReply_List struct {
+type AdminProposalListReply struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	CreatedAt string `protobuf:"bytes,1,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	Amount    string `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	Proposals []*AdminProposalListReply_List `protobuf:"bytes,1,rep,name=proposals,proto3" json:"proposals,omitempty"`
+	Count     int64                          `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
 }
 
-func (x *FeeRewardListReply_List) Reset() {
-	*x = FeeRewardListReply_List{}
+func (x *AdminProposalListReply) Reset() {
+	*x = AdminProposalListReply{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_app_app_api_app_proto_msgTypes[99]
+		mi := &file_app_app_api_app_proto_msgTypes[101]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *FeeRewardListReply_List) String() string {
+func (x *AdminProposalListReply) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*FeeRewardListReply_List) ProtoMessage() {}
+func (*AdminProposalListReply) ProtoMessage() {}
 
-func (x *FeeRewardListReply_List) ProtoReflect() protoreflect.Message {
-	mi := &file_app_app_api_app_proto_msgTypes[99]
+func (x *AdminProposalListReply) ProtoReflect() protoreflect.Message {
+	mi := &file_app_app_api_app_proto_msgTypes[101]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4885,53 +4981,50 @@ func (x *FeeRewardListReply_List) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use FeeRewardListReply_List.ProtoReflect.Descriptor instead.
-func (*FeeRewardListReply_List) Descriptor() ([]byte, []int) {
-	return file_app_app_api_app_proto_rawDescGZIP(), []int{11, 0}
+// Deprecated: Use AdminProposalListReply.ProtoReflect.Descriptor instead.
+func (*AdminProposalListReply) Descriptor() ([]byte, []int) {
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{101}
 }
 
-func (x *FeeRewardListReply_List) GetCreatedAt() string {
+func (x *AdminProposalListReply) GetProposals() []*AdminProposalListReply_List {
 	if x != nil {
-		return x.CreatedAt
+		return x.Proposals
 	}
-	return ""
+	return nil
 }
 
-func (x *FeeRewardListReply_List) GetAmount() string {
+func (x *AdminProposalListReply) GetCount() int64 {
 	if x != nil {
-		return x.Amount
+		return x.Count
 	}
-	return ""
+	return 0
 }
 
-type WithdrawListReply_List struct {
+type AdminProposalApproveRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	CreatedAt string `protobuf:"bytes,1,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	Amount    string `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount,omitempty"`
-	Type      string `protobuf:"bytes,3,opt,name=type,proto3" json:"type,omitempty"`
-	Status    string `protobuf:"bytes,4,opt,name=status,proto3" json:"status,omitempty"`
+	SendBody *AdminProposalApproveRequest_SendBody `protobuf:"bytes,1,opt,name=send_body,json=sendBody,proto3" json:"send_body,omitempty"`
 }
 
-func (x *WithdrawListReply_List) Reset() {
-	*x = WithdrawListReply_List{}
+func (x *AdminProposalApproveRequest) Reset() {
+	*x = AdminProposalApproveRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_app_app_api_app_proto_msgTypes[100]
+		mi := &file_app_app_api_app_proto_msgTypes[102]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *WithdrawListReply_List) String() string {
+func (x *AdminProposalApproveRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*WithdrawListReply_List) ProtoMessage() {}
+func (*AdminProposalApproveRequest) ProtoMessage() {}
 
-func (x *WithdrawListReply_List) ProtoReflect() protoreflect.Message {
-	mi := &file_app_app_api_app_proto_msgTypes[100]
+func (x *AdminProposalApproveRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_app_app_api_app_proto_msgTypes[102]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4942,65 +5035,44 @@ func (x *WithdrawListReply_List) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use WithdrawListReply_List.ProtoReflect.Descriptor instead.
-func (*WithdrawListReply_List) Descriptor() ([]byte, []int) {
-	return file_app_app_api_app_proto_rawDescGZIP(), []int{13, 0}
-}
-
-func (x *WithdrawListReply_List) GetCreatedAt() string {
-	if x != nil {
-		return x.CreatedAt
-	}
-	return ""
-}
-
-func (x *WithdrawListReply_List) GetAmount() string {
-	if x != nil {
-		return x.Amount
-	}
-	return ""
-}
-
-func (x *WithdrawListReply_List) GetType() string {
-	if x != nil {
-		return x.Type
-	}
-	return ""
+// Deprecated: Use AdminProposalApproveRequest.ProtoReflect.Descriptor instead.
+func (*AdminProposalApproveRequest) Descriptor() ([]byte, []int) {
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{102}
 }
 
-func (x *WithdrawListReply_List) GetStatus() string {
+func (x *AdminProposalApproveRequest) GetSendBody() *AdminProposalApproveRequest_SendBody {
 	if x != nil {
-		return x.Status
+		return x.SendBody
 	}
-	return ""
+	return nil
 }
 
-type RecommendListReply_List struct {
+type AdminProposalApproveReply struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Amount string `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount,omitempty"`
-	Status string `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`
+	Status string `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	Result string `protobuf:"bytes,2,opt,name=result,proto3" json:"result,omitempty"`
 }
 
-func (x *RecommendListReply_List) Reset() {
-	*x = RecommendListReply_List{}
+func (x *AdminProposalApproveReply) Reset() {
+	*x = AdminProposalApproveReply{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_app_app_api_app_proto_msgTypes[101]
+		mi := &file_app_app_api_app_proto_msgTypes[103]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *RecommendListReply_List) String() string {
+func (x *AdminProposalApproveReply) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RecommendListReply_List) ProtoMessage() {}
+func (*AdminProposalApproveReply) ProtoMessage() {}
 
-func (x *RecommendListReply_List) ProtoReflect() protoreflect.Message {
-	mi := &file_app_app_api_app_proto_msgTypes[101]
+func (x *AdminProposalApproveReply) ProtoReflect() protoreflect.Message {
+	mi := &file_app_app_api_app_proto_msgTypes[103]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5011,51 +5083,50 @@ func (x *RecommendListReply_List) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RecommendListReply_List.ProtoReflect.Descriptor instead.
-func (*RecommendListReply_List) Descriptor() ([]byte, []int) {
-	return file_app_app_api_app_proto_rawDescGZIP(), []int{15, 0}
+// Deprecated: Use AdminProposalApproveReply.ProtoReflect.Descriptor instead.
+func (*AdminProposalApproveReply) Descriptor() ([]byte, []int) {
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{103}
 }
 
-func (x *RecommendListReply_List) GetAmount() string {
+func (x *AdminProposalApproveReply) GetStatus() string {
 	if x != nil {
-		return x.Amount
+		return x.Status
 	}
 	return ""
 }
 
-func (x *RecommendListReply_List) GetStatus() string {
+func (x *AdminProposalApproveReply) GetResult() string {
 	if x != nil {
-		return x.Status
+		return x.Result
 	}
 	return ""
 }
 
-type WithdrawRequest_SendBody struct {
+type AdminProposalRejectRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Type   string `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
-	Amount string `protobuf:"bytes,1,opt,name=amount,proto3" json:"amount,omitempty"`
+	SendBody *AdminProposalRejectRequest_SendBody `protobuf:"bytes,1,opt,name=send_body,json=sendBody,proto3" json:"send_body,omitempty"`
 }
 
-func (x *WithdrawRequest_SendBody) Reset() {
-	*x = WithdrawRequest_SendBody{}
+func (x *AdminProposalRejectRequest) Reset() {
+	*x = AdminProposalRejectRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_app_app_api_app_proto_msgTypes[102]
+		mi := &file_app_app_api_app_proto_msgTypes[104]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *WithdrawRequest_SendBody) String() string {
+func (x *AdminProposalRejectRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*WithdrawRequest_SendBody) ProtoMessage() {}
+func (*AdminProposalRejectRequest) ProtoMessage() {}
 
-func (x *WithdrawRequest_SendBody) ProtoReflect() protoreflect.Message {
-	mi := &file_app_app_api_app_proto_msgTypes[102]
+func (x *AdminProposalRejectRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_app_app_api_app_proto_msgTypes[104]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5066,54 +5137,41 @@ func (x *WithdrawRequest_SendBody) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use WithdrawRequest_SendBody.ProtoReflect.Descriptor instead.
-func (*WithdrawRequest_SendBody) Descriptor() ([]byte, []int) {
-	return file_app_app_api_app_proto_rawDescGZIP(), []int{16, 0}
+// Deprecated: Use AdminProposalRejectRequest.ProtoReflect.Descriptor instead.
+func (*AdminProposalRejectRequest) Descriptor() ([]byte, []int) {
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{104}
 }
 
-func (x *WithdrawRequest_SendBody) GetType() string {
+func (x *AdminProposalRejectRequest) GetSendBody() *AdminProposalRejectRequest_SendBody {
 	if x != nil {
-		return x.Type
-	}
-	return ""
-}
-
-func (x *WithdrawRequest_SendBody) GetAmount() string {
-	if x != nil {
-		return x.Amount
+		return x.SendBody
 	}
-	return ""
+	return nil
 }
 
-type AdminRewardListReply_List struct {
+type AdminProposalRejectReply struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	CreatedAt string `protobuf:"bytes,1,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	Amount    string `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount,omitempty"`
-	Type      string `protobuf:"bytes,4,opt,name=type,proto3" json:"type,omitempty"`
-	Address   string `protobuf:"bytes,5,opt,name=address,proto3" json:"address,omitempty"`
-	Reason    string `protobuf:"bytes,6,opt,name=reason,proto3" json:"reason,omitempty"`
 }
 
-func (x *AdminRewardListReply_List) Reset() {
-	*x = AdminRewardListReply_List{}
+func (x *AdminProposalRejectReply) Reset() {
+	*x = AdminProposalRejectReply{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_app_app_api_app_proto_msgTypes[103]
+		mi := &file_app_app_api_app_proto_msgTypes[105]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *AdminRewardListReply_List) String() string {
+func (x *AdminProposalRejectReply) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AdminRewardListReply_List) ProtoMessage() {}
+func (*AdminProposalRejectReply) ProtoMessage() {}
 
-func (x *AdminRewardListReply_List) ProtoReflect() protoreflect.Message {
-	mi := &file_app_app_api_app_proto_msgTypes[103]
+func (x *AdminProposalRejectReply) ProtoReflect() protoreflect.Message {
+	mi := &file_app_app_api_app_proto_msgTypes[105]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5124,74 +5182,92 @@ func (x *AdminRewardListReply_List) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AdminRewardListReply_List.ProtoReflect.Descriptor instead.
-func (*AdminRewardListReply_List) Descriptor() ([]byte, []int) {
-	return file_app_app_api_app_proto_rawDescGZIP(), []int{19, 0}
+// Deprecated: Use AdminProposalRejectReply.ProtoReflect.Descriptor instead.
+func (*AdminProposalRejectReply) Descriptor() ([]byte, []int) {
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{105}
 }
 
-func (x *AdminRewardListReply_List) GetCreatedAt() string {
-	if x != nil {
-		return x.CreatedAt
-	}
-	return ""
+type AdminWithdrawReviewListRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Page   int64  `protobuf:"varint,1,opt,name=page,proto3" json:"page,omitempty"`
+	Status string `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
 }
 
-func (x *AdminRewardListReply_List) GetAmount() string {
-	if x != nil {
-		return x.Amount
+func (x *AdminWithdrawReviewListRequest) Reset() {
+	*x = AdminWithdrawReviewListRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_app_app_api_app_proto_msgTypes[106]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return ""
 }
 
-func (x *AdminRewardListReply_List) GetType() string {
-	if x != nil {
-		return x.Type
+func (x *AdminWithdrawReviewListRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminWithdrawReviewListRequest) ProtoMessage() {}
+
+func (x *AdminWithdrawReviewListRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_app_app_api_app_proto_msgTypes[106]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *AdminRewardListReply_List) GetAddress() string {
+// Deprecated: Use AdminWithdrawReviewListRequest.ProtoReflect.Descriptor instead.
+func (*AdminWithdrawReviewListRequest) Descriptor() ([]byte, []int) {
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{106}
+}
+
+func (x *AdminWithdrawReviewListRequest) GetPage() int64 {
 	if x != nil {
-		return x.Address
+		return x.Page
 	}
-	return ""
+	return 0
 }
 
-func (x *AdminRewardListReply_List) GetReason() string {
+func (x *AdminWithdrawReviewListRequest) GetStatus() string {
 	if x != nil {
-		return x.Reason
+		return x.Status
 	}
 	return ""
 }
 
-type AdminRewardBnbListReply_List struct {
+type AdminWithdrawReviewListReply struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	CreatedAt  string `protobuf:"bytes,1,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	Amount     string `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount,omitempty"`
-	BalanceAll string `protobuf:"bytes,3,opt,name=balanceAll,proto3" json:"balanceAll,omitempty"`
-	Address    string `protobuf:"bytes,5,opt,name=address,proto3" json:"address,omitempty"`
+	Reviews []*AdminWithdrawReviewListReply_List `protobuf:"bytes,1,rep,name=reviews,proto3" json:"reviews,omitempty"`
+	Count   int64                                `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
 }
 
-func (x *AdminRewardBnbListReply_List) Reset() {
-	*x = AdminRewardBnbListReply_List{}
+func (x *AdminWithdrawReviewListReply) Reset() {
+	*x = AdminWithdrawReviewListReply{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_app_app_api_app_proto_msgTypes[104]
+		mi := &file_app_app_api_app_proto_msgTypes[107]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *AdminRewardBnbListReply_List) String() string {
+func (x *AdminWithdrawReviewListReply) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AdminRewardBnbListReply_List) ProtoMessage() {}
+func (*AdminWithdrawReviewListReply) ProtoMessage() {}
 
-func (x *AdminRewardBnbListReply_List) ProtoReflect() protoreflect.Message {
-	mi := &file_app_app_api_app_proto_msgTypes[104]
+func (x *AdminWithdrawReviewListReply) ProtoReflect() protoreflect.Message {
+	mi := &file_app_app_api_app_proto_msgTypes[107]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5202,75 +5278,50 @@ func (x *AdminRewardBnbListReply_List) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AdminRewardBnbListReply_List.ProtoReflect.Descriptor instead.
-func (*AdminRewardBnbListReply_List) Descriptor() ([]byte, []int) {
-	return file_app_app_api_app_proto_rawDescGZIP(), []int{21, 0}
-}
-
-func (x *AdminRewardBnbListReply_List) GetCreatedAt() string {
-	if x != nil {
-		return x.CreatedAt
-	}
-	return ""
-}
-
-func (x *AdminRewardBnbListReply_List) GetAmount() string {
-	if x != nil {
-		return x.Amount
-	}
-	return ""
+// Deprecated: Use AdminWithdrawReviewListReply.ProtoReflect.Descriptor instead.
+func (*AdminWithdrawReviewListReply) Descriptor() ([]byte, []int) {
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{107}
 }
 
-func (x *AdminRewardBnbListReply_List) GetBalanceAll() string {
+func (x *AdminWithdrawReviewListReply) GetReviews() []*AdminWithdrawReviewListReply_List {
 	if x != nil {
-		return x.BalanceAll
+		return x.Reviews
 	}
-	return ""
+	return nil
 }
 
-func (x *AdminRewardBnbListReply_List) GetAddress() string {
+func (x *AdminWithdrawReviewListReply) GetCount() int64 {
 	if x != nil {
-		return x.Address
+		return x.Count
 	}
-	return ""
+	return 0
 }
 
-type AdminUserListReply_UserList struct {
+type AdminWithdrawReviewApproveRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	UserId           int64  `protobuf:"varint,8,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	CreatedAt        string `protobuf:"bytes,1,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	Address          string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
-	BalanceUsdt      string `protobuf:"bytes,3,opt,name=balanceUsdt,proto3" json:"balanceUsdt,omitempty"`
-	BalanceDhb       string `protobuf:"bytes,4,opt,name=balanceDhb,proto3" json:"balanceDhb,omitempty"`
-	Vip              int64  `protobuf:"varint,5,opt,name=vip,proto3" json:"vip,omitempty"`
-	MonthRecommend   int64  `protobuf:"varint,7,opt,name=monthRecommend,proto3" json:"monthRecommend,omitempty"`
-	HistoryRecommend int64  `protobuf:"varint,6,opt,name=historyRecommend,proto3" json:"historyRecommend,omitempty"`
-	AreaAmount       int64  `protobuf:"varint,9,opt,name=areaAmount,proto3" json:"areaAmount,omitempty"`
-	AreaMaxAmount    int64  `protobuf:"varint,10,opt,name=areaMaxAmount,proto3" json:"areaMaxAmount,omitempty"`
-	BnbAmount        string `protobuf:"bytes,11,opt,name=bnbAmount,proto3" json:"bnbAmount,omitempty"`
-	BnbBalance       string `protobuf:"bytes,12,opt,name=bnbBalance,proto3" json:"bnbBalance,omitempty"`
+	SendBody *AdminWithdrawReviewApproveRequest_SendBody `protobuf:"bytes,1,opt,name=send_body,json=sendBody,proto3" json:"send_body,omitempty"`
 }
 
-func (x *AdminUserListReply_UserList) Reset() {
-	*x = AdminUserListReply_UserList{}
+func (x *AdminWithdrawReviewApproveRequest) Reset() {
+	*x = AdminWithdrawReviewApproveRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_app_app_api_app_proto_msgTypes[105]
+		mi := &file_app_app_api_app_proto_msgTypes[108]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *AdminUserListReply_UserList) String() string {
+func (x *AdminWithdrawReviewApproveRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AdminUserListReply_UserList) ProtoMessage() {}
+func (*AdminWithdrawReviewApproveRequest) ProtoMessage() {}
 
-func (x *AdminUserListReply_UserList) ProtoReflect() protoreflect.Message {
-	mi := &file_app_app_api_app_proto_msgTypes[105]
+func (x *AdminWithdrawReviewApproveRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_app_app_api_app_proto_msgTypes[108]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5281,127 +5332,126 @@ func (x *AdminUserListReply_UserList) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AdminUserListReply_UserList.ProtoReflect.Descriptor instead.
-func (*AdminUserListReply_UserList) Descriptor() ([]byte, []int) {
-	return file_app_app_api_app_proto_rawDescGZIP(), []int{29, 0}
+// Deprecated: Use AdminWithdrawReviewApproveRequest.ProtoReflect.Descriptor instead.
+func (*AdminWithdrawReviewApproveRequest) Descriptor() ([]byte, []int) {
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{108}
 }
 
-func (x *AdminUserListReply_UserList) GetUserId() int64 {
+func (x *AdminWithdrawReviewApproveRequest) GetSendBody() *AdminWithdrawReviewApproveRequest_SendBody {
 	if x != nil {
-		return x.UserId
+		return x.SendBody
 	}
-	return 0
+	return nil
 }
 
-func (x *AdminUserListReply_UserList) GetCreatedAt() string {
-	if x != nil {
-		return x.CreatedAt
-	}
-	return ""
+type AdminWithdrawReviewApproveReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 }
 
-func (x *AdminUserListReply_UserList) GetAddress() string {
-	if x != nil {
-		return x.Address
+func (x *AdminWithdrawReviewApproveReply) Reset() {
+	*x = AdminWithdrawReviewApproveReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_app_app_api_app_proto_msgTypes[109]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return ""
 }
 
-func (x *AdminUserListReply_UserList) GetBalanceUsdt() string {
-	if x != nil {
-		return x.BalanceUsdt
-	}
-	return ""
+func (x *AdminWithdrawReviewApproveReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *AdminUserListReply_UserList) GetBalanceDhb() string {
-	if x != nil {
-		return x.BalanceDhb
+func (*AdminWithdrawReviewApproveReply) ProtoMessage() {}
+
+func (x *AdminWithdrawReviewApproveReply) ProtoReflect() protoreflect.Message {
+	mi := &file_app_app_api_app_proto_msgTypes[109]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *AdminUserListReply_UserList) GetVip() int64 {
-	if x != nil {
-		return x.Vip
-	}
-	return 0
+// Deprecated: Use AdminWithdrawReviewApproveReply.ProtoReflect.Descriptor instead.
+func (*AdminWithdrawReviewApproveReply) Descriptor() ([]byte, []int) {
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{109}
 }
 
-func (x *AdminUserListReply_UserList) GetMonthRecommend() int64 {
-	if x != nil {
-		return x.MonthRecommend
-	}
-	return 0
+type AdminWithdrawReviewRejectRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SendBody *AdminWithdrawReviewRejectRequest_SendBody `protobuf:"bytes,1,opt,name=send_body,json=sendBody,proto3" json:"send_body,omitempty"`
 }
 
-func (x *AdminUserListReply_UserList) GetHistoryRecommend() int64 {
-	if x != nil {
-		return x.HistoryRecommend
+func (x *AdminWithdrawReviewRejectRequest) Reset() {
+	*x = AdminWithdrawReviewRejectRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_app_app_api_app_proto_msgTypes[110]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return 0
 }
 
-func (x *AdminUserListReply_UserList) GetAreaAmount() int64 {
-	if x != nil {
-		return x.AreaAmount
-	}
-	return 0
+func (x *AdminWithdrawReviewRejectRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *AdminUserListReply_UserList) GetAreaMaxAmount() int64 {
-	if x != nil {
-		return x.AreaMaxAmount
+func (*AdminWithdrawReviewRejectRequest) ProtoMessage() {}
+
+func (x *AdminWithdrawReviewRejectRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_app_app_api_app_proto_msgTypes[110]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *AdminUserListReply_UserList) GetBnbAmount() string {
-	if x != nil {
-		return x.BnbAmount
-	}
-	return ""
+// Deprecated: Use AdminWithdrawReviewRejectRequest.ProtoReflect.Descriptor instead.
+func (*AdminWithdrawReviewRejectRequest) Descriptor() ([]byte, []int) {
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{110}
 }
 
-func (x *AdminUserListReply_UserList) GetBnbBalance() string {
+func (x *AdminWithdrawReviewRejectRequest) GetSendBody() *AdminWithdrawReviewRejectRequest_SendBody {
 	if x != nil {
-		return x.BnbBalance
+		return x.SendBody
 	}
-	return ""
+	return nil
 }
 
-type AdminLocationListReply_LocationList struct {
+type AdminWithdrawReviewRejectReply struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	CreatedAt    string `protobuf:"bytes,1,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	Address      string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
-	Row          int64  `protobuf:"varint,3,opt,name=row,proto3" json:"row,omitempty"`
-	Col          int64  `protobuf:"varint,4,opt,name=col,proto3" json:"col,omitempty"`
-	Status       string `protobuf:"bytes,5,opt,name=status,proto3" json:"status,omitempty"`
-	CurrentLevel int64  `protobuf:"varint,6,opt,name=currentLevel,proto3" json:"currentLevel,omitempty"`
-	Current      string `protobuf:"bytes,7,opt,name=current,proto3" json:"current,omitempty"`
-	CurrentMax   string `protobuf:"bytes,8,opt,name=currentMax,proto3" json:"currentMax,omitempty"`
 }
 
-func (x *AdminLocationListReply_LocationList) Reset() {
-	*x = AdminLocationListReply_LocationList{}
+func (x *AdminWithdrawReviewRejectReply) Reset() {
+	*x = AdminWithdrawReviewRejectReply{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_app_app_api_app_proto_msgTypes[106]
+		mi := &file_app_app_api_app_proto_msgTypes[111]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *AdminLocationListReply_LocationList) String() string {
+func (x *AdminWithdrawReviewRejectReply) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AdminLocationListReply_LocationList) ProtoMessage() {}
+func (*AdminWithdrawReviewRejectReply) ProtoMessage() {}
 
-func (x *AdminLocationListReply_LocationList) ProtoReflect() protoreflect.Message {
-	mi := &file_app_app_api_app_proto_msgTypes[106]
+func (x *AdminWithdrawReviewRejectReply) ProtoReflect() protoreflect.Message {
+	mi := &file_app_app_api_app_proto_msgTypes[111]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5412,99 +5462,81 @@ func (x *AdminLocationListReply_LocationList) ProtoReflect() protoreflect.Messag
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AdminLocationListReply_LocationList.ProtoReflect.Descriptor instead.
-func (*AdminLocationListReply_LocationList) Descriptor() ([]byte, []int) {
-	return file_app_app_api_app_proto_rawDescGZIP(), []int{31, 0}
-}
-
-func (x *AdminLocationListReply_LocationList) GetCreatedAt() string {
-	if x != nil {
-		return x.CreatedAt
-	}
-	return ""
+// Deprecated: Use AdminWithdrawReviewRejectReply.ProtoReflect.Descriptor instead.
+func (*AdminWithdrawReviewRejectReply) Descriptor() ([]byte, []int) {
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{111}
 }
 
-func (x *AdminLocationListReply_LocationList) GetAddress() string {
-	if x != nil {
-		return x.Address
-	}
-	return ""
-}
+type AdminWithdrawRejectRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 
-func (x *AdminLocationListReply_LocationList) GetRow() int64 {
-	if x != nil {
-		return x.Row
-	}
-	return 0
+	SendBody *AdminWithdrawRejectRequest_SendBody `protobuf:"bytes,1,opt,name=send_body,json=sendBody,proto3" json:"send_body,omitempty"`
 }
 
-func (x *AdminLocationListReply_LocationList) GetCol() int64 {
-	if x != nil {
-		return x.Col
+func (x *AdminWithdrawRejectRequest) Reset() {
+	*x = AdminWithdrawRejectRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_app_app_api_app_proto_msgTypes[112]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return 0
 }
 
-func (x *AdminLocationListReply_LocationList) GetStatus() string {
-	if x != nil {
-		return x.Status
-	}
-	return ""
+func (x *AdminWithdrawRejectRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *AdminLocationListReply_LocationList) GetCurrentLevel() int64 {
-	if x != nil {
-		return x.CurrentLevel
+func (*AdminWithdrawRejectRequest) ProtoMessage() {}
+
+func (x *AdminWithdrawRejectRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_app_app_api_app_proto_msgTypes[112]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *AdminLocationListReply_LocationList) GetCurrent() string {
-	if x != nil {
-		return x.Current
-	}
-	return ""
+// Deprecated: Use AdminWithdrawRejectRequest.ProtoReflect.Descriptor instead.
+func (*AdminWithdrawRejectRequest) Descriptor() ([]byte, []int) {
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{112}
 }
 
-func (x *AdminLocationListReply_LocationList) GetCurrentMax() string {
+func (x *AdminWithdrawRejectRequest) GetSendBody() *AdminWithdrawRejectRequest_SendBody {
 	if x != nil {
-		return x.CurrentMax
+		return x.SendBody
 	}
-	return ""
+	return nil
 }
 
-type AdminLocationAllListReply_LocationList struct {
+type AdminWithdrawRejectReply struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	CreatedAt    string `protobuf:"bytes,1,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	Address      string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
-	Row          int64  `protobuf:"varint,3,opt,name=row,proto3" json:"row,omitempty"`
-	Col          int64  `protobuf:"varint,4,opt,name=col,proto3" json:"col,omitempty"`
-	Status       string `protobuf:"bytes,5,opt,name=status,proto3" json:"status,omitempty"`
-	CurrentLevel int64  `protobuf:"varint,6,opt,name=currentLevel,proto3" json:"currentLevel,omitempty"`
-	Current      string `protobuf:"bytes,7,opt,name=current,proto3" json:"current,omitempty"`
-	CurrentMax   string `protobuf:"bytes,8,opt,name=currentMax,proto3" json:"currentMax,omitempty"`
 }
 
-func (x *AdminLocationAllListReply_LocationList) Reset() {
-	*x = AdminLocationAllListReply_LocationList{}
+func (x *AdminWithdrawRejectReply) Reset() {
+	*x = AdminWithdrawRejectReply{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_app_app_api_app_proto_msgTypes[107]
+		mi := &file_app_app_api_app_proto_msgTypes[113]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *AdminLocationAllListReply_LocationList) String() string {
+func (x *AdminWithdrawRejectReply) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AdminLocationAllListReply_LocationList) ProtoMessage() {}
+func (*AdminWithdrawRejectReply) ProtoMessage() {}
 
-func (x *AdminLocationAllListReply_LocationList) ProtoReflect() protoreflect.Message {
-	mi := &file_app_app_api_app_proto_msgTypes[107]
+func (x *AdminWithdrawRejectReply) ProtoReflect() protoreflect.Message {
+	mi := &file_app_app_api_app_proto_msgTypes[113]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5515,98 +5547,83 @@ func (x *AdminLocationAllListReply_LocationList) ProtoReflect() protoreflect.Mes
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AdminLocationAllListReply_LocationList.ProtoReflect.Descriptor instead.
-func (*AdminLocationAllListReply_LocationList) Descriptor() ([]byte, []int) {
-	return file_app_app_api_app_proto_rawDescGZIP(), []int{33, 0}
+// Deprecated: Use AdminWithdrawRejectReply.ProtoReflect.Descriptor instead.
+func (*AdminWithdrawRejectReply) Descriptor() ([]byte, []int) {
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{113}
 }
 
-func (x *AdminLocationAllListReply_LocationList) GetCreatedAt() string {
-	if x != nil {
-		return x.CreatedAt
-	}
-	return ""
-}
+type AdminWithdrawEventListRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 
-func (x *AdminLocationAllListReply_LocationList) GetAddress() string {
-	if x != nil {
-		return x.Address
-	}
-	return ""
+	WithdrawId int64 `protobuf:"varint,1,opt,name=withdraw_id,json=withdrawId,proto3" json:"withdraw_id,omitempty"`
 }
 
-func (x *AdminLocationAllListReply_LocationList) GetRow() int64 {
-	if x != nil {
-		return x.Row
+func (x *AdminWithdrawEventListRequest) Reset() {
+	*x = AdminWithdrawEventListRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_app_app_api_app_proto_msgTypes[114]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return 0
 }
 
-func (x *AdminLocationAllListReply_LocationList) GetCol() int64 {
-	if x != nil {
-		return x.Col
-	}
-	return 0
+func (x *AdminWithdrawEventListRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *AdminLocationAllListReply_LocationList) GetStatus() string {
-	if x != nil {
-		return x.Status
-	}
-	return ""
-}
+func (*AdminWithdrawEventListRequest) ProtoMessage() {}
 
-func (x *AdminLocationAllListReply_LocationList) GetCurrentLevel() int64 {
-	if x != nil {
-		return x.CurrentLevel
+func (x *AdminWithdrawEventListRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_app_app_api_app_proto_msgTypes[114]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *AdminLocationAllListReply_LocationList) GetCurrent() string {
-	if x != nil {
-		return x.Current
-	}
-	return ""
+// Deprecated: Use AdminWithdrawEventListRequest.ProtoReflect.Descriptor instead.
+func (*AdminWithdrawEventListRequest) Descriptor() ([]byte, []int) {
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{114}
 }
 
-func (x *AdminLocationAllListReply_LocationList) GetCurrentMax() string {
+func (x *AdminWithdrawEventListRequest) GetWithdrawId() int64 {
 	if x != nil {
-		return x.CurrentMax
+		return x.WithdrawId
 	}
-	return ""
+	return 0
 }
 
-type AdminWithdrawListReply_List struct {
+type AdminWithdrawEventListReply struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Address   string `protobuf:"bytes,5,opt,name=address,proto3" json:"address,omitempty"`
-	Id        int64  `protobuf:"varint,7,opt,name=id,proto3" json:"id,omitempty"`
-	CreatedAt string `protobuf:"bytes,1,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	Amount    string `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount,omitempty"`
-	RelAmount string `protobuf:"bytes,6,opt,name=relAmount,proto3" json:"relAmount,omitempty"`
-	Type      string `protobuf:"bytes,3,opt,name=type,proto3" json:"type,omitempty"`
-	Status    string `protobuf:"bytes,4,opt,name=status,proto3" json:"status,omitempty"`
+	Events []*AdminWithdrawEventListReply_List `protobuf:"bytes,1,rep,name=events,proto3" json:"events,omitempty"`
 }
 
-func (x *AdminWithdrawListReply_List) Reset() {
-	*x = AdminWithdrawListReply_List{}
+func (x *AdminWithdrawEventListReply) Reset() {
+	*x = AdminWithdrawEventListReply{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_app_app_api_app_proto_msgTypes[108]
+		mi := &file_app_app_api_app_proto_msgTypes[115]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *AdminWithdrawListReply_List) String() string {
+func (x *AdminWithdrawEventListReply) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AdminWithdrawListReply_List) ProtoMessage() {}
+func (*AdminWithdrawEventListReply) ProtoMessage() {}
 
-func (x *AdminWithdrawListReply_List) ProtoReflect() protoreflect.Message {
-	mi := &file_app_app_api_app_proto_msgTypes[108]
+func (x *AdminWithdrawEventListReply) ProtoReflect() protoreflect.Message {
+	mi := &file_app_app_api_app_proto_msgTypes[115]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5617,88 +5634,82 @@ func (x *AdminWithdrawListReply_List) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AdminWithdrawListReply_List.ProtoReflect.Descriptor instead.
-func (*AdminWithdrawListReply_List) Descriptor() ([]byte, []int) {
-	return file_app_app_api_app_proto_rawDescGZIP(), []int{35, 0}
+// Deprecated: Use AdminWithdrawEventListReply.ProtoReflect.Descriptor instead.
+func (*AdminWithdrawEventListReply) Descriptor() ([]byte, []int) {
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{115}
 }
 
-func (x *AdminWithdrawListReply_List) GetAddress() string {
+func (x *AdminWithdrawEventListReply) GetEvents() []*AdminWithdrawEventListReply_List {
 	if x != nil {
-		return x.Address
+		return x.Events
 	}
-	return ""
+	return nil
 }
 
-func (x *AdminWithdrawListReply_List) GetId() int64 {
-	if x != nil {
-		return x.Id
-	}
-	return 0
+type AdminLedgerVerifyRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 }
 
-func (x *AdminWithdrawListReply_List) GetCreatedAt() string {
-	if x != nil {
-		return x.CreatedAt
+func (x *AdminLedgerVerifyRequest) Reset() {
+	*x = AdminLedgerVerifyRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_app_app_api_app_proto_msgTypes[116]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return ""
 }
 
-func (x *AdminWithdrawListReply_List) GetAmount() string {
-	if x != nil {
-		return x.Amount
-	}
-	return ""
+func (x *AdminLedgerVerifyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *AdminWithdrawListReply_List) GetRelAmount() string {
-	if x != nil {
-		return x.RelAmount
-	}
-	return ""
-}
+func (*AdminLedgerVerifyRequest) ProtoMessage() {}
 
-func (x *AdminWithdrawListReply_List) GetType() string {
-	if x != nil {
-		return x.Type
+func (x *AdminLedgerVerifyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_app_app_api_app_proto_msgTypes[116]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *AdminWithdrawListReply_List) GetStatus() string {
-	if x != nil {
-		return x.Status
-	}
-	return ""
+// Deprecated: Use AdminLedgerVerifyRequest.ProtoReflect.Descriptor instead.
+func (*AdminLedgerVerifyRequest) Descriptor() ([]byte, []int) {
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{116}
 }
 
-type AdminUserRecommendReply_List struct {
+type AdminLedgerVerifyReply struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	UserId    int64  `protobuf:"varint,4,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	Address   string `protobuf:"bytes,3,opt,name=address,proto3" json:"address,omitempty"`
-	Id        int64  `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
-	CreatedAt string `protobuf:"bytes,1,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	Mismatches []*AdminLedgerVerifyReply_List `protobuf:"bytes,1,rep,name=mismatches,proto3" json:"mismatches,omitempty"`
+	Count      int64                          `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
 }
 
-func (x *AdminUserRecommendReply_List) Reset() {
-	*x = AdminUserRecommendReply_List{}
+func (x *AdminLedgerVerifyReply) Reset() {
+	*x = AdminLedgerVerifyReply{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_app_app_api_app_proto_msgTypes[109]
+		mi := &file_app_app_api_app_proto_msgTypes[117]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *AdminUserRecommendReply_List) String() string {
+func (x *AdminLedgerVerifyReply) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AdminUserRecommendReply_List) ProtoMessage() {}
+func (*AdminLedgerVerifyReply) ProtoMessage() {}
 
-func (x *AdminUserRecommendReply_List) ProtoReflect() protoreflect.Message {
-	mi := &file_app_app_api_app_proto_msgTypes[109]
+func (x *AdminLedgerVerifyReply) ProtoReflect() protoreflect.Message {
+	mi := &file_app_app_api_app_proto_msgTypes[117]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5709,67 +5720,50 @@ func (x *AdminUserRecommendReply_List) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AdminUserRecommendReply_List.ProtoReflect.Descriptor instead.
-func (*AdminUserRecommendReply_List) Descriptor() ([]byte, []int) {
-	return file_app_app_api_app_proto_rawDescGZIP(), []int{49, 0}
-}
-
-func (x *AdminUserRecommendReply_List) GetUserId() int64 {
-	if x != nil {
-		return x.UserId
-	}
-	return 0
+// Deprecated: Use AdminLedgerVerifyReply.ProtoReflect.Descriptor instead.
+func (*AdminLedgerVerifyReply) Descriptor() ([]byte, []int) {
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{117}
 }
 
-func (x *AdminUserRecommendReply_List) GetAddress() string {
+func (x *AdminLedgerVerifyReply) GetMismatches() []*AdminLedgerVerifyReply_List {
 	if x != nil {
-		return x.Address
+		return x.Mismatches
 	}
-	return ""
+	return nil
 }
 
-func (x *AdminUserRecommendReply_List) GetId() int64 {
+func (x *AdminLedgerVerifyReply) GetCount() int64 {
 	if x != nil {
-		return x.Id
+		return x.Count
 	}
 	return 0
 }
 
-func (x *AdminUserRecommendReply_List) GetCreatedAt() string {
-	if x != nil {
-		return x.CreatedAt
-	}
-	return ""
-}
-
-type AdminMonthRecommendReply_List struct {
+type AdminLedgerOpenRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Address          string `protobuf:"bytes,3,opt,name=address,proto3" json:"address,omitempty"`
-	RecommendAddress string `protobuf:"bytes,4,opt,name=recommendAddress,proto3" json:"recommendAddress,omitempty"`
-	Id               int64  `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
-	CreatedAt        string `protobuf:"bytes,1,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	SendBody *AdminLedgerOpenRequest_SendBody `protobuf:"bytes,1,opt,name=send_body,json=sendBody,proto3" json:"send_body,omitempty"`
 }
 
-func (x *AdminMonthRecommendReply_List) Reset() {
-	*x = AdminMonthRecommendReply_List{}
+func (x *AdminLedgerOpenRequest) Reset() {
+	*x = AdminLedgerOpenRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_app_app_api_app_proto_msgTypes[110]
+		mi := &file_app_app_api_app_proto_msgTypes[118]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *AdminMonthRecommendReply_List) String() string {
+func (x *AdminLedgerOpenRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AdminMonthRecommendReply_List) ProtoMessage() {}
+func (*AdminLedgerOpenRequest) ProtoMessage() {}
 
-func (x *AdminMonthRecommendReply_List) ProtoReflect() protoreflect.Message {
-	mi := &file_app_app_api_app_proto_msgTypes[110]
+func (x *AdminLedgerOpenRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_app_app_api_app_proto_msgTypes[118]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5780,66 +5774,43 @@ func (x *AdminMonthRecommendReply_List) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AdminMonthRecommendReply_List.ProtoReflect.Descriptor instead.
-func (*AdminMonthRecommendReply_List) Descriptor() ([]byte, []int) {
-	return file_app_app_api_app_proto_rawDescGZIP(), []int{51, 0}
+// Deprecated: Use AdminLedgerOpenRequest.ProtoReflect.Descriptor instead.
+func (*AdminLedgerOpenRequest) Descriptor() ([]byte, []int) {
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{118}
 }
 
-func (x *AdminMonthRecommendReply_List) GetAddress() string {
+func (x *AdminLedgerOpenRequest) GetSendBody() *AdminLedgerOpenRequest_SendBody {
 	if x != nil {
-		return x.Address
+		return x.SendBody
 	}
-	return ""
+	return nil
 }
 
-func (x *AdminMonthRecommendReply_List) GetRecommendAddress() string {
-	if x != nil {
-		return x.RecommendAddress
-	}
-	return ""
-}
-
-func (x *AdminMonthRecommendReply_List) GetId() int64 {
-	if x != nil {
-		return x.Id
-	}
-	return 0
-}
-
-func (x *AdminMonthRecommendReply_List) GetCreatedAt() string {
-	if x != nil {
-		return x.CreatedAt
-	}
-	return ""
-}
-
-type AdminConfigReply_List struct {
+type AdminLedgerOpenReply struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id    int64  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name  string `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
-	Value string `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	Count int64 `protobuf:"varint,1,opt,name=count,proto3" json:"count,omitempty"`
 }
 
-func (x *AdminConfigReply_List) Reset() {
-	*x = AdminConfigReply_List{}
+func (x *AdminLedgerOpenReply) Reset() {
+	*x = AdminLedgerOpenReply{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_app_app_api_app_proto_msgTypes[111]
+		mi := &file_app_app_api_app_proto_msgTypes[119]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *AdminConfigReply_List) String() string {
+func (x *AdminLedgerOpenReply) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AdminConfigReply_List) ProtoMessage() {}
+func (*AdminLedgerOpenReply) ProtoMessage() {}
 
-func (x *AdminConfigReply_List) ProtoReflect() protoreflect.Message {
-	mi := &file_app_app_api_app_proto_msgTypes[111]
+func (x *AdminLedgerOpenReply) ProtoReflect() protoreflect.Message {
+	mi := &file_app_app_api_app_proto_msgTypes[119]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5850,58 +5821,41 @@ func (x *AdminConfigReply_List) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AdminConfigReply_List.ProtoReflect.Descriptor instead.
-func (*AdminConfigReply_List) Descriptor() ([]byte, []int) {
-	return file_app_app_api_app_proto_rawDescGZIP(), []int{53, 0}
+// Deprecated: Use AdminLedgerOpenReply.ProtoReflect.Descriptor instead.
+func (*AdminLedgerOpenReply) Descriptor() ([]byte, []int) {
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{119}
 }
 
-func (x *AdminConfigReply_List) GetId() int64 {
+func (x *AdminLedgerOpenReply) GetCount() int64 {
 	if x != nil {
-		return x.Id
+		return x.Count
 	}
 	return 0
 }
 
-func (x *AdminConfigReply_List) GetName() string {
-	if x != nil {
-		return x.Name
-	}
-	return ""
-}
-
-func (x *AdminConfigReply_List) GetValue() string {
-	if x != nil {
-		return x.Value
-	}
-	return ""
-}
-
-type AdminListReply_List struct {
+type AdminReconcileRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	Id      int64  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Account string `protobuf:"bytes,2,opt,name=account,proto3" json:"account,omitempty"`
 }
 
-func (x *AdminListReply_List) Reset() {
-	*x = AdminListReply_List{}
+func (x *AdminReconcileRequest) Reset() {
+	*x = AdminReconcileRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_app_app_api_app_proto_msgTypes[112]
+		mi := &file_app_app_api_app_proto_msgTypes[120]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *AdminListReply_List) String() string {
+func (x *AdminReconcileRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AdminListReply_List) ProtoMessage() {}
+func (*AdminReconcileRequest) ProtoMessage() {}
 
-func (x *AdminListReply_List) ProtoReflect() protoreflect.Message {
-	mi := &file_app_app_api_app_proto_msgTypes[112]
+func (x *AdminReconcileRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_app_app_api_app_proto_msgTypes[120]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5912,52 +5866,37 @@ func (x *AdminListReply_List) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AdminListReply_List.ProtoReflect.Descriptor instead.
-func (*AdminListReply_List) Descriptor() ([]byte, []int) {
-	return file_app_app_api_app_proto_rawDescGZIP(), []int{55, 0}
-}
-
-func (x *AdminListReply_List) GetId() int64 {
-	if x != nil {
-		return x.Id
-	}
-	return 0
-}
-
-func (x *AdminListReply_List) GetAccount() string {
-	if x != nil {
-		return x.Account
-	}
-	return ""
+// Deprecated: Use AdminReconcileRequest.ProtoReflect.Descriptor instead.
+func (*AdminReconcileRequest) Descriptor() ([]byte, []int) {
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{120}
 }
 
-type AuthListReply_List struct {
+type AdminReconcileReply struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id   int64  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Path string `protobuf:"bytes,3,opt,name=path,proto3" json:"path,omitempty"`
+	Batch string `protobuf:"bytes,1,opt,name=batch,proto3" json:"batch,omitempty"`
+	Count int64  `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
 }
 
-func (x *AuthListReply_List) Reset() {
-	*x = AuthListReply_List{}
+func (x *AdminReconcileReply) Reset() {
+	*x = AdminReconcileReply{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_app_app_api_app_proto_msgTypes[113]
+		mi := &file_app_app_api_app_proto_msgTypes[121]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *AuthListReply_List) String() string {
+func (x *AdminReconcileReply) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AuthListReply_List) ProtoMessage() {}
+func (*AdminReconcileReply) ProtoMessage() {}
 
-func (x *AuthListReply_List) ProtoReflect() protoreflect.Message {
-	mi := &file_app_app_api_app_proto_msgTypes[113]
+func (x *AdminReconcileReply) ProtoReflect() protoreflect.Message {
+	mi := &file_app_app_api_app_proto_msgTypes[121]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5968,59 +5907,54 @@ func (x *AuthListReply_List) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AuthListReply_List.ProtoReflect.Descriptor instead.
-func (*AuthListReply_List) Descriptor() ([]byte, []int) {
-	return file_app_app_api_app_proto_rawDescGZIP(), []int{57, 0}
-}
-
-func (x *AuthListReply_List) GetId() int64 {
-	if x != nil {
-		return x.Id
-	}
-	return 0
+// Deprecated: Use AdminReconcileReply.ProtoReflect.Descriptor instead.
+func (*AdminReconcileReply) Descriptor() ([]byte, []int) {
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{121}
 }
 
-func (x *AuthListReply_List) GetName() string {
+func (x *AdminReconcileReply) GetBatch() string {
 	if x != nil {
-		return x.Name
+		return x.Batch
 	}
 	return ""
 }
 
-func (x *AuthListReply_List) GetPath() string {
+func (x *AdminReconcileReply) GetCount() int64 {
 	if x != nil {
-		return x.Path
+		return x.Count
 	}
-	return ""
+	return 0
 }
 
-type UserAuthListReply_List struct {
+type AdminDiscrepancyListRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id   int64  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Path string `protobuf:"bytes,3,opt,name=path,proto3" json:"path,omitempty"`
+	Page     int64  `protobuf:"varint,1,opt,name=page,proto3" json:"page,omitempty"`
+	Batch    string `protobuf:"bytes,2,opt,name=batch,proto3" json:"batch,omitempty"`
+	Check    string `protobuf:"bytes,3,opt,name=check,proto3" json:"check,omitempty"`
+	Severity string `protobuf:"bytes,4,opt,name=severity,proto3" json:"severity,omitempty"`
+	Export   bool   `protobuf:"varint,5,opt,name=export,proto3" json:"export,omitempty"`
 }
 
-func (x *UserAuthListReply_List) Reset() {
-	*x = UserAuthListReply_List{}
+func (x *AdminDiscrepancyListRequest) Reset() {
+	*x = AdminDiscrepancyListRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_app_app_api_app_proto_msgTypes[114]
+		mi := &file_app_app_api_app_proto_msgTypes[122]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *UserAuthListReply_List) String() string {
+func (x *AdminDiscrepancyListRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UserAuthListReply_List) ProtoMessage() {}
+func (*AdminDiscrepancyListRequest) ProtoMessage() {}
 
-func (x *UserAuthListReply_List) ProtoReflect() protoreflect.Message {
-	mi := &file_app_app_api_app_proto_msgTypes[114]
+func (x *AdminDiscrepancyListRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_app_app_api_app_proto_msgTypes[122]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6031,59 +5965,74 @@ func (x *UserAuthListReply_List) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UserAuthListReply_List.ProtoReflect.Descriptor instead.
-func (*UserAuthListReply_List) Descriptor() ([]byte, []int) {
-	return file_app_app_api_app_proto_rawDescGZIP(), []int{59, 0}
+// Deprecated: Use AdminDiscrepancyListRequest.ProtoReflect.Descriptor instead.
+func (*AdminDiscrepancyListRequest) Descriptor() ([]byte, []int) {
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{122}
 }
 
-func (x *UserAuthListReply_List) GetId() int64 {
+func (x *AdminDiscrepancyListRequest) GetPage() int64 {
 	if x != nil {
-		return x.Id
+		return x.Page
 	}
 	return 0
 }
 
-func (x *UserAuthListReply_List) GetName() string {
+func (x *AdminDiscrepancyListRequest) GetBatch() string {
 	if x != nil {
-		return x.Name
+		return x.Batch
 	}
 	return ""
 }
 
-func (x *UserAuthListReply_List) GetPath() string {
+func (x *AdminDiscrepancyListRequest) GetCheck() string {
 	if x != nil {
-		return x.Path
+		return x.Check
 	}
 	return ""
 }
 
-type MyAuthListReply_List struct {
+func (x *AdminDiscrepancyListRequest) GetSeverity() string {
+	if x != nil {
+		return x.Severity
+	}
+	return ""
+}
+
+func (x *AdminDiscrepancyListRequest) GetExport() bool {
+	if x != nil {
+		return x.Export
+	}
+	return false
+}
+
+type AdminDiscrepancyListReply struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id   int64  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Path string `protobuf:"bytes,3,opt,name=path,proto3" json:"path,omitempty"`
+	Discrepancies []*AdminDiscrepancyListReply_List `protobuf:"bytes,1,rep,name=discrepancies,proto3" json:"discrepancies,omitempty"`
+	Count         int64                             `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	Batch         string                            `protobuf:"bytes,3,opt,name=batch,proto3" json:"batch,omitempty"`
+	Csv           string                            `protobuf:"bytes,4,opt,name=csv,proto3" json:"csv,omitempty"`
 }
 
-func (x *MyAuthListReply_List) Reset() {
-	*x = MyAuthListReply_List{}
+func (x *AdminDiscrepancyListReply) Reset() {
+	*x = AdminDiscrepancyListReply{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_app_app_api_app_proto_msgTypes[115]
+		mi := &file_app_app_api_app_proto_msgTypes[123]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *MyAuthListReply_List) String() string {
+func (x *AdminDiscrepancyListReply) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MyAuthListReply_List) ProtoMessage() {}
+func (*AdminDiscrepancyListReply) ProtoMessage() {}
 
-func (x *MyAuthListReply_List) ProtoReflect() protoreflect.Message {
-	mi := &file_app_app_api_app_proto_msgTypes[115]
+func (x *AdminDiscrepancyListReply) ProtoReflect() protoreflect.Message {
+	mi := &file_app_app_api_app_proto_msgTypes[123]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6094,58 +6043,65 @@ func (x *MyAuthListReply_List) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use MyAuthListReply_List.ProtoReflect.Descriptor instead.
-func (*MyAuthListReply_List) Descriptor() ([]byte, []int) {
-	return file_app_app_api_app_proto_rawDescGZIP(), []int{61, 0}
+// Deprecated: Use AdminDiscrepancyListReply.ProtoReflect.Descriptor instead.
+func (*AdminDiscrepancyListReply) Descriptor() ([]byte, []int) {
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{123}
 }
 
-func (x *MyAuthListReply_List) GetId() int64 {
+func (x *AdminDiscrepancyListReply) GetDiscrepancies() []*AdminDiscrepancyListReply_List {
 	if x != nil {
-		return x.Id
+		return x.Discrepancies
+	}
+	return nil
+}
+
+func (x *AdminDiscrepancyListReply) GetCount() int64 {
+	if x != nil {
+		return x.Count
 	}
 	return 0
 }
 
-func (x *MyAuthListReply_List) GetName() string {
+func (x *AdminDiscrepancyListReply) GetBatch() string {
 	if x != nil {
-		return x.Name
+		return x.Batch
 	}
 	return ""
 }
 
-func (x *MyAuthListReply_List) GetPath() string {
+func (x *AdminDiscrepancyListReply) GetCsv() string {
 	if x != nil {
-		return x.Path
+		return x.Csv
 	}
 	return ""
 }
 
-type AdminConfigUpdateRequest_SendBody struct {
+type AdminChainReconcileRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id    int64  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Value string `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	Start string `protobuf:"bytes,1,opt,name=start,proto3" json:"start,omitempty"`
+	End   string `protobuf:"bytes,2,opt,name=end,proto3" json:"end,omitempty"`
 }
 
-func (x *AdminConfigUpdateRequest_SendBody) Reset() {
-	*x = AdminConfigUpdateRequest_SendBody{}
+func (x *AdminChainReconcileRequest) Reset() {
+	*x = AdminChainReconcileRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_app_app_api_app_proto_msgTypes[116]
+		mi := &file_app_app_api_app_proto_msgTypes[124]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *AdminConfigUpdateRequest_SendBody) String() string {
+func (x *AdminChainReconcileRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AdminConfigUpdateRequest_SendBody) ProtoMessage() {}
+func (*AdminChainReconcileRequest) ProtoMessage() {}
 
-func (x *AdminConfigUpdateRequest_SendBody) ProtoReflect() protoreflect.Message {
-	mi := &file_app_app_api_app_proto_msgTypes[116]
+func (x *AdminChainReconcileRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_app_app_api_app_proto_msgTypes[124]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6156,51 +6112,51 @@ func (x *AdminConfigUpdateRequest_SendBody) ProtoReflect() protoreflect.Message
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AdminConfigUpdateRequest_SendBody.ProtoReflect.Descriptor instead.
-func (*AdminConfigUpdateRequest_SendBody) Descriptor() ([]byte, []int) {
-	return file_app_app_api_app_proto_rawDescGZIP(), []int{62, 0}
+// Deprecated: Use AdminChainReconcileRequest.ProtoReflect.Descriptor instead.
+func (*AdminChainReconcileRequest) Descriptor() ([]byte, []int) {
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{124}
 }
 
-func (x *AdminConfigUpdateRequest_SendBody) GetId() int64 {
+func (x *AdminChainReconcileRequest) GetStart() string {
 	if x != nil {
-		return x.Id
+		return x.Start
 	}
-	return 0
+	return ""
 }
 
-func (x *AdminConfigUpdateRequest_SendBody) GetValue() string {
+func (x *AdminChainReconcileRequest) GetEnd() string {
 	if x != nil {
-		return x.Value
+		return x.End
 	}
 	return ""
 }
 
-type AdminVipUpdateRequest_SendBody struct {
+type AdminChainReconcileReply struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	UserId int64 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	Vip    int64 `protobuf:"varint,2,opt,name=vip,proto3" json:"vip,omitempty"`
+	Items []*AdminChainReconcileReply_List `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	Count int64                            `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
 }
 
-func (x *AdminVipUpdateRequest_SendBody) Reset() {
-	*x = AdminVipUpdateRequest_SendBody{}
+func (x *AdminChainReconcileReply) Reset() {
+	*x = AdminChainReconcileReply{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_app_app_api_app_proto_msgTypes[117]
+		mi := &file_app_app_api_app_proto_msgTypes[125]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *AdminVipUpdateRequest_SendBody) String() string {
+func (x *AdminChainReconcileReply) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AdminVipUpdateRequest_SendBody) ProtoMessage() {}
+func (*AdminChainReconcileReply) ProtoMessage() {}
 
-func (x *AdminVipUpdateRequest_SendBody) ProtoReflect() protoreflect.Message {
-	mi := &file_app_app_api_app_proto_msgTypes[117]
+func (x *AdminChainReconcileReply) ProtoReflect() protoreflect.Message {
+	mi := &file_app_app_api_app_proto_msgTypes[125]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6211,51 +6167,51 @@ func (x *AdminVipUpdateRequest_SendBody) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AdminVipUpdateRequest_SendBody.ProtoReflect.Descriptor instead.
-func (*AdminVipUpdateRequest_SendBody) Descriptor() ([]byte, []int) {
-	return file_app_app_api_app_proto_rawDescGZIP(), []int{64, 0}
+// Deprecated: Use AdminChainReconcileReply.ProtoReflect.Descriptor instead.
+func (*AdminChainReconcileReply) Descriptor() ([]byte, []int) {
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{125}
 }
 
-func (x *AdminVipUpdateRequest_SendBody) GetUserId() int64 {
+func (x *AdminChainReconcileReply) GetItems() []*AdminChainReconcileReply_List {
 	if x != nil {
-		return x.UserId
+		return x.Items
 	}
-	return 0
+	return nil
 }
 
-func (x *AdminVipUpdateRequest_SendBody) GetVip() int64 {
+func (x *AdminChainReconcileReply) GetCount() int64 {
 	if x != nil {
-		return x.Vip
+		return x.Count
 	}
 	return 0
 }
 
-type AdminUndoUpdateRequest_SendBody struct {
+type AdminBalanceSnapshotRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	UserId int64 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	Undo   int64 `protobuf:"varint,2,opt,name=undo,proto3" json:"undo,omitempty"`
+	Date  string `protobuf:"bytes,1,opt,name=date,proto3" json:"date,omitempty"`    // 快照日期，只能是最近一次日结（UTC 14:00）的日期，为空时取该日
+	Force bool   `protobuf:"varint,2,opt,name=force,proto3" json:"force,omitempty"` // 覆盖已有快照
 }
 
-func (x *AdminUndoUpdateRequest_SendBody) Reset() {
-	*x = AdminUndoUpdateRequest_SendBody{}
+func (x *AdminBalanceSnapshotRequest) Reset() {
+	*x = AdminBalanceSnapshotRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_app_app_api_app_proto_msgTypes[118]
+		mi := &file_app_app_api_app_proto_msgTypes[126]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *AdminUndoUpdateRequest_SendBody) String() string {
+func (x *AdminBalanceSnapshotRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AdminUndoUpdateRequest_SendBody) ProtoMessage() {}
+func (*AdminBalanceSnapshotRequest) ProtoMessage() {}
 
-func (x *AdminUndoUpdateRequest_SendBody) ProtoReflect() protoreflect.Message {
-	mi := &file_app_app_api_app_proto_msgTypes[118]
+func (x *AdminBalanceSnapshotRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_app_app_api_app_proto_msgTypes[126]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6266,51 +6222,51 @@ func (x *AdminUndoUpdateRequest_SendBody) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AdminUndoUpdateRequest_SendBody.ProtoReflect.Descriptor instead.
-func (*AdminUndoUpdateRequest_SendBody) Descriptor() ([]byte, []int) {
-	return file_app_app_api_app_proto_rawDescGZIP(), []int{66, 0}
+// Deprecated: Use AdminBalanceSnapshotRequest.ProtoReflect.Descriptor instead.
+func (*AdminBalanceSnapshotRequest) Descriptor() ([]byte, []int) {
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{126}
 }
 
-func (x *AdminUndoUpdateRequest_SendBody) GetUserId() int64 {
+func (x *AdminBalanceSnapshotRequest) GetDate() string {
 	if x != nil {
-		return x.UserId
+		return x.Date
 	}
-	return 0
+	return ""
 }
 
-func (x *AdminUndoUpdateRequest_SendBody) GetUndo() int64 {
+func (x *AdminBalanceSnapshotRequest) GetForce() bool {
 	if x != nil {
-		return x.Undo
+		return x.Force
 	}
-	return 0
+	return false
 }
 
-type AdminAreaLevelUpdateRequest_SendBody struct {
+type AdminBalanceSnapshotReply struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	UserId int64 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	Level  int64 `protobuf:"varint,2,opt,name=level,proto3" json:"level,omitempty"`
+	Date      string `protobuf:"bytes,1,opt,name=date,proto3" json:"date,omitempty"`
+	UserCount int64  `protobuf:"varint,2,opt,name=user_count,json=userCount,proto3" json:"user_count,omitempty"`
 }
 
-func (x *AdminAreaLevelUpdateRequest_SendBody) Reset() {
-	*x = AdminAreaLevelUpdateRequest_SendBody{}
+func (x *AdminBalanceSnapshotReply) Reset() {
+	*x = AdminBalanceSnapshotReply{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_app_app_api_app_proto_msgTypes[119]
+		mi := &file_app_app_api_app_proto_msgTypes[127]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *AdminAreaLevelUpdateRequest_SendBody) String() string {
+func (x *AdminBalanceSnapshotReply) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AdminAreaLevelUpdateRequest_SendBody) ProtoMessage() {}
+func (*AdminBalanceSnapshotReply) ProtoMessage() {}
 
-func (x *AdminAreaLevelUpdateRequest_SendBody) ProtoReflect() protoreflect.Message {
-	mi := &file_app_app_api_app_proto_msgTypes[119]
+func (x *AdminBalanceSnapshotReply) ProtoReflect() protoreflect.Message {
+	mi := &file_app_app_api_app_proto_msgTypes[127]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6321,51 +6277,51 @@ func (x *AdminAreaLevelUpdateRequest_SendBody) ProtoReflect() protoreflect.Messa
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AdminAreaLevelUpdateRequest_SendBody.ProtoReflect.Descriptor instead.
-func (*AdminAreaLevelUpdateRequest_SendBody) Descriptor() ([]byte, []int) {
-	return file_app_app_api_app_proto_rawDescGZIP(), []int{68, 0}
+// Deprecated: Use AdminBalanceSnapshotReply.ProtoReflect.Descriptor instead.
+func (*AdminBalanceSnapshotReply) Descriptor() ([]byte, []int) {
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{127}
 }
 
-func (x *AdminAreaLevelUpdateRequest_SendBody) GetUserId() int64 {
+func (x *AdminBalanceSnapshotReply) GetDate() string {
 	if x != nil {
-		return x.UserId
+		return x.Date
 	}
-	return 0
+	return ""
 }
 
-func (x *AdminAreaLevelUpdateRequest_SendBody) GetLevel() int64 {
+func (x *AdminBalanceSnapshotReply) GetUserCount() int64 {
 	if x != nil {
-		return x.Level
+		return x.UserCount
 	}
 	return 0
 }
 
-type AdminLocationInsertRequest_SendBody struct {
+type AdminUserBalanceHistoryRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	UserId int64 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	Amount int64 `protobuf:"varint,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	UserId int64  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Date   string `protobuf:"bytes,2,opt,name=date,proto3" json:"date,omitempty"`
 }
 
-func (x *AdminLocationInsertRequest_SendBody) Reset() {
-	*x = AdminLocationInsertRequest_SendBody{}
+func (x *AdminUserBalanceHistoryRequest) Reset() {
+	*x = AdminUserBalanceHistoryRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_app_app_api_app_proto_msgTypes[120]
+		mi := &file_app_app_api_app_proto_msgTypes[128]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *AdminLocationInsertRequest_SendBody) String() string {
+func (x *AdminUserBalanceHistoryRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AdminLocationInsertRequest_SendBody) ProtoMessage() {}
+func (*AdminUserBalanceHistoryRequest) ProtoMessage() {}
 
-func (x *AdminLocationInsertRequest_SendBody) ProtoReflect() protoreflect.Message {
-	mi := &file_app_app_api_app_proto_msgTypes[120]
+func (x *AdminUserBalanceHistoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_app_app_api_app_proto_msgTypes[128]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6376,51 +6332,53 @@ func (x *AdminLocationInsertRequest_SendBody) ProtoReflect() protoreflect.Messag
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AdminLocationInsertRequest_SendBody.ProtoReflect.Descriptor instead.
-func (*AdminLocationInsertRequest_SendBody) Descriptor() ([]byte, []int) {
-	return file_app_app_api_app_proto_rawDescGZIP(), []int{70, 0}
+// Deprecated: Use AdminUserBalanceHistoryRequest.ProtoReflect.Descriptor instead.
+func (*AdminUserBalanceHistoryRequest) Descriptor() ([]byte, []int) {
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{128}
 }
 
-func (x *AdminLocationInsertRequest_SendBody) GetUserId() int64 {
+func (x *AdminUserBalanceHistoryRequest) GetUserId() int64 {
 	if x != nil {
 		return x.UserId
 	}
 	return 0
 }
 
-func (x *AdminLocationInsertRequest_SendBody) GetAmount() int64 {
+func (x *AdminUserBalanceHistoryRequest) GetDate() string {
 	if x != nil {
-		return x.Amount
+		return x.Date
 	}
-	return 0
+	return ""
 }
 
-type AdminBalanceUpdateRequest_SendBody struct {
+type AdminUserBalanceHistoryReply struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	UserId int64  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	Amount string `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	Date        string `protobuf:"bytes,1,opt,name=date,proto3" json:"date,omitempty"`
+	BalanceUsdt string `protobuf:"bytes,2,opt,name=balance_usdt,json=balanceUsdt,proto3" json:"balance_usdt,omitempty"`
+	BalanceDhb  string `protobuf:"bytes,3,opt,name=balance_dhb,json=balanceDhb,proto3" json:"balance_dhb,omitempty"`
+	BalanceBnb  string `protobuf:"bytes,4,opt,name=balance_bnb,json=balanceBnb,proto3" json:"balance_bnb,omitempty"`
 }
 
-func (x *AdminBalanceUpdateRequest_SendBody) Reset() {
-	*x = AdminBalanceUpdateRequest_SendBody{}
+func (x *AdminUserBalanceHistoryReply) Reset() {
+	*x = AdminUserBalanceHistoryReply{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_app_app_api_app_proto_msgTypes[121]
+		mi := &file_app_app_api_app_proto_msgTypes[129]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *AdminBalanceUpdateRequest_SendBody) String() string {
+func (x *AdminUserBalanceHistoryReply) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AdminBalanceUpdateRequest_SendBody) ProtoMessage() {}
+func (*AdminUserBalanceHistoryReply) ProtoMessage() {}
 
-func (x *AdminBalanceUpdateRequest_SendBody) ProtoReflect() protoreflect.Message {
-	mi := &file_app_app_api_app_proto_msgTypes[121]
+func (x *AdminUserBalanceHistoryReply) ProtoReflect() protoreflect.Message {
+	mi := &file_app_app_api_app_proto_msgTypes[129]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6431,51 +6389,65 @@ func (x *AdminBalanceUpdateRequest_SendBody) ProtoReflect() protoreflect.Message
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AdminBalanceUpdateRequest_SendBody.ProtoReflect.Descriptor instead.
-func (*AdminBalanceUpdateRequest_SendBody) Descriptor() ([]byte, []int) {
-	return file_app_app_api_app_proto_rawDescGZIP(), []int{72, 0}
+// Deprecated: Use AdminUserBalanceHistoryReply.ProtoReflect.Descriptor instead.
+func (*AdminUserBalanceHistoryReply) Descriptor() ([]byte, []int) {
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{129}
 }
 
-func (x *AdminBalanceUpdateRequest_SendBody) GetUserId() int64 {
+func (x *AdminUserBalanceHistoryReply) GetDate() string {
 	if x != nil {
-		return x.UserId
+		return x.Date
 	}
-	return 0
+	return ""
 }
 
-func (x *AdminBalanceUpdateRequest_SendBody) GetAmount() string {
+func (x *AdminUserBalanceHistoryReply) GetBalanceUsdt() string {
 	if x != nil {
-		return x.Amount
+		return x.BalanceUsdt
 	}
 	return ""
 }
 
-type AuthAdminCreateRequest_SendBody struct {
+func (x *AdminUserBalanceHistoryReply) GetBalanceDhb() string {
+	if x != nil {
+		return x.BalanceDhb
+	}
+	return ""
+}
+
+func (x *AdminUserBalanceHistoryReply) GetBalanceBnb() string {
+	if x != nil {
+		return x.BalanceBnb
+	}
+	return ""
+}
+
+type AdminLiabilityListRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	AdminId int64 `protobuf:"varint,1,opt,name=admin_id,json=adminId,proto3" json:"admin_id,omitempty"`
-	AuthId  int64 `protobuf:"varint,2,opt,name=auth_id,json=authId,proto3" json:"auth_id,omitempty"`
+	Start string `protobuf:"bytes,1,opt,name=start,proto3" json:"start,omitempty"`
+	End   string `protobuf:"bytes,2,opt,name=end,proto3" json:"end,omitempty"`
 }
 
-func (x *AuthAdminCreateRequest_SendBody) Reset() {
-	*x = AuthAdminCreateRequest_SendBody{}
+func (x *AdminLiabilityListRequest) Reset() {
+	*x = AdminLiabilityListRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_app_app_api_app_proto_msgTypes[122]
+		mi := &file_app_app_api_app_proto_msgTypes[130]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *AuthAdminCreateRequest_SendBody) String() string {
+func (x *AdminLiabilityListRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AuthAdminCreateRequest_SendBody) ProtoMessage() {}
+func (*AdminLiabilityListRequest) ProtoMessage() {}
 
-func (x *AuthAdminCreateRequest_SendBody) ProtoReflect() protoreflect.Message {
-	mi := &file_app_app_api_app_proto_msgTypes[122]
+func (x *AdminLiabilityListRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_app_app_api_app_proto_msgTypes[130]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6486,51 +6458,50 @@ func (x *AuthAdminCreateRequest_SendBody) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AuthAdminCreateRequest_SendBody.ProtoReflect.Descriptor instead.
-func (*AuthAdminCreateRequest_SendBody) Descriptor() ([]byte, []int) {
-	return file_app_app_api_app_proto_rawDescGZIP(), []int{74, 0}
+// Deprecated: Use AdminLiabilityListRequest.ProtoReflect.Descriptor instead.
+func (*AdminLiabilityListRequest) Descriptor() ([]byte, []int) {
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{130}
 }
 
-func (x *AuthAdminCreateRequest_SendBody) GetAdminId() int64 {
+func (x *AdminLiabilityListRequest) GetStart() string {
 	if x != nil {
-		return x.AdminId
+		return x.Start
 	}
-	return 0
+	return ""
 }
 
-func (x *AuthAdminCreateRequest_SendBody) GetAuthId() int64 {
+func (x *AdminLiabilityListRequest) GetEnd() string {
 	if x != nil {
-		return x.AuthId
+		return x.End
 	}
-	return 0
+	return ""
 }
 
-type AuthAdminDeleteRequest_SendBody struct {
+type AdminLiabilityListReply struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	AdminId int64 `protobuf:"varint,1,opt,name=admin_id,json=adminId,proto3" json:"admin_id,omitempty"`
-	AuthId  int64 `protobuf:"varint,2,opt,name=auth_id,json=authId,proto3" json:"auth_id,omitempty"`
+	Liabilities []*AdminLiabilityListReply_List `protobuf:"bytes,1,rep,name=liabilities,proto3" json:"liabilities,omitempty"`
 }
 
-func (x *AuthAdminDeleteRequest_SendBody) Reset() {
-	*x = AuthAdminDeleteRequest_SendBody{}
+func (x *AdminLiabilityListReply) Reset() {
+	*x = AdminLiabilityListReply{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_app_app_api_app_proto_msgTypes[123]
+		mi := &file_app_app_api_app_proto_msgTypes[131]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *AuthAdminDeleteRequest_SendBody) String() string {
+func (x *AdminLiabilityListReply) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AuthAdminDeleteRequest_SendBody) ProtoMessage() {}
+func (*AdminLiabilityListReply) ProtoMessage() {}
 
-func (x *AuthAdminDeleteRequest_SendBody) ProtoReflect() protoreflect.Message {
-	mi := &file_app_app_api_app_proto_msgTypes[123]
+func (x *AdminLiabilityListReply) ProtoReflect() protoreflect.Message {
+	mi := &file_app_app_api_app_proto_msgTypes[131]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6541,51 +6512,115 @@ func (x *AuthAdminDeleteRequest_SendBody) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AuthAdminDeleteRequest_SendBody.ProtoReflect.Descriptor instead.
-func (*AuthAdminDeleteRequest_SendBody) Descriptor() ([]byte, []int) {
-	return file_app_app_api_app_proto_rawDescGZIP(), []int{76, 0}
+// Deprecated: Use AdminLiabilityListReply.ProtoReflect.Descriptor instead.
+func (*AdminLiabilityListReply) Descriptor() ([]byte, []int) {
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{131}
 }
 
-func (x *AuthAdminDeleteRequest_SendBody) GetAdminId() int64 {
+func (x *AdminLiabilityListReply) GetLiabilities() []*AdminLiabilityListReply_List {
 	if x != nil {
-		return x.AdminId
+		return x.Liabilities
 	}
-	return 0
+	return nil
 }
 
-func (x *AuthAdminDeleteRequest_SendBody) GetAuthId() int64 {
+type AdminUserStatementRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId int64  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Start  string `protobuf:"bytes,2,opt,name=start,proto3" json:"start,omitempty"`
+	End    string `protobuf:"bytes,3,opt,name=end,proto3" json:"end,omitempty"`
+	Format string `protobuf:"bytes,4,opt,name=format,proto3" json:"format,omitempty"`
+}
+
+func (x *AdminUserStatementRequest) Reset() {
+	*x = AdminUserStatementRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_app_app_api_app_proto_msgTypes[132]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AdminUserStatementRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminUserStatementRequest) ProtoMessage() {}
+
+func (x *AdminUserStatementRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_app_app_api_app_proto_msgTypes[132]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminUserStatementRequest.ProtoReflect.Descriptor instead.
+func (*AdminUserStatementRequest) Descriptor() ([]byte, []int) {
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{132}
+}
+
+func (x *AdminUserStatementRequest) GetUserId() int64 {
 	if x != nil {
-		return x.AuthId
+		return x.UserId
 	}
 	return 0
 }
 
-type AdminLoginRequest_SendBody struct {
+func (x *AdminUserStatementRequest) GetStart() string {
+	if x != nil {
+		return x.Start
+	}
+	return ""
+}
+
+func (x *AdminUserStatementRequest) GetEnd() string {
+	if x != nil {
+		return x.End
+	}
+	return ""
+}
+
+func (x *AdminUserStatementRequest) GetFormat() string {
+	if x != nil {
+		return x.Format
+	}
+	return ""
+}
+
+type AdminUserStatementReply struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Account  string `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
-	Password string `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`
+	Lines []*AdminUserStatementReply_List `protobuf:"bytes,1,rep,name=lines,proto3" json:"lines,omitempty"`
+	Csv   string                          `protobuf:"bytes,2,opt,name=csv,proto3" json:"csv,omitempty"`
 }
 
-func (x *AdminLoginRequest_SendBody) Reset() {
-	*x = AdminLoginRequest_SendBody{}
+func (x *AdminUserStatementReply) Reset() {
+	*x = AdminUserStatementReply{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_app_app_api_app_proto_msgTypes[124]
+		mi := &file_app_app_api_app_proto_msgTypes[133]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *AdminLoginRequest_SendBody) String() string {
+func (x *AdminUserStatementReply) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AdminLoginRequest_SendBody) ProtoMessage() {}
+func (*AdminUserStatementReply) ProtoMessage() {}
 
-func (x *AdminLoginRequest_SendBody) ProtoReflect() protoreflect.Message {
-	mi := &file_app_app_api_app_proto_msgTypes[124]
+func (x *AdminUserStatementReply) ProtoReflect() protoreflect.Message {
+	mi := &file_app_app_api_app_proto_msgTypes[133]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6596,51 +6631,89 @@ func (x *AdminLoginRequest_SendBody) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AdminLoginRequest_SendBody.ProtoReflect.Descriptor instead.
-func (*AdminLoginRequest_SendBody) Descriptor() ([]byte, []int) {
-	return file_app_app_api_app_proto_rawDescGZIP(), []int{90, 0}
+// Deprecated: Use AdminUserStatementReply.ProtoReflect.Descriptor instead.
+func (*AdminUserStatementReply) Descriptor() ([]byte, []int) {
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{133}
 }
 
-func (x *AdminLoginRequest_SendBody) GetAccount() string {
+func (x *AdminUserStatementReply) GetLines() []*AdminUserStatementReply_List {
 	if x != nil {
-		return x.Account
+		return x.Lines
 	}
-	return ""
+	return nil
 }
 
-func (x *AdminLoginRequest_SendBody) GetPassword() string {
+func (x *AdminUserStatementReply) GetCsv() string {
 	if x != nil {
-		return x.Password
+		return x.Csv
 	}
 	return ""
 }
 
-type AdminChangePasswordRequest_SendBody struct {
+type AdminSolvencyRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *AdminSolvencyRequest) Reset() {
+	*x = AdminSolvencyRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_app_app_api_app_proto_msgTypes[134]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AdminSolvencyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminSolvencyRequest) ProtoMessage() {}
+
+func (x *AdminSolvencyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_app_app_api_app_proto_msgTypes[134]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminSolvencyRequest.ProtoReflect.Descriptor instead.
+func (*AdminSolvencyRequest) Descriptor() ([]byte, []int) {
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{134}
+}
+
+type AdminSolvencyReply struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Account  string `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
-	Password string `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`
+	Solvency   *AdminSolvencyListReply_List `protobuf:"bytes,1,opt,name=solvency,proto3" json:"solvency,omitempty"`
+	AlarmRatio string                       `protobuf:"bytes,2,opt,name=alarm_ratio,json=alarmRatio,proto3" json:"alarm_ratio,omitempty"`
 }
 
-func (x *AdminChangePasswordRequest_SendBody) Reset() {
-	*x = AdminChangePasswordRequest_SendBody{}
+func (x *AdminSolvencyReply) Reset() {
+	*x = AdminSolvencyReply{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_app_app_api_app_proto_msgTypes[125]
+		mi := &file_app_app_api_app_proto_msgTypes[135]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *AdminChangePasswordRequest_SendBody) String() string {
+func (x *AdminSolvencyReply) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AdminChangePasswordRequest_SendBody) ProtoMessage() {}
+func (*AdminSolvencyReply) ProtoMessage() {}
 
-func (x *AdminChangePasswordRequest_SendBody) ProtoReflect() protoreflect.Message {
-	mi := &file_app_app_api_app_proto_msgTypes[125]
+func (x *AdminSolvencyReply) ProtoReflect() protoreflect.Message {
+	mi := &file_app_app_api_app_proto_msgTypes[135]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6651,51 +6724,48 @@ func (x *AdminChangePasswordRequest_SendBody) ProtoReflect() protoreflect.Messag
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AdminChangePasswordRequest_SendBody.ProtoReflect.Descriptor instead.
-func (*AdminChangePasswordRequest_SendBody) Descriptor() ([]byte, []int) {
-	return file_app_app_api_app_proto_rawDescGZIP(), []int{92, 0}
+// Deprecated: Use AdminSolvencyReply.ProtoReflect.Descriptor instead.
+func (*AdminSolvencyReply) Descriptor() ([]byte, []int) {
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{135}
 }
 
-func (x *AdminChangePasswordRequest_SendBody) GetAccount() string {
+func (x *AdminSolvencyReply) GetSolvency() *AdminSolvencyListReply_List {
 	if x != nil {
-		return x.Account
+		return x.Solvency
 	}
-	return ""
+	return nil
 }
 
-func (x *AdminChangePasswordRequest_SendBody) GetPassword() string {
+func (x *AdminSolvencyReply) GetAlarmRatio() string {
 	if x != nil {
-		return x.Password
+		return x.AlarmRatio
 	}
 	return ""
 }
 
-type AdminCreateAccountRequest_SendBody struct {
+type AdminSolvencyCheckRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	Account  string `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
-	Password string `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`
 }
 
-func (x *AdminCreateAccountRequest_SendBody) Reset() {
-	*x = AdminCreateAccountRequest_SendBody{}
+func (x *AdminSolvencyCheckRequest) Reset() {
+	*x = AdminSolvencyCheckRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_app_app_api_app_proto_msgTypes[126]
+		mi := &file_app_app_api_app_proto_msgTypes[136]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *AdminCreateAccountRequest_SendBody) String() string {
+func (x *AdminSolvencyCheckRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AdminCreateAccountRequest_SendBody) ProtoMessage() {}
+func (*AdminSolvencyCheckRequest) ProtoMessage() {}
 
-func (x *AdminCreateAccountRequest_SendBody) ProtoReflect() protoreflect.Message {
-	mi := &file_app_app_api_app_proto_msgTypes[126]
+func (x *AdminSolvencyCheckRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_app_app_api_app_proto_msgTypes[136]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6706,1288 +6776,8420 @@ func (x *AdminCreateAccountRequest_SendBody) ProtoReflect() protoreflect.Message
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AdminCreateAccountRequest_SendBody.ProtoReflect.Descriptor instead.
-func (*AdminCreateAccountRequest_SendBody) Descriptor() ([]byte, []int) {
-	return file_app_app_api_app_proto_rawDescGZIP(), []int{94, 0}
+// Deprecated: Use AdminSolvencyCheckRequest.ProtoReflect.Descriptor instead.
+func (*AdminSolvencyCheckRequest) Descriptor() ([]byte, []int) {
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{136}
 }
 
-func (x *AdminCreateAccountRequest_SendBody) GetAccount() string {
-	if x != nil {
-		return x.Account
+type AdminSolvencyCheckReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Alarm bool `protobuf:"varint,1,opt,name=alarm,proto3" json:"alarm,omitempty"`
+}
+
+func (x *AdminSolvencyCheckReply) Reset() {
+	*x = AdminSolvencyCheckReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_app_app_api_app_proto_msgTypes[137]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return ""
 }
 
-func (x *AdminCreateAccountRequest_SendBody) GetPassword() string {
+func (x *AdminSolvencyCheckReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminSolvencyCheckReply) ProtoMessage() {}
+
+func (x *AdminSolvencyCheckReply) ProtoReflect() protoreflect.Message {
+	mi := &file_app_app_api_app_proto_msgTypes[137]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminSolvencyCheckReply.ProtoReflect.Descriptor instead.
+func (*AdminSolvencyCheckReply) Descriptor() ([]byte, []int) {
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{137}
+}
+
+func (x *AdminSolvencyCheckReply) GetAlarm() bool {
 	if x != nil {
-		return x.Password
+		return x.Alarm
 	}
-	return ""
+	return false
 }
 
-var File_app_app_api_app_proto protoreflect.FileDescriptor
+type AdminSolvencyListRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 
-var file_app_app_api_app_proto_rawDesc = []byte{
-	0x0a, 0x15, 0x61, 0x70, 0x70, 0x2f, 0x61, 0x70, 0x70, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x70,
-	0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x03, 0x61, 0x70, 0x69, 0x1a, 0x1c, 0x67, 0x6f,
-	0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x17, 0x76, 0x61, 0x6c, 0x69,
-	0x64, 0x61, 0x74, 0x65, 0x2f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x22, 0x8f, 0x01, 0x0a, 0x13, 0x45, 0x74, 0x68, 0x41, 0x75, 0x74, 0x68, 0x6f,
-	0x72, 0x69, 0x7a, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x3e, 0x0a, 0x09, 0x73,
-	0x65, 0x6e, 0x64, 0x5f, 0x62, 0x6f, 0x64, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21,
-	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x45, 0x74, 0x68, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a,
-	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x53, 0x65, 0x6e, 0x64, 0x42, 0x6f, 0x64,
-	0x79, 0x52, 0x08, 0x73, 0x65, 0x6e, 0x64, 0x42, 0x6f, 0x64, 0x79, 0x1a, 0x38, 0x0a, 0x08, 0x53,
-	0x65, 0x6e, 0x64, 0x42, 0x6f, 0x64, 0x79, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65,
-	0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73,
-	0x73, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x04, 0x63, 0x6f, 0x64, 0x65, 0x22, 0x29, 0x0a, 0x11, 0x45, 0x74, 0x68, 0x41, 0x75, 0x74, 0x68,
-	0x6f, 0x72, 0x69, 0x7a, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f,
-	0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e,
-	0x22, 0x10, 0x0a, 0x0e, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x22, 0x0e, 0x0a, 0x0c, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x52, 0x65, 0x70,
-	0x6c, 0x79, 0x22, 0x11, 0x0a, 0x0f, 0x55, 0x73, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0xd6, 0x06, 0x0a, 0x0d, 0x55, 0x73, 0x65, 0x72, 0x49, 0x6e,
-	0x66, 0x6f, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65,
-	0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73,
-	0x73, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03,
-	0x52, 0x05, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75,
-	0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12,
-	0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x62, 0x61, 0x6c, 0x61, 0x6e,
-	0x63, 0x65, 0x55, 0x73, 0x64, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x62, 0x61,
-	0x6c, 0x61, 0x6e, 0x63, 0x65, 0x55, 0x73, 0x64, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x62, 0x61, 0x6c,
-	0x61, 0x6e, 0x63, 0x65, 0x44, 0x68, 0x62, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x62,
-	0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x44, 0x68, 0x62, 0x12, 0x1c, 0x0a, 0x09, 0x69, 0x6e, 0x76,
-	0x69, 0x74, 0x65, 0x55, 0x72, 0x6c, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x69, 0x6e,
-	0x76, 0x69, 0x74, 0x65, 0x55, 0x72, 0x6c, 0x12, 0x2c, 0x0a, 0x11, 0x69, 0x6e, 0x76, 0x69, 0x74,
-	0x65, 0x55, 0x73, 0x65, 0x72, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x08, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x11, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x41, 0x64,
-	0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x22, 0x0a, 0x0c, 0x72, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65,
-	0x6e, 0x64, 0x4e, 0x75, 0x6d, 0x18, 0x09, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x72, 0x65, 0x63,
-	0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x4e, 0x75, 0x6d, 0x12, 0x2a, 0x0a, 0x10, 0x72, 0x65, 0x63,
-	0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x54, 0x65, 0x61, 0x6d, 0x4e, 0x75, 0x6d, 0x18, 0x0a, 0x20,
-	0x01, 0x28, 0x03, 0x52, 0x10, 0x72, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x54, 0x65,
-	0x61, 0x6d, 0x4e, 0x75, 0x6d, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x0b,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x1a, 0x0a, 0x08, 0x66,
-	0x65, 0x65, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x17, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x66,
-	0x65, 0x65, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x10, 0x0a, 0x03, 0x72, 0x6f, 0x77, 0x18, 0x0c,
-	0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x72, 0x6f, 0x77, 0x12, 0x10, 0x0a, 0x03, 0x63, 0x6f, 0x6c,
-	0x18, 0x0d, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x63, 0x6f, 0x6c, 0x12, 0x3a, 0x0a, 0x18, 0x63,
-	0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x4d, 0x6f, 0x6e, 0x74, 0x68, 0x52, 0x65, 0x63, 0x6f, 0x6d,
-	0x6d, 0x65, 0x6e, 0x64, 0x4e, 0x75, 0x6d, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x03, 0x52, 0x18, 0x63,
-	0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x4d, 0x6f, 0x6e, 0x74, 0x68, 0x52, 0x65, 0x63, 0x6f, 0x6d,
-	0x6d, 0x65, 0x6e, 0x64, 0x4e, 0x75, 0x6d, 0x12, 0x26, 0x0a, 0x0e, 0x72, 0x65, 0x63, 0x6f, 0x6d,
-	0x6d, 0x65, 0x6e, 0x64, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x0e, 0x72, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x12,
-	0x24, 0x0a, 0x0d, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x6f, 0x74, 0x61, 0x6c,
-	0x18, 0x10, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x54, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x1c, 0x0a, 0x09, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x31, 0x44,
-	0x68, 0x62, 0x18, 0x11, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x31,
-	0x44, 0x68, 0x62, 0x12, 0x1c, 0x0a, 0x09, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x32, 0x44, 0x68, 0x62,
-	0x18, 0x12, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x32, 0x44, 0x68,
-	0x62, 0x12, 0x1c, 0x0a, 0x09, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x33, 0x44, 0x68, 0x62, 0x18, 0x13,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x33, 0x44, 0x68, 0x62, 0x12,
-	0x12, 0x0a, 0x04, 0x75, 0x73, 0x64, 0x74, 0x18, 0x14, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x75,
-	0x73, 0x64, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x64, 0x68, 0x62, 0x18, 0x15, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x03, 0x64, 0x68, 0x62, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
-	0x18, 0x16, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12,
-	0x28, 0x0a, 0x0f, 0x72, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x4e, 0x75, 0x6d, 0x41,
-	0x6c, 0x6c, 0x18, 0x18, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0f, 0x72, 0x65, 0x63, 0x6f, 0x6d, 0x6d,
-	0x65, 0x6e, 0x64, 0x4e, 0x75, 0x6d, 0x41, 0x6c, 0x6c, 0x12, 0x2a, 0x0a, 0x10, 0x72, 0x65, 0x63,
-	0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x54, 0x65, 0x61, 0x6d, 0x41, 0x6c, 0x6c, 0x18, 0x19, 0x20,
-	0x01, 0x28, 0x03, 0x52, 0x10, 0x72, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x54, 0x65,
-	0x61, 0x6d, 0x41, 0x6c, 0x6c, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x42,
-	0x18, 0x1b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x42, 0x12,
-	0x1d, 0x0a, 0x0a, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x1c, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x09, 0x75, 0x73, 0x65, 0x72, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x27,
-	0x0a, 0x11, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x22, 0xc1, 0x01, 0x0a, 0x0f, 0x52, 0x65, 0x77, 0x61,
-	0x72, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x33, 0x0a, 0x07, 0x72,
-	0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x61,
-	0x70, 0x69, 0x2e, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x70,
-	0x6c, 0x79, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x07, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73,
-	0x1a, 0x79, 0x0a, 0x04, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61,
-	0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x72,
-	0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e,
-	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12,
-	0x26, 0x0a, 0x0e, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75,
-	0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18,
-	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x22, 0x1c, 0x0a, 0x1a, 0x52,
-	0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x4c, 0x69,
-	0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0xc3, 0x01, 0x0a, 0x18, 0x52, 0x65,
-	0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x4c, 0x69, 0x73,
-	0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x3c, 0x0a, 0x07, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64,
-	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x52, 0x65,
-	0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x4c, 0x69, 0x73,
-	0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x07, 0x72, 0x65, 0x77,
-	0x61, 0x72, 0x64, 0x73, 0x1a, 0x69, 0x0a, 0x04, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a,
-	0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x61,
-	0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x6d, 0x6f,
-	0x75, 0x6e, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f,
-	0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x22,
-	0x16, 0x0a, 0x14, 0x46, 0x65, 0x65, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x4c, 0x69, 0x73, 0x74,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x8b, 0x01, 0x0a, 0x12, 0x46, 0x65, 0x65, 0x52,
-	0x65, 0x77, 0x61, 0x72, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x36,
-	0x0a, 0x07, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
-	0x1c, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x46, 0x65, 0x65, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x4c,
-	0x69, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x07, 0x72,
-	0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x1a, 0x3d, 0x0a, 0x04, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x1d,
-	0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x16, 0x0a,
-	0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61,
-	0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x29, 0x0a, 0x13, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61,
-	0x77, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04,
-	0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65,
-	0x22, 0xb7, 0x01, 0x0a, 0x11, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x4c, 0x69, 0x73,
-	0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x37, 0x0a, 0x08, 0x77, 0x69, 0x74, 0x68, 0x64, 0x72,
-	0x61, 0x77, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x57,
-	0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79,
-	0x2e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x08, 0x77, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x1a,
-	0x69, 0x0a, 0x04, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74,
-	0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x72, 0x65,
-	0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x12,
-	0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79,
-	0x70, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x04, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x2a, 0x0a, 0x14, 0x52, 0x65,
-	0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x22, 0x8a, 0x01, 0x0a, 0x12, 0x52, 0x65, 0x63, 0x6f, 0x6d,
-	0x6d, 0x65, 0x6e, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x3c, 0x0a,
-	0x0a, 0x72, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
-	0x0b, 0x32, 0x1c, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e,
-	0x64, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x52,
-	0x0a, 0x72, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x73, 0x1a, 0x36, 0x0a, 0x04, 0x4c,
-	0x69, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x73,
-	0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61,
-	0x74, 0x75, 0x73, 0x22, 0x85, 0x01, 0x0a, 0x0f, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x3a, 0x0a, 0x09, 0x73, 0x65, 0x6e, 0x64, 0x5f,
-	0x62, 0x6f, 0x64, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x61, 0x70, 0x69,
-	0x2e, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x2e, 0x53, 0x65, 0x6e, 0x64, 0x42, 0x6f, 0x64, 0x79, 0x52, 0x08, 0x73, 0x65, 0x6e, 0x64, 0x42,
-	0x6f, 0x64, 0x79, 0x1a, 0x36, 0x0a, 0x08, 0x53, 0x65, 0x6e, 0x64, 0x42, 0x6f, 0x64, 0x79, 0x12,
-	0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74,
-	0x79, 0x70, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x27, 0x0a, 0x0d, 0x57,
-	0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x16, 0x0a, 0x06,
-	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74,
-	0x61, 0x74, 0x75, 0x73, 0x22, 0x46, 0x0a, 0x16, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x52, 0x65, 0x77,
-	0x61, 0x72, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12,
-	0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x70, 0x61,
-	0x67, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x22, 0xec, 0x01, 0x0a,
-	0x14, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x4c, 0x69, 0x73, 0x74,
-	0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x38, 0x0a, 0x07, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73,
-	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d,
-	0x69, 0x6e, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6c,
-	0x79, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x07, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x12,
-	0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05,
-	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x1a, 0x83, 0x01, 0x0a, 0x04, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x1d,
-	0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x16, 0x0a,
-	0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61,
-	0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x04, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64,
-	0x72, 0x65, 0x73, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72,
-	0x65, 0x73, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x06, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x22, 0x49, 0x0a, 0x19, 0x41,
-	0x64, 0x6d, 0x69, 0x6e, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x42, 0x6e, 0x62, 0x4c, 0x69, 0x73,
-	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x67, 0x65,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x70, 0x61, 0x67, 0x65, 0x12, 0x18, 0x0a, 0x07,
-	0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61,
-	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x22, 0xe5, 0x01, 0x0a, 0x17, 0x41, 0x64, 0x6d, 0x69, 0x6e,
-	0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x42, 0x6e, 0x62, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x70,
-	0x6c, 0x79, 0x12, 0x3b, 0x0a, 0x07, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x18, 0x01, 0x20,
-	0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x52,
-	0x65, 0x77, 0x61, 0x72, 0x64, 0x42, 0x6e, 0x62, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6c,
-	0x79, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x07, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x12,
-	0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05,
-	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x1a, 0x77, 0x0a, 0x04, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x1d, 0x0a,
-	0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x16, 0x0a, 0x06,
-	0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x6d,
-	0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x41,
-	0x6c, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63,
-	0x65, 0x41, 0x6c, 0x6c, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18,
-	0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x22, 0x1b,
-	0x0a, 0x19, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x55, 0x73, 0x65, 0x72,
-	0x41, 0x72, 0x65, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x19, 0x0a, 0x17, 0x43,
-	0x68, 0x65, 0x63, 0x6b, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x55, 0x73, 0x65, 0x72, 0x41, 0x72, 0x65,
-	0x61, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x2d, 0x0a, 0x2b, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x41,
-	0x6e, 0x64, 0x49, 0x6e, 0x73, 0x65, 0x72, 0x74, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x73, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x2b, 0x0a, 0x29, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x41, 0x6e,
-	0x64, 0x49, 0x6e, 0x73, 0x65, 0x72, 0x74, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73,
-	0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x70,
-	0x6c, 0x79, 0x22, 0x1c, 0x0a, 0x1a, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x52, 0x65, 0x63, 0x6f,
-	0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x22, 0x1a, 0x0a, 0x18, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d,
-	0x65, 0x6e, 0x64, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x76, 0x0a, 0x14,
-	0x41, 0x64, 0x6d, 0x69, 0x6e, 0x55, 0x73, 0x65, 0x72, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x03, 0x52, 0x04, 0x70, 0x61, 0x67, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72,
-	0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65,
-	0x73, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x69, 0x73, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x69, 0x73, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x12, 0x10, 0x0a, 0x03, 0x76, 0x69, 0x70, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52,
-	0x03, 0x76, 0x69, 0x70, 0x22, 0xed, 0x03, 0x0a, 0x12, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x55, 0x73,
-	0x65, 0x72, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x36, 0x0a, 0x05, 0x75,
-	0x73, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x61, 0x70, 0x69,
-	0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x55, 0x73, 0x65, 0x72, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65,
-	0x70, 0x6c, 0x79, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x05, 0x75, 0x73,
-	0x65, 0x72, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x03, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x1a, 0x88, 0x03, 0x0a, 0x08, 0x55, 0x73,
-	0x65, 0x72, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69,
-	0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12,
-	0x1d, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x18,
-	0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x62, 0x61, 0x6c, 0x61,
-	0x6e, 0x63, 0x65, 0x55, 0x73, 0x64, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x62,
-	0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x55, 0x73, 0x64, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x62, 0x61,
-	0x6c, 0x61, 0x6e, 0x63, 0x65, 0x44, 0x68, 0x62, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a,
-	0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x44, 0x68, 0x62, 0x12, 0x10, 0x0a, 0x03, 0x76, 0x69,
-	0x70, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x76, 0x69, 0x70, 0x12, 0x26, 0x0a, 0x0e,
-	0x6d, 0x6f, 0x6e, 0x74, 0x68, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x18, 0x07,
-	0x20, 0x01, 0x28, 0x03, 0x52, 0x0e, 0x6d, 0x6f, 0x6e, 0x74, 0x68, 0x52, 0x65, 0x63, 0x6f, 0x6d,
-	0x6d, 0x65, 0x6e, 0x64, 0x12, 0x2a, 0x0a, 0x10, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52,
-	0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x10,
-	0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64,
-	0x12, 0x1e, 0x0a, 0x0a, 0x61, 0x72, 0x65, 0x61, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x09,
-	0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x61, 0x72, 0x65, 0x61, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74,
-	0x12, 0x24, 0x0a, 0x0d, 0x61, 0x72, 0x65, 0x61, 0x4d, 0x61, 0x78, 0x41, 0x6d, 0x6f, 0x75, 0x6e,
-	0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x61, 0x72, 0x65, 0x61, 0x4d, 0x61, 0x78,
-	0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x62, 0x6e, 0x62, 0x41, 0x6d, 0x6f,
-	0x75, 0x6e, 0x74, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x62, 0x6e, 0x62, 0x41, 0x6d,
-	0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x62, 0x6e, 0x62, 0x42, 0x61, 0x6c, 0x61, 0x6e,
-	0x63, 0x65, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x62, 0x6e, 0x62, 0x42, 0x61, 0x6c,
-	0x61, 0x6e, 0x63, 0x65, 0x22, 0x48, 0x0a, 0x18, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x4c, 0x6f, 0x63,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04,
-	0x70, 0x61, 0x67, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x22, 0xda,
-	0x02, 0x0a, 0x16, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x46, 0x0a, 0x09, 0x6c, 0x6f, 0x63,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x61,
-	0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x2e, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x09, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x73, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03,
-	0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x1a, 0xe1, 0x01, 0x0a, 0x0c, 0x4c, 0x6f, 0x63, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61,
-	0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x72,
-	0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65,
-	0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73,
-	0x73, 0x12, 0x10, 0x0a, 0x03, 0x72, 0x6f, 0x77, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03,
-	0x72, 0x6f, 0x77, 0x12, 0x10, 0x0a, 0x03, 0x63, 0x6f, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03,
-	0x52, 0x03, 0x63, 0x6f, 0x6c, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18,
-	0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x22, 0x0a,
-	0x0c, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x18, 0x06, 0x20,
-	0x01, 0x28, 0x03, 0x52, 0x0c, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x4c, 0x65, 0x76, 0x65,
-	0x6c, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x18, 0x07, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x07, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x63,
-	0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x4d, 0x61, 0x78, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x0a, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x4d, 0x61, 0x78, 0x22, 0x4b, 0x0a, 0x1b, 0x41,
-	0x64, 0x6d, 0x69, 0x6e, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x6c, 0x6c, 0x4c,
-	0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61,
-	0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x70, 0x61, 0x67, 0x65, 0x12, 0x18,
-	0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x22, 0xe0, 0x02, 0x0a, 0x19, 0x41, 0x64, 0x6d,
-	0x69, 0x6e, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x6c, 0x6c, 0x4c, 0x69, 0x73,
-	0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x49, 0x0a, 0x09, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x61, 0x70, 0x69, 0x2e,
-	0x41, 0x64, 0x6d, 0x69, 0x6e, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x6c, 0x6c,
-	0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x2e, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x09, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x73, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03,
-	0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x1a, 0xe1, 0x01, 0x0a, 0x0c, 0x4c, 0x6f, 0x63, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61,
-	0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x72,
-	0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65,
-	0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73,
-	0x73, 0x12, 0x10, 0x0a, 0x03, 0x72, 0x6f, 0x77, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03,
-	0x72, 0x6f, 0x77, 0x12, 0x10, 0x0a, 0x03, 0x63, 0x6f, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03,
-	0x52, 0x03, 0x63, 0x6f, 0x6c, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18,
-	0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x22, 0x0a,
-	0x0c, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x18, 0x06, 0x20,
-	0x01, 0x28, 0x03, 0x52, 0x0c, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x4c, 0x65, 0x76, 0x65,
-	0x6c, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x18, 0x07, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x07, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x63,
-	0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x4d, 0x61, 0x78, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x0a, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x4d, 0x61, 0x78, 0x22, 0x5c, 0x0a, 0x18, 0x41,
-	0x64, 0x6d, 0x69, 0x6e, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x4c, 0x69, 0x73, 0x74,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x70, 0x61, 0x67, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x61,
-	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64,
-	0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x22, 0xa0, 0x02, 0x0a, 0x16, 0x41, 0x64,
-	0x6d, 0x69, 0x6e, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x4c, 0x69, 0x73, 0x74, 0x52,
-	0x65, 0x70, 0x6c, 0x79, 0x12, 0x3c, 0x0a, 0x08, 0x77, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77,
-	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d,
-	0x69, 0x6e, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65,
-	0x70, 0x6c, 0x79, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x08, 0x77, 0x69, 0x74, 0x68, 0x64, 0x72,
-	0x61, 0x77, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x03, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x1a, 0xb1, 0x01, 0x0a, 0x04, 0x4c, 0x69, 0x73,
-	0x74, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x05, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x0e, 0x0a, 0x02, 0x69,
-	0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x63,
-	0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d,
-	0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75,
-	0x6e, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x72, 0x65, 0x6c, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18,
-	0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x72, 0x65, 0x6c, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74,
-	0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
-	0x74, 0x79, 0x70, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x04,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x16, 0x0a, 0x14,
-	0x41, 0x64, 0x6d, 0x69, 0x6e, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x22, 0x14, 0x0a, 0x12, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x57, 0x69, 0x74,
-	0x68, 0x64, 0x72, 0x61, 0x77, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x25, 0x0a, 0x23, 0x41, 0x64,
-	0x6d, 0x69, 0x6e, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x44, 0x6f, 0x69, 0x6e, 0x67,
-	0x54, 0x6f, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x65, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x22, 0x23, 0x0a, 0x21, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72,
-	0x61, 0x77, 0x44, 0x6f, 0x69, 0x6e, 0x67, 0x54, 0x6f, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x65,
-	0x64, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x19, 0x0a, 0x17, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x57,
-	0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x45, 0x74, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x22, 0x17, 0x0a, 0x15, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72,
-	0x61, 0x77, 0x45, 0x74, 0x68, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x11, 0x0a, 0x0f, 0x41, 0x64,
-	0x6d, 0x69, 0x6e, 0x46, 0x65, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x0f, 0x0a,
-	0x0d, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x46, 0x65, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x28,
-	0x0a, 0x14, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x44, 0x61, 0x69, 0x6c, 0x79, 0x46, 0x65, 0x65, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x64, 0x61, 0x79, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x05, 0x52, 0x03, 0x64, 0x61, 0x79, 0x22, 0x14, 0x0a, 0x12, 0x41, 0x64, 0x6d, 0x69,
-	0x6e, 0x44, 0x61, 0x69, 0x6c, 0x79, 0x46, 0x65, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x11,
-	0x0a, 0x0f, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x41, 0x6c, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x22, 0xf9, 0x04, 0x0a, 0x0d, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x41, 0x6c, 0x6c, 0x52, 0x65,
-	0x70, 0x6c, 0x79, 0x12, 0x26, 0x0a, 0x0e, 0x74, 0x6f, 0x64, 0x61, 0x79, 0x54, 0x6f, 0x74, 0x61,
-	0x6c, 0x55, 0x73, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0e, 0x74, 0x6f, 0x64,
-	0x61, 0x79, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x55, 0x73, 0x65, 0x72, 0x12, 0x24, 0x0a, 0x0d, 0x6c,
-	0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x0a, 0x20, 0x01,
-	0x28, 0x03, 0x52, 0x0d, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x75, 0x6e,
-	0x74, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x55, 0x73, 0x65, 0x72, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x55, 0x73, 0x65, 0x72, 0x12,
-	0x1e, 0x0a, 0x0a, 0x61, 0x6c, 0x6c, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x0a, 0x61, 0x6c, 0x6c, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x12,
-	0x24, 0x0a, 0x0d, 0x74, 0x6f, 0x64, 0x61, 0x79, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x74, 0x6f, 0x64, 0x61, 0x79, 0x4c, 0x6f, 0x63,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x20, 0x0a, 0x0b, 0x61, 0x6c, 0x6c, 0x4c, 0x6f, 0x63, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x6c, 0x6c, 0x4c,
-	0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x24, 0x0a, 0x0d, 0x74, 0x6f, 0x64, 0x61, 0x79,
-	0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d,
-	0x74, 0x6f, 0x64, 0x61, 0x79, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x12, 0x20, 0x0a,
-	0x0b, 0x61, 0x6c, 0x6c, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x18, 0x07, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x0b, 0x61, 0x6c, 0x6c, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x12,
-	0x1c, 0x0a, 0x09, 0x61, 0x6c, 0x6c, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x18, 0x08, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x09, 0x61, 0x6c, 0x6c, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x12, 0x34, 0x0a,
-	0x15, 0x61, 0x6c, 0x6c, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64,
-	0x41, 0x6e, 0x64, 0x46, 0x65, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x15, 0x61, 0x6c,
-	0x6c, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x41, 0x6e, 0x64,
-	0x46, 0x65, 0x65, 0x12, 0x24, 0x0a, 0x0d, 0x61, 0x6c, 0x6c, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63,
-	0x65, 0x42, 0x6e, 0x62, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x61, 0x6c, 0x6c, 0x42,
-	0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x42, 0x6e, 0x62, 0x12, 0x26, 0x0a, 0x0e, 0x61, 0x6c, 0x6c,
-	0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x42, 0x6e, 0x62, 0x18, 0x0d, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x0e, 0x61, 0x6c, 0x6c, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x42, 0x6e,
-	0x62, 0x12, 0x2a, 0x0a, 0x10, 0x74, 0x6f, 0x64, 0x61, 0x79, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72,
-	0x61, 0x77, 0x42, 0x6e, 0x62, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x74, 0x6f, 0x64,
-	0x61, 0x79, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x42, 0x6e, 0x62, 0x12, 0x28, 0x0a,
-	0x0f, 0x61, 0x6c, 0x6c, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x42, 0x6e, 0x62, 0x34,
-	0x18, 0x0f, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x61, 0x6c, 0x6c, 0x57, 0x69, 0x74, 0x68, 0x64,
-	0x72, 0x61, 0x77, 0x42, 0x6e, 0x62, 0x34, 0x12, 0x2c, 0x0a, 0x11, 0x74, 0x6f, 0x64, 0x61, 0x79,
-	0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x42, 0x6e, 0x62, 0x34, 0x18, 0x10, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x11, 0x74, 0x6f, 0x64, 0x61, 0x79, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61,
-	0x77, 0x42, 0x6e, 0x62, 0x34, 0x12, 0x26, 0x0a, 0x0e, 0x61, 0x6c, 0x6c, 0x42, 0x61, 0x6c, 0x61,
-	0x6e, 0x63, 0x65, 0x42, 0x6e, 0x62, 0x34, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x61,
-	0x6c, 0x6c, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x42, 0x6e, 0x62, 0x34, 0x22, 0x34, 0x0a,
-	0x19, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d,
-	0x65, 0x6e, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73,
-	0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x75, 0x73, 0x65,
-	0x72, 0x49, 0x64, 0x22, 0xbc, 0x01, 0x0a, 0x17, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x55, 0x73, 0x65,
-	0x72, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12,
-	0x37, 0x0a, 0x05, 0x75, 0x73, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x21,
-	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65,
-	0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x2e, 0x4c, 0x69, 0x73,
-	0x74, 0x52, 0x05, 0x75, 0x73, 0x65, 0x72, 0x73, 0x1a, 0x68, 0x0a, 0x04, 0x4c, 0x69, 0x73, 0x74,
-	0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28,
-	0x03, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64,
-	0x72, 0x65, 0x73, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72,
-	0x65, 0x73, 0x73, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52,
-	0x02, 0x69, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61,
-	0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64,
-	0x41, 0x74, 0x22, 0x4a, 0x0a, 0x1a, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x4d, 0x6f, 0x6e, 0x74, 0x68,
-	0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61,
-	0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x70, 0x61, 0x67, 0x65, 0x22, 0xe7,
-	0x01, 0x0a, 0x18, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x4d, 0x6f, 0x6e, 0x74, 0x68, 0x52, 0x65, 0x63,
-	0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x38, 0x0a, 0x05, 0x75,
-	0x73, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x61, 0x70, 0x69,
-	0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x4d, 0x6f, 0x6e, 0x74, 0x68, 0x52, 0x65, 0x63, 0x6f, 0x6d,
-	0x6d, 0x65, 0x6e, 0x64, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x05,
-	0x75, 0x73, 0x65, 0x72, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x1a, 0x7b, 0x0a, 0x04, 0x4c,
-	0x69, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x2a, 0x0a,
-	0x10, 0x72, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73,
-	0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x72, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65,
-	0x6e, 0x64, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x72, 0x65,
-	0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63,
-	0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0x40, 0x0a, 0x12, 0x41, 0x64, 0x6d, 0x69,
-	0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16,
-	0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06,
-	0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x70, 0x61, 0x67, 0x65, 0x22, 0x9e, 0x01, 0x0a, 0x10, 0x41,
-	0x64, 0x6d, 0x69, 0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12,
-	0x32, 0x0a, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
-	0x1a, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x69,
-	0x67, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x06, 0x63, 0x6f, 0x6e,
-	0x66, 0x69, 0x67, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x03, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x1a, 0x40, 0x0a, 0x04, 0x4c, 0x69, 0x73,
-	0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69,
-	0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x12, 0x0a, 0x10, 0x41,
-	0x64, 0x6d, 0x69, 0x6e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22,
-	0x76, 0x0a, 0x0e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6c,
-	0x79, 0x12, 0x32, 0x0a, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x03,
-	0x28, 0x0b, 0x32, 0x18, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x4c, 0x69,
-	0x73, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x07, 0x61, 0x63,
-	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x1a, 0x30, 0x0a, 0x04, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x0e, 0x0a,
-	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x18, 0x0a,
-	0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
-	0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x11, 0x0a, 0x0f, 0x41, 0x75, 0x74, 0x68, 0x4c,
-	0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x7c, 0x0a, 0x0d, 0x41, 0x75,
-	0x74, 0x68, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x2b, 0x0a, 0x04, 0x61,
-	0x75, 0x74, 0x68, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x61, 0x70, 0x69, 0x2e,
-	0x41, 0x75, 0x74, 0x68, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x2e, 0x4c, 0x69,
-	0x73, 0x74, 0x52, 0x04, 0x61, 0x75, 0x74, 0x68, 0x1a, 0x3e, 0x0a, 0x04, 0x4c, 0x69, 0x73, 0x74,
-	0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64,
-	0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
-	0x6e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x22, 0x30, 0x0a, 0x13, 0x55, 0x73, 0x65, 0x72,
-	0x41, 0x75, 0x74, 0x68, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
-	0x19, 0x0a, 0x08, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x03, 0x52, 0x07, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x49, 0x64, 0x22, 0x84, 0x01, 0x0a, 0x11, 0x55,
-	0x73, 0x65, 0x72, 0x41, 0x75, 0x74, 0x68, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79,
-	0x12, 0x2f, 0x0a, 0x04, 0x61, 0x75, 0x74, 0x68, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b,
-	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x41, 0x75, 0x74, 0x68, 0x4c, 0x69, 0x73,
-	0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x04, 0x61, 0x75, 0x74,
-	0x68, 0x1a, 0x3e, 0x0a, 0x04, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d,
-	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a,
-	0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74,
-	0x68, 0x22, 0x13, 0x0a, 0x11, 0x4d, 0x79, 0x41, 0x75, 0x74, 0x68, 0x4c, 0x69, 0x73, 0x74, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x96, 0x01, 0x0a, 0x0f, 0x4d, 0x79, 0x41, 0x75, 0x74,
-	0x68, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x2d, 0x0a, 0x04, 0x61, 0x75,
-	0x74, 0x68, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x4d,
-	0x79, 0x41, 0x75, 0x74, 0x68, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x2e, 0x4c,
-	0x69, 0x73, 0x74, 0x52, 0x04, 0x61, 0x75, 0x74, 0x68, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x75, 0x70,
-	0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x73, 0x75, 0x70, 0x65, 0x72, 0x1a,
-	0x3e, 0x0a, 0x04, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x70,
-	0x61, 0x74, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x22,
-	0x91, 0x01, 0x0a, 0x18, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x55,
-	0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x43, 0x0a, 0x09,
-	0x73, 0x65, 0x6e, 0x64, 0x5f, 0x62, 0x6f, 0x64, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x26, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x69,
-	0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x53,
-	0x65, 0x6e, 0x64, 0x42, 0x6f, 0x64, 0x79, 0x52, 0x08, 0x73, 0x65, 0x6e, 0x64, 0x42, 0x6f, 0x64,
-	0x79, 0x1a, 0x30, 0x0a, 0x08, 0x53, 0x65, 0x6e, 0x64, 0x42, 0x6f, 0x64, 0x79, 0x12, 0x0e, 0x0a,
-	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x14, 0x0a,
-	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61,
-	0x6c, 0x75, 0x65, 0x22, 0x18, 0x0a, 0x16, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x43, 0x6f, 0x6e, 0x66,
-	0x69, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x90, 0x01,
-	0x0a, 0x15, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x56, 0x69, 0x70, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x40, 0x0a, 0x09, 0x73, 0x65, 0x6e, 0x64, 0x5f,
-	0x62, 0x6f, 0x64, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x61, 0x70, 0x69,
-	0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x56, 0x69, 0x70, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x53, 0x65, 0x6e, 0x64, 0x42, 0x6f, 0x64, 0x79, 0x52,
-	0x08, 0x73, 0x65, 0x6e, 0x64, 0x42, 0x6f, 0x64, 0x79, 0x1a, 0x35, 0x0a, 0x08, 0x53, 0x65, 0x6e,
-	0x64, 0x42, 0x6f, 0x64, 0x79, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x10,
-	0x0a, 0x03, 0x76, 0x69, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x76, 0x69, 0x70,
-	0x22, 0x15, 0x0a, 0x13, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x56, 0x69, 0x70, 0x55, 0x70, 0x64, 0x61,
-	0x74, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x94, 0x01, 0x0a, 0x16, 0x41, 0x64, 0x6d, 0x69,
-	0x6e, 0x55, 0x6e, 0x64, 0x6f, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x12, 0x41, 0x0a, 0x09, 0x73, 0x65, 0x6e, 0x64, 0x5f, 0x62, 0x6f, 0x64, 0x79, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69,
-	0x6e, 0x55, 0x6e, 0x64, 0x6f, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x2e, 0x53, 0x65, 0x6e, 0x64, 0x42, 0x6f, 0x64, 0x79, 0x52, 0x08, 0x73, 0x65, 0x6e,
-	0x64, 0x42, 0x6f, 0x64, 0x79, 0x1a, 0x37, 0x0a, 0x08, 0x53, 0x65, 0x6e, 0x64, 0x42, 0x6f, 0x64,
-	0x79, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x03, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x6e,
-	0x64, 0x6f, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x75, 0x6e, 0x64, 0x6f, 0x22, 0x16,
-	0x0a, 0x14, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x55, 0x6e, 0x64, 0x6f, 0x55, 0x70, 0x64, 0x61, 0x74,
-	0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0xa0, 0x01, 0x0a, 0x1b, 0x41, 0x64, 0x6d, 0x69, 0x6e,
-	0x41, 0x72, 0x65, 0x61, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x46, 0x0a, 0x09, 0x73, 0x65, 0x6e, 0x64, 0x5f, 0x62,
-	0x6f, 0x64, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x61, 0x70, 0x69, 0x2e,
-	0x41, 0x64, 0x6d, 0x69, 0x6e, 0x41, 0x72, 0x65, 0x61, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x55, 0x70,
-	0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x53, 0x65, 0x6e, 0x64,
-	0x42, 0x6f, 0x64, 0x79, 0x52, 0x08, 0x73, 0x65, 0x6e, 0x64, 0x42, 0x6f, 0x64, 0x79, 0x1a, 0x39,
-	0x0a, 0x08, 0x53, 0x65, 0x6e, 0x64, 0x42, 0x6f, 0x64, 0x79, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73,
-	0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x75, 0x73, 0x65,
-	0x72, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x03, 0x52, 0x05, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x22, 0x1b, 0x0a, 0x19, 0x41, 0x64, 0x6d,
-	0x69, 0x6e, 0x41, 0x72, 0x65, 0x61, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x55, 0x70, 0x64, 0x61, 0x74,
-	0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0xa0, 0x01, 0x0a, 0x1a, 0x41, 0x64, 0x6d, 0x69, 0x6e,
-	0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x73, 0x65, 0x72, 0x74, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x45, 0x0a, 0x09, 0x73, 0x65, 0x6e, 0x64, 0x5f, 0x62, 0x6f,
-	0x64, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41,
-	0x64, 0x6d, 0x69, 0x6e, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x73, 0x65,
-	0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x53, 0x65, 0x6e, 0x64, 0x42, 0x6f,
-	0x64, 0x79, 0x52, 0x08, 0x73, 0x65, 0x6e, 0x64, 0x42, 0x6f, 0x64, 0x79, 0x1a, 0x3b, 0x0a, 0x08,
-	0x53, 0x65, 0x6e, 0x64, 0x42, 0x6f, 0x64, 0x79, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72,
-	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49,
-	0x64, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x03, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x1a, 0x0a, 0x18, 0x41, 0x64, 0x6d,
-	0x69, 0x6e, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x73, 0x65, 0x72, 0x74,
-	0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x9e, 0x01, 0x0a, 0x19, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x42,
-	0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x12, 0x44, 0x0a, 0x09, 0x73, 0x65, 0x6e, 0x64, 0x5f, 0x62, 0x6f, 0x64, 0x79,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d,
-	0x69, 0x6e, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x53, 0x65, 0x6e, 0x64, 0x42, 0x6f, 0x64, 0x79, 0x52,
-	0x08, 0x73, 0x65, 0x6e, 0x64, 0x42, 0x6f, 0x64, 0x79, 0x1a, 0x3b, 0x0a, 0x08, 0x53, 0x65, 0x6e,
-	0x64, 0x42, 0x6f, 0x64, 0x79, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x16,
-	0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
-	0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x19, 0x0a, 0x17, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x42,
-	0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x70, 0x6c,
-	0x79, 0x22, 0x9b, 0x01, 0x0a, 0x16, 0x41, 0x75, 0x74, 0x68, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x43,
-	0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x41, 0x0a, 0x09,
-	0x73, 0x65, 0x6e, 0x64, 0x5f, 0x62, 0x6f, 0x64, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x24, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x43,
-	0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x53, 0x65, 0x6e,
-	0x64, 0x42, 0x6f, 0x64, 0x79, 0x52, 0x08, 0x73, 0x65, 0x6e, 0x64, 0x42, 0x6f, 0x64, 0x79, 0x1a,
-	0x3e, 0x0a, 0x08, 0x53, 0x65, 0x6e, 0x64, 0x42, 0x6f, 0x64, 0x79, 0x12, 0x19, 0x0a, 0x08, 0x61,
-	0x64, 0x6d, 0x69, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x61,
-	0x64, 0x6d, 0x69, 0x6e, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x61, 0x75, 0x74, 0x68, 0x5f, 0x69,
-	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x61, 0x75, 0x74, 0x68, 0x49, 0x64, 0x22,
-	0x16, 0x0a, 0x14, 0x41, 0x75, 0x74, 0x68, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x43, 0x72, 0x65, 0x61,
-	0x74, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x9b, 0x01, 0x0a, 0x16, 0x41, 0x75, 0x74, 0x68,
-	0x41, 0x64, 0x6d, 0x69, 0x6e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x12, 0x41, 0x0a, 0x09, 0x73, 0x65, 0x6e, 0x64, 0x5f, 0x62, 0x6f, 0x64, 0x79, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x75, 0x74, 0x68,
-	0x41, 0x64, 0x6d, 0x69, 0x6e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x2e, 0x53, 0x65, 0x6e, 0x64, 0x42, 0x6f, 0x64, 0x79, 0x52, 0x08, 0x73, 0x65, 0x6e,
-	0x64, 0x42, 0x6f, 0x64, 0x79, 0x1a, 0x3e, 0x0a, 0x08, 0x53, 0x65, 0x6e, 0x64, 0x42, 0x6f, 0x64,
-	0x79, 0x12, 0x19, 0x0a, 0x08, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x03, 0x52, 0x07, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07,
-	0x61, 0x75, 0x74, 0x68, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x61,
-	0x75, 0x74, 0x68, 0x49, 0x64, 0x22, 0x16, 0x0a, 0x14, 0x41, 0x75, 0x74, 0x68, 0x41, 0x64, 0x6d,
-	0x69, 0x6e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x24, 0x0a,
-	0x22, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x41, 0x6e, 0x64, 0x49, 0x6e, 0x73, 0x65, 0x72, 0x74, 0x52,
-	0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x41, 0x72, 0x65, 0x61, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x22, 0x22, 0x0a, 0x20, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x41, 0x6e, 0x64, 0x49,
-	0x6e, 0x73, 0x65, 0x72, 0x74, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x41, 0x72,
-	0x65, 0x61, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x34, 0x0a, 0x20, 0x41, 0x64, 0x6d, 0x69, 0x6e,
-	0x44, 0x61, 0x69, 0x6c, 0x79, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x52, 0x65,
-	0x77, 0x61, 0x72, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x64,
-	0x61, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x03, 0x64, 0x61, 0x79, 0x22, 0x20, 0x0a,
-	0x1e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x44, 0x61, 0x69, 0x6c, 0x79, 0x52, 0x65, 0x63, 0x6f, 0x6d,
-	0x6d, 0x65, 0x6e, 0x64, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22,
-	0x37, 0x0a, 0x23, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x44, 0x61, 0x69, 0x6c, 0x79, 0x52, 0x65, 0x63,
-	0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x54, 0x6f, 0x70, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x64, 0x61, 0x79, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x05, 0x52, 0x03, 0x64, 0x61, 0x79, 0x22, 0x23, 0x0a, 0x21, 0x41, 0x64, 0x6d, 0x69,
-	0x6e, 0x44, 0x61, 0x69, 0x6c, 0x79, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x54,
-	0x6f, 0x70, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x33, 0x0a,
-	0x1f, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x44, 0x61, 0x69, 0x6c, 0x79, 0x57, 0x69, 0x74, 0x68, 0x64,
-	0x72, 0x61, 0x77, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x12, 0x10, 0x0a, 0x03, 0x64, 0x61, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x03, 0x64,
-	0x61, 0x79, 0x22, 0x1f, 0x0a, 0x1d, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x44, 0x61, 0x69, 0x6c, 0x79,
-	0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x52, 0x65,
-	0x70, 0x6c, 0x79, 0x22, 0x36, 0x0a, 0x10, 0x46, 0x69, 0x78, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x69, 0x64, 0x31, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x69, 0x64, 0x31, 0x12, 0x10, 0x0a, 0x03, 0x69, 0x64, 0x32,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x69, 0x64, 0x32, 0x22, 0x10, 0x0a, 0x0e, 0x46,
-	0x69, 0x78, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x15, 0x0a,
-	0x13, 0x46, 0x69, 0x78, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x22, 0x13, 0x0a, 0x11, 0x46, 0x69, 0x78, 0x4c, 0x6f, 0x63, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x93, 0x01, 0x0a, 0x11, 0x41, 0x64,
-	0x6d, 0x69, 0x6e, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
-	0x3c, 0x0a, 0x09, 0x73, 0x65, 0x6e, 0x64, 0x5f, 0x62, 0x6f, 0x64, 0x79, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x4c, 0x6f,
-	0x67, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x53, 0x65, 0x6e, 0x64, 0x42,
-	0x6f, 0x64, 0x79, 0x52, 0x08, 0x73, 0x65, 0x6e, 0x64, 0x42, 0x6f, 0x64, 0x79, 0x1a, 0x40, 0x0a,
-	0x08, 0x53, 0x65, 0x6e, 0x64, 0x42, 0x6f, 0x64, 0x79, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x63, 0x63,
-	0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x63, 0x63, 0x6f,
-	0x75, 0x6e, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x22,
-	0x27, 0x0a, 0x0f, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x70,
-	0x6c, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0xa5, 0x01, 0x0a, 0x1a, 0x41, 0x64, 0x6d,
-	0x69, 0x6e, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x45, 0x0a, 0x09, 0x73, 0x65, 0x6e, 0x64, 0x5f,
-	0x62, 0x6f, 0x64, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x61, 0x70, 0x69,
-	0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x50, 0x61, 0x73, 0x73,
-	0x77, 0x6f, 0x72, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x53, 0x65, 0x6e, 0x64,
-	0x42, 0x6f, 0x64, 0x79, 0x52, 0x08, 0x73, 0x65, 0x6e, 0x64, 0x42, 0x6f, 0x64, 0x79, 0x1a, 0x40,
-	0x0a, 0x08, 0x53, 0x65, 0x6e, 0x64, 0x42, 0x6f, 0x64, 0x79, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x63,
-	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x63, 0x63,
-	0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64,
-	0x22, 0x1a, 0x0a, 0x18, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x50,
-	0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0xa3, 0x01, 0x0a,
-	0x19, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x63, 0x63, 0x6f,
-	0x75, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x44, 0x0a, 0x09, 0x73, 0x65,
-	0x6e, 0x64, 0x5f, 0x62, 0x6f, 0x64, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e,
-	0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41,
-	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x53, 0x65,
-	0x6e, 0x64, 0x42, 0x6f, 0x64, 0x79, 0x52, 0x08, 0x73, 0x65, 0x6e, 0x64, 0x42, 0x6f, 0x64, 0x79,
-	0x1a, 0x40, 0x0a, 0x08, 0x53, 0x65, 0x6e, 0x64, 0x42, 0x6f, 0x64, 0x79, 0x12, 0x18, 0x0a, 0x07,
-	0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61,
-	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f,
-	0x72, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f,
-	0x72, 0x64, 0x22, 0x19, 0x0a, 0x17, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x43, 0x72, 0x65, 0x61, 0x74,
-	0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x32, 0xf6, 0x2b,
-	0x0a, 0x03, 0x41, 0x70, 0x70, 0x12, 0x57, 0x0a, 0x08, 0x55, 0x73, 0x65, 0x72, 0x49, 0x6e, 0x66,
-	0x6f, 0x12, 0x14, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x12, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x55, 0x73,
-	0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x21, 0x82, 0xd3, 0xe4,
-	0x93, 0x02, 0x1b, 0x12, 0x19, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x70, 0x70, 0x5f, 0x73, 0x65,
-	0x72, 0x76, 0x65, 0x72, 0x2f, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x12, 0x5f,
-	0x0a, 0x0a, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x16, 0x2e, 0x61,
-	0x70, 0x69, 0x2e, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x14, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x52, 0x65, 0x77, 0x61, 0x72,
-	0x64, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x23, 0x82, 0xd3, 0xe4, 0x93,
-	0x02, 0x1d, 0x12, 0x1b, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x70, 0x70, 0x5f, 0x73, 0x65, 0x72,
-	0x76, 0x65, 0x72, 0x2f, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x12,
-	0x84, 0x01, 0x0a, 0x13, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x52, 0x65, 0x77,
-	0x61, 0x72, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x1f, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x52, 0x65,
-	0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x4c, 0x69, 0x73,
-	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x52,
-	0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x4c, 0x69,
-	0x73, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x2d, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x27, 0x12,
-	0x25, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x70, 0x70, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72,
-	0x2f, 0x72, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x5f, 0x72, 0x65, 0x77, 0x61, 0x72,
-	0x64, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x12, 0x6c, 0x0a, 0x0d, 0x46, 0x65, 0x65, 0x52, 0x65, 0x77,
-	0x61, 0x72, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x19, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x46, 0x65,
-	0x65, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x17, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x46, 0x65, 0x65, 0x52, 0x65, 0x77, 0x61,
-	0x72, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x27, 0x82, 0xd3, 0xe4,
-	0x93, 0x02, 0x21, 0x12, 0x1f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x70, 0x70, 0x5f, 0x73, 0x65,
-	0x72, 0x76, 0x65, 0x72, 0x2f, 0x66, 0x65, 0x65, 0x5f, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x5f,
-	0x6c, 0x69, 0x73, 0x74, 0x12, 0x67, 0x0a, 0x0c, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77,
-	0x4c, 0x69, 0x73, 0x74, 0x12, 0x18, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x57, 0x69, 0x74, 0x68, 0x64,
-	0x72, 0x61, 0x77, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16,
-	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x4c, 0x69, 0x73,
-	0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x25, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1f, 0x12, 0x1d,
-	0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x70, 0x70, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2f,
-	0x77, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x12, 0x6b, 0x0a,
-	0x0d, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x19,
-	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x4c, 0x69,
-	0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x61, 0x70, 0x69, 0x2e,
-	0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x70,
-	0x6c, 0x79, 0x22, 0x26, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x20, 0x12, 0x1e, 0x2f, 0x61, 0x70, 0x69,
-	0x2f, 0x61, 0x70, 0x70, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2f, 0x72, 0x65, 0x63, 0x6f,
-	0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x12, 0x61, 0x0a, 0x08, 0x57, 0x69,
-	0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x12, 0x14, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x57, 0x69, 0x74,
-	0x68, 0x64, 0x72, 0x61, 0x77, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x12, 0x2e, 0x61,
-	0x70, 0x69, 0x2e, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x52, 0x65, 0x70, 0x6c, 0x79,
-	0x22, 0x2b, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x25, 0x22, 0x18, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61,
-	0x70, 0x70, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2f, 0x77, 0x69, 0x74, 0x68, 0x64, 0x72,
-	0x61, 0x77, 0x3a, 0x09, 0x73, 0x65, 0x6e, 0x64, 0x5f, 0x62, 0x6f, 0x64, 0x79, 0x12, 0x51, 0x0a,
-	0x07, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x12, 0x13, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x44,
-	0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x11, 0x2e,
-	0x61, 0x70, 0x69, 0x2e, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79,
-	0x22, 0x1e, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x18, 0x12, 0x16, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61,
-	0x64, 0x6d, 0x69, 0x6e, 0x5f, 0x64, 0x68, 0x62, 0x2f, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74,
-	0x12, 0x6d, 0x0a, 0x0f, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x4c,
-	0x69, 0x73, 0x74, 0x12, 0x1b, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x52,
-	0x65, 0x77, 0x61, 0x72, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x19, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x52, 0x65, 0x77, 0x61,
-	0x72, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x22, 0x82, 0xd3, 0xe4,
-	0x93, 0x02, 0x1c, 0x12, 0x1a, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x5f,
-	0x64, 0x68, 0x62, 0x2f, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x12,
-	0x7a, 0x0a, 0x12, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x42, 0x6e,
-	0x62, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x1e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69,
-	0x6e, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x42, 0x6e, 0x62, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69,
-	0x6e, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x42, 0x6e, 0x62, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65,
-	0x70, 0x6c, 0x79, 0x22, 0x26, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x20, 0x12, 0x1e, 0x2f, 0x61, 0x70,
-	0x69, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x5f, 0x64, 0x68, 0x62, 0x2f, 0x72, 0x65, 0x77, 0x61,
-	0x72, 0x64, 0x5f, 0x62, 0x6e, 0x62, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x12, 0x65, 0x0a, 0x0d, 0x41,
-	0x64, 0x6d, 0x69, 0x6e, 0x55, 0x73, 0x65, 0x72, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x19, 0x2e, 0x61,
-	0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x55, 0x73, 0x65, 0x72, 0x4c, 0x69, 0x73, 0x74,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64,
-	0x6d, 0x69, 0x6e, 0x55, 0x73, 0x65, 0x72, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79,
-	0x22, 0x20, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1a, 0x12, 0x18, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61,
-	0x64, 0x6d, 0x69, 0x6e, 0x5f, 0x64, 0x68, 0x62, 0x2f, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x6c, 0x69,
-	0x73, 0x74, 0x12, 0x80, 0x01, 0x0a, 0x12, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x41, 0x64, 0x6d, 0x69,
-	0x6e, 0x55, 0x73, 0x65, 0x72, 0x41, 0x72, 0x65, 0x61, 0x12, 0x1e, 0x2e, 0x61, 0x70, 0x69, 0x2e,
-	0x43, 0x68, 0x65, 0x63, 0x6b, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x55, 0x73, 0x65, 0x72, 0x41, 0x72,
-	0x65, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x61, 0x70, 0x69, 0x2e,
-	0x43, 0x68, 0x65, 0x63, 0x6b, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x55, 0x73, 0x65, 0x72, 0x41, 0x72,
-	0x65, 0x61, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x2c, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x26, 0x12,
-	0x24, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x5f, 0x64, 0x68, 0x62, 0x2f,
-	0x63, 0x68, 0x65, 0x63, 0x6b, 0x5f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x5f, 0x75, 0x73, 0x65, 0x72,
-	0x5f, 0x61, 0x72, 0x65, 0x61, 0x12, 0xca, 0x01, 0x0a, 0x24, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x41,
-	0x6e, 0x64, 0x49, 0x6e, 0x73, 0x65, 0x72, 0x74, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x73, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x55, 0x73, 0x65, 0x72, 0x12, 0x30,
-	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x41, 0x6e, 0x64, 0x49, 0x6e, 0x73,
-	0x65, 0x72, 0x74, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x63, 0x6f,
-	0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x2e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x41, 0x6e, 0x64, 0x49,
-	0x6e, 0x73, 0x65, 0x72, 0x74, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65,
-	0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x70, 0x6c, 0x79,
-	0x22, 0x40, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x3a, 0x12, 0x38, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61,
-	0x64, 0x6d, 0x69, 0x6e, 0x5f, 0x64, 0x68, 0x62, 0x2f, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x5f, 0x61,
-	0x6e, 0x64, 0x5f, 0x69, 0x6e, 0x73, 0x65, 0x72, 0x74, 0x5f, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x73, 0x5f, 0x72, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x5f, 0x75, 0x73,
-	0x65, 0x72, 0x12, 0x83, 0x01, 0x0a, 0x13, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x52, 0x65, 0x63,
-	0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x55, 0x73, 0x65, 0x72, 0x12, 0x1f, 0x2e, 0x61, 0x70, 0x69,
-	0x2e, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64,
-	0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x61, 0x70,
-	0x69, 0x2e, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e,
-	0x64, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x2c, 0x82, 0xd3, 0xe4, 0x93,
-	0x02, 0x26, 0x12, 0x24, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x5f, 0x64,
-	0x68, 0x62, 0x2f, 0x75, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x5f, 0x72, 0x65, 0x63, 0x6f, 0x6d, 0x6d,
-	0x65, 0x6e, 0x64, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x12, 0x75, 0x0a, 0x11, 0x41, 0x64, 0x6d, 0x69,
-	0x6e, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x1d, 0x2e,
-	0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x61,
-	0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x24, 0x82, 0xd3, 0xe4, 0x93, 0x02,
-	0x1e, 0x12, 0x1c, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x5f, 0x64, 0x68,
-	0x62, 0x2f, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x12,
-	0x82, 0x01, 0x0a, 0x14, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x41, 0x6c, 0x6c, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x20, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41,
-	0x64, 0x6d, 0x69, 0x6e, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x6c, 0x6c, 0x4c,
-	0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x61, 0x70, 0x69,
-	0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x6c,
-	0x6c, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x28, 0x82, 0xd3, 0xe4, 0x93,
-	0x02, 0x22, 0x12, 0x20, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x5f, 0x64,
-	0x68, 0x62, 0x2f, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x61, 0x6c, 0x6c, 0x5f,
-	0x6c, 0x69, 0x73, 0x74, 0x12, 0x75, 0x0a, 0x11, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x57, 0x69, 0x74,
-	0x68, 0x64, 0x72, 0x61, 0x77, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x1d, 0x2e, 0x61, 0x70, 0x69, 0x2e,
-	0x41, 0x64, 0x6d, 0x69, 0x6e, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x4c, 0x69, 0x73,
-	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41,
-	0x64, 0x6d, 0x69, 0x6e, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x4c, 0x69, 0x73, 0x74,
-	0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x24, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1e, 0x12, 0x1c, 0x2f,
-	0x61, 0x70, 0x69, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x5f, 0x64, 0x68, 0x62, 0x2f, 0x77, 0x69,
-	0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x12, 0x64, 0x0a, 0x0d, 0x41,
-	0x64, 0x6d, 0x69, 0x6e, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x12, 0x19, 0x2e, 0x61,
-	0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64,
-	0x6d, 0x69, 0x6e, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x52, 0x65, 0x70, 0x6c, 0x79,
-	0x22, 0x1f, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x19, 0x12, 0x17, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61,
-	0x64, 0x6d, 0x69, 0x6e, 0x5f, 0x64, 0x68, 0x62, 0x2f, 0x77, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61,
-	0x77, 0x12, 0xa3, 0x01, 0x0a, 0x1c, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x57, 0x69, 0x74, 0x68, 0x64,
-	0x72, 0x61, 0x77, 0x44, 0x6f, 0x69, 0x6e, 0x67, 0x54, 0x6f, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64,
-	0x65, 0x64, 0x12, 0x28, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x57, 0x69,
-	0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x44, 0x6f, 0x69, 0x6e, 0x67, 0x54, 0x6f, 0x52, 0x65, 0x77,
-	0x61, 0x72, 0x64, 0x65, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x61,
-	0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77,
-	0x44, 0x6f, 0x69, 0x6e, 0x67, 0x54, 0x6f, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x65, 0x64, 0x52,
-	0x65, 0x70, 0x6c, 0x79, 0x22, 0x31, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2b, 0x12, 0x29, 0x2f, 0x61,
-	0x70, 0x69, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x5f, 0x64, 0x68, 0x62, 0x2f, 0x77, 0x69, 0x74,
-	0x68, 0x64, 0x72, 0x61, 0x77, 0x5f, 0x64, 0x6f, 0x69, 0x6e, 0x67, 0x5f, 0x74, 0x6f, 0x5f, 0x72,
-	0x65, 0x77, 0x61, 0x72, 0x64, 0x65, 0x64, 0x12, 0x71, 0x0a, 0x10, 0x41, 0x64, 0x6d, 0x69, 0x6e,
-	0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x45, 0x74, 0x68, 0x12, 0x1c, 0x2e, 0x61, 0x70,
-	0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x45,
-	0x74, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x61, 0x70, 0x69, 0x2e,
-	0x41, 0x64, 0x6d, 0x69, 0x6e, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x45, 0x74, 0x68,
-	0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x23, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1d, 0x12, 0x1b, 0x2f,
-	0x61, 0x70, 0x69, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x5f, 0x64, 0x68, 0x62, 0x2f, 0x77, 0x69,
-	0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x5f, 0x65, 0x74, 0x68, 0x12, 0x50, 0x0a, 0x08, 0x41, 0x64,
-	0x6d, 0x69, 0x6e, 0x46, 0x65, 0x65, 0x12, 0x14, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d,
-	0x69, 0x6e, 0x46, 0x65, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x12, 0x2e, 0x61,
-	0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x46, 0x65, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79,
-	0x22, 0x1a, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x14, 0x12, 0x12, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61,
-	0x64, 0x6d, 0x69, 0x6e, 0x5f, 0x64, 0x68, 0x62, 0x2f, 0x66, 0x65, 0x65, 0x12, 0x65, 0x0a, 0x0d,
-	0x41, 0x64, 0x6d, 0x69, 0x6e, 0x44, 0x61, 0x69, 0x6c, 0x79, 0x46, 0x65, 0x65, 0x12, 0x19, 0x2e,
-	0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x44, 0x61, 0x69, 0x6c, 0x79, 0x46, 0x65,
-	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41,
-	0x64, 0x6d, 0x69, 0x6e, 0x44, 0x61, 0x69, 0x6c, 0x79, 0x46, 0x65, 0x65, 0x52, 0x65, 0x70, 0x6c,
-	0x79, 0x22, 0x20, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1a, 0x12, 0x18, 0x2f, 0x61, 0x70, 0x69, 0x2f,
-	0x61, 0x64, 0x6d, 0x69, 0x6e, 0x5f, 0x64, 0x68, 0x62, 0x2f, 0x64, 0x61, 0x69, 0x6c, 0x79, 0x5f,
-	0x66, 0x65, 0x65, 0x12, 0x50, 0x0a, 0x08, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x41, 0x6c, 0x6c, 0x12,
-	0x14, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x41, 0x6c, 0x6c, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x12, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69,
-	0x6e, 0x41, 0x6c, 0x6c, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x1a, 0x82, 0xd3, 0xe4, 0x93, 0x02,
-	0x14, 0x12, 0x12, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x5f, 0x64, 0x68,
-	0x62, 0x2f, 0x61, 0x6c, 0x6c, 0x12, 0x79, 0x0a, 0x12, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x55, 0x73,
-	0x65, 0x72, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x12, 0x1e, 0x2e, 0x61, 0x70,
-	0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x63, 0x6f, 0x6d,
-	0x6d, 0x65, 0x6e, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x61, 0x70,
-	0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x63, 0x6f, 0x6d,
-	0x6d, 0x65, 0x6e, 0x64, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x25, 0x82, 0xd3, 0xe4, 0x93, 0x02,
-	0x1f, 0x12, 0x1d, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x5f, 0x64, 0x68,
-	0x62, 0x2f, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x72, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64,
-	0x12, 0x7d, 0x0a, 0x13, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x4d, 0x6f, 0x6e, 0x74, 0x68, 0x52, 0x65,
-	0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x12, 0x1f, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64,
-	0x6d, 0x69, 0x6e, 0x4d, 0x6f, 0x6e, 0x74, 0x68, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e,
-	0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41,
-	0x64, 0x6d, 0x69, 0x6e, 0x4d, 0x6f, 0x6e, 0x74, 0x68, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65,
-	0x6e, 0x64, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x26, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x20, 0x12,
-	0x1e, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x5f, 0x64, 0x68, 0x62, 0x2f,
-	0x6d, 0x6f, 0x6e, 0x74, 0x68, 0x5f, 0x72, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x12,
-	0x5c, 0x0a, 0x0b, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x17,
-	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64,
-	0x6d, 0x69, 0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x1d,
-	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x17, 0x12, 0x15, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x64, 0x6d,
-	0x69, 0x6e, 0x5f, 0x64, 0x68, 0x62, 0x2f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x80, 0x01,
-	0x0a, 0x11, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x55, 0x70, 0x64,
-	0x61, 0x74, 0x65, 0x12, 0x1d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x43,
-	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x43, 0x6f,
-	0x6e, 0x66, 0x69, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22,
-	0x2f, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x29, 0x22, 0x1c, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x64,
-	0x6d, 0x69, 0x6e, 0x5f, 0x64, 0x68, 0x62, 0x2f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x5f, 0x75,
-	0x70, 0x64, 0x61, 0x74, 0x65, 0x3a, 0x09, 0x73, 0x65, 0x6e, 0x64, 0x5f, 0x62, 0x6f, 0x64, 0x79,
-	0x12, 0x74, 0x0a, 0x0e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x56, 0x69, 0x70, 0x55, 0x70, 0x64, 0x61,
-	0x74, 0x65, 0x12, 0x1a, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x56, 0x69,
-	0x70, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18,
-	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x56, 0x69, 0x70, 0x55, 0x70, 0x64,
-	0x61, 0x74, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x2c, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x26,
-	0x22, 0x19, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x5f, 0x64, 0x68, 0x62,
-	0x2f, 0x76, 0x69, 0x70, 0x5f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x3a, 0x09, 0x73, 0x65, 0x6e,
-	0x64, 0x5f, 0x62, 0x6f, 0x64, 0x79, 0x12, 0x78, 0x0a, 0x0f, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x55,
-	0x6e, 0x64, 0x6f, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x12, 0x1b, 0x2e, 0x61, 0x70, 0x69, 0x2e,
-	0x41, 0x64, 0x6d, 0x69, 0x6e, 0x55, 0x6e, 0x64, 0x6f, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d,
-	0x69, 0x6e, 0x55, 0x6e, 0x64, 0x6f, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x70, 0x6c,
-	0x79, 0x22, 0x2d, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x27, 0x22, 0x1a, 0x2f, 0x61, 0x70, 0x69, 0x2f,
-	0x61, 0x64, 0x6d, 0x69, 0x6e, 0x5f, 0x64, 0x68, 0x62, 0x2f, 0x75, 0x6e, 0x64, 0x6f, 0x5f, 0x75,
-	0x70, 0x64, 0x61, 0x74, 0x65, 0x3a, 0x09, 0x73, 0x65, 0x6e, 0x64, 0x5f, 0x62, 0x6f, 0x64, 0x79,
-	0x12, 0x88, 0x01, 0x0a, 0x14, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x41, 0x72, 0x65, 0x61, 0x4c, 0x65,
-	0x76, 0x65, 0x6c, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x12, 0x20, 0x2e, 0x61, 0x70, 0x69, 0x2e,
-	0x41, 0x64, 0x6d, 0x69, 0x6e, 0x41, 0x72, 0x65, 0x61, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x55, 0x70,
-	0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x61, 0x70,
-	0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x41, 0x72, 0x65, 0x61, 0x4c, 0x65, 0x76, 0x65, 0x6c,
-	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x2e, 0x82, 0xd3, 0xe4,
-	0x93, 0x02, 0x28, 0x22, 0x1b, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x5f,
-	0x64, 0x68, 0x62, 0x2f, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x5f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65,
-	0x3a, 0x09, 0x73, 0x65, 0x6e, 0x64, 0x5f, 0x62, 0x6f, 0x64, 0x79, 0x12, 0x88, 0x01, 0x0a, 0x13,
-	0x41, 0x64, 0x6d, 0x69, 0x6e, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x73,
-	0x65, 0x72, 0x74, 0x12, 0x1f, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x4c,
-	0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x73, 0x65, 0x72, 0x74, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e,
-	0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x73, 0x65, 0x72, 0x74, 0x52, 0x65,
-	0x70, 0x6c, 0x79, 0x22, 0x31, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2b, 0x22, 0x1e, 0x2f, 0x61, 0x70,
-	0x69, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x5f, 0x64, 0x68, 0x62, 0x2f, 0x6c, 0x6f, 0x63, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x6e, 0x73, 0x65, 0x72, 0x74, 0x3a, 0x09, 0x73, 0x65, 0x6e,
-	0x64, 0x5f, 0x62, 0x6f, 0x64, 0x79, 0x12, 0x84, 0x01, 0x0a, 0x12, 0x41, 0x64, 0x6d, 0x69, 0x6e,
-	0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x12, 0x1e, 0x2e,
-	0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65,
-	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e,
-	0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65,
-	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x30, 0x82, 0xd3, 0xe4,
-	0x93, 0x02, 0x2a, 0x22, 0x1d, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x5f,
-	0x64, 0x68, 0x62, 0x2f, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x75, 0x70, 0x64, 0x61,
-	0x74, 0x65, 0x3a, 0x09, 0x73, 0x65, 0x6e, 0x64, 0x5f, 0x62, 0x6f, 0x64, 0x79, 0x12, 0x63, 0x0a,
-	0x0a, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x12, 0x16, 0x2e, 0x61, 0x70,
-	0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x14, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x4c,
-	0x6f, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x27, 0x82, 0xd3, 0xe4, 0x93, 0x02,
-	0x21, 0x22, 0x14, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x5f, 0x64, 0x68,
-	0x62, 0x2f, 0x6c, 0x6f, 0x67, 0x69, 0x6e, 0x3a, 0x09, 0x73, 0x65, 0x6e, 0x64, 0x5f, 0x62, 0x6f,
-	0x64, 0x79, 0x12, 0x84, 0x01, 0x0a, 0x12, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x43, 0x72, 0x65, 0x61,
-	0x74, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1e, 0x2e, 0x61, 0x70, 0x69, 0x2e,
-	0x41, 0x64, 0x6d, 0x69, 0x6e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75,
-	0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x61, 0x70, 0x69, 0x2e,
-	0x41, 0x64, 0x6d, 0x69, 0x6e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75,
-	0x6e, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x30, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2a, 0x22,
-	0x1d, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x5f, 0x64, 0x68, 0x62, 0x2f,
-	0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x5f, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x3a, 0x09,
-	0x73, 0x65, 0x6e, 0x64, 0x5f, 0x62, 0x6f, 0x64, 0x79, 0x12, 0x88, 0x01, 0x0a, 0x13, 0x41, 0x64,
-	0x6d, 0x69, 0x6e, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72,
-	0x64, 0x12, 0x1f, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x43, 0x68, 0x61,
-	0x6e, 0x67, 0x65, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x43, 0x68,
-	0x61, 0x6e, 0x67, 0x65, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x52, 0x65, 0x70, 0x6c,
-	0x79, 0x22, 0x31, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2b, 0x22, 0x1e, 0x2f, 0x61, 0x70, 0x69, 0x2f,
-	0x61, 0x64, 0x6d, 0x69, 0x6e, 0x5f, 0x64, 0x68, 0x62, 0x2f, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65,
-	0x5f, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x3a, 0x09, 0x73, 0x65, 0x6e, 0x64, 0x5f,
-	0x62, 0x6f, 0x64, 0x79, 0x12, 0x5a, 0x0a, 0x09, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x4c, 0x69, 0x73,
-	0x74, 0x12, 0x15, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x4c, 0x69, 0x73,
-	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x13, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41,
-	0x64, 0x6d, 0x69, 0x6e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x21, 0x82,
-	0xd3, 0xe4, 0x93, 0x02, 0x1b, 0x12, 0x19, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x64, 0x6d, 0x69,
-	0x6e, 0x5f, 0x64, 0x68, 0x62, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x5f, 0x6c, 0x69, 0x73, 0x74,
-	0x12, 0x56, 0x0a, 0x08, 0x41, 0x75, 0x74, 0x68, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x14, 0x2e, 0x61,
-	0x70, 0x69, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x12, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x4c, 0x69, 0x73,
-	0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x20, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1a, 0x12, 0x18,
-	0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x5f, 0x64, 0x68, 0x62, 0x2f, 0x61,
-	0x75, 0x74, 0x68, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x12, 0x5f, 0x0a, 0x0a, 0x4d, 0x79, 0x41, 0x75,
-	0x74, 0x68, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x16, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x4d, 0x79, 0x41,
-	0x75, 0x74, 0x68, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x14,
-	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x4d, 0x79, 0x41, 0x75, 0x74, 0x68, 0x4c, 0x69, 0x73, 0x74, 0x52,
-	0x65, 0x70, 0x6c, 0x79, 0x22, 0x23, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1d, 0x12, 0x1b, 0x2f, 0x61,
-	0x70, 0x69, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x5f, 0x64, 0x68, 0x62, 0x2f, 0x6d, 0x79, 0x5f,
-	0x61, 0x75, 0x74, 0x68, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x12, 0x67, 0x0a, 0x0c, 0x55, 0x73, 0x65,
-	0x72, 0x41, 0x75, 0x74, 0x68, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x18, 0x2e, 0x61, 0x70, 0x69, 0x2e,
-	0x55, 0x73, 0x65, 0x72, 0x41, 0x75, 0x74, 0x68, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x41, 0x75,
-	0x74, 0x68, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x25, 0x82, 0xd3, 0xe4,
-	0x93, 0x02, 0x1f, 0x12, 0x1d, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x5f,
-	0x64, 0x68, 0x62, 0x2f, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x61, 0x75, 0x74, 0x68, 0x5f, 0x6c, 0x69,
-	0x73, 0x74, 0x12, 0x78, 0x0a, 0x0f, 0x41, 0x75, 0x74, 0x68, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x43,
-	0x72, 0x65, 0x61, 0x74, 0x65, 0x12, 0x1b, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x75, 0x74, 0x68,
-	0x41, 0x64, 0x6d, 0x69, 0x6e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x19, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x41, 0x64, 0x6d,
-	0x69, 0x6e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x2d, 0x82,
-	0xd3, 0xe4, 0x93, 0x02, 0x27, 0x22, 0x1a, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x64, 0x6d, 0x69,
-	0x6e, 0x5f, 0x64, 0x68, 0x62, 0x2f, 0x61, 0x75, 0x74, 0x68, 0x5f, 0x63, 0x72, 0x65, 0x61, 0x74,
-	0x65, 0x3a, 0x09, 0x73, 0x65, 0x6e, 0x64, 0x5f, 0x62, 0x6f, 0x64, 0x79, 0x12, 0x78, 0x0a, 0x0f,
-	0x41, 0x75, 0x74, 0x68, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x12,
-	0x1b, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x44,
-	0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x61,
-	0x70, 0x69, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x44, 0x65, 0x6c, 0x65,
-	0x74, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x2d, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x27, 0x22,
-	0x1a, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x5f, 0x64, 0x68, 0x62, 0x2f,
-	0x61, 0x75, 0x74, 0x68, 0x5f, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x3a, 0x09, 0x73, 0x65, 0x6e,
-	0x64, 0x5f, 0x62, 0x6f, 0x64, 0x79, 0x12, 0xa5, 0x01, 0x0a, 0x1b, 0x43, 0x68, 0x65, 0x63, 0x6b,
-	0x41, 0x6e, 0x64, 0x49, 0x6e, 0x73, 0x65, 0x72, 0x74, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65,
-	0x6e, 0x64, 0x41, 0x72, 0x65, 0x61, 0x12, 0x27, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x43, 0x68, 0x65,
-	0x63, 0x6b, 0x41, 0x6e, 0x64, 0x49, 0x6e, 0x73, 0x65, 0x72, 0x74, 0x52, 0x65, 0x63, 0x6f, 0x6d,
-	0x6d, 0x65, 0x6e, 0x64, 0x41, 0x72, 0x65, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x25, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x41, 0x6e, 0x64, 0x49, 0x6e,
-	0x73, 0x65, 0x72, 0x74, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x41, 0x72, 0x65,
-	0x61, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x36, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x30, 0x12, 0x2e,
-	0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x5f, 0x64, 0x68, 0x62, 0x2f, 0x63,
-	0x68, 0x65, 0x63, 0x6b, 0x5f, 0x61, 0x6e, 0x64, 0x5f, 0x69, 0x6e, 0x73, 0x65, 0x72, 0x74, 0x5f,
-	0x72, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x5f, 0x61, 0x72, 0x65, 0x61, 0x12, 0x96,
-	0x01, 0x0a, 0x19, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x44, 0x61, 0x69, 0x6c, 0x79, 0x52, 0x65, 0x63,
-	0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x12, 0x25, 0x2e, 0x61,
-	0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x44, 0x61, 0x69, 0x6c, 0x79, 0x52, 0x65, 0x63,
-	0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x44,
-	0x61, 0x69, 0x6c, 0x79, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x52, 0x65, 0x77,
-	0x61, 0x72, 0x64, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x2d, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x27,
-	0x12, 0x25, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x5f, 0x64, 0x68, 0x62,
-	0x2f, 0x64, 0x61, 0x69, 0x6c, 0x79, 0x5f, 0x72, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64,
-	0x5f, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x12, 0xa3, 0x01, 0x0a, 0x1c, 0x41, 0x64, 0x6d, 0x69,
-	0x6e, 0x44, 0x61, 0x69, 0x6c, 0x79, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x54,
-	0x6f, 0x70, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x12, 0x28, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41,
-	0x64, 0x6d, 0x69, 0x6e, 0x44, 0x61, 0x69, 0x6c, 0x79, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65,
-	0x6e, 0x64, 0x54, 0x6f, 0x70, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x26, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x44, 0x61,
-	0x69, 0x6c, 0x79, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x54, 0x6f, 0x70, 0x52,
-	0x65, 0x77, 0x61, 0x72, 0x64, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x31, 0x82, 0xd3, 0xe4, 0x93,
-	0x02, 0x2b, 0x12, 0x29, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x5f, 0x64,
-	0x68, 0x62, 0x2f, 0x64, 0x61, 0x69, 0x6c, 0x79, 0x5f, 0x72, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65,
-	0x6e, 0x64, 0x5f, 0x74, 0x6f, 0x70, 0x5f, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x12, 0x92, 0x01,
-	0x0a, 0x18, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x44, 0x61, 0x69, 0x6c, 0x79, 0x57, 0x69, 0x74, 0x68,
-	0x64, 0x72, 0x61, 0x77, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x12, 0x24, 0x2e, 0x61, 0x70, 0x69,
-	0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x44, 0x61, 0x69, 0x6c, 0x79, 0x57, 0x69, 0x74, 0x68, 0x64,
-	0x72, 0x61, 0x77, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x22, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x44, 0x61, 0x69, 0x6c,
-	0x79, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x52,
-	0x65, 0x70, 0x6c, 0x79, 0x22, 0x2c, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x26, 0x12, 0x24, 0x2f, 0x61,
-	0x70, 0x69, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x5f, 0x64, 0x68, 0x62, 0x2f, 0x64, 0x61, 0x69,
-	0x6c, 0x79, 0x5f, 0x77, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x5f, 0x72, 0x65, 0x77, 0x61,
-	0x72, 0x64, 0x12, 0x5a, 0x0a, 0x09, 0x46, 0x69, 0x78, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x12,
-	0x15, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x46, 0x69, 0x78, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x13, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x46, 0x69, 0x78,
-	0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x21, 0x82, 0xd3, 0xe4,
-	0x93, 0x02, 0x1b, 0x12, 0x19, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x5f,
-	0x64, 0x68, 0x62, 0x2f, 0x66, 0x69, 0x78, 0x5f, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x12, 0x66,
-	0x0a, 0x0c, 0x46, 0x69, 0x78, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x18,
-	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x46, 0x69, 0x78, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x46,
-	0x69, 0x78, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79,
-	0x22, 0x24, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1e, 0x12, 0x1c, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61,
-	0x64, 0x6d, 0x69, 0x6e, 0x5f, 0x64, 0x68, 0x62, 0x2f, 0x66, 0x69, 0x78, 0x5f, 0x6c, 0x6f, 0x63,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x42, 0x11, 0x0a, 0x03, 0x61, 0x70, 0x69, 0x50, 0x01, 0x5a,
-	0x08, 0x2f, 0x61, 0x70, 0x69, 0x3b, 0x61, 0x70, 0x69, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x33,
+	Start string `protobuf:"bytes,1,opt,name=start,proto3" json:"start,omitempty"`
+	End   string `protobuf:"bytes,2,opt,name=end,proto3" json:"end,omitempty"`
 }
 
-var (
-	file_app_app_api_app_proto_rawDescOnce sync.Once
-	file_app_app_api_app_proto_rawDescData = file_app_app_api_app_proto_rawDesc
-)
+func (x *AdminSolvencyListRequest) Reset() {
+	*x = AdminSolvencyListRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_app_app_api_app_proto_msgTypes[138]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
 
-func file_app_app_api_app_proto_rawDescGZIP() []byte {
-	file_app_app_api_app_proto_rawDescOnce.Do(func() {
-		file_app_app_api_app_proto_rawDescData = protoimpl.X.CompressGZIP(file_app_app_api_app_proto_rawDescData)
-	})
-	return file_app_app_api_app_proto_rawDescData
+func (x *AdminSolvencyListRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-var file_app_app_api_app_proto_msgTypes = make([]protoimpl.MessageInfo, 127)
-var file_app_app_api_app_proto_goTypes = []interface{}{
-	(*EthAuthorizeRequest)(nil),                         // 0: api.EthAuthorizeRequest
-	(*EthAuthorizeReply)(nil),                           // 1: api.EthAuthorizeReply
-	(*DepositRequest)(nil),                              // 2: api.DepositRequest
-	(*DepositReply)(nil),                                // 3: api.DepositReply
-	(*UserInfoRequest)(nil),                             // 4: api.UserInfoRequest
-	(*UserInfoReply)(nil),                               // 5: api.UserInfoReply
-	(*RewardListRequest)(nil),                           // 6: api.RewardListRequest
-	(*RewardListReply)(nil),                             // 7: api.RewardListReply
-	(*RecommendRewardListRequest)(nil),                  // 8: api.RecommendRewardListRequest
-	(*RecommendRewardListReply)(nil),                    // 9: api.RecommendRewardListReply
-	(*FeeRewardListRequest)(nil),                        // 10: api.FeeRewardListRequest
-	(*FeeRewardListReply)(nil),                          // 11: api.FeeRewardListReply
-	(*WithdrawListRequest)(nil),                         // 12: api.WithdrawListRequest
-	(*WithdrawListReply)(nil),                           // 13: api.WithdrawListReply
-	(*RecommendListRequest)(nil),                        // 14: api.RecommendListRequest
-	(*RecommendListReply)(nil),                          // 15: api.RecommendListReply
-	(*WithdrawRequest)(nil),                             // 16: api.WithdrawRequest
-	(*WithdrawReply)(nil),                               // 17: api.WithdrawReply
-	(*AdminRewardListRequest)(nil),                      // 18: api.AdminRewardListRequest
-	(*AdminRewardListReply)(nil),                        // 19: api.AdminRewardListReply
-	(*AdminRewardBnbListRequest)(nil),                   // 20: api.AdminRewardBnbListRequest
-	(*AdminRewardBnbListReply)(nil),                     // 21: api.AdminRewardBnbListReply
-	(*CheckAdminUserAreaRequest)(nil),                   // 22: api.CheckAdminUserAreaRequest
-	(*CheckAdminUserAreaReply)(nil),                     // 23: api.CheckAdminUserAreaReply
-	(*CheckAndInsertLocationsRecommendUserRequest)(nil), // 24: api.CheckAndInsertLocationsRecommendUserRequest
-	(*CheckAndInsertLocationsRecommendUserReply)(nil),   // 25: api.CheckAndInsertLocationsRecommendUserReply
-	(*UploadRecommendUserRequest)(nil),                  // 26: api.UploadRecommendUserRequest
-	(*UploadRecommendUserReply)(nil),                    // 27: api.UploadRecommendUserReply
-	(*AdminUserListRequest)(nil),                        // 28: api.AdminUserListRequest
-	(*AdminUserListReply)(nil),                          // 29: api.AdminUserListReply
-	(*AdminLocationListRequest)(nil),                    // 30: api.AdminLocationListRequest
-	(*AdminLocationListReply)(nil),                      // 31: api.AdminLocationListReply
-	(*AdminLocationAllListRequest)(nil),                 // 32: api.AdminLocationAllListRequest
-	(*AdminLocationAllListReply)(nil),                   // 33: api.AdminLocationAllListReply
-	(*AdminWithdrawListRequest)(nil),                    // 34: api.AdminWithdrawListRequest
-	(*AdminWithdrawListReply)(nil),                      // 35: api.AdminWithdrawListReply
-	(*AdminWithdrawRequest)(nil),                        // 36: api.AdminWithdrawRequest
-	(*AdminWithdrawReply)(nil),                          // 37: api.AdminWithdrawReply
-	(*AdminWithdrawDoingToRewardedRequest)(nil),         // 38: api.AdminWithdrawDoingToRewardedRequest
-	(*AdminWithdrawDoingToRewardedReply)(nil),           // 39: api.AdminWithdrawDoingToRewardedReply
-	(*AdminWithdrawEthRequest)(nil),                     // 40: api.AdminWithdrawEthRequest
-	(*AdminWithdrawEthReply)(nil),                       // 41: api.AdminWithdrawEthReply
-	(*AdminFeeRequest)(nil),                             // 42: api.AdminFeeRequest
-	(*AdminFeeReply)(nil),                               // 43: api.AdminFeeReply
-	(*AdminDailyFeeRequest)(nil),                        // 44: api.AdminDailyFeeRequest
-	(*AdminDailyFeeReply)(nil),                          // 45: api.AdminDailyFeeReply
-	(*AdminAllRequest)(nil),                             // 46: api.AdminAllRequest
-	(*AdminAllReply)(nil),                               // 47: api.AdminAllReply
-	(*AdminUserRecommendRequest)(nil),                   // 48: api.AdminUserRecommendRequest
-	(*AdminUserRecommendReply)(nil),                     // 49: api.AdminUserRecommendReply
-	(*AdminMonthRecommendRequest)(nil),                  // 50: api.AdminMonthRecommendRequest
-	(*AdminMonthRecommendReply)(nil),                    // 51: api.AdminMonthRecommendReply
-	(*AdminConfigRequest)(nil),                          // 52: api.AdminConfigRequest
-	(*AdminConfigReply)(nil),                            // 53: api.AdminConfigReply
-	(*AdminListRequest)(nil),                            // 54: api.AdminListRequest
-	(*AdminListReply)(nil),                              // 55: api.AdminListReply
-	(*AuthListRequest)(nil),                             // 56: api.AuthListRequest
-	(*AuthListReply)(nil),                               // 57: api.AuthListReply
-	(*UserAuthListRequest)(nil),                         // 58: api.UserAuthListRequest
-	(*UserAuthListReply)(nil),                           // 59: api.UserAuthListReply
-	(*MyAuthListRequest)(nil),                           // 60: api.MyAuthListRequest
-	(*MyAuthListReply)(nil),                             // 61: api.MyAuthListReply
-	(*AdminConfigUpdateRequest)(nil),                    // 62: api.AdminConfigUpdateRequest
-	(*AdminConfigUpdateReply)(nil),                      // 63: api.AdminConfigUpdateReply
-	(*AdminVipUpdateRequest)(nil),                       // 64: api.AdminVipUpdateRequest
-	(*AdminVipUpdateReply)(nil),                         // 65: api.AdminVipUpdateReply
-	(*AdminUndoUpdateRequest)(nil),                      // 66: api.AdminUndoUpdateRequest
-	(*AdminUndoUpdateReply)(nil),                        // 67: api.AdminUndoUpdateReply
-	(*AdminAreaLevelUpdateRequest)(nil),                 // 68: api.AdminAreaLevelUpdateRequest
-	(*AdminAreaLevelUpdateReply)(nil),                   // 69: api.AdminAreaLevelUpdateReply
-	(*AdminLocationInsertRequest)(nil),                  // 70: api.AdminLocationInsertRequest
-	(*AdminLocationInsertReply)(nil),                    // 71: api.AdminLocationInsertReply
-	(*AdminBalanceUpdateRequest)(nil),                   // 72: api.AdminBalanceUpdateRequest
-	(*AdminBalanceUpdateReply)(nil),                     // 73: api.AdminBalanceUpdateReply
-	(*AuthAdminCreateRequest)(nil),                      // 74: api.AuthAdminCreateRequest
-	(*AuthAdminCreateReply)(nil),                        // 75: api.AuthAdminCreateReply
-	(*AuthAdminDeleteRequest)(nil),                      // 76: api.AuthAdminDeleteRequest
-	(*AuthAdminDeleteReply)(nil),                        // 77: api.AuthAdminDeleteReply
-	(*CheckAndInsertRecommendAreaRequest)(nil),          // 78: api.CheckAndInsertRecommendAreaRequest
-	(*CheckAndInsertRecommendAreaReply)(nil),            // 79: api.CheckAndInsertRecommendAreaReply
-	(*AdminDailyRecommendRewardRequest)(nil),            // 80: api.AdminDailyRecommendRewardRequest
-	(*AdminDailyRecommendRewardReply)(nil),              // 81: api.AdminDailyRecommendRewardReply
-	(*AdminDailyRecommendTopRewardRequest)(nil),         // 82: api.AdminDailyRecommendTopRewardRequest
-	(*AdminDailyRecommendTopRewardReply)(nil),           // 83: api.AdminDailyRecommendTopRewardReply
-	(*AdminDailyWithdrawRewardRequest)(nil),             // 84: api.AdminDailyWithdrawRewardRequest
-	(*AdminDailyWithdrawRewardReply)(nil),               // 85: api.AdminDailyWithdrawRewardReply
-	(*FixRewardRequest)(nil),                            // 86: api.FixRewardRequest
-	(*FixRewardReply)(nil),                              // 87: api.FixRewardReply
-	(*FixLocationsRequest)(nil),                         // 88: api.FixLocationsRequest
-	(*FixLocationsReply)(nil),                           // 89: api.FixLocationsReply
-	(*AdminLoginRequest)(nil),                           // 90: api.AdminLoginRequest
-	(*AdminLoginReply)(nil),                             // 91: api.AdminLoginReply
-	(*AdminChangePasswordRequest)(nil),                  // 92: api.AdminChangePasswordRequest
-	(*AdminChangePasswordReply)(nil),                    // 93: api.AdminChangePasswordReply
-	(*AdminCreateAccountRequest)(nil),                   // 94: api.AdminCreateAccountRequest
-	(*AdminCreateAccountReply)(nil),                     // 95: api.AdminCreateAccountReply
-	(*EthAuthorizeRequest_SendBody)(nil),                // 96: api.EthAuthorizeRequest.SendBody
-	(*RewardListReply_List)(nil),                        // 97: api.RewardListReply.List
-	(*RecommendRewardListReply_List)(nil),               // 98: api.RecommendRewardListReply.List
-	(*FeeRewardListReply_List)(nil),                     // 99: api.FeeRewardListReply.List
-	(*WithdrawListReply_List)(nil),                      // 100: api.WithdrawListReply.List
-	(*RecommendListReply_List)(nil),                     // 101: api.RecommendListReply.List
-	(*WithdrawRequest_SendBody)(nil),                    // 102: api.WithdrawRequest.SendBody
-	(*AdminRewardListReply_List)(nil),                   // 103: api.AdminRewardListReply.List
-	(*AdminRewardBnbListReply_List)(nil),                // 104: api.AdminRewardBnbListReply.List
-	(*AdminUserListReply_UserList)(nil),                 // 105: api.AdminUserListReply.UserList
-	(*AdminLocationListReply_LocationList)(nil),         // 106: api.AdminLocationListReply.LocationList
-	(*AdminLocationAllListReply_LocationList)(nil),      // 107: api.AdminLocationAllListReply.LocationList
-	(*AdminWithdrawListReply_List)(nil),                 // 108: api.AdminWithdrawListReply.List
-	(*AdminUserRecommendReply_List)(nil),                // 109: api.AdminUserRecommendReply.List
-	(*AdminMonthRecommendReply_List)(nil),               // 110: api.AdminMonthRecommendReply.List
-	(*AdminConfigReply_List)(nil),                       // 111: api.AdminConfigReply.List
-	(*AdminListReply_List)(nil),                         // 112: api.AdminListReply.List
-	(*AuthListReply_List)(nil),                          // 113: api.AuthListReply.List
-	(*UserAuthListReply_List)(nil),                      // 114: api.UserAuthListReply.List
-	(*MyAuthListReply_List)(nil),                        // 115: api.MyAuthListReply.List
-	(*AdminConfigUpdateRequest_SendBody)(nil),           // 116: api.AdminConfigUpdateRequest.SendBody
-	(*AdminVipUpdateRequest_SendBody)(nil),              // 117: api.AdminVipUpdateRequest.SendBody
-	(*AdminUndoUpdateRequest_SendBody)(nil),             // 118: api.AdminUndoUpdateRequest.SendBody
-	(*AdminAreaLevelUpdateRequest_SendBody)(nil),        // 119: api.AdminAreaLevelUpdateRequest.SendBody
-	(*AdminLocationInsertRequest_SendBody)(nil),         // 120: api.AdminLocationInsertRequest.SendBody
-	(*AdminBalanceUpdateRequest_SendBody)(nil),          // 121: api.AdminBalanceUpdateRequest.SendBody
-	(*AuthAdminCreateRequest_SendBody)(nil),             // 122: api.AuthAdminCreateRequest.SendBody
-	(*AuthAdminDeleteRequest_SendBody)(nil),             // 123: api.AuthAdminDeleteRequest.SendBody
-	(*AdminLoginRequest_SendBody)(nil),                  // 124: api.AdminLoginRequest.SendBody
-	(*AdminChangePasswordRequest_SendBody)(nil),         // 125: api.AdminChangePasswordRequest.SendBody
-	(*AdminCreateAccountRequest_SendBody)(nil),          // 126: api.AdminCreateAccountRequest.SendBody
+func (*AdminSolvencyListRequest) ProtoMessage() {}
+
+func (x *AdminSolvencyListRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_app_app_api_app_proto_msgTypes[138]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
-var file_app_app_api_app_proto_depIdxs = []int32{
-	96,  // 0: api.EthAuthorizeRequest.send_body:type_name -> api.EthAuthorizeRequest.SendBody
-	97,  // 1: api.RewardListReply.rewards:type_name -> api.RewardListReply.List
-	98,  // 2: api.RecommendRewardListReply.rewards:type_name -> api.RecommendRewardListReply.List
-	99,  // 3: api.FeeRewardListReply.rewards:type_name -> api.FeeRewardListReply.List
-	100, // 4: api.WithdrawListReply.withdraw:type_name -> api.WithdrawListReply.List
-	101, // 5: api.RecommendListReply.recommends:type_name -> api.RecommendListReply.List
-	102, // 6: api.WithdrawRequest.send_body:type_name -> api.WithdrawRequest.SendBody
-	103, // 7: api.AdminRewardListReply.rewards:type_name -> api.AdminRewardListReply.List
-	104, // 8: api.AdminRewardBnbListReply.rewards:type_name -> api.AdminRewardBnbListReply.List
-	105, // 9: api.AdminUserListReply.users:type_name -> api.AdminUserListReply.UserList
-	106, // 10: api.AdminLocationListReply.locations:type_name -> api.AdminLocationListReply.LocationList
-	107, // 11: api.AdminLocationAllListReply.locations:type_name -> api.AdminLocationAllListReply.LocationList
-	108, // 12: api.AdminWithdrawListReply.withdraw:type_name -> api.AdminWithdrawListReply.List
-	109, // 13: api.AdminUserRecommendReply.users:type_name -> api.AdminUserRecommendReply.List
-	110, // 14: api.AdminMonthRecommendReply.users:type_name -> api.AdminMonthRecommendReply.List
-	111, // 15: api.AdminConfigReply.config:type_name -> api.AdminConfigReply.List
-	112, // 16: api.AdminListReply.account:type_name -> api.AdminListReply.List
-	113, // 17: api.AuthListReply.auth:type_name -> api.AuthListReply.List
-	114, // 18: api.UserAuthListReply.auth:type_name -> api.UserAuthListReply.List
-	115, // 19: api.MyAuthListReply.auth:type_name -> api.MyAuthListReply.List
-	116, // 20: api.AdminConfigUpdateRequest.send_body:type_name -> api.AdminConfigUpdateRequest.SendBody
-	117, // 21: api.AdminVipUpdateRequest.send_body:type_name -> api.AdminVipUpdateRequest.SendBody
-	118, // 22: api.AdminUndoUpdateRequest.send_body:type_name -> api.AdminUndoUpdateRequest.SendBody
-	119, // 23: api.AdminAreaLevelUpdateRequest.send_body:type_name -> api.AdminAreaLevelUpdateRequest.SendBody
-	120, // 24: api.AdminLocationInsertRequest.send_body:type_name -> api.AdminLocationInsertRequest.SendBody
-	121, // 25: api.AdminBalanceUpdateRequest.send_body:type_name -> api.AdminBalanceUpdateRequest.SendBody
-	122, // 26: api.AuthAdminCreateRequest.send_body:type_name -> api.AuthAdminCreateRequest.SendBody
-	123, // 27: api.AuthAdminDeleteRequest.send_body:type_name -> api.AuthAdminDeleteRequest.SendBody
-	124, // 28: api.AdminLoginRequest.send_body:type_name -> api.AdminLoginRequest.SendBody
-	125, // 29: api.AdminChangePasswordRequest.send_body:type_name -> api.AdminChangePasswordRequest.SendBody
-	126, // 30: api.AdminCreateAccountRequest.send_body:type_name -> api.AdminCreateAccountRequest.SendBody
-	4,   // 31: api.App.UserInfo:input_type -> api.UserInfoRequest
-	6,   // 32: api.App.RewardList:input_type -> api.RewardListRequest
-	8,   // 33: api.App.RecommendRewardList:input_type -> api.RecommendRewardListRequest
-	10,  // 34: api.App.FeeRewardList:input_type -> api.FeeRewardListRequest
-	12,  // 35: api.App.WithdrawList:input_type -> api.WithdrawListRequest
-	14,  // 36: api.App.RecommendList:input_type -> api.RecommendListRequest
-	16,  // 37: api.App.Withdraw:input_type -> api.WithdrawRequest
-	2,   // 38: api.App.Deposit:input_type -> api.DepositRequest
-	18,  // 39: api.App.AdminRewardList:input_type -> api.AdminRewardListRequest
-	20,  // 40: api.App.AdminRewardBnbList:input_type -> api.AdminRewardBnbListRequest
-	28,  // 41: api.App.AdminUserList:input_type -> api.AdminUserListRequest
-	22,  // 42: api.App.CheckAdminUserArea:input_type -> api.CheckAdminUserAreaRequest
-	24,  // 43: api.App.CheckAndInsertLocationsRecommendUser:input_type -> api.CheckAndInsertLocationsRecommendUserRequest
-	26,  // 44: api.App.UploadRecommendUser:input_type -> api.UploadRecommendUserRequest
-	30,  // 45: api.App.AdminLocationList:input_type -> api.AdminLocationListRequest
-	32,  // 46: api.App.AdminLocationAllList:input_type -> api.AdminLocationAllListRequest
-	34,  // 47: api.App.AdminWithdrawList:input_type -> api.AdminWithdrawListRequest
-	36,  // 48: api.App.AdminWithdraw:input_type -> api.AdminWithdrawRequest
-	38,  // 49: api.App.AdminWithdrawDoingToRewarded:input_type -> api.AdminWithdrawDoingToRewardedRequest
-	40,  // 50: api.App.AdminWithdrawEth:input_type -> api.AdminWithdrawEthRequest
-	42,  // 51: api.App.AdminFee:input_type -> api.AdminFeeRequest
-	44,  // 52: api.App.AdminDailyFee:input_type -> api.AdminDailyFeeRequest
-	46,  // 53: api.App.AdminAll:input_type -> api.AdminAllRequest
-	48,  // 54: api.App.AdminUserRecommend:input_type -> api.AdminUserRecommendRequest
-	50,  // 55: api.App.AdminMonthRecommend:input_type -> api.AdminMonthRecommendRequest
-	52,  // 56: api.App.AdminConfig:input_type -> api.AdminConfigRequest
-	62,  // 57: api.App.AdminConfigUpdate:input_type -> api.AdminConfigUpdateRequest
-	64,  // 58: api.App.AdminVipUpdate:input_type -> api.AdminVipUpdateRequest
-	66,  // 59: api.App.AdminUndoUpdate:input_type -> api.AdminUndoUpdateRequest
-	68,  // 60: api.App.AdminAreaLevelUpdate:input_type -> api.AdminAreaLevelUpdateRequest
-	70,  // 61: api.App.AdminLocationInsert:input_type -> api.AdminLocationInsertRequest
-	72,  // 62: api.App.AdminBalanceUpdate:input_type -> api.AdminBalanceUpdateRequest
-	90,  // 63: api.App.AdminLogin:input_type -> api.AdminLoginRequest
-	94,  // 64: api.App.AdminCreateAccount:input_type -> api.AdminCreateAccountRequest
-	92,  // 65: api.App.AdminChangePassword:input_type -> api.AdminChangePasswordRequest
-	54,  // 66: api.App.AdminList:input_type -> api.AdminListRequest
-	56,  // 67: api.App.AuthList:input_type -> api.AuthListRequest
-	60,  // 68: api.App.MyAuthList:input_type -> api.MyAuthListRequest
-	58,  // 69: api.App.UserAuthList:input_type -> api.UserAuthListRequest
-	74,  // 70: api.App.AuthAdminCreate:input_type -> api.AuthAdminCreateRequest
-	76,  // 71: api.App.AuthAdminDelete:input_type -> api.AuthAdminDeleteRequest
-	78,  // 72: api.App.CheckAndInsertRecommendArea:input_type -> api.CheckAndInsertRecommendAreaRequest
-	80,  // 73: api.App.AdminDailyRecommendReward:input_type -> api.AdminDailyRecommendRewardRequest
-	82,  // 74: api.App.AdminDailyRecommendTopReward:input_type -> api.AdminDailyRecommendTopRewardRequest
-	84,  // 75: api.App.AdminDailyWithdrawReward:input_type -> api.AdminDailyWithdrawRewardRequest
-	86,  // 76: api.App.FixReward:input_type -> api.FixRewardRequest
-	88,  // 77: api.App.FixLocations:input_type -> api.FixLocationsRequest
-	5,   // 78: api.App.UserInfo:output_type -> api.UserInfoReply
-	7,   // 79: api.App.RewardList:output_type -> api.RewardListReply
-	9,   // 80: api.App.RecommendRewardList:output_type -> api.RecommendRewardListReply
-	11,  // 81: api.App.FeeRewardList:output_type -> api.FeeRewardListReply
-	13,  // 82: api.App.WithdrawList:output_type -> api.WithdrawListReply
-	15,  // 83: api.App.RecommendList:output_type -> api.RecommendListReply
-	17,  // 84: api.App.Withdraw:output_type -> api.WithdrawReply
-	3,   // 85: api.App.Deposit:output_type -> api.DepositReply
-	19,  // 86: api.App.AdminRewardList:output_type -> api.AdminRewardListReply
-	21,  // 87: api.App.AdminRewardBnbList:output_type -> api.AdminRewardBnbListReply
-	29,  // 88: api.App.AdminUserList:output_type -> api.AdminUserListReply
-	23,  // 89: api.App.CheckAdminUserArea:output_type -> api.CheckAdminUserAreaReply
-	25,  // 90: api.App.CheckAndInsertLocationsRecommendUser:output_type -> api.CheckAndInsertLocationsRecommendUserReply
-	27,  // 91: api.App.UploadRecommendUser:output_type -> api.UploadRecommendUserReply
-	31,  // 92: api.App.AdminLocationList:output_type -> api.AdminLocationListReply
-	33,  // 93: api.App.AdminLocationAllList:output_type -> api.AdminLocationAllListReply
-	35,  // 94: api.App.AdminWithdrawList:output_type -> api.AdminWithdrawListReply
-	37,  // 95: api.App.AdminWithdraw:output_type -> api.AdminWithdrawReply
-	39,  // 96: api.App.AdminWithdrawDoingToRewarded:output_type -> api.AdminWithdrawDoingToRewardedReply
-	41,  // 97: api.App.AdminWithdrawEth:output_type -> api.AdminWithdrawEthReply
-	43,  // 98: api.App.AdminFee:output_type -> api.AdminFeeReply
-	45,  // 99: api.App.AdminDailyFee:output_type -> api.AdminDailyFeeReply
-	47,  // 100: api.App.AdminAll:output_type -> api.AdminAllReply
-	49,  // 101: api.App.AdminUserRecommend:output_type -> api.AdminUserRecommendReply
-	51,  // 102: api.App.AdminMonthRecommend:output_type -> api.AdminMonthRecommendReply
-	53,  // 103: api.App.AdminConfig:output_type -> api.AdminConfigReply
-	63,  // 104: api.App.AdminConfigUpdate:output_type -> api.AdminConfigUpdateReply
-	65,  // 105: api.App.AdminVipUpdate:output_type -> api.AdminVipUpdateReply
-	67,  // 106: api.App.AdminUndoUpdate:output_type -> api.AdminUndoUpdateReply
-	69,  // 107: api.App.AdminAreaLevelUpdate:output_type -> api.AdminAreaLevelUpdateReply
-	71,  // 108: api.App.AdminLocationInsert:output_type -> api.AdminLocationInsertReply
-	73,  // 109: api.App.AdminBalanceUpdate:output_type -> api.AdminBalanceUpdateReply
-	91,  // 110: api.App.AdminLogin:output_type -> api.AdminLoginReply
-	95,  // 111: api.App.AdminCreateAccount:output_type -> api.AdminCreateAccountReply
-	93,  // 112: api.App.AdminChangePassword:output_type -> api.AdminChangePasswordReply
-	55,  // 113: api.App.AdminList:output_type -> api.AdminListReply
-	57,  // 114: api.App.AuthList:output_type -> api.AuthListReply
-	61,  // 115: api.App.MyAuthList:output_type -> api.MyAuthListReply
-	59,  // 116: api.App.UserAuthList:output_type -> api.UserAuthListReply
-	75,  // 117: api.App.AuthAdminCreate:output_type -> api.AuthAdminCreateReply
-	77,  // 118: api.App.AuthAdminDelete:output_type -> api.AuthAdminDeleteReply
-	79,  // 119: api.App.CheckAndInsertRecommendArea:output_type -> api.CheckAndInsertRecommendAreaReply
-	81,  // 120: api.App.AdminDailyRecommendReward:output_type -> api.AdminDailyRecommendRewardReply
-	83,  // 121: api.App.AdminDailyRecommendTopReward:output_type -> api.AdminDailyRecommendTopRewardReply
-	85,  // 122: api.App.AdminDailyWithdrawReward:output_type -> api.AdminDailyWithdrawRewardReply
-	87,  // 123: api.App.FixReward:output_type -> api.FixRewardReply
-	89,  // 124: api.App.FixLocations:output_type -> api.FixLocationsReply
-	78,  // [78:125] is the sub-list for method output_type
-	31,  // [31:78] is the sub-list for method input_type
-	31,  // [31:31] is the sub-list for extension type_name
-	31,  // [31:31] is the sub-list for extension extendee
-	0,   // [0:31] is the sub-list for field type_name
+
+// Deprecated: Use AdminSolvencyListRequest.ProtoReflect.Descriptor instead.
+func (*AdminSolvencyListRequest) Descriptor() ([]byte, []int) {
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{138}
 }
 
-func init() { file_app_app_api_app_proto_init() }
-func file_app_app_api_app_proto_init() {
-	if File_app_app_api_app_proto != nil {
-		return
+func (x *AdminSolvencyListRequest) GetStart() string {
+	if x != nil {
+		return x.Start
 	}
-	if !protoimpl.UnsafeEnabled {
-		file_app_app_api_app_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*EthAuthorizeRequest); i {
+	return ""
+}
+
+func (x *AdminSolvencyListRequest) GetEnd() string {
+	if x != nil {
+		return x.End
+	}
+	return ""
+}
+
+type AdminSolvencyListReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Solvencies []*AdminSolvencyListReply_List `protobuf:"bytes,1,rep,name=solvencies,proto3" json:"solvencies,omitempty"`
+}
+
+func (x *AdminSolvencyListReply) Reset() {
+	*x = AdminSolvencyListReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_app_app_api_app_proto_msgTypes[139]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AdminSolvencyListReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminSolvencyListReply) ProtoMessage() {}
+
+func (x *AdminSolvencyListReply) ProtoReflect() protoreflect.Message {
+	mi := &file_app_app_api_app_proto_msgTypes[139]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminSolvencyListReply.ProtoReflect.Descriptor instead.
+func (*AdminSolvencyListReply) Descriptor() ([]byte, []int) {
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{139}
+}
+
+func (x *AdminSolvencyListReply) GetSolvencies() []*AdminSolvencyListReply_List {
+	if x != nil {
+		return x.Solvencies
+	}
+	return nil
+}
+
+type AdminDepositSimulateRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId          int64             `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // 为0时模拟一个新注册的用户
+	Amount          int64             `protobuf:"varint,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	RecommendNeed   int64             `protobuf:"varint,3,opt,name=recommend_need,json=recommendNeed,proto3" json:"recommend_need,omitempty"`
+	LocationRow     int64             `protobuf:"varint,4,opt,name=location_row,json=locationRow,proto3" json:"location_row,omitempty"`
+	RecommendUserId int64             `protobuf:"varint,5,opt,name=recommend_user_id,json=recommendUserId,proto3" json:"recommend_user_id,omitempty"`                                               // 模拟新用户的推荐人，0为无推荐人
+	Configs         map[string]string `protobuf:"bytes,6,rep,name=configs,proto3" json:"configs,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"` // 按配置项名覆盖当前配置，如 configs.location_width=5
+}
+
+func (x *AdminDepositSimulateRequest) Reset() {
+	*x = AdminDepositSimulateRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_app_app_api_app_proto_msgTypes[140]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AdminDepositSimulateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminDepositSimulateRequest) ProtoMessage() {}
+
+func (x *AdminDepositSimulateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_app_app_api_app_proto_msgTypes[140]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminDepositSimulateRequest.ProtoReflect.Descriptor instead.
+func (*AdminDepositSimulateRequest) Descriptor() ([]byte, []int) {
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{140}
+}
+
+func (x *AdminDepositSimulateRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *AdminDepositSimulateRequest) GetAmount() int64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *AdminDepositSimulateRequest) GetRecommendNeed() int64 {
+	if x != nil {
+		return x.RecommendNeed
+	}
+	return 0
+}
+
+func (x *AdminDepositSimulateRequest) GetLocationRow() int64 {
+	if x != nil {
+		return x.LocationRow
+	}
+	return 0
+}
+
+func (x *AdminDepositSimulateRequest) GetRecommendUserId() int64 {
+	if x != nil {
+		return x.RecommendUserId
+	}
+	return 0
+}
+
+func (x *AdminDepositSimulateRequest) GetConfigs() map[string]string {
+	if x != nil {
+		return x.Configs
+	}
+	return nil
+}
+
+type AdminDepositSimulateReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Row          int64                             `protobuf:"varint,1,opt,name=row,proto3" json:"row,omitempty"`
+	Col          int64                             `protobuf:"varint,2,opt,name=col,proto3" json:"col,omitempty"`
+	CurrentLevel int64                             `protobuf:"varint,3,opt,name=current_level,json=currentLevel,proto3" json:"current_level,omitempty"`
+	Current      string                            `protobuf:"bytes,4,opt,name=current,proto3" json:"current,omitempty"`
+	CurrentMax   string                            `protobuf:"bytes,5,opt,name=current_max,json=currentMax,proto3" json:"current_max,omitempty"`
+	Rewards      []*AdminDepositSimulateReply_List `protobuf:"bytes,6,rep,name=rewards,proto3" json:"rewards,omitempty"`
+	SystemReward string                            `protobuf:"bytes,7,opt,name=system_reward,json=systemReward,proto3" json:"system_reward,omitempty"`
+}
+
+func (x *AdminDepositSimulateReply) Reset() {
+	*x = AdminDepositSimulateReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_app_app_api_app_proto_msgTypes[141]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AdminDepositSimulateReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminDepositSimulateReply) ProtoMessage() {}
+
+func (x *AdminDepositSimulateReply) ProtoReflect() protoreflect.Message {
+	mi := &file_app_app_api_app_proto_msgTypes[141]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminDepositSimulateReply.ProtoReflect.Descriptor instead.
+func (*AdminDepositSimulateReply) Descriptor() ([]byte, []int) {
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{141}
+}
+
+func (x *AdminDepositSimulateReply) GetRow() int64 {
+	if x != nil {
+		return x.Row
+	}
+	return 0
+}
+
+func (x *AdminDepositSimulateReply) GetCol() int64 {
+	if x != nil {
+		return x.Col
+	}
+	return 0
+}
+
+func (x *AdminDepositSimulateReply) GetCurrentLevel() int64 {
+	if x != nil {
+		return x.CurrentLevel
+	}
+	return 0
+}
+
+func (x *AdminDepositSimulateReply) GetCurrent() string {
+	if x != nil {
+		return x.Current
+	}
+	return ""
+}
+
+func (x *AdminDepositSimulateReply) GetCurrentMax() string {
+	if x != nil {
+		return x.CurrentMax
+	}
+	return ""
+}
+
+func (x *AdminDepositSimulateReply) GetRewards() []*AdminDepositSimulateReply_List {
+	if x != nil {
+		return x.Rewards
+	}
+	return nil
+}
+
+func (x *AdminDepositSimulateReply) GetSystemReward() string {
+	if x != nil {
+		return x.SystemReward
+	}
+	return ""
+}
+
+type RewardDetailRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id int64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *RewardDetailRequest) Reset() {
+	*x = RewardDetailRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_app_app_api_app_proto_msgTypes[142]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RewardDetailRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RewardDetailRequest) ProtoMessage() {}
+
+func (x *RewardDetailRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_app_app_api_app_proto_msgTypes[142]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RewardDetailRequest.ProtoReflect.Descriptor instead.
+func (*RewardDetailRequest) Descriptor() ([]byte, []int) {
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{142}
+}
+
+func (x *RewardDetailRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type RewardDetailReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id               int64                       `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	CreatedAt        string                      `protobuf:"bytes,2,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	Amount           string                      `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	Type             string                      `protobuf:"bytes,4,opt,name=type,proto3" json:"type,omitempty"`
+	Reason           string                      `protobuf:"bytes,5,opt,name=reason,proto3" json:"reason,omitempty"`
+	Rule             string                      `protobuf:"bytes,6,opt,name=rule,proto3" json:"rule,omitempty"`
+	Level            int64                       `protobuf:"varint,7,opt,name=level,proto3" json:"level,omitempty"`
+	Source           string                      `protobuf:"bytes,8,opt,name=source,proto3" json:"source,omitempty"`
+	LocationId       int64                       `protobuf:"varint,9,opt,name=location_id,json=locationId,proto3" json:"location_id,omitempty"`
+	LocationRow      int64                       `protobuf:"varint,10,opt,name=location_row,json=locationRow,proto3" json:"location_row,omitempty"`
+	LocationCol      int64                       `protobuf:"varint,11,opt,name=location_col,json=locationCol,proto3" json:"location_col,omitempty"`
+	PayerAddress     string                      `protobuf:"bytes,12,opt,name=payer_address,json=payerAddress,proto3" json:"payer_address,omitempty"`
+	DepositHash      string                      `protobuf:"bytes,13,opt,name=deposit_hash,json=depositHash,proto3" json:"deposit_hash,omitempty"`
+	DepositAmount    string                      `protobuf:"bytes,14,opt,name=deposit_amount,json=depositAmount,proto3" json:"deposit_amount,omitempty"`
+	DepositCreatedAt string                      `protobuf:"bytes,15,opt,name=deposit_created_at,json=depositCreatedAt,proto3" json:"deposit_created_at,omitempty"`
+	RewardLocationId int64                       `protobuf:"varint,16,opt,name=reward_location_id,json=rewardLocationId,proto3" json:"reward_location_id,omitempty"`
+	Configs          []*RewardDetailReply_Config `protobuf:"bytes,17,rep,name=configs,proto3" json:"configs,omitempty"`
+}
+
+func (x *RewardDetailReply) Reset() {
+	*x = RewardDetailReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_app_app_api_app_proto_msgTypes[143]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RewardDetailReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RewardDetailReply) ProtoMessage() {}
+
+func (x *RewardDetailReply) ProtoReflect() protoreflect.Message {
+	mi := &file_app_app_api_app_proto_msgTypes[143]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RewardDetailReply.ProtoReflect.Descriptor instead.
+func (*RewardDetailReply) Descriptor() ([]byte, []int) {
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{143}
+}
+
+func (x *RewardDetailReply) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *RewardDetailReply) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *RewardDetailReply) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+func (x *RewardDetailReply) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *RewardDetailReply) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *RewardDetailReply) GetRule() string {
+	if x != nil {
+		return x.Rule
+	}
+	return ""
+}
+
+func (x *RewardDetailReply) GetLevel() int64 {
+	if x != nil {
+		return x.Level
+	}
+	return 0
+}
+
+func (x *RewardDetailReply) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+func (x *RewardDetailReply) GetLocationId() int64 {
+	if x != nil {
+		return x.LocationId
+	}
+	return 0
+}
+
+func (x *RewardDetailReply) GetLocationRow() int64 {
+	if x != nil {
+		return x.LocationRow
+	}
+	return 0
+}
+
+func (x *RewardDetailReply) GetLocationCol() int64 {
+	if x != nil {
+		return x.LocationCol
+	}
+	return 0
+}
+
+func (x *RewardDetailReply) GetPayerAddress() string {
+	if x != nil {
+		return x.PayerAddress
+	}
+	return ""
+}
+
+func (x *RewardDetailReply) GetDepositHash() string {
+	if x != nil {
+		return x.DepositHash
+	}
+	return ""
+}
+
+func (x *RewardDetailReply) GetDepositAmount() string {
+	if x != nil {
+		return x.DepositAmount
+	}
+	return ""
+}
+
+func (x *RewardDetailReply) GetDepositCreatedAt() string {
+	if x != nil {
+		return x.DepositCreatedAt
+	}
+	return ""
+}
+
+func (x *RewardDetailReply) GetRewardLocationId() int64 {
+	if x != nil {
+		return x.RewardLocationId
+	}
+	return 0
+}
+
+func (x *RewardDetailReply) GetConfigs() []*RewardDetailReply_Config {
+	if x != nil {
+		return x.Configs
+	}
+	return nil
+}
+
+type AdminRewardDetailRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id int64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *AdminRewardDetailRequest) Reset() {
+	*x = AdminRewardDetailRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_app_app_api_app_proto_msgTypes[144]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AdminRewardDetailRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminRewardDetailRequest) ProtoMessage() {}
+
+func (x *AdminRewardDetailRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_app_app_api_app_proto_msgTypes[144]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminRewardDetailRequest.ProtoReflect.Descriptor instead.
+func (*AdminRewardDetailRequest) Descriptor() ([]byte, []int) {
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{144}
+}
+
+func (x *AdminRewardDetailRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type AdminRewardDetailReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id                   int64                            `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Address              string                           `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	CreatedAt            string                           `protobuf:"bytes,3,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	Amount               string                           `protobuf:"bytes,4,opt,name=amount,proto3" json:"amount,omitempty"`
+	Type                 string                           `protobuf:"bytes,5,opt,name=type,proto3" json:"type,omitempty"`
+	Reason               string                           `protobuf:"bytes,6,opt,name=reason,proto3" json:"reason,omitempty"`
+	TypeRecordId         int64                            `protobuf:"varint,7,opt,name=type_record_id,json=typeRecordId,proto3" json:"type_record_id,omitempty"`
+	LocationType         string                           `protobuf:"bytes,8,opt,name=location_type,json=locationType,proto3" json:"location_type,omitempty"`
+	Rule                 string                           `protobuf:"bytes,9,opt,name=rule,proto3" json:"rule,omitempty"`
+	Level                int64                            `protobuf:"varint,10,opt,name=level,proto3" json:"level,omitempty"`
+	Source               string                           `protobuf:"bytes,11,opt,name=source,proto3" json:"source,omitempty"`
+	AdminId              int64                            `protobuf:"varint,12,opt,name=admin_id,json=adminId,proto3" json:"admin_id,omitempty"`
+	LocationId           int64                            `protobuf:"varint,13,opt,name=location_id,json=locationId,proto3" json:"location_id,omitempty"`
+	LocationRow          int64                            `protobuf:"varint,14,opt,name=location_row,json=locationRow,proto3" json:"location_row,omitempty"`
+	LocationCol          int64                            `protobuf:"varint,15,opt,name=location_col,json=locationCol,proto3" json:"location_col,omitempty"`
+	LocationStatus       string                           `protobuf:"bytes,16,opt,name=location_status,json=locationStatus,proto3" json:"location_status,omitempty"`
+	PayerAddress         string                           `protobuf:"bytes,17,opt,name=payer_address,json=payerAddress,proto3" json:"payer_address,omitempty"`
+	DepositHash          string                           `protobuf:"bytes,18,opt,name=deposit_hash,json=depositHash,proto3" json:"deposit_hash,omitempty"`
+	DepositAmount        string                           `protobuf:"bytes,19,opt,name=deposit_amount,json=depositAmount,proto3" json:"deposit_amount,omitempty"`
+	DepositCreatedAt     string                           `protobuf:"bytes,20,opt,name=deposit_created_at,json=depositCreatedAt,proto3" json:"deposit_created_at,omitempty"`
+	RewardLocationId     int64                            `protobuf:"varint,21,opt,name=reward_location_id,json=rewardLocationId,proto3" json:"reward_location_id,omitempty"`
+	RewardLocationStatus string                           `protobuf:"bytes,22,opt,name=reward_location_status,json=rewardLocationStatus,proto3" json:"reward_location_status,omitempty"`
+	Configs              []*AdminRewardDetailReply_Config `protobuf:"bytes,23,rep,name=configs,proto3" json:"configs,omitempty"`
+}
+
+func (x *AdminRewardDetailReply) Reset() {
+	*x = AdminRewardDetailReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_app_app_api_app_proto_msgTypes[145]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AdminRewardDetailReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminRewardDetailReply) ProtoMessage() {}
+
+func (x *AdminRewardDetailReply) ProtoReflect() protoreflect.Message {
+	mi := &file_app_app_api_app_proto_msgTypes[145]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminRewardDetailReply.ProtoReflect.Descriptor instead.
+func (*AdminRewardDetailReply) Descriptor() ([]byte, []int) {
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{145}
+}
+
+func (x *AdminRewardDetailReply) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *AdminRewardDetailReply) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *AdminRewardDetailReply) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *AdminRewardDetailReply) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+func (x *AdminRewardDetailReply) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *AdminRewardDetailReply) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *AdminRewardDetailReply) GetTypeRecordId() int64 {
+	if x != nil {
+		return x.TypeRecordId
+	}
+	return 0
+}
+
+func (x *AdminRewardDetailReply) GetLocationType() string {
+	if x != nil {
+		return x.LocationType
+	}
+	return ""
+}
+
+func (x *AdminRewardDetailReply) GetRule() string {
+	if x != nil {
+		return x.Rule
+	}
+	return ""
+}
+
+func (x *AdminRewardDetailReply) GetLevel() int64 {
+	if x != nil {
+		return x.Level
+	}
+	return 0
+}
+
+func (x *AdminRewardDetailReply) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+func (x *AdminRewardDetailReply) GetAdminId() int64 {
+	if x != nil {
+		return x.AdminId
+	}
+	return 0
+}
+
+func (x *AdminRewardDetailReply) GetLocationId() int64 {
+	if x != nil {
+		return x.LocationId
+	}
+	return 0
+}
+
+func (x *AdminRewardDetailReply) GetLocationRow() int64 {
+	if x != nil {
+		return x.LocationRow
+	}
+	return 0
+}
+
+func (x *AdminRewardDetailReply) GetLocationCol() int64 {
+	if x != nil {
+		return x.LocationCol
+	}
+	return 0
+}
+
+func (x *AdminRewardDetailReply) GetLocationStatus() string {
+	if x != nil {
+		return x.LocationStatus
+	}
+	return ""
+}
+
+func (x *AdminRewardDetailReply) GetPayerAddress() string {
+	if x != nil {
+		return x.PayerAddress
+	}
+	return ""
+}
+
+func (x *AdminRewardDetailReply) GetDepositHash() string {
+	if x != nil {
+		return x.DepositHash
+	}
+	return ""
+}
+
+func (x *AdminRewardDetailReply) GetDepositAmount() string {
+	if x != nil {
+		return x.DepositAmount
+	}
+	return ""
+}
+
+func (x *AdminRewardDetailReply) GetDepositCreatedAt() string {
+	if x != nil {
+		return x.DepositCreatedAt
+	}
+	return ""
+}
+
+func (x *AdminRewardDetailReply) GetRewardLocationId() int64 {
+	if x != nil {
+		return x.RewardLocationId
+	}
+	return 0
+}
+
+func (x *AdminRewardDetailReply) GetRewardLocationStatus() string {
+	if x != nil {
+		return x.RewardLocationStatus
+	}
+	return ""
+}
+
+func (x *AdminRewardDetailReply) GetConfigs() []*AdminRewardDetailReply_Config {
+	if x != nil {
+		return x.Configs
+	}
+	return nil
+}
+
+type AdminVipRecalcRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *AdminVipRecalcRequest) Reset() {
+	*x = AdminVipRecalcRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_app_app_api_app_proto_msgTypes[146]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AdminVipRecalcRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminVipRecalcRequest) ProtoMessage() {}
+
+func (x *AdminVipRecalcRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_app_app_api_app_proto_msgTypes[146]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminVipRecalcRequest.ProtoReflect.Descriptor instead.
+func (*AdminVipRecalcRequest) Descriptor() ([]byte, []int) {
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{146}
+}
+
+type AdminVipRecalcReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Total   int64 `protobuf:"varint,1,opt,name=total,proto3" json:"total,omitempty"`
+	Changed int64 `protobuf:"varint,2,opt,name=changed,proto3" json:"changed,omitempty"`
+}
+
+func (x *AdminVipRecalcReply) Reset() {
+	*x = AdminVipRecalcReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_app_app_api_app_proto_msgTypes[147]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AdminVipRecalcReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminVipRecalcReply) ProtoMessage() {}
+
+func (x *AdminVipRecalcReply) ProtoReflect() protoreflect.Message {
+	mi := &file_app_app_api_app_proto_msgTypes[147]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminVipRecalcReply.ProtoReflect.Descriptor instead.
+func (*AdminVipRecalcReply) Descriptor() ([]byte, []int) {
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{147}
+}
+
+func (x *AdminVipRecalcReply) GetTotal() int64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *AdminVipRecalcReply) GetChanged() int64 {
+	if x != nil {
+		return x.Changed
+	}
+	return 0
+}
+
+type AdminLockListRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *AdminLockListRequest) Reset() {
+	*x = AdminLockListRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_app_app_api_app_proto_msgTypes[148]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AdminLockListRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminLockListRequest) ProtoMessage() {}
+
+func (x *AdminLockListRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_app_app_api_app_proto_msgTypes[148]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminLockListRequest.ProtoReflect.Descriptor instead.
+func (*AdminLockListRequest) Descriptor() ([]byte, []int) {
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{148}
+}
+
+type AdminLockListReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Locks []*AdminLockListReply_List `protobuf:"bytes,1,rep,name=locks,proto3" json:"locks,omitempty"`
+}
+
+func (x *AdminLockListReply) Reset() {
+	*x = AdminLockListReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_app_app_api_app_proto_msgTypes[149]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AdminLockListReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminLockListReply) ProtoMessage() {}
+
+func (x *AdminLockListReply) ProtoReflect() protoreflect.Message {
+	mi := &file_app_app_api_app_proto_msgTypes[149]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminLockListReply.ProtoReflect.Descriptor instead.
+func (*AdminLockListReply) Descriptor() ([]byte, []int) {
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{149}
+}
+
+func (x *AdminLockListReply) GetLocks() []*AdminLockListReply_List {
+	if x != nil {
+		return x.Locks
+	}
+	return nil
+}
+
+type ReinvestRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SendBody *ReinvestRequest_SendBody `protobuf:"bytes,1,opt,name=send_body,json=sendBody,proto3" json:"send_body,omitempty"`
+}
+
+func (x *ReinvestRequest) Reset() {
+	*x = ReinvestRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_app_app_api_app_proto_msgTypes[150]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReinvestRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReinvestRequest) ProtoMessage() {}
+
+func (x *ReinvestRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_app_app_api_app_proto_msgTypes[150]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReinvestRequest.ProtoReflect.Descriptor instead.
+func (*ReinvestRequest) Descriptor() ([]byte, []int) {
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{150}
+}
+
+func (x *ReinvestRequest) GetSendBody() *ReinvestRequest_SendBody {
+	if x != nil {
+		return x.SendBody
+	}
+	return nil
+}
+
+type ReinvestReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status string `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	Hash   string `protobuf:"bytes,2,opt,name=hash,proto3" json:"hash,omitempty"`
+}
+
+func (x *ReinvestReply) Reset() {
+	*x = ReinvestReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_app_app_api_app_proto_msgTypes[151]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReinvestReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReinvestReply) ProtoMessage() {}
+
+func (x *ReinvestReply) ProtoReflect() protoreflect.Message {
+	mi := &file_app_app_api_app_proto_msgTypes[151]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReinvestReply.ProtoReflect.Descriptor instead.
+func (*ReinvestReply) Descriptor() ([]byte, []int) {
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{151}
+}
+
+func (x *ReinvestReply) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *ReinvestReply) GetHash() string {
+	if x != nil {
+		return x.Hash
+	}
+	return ""
+}
+
+type LocationProgressRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *LocationProgressRequest) Reset() {
+	*x = LocationProgressRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_app_app_api_app_proto_msgTypes[152]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LocationProgressRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LocationProgressRequest) ProtoMessage() {}
+
+func (x *LocationProgressRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_app_app_api_app_proto_msgTypes[152]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LocationProgressRequest.ProtoReflect.Descriptor instead.
+func (*LocationProgressRequest) Descriptor() ([]byte, []int) {
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{152}
+}
+
+type LocationProgressReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Locations          []*LocationProgressReply_List `protobuf:"bytes,1,rep,name=locations,proto3" json:"locations,omitempty"`
+	LastStopLocationId int64                         `protobuf:"varint,2,opt,name=last_stop_location_id,json=lastStopLocationId,proto3" json:"last_stop_location_id,omitempty"`
+	CanReenter         bool                          `protobuf:"varint,3,opt,name=can_reenter,json=canReenter,proto3" json:"can_reenter,omitempty"`
+	ReenterRemaining   int64                         `protobuf:"varint,4,opt,name=reenter_remaining,json=reenterRemaining,proto3" json:"reenter_remaining,omitempty"`
+	ReenterEnd         string                        `protobuf:"bytes,5,opt,name=reenter_end,json=reenterEnd,proto3" json:"reenter_end,omitempty"`
+	CarryOver          string                        `protobuf:"bytes,6,opt,name=carry_over,json=carryOver,proto3" json:"carry_over,omitempty"`
+}
+
+func (x *LocationProgressReply) Reset() {
+	*x = LocationProgressReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_app_app_api_app_proto_msgTypes[153]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LocationProgressReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LocationProgressReply) ProtoMessage() {}
+
+func (x *LocationProgressReply) ProtoReflect() protoreflect.Message {
+	mi := &file_app_app_api_app_proto_msgTypes[153]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LocationProgressReply.ProtoReflect.Descriptor instead.
+func (*LocationProgressReply) Descriptor() ([]byte, []int) {
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{153}
+}
+
+func (x *LocationProgressReply) GetLocations() []*LocationProgressReply_List {
+	if x != nil {
+		return x.Locations
+	}
+	return nil
+}
+
+func (x *LocationProgressReply) GetLastStopLocationId() int64 {
+	if x != nil {
+		return x.LastStopLocationId
+	}
+	return 0
+}
+
+func (x *LocationProgressReply) GetCanReenter() bool {
+	if x != nil {
+		return x.CanReenter
+	}
+	return false
+}
+
+func (x *LocationProgressReply) GetReenterRemaining() int64 {
+	if x != nil {
+		return x.ReenterRemaining
+	}
+	return 0
+}
+
+func (x *LocationProgressReply) GetReenterEnd() string {
+	if x != nil {
+		return x.ReenterEnd
+	}
+	return ""
+}
+
+func (x *LocationProgressReply) GetCarryOver() string {
+	if x != nil {
+		return x.CarryOver
+	}
+	return ""
+}
+
+type EthAuthorizeRequest_SendBody struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Code    string `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`
+}
+
+func (x *EthAuthorizeRequest_SendBody) Reset() {
+	*x = EthAuthorizeRequest_SendBody{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_app_app_api_app_proto_msgTypes[154]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EthAuthorizeRequest_SendBody) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EthAuthorizeRequest_SendBody) ProtoMessage() {}
+
+func (x *EthAuthorizeRequest_SendBody) ProtoReflect() protoreflect.Message {
+	mi := &file_app_app_api_app_proto_msgTypes[154]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EthAuthorizeRequest_SendBody.ProtoReflect.Descriptor instead.
+func (*EthAuthorizeRequest_SendBody) Descriptor() ([]byte, []int) {
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{0, 0}
+}
+
+func (x *EthAuthorizeRequest_SendBody) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *EthAuthorizeRequest_SendBody) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+type RewardListReply_List struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CreatedAt      string `protobuf:"bytes,1,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	Amount         string `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	LocationStatus string `protobuf:"bytes,3,opt,name=locationStatus,proto3" json:"locationStatus,omitempty"`
+	Type           string `protobuf:"bytes,4,opt,name=type,proto3" json:"type,omitempty"`
+	Id             int64  `protobuf:"varint,5,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *RewardListReply_List) Reset() {
+	*x = RewardListReply_List{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_app_app_api_app_proto_msgTypes[155]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RewardListReply_List) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RewardListReply_List) ProtoMessage() {}
+
+func (x *RewardListReply_List) ProtoReflect() protoreflect.Message {
+	mi := &file_app_app_api_app_proto_msgTypes[155]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RewardListReply_List.ProtoReflect.Descriptor instead.
+func (*RewardListReply_List) Descriptor() ([]byte, []int) {
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{7, 0}
+}
+
+func (x *RewardListReply_List) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *RewardListReply_List) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+func (x *RewardListReply_List) GetLocationStatus() string {
+	if x != nil {
+		return x.LocationStatus
+	}
+	return ""
+}
+
+func (x *RewardListReply_List) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *RewardListReply_List) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type RecommendRewardListReply_List struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CreatedAt string `protobuf:"bytes,1,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	Amount    string `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	Type      string `protobuf:"bytes,4,opt,name=type,proto3" json:"type,omitempty"`
+	Reason    string `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	Id        int64  `protobuf:"varint,5,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *RecommendRewardListReply_List) Reset() {
+	*x = RecommendRewardListReply_List{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_app_app_api_app_proto_msgTypes[156]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RecommendRewardListReply_List) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecommendRewardListReply_List) ProtoMessage() {}
+
+func (x *RecommendRewardListReply_List) ProtoReflect() protoreflect.Message {
+	mi := &file_app_app_api_app_proto_msgTypes[156]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecommendRewardListReply_List.ProtoReflect.Descriptor instead.
+func (*RecommendRewardListReply_List) Descriptor() ([]byte, []int) {
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{9, 0}
+}
+
+func (x *RecommendRewardListReply_List) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *RecommendRewardListReply_List) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+func (x *RecommendRewardListReply_List) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *RecommendRewardListReply_List) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *RecommendRewardListReply_List) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type FeeRewardListReply_List struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CreatedAt string `protobuf:"bytes,1,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	Amount    string `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	Id        int64  `protobuf:"varint,3,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *FeeRewardListReply_List) Reset() {
+	*x = FeeRewardListReply_List{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_app_app_api_app_proto_msgTypes[157]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FeeRewardListReply_List) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FeeRewardListReply_List) ProtoMessage() {}
+
+func (x *FeeRewardListReply_List) ProtoReflect() protoreflect.Message {
+	mi := &file_app_app_api_app_proto_msgTypes[157]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FeeRewardListReply_List.ProtoReflect.Descriptor instead.
+func (*FeeRewardListReply_List) Descriptor() ([]byte, []int) {
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{11, 0}
+}
+
+func (x *FeeRewardListReply_List) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *FeeRewardListReply_List) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+func (x *FeeRewardListReply_List) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type WithdrawListReply_List struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CreatedAt string `protobuf:"bytes,1,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	Amount    string `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	Type      string `protobuf:"bytes,3,opt,name=type,proto3" json:"type,omitempty"`
+	Status    string `protobuf:"bytes,4,opt,name=status,proto3" json:"status,omitempty"`
+	Reason    string `protobuf:"bytes,5,opt,name=reason,proto3" json:"reason,omitempty"`
+}
+
+func (x *WithdrawListReply_List) Reset() {
+	*x = WithdrawListReply_List{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_app_app_api_app_proto_msgTypes[158]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WithdrawListReply_List) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WithdrawListReply_List) ProtoMessage() {}
+
+func (x *WithdrawListReply_List) ProtoReflect() protoreflect.Message {
+	mi := &file_app_app_api_app_proto_msgTypes[158]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WithdrawListReply_List.ProtoReflect.Descriptor instead.
+func (*WithdrawListReply_List) Descriptor() ([]byte, []int) {
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{13, 0}
+}
+
+func (x *WithdrawListReply_List) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *WithdrawListReply_List) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+func (x *WithdrawListReply_List) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *WithdrawListReply_List) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *WithdrawListReply_List) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type RecommendListReply_List struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Amount string `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	Status string `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`
+}
+
+func (x *RecommendListReply_List) Reset() {
+	*x = RecommendListReply_List{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_app_app_api_app_proto_msgTypes[159]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RecommendListReply_List) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecommendListReply_List) ProtoMessage() {}
+
+func (x *RecommendListReply_List) ProtoReflect() protoreflect.Message {
+	mi := &file_app_app_api_app_proto_msgTypes[159]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecommendListReply_List.ProtoReflect.Descriptor instead.
+func (*RecommendListReply_List) Descriptor() ([]byte, []int) {
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{15, 0}
+}
+
+func (x *RecommendListReply_List) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+func (x *RecommendListReply_List) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+type WithdrawRequest_SendBody struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Type           string `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	Amount         string `protobuf:"bytes,1,opt,name=amount,proto3" json:"amount,omitempty"`
+	IdempotencyKey string `protobuf:"bytes,3,opt,name=idempotency_key,json=idempotencyKey,proto3" json:"idempotency_key,omitempty"`
+}
+
+func (x *WithdrawRequest_SendBody) Reset() {
+	*x = WithdrawRequest_SendBody{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_app_app_api_app_proto_msgTypes[160]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WithdrawRequest_SendBody) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WithdrawRequest_SendBody) ProtoMessage() {}
+
+func (x *WithdrawRequest_SendBody) ProtoReflect() protoreflect.Message {
+	mi := &file_app_app_api_app_proto_msgTypes[160]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WithdrawRequest_SendBody.ProtoReflect.Descriptor instead.
+func (*WithdrawRequest_SendBody) Descriptor() ([]byte, []int) {
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{16, 0}
+}
+
+func (x *WithdrawRequest_SendBody) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *WithdrawRequest_SendBody) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+func (x *WithdrawRequest_SendBody) GetIdempotencyKey() string {
+	if x != nil {
+		return x.IdempotencyKey
+	}
+	return ""
+}
+
+type WithdrawCancelRequest_SendBody struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id int64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *WithdrawCancelRequest_SendBody) Reset() {
+	*x = WithdrawCancelRequest_SendBody{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_app_app_api_app_proto_msgTypes[161]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WithdrawCancelRequest_SendBody) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WithdrawCancelRequest_SendBody) ProtoMessage() {}
+
+func (x *WithdrawCancelRequest_SendBody) ProtoReflect() protoreflect.Message {
+	mi := &file_app_app_api_app_proto_msgTypes[161]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WithdrawCancelRequest_SendBody.ProtoReflect.Descriptor instead.
+func (*WithdrawCancelRequest_SendBody) Descriptor() ([]byte, []int) {
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{18, 0}
+}
+
+func (x *WithdrawCancelRequest_SendBody) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type AdminRewardListReply_List struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CreatedAt string `protobuf:"bytes,1,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	Amount    string `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	Type      string `protobuf:"bytes,4,opt,name=type,proto3" json:"type,omitempty"`
+	Address   string `protobuf:"bytes,5,opt,name=address,proto3" json:"address,omitempty"`
+	Reason    string `protobuf:"bytes,6,opt,name=reason,proto3" json:"reason,omitempty"`
+	Id        int64  `protobuf:"varint,7,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *AdminRewardListReply_List) Reset() {
+	*x = AdminRewardListReply_List{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_app_app_api_app_proto_msgTypes[162]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AdminRewardListReply_List) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminRewardListReply_List) ProtoMessage() {}
+
+func (x *AdminRewardListReply_List) ProtoReflect() protoreflect.Message {
+	mi := &file_app_app_api_app_proto_msgTypes[162]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminRewardListReply_List.ProtoReflect.Descriptor instead.
+func (*AdminRewardListReply_List) Descriptor() ([]byte, []int) {
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{21, 0}
+}
+
+func (x *AdminRewardListReply_List) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *AdminRewardListReply_List) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+func (x *AdminRewardListReply_List) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *AdminRewardListReply_List) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *AdminRewardListReply_List) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *AdminRewardListReply_List) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type AdminRewardBnbListReply_List struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CreatedAt  string `protobuf:"bytes,1,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	Amount     string `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	BalanceAll string `protobuf:"bytes,3,opt,name=balanceAll,proto3" json:"balanceAll,omitempty"`
+	Address    string `protobuf:"bytes,5,opt,name=address,proto3" json:"address,omitempty"`
+}
+
+func (x *AdminRewardBnbListReply_List) Reset() {
+	*x = AdminRewardBnbListReply_List{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_app_app_api_app_proto_msgTypes[163]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AdminRewardBnbListReply_List) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminRewardBnbListReply_List) ProtoMessage() {}
+
+func (x *AdminRewardBnbListReply_List) ProtoReflect() protoreflect.Message {
+	mi := &file_app_app_api_app_proto_msgTypes[163]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminRewardBnbListReply_List.ProtoReflect.Descriptor instead.
+func (*AdminRewardBnbListReply_List) Descriptor() ([]byte, []int) {
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{23, 0}
+}
+
+func (x *AdminRewardBnbListReply_List) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *AdminRewardBnbListReply_List) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+func (x *AdminRewardBnbListReply_List) GetBalanceAll() string {
+	if x != nil {
+		return x.BalanceAll
+	}
+	return ""
+}
+
+func (x *AdminRewardBnbListReply_List) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+type AdminUserListReply_UserList struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId           int64  `protobuf:"varint,8,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	CreatedAt        string `protobuf:"bytes,1,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	Address          string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	BalanceUsdt      string `protobuf:"bytes,3,opt,name=balanceUsdt,proto3" json:"balanceUsdt,omitempty"`
+	BalanceDhb       string `protobuf:"bytes,4,opt,name=balanceDhb,proto3" json:"balanceDhb,omitempty"`
+	Vip              int64  `protobuf:"varint,5,opt,name=vip,proto3" json:"vip,omitempty"`
+	MonthRecommend   int64  `protobuf:"varint,7,opt,name=monthRecommend,proto3" json:"monthRecommend,omitempty"`
+	HistoryRecommend int64  `protobuf:"varint,6,opt,name=historyRecommend,proto3" json:"historyRecommend,omitempty"`
+	AreaAmount       int64  `protobuf:"varint,9,opt,name=areaAmount,proto3" json:"areaAmount,omitempty"`
+	AreaMaxAmount    int64  `protobuf:"varint,10,opt,name=areaMaxAmount,proto3" json:"areaMaxAmount,omitempty"`
+	BnbAmount        string `protobuf:"bytes,11,opt,name=bnbAmount,proto3" json:"bnbAmount,omitempty"`
+	BnbBalance       string `protobuf:"bytes,12,opt,name=bnbBalance,proto3" json:"bnbBalance,omitempty"`
+	VipCompute       int64  `protobuf:"varint,13,opt,name=vipCompute,proto3" json:"vipCompute,omitempty"`
+	VipAdmin         int64  `protobuf:"varint,14,opt,name=vipAdmin,proto3" json:"vipAdmin,omitempty"`
+}
+
+func (x *AdminUserListReply_UserList) Reset() {
+	*x = AdminUserListReply_UserList{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_app_app_api_app_proto_msgTypes[164]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AdminUserListReply_UserList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminUserListReply_UserList) ProtoMessage() {}
+
+func (x *AdminUserListReply_UserList) ProtoReflect() protoreflect.Message {
+	mi := &file_app_app_api_app_proto_msgTypes[164]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminUserListReply_UserList.ProtoReflect.Descriptor instead.
+func (*AdminUserListReply_UserList) Descriptor() ([]byte, []int) {
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{31, 0}
+}
+
+func (x *AdminUserListReply_UserList) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *AdminUserListReply_UserList) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *AdminUserListReply_UserList) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *AdminUserListReply_UserList) GetBalanceUsdt() string {
+	if x != nil {
+		return x.BalanceUsdt
+	}
+	return ""
+}
+
+func (x *AdminUserListReply_UserList) GetBalanceDhb() string {
+	if x != nil {
+		return x.BalanceDhb
+	}
+	return ""
+}
+
+func (x *AdminUserListReply_UserList) GetVip() int64 {
+	if x != nil {
+		return x.Vip
+	}
+	return 0
+}
+
+func (x *AdminUserListReply_UserList) GetMonthRecommend() int64 {
+	if x != nil {
+		return x.MonthRecommend
+	}
+	return 0
+}
+
+func (x *AdminUserListReply_UserList) GetHistoryRecommend() int64 {
+	if x != nil {
+		return x.HistoryRecommend
+	}
+	return 0
+}
+
+func (x *AdminUserListReply_UserList) GetAreaAmount() int64 {
+	if x != nil {
+		return x.AreaAmount
+	}
+	return 0
+}
+
+func (x *AdminUserListReply_UserList) GetAreaMaxAmount() int64 {
+	if x != nil {
+		return x.AreaMaxAmount
+	}
+	return 0
+}
+
+func (x *AdminUserListReply_UserList) GetBnbAmount() string {
+	if x != nil {
+		return x.BnbAmount
+	}
+	return ""
+}
+
+func (x *AdminUserListReply_UserList) GetBnbBalance() string {
+	if x != nil {
+		return x.BnbBalance
+	}
+	return ""
+}
+
+func (x *AdminUserListReply_UserList) GetVipCompute() int64 {
+	if x != nil {
+		return x.VipCompute
+	}
+	return 0
+}
+
+func (x *AdminUserListReply_UserList) GetVipAdmin() int64 {
+	if x != nil {
+		return x.VipAdmin
+	}
+	return 0
+}
+
+type AdminLocationListReply_LocationList struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CreatedAt    string `protobuf:"bytes,1,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	Address      string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	Row          int64  `protobuf:"varint,3,opt,name=row,proto3" json:"row,omitempty"`
+	Col          int64  `protobuf:"varint,4,opt,name=col,proto3" json:"col,omitempty"`
+	Status       string `protobuf:"bytes,5,opt,name=status,proto3" json:"status,omitempty"`
+	CurrentLevel int64  `protobuf:"varint,6,opt,name=currentLevel,proto3" json:"currentLevel,omitempty"`
+	Current      string `protobuf:"bytes,7,opt,name=current,proto3" json:"current,omitempty"`
+	CurrentMax   string `protobuf:"bytes,8,opt,name=currentMax,proto3" json:"currentMax,omitempty"`
+}
+
+func (x *AdminLocationListReply_LocationList) Reset() {
+	*x = AdminLocationListReply_LocationList{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_app_app_api_app_proto_msgTypes[165]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AdminLocationListReply_LocationList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminLocationListReply_LocationList) ProtoMessage() {}
+
+func (x *AdminLocationListReply_LocationList) ProtoReflect() protoreflect.Message {
+	mi := &file_app_app_api_app_proto_msgTypes[165]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminLocationListReply_LocationList.ProtoReflect.Descriptor instead.
+func (*AdminLocationListReply_LocationList) Descriptor() ([]byte, []int) {
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{33, 0}
+}
+
+func (x *AdminLocationListReply_LocationList) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *AdminLocationListReply_LocationList) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *AdminLocationListReply_LocationList) GetRow() int64 {
+	if x != nil {
+		return x.Row
+	}
+	return 0
+}
+
+func (x *AdminLocationListReply_LocationList) GetCol() int64 {
+	if x != nil {
+		return x.Col
+	}
+	return 0
+}
+
+func (x *AdminLocationListReply_LocationList) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *AdminLocationListReply_LocationList) GetCurrentLevel() int64 {
+	if x != nil {
+		return x.CurrentLevel
+	}
+	return 0
+}
+
+func (x *AdminLocationListReply_LocationList) GetCurrent() string {
+	if x != nil {
+		return x.Current
+	}
+	return ""
+}
+
+func (x *AdminLocationListReply_LocationList) GetCurrentMax() string {
+	if x != nil {
+		return x.CurrentMax
+	}
+	return ""
+}
+
+type AdminLocationAllListReply_LocationList struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CreatedAt    string `protobuf:"bytes,1,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	Address      string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	Row          int64  `protobuf:"varint,3,opt,name=row,proto3" json:"row,omitempty"`
+	Col          int64  `protobuf:"varint,4,opt,name=col,proto3" json:"col,omitempty"`
+	Status       string `protobuf:"bytes,5,opt,name=status,proto3" json:"status,omitempty"`
+	CurrentLevel int64  `protobuf:"varint,6,opt,name=currentLevel,proto3" json:"currentLevel,omitempty"`
+	Current      string `protobuf:"bytes,7,opt,name=current,proto3" json:"current,omitempty"`
+	CurrentMax   string `protobuf:"bytes,8,opt,name=currentMax,proto3" json:"currentMax,omitempty"`
+}
+
+func (x *AdminLocationAllListReply_LocationList) Reset() {
+	*x = AdminLocationAllListReply_LocationList{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_app_app_api_app_proto_msgTypes[166]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AdminLocationAllListReply_LocationList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminLocationAllListReply_LocationList) ProtoMessage() {}
+
+func (x *AdminLocationAllListReply_LocationList) ProtoReflect() protoreflect.Message {
+	mi := &file_app_app_api_app_proto_msgTypes[166]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminLocationAllListReply_LocationList.ProtoReflect.Descriptor instead.
+func (*AdminLocationAllListReply_LocationList) Descriptor() ([]byte, []int) {
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{35, 0}
+}
+
+func (x *AdminLocationAllListReply_LocationList) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *AdminLocationAllListReply_LocationList) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *AdminLocationAllListReply_LocationList) GetRow() int64 {
+	if x != nil {
+		return x.Row
+	}
+	return 0
+}
+
+func (x *AdminLocationAllListReply_LocationList) GetCol() int64 {
+	if x != nil {
+		return x.Col
+	}
+	return 0
+}
+
+func (x *AdminLocationAllListReply_LocationList) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *AdminLocationAllListReply_LocationList) GetCurrentLevel() int64 {
+	if x != nil {
+		return x.CurrentLevel
+	}
+	return 0
+}
+
+func (x *AdminLocationAllListReply_LocationList) GetCurrent() string {
+	if x != nil {
+		return x.Current
+	}
+	return ""
+}
+
+func (x *AdminLocationAllListReply_LocationList) GetCurrentMax() string {
+	if x != nil {
+		return x.CurrentMax
+	}
+	return ""
+}
+
+type AdminWithdrawListReply_List struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Address   string `protobuf:"bytes,5,opt,name=address,proto3" json:"address,omitempty"`
+	Id        int64  `protobuf:"varint,7,opt,name=id,proto3" json:"id,omitempty"`
+	CreatedAt string `protobuf:"bytes,1,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	Amount    string `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	RelAmount string `protobuf:"bytes,6,opt,name=relAmount,proto3" json:"relAmount,omitempty"`
+	Type      string `protobuf:"bytes,3,opt,name=type,proto3" json:"type,omitempty"`
+	Status    string `protobuf:"bytes,4,opt,name=status,proto3" json:"status,omitempty"`
+	Reason    string `protobuf:"bytes,8,opt,name=reason,proto3" json:"reason,omitempty"`
+}
+
+func (x *AdminWithdrawListReply_List) Reset() {
+	*x = AdminWithdrawListReply_List{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_app_app_api_app_proto_msgTypes[167]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AdminWithdrawListReply_List) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminWithdrawListReply_List) ProtoMessage() {}
+
+func (x *AdminWithdrawListReply_List) ProtoReflect() protoreflect.Message {
+	mi := &file_app_app_api_app_proto_msgTypes[167]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminWithdrawListReply_List.ProtoReflect.Descriptor instead.
+func (*AdminWithdrawListReply_List) Descriptor() ([]byte, []int) {
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{37, 0}
+}
+
+func (x *AdminWithdrawListReply_List) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *AdminWithdrawListReply_List) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *AdminWithdrawListReply_List) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *AdminWithdrawListReply_List) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+func (x *AdminWithdrawListReply_List) GetRelAmount() string {
+	if x != nil {
+		return x.RelAmount
+	}
+	return ""
+}
+
+func (x *AdminWithdrawListReply_List) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *AdminWithdrawListReply_List) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *AdminWithdrawListReply_List) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type AdminUserRecommendReply_List struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId    int64  `protobuf:"varint,4,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Address   string `protobuf:"bytes,3,opt,name=address,proto3" json:"address,omitempty"`
+	Id        int64  `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+	CreatedAt string `protobuf:"bytes,1,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+}
+
+func (x *AdminUserRecommendReply_List) Reset() {
+	*x = AdminUserRecommendReply_List{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_app_app_api_app_proto_msgTypes[168]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AdminUserRecommendReply_List) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminUserRecommendReply_List) ProtoMessage() {}
+
+func (x *AdminUserRecommendReply_List) ProtoReflect() protoreflect.Message {
+	mi := &file_app_app_api_app_proto_msgTypes[168]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminUserRecommendReply_List.ProtoReflect.Descriptor instead.
+func (*AdminUserRecommendReply_List) Descriptor() ([]byte, []int) {
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{51, 0}
+}
+
+func (x *AdminUserRecommendReply_List) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *AdminUserRecommendReply_List) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *AdminUserRecommendReply_List) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *AdminUserRecommendReply_List) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+type AdminMonthRecommendReply_List struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Address          string `protobuf:"bytes,3,opt,name=address,proto3" json:"address,omitempty"`
+	RecommendAddress string `protobuf:"bytes,4,opt,name=recommendAddress,proto3" json:"recommendAddress,omitempty"`
+	Id               int64  `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+	CreatedAt        string `protobuf:"bytes,1,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+}
+
+func (x *AdminMonthRecommendReply_List) Reset() {
+	*x = AdminMonthRecommendReply_List{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_app_app_api_app_proto_msgTypes[169]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AdminMonthRecommendReply_List) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminMonthRecommendReply_List) ProtoMessage() {}
+
+func (x *AdminMonthRecommendReply_List) ProtoReflect() protoreflect.Message {
+	mi := &file_app_app_api_app_proto_msgTypes[169]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminMonthRecommendReply_List.ProtoReflect.Descriptor instead.
+func (*AdminMonthRecommendReply_List) Descriptor() ([]byte, []int) {
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{53, 0}
+}
+
+func (x *AdminMonthRecommendReply_List) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *AdminMonthRecommendReply_List) GetRecommendAddress() string {
+	if x != nil {
+		return x.RecommendAddress
+	}
+	return ""
+}
+
+func (x *AdminMonthRecommendReply_List) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *AdminMonthRecommendReply_List) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+type AdminConfigReply_List struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id    int64  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name  string `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Value string `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+}
+
+func (x *AdminConfigReply_List) Reset() {
+	*x = AdminConfigReply_List{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_app_app_api_app_proto_msgTypes[170]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AdminConfigReply_List) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminConfigReply_List) ProtoMessage() {}
+
+func (x *AdminConfigReply_List) ProtoReflect() protoreflect.Message {
+	mi := &file_app_app_api_app_proto_msgTypes[170]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminConfigReply_List.ProtoReflect.Descriptor instead.
+func (*AdminConfigReply_List) Descriptor() ([]byte, []int) {
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{55, 0}
+}
+
+func (x *AdminConfigReply_List) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *AdminConfigReply_List) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *AdminConfigReply_List) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+type AdminListReply_List struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id      int64  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Account string `protobuf:"bytes,2,opt,name=account,proto3" json:"account,omitempty"`
+}
+
+func (x *AdminListReply_List) Reset() {
+	*x = AdminListReply_List{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_app_app_api_app_proto_msgTypes[171]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AdminListReply_List) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminListReply_List) ProtoMessage() {}
+
+func (x *AdminListReply_List) ProtoReflect() protoreflect.Message {
+	mi := &file_app_app_api_app_proto_msgTypes[171]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminListReply_List.ProtoReflect.Descriptor instead.
+func (*AdminListReply_List) Descriptor() ([]byte, []int) {
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{57, 0}
+}
+
+func (x *AdminListReply_List) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *AdminListReply_List) GetAccount() string {
+	if x != nil {
+		return x.Account
+	}
+	return ""
+}
+
+type AuthListReply_List struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id   int64  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Path string `protobuf:"bytes,3,opt,name=path,proto3" json:"path,omitempty"`
+}
+
+func (x *AuthListReply_List) Reset() {
+	*x = AuthListReply_List{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_app_app_api_app_proto_msgTypes[172]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AuthListReply_List) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuthListReply_List) ProtoMessage() {}
+
+func (x *AuthListReply_List) ProtoReflect() protoreflect.Message {
+	mi := &file_app_app_api_app_proto_msgTypes[172]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuthListReply_List.ProtoReflect.Descriptor instead.
+func (*AuthListReply_List) Descriptor() ([]byte, []int) {
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{59, 0}
+}
+
+func (x *AuthListReply_List) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *AuthListReply_List) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *AuthListReply_List) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+type UserAuthListReply_List struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id   int64  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Path string `protobuf:"bytes,3,opt,name=path,proto3" json:"path,omitempty"`
+}
+
+func (x *UserAuthListReply_List) Reset() {
+	*x = UserAuthListReply_List{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_app_app_api_app_proto_msgTypes[173]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UserAuthListReply_List) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserAuthListReply_List) ProtoMessage() {}
+
+func (x *UserAuthListReply_List) ProtoReflect() protoreflect.Message {
+	mi := &file_app_app_api_app_proto_msgTypes[173]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserAuthListReply_List.ProtoReflect.Descriptor instead.
+func (*UserAuthListReply_List) Descriptor() ([]byte, []int) {
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{61, 0}
+}
+
+func (x *UserAuthListReply_List) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *UserAuthListReply_List) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *UserAuthListReply_List) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+type MyAuthListReply_List struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id   int64  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Path string `protobuf:"bytes,3,opt,name=path,proto3" json:"path,omitempty"`
+}
+
+func (x *MyAuthListReply_List) Reset() {
+	*x = MyAuthListReply_List{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_app_app_api_app_proto_msgTypes[174]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MyAuthListReply_List) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MyAuthListReply_List) ProtoMessage() {}
+
+func (x *MyAuthListReply_List) ProtoReflect() protoreflect.Message {
+	mi := &file_app_app_api_app_proto_msgTypes[174]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MyAuthListReply_List.ProtoReflect.Descriptor instead.
+func (*MyAuthListReply_List) Descriptor() ([]byte, []int) {
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{63, 0}
+}
+
+func (x *MyAuthListReply_List) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *MyAuthListReply_List) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *MyAuthListReply_List) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+type AdminConfigUpdateRequest_SendBody struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id    int64  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Value string `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+}
+
+func (x *AdminConfigUpdateRequest_SendBody) Reset() {
+	*x = AdminConfigUpdateRequest_SendBody{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_app_app_api_app_proto_msgTypes[175]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AdminConfigUpdateRequest_SendBody) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminConfigUpdateRequest_SendBody) ProtoMessage() {}
+
+func (x *AdminConfigUpdateRequest_SendBody) ProtoReflect() protoreflect.Message {
+	mi := &file_app_app_api_app_proto_msgTypes[175]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminConfigUpdateRequest_SendBody.ProtoReflect.Descriptor instead.
+func (*AdminConfigUpdateRequest_SendBody) Descriptor() ([]byte, []int) {
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{64, 0}
+}
+
+func (x *AdminConfigUpdateRequest_SendBody) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *AdminConfigUpdateRequest_SendBody) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+type AdminVipUpdateRequest_SendBody struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId int64 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Vip    int64 `protobuf:"varint,2,opt,name=vip,proto3" json:"vip,omitempty"`
+}
+
+func (x *AdminVipUpdateRequest_SendBody) Reset() {
+	*x = AdminVipUpdateRequest_SendBody{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_app_app_api_app_proto_msgTypes[176]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AdminVipUpdateRequest_SendBody) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminVipUpdateRequest_SendBody) ProtoMessage() {}
+
+func (x *AdminVipUpdateRequest_SendBody) ProtoReflect() protoreflect.Message {
+	mi := &file_app_app_api_app_proto_msgTypes[176]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminVipUpdateRequest_SendBody.ProtoReflect.Descriptor instead.
+func (*AdminVipUpdateRequest_SendBody) Descriptor() ([]byte, []int) {
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{66, 0}
+}
+
+func (x *AdminVipUpdateRequest_SendBody) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *AdminVipUpdateRequest_SendBody) GetVip() int64 {
+	if x != nil {
+		return x.Vip
+	}
+	return 0
+}
+
+type AdminUndoUpdateRequest_SendBody struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId int64 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Undo   int64 `protobuf:"varint,2,opt,name=undo,proto3" json:"undo,omitempty"`
+}
+
+func (x *AdminUndoUpdateRequest_SendBody) Reset() {
+	*x = AdminUndoUpdateRequest_SendBody{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_app_app_api_app_proto_msgTypes[177]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AdminUndoUpdateRequest_SendBody) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminUndoUpdateRequest_SendBody) ProtoMessage() {}
+
+func (x *AdminUndoUpdateRequest_SendBody) ProtoReflect() protoreflect.Message {
+	mi := &file_app_app_api_app_proto_msgTypes[177]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminUndoUpdateRequest_SendBody.ProtoReflect.Descriptor instead.
+func (*AdminUndoUpdateRequest_SendBody) Descriptor() ([]byte, []int) {
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{68, 0}
+}
+
+func (x *AdminUndoUpdateRequest_SendBody) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *AdminUndoUpdateRequest_SendBody) GetUndo() int64 {
+	if x != nil {
+		return x.Undo
+	}
+	return 0
+}
+
+type AdminAreaLevelUpdateRequest_SendBody struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId int64 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Level  int64 `protobuf:"varint,2,opt,name=level,proto3" json:"level,omitempty"`
+}
+
+func (x *AdminAreaLevelUpdateRequest_SendBody) Reset() {
+	*x = AdminAreaLevelUpdateRequest_SendBody{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_app_app_api_app_proto_msgTypes[178]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AdminAreaLevelUpdateRequest_SendBody) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminAreaLevelUpdateRequest_SendBody) ProtoMessage() {}
+
+func (x *AdminAreaLevelUpdateRequest_SendBody) ProtoReflect() protoreflect.Message {
+	mi := &file_app_app_api_app_proto_msgTypes[178]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminAreaLevelUpdateRequest_SendBody.ProtoReflect.Descriptor instead.
+func (*AdminAreaLevelUpdateRequest_SendBody) Descriptor() ([]byte, []int) {
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{70, 0}
+}
+
+func (x *AdminAreaLevelUpdateRequest_SendBody) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *AdminAreaLevelUpdateRequest_SendBody) GetLevel() int64 {
+	if x != nil {
+		return x.Level
+	}
+	return 0
+}
+
+type AdminLocationInsertRequest_SendBody struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId int64 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Amount int64 `protobuf:"varint,2,opt,name=amount,proto3" json:"amount,omitempty"`
+}
+
+func (x *AdminLocationInsertRequest_SendBody) Reset() {
+	*x = AdminLocationInsertRequest_SendBody{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_app_app_api_app_proto_msgTypes[179]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AdminLocationInsertRequest_SendBody) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminLocationInsertRequest_SendBody) ProtoMessage() {}
+
+func (x *AdminLocationInsertRequest_SendBody) ProtoReflect() protoreflect.Message {
+	mi := &file_app_app_api_app_proto_msgTypes[179]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminLocationInsertRequest_SendBody.ProtoReflect.Descriptor instead.
+func (*AdminLocationInsertRequest_SendBody) Descriptor() ([]byte, []int) {
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{72, 0}
+}
+
+func (x *AdminLocationInsertRequest_SendBody) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *AdminLocationInsertRequest_SendBody) GetAmount() int64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+type AdminBalanceUpdateRequest_SendBody struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId     int64  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Amount     string `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount,omitempty"` // 正数加，负数减
+	CoinType   string `protobuf:"bytes,3,opt,name=coin_type,json=coinType,proto3" json:"coin_type,omitempty"`
+	ReasonCode string `protobuf:"bytes,4,opt,name=reason_code,json=reasonCode,proto3" json:"reason_code,omitempty"`
+	Note       string `protobuf:"bytes,5,opt,name=note,proto3" json:"note,omitempty"`
+}
+
+func (x *AdminBalanceUpdateRequest_SendBody) Reset() {
+	*x = AdminBalanceUpdateRequest_SendBody{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_app_app_api_app_proto_msgTypes[180]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AdminBalanceUpdateRequest_SendBody) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminBalanceUpdateRequest_SendBody) ProtoMessage() {}
+
+func (x *AdminBalanceUpdateRequest_SendBody) ProtoReflect() protoreflect.Message {
+	mi := &file_app_app_api_app_proto_msgTypes[180]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminBalanceUpdateRequest_SendBody.ProtoReflect.Descriptor instead.
+func (*AdminBalanceUpdateRequest_SendBody) Descriptor() ([]byte, []int) {
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{74, 0}
+}
+
+func (x *AdminBalanceUpdateRequest_SendBody) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *AdminBalanceUpdateRequest_SendBody) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+func (x *AdminBalanceUpdateRequest_SendBody) GetCoinType() string {
+	if x != nil {
+		return x.CoinType
+	}
+	return ""
+}
+
+func (x *AdminBalanceUpdateRequest_SendBody) GetReasonCode() string {
+	if x != nil {
+		return x.ReasonCode
+	}
+	return ""
+}
+
+func (x *AdminBalanceUpdateRequest_SendBody) GetNote() string {
+	if x != nil {
+		return x.Note
+	}
+	return ""
+}
+
+type AuthAdminCreateRequest_SendBody struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AdminId int64 `protobuf:"varint,1,opt,name=admin_id,json=adminId,proto3" json:"admin_id,omitempty"`
+	AuthId  int64 `protobuf:"varint,2,opt,name=auth_id,json=authId,proto3" json:"auth_id,omitempty"`
+}
+
+func (x *AuthAdminCreateRequest_SendBody) Reset() {
+	*x = AuthAdminCreateRequest_SendBody{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_app_app_api_app_proto_msgTypes[181]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AuthAdminCreateRequest_SendBody) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuthAdminCreateRequest_SendBody) ProtoMessage() {}
+
+func (x *AuthAdminCreateRequest_SendBody) ProtoReflect() protoreflect.Message {
+	mi := &file_app_app_api_app_proto_msgTypes[181]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuthAdminCreateRequest_SendBody.ProtoReflect.Descriptor instead.
+func (*AuthAdminCreateRequest_SendBody) Descriptor() ([]byte, []int) {
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{76, 0}
+}
+
+func (x *AuthAdminCreateRequest_SendBody) GetAdminId() int64 {
+	if x != nil {
+		return x.AdminId
+	}
+	return 0
+}
+
+func (x *AuthAdminCreateRequest_SendBody) GetAuthId() int64 {
+	if x != nil {
+		return x.AuthId
+	}
+	return 0
+}
+
+type AuthAdminDeleteRequest_SendBody struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AdminId int64 `protobuf:"varint,1,opt,name=admin_id,json=adminId,proto3" json:"admin_id,omitempty"`
+	AuthId  int64 `protobuf:"varint,2,opt,name=auth_id,json=authId,proto3" json:"auth_id,omitempty"`
+}
+
+func (x *AuthAdminDeleteRequest_SendBody) Reset() {
+	*x = AuthAdminDeleteRequest_SendBody{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_app_app_api_app_proto_msgTypes[182]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AuthAdminDeleteRequest_SendBody) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuthAdminDeleteRequest_SendBody) ProtoMessage() {}
+
+func (x *AuthAdminDeleteRequest_SendBody) ProtoReflect() protoreflect.Message {
+	mi := &file_app_app_api_app_proto_msgTypes[182]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuthAdminDeleteRequest_SendBody.ProtoReflect.Descriptor instead.
+func (*AuthAdminDeleteRequest_SendBody) Descriptor() ([]byte, []int) {
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{78, 0}
+}
+
+func (x *AuthAdminDeleteRequest_SendBody) GetAdminId() int64 {
+	if x != nil {
+		return x.AdminId
+	}
+	return 0
+}
+
+func (x *AuthAdminDeleteRequest_SendBody) GetAuthId() int64 {
+	if x != nil {
+		return x.AuthId
+	}
+	return 0
+}
+
+type AdminLoginRequest_SendBody struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Account  string `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
+	Password string `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`
+}
+
+func (x *AdminLoginRequest_SendBody) Reset() {
+	*x = AdminLoginRequest_SendBody{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_app_app_api_app_proto_msgTypes[183]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AdminLoginRequest_SendBody) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminLoginRequest_SendBody) ProtoMessage() {}
+
+func (x *AdminLoginRequest_SendBody) ProtoReflect() protoreflect.Message {
+	mi := &file_app_app_api_app_proto_msgTypes[183]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminLoginRequest_SendBody.ProtoReflect.Descriptor instead.
+func (*AdminLoginRequest_SendBody) Descriptor() ([]byte, []int) {
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{92, 0}
+}
+
+func (x *AdminLoginRequest_SendBody) GetAccount() string {
+	if x != nil {
+		return x.Account
+	}
+	return ""
+}
+
+func (x *AdminLoginRequest_SendBody) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+type AdminChangePasswordRequest_SendBody struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Account  string `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
+	Password string `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`
+}
+
+func (x *AdminChangePasswordRequest_SendBody) Reset() {
+	*x = AdminChangePasswordRequest_SendBody{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_app_app_api_app_proto_msgTypes[184]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AdminChangePasswordRequest_SendBody) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminChangePasswordRequest_SendBody) ProtoMessage() {}
+
+func (x *AdminChangePasswordRequest_SendBody) ProtoReflect() protoreflect.Message {
+	mi := &file_app_app_api_app_proto_msgTypes[184]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminChangePasswordRequest_SendBody.ProtoReflect.Descriptor instead.
+func (*AdminChangePasswordRequest_SendBody) Descriptor() ([]byte, []int) {
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{94, 0}
+}
+
+func (x *AdminChangePasswordRequest_SendBody) GetAccount() string {
+	if x != nil {
+		return x.Account
+	}
+	return ""
+}
+
+func (x *AdminChangePasswordRequest_SendBody) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+type AdminCreateAccountRequest_SendBody struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Account  string `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
+	Password string `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`
+}
+
+func (x *AdminCreateAccountRequest_SendBody) Reset() {
+	*x = AdminCreateAccountRequest_SendBody{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_app_app_api_app_proto_msgTypes[185]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AdminCreateAccountRequest_SendBody) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminCreateAccountRequest_SendBody) ProtoMessage() {}
+
+func (x *AdminCreateAccountRequest_SendBody) ProtoReflect() protoreflect.Message {
+	mi := &file_app_app_api_app_proto_msgTypes[185]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminCreateAccountRequest_SendBody.ProtoReflect.Descriptor instead.
+func (*AdminCreateAccountRequest_SendBody) Descriptor() ([]byte, []int) {
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{96, 0}
+}
+
+func (x *AdminCreateAccountRequest_SendBody) GetAccount() string {
+	if x != nil {
+		return x.Account
+	}
+	return ""
+}
+
+func (x *AdminCreateAccountRequest_SendBody) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+type AdminAuditLogListReply_List struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id        int64  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	AdminId   int64  `protobuf:"varint,2,opt,name=admin_id,json=adminId,proto3" json:"admin_id,omitempty"`
+	Account   string `protobuf:"bytes,3,opt,name=account,proto3" json:"account,omitempty"`
+	Operation string `protobuf:"bytes,4,opt,name=operation,proto3" json:"operation,omitempty"`
+	Payload   string `protobuf:"bytes,5,opt,name=payload,proto3" json:"payload,omitempty"`
+	Before    string `protobuf:"bytes,6,opt,name=before,proto3" json:"before,omitempty"`
+	After     string `protobuf:"bytes,7,opt,name=after,proto3" json:"after,omitempty"`
+	Ip        string `protobuf:"bytes,8,opt,name=ip,proto3" json:"ip,omitempty"`
+	CreatedAt string `protobuf:"bytes,9,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+}
+
+func (x *AdminAuditLogListReply_List) Reset() {
+	*x = AdminAuditLogListReply_List{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_app_app_api_app_proto_msgTypes[186]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AdminAuditLogListReply_List) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminAuditLogListReply_List) ProtoMessage() {}
+
+func (x *AdminAuditLogListReply_List) ProtoReflect() protoreflect.Message {
+	mi := &file_app_app_api_app_proto_msgTypes[186]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminAuditLogListReply_List.ProtoReflect.Descriptor instead.
+func (*AdminAuditLogListReply_List) Descriptor() ([]byte, []int) {
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{99, 0}
+}
+
+func (x *AdminAuditLogListReply_List) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *AdminAuditLogListReply_List) GetAdminId() int64 {
+	if x != nil {
+		return x.AdminId
+	}
+	return 0
+}
+
+func (x *AdminAuditLogListReply_List) GetAccount() string {
+	if x != nil {
+		return x.Account
+	}
+	return ""
+}
+
+func (x *AdminAuditLogListReply_List) GetOperation() string {
+	if x != nil {
+		return x.Operation
+	}
+	return ""
+}
+
+func (x *AdminAuditLogListReply_List) GetPayload() string {
+	if x != nil {
+		return x.Payload
+	}
+	return ""
+}
+
+func (x *AdminAuditLogListReply_List) GetBefore() string {
+	if x != nil {
+		return x.Before
+	}
+	return ""
+}
+
+func (x *AdminAuditLogListReply_List) GetAfter() string {
+	if x != nil {
+		return x.After
+	}
+	return ""
+}
+
+func (x *AdminAuditLogListReply_List) GetIp() string {
+	if x != nil {
+		return x.Ip
+	}
+	return ""
+}
+
+func (x *AdminAuditLogListReply_List) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+type AdminProposalListReply_List struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id         int64  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Operation  string `protobuf:"bytes,2,opt,name=operation,proto3" json:"operation,omitempty"`
+	Payload    string `protobuf:"bytes,3,opt,name=payload,proto3" json:"payload,omitempty"`
+	ProposerId int64  `protobuf:"varint,4,opt,name=proposer_id,json=proposerId,proto3" json:"proposer_id,omitempty"`
+	CheckerId  int64  `protobuf:"varint,5,opt,name=checker_id,json=checkerId,proto3" json:"checker_id,omitempty"`
+	Status     string `protobuf:"bytes,6,opt,name=status,proto3" json:"status,omitempty"`
+	Result     string `protobuf:"bytes,7,opt,name=result,proto3" json:"result,omitempty"`
+	CreatedAt  string `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt  string `protobuf:"bytes,9,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+}
+
+func (x *AdminProposalListReply_List) Reset() {
+	*x = AdminProposalListReply_List{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_app_app_api_app_proto_msgTypes[187]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AdminProposalListReply_List) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminProposalListReply_List) ProtoMessage() {}
+
+func (x *AdminProposalListReply_List) ProtoReflect() protoreflect.Message {
+	mi := &file_app_app_api_app_proto_msgTypes[187]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminProposalListReply_List.ProtoReflect.Descriptor instead.
+func (*AdminProposalListReply_List) Descriptor() ([]byte, []int) {
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{101, 0}
+}
+
+func (x *AdminProposalListReply_List) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *AdminProposalListReply_List) GetOperation() string {
+	if x != nil {
+		return x.Operation
+	}
+	return ""
+}
+
+func (x *AdminProposalListReply_List) GetPayload() string {
+	if x != nil {
+		return x.Payload
+	}
+	return ""
+}
+
+func (x *AdminProposalListReply_List) GetProposerId() int64 {
+	if x != nil {
+		return x.ProposerId
+	}
+	return 0
+}
+
+func (x *AdminProposalListReply_List) GetCheckerId() int64 {
+	if x != nil {
+		return x.CheckerId
+	}
+	return 0
+}
+
+func (x *AdminProposalListReply_List) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *AdminProposalListReply_List) GetResult() string {
+	if x != nil {
+		return x.Result
+	}
+	return ""
+}
+
+func (x *AdminProposalListReply_List) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *AdminProposalListReply_List) GetUpdatedAt() string {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return ""
+}
+
+type AdminProposalApproveRequest_SendBody struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id int64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *AdminProposalApproveRequest_SendBody) Reset() {
+	*x = AdminProposalApproveRequest_SendBody{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_app_app_api_app_proto_msgTypes[188]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AdminProposalApproveRequest_SendBody) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminProposalApproveRequest_SendBody) ProtoMessage() {}
+
+func (x *AdminProposalApproveRequest_SendBody) ProtoReflect() protoreflect.Message {
+	mi := &file_app_app_api_app_proto_msgTypes[188]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminProposalApproveRequest_SendBody.ProtoReflect.Descriptor instead.
+func (*AdminProposalApproveRequest_SendBody) Descriptor() ([]byte, []int) {
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{102, 0}
+}
+
+func (x *AdminProposalApproveRequest_SendBody) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type AdminProposalRejectRequest_SendBody struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id     int64  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Reason string `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+}
+
+func (x *AdminProposalRejectRequest_SendBody) Reset() {
+	*x = AdminProposalRejectRequest_SendBody{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_app_app_api_app_proto_msgTypes[189]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AdminProposalRejectRequest_SendBody) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminProposalRejectRequest_SendBody) ProtoMessage() {}
+
+func (x *AdminProposalRejectRequest_SendBody) ProtoReflect() protoreflect.Message {
+	mi := &file_app_app_api_app_proto_msgTypes[189]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminProposalRejectRequest_SendBody.ProtoReflect.Descriptor instead.
+func (*AdminProposalRejectRequest_SendBody) Descriptor() ([]byte, []int) {
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{104, 0}
+}
+
+func (x *AdminProposalRejectRequest_SendBody) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *AdminProposalRejectRequest_SendBody) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type AdminWithdrawReviewListReply_List struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	WithdrawId int64  `protobuf:"varint,1,opt,name=withdraw_id,json=withdrawId,proto3" json:"withdraw_id,omitempty"`
+	Address    string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	Amount     string `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	Type       string `protobuf:"bytes,4,opt,name=type,proto3" json:"type,omitempty"`
+	Rule       string `protobuf:"bytes,5,opt,name=rule,proto3" json:"rule,omitempty"`
+	Detail     string `protobuf:"bytes,6,opt,name=detail,proto3" json:"detail,omitempty"`
+	Status     string `protobuf:"bytes,7,opt,name=status,proto3" json:"status,omitempty"`
+	AdminId    int64  `protobuf:"varint,8,opt,name=admin_id,json=adminId,proto3" json:"admin_id,omitempty"`
+	Reason     string `protobuf:"bytes,9,opt,name=reason,proto3" json:"reason,omitempty"`
+	CreatedAt  string `protobuf:"bytes,10,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+}
+
+func (x *AdminWithdrawReviewListReply_List) Reset() {
+	*x = AdminWithdrawReviewListReply_List{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_app_app_api_app_proto_msgTypes[190]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AdminWithdrawReviewListReply_List) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminWithdrawReviewListReply_List) ProtoMessage() {}
+
+func (x *AdminWithdrawReviewListReply_List) ProtoReflect() protoreflect.Message {
+	mi := &file_app_app_api_app_proto_msgTypes[190]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminWithdrawReviewListReply_List.ProtoReflect.Descriptor instead.
+func (*AdminWithdrawReviewListReply_List) Descriptor() ([]byte, []int) {
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{107, 0}
+}
+
+func (x *AdminWithdrawReviewListReply_List) GetWithdrawId() int64 {
+	if x != nil {
+		return x.WithdrawId
+	}
+	return 0
+}
+
+func (x *AdminWithdrawReviewListReply_List) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *AdminWithdrawReviewListReply_List) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+func (x *AdminWithdrawReviewListReply_List) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *AdminWithdrawReviewListReply_List) GetRule() string {
+	if x != nil {
+		return x.Rule
+	}
+	return ""
+}
+
+func (x *AdminWithdrawReviewListReply_List) GetDetail() string {
+	if x != nil {
+		return x.Detail
+	}
+	return ""
+}
+
+func (x *AdminWithdrawReviewListReply_List) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *AdminWithdrawReviewListReply_List) GetAdminId() int64 {
+	if x != nil {
+		return x.AdminId
+	}
+	return 0
+}
+
+func (x *AdminWithdrawReviewListReply_List) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *AdminWithdrawReviewListReply_List) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+type AdminWithdrawReviewApproveRequest_SendBody struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id int64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *AdminWithdrawReviewApproveRequest_SendBody) Reset() {
+	*x = AdminWithdrawReviewApproveRequest_SendBody{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_app_app_api_app_proto_msgTypes[191]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AdminWithdrawReviewApproveRequest_SendBody) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminWithdrawReviewApproveRequest_SendBody) ProtoMessage() {}
+
+func (x *AdminWithdrawReviewApproveRequest_SendBody) ProtoReflect() protoreflect.Message {
+	mi := &file_app_app_api_app_proto_msgTypes[191]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminWithdrawReviewApproveRequest_SendBody.ProtoReflect.Descriptor instead.
+func (*AdminWithdrawReviewApproveRequest_SendBody) Descriptor() ([]byte, []int) {
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{108, 0}
+}
+
+func (x *AdminWithdrawReviewApproveRequest_SendBody) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type AdminWithdrawReviewRejectRequest_SendBody struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id     int64  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Reason string `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+}
+
+func (x *AdminWithdrawReviewRejectRequest_SendBody) Reset() {
+	*x = AdminWithdrawReviewRejectRequest_SendBody{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_app_app_api_app_proto_msgTypes[192]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AdminWithdrawReviewRejectRequest_SendBody) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminWithdrawReviewRejectRequest_SendBody) ProtoMessage() {}
+
+func (x *AdminWithdrawReviewRejectRequest_SendBody) ProtoReflect() protoreflect.Message {
+	mi := &file_app_app_api_app_proto_msgTypes[192]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminWithdrawReviewRejectRequest_SendBody.ProtoReflect.Descriptor instead.
+func (*AdminWithdrawReviewRejectRequest_SendBody) Descriptor() ([]byte, []int) {
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{110, 0}
+}
+
+func (x *AdminWithdrawReviewRejectRequest_SendBody) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *AdminWithdrawReviewRejectRequest_SendBody) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type AdminWithdrawRejectRequest_SendBody struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id     int64  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Reason string `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+}
+
+func (x *AdminWithdrawRejectRequest_SendBody) Reset() {
+	*x = AdminWithdrawRejectRequest_SendBody{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_app_app_api_app_proto_msgTypes[193]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AdminWithdrawRejectRequest_SendBody) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminWithdrawRejectRequest_SendBody) ProtoMessage() {}
+
+func (x *AdminWithdrawRejectRequest_SendBody) ProtoReflect() protoreflect.Message {
+	mi := &file_app_app_api_app_proto_msgTypes[193]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminWithdrawRejectRequest_SendBody.ProtoReflect.Descriptor instead.
+func (*AdminWithdrawRejectRequest_SendBody) Descriptor() ([]byte, []int) {
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{112, 0}
+}
+
+func (x *AdminWithdrawRejectRequest_SendBody) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *AdminWithdrawRejectRequest_SendBody) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type AdminWithdrawEventListReply_List struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id         int64  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	WithdrawId int64  `protobuf:"varint,2,opt,name=withdraw_id,json=withdrawId,proto3" json:"withdraw_id,omitempty"`
+	FromStatus string `protobuf:"bytes,3,opt,name=from_status,json=fromStatus,proto3" json:"from_status,omitempty"`
+	ToStatus   string `protobuf:"bytes,4,opt,name=to_status,json=toStatus,proto3" json:"to_status,omitempty"`
+	Operator   string `protobuf:"bytes,5,opt,name=operator,proto3" json:"operator,omitempty"`
+	OperatorId int64  `protobuf:"varint,6,opt,name=operator_id,json=operatorId,proto3" json:"operator_id,omitempty"`
+	Remark     string `protobuf:"bytes,7,opt,name=remark,proto3" json:"remark,omitempty"`
+	CreatedAt  string `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+}
+
+func (x *AdminWithdrawEventListReply_List) Reset() {
+	*x = AdminWithdrawEventListReply_List{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_app_app_api_app_proto_msgTypes[194]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AdminWithdrawEventListReply_List) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminWithdrawEventListReply_List) ProtoMessage() {}
+
+func (x *AdminWithdrawEventListReply_List) ProtoReflect() protoreflect.Message {
+	mi := &file_app_app_api_app_proto_msgTypes[194]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminWithdrawEventListReply_List.ProtoReflect.Descriptor instead.
+func (*AdminWithdrawEventListReply_List) Descriptor() ([]byte, []int) {
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{115, 0}
+}
+
+func (x *AdminWithdrawEventListReply_List) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *AdminWithdrawEventListReply_List) GetWithdrawId() int64 {
+	if x != nil {
+		return x.WithdrawId
+	}
+	return 0
+}
+
+func (x *AdminWithdrawEventListReply_List) GetFromStatus() string {
+	if x != nil {
+		return x.FromStatus
+	}
+	return ""
+}
+
+func (x *AdminWithdrawEventListReply_List) GetToStatus() string {
+	if x != nil {
+		return x.ToStatus
+	}
+	return ""
+}
+
+func (x *AdminWithdrawEventListReply_List) GetOperator() string {
+	if x != nil {
+		return x.Operator
+	}
+	return ""
+}
+
+func (x *AdminWithdrawEventListReply_List) GetOperatorId() int64 {
+	if x != nil {
+		return x.OperatorId
+	}
+	return 0
+}
+
+func (x *AdminWithdrawEventListReply_List) GetRemark() string {
+	if x != nil {
+		return x.Remark
+	}
+	return ""
+}
+
+func (x *AdminWithdrawEventListReply_List) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+type AdminLedgerVerifyReply_List struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId   int64  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	CoinType string `protobuf:"bytes,2,opt,name=coin_type,json=coinType,proto3" json:"coin_type,omitempty"`
+	Balance  string `protobuf:"bytes,3,opt,name=balance,proto3" json:"balance,omitempty"`
+	Ledger   string `protobuf:"bytes,4,opt,name=ledger,proto3" json:"ledger,omitempty"`
+}
+
+func (x *AdminLedgerVerifyReply_List) Reset() {
+	*x = AdminLedgerVerifyReply_List{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_app_app_api_app_proto_msgTypes[195]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AdminLedgerVerifyReply_List) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminLedgerVerifyReply_List) ProtoMessage() {}
+
+func (x *AdminLedgerVerifyReply_List) ProtoReflect() protoreflect.Message {
+	mi := &file_app_app_api_app_proto_msgTypes[195]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminLedgerVerifyReply_List.ProtoReflect.Descriptor instead.
+func (*AdminLedgerVerifyReply_List) Descriptor() ([]byte, []int) {
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{117, 0}
+}
+
+func (x *AdminLedgerVerifyReply_List) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *AdminLedgerVerifyReply_List) GetCoinType() string {
+	if x != nil {
+		return x.CoinType
+	}
+	return ""
+}
+
+func (x *AdminLedgerVerifyReply_List) GetBalance() string {
+	if x != nil {
+		return x.Balance
+	}
+	return ""
+}
+
+func (x *AdminLedgerVerifyReply_List) GetLedger() string {
+	if x != nil {
+		return x.Ledger
+	}
+	return ""
+}
+
+type AdminLedgerOpenRequest_SendBody struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *AdminLedgerOpenRequest_SendBody) Reset() {
+	*x = AdminLedgerOpenRequest_SendBody{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_app_app_api_app_proto_msgTypes[196]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AdminLedgerOpenRequest_SendBody) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminLedgerOpenRequest_SendBody) ProtoMessage() {}
+
+func (x *AdminLedgerOpenRequest_SendBody) ProtoReflect() protoreflect.Message {
+	mi := &file_app_app_api_app_proto_msgTypes[196]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminLedgerOpenRequest_SendBody.ProtoReflect.Descriptor instead.
+func (*AdminLedgerOpenRequest_SendBody) Descriptor() ([]byte, []int) {
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{118, 0}
+}
+
+type AdminDiscrepancyListReply_List struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id        int64  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Check     string `protobuf:"bytes,2,opt,name=check,proto3" json:"check,omitempty"`
+	UserId    int64  `protobuf:"varint,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Expected  string `protobuf:"bytes,4,opt,name=expected,proto3" json:"expected,omitempty"`
+	Actual    string `protobuf:"bytes,5,opt,name=actual,proto3" json:"actual,omitempty"`
+	Diff      string `protobuf:"bytes,6,opt,name=diff,proto3" json:"diff,omitempty"`
+	Severity  string `protobuf:"bytes,7,opt,name=severity,proto3" json:"severity,omitempty"`
+	CreatedAt string `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+}
+
+func (x *AdminDiscrepancyListReply_List) Reset() {
+	*x = AdminDiscrepancyListReply_List{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_app_app_api_app_proto_msgTypes[197]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AdminDiscrepancyListReply_List) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminDiscrepancyListReply_List) ProtoMessage() {}
+
+func (x *AdminDiscrepancyListReply_List) ProtoReflect() protoreflect.Message {
+	mi := &file_app_app_api_app_proto_msgTypes[197]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminDiscrepancyListReply_List.ProtoReflect.Descriptor instead.
+func (*AdminDiscrepancyListReply_List) Descriptor() ([]byte, []int) {
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{123, 0}
+}
+
+func (x *AdminDiscrepancyListReply_List) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *AdminDiscrepancyListReply_List) GetCheck() string {
+	if x != nil {
+		return x.Check
+	}
+	return ""
+}
+
+func (x *AdminDiscrepancyListReply_List) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *AdminDiscrepancyListReply_List) GetExpected() string {
+	if x != nil {
+		return x.Expected
+	}
+	return ""
+}
+
+func (x *AdminDiscrepancyListReply_List) GetActual() string {
+	if x != nil {
+		return x.Actual
+	}
+	return ""
+}
+
+func (x *AdminDiscrepancyListReply_List) GetDiff() string {
+	if x != nil {
+		return x.Diff
+	}
+	return ""
+}
+
+func (x *AdminDiscrepancyListReply_List) GetSeverity() string {
+	if x != nil {
+		return x.Severity
+	}
+	return ""
+}
+
+func (x *AdminDiscrepancyListReply_List) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+type AdminChainReconcileReply_List struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Kind        string `protobuf:"bytes,1,opt,name=kind,proto3" json:"kind,omitempty"`
+	Issue       string `protobuf:"bytes,2,opt,name=issue,proto3" json:"issue,omitempty"`
+	Hash        string `protobuf:"bytes,3,opt,name=hash,proto3" json:"hash,omitempty"`
+	Address     string `protobuf:"bytes,4,opt,name=address,proto3" json:"address,omitempty"`
+	RecordId    int64  `protobuf:"varint,5,opt,name=record_id,json=recordId,proto3" json:"record_id,omitempty"`
+	ChainAmount string `protobuf:"bytes,6,opt,name=chain_amount,json=chainAmount,proto3" json:"chain_amount,omitempty"`
+	DbAmount    string `protobuf:"bytes,7,opt,name=db_amount,json=dbAmount,proto3" json:"db_amount,omitempty"`
+}
+
+func (x *AdminChainReconcileReply_List) Reset() {
+	*x = AdminChainReconcileReply_List{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_app_app_api_app_proto_msgTypes[198]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AdminChainReconcileReply_List) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminChainReconcileReply_List) ProtoMessage() {}
+
+func (x *AdminChainReconcileReply_List) ProtoReflect() protoreflect.Message {
+	mi := &file_app_app_api_app_proto_msgTypes[198]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminChainReconcileReply_List.ProtoReflect.Descriptor instead.
+func (*AdminChainReconcileReply_List) Descriptor() ([]byte, []int) {
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{125, 0}
+}
+
+func (x *AdminChainReconcileReply_List) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *AdminChainReconcileReply_List) GetIssue() string {
+	if x != nil {
+		return x.Issue
+	}
+	return ""
+}
+
+func (x *AdminChainReconcileReply_List) GetHash() string {
+	if x != nil {
+		return x.Hash
+	}
+	return ""
+}
+
+func (x *AdminChainReconcileReply_List) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *AdminChainReconcileReply_List) GetRecordId() int64 {
+	if x != nil {
+		return x.RecordId
+	}
+	return 0
+}
+
+func (x *AdminChainReconcileReply_List) GetChainAmount() string {
+	if x != nil {
+		return x.ChainAmount
+	}
+	return ""
+}
+
+func (x *AdminChainReconcileReply_List) GetDbAmount() string {
+	if x != nil {
+		return x.DbAmount
+	}
+	return ""
+}
+
+type AdminLiabilityListReply_List struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Date            string `protobuf:"bytes,1,opt,name=date,proto3" json:"date,omitempty"`
+	UserCount       int64  `protobuf:"varint,2,opt,name=user_count,json=userCount,proto3" json:"user_count,omitempty"`
+	BalanceUsdt     string `protobuf:"bytes,3,opt,name=balance_usdt,json=balanceUsdt,proto3" json:"balance_usdt,omitempty"`
+	BalanceDhb      string `protobuf:"bytes,4,opt,name=balance_dhb,json=balanceDhb,proto3" json:"balance_dhb,omitempty"`
+	BalanceBnb      string `protobuf:"bytes,5,opt,name=balance_bnb,json=balanceBnb,proto3" json:"balance_bnb,omitempty"`
+	WithdrawingUsdt string `protobuf:"bytes,6,opt,name=withdrawing_usdt,json=withdrawingUsdt,proto3" json:"withdrawing_usdt,omitempty"`
+	WithdrawingDhb  string `protobuf:"bytes,7,opt,name=withdrawing_dhb,json=withdrawingDhb,proto3" json:"withdrawing_dhb,omitempty"`
+	LiabilityUsdt   string `protobuf:"bytes,8,opt,name=liability_usdt,json=liabilityUsdt,proto3" json:"liability_usdt,omitempty"`
+	LiabilityDhb    string `protobuf:"bytes,9,opt,name=liability_dhb,json=liabilityDhb,proto3" json:"liability_dhb,omitempty"`
+	CreatedAt       string `protobuf:"bytes,10,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+}
+
+func (x *AdminLiabilityListReply_List) Reset() {
+	*x = AdminLiabilityListReply_List{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_app_app_api_app_proto_msgTypes[199]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AdminLiabilityListReply_List) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminLiabilityListReply_List) ProtoMessage() {}
+
+func (x *AdminLiabilityListReply_List) ProtoReflect() protoreflect.Message {
+	mi := &file_app_app_api_app_proto_msgTypes[199]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminLiabilityListReply_List.ProtoReflect.Descriptor instead.
+func (*AdminLiabilityListReply_List) Descriptor() ([]byte, []int) {
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{131, 0}
+}
+
+func (x *AdminLiabilityListReply_List) GetDate() string {
+	if x != nil {
+		return x.Date
+	}
+	return ""
+}
+
+func (x *AdminLiabilityListReply_List) GetUserCount() int64 {
+	if x != nil {
+		return x.UserCount
+	}
+	return 0
+}
+
+func (x *AdminLiabilityListReply_List) GetBalanceUsdt() string {
+	if x != nil {
+		return x.BalanceUsdt
+	}
+	return ""
+}
+
+func (x *AdminLiabilityListReply_List) GetBalanceDhb() string {
+	if x != nil {
+		return x.BalanceDhb
+	}
+	return ""
+}
+
+func (x *AdminLiabilityListReply_List) GetBalanceBnb() string {
+	if x != nil {
+		return x.BalanceBnb
+	}
+	return ""
+}
+
+func (x *AdminLiabilityListReply_List) GetWithdrawingUsdt() string {
+	if x != nil {
+		return x.WithdrawingUsdt
+	}
+	return ""
+}
+
+func (x *AdminLiabilityListReply_List) GetWithdrawingDhb() string {
+	if x != nil {
+		return x.WithdrawingDhb
+	}
+	return ""
+}
+
+func (x *AdminLiabilityListReply_List) GetLiabilityUsdt() string {
+	if x != nil {
+		return x.LiabilityUsdt
+	}
+	return ""
+}
+
+func (x *AdminLiabilityListReply_List) GetLiabilityDhb() string {
+	if x != nil {
+		return x.LiabilityDhb
+	}
+	return ""
+}
+
+func (x *AdminLiabilityListReply_List) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+type AdminUserStatementReply_List struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CreatedAt   string `protobuf:"bytes,1,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	Source      string `protobuf:"bytes,2,opt,name=source,proto3" json:"source,omitempty"`
+	RecordId    int64  `protobuf:"varint,3,opt,name=record_id,json=recordId,proto3" json:"record_id,omitempty"`
+	Type        string `protobuf:"bytes,4,opt,name=type,proto3" json:"type,omitempty"`
+	CoinType    string `protobuf:"bytes,5,opt,name=coin_type,json=coinType,proto3" json:"coin_type,omitempty"`
+	Amount      string `protobuf:"bytes,6,opt,name=amount,proto3" json:"amount,omitempty"`
+	Change      string `protobuf:"bytes,7,opt,name=change,proto3" json:"change,omitempty"`
+	BalanceUsdt string `protobuf:"bytes,8,opt,name=balance_usdt,json=balanceUsdt,proto3" json:"balance_usdt,omitempty"`
+	BalanceDhb  string `protobuf:"bytes,9,opt,name=balance_dhb,json=balanceDhb,proto3" json:"balance_dhb,omitempty"`
+	BalanceBnb  string `protobuf:"bytes,10,opt,name=balance_bnb,json=balanceBnb,proto3" json:"balance_bnb,omitempty"`
+	Detail      string `protobuf:"bytes,11,opt,name=detail,proto3" json:"detail,omitempty"`
+}
+
+func (x *AdminUserStatementReply_List) Reset() {
+	*x = AdminUserStatementReply_List{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_app_app_api_app_proto_msgTypes[200]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AdminUserStatementReply_List) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminUserStatementReply_List) ProtoMessage() {}
+
+func (x *AdminUserStatementReply_List) ProtoReflect() protoreflect.Message {
+	mi := &file_app_app_api_app_proto_msgTypes[200]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminUserStatementReply_List.ProtoReflect.Descriptor instead.
+func (*AdminUserStatementReply_List) Descriptor() ([]byte, []int) {
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{133, 0}
+}
+
+func (x *AdminUserStatementReply_List) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *AdminUserStatementReply_List) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+func (x *AdminUserStatementReply_List) GetRecordId() int64 {
+	if x != nil {
+		return x.RecordId
+	}
+	return 0
+}
+
+func (x *AdminUserStatementReply_List) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *AdminUserStatementReply_List) GetCoinType() string {
+	if x != nil {
+		return x.CoinType
+	}
+	return ""
+}
+
+func (x *AdminUserStatementReply_List) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+func (x *AdminUserStatementReply_List) GetChange() string {
+	if x != nil {
+		return x.Change
+	}
+	return ""
+}
+
+func (x *AdminUserStatementReply_List) GetBalanceUsdt() string {
+	if x != nil {
+		return x.BalanceUsdt
+	}
+	return ""
+}
+
+func (x *AdminUserStatementReply_List) GetBalanceDhb() string {
+	if x != nil {
+		return x.BalanceDhb
+	}
+	return ""
+}
+
+func (x *AdminUserStatementReply_List) GetBalanceBnb() string {
+	if x != nil {
+		return x.BalanceBnb
+	}
+	return ""
+}
+
+func (x *AdminUserStatementReply_List) GetDetail() string {
+	if x != nil {
+		return x.Detail
+	}
+	return ""
+}
+
+type AdminSolvencyListReply_List struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	LocationOutstanding string `protobuf:"bytes,1,opt,name=location_outstanding,json=locationOutstanding,proto3" json:"location_outstanding,omitempty"`
+	WithdrawingUsdt     string `protobuf:"bytes,2,opt,name=withdrawing_usdt,json=withdrawingUsdt,proto3" json:"withdrawing_usdt,omitempty"`
+	WithdrawingDhb      string `protobuf:"bytes,3,opt,name=withdrawing_dhb,json=withdrawingDhb,proto3" json:"withdrawing_dhb,omitempty"`
+	BalanceUsdt         string `protobuf:"bytes,4,opt,name=balance_usdt,json=balanceUsdt,proto3" json:"balance_usdt,omitempty"`
+	BalanceDhb          string `protobuf:"bytes,5,opt,name=balance_dhb,json=balanceDhb,proto3" json:"balance_dhb,omitempty"`
+	LiabilityUsdt       string `protobuf:"bytes,6,opt,name=liability_usdt,json=liabilityUsdt,proto3" json:"liability_usdt,omitempty"`
+	LiabilityDhb        string `protobuf:"bytes,7,opt,name=liability_dhb,json=liabilityDhb,proto3" json:"liability_dhb,omitempty"`
+	WalletUsdt          string `protobuf:"bytes,8,opt,name=wallet_usdt,json=walletUsdt,proto3" json:"wallet_usdt,omitempty"`
+	WalletDhb           string `protobuf:"bytes,9,opt,name=wallet_dhb,json=walletDhb,proto3" json:"wallet_dhb,omitempty"`
+	RatioUsdt           string `protobuf:"bytes,10,opt,name=ratio_usdt,json=ratioUsdt,proto3" json:"ratio_usdt,omitempty"`
+	RatioDhb            string `protobuf:"bytes,11,opt,name=ratio_dhb,json=ratioDhb,proto3" json:"ratio_dhb,omitempty"`
+	Alarm               bool   `protobuf:"varint,12,opt,name=alarm,proto3" json:"alarm,omitempty"`
+	CreatedAt           string `protobuf:"bytes,13,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+}
+
+func (x *AdminSolvencyListReply_List) Reset() {
+	*x = AdminSolvencyListReply_List{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_app_app_api_app_proto_msgTypes[201]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AdminSolvencyListReply_List) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminSolvencyListReply_List) ProtoMessage() {}
+
+func (x *AdminSolvencyListReply_List) ProtoReflect() protoreflect.Message {
+	mi := &file_app_app_api_app_proto_msgTypes[201]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminSolvencyListReply_List.ProtoReflect.Descriptor instead.
+func (*AdminSolvencyListReply_List) Descriptor() ([]byte, []int) {
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{139, 0}
+}
+
+func (x *AdminSolvencyListReply_List) GetLocationOutstanding() string {
+	if x != nil {
+		return x.LocationOutstanding
+	}
+	return ""
+}
+
+func (x *AdminSolvencyListReply_List) GetWithdrawingUsdt() string {
+	if x != nil {
+		return x.WithdrawingUsdt
+	}
+	return ""
+}
+
+func (x *AdminSolvencyListReply_List) GetWithdrawingDhb() string {
+	if x != nil {
+		return x.WithdrawingDhb
+	}
+	return ""
+}
+
+func (x *AdminSolvencyListReply_List) GetBalanceUsdt() string {
+	if x != nil {
+		return x.BalanceUsdt
+	}
+	return ""
+}
+
+func (x *AdminSolvencyListReply_List) GetBalanceDhb() string {
+	if x != nil {
+		return x.BalanceDhb
+	}
+	return ""
+}
+
+func (x *AdminSolvencyListReply_List) GetLiabilityUsdt() string {
+	if x != nil {
+		return x.LiabilityUsdt
+	}
+	return ""
+}
+
+func (x *AdminSolvencyListReply_List) GetLiabilityDhb() string {
+	if x != nil {
+		return x.LiabilityDhb
+	}
+	return ""
+}
+
+func (x *AdminSolvencyListReply_List) GetWalletUsdt() string {
+	if x != nil {
+		return x.WalletUsdt
+	}
+	return ""
+}
+
+func (x *AdminSolvencyListReply_List) GetWalletDhb() string {
+	if x != nil {
+		return x.WalletDhb
+	}
+	return ""
+}
+
+func (x *AdminSolvencyListReply_List) GetRatioUsdt() string {
+	if x != nil {
+		return x.RatioUsdt
+	}
+	return ""
+}
+
+func (x *AdminSolvencyListReply_List) GetRatioDhb() string {
+	if x != nil {
+		return x.RatioDhb
+	}
+	return ""
+}
+
+func (x *AdminSolvencyListReply_List) GetAlarm() bool {
+	if x != nil {
+		return x.Alarm
+	}
+	return false
+}
+
+func (x *AdminSolvencyListReply_List) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+type AdminDepositSimulateReply_List struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId     int64  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	LocationId int64  `protobuf:"varint,2,opt,name=location_id,json=locationId,proto3" json:"location_id,omitempty"`
+	Type       string `protobuf:"bytes,3,opt,name=type,proto3" json:"type,omitempty"`
+	Amount     string `protobuf:"bytes,4,opt,name=amount,proto3" json:"amount,omitempty"`
+	Credited   bool   `protobuf:"varint,5,opt,name=credited,proto3" json:"credited,omitempty"`
+	Stop       bool   `protobuf:"varint,6,opt,name=stop,proto3" json:"stop,omitempty"`
+}
+
+func (x *AdminDepositSimulateReply_List) Reset() {
+	*x = AdminDepositSimulateReply_List{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_app_app_api_app_proto_msgTypes[203]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AdminDepositSimulateReply_List) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminDepositSimulateReply_List) ProtoMessage() {}
+
+func (x *AdminDepositSimulateReply_List) ProtoReflect() protoreflect.Message {
+	mi := &file_app_app_api_app_proto_msgTypes[203]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminDepositSimulateReply_List.ProtoReflect.Descriptor instead.
+func (*AdminDepositSimulateReply_List) Descriptor() ([]byte, []int) {
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{141, 0}
+}
+
+func (x *AdminDepositSimulateReply_List) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *AdminDepositSimulateReply_List) GetLocationId() int64 {
+	if x != nil {
+		return x.LocationId
+	}
+	return 0
+}
+
+func (x *AdminDepositSimulateReply_List) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *AdminDepositSimulateReply_List) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+func (x *AdminDepositSimulateReply_List) GetCredited() bool {
+	if x != nil {
+		return x.Credited
+	}
+	return false
+}
+
+func (x *AdminDepositSimulateReply_List) GetStop() bool {
+	if x != nil {
+		return x.Stop
+	}
+	return false
+}
+
+type RewardDetailReply_Config struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	KeyName string `protobuf:"bytes,1,opt,name=key_name,json=keyName,proto3" json:"key_name,omitempty"`
+	Name    string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Value   string `protobuf:"bytes,3,opt,name=value,proto3" json:"value,omitempty"`
+}
+
+func (x *RewardDetailReply_Config) Reset() {
+	*x = RewardDetailReply_Config{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_app_app_api_app_proto_msgTypes[204]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RewardDetailReply_Config) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RewardDetailReply_Config) ProtoMessage() {}
+
+func (x *RewardDetailReply_Config) ProtoReflect() protoreflect.Message {
+	mi := &file_app_app_api_app_proto_msgTypes[204]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RewardDetailReply_Config.ProtoReflect.Descriptor instead.
+func (*RewardDetailReply_Config) Descriptor() ([]byte, []int) {
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{143, 0}
+}
+
+func (x *RewardDetailReply_Config) GetKeyName() string {
+	if x != nil {
+		return x.KeyName
+	}
+	return ""
+}
+
+func (x *RewardDetailReply_Config) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *RewardDetailReply_Config) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+type AdminRewardDetailReply_Config struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	KeyName string `protobuf:"bytes,1,opt,name=key_name,json=keyName,proto3" json:"key_name,omitempty"`
+	Name    string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Value   string `protobuf:"bytes,3,opt,name=value,proto3" json:"value,omitempty"`
+}
+
+func (x *AdminRewardDetailReply_Config) Reset() {
+	*x = AdminRewardDetailReply_Config{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_app_app_api_app_proto_msgTypes[205]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AdminRewardDetailReply_Config) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminRewardDetailReply_Config) ProtoMessage() {}
+
+func (x *AdminRewardDetailReply_Config) ProtoReflect() protoreflect.Message {
+	mi := &file_app_app_api_app_proto_msgTypes[205]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminRewardDetailReply_Config.ProtoReflect.Descriptor instead.
+func (*AdminRewardDetailReply_Config) Descriptor() ([]byte, []int) {
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{145, 0}
+}
+
+func (x *AdminRewardDetailReply_Config) GetKeyName() string {
+	if x != nil {
+		return x.KeyName
+	}
+	return ""
+}
+
+func (x *AdminRewardDetailReply_Config) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *AdminRewardDetailReply_Config) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+type AdminLockListReply_List struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name     string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Holder   string `protobuf:"bytes,2,opt,name=holder,proto3" json:"holder,omitempty"`
+	Token    int64  `protobuf:"varint,3,opt,name=token,proto3" json:"token,omitempty"`
+	ExpireAt string `protobuf:"bytes,4,opt,name=expire_at,json=expireAt,proto3" json:"expire_at,omitempty"`
+}
+
+func (x *AdminLockListReply_List) Reset() {
+	*x = AdminLockListReply_List{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_app_app_api_app_proto_msgTypes[206]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AdminLockListReply_List) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminLockListReply_List) ProtoMessage() {}
+
+func (x *AdminLockListReply_List) ProtoReflect() protoreflect.Message {
+	mi := &file_app_app_api_app_proto_msgTypes[206]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminLockListReply_List.ProtoReflect.Descriptor instead.
+func (*AdminLockListReply_List) Descriptor() ([]byte, []int) {
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{149, 0}
+}
+
+func (x *AdminLockListReply_List) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *AdminLockListReply_List) GetHolder() string {
+	if x != nil {
+		return x.Holder
+	}
+	return ""
+}
+
+func (x *AdminLockListReply_List) GetToken() int64 {
+	if x != nil {
+		return x.Token
+	}
+	return 0
+}
+
+func (x *AdminLockListReply_List) GetExpireAt() string {
+	if x != nil {
+		return x.ExpireAt
+	}
+	return ""
+}
+
+type ReinvestRequest_SendBody struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Amount         int64  `protobuf:"varint,1,opt,name=amount,proto3" json:"amount,omitempty"`
+	IdempotencyKey string `protobuf:"bytes,2,opt,name=idempotency_key,json=idempotencyKey,proto3" json:"idempotency_key,omitempty"`
+}
+
+func (x *ReinvestRequest_SendBody) Reset() {
+	*x = ReinvestRequest_SendBody{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_app_app_api_app_proto_msgTypes[207]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReinvestRequest_SendBody) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReinvestRequest_SendBody) ProtoMessage() {}
+
+func (x *ReinvestRequest_SendBody) ProtoReflect() protoreflect.Message {
+	mi := &file_app_app_api_app_proto_msgTypes[207]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReinvestRequest_SendBody.ProtoReflect.Descriptor instead.
+func (*ReinvestRequest_SendBody) Descriptor() ([]byte, []int) {
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{150, 0}
+}
+
+func (x *ReinvestRequest_SendBody) GetAmount() int64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *ReinvestRequest_SendBody) GetIdempotencyKey() string {
+	if x != nil {
+		return x.IdempotencyKey
+	}
+	return ""
+}
+
+type LocationProgressReply_List struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id           int64  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Row          int64  `protobuf:"varint,2,opt,name=row,proto3" json:"row,omitempty"`
+	Col          int64  `protobuf:"varint,3,opt,name=col,proto3" json:"col,omitempty"`
+	CurrentLevel int64  `protobuf:"varint,4,opt,name=current_level,json=currentLevel,proto3" json:"current_level,omitempty"`
+	Current      string `protobuf:"bytes,5,opt,name=current,proto3" json:"current,omitempty"`
+	CurrentMax   string `protobuf:"bytes,6,opt,name=current_max,json=currentMax,proto3" json:"current_max,omitempty"`
+	Percent      string `protobuf:"bytes,7,opt,name=percent,proto3" json:"percent,omitempty"`
+	Status       string `protobuf:"bytes,8,opt,name=status,proto3" json:"status,omitempty"`
+	StopDate     string `protobuf:"bytes,9,opt,name=stop_date,json=stopDate,proto3" json:"stop_date,omitempty"`
+	CreatedAt    string `protobuf:"bytes,10,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+}
+
+func (x *LocationProgressReply_List) Reset() {
+	*x = LocationProgressReply_List{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_app_app_api_app_proto_msgTypes[208]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LocationProgressReply_List) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LocationProgressReply_List) ProtoMessage() {}
+
+func (x *LocationProgressReply_List) ProtoReflect() protoreflect.Message {
+	mi := &file_app_app_api_app_proto_msgTypes[208]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LocationProgressReply_List.ProtoReflect.Descriptor instead.
+func (*LocationProgressReply_List) Descriptor() ([]byte, []int) {
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{153, 0}
+}
+
+func (x *LocationProgressReply_List) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *LocationProgressReply_List) GetRow() int64 {
+	if x != nil {
+		return x.Row
+	}
+	return 0
+}
+
+func (x *LocationProgressReply_List) GetCol() int64 {
+	if x != nil {
+		return x.Col
+	}
+	return 0
+}
+
+func (x *LocationProgressReply_List) GetCurrentLevel() int64 {
+	if x != nil {
+		return x.CurrentLevel
+	}
+	return 0
+}
+
+func (x *LocationProgressReply_List) GetCurrent() string {
+	if x != nil {
+		return x.Current
+	}
+	return ""
+}
+
+func (x *LocationProgressReply_List) GetCurrentMax() string {
+	if x != nil {
+		return x.CurrentMax
+	}
+	return ""
+}
+
+func (x *LocationProgressReply_List) GetPercent() string {
+	if x != nil {
+		return x.Percent
+	}
+	return ""
+}
+
+func (x *LocationProgressReply_List) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *LocationProgressReply_List) GetStopDate() string {
+	if x != nil {
+		return x.StopDate
+	}
+	return ""
+}
+
+func (x *LocationProgressReply_List) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+var File_app_app_api_app_proto protoreflect.FileDescriptor
+
+var file_app_app_api_app_proto_rawDesc = []byte{
+	0x0a, 0x15, 0x61, 0x70, 0x70, 0x2f, 0x61, 0x70, 0x70, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x70,
+	0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x03, 0x61, 0x70, 0x69, 0x1a, 0x1c, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x17, 0x76, 0x61, 0x6c, 0x69,
+	0x64, 0x61, 0x74, 0x65, 0x2f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x22, 0x8f, 0x01, 0x0a, 0x13, 0x45, 0x74, 0x68, 0x41, 0x75, 0x74, 0x68, 0x6f,
+	0x72, 0x69, 0x7a, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x3e, 0x0a, 0x09, 0x73,
+	0x65, 0x6e, 0x64, 0x5f, 0x62, 0x6f, 0x64, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21,
+	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x45, 0x74, 0x68, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x53, 0x65, 0x6e, 0x64, 0x42, 0x6f, 0x64,
+	0x79, 0x52, 0x08, 0x73, 0x65, 0x6e, 0x64, 0x42, 0x6f, 0x64, 0x79, 0x1a, 0x38, 0x0a, 0x08, 0x53,
+	0x65, 0x6e, 0x64, 0x42, 0x6f, 0x64, 0x79, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x73, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x63, 0x6f, 0x64, 0x65, 0x22, 0x29, 0x0a, 0x11, 0x45, 0x74, 0x68, 0x41, 0x75, 0x74, 0x68,
+	0x6f, 0x72, 0x69, 0x7a, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f,
+	0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e,
+	0x22, 0x10, 0x0a, 0x0e, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x22, 0x0e, 0x0a, 0x0c, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x52, 0x65, 0x70,
+	0x6c, 0x79, 0x22, 0x11, 0x0a, 0x0f, 0x55, 0x73, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0xd6, 0x06, 0x0a, 0x0d, 0x55, 0x73, 0x65, 0x72, 0x49, 0x6e,
+	0x66, 0x6f, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x73, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x05, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12,
+	0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x62, 0x61, 0x6c, 0x61, 0x6e,
+	0x63, 0x65, 0x55, 0x73, 0x64, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x62, 0x61,
+	0x6c, 0x61, 0x6e, 0x63, 0x65, 0x55, 0x73, 0x64, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x62, 0x61, 0x6c,
+	0x61, 0x6e, 0x63, 0x65, 0x44, 0x68, 0x62, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x62,
+	0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x44, 0x68, 0x62, 0x12, 0x1c, 0x0a, 0x09, 0x69, 0x6e, 0x76,
+	0x69, 0x74, 0x65, 0x55, 0x72, 0x6c, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x69, 0x6e,
+	0x76, 0x69, 0x74, 0x65, 0x55, 0x72, 0x6c, 0x12, 0x2c, 0x0a, 0x11, 0x69, 0x6e, 0x76, 0x69, 0x74,
+	0x65, 0x55, 0x73, 0x65, 0x72, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x08, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x11, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x41, 0x64,
+	0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x22, 0x0a, 0x0c, 0x72, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65,
+	0x6e, 0x64, 0x4e, 0x75, 0x6d, 0x18, 0x09, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x72, 0x65, 0x63,
+	0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x4e, 0x75, 0x6d, 0x12, 0x2a, 0x0a, 0x10, 0x72, 0x65, 0x63,
+	0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x54, 0x65, 0x61, 0x6d, 0x4e, 0x75, 0x6d, 0x18, 0x0a, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x10, 0x72, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x54, 0x65,
+	0x61, 0x6d, 0x4e, 0x75, 0x6d, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x0b,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x1a, 0x0a, 0x08, 0x66,
+	0x65, 0x65, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x17, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x66,
+	0x65, 0x65, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x10, 0x0a, 0x03, 0x72, 0x6f, 0x77, 0x18, 0x0c,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x72, 0x6f, 0x77, 0x12, 0x10, 0x0a, 0x03, 0x63, 0x6f, 0x6c,
+	0x18, 0x0d, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x63, 0x6f, 0x6c, 0x12, 0x3a, 0x0a, 0x18, 0x63,
+	0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x4d, 0x6f, 0x6e, 0x74, 0x68, 0x52, 0x65, 0x63, 0x6f, 0x6d,
+	0x6d, 0x65, 0x6e, 0x64, 0x4e, 0x75, 0x6d, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x03, 0x52, 0x18, 0x63,
+	0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x4d, 0x6f, 0x6e, 0x74, 0x68, 0x52, 0x65, 0x63, 0x6f, 0x6d,
+	0x6d, 0x65, 0x6e, 0x64, 0x4e, 0x75, 0x6d, 0x12, 0x26, 0x0a, 0x0e, 0x72, 0x65, 0x63, 0x6f, 0x6d,
+	0x6d, 0x65, 0x6e, 0x64, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0e, 0x72, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x12,
+	0x24, 0x0a, 0x0d, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x6f, 0x74, 0x61, 0x6c,
+	0x18, 0x10, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x54, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x1c, 0x0a, 0x09, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x31, 0x44,
+	0x68, 0x62, 0x18, 0x11, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x31,
+	0x44, 0x68, 0x62, 0x12, 0x1c, 0x0a, 0x09, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x32, 0x44, 0x68, 0x62,
+	0x18, 0x12, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x32, 0x44, 0x68,
+	0x62, 0x12, 0x1c, 0x0a, 0x09, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x33, 0x44, 0x68, 0x62, 0x18, 0x13,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x33, 0x44, 0x68, 0x62, 0x12,
+	0x12, 0x0a, 0x04, 0x75, 0x73, 0x64, 0x74, 0x18, 0x14, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x75,
+	0x73, 0x64, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x64, 0x68, 0x62, 0x18, 0x15, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x03, 0x64, 0x68, 0x62, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x18, 0x16, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12,
+	0x28, 0x0a, 0x0f, 0x72, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x4e, 0x75, 0x6d, 0x41,
+	0x6c, 0x6c, 0x18, 0x18, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0f, 0x72, 0x65, 0x63, 0x6f, 0x6d, 0x6d,
+	0x65, 0x6e, 0x64, 0x4e, 0x75, 0x6d, 0x41, 0x6c, 0x6c, 0x12, 0x2a, 0x0a, 0x10, 0x72, 0x65, 0x63,
+	0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x54, 0x65, 0x61, 0x6d, 0x41, 0x6c, 0x6c, 0x18, 0x19, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x10, 0x72, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x54, 0x65,
+	0x61, 0x6d, 0x41, 0x6c, 0x6c, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x42,
+	0x18, 0x1b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x42, 0x12,
+	0x1d, 0x0a, 0x0a, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x1c, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x75, 0x73, 0x65, 0x72, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x27,
+	0x0a, 0x11, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x22, 0xd2, 0x01, 0x0a, 0x0f, 0x52, 0x65, 0x77, 0x61,
+	0x72, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x33, 0x0a, 0x07, 0x72,
+	0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x70,
+	0x6c, 0x79, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x07, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73,
+	0x1a, 0x89, 0x01, 0x0a, 0x04, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75,
+	0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74,
+	0x12, 0x26, 0x0a, 0x0e, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x0e, 0x0a, 0x02,
+	0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x22, 0x1c, 0x0a, 0x1a,
+	0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x4c,
+	0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0xd3, 0x01, 0x0a, 0x18, 0x52,
+	0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x4c, 0x69,
+	0x73, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x3c, 0x0a, 0x07, 0x72, 0x65, 0x77, 0x61, 0x72,
+	0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x52,
+	0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x4c, 0x69,
+	0x73, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x07, 0x72, 0x65,
+	0x77, 0x61, 0x72, 0x64, 0x73, 0x1a, 0x79, 0x0a, 0x04, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x1d, 0x0a,
+	0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x16, 0x0a, 0x06,
+	0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x6d,
+	0x6f, 0x75, 0x6e, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73,
+	0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e,
+	0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64,
+	0x22, 0x16, 0x0a, 0x14, 0x46, 0x65, 0x65, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x4c, 0x69, 0x73,
+	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x9b, 0x01, 0x0a, 0x12, 0x46, 0x65, 0x65,
+	0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12,
+	0x36, 0x0a, 0x07, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x1c, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x46, 0x65, 0x65, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64,
+	0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x07,
+	0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x1a, 0x4d, 0x0a, 0x04, 0x4c, 0x69, 0x73, 0x74, 0x12,
+	0x1d, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x16,
+	0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x22, 0x29, 0x0a, 0x13, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72,
+	0x61, 0x77, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a,
+	0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70,
+	0x65, 0x22, 0xd0, 0x01, 0x0a, 0x11, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x4c, 0x69,
+	0x73, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x37, 0x0a, 0x08, 0x77, 0x69, 0x74, 0x68, 0x64,
+	0x72, 0x61, 0x77, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6c,
+	0x79, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x08, 0x77, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77,
+	0x1a, 0x81, 0x01, 0x0a, 0x04, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75,
+	0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74,
+	0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x74, 0x79, 0x70, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x16, 0x0a, 0x06,
+	0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65,
+	0x61, 0x73, 0x6f, 0x6e, 0x22, 0x2a, 0x0a, 0x14, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e,
+	0x64, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04,
+	0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65,
+	0x22, 0x8a, 0x01, 0x0a, 0x12, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x4c, 0x69,
+	0x73, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x3c, 0x0a, 0x0a, 0x72, 0x65, 0x63, 0x6f, 0x6d,
+	0x6d, 0x65, 0x6e, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x61, 0x70,
+	0x69, 0x2e, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x52,
+	0x65, 0x70, 0x6c, 0x79, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x0a, 0x72, 0x65, 0x63, 0x6f, 0x6d,
+	0x6d, 0x65, 0x6e, 0x64, 0x73, 0x1a, 0x36, 0x0a, 0x04, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x16, 0x0a,
+	0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61,
+	0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0xae, 0x01,
+	0x0a, 0x0f, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x3a, 0x0a, 0x09, 0x73, 0x65, 0x6e, 0x64, 0x5f, 0x62, 0x6f, 0x64, 0x79, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x57, 0x69, 0x74, 0x68, 0x64,
+	0x72, 0x61, 0x77, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x53, 0x65, 0x6e, 0x64, 0x42,
+	0x6f, 0x64, 0x79, 0x52, 0x08, 0x73, 0x65, 0x6e, 0x64, 0x42, 0x6f, 0x64, 0x79, 0x1a, 0x5f, 0x0a,
+	0x08, 0x53, 0x65, 0x6e, 0x64, 0x42, 0x6f, 0x64, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x16, 0x0a,
+	0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61,
+	0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x27, 0x0a, 0x0f, 0x69, 0x64, 0x65, 0x6d, 0x70, 0x6f, 0x74,
+	0x65, 0x6e, 0x63, 0x79, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e,
+	0x69, 0x64, 0x65, 0x6d, 0x70, 0x6f, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x4b, 0x65, 0x79, 0x22, 0x27,
+	0x0a, 0x0d, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12,
+	0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x75, 0x0a, 0x15, 0x57, 0x69, 0x74, 0x68, 0x64,
+	0x72, 0x61, 0x77, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x40, 0x0a, 0x09, 0x73, 0x65, 0x6e, 0x64, 0x5f, 0x62, 0x6f, 0x64, 0x79, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72,
+	0x61, 0x77, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e,
+	0x53, 0x65, 0x6e, 0x64, 0x42, 0x6f, 0x64, 0x79, 0x52, 0x08, 0x73, 0x65, 0x6e, 0x64, 0x42, 0x6f,
+	0x64, 0x79, 0x1a, 0x1a, 0x0a, 0x08, 0x53, 0x65, 0x6e, 0x64, 0x42, 0x6f, 0x64, 0x79, 0x12, 0x0e,
+	0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x22, 0x2d,
+	0x0a, 0x13, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c,
+	0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x46, 0x0a,
+	0x16, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x4c, 0x69, 0x73, 0x74,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x70, 0x61, 0x67, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x61,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64,
+	0x64, 0x72, 0x65, 0x73, 0x73, 0x22, 0xfc, 0x01, 0x0a, 0x14, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x52,
+	0x65, 0x77, 0x61, 0x72, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x38,
+	0x0a, 0x07, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x1e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x52, 0x65, 0x77, 0x61, 0x72,
+	0x64, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x52,
+	0x07, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x1a, 0x93,
+	0x01, 0x0a, 0x04, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x12,
+	0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79,
+	0x70, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x16, 0x0a, 0x06,
+	0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65,
+	0x61, 0x73, 0x6f, 0x6e, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x02, 0x69, 0x64, 0x22, 0x49, 0x0a, 0x19, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x52, 0x65, 0x77,
+	0x61, 0x72, 0x64, 0x42, 0x6e, 0x62, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x04, 0x70, 0x61, 0x67, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x22,
+	0xe5, 0x01, 0x0a, 0x17, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x42,
+	0x6e, 0x62, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x3b, 0x0a, 0x07, 0x72,
+	0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x42, 0x6e,
+	0x62, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x52,
+	0x07, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x1a, 0x77,
+	0x0a, 0x04, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x64, 0x5f, 0x61, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1e, 0x0a,
+	0x0a, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x41, 0x6c, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0a, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x41, 0x6c, 0x6c, 0x12, 0x18, 0x0a,
+	0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x22, 0x1b, 0x0a, 0x19, 0x43, 0x68, 0x65, 0x63, 0x6b,
+	0x41, 0x64, 0x6d, 0x69, 0x6e, 0x55, 0x73, 0x65, 0x72, 0x41, 0x72, 0x65, 0x61, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x22, 0x19, 0x0a, 0x17, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x41, 0x64, 0x6d,
+	0x69, 0x6e, 0x55, 0x73, 0x65, 0x72, 0x41, 0x72, 0x65, 0x61, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22,
+	0x2d, 0x0a, 0x2b, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x41, 0x6e, 0x64, 0x49, 0x6e, 0x73, 0x65, 0x72,
+	0x74, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d,
+	0x65, 0x6e, 0x64, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x2b,
+	0x0a, 0x29, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x41, 0x6e, 0x64, 0x49, 0x6e, 0x73, 0x65, 0x72, 0x74,
+	0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65,
+	0x6e, 0x64, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x1c, 0x0a, 0x1a, 0x55,
+	0x70, 0x6c, 0x6f, 0x61, 0x64, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x55, 0x73,
+	0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x1a, 0x0a, 0x18, 0x55, 0x70, 0x6c,
+	0x6f, 0x61, 0x64, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x55, 0x73, 0x65, 0x72,
+	0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x76, 0x0a, 0x14, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x55, 0x73,
+	0x65, 0x72, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a,
+	0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x70, 0x61, 0x67,
+	0x65, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x69,
+	0x73, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x0a, 0x69, 0x73, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x10, 0x0a, 0x03, 0x76,
+	0x69, 0x70, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x76, 0x69, 0x70, 0x22, 0xa9, 0x04,
+	0x0a, 0x12, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x55, 0x73, 0x65, 0x72, 0x4c, 0x69, 0x73, 0x74, 0x52,
+	0x65, 0x70, 0x6c, 0x79, 0x12, 0x36, 0x0a, 0x05, 0x75, 0x73, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x55,
+	0x73, 0x65, 0x72, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x2e, 0x55, 0x73, 0x65,
+	0x72, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x05, 0x75, 0x73, 0x65, 0x72, 0x73, 0x12, 0x14, 0x0a, 0x05,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x1a, 0xc4, 0x03, 0x0a, 0x08, 0x55, 0x73, 0x65, 0x72, 0x4c, 0x69, 0x73, 0x74, 0x12,
+	0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x73, 0x12, 0x20, 0x0a, 0x0b, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x55, 0x73, 0x64, 0x74,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x55,
+	0x73, 0x64, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x44, 0x68,
+	0x62, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65,
+	0x44, 0x68, 0x62, 0x12, 0x10, 0x0a, 0x03, 0x76, 0x69, 0x70, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x03, 0x76, 0x69, 0x70, 0x12, 0x26, 0x0a, 0x0e, 0x6d, 0x6f, 0x6e, 0x74, 0x68, 0x52, 0x65,
+	0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0e, 0x6d,
+	0x6f, 0x6e, 0x74, 0x68, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x12, 0x2a, 0x0a,
+	0x10, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e,
+	0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x10, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79,
+	0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x61, 0x72, 0x65,
+	0x61, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x61,
+	0x72, 0x65, 0x61, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x24, 0x0a, 0x0d, 0x61, 0x72, 0x65,
+	0x61, 0x4d, 0x61, 0x78, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x0d, 0x61, 0x72, 0x65, 0x61, 0x4d, 0x61, 0x78, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12,
+	0x1c, 0x0a, 0x09, 0x62, 0x6e, 0x62, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x0b, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x09, 0x62, 0x6e, 0x62, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1e, 0x0a,
+	0x0a, 0x62, 0x6e, 0x62, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x0c, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0a, 0x62, 0x6e, 0x62, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x1e, 0x0a,
+	0x0a, 0x76, 0x69, 0x70, 0x43, 0x6f, 0x6d, 0x70, 0x75, 0x74, 0x65, 0x18, 0x0d, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x0a, 0x76, 0x69, 0x70, 0x43, 0x6f, 0x6d, 0x70, 0x75, 0x74, 0x65, 0x12, 0x1a, 0x0a,
+	0x08, 0x76, 0x69, 0x70, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x08, 0x76, 0x69, 0x70, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x22, 0x48, 0x0a, 0x18, 0x41, 0x64, 0x6d,
+	0x69, 0x6e, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x04, 0x70, 0x61, 0x67, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72,
+	0x65, 0x73, 0x73, 0x22, 0xda, 0x02, 0x0a, 0x16, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x4c, 0x6f, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x46,
+	0x0a, 0x09, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x28, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x4c, 0x6f, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x2e, 0x4c,
+	0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x09, 0x6c, 0x6f, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x1a, 0xe1, 0x01, 0x0a,
+	0x0c, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x1d, 0x0a,
+	0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x18, 0x0a, 0x07,
+	0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x10, 0x0a, 0x03, 0x72, 0x6f, 0x77, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x03, 0x72, 0x6f, 0x77, 0x12, 0x10, 0x0a, 0x03, 0x63, 0x6f, 0x6c, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x63, 0x6f, 0x6c, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x12, 0x22, 0x0a, 0x0c, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x4c, 0x65, 0x76,
+	0x65, 0x6c, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e,
+	0x74, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e,
+	0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74,
+	0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x4d, 0x61, 0x78, 0x18, 0x08,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x4d, 0x61, 0x78,
+	0x22, 0x4b, 0x0a, 0x1b, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x41, 0x6c, 0x6c, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x12, 0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x70,
+	0x61, 0x67, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x22, 0xe0, 0x02,
+	0x0a, 0x19, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x41,
+	0x6c, 0x6c, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x49, 0x0a, 0x09, 0x6c,
+	0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2b,
+	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x41, 0x6c, 0x6c, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x2e, 0x4c,
+	0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x09, 0x6c, 0x6f, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x1a, 0xe1, 0x01, 0x0a,
+	0x0c, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x1d, 0x0a,
+	0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x18, 0x0a, 0x07,
+	0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x10, 0x0a, 0x03, 0x72, 0x6f, 0x77, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x03, 0x72, 0x6f, 0x77, 0x12, 0x10, 0x0a, 0x03, 0x63, 0x6f, 0x6c, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x63, 0x6f, 0x6c, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x12, 0x22, 0x0a, 0x0c, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x4c, 0x65, 0x76,
+	0x65, 0x6c, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e,
+	0x74, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e,
+	0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74,
+	0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x4d, 0x61, 0x78, 0x18, 0x08,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x4d, 0x61, 0x78,
+	0x22, 0x5c, 0x0a, 0x18, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61,
+	0x77, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04,
+	0x70, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x70, 0x61, 0x67, 0x65,
+	0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79,
+	0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x22, 0xb8,
+	0x02, 0x0a, 0x16, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77,
+	0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x3c, 0x0a, 0x08, 0x77, 0x69, 0x74,
+	0x68, 0x64, 0x72, 0x61, 0x77, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x61, 0x70,
+	0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x4c,
+	0x69, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x08, 0x77,
+	0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x1a, 0xc9, 0x01,
+	0x0a, 0x04, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64,
+	0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12,
+	0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x72, 0x65, 0x6c, 0x41, 0x6d,
+	0x6f, 0x75, 0x6e, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x72, 0x65, 0x6c, 0x41,
+	0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x08, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x22, 0x16, 0x0a, 0x14, 0x41, 0x64, 0x6d,
+	0x69, 0x6e, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x22, 0x14, 0x0a, 0x12, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72,
+	0x61, 0x77, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x25, 0x0a, 0x23, 0x41, 0x64, 0x6d, 0x69, 0x6e,
+	0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x44, 0x6f, 0x69, 0x6e, 0x67, 0x54, 0x6f, 0x52,
+	0x65, 0x77, 0x61, 0x72, 0x64, 0x65, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x23,
+	0x0a, 0x21, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x44,
+	0x6f, 0x69, 0x6e, 0x67, 0x54, 0x6f, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x65, 0x64, 0x52, 0x65,
+	0x70, 0x6c, 0x79, 0x22, 0x19, 0x0a, 0x17, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x57, 0x69, 0x74, 0x68,
+	0x64, 0x72, 0x61, 0x77, 0x45, 0x74, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x17,
+	0x0a, 0x15, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x45,
+	0x74, 0x68, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x11, 0x0a, 0x0f, 0x41, 0x64, 0x6d, 0x69, 0x6e,
+	0x46, 0x65, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x0f, 0x0a, 0x0d, 0x41, 0x64,
+	0x6d, 0x69, 0x6e, 0x46, 0x65, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x28, 0x0a, 0x14, 0x41,
+	0x64, 0x6d, 0x69, 0x6e, 0x44, 0x61, 0x69, 0x6c, 0x79, 0x46, 0x65, 0x65, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x64, 0x61, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x03, 0x64, 0x61, 0x79, 0x22, 0x14, 0x0a, 0x12, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x44, 0x61,
+	0x69, 0x6c, 0x79, 0x46, 0x65, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x11, 0x0a, 0x0f, 0x41,
+	0x64, 0x6d, 0x69, 0x6e, 0x41, 0x6c, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0xf9,
+	0x04, 0x0a, 0x0d, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x41, 0x6c, 0x6c, 0x52, 0x65, 0x70, 0x6c, 0x79,
+	0x12, 0x26, 0x0a, 0x0e, 0x74, 0x6f, 0x64, 0x61, 0x79, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x55, 0x73,
+	0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0e, 0x74, 0x6f, 0x64, 0x61, 0x79, 0x54,
+	0x6f, 0x74, 0x61, 0x6c, 0x55, 0x73, 0x65, 0x72, 0x12, 0x24, 0x0a, 0x0d, 0x6c, 0x6f, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x0d, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1c,
+	0x0a, 0x09, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x55, 0x73, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x09, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x55, 0x73, 0x65, 0x72, 0x12, 0x1e, 0x0a, 0x0a,
+	0x61, 0x6c, 0x6c, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0a, 0x61, 0x6c, 0x6c, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x24, 0x0a, 0x0d,
+	0x74, 0x6f, 0x64, 0x61, 0x79, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0d, 0x74, 0x6f, 0x64, 0x61, 0x79, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x20, 0x0a, 0x0b, 0x61, 0x6c, 0x6c, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x6c, 0x6c, 0x4c, 0x6f, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x24, 0x0a, 0x0d, 0x74, 0x6f, 0x64, 0x61, 0x79, 0x57, 0x69, 0x74,
+	0x68, 0x64, 0x72, 0x61, 0x77, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x74, 0x6f, 0x64,
+	0x61, 0x79, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x12, 0x20, 0x0a, 0x0b, 0x61, 0x6c,
+	0x6c, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0b, 0x61, 0x6c, 0x6c, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x12, 0x1c, 0x0a, 0x09,
+	0x61, 0x6c, 0x6c, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x09, 0x61, 0x6c, 0x6c, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x12, 0x34, 0x0a, 0x15, 0x61, 0x6c,
+	0x6c, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x41, 0x6e, 0x64,
+	0x46, 0x65, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x15, 0x61, 0x6c, 0x6c, 0x53, 0x79,
+	0x73, 0x74, 0x65, 0x6d, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x41, 0x6e, 0x64, 0x46, 0x65, 0x65,
+	0x12, 0x24, 0x0a, 0x0d, 0x61, 0x6c, 0x6c, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x42, 0x6e,
+	0x62, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x61, 0x6c, 0x6c, 0x42, 0x61, 0x6c, 0x61,
+	0x6e, 0x63, 0x65, 0x42, 0x6e, 0x62, 0x12, 0x26, 0x0a, 0x0e, 0x61, 0x6c, 0x6c, 0x57, 0x69, 0x74,
+	0x68, 0x64, 0x72, 0x61, 0x77, 0x42, 0x6e, 0x62, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e,
+	0x61, 0x6c, 0x6c, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x42, 0x6e, 0x62, 0x12, 0x2a,
+	0x0a, 0x10, 0x74, 0x6f, 0x64, 0x61, 0x79, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x42,
+	0x6e, 0x62, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x74, 0x6f, 0x64, 0x61, 0x79, 0x57,
+	0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x42, 0x6e, 0x62, 0x12, 0x28, 0x0a, 0x0f, 0x61, 0x6c,
+	0x6c, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x42, 0x6e, 0x62, 0x34, 0x18, 0x0f, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0f, 0x61, 0x6c, 0x6c, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77,
+	0x42, 0x6e, 0x62, 0x34, 0x12, 0x2c, 0x0a, 0x11, 0x74, 0x6f, 0x64, 0x61, 0x79, 0x57, 0x69, 0x74,
+	0x68, 0x64, 0x72, 0x61, 0x77, 0x42, 0x6e, 0x62, 0x34, 0x18, 0x10, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x11, 0x74, 0x6f, 0x64, 0x61, 0x79, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x42, 0x6e,
+	0x62, 0x34, 0x12, 0x26, 0x0a, 0x0e, 0x61, 0x6c, 0x6c, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65,
+	0x42, 0x6e, 0x62, 0x34, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x61, 0x6c, 0x6c, 0x42,
+	0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x42, 0x6e, 0x62, 0x34, 0x22, 0x34, 0x0a, 0x19, 0x41, 0x64,
+	0x6d, 0x69, 0x6e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64,
+	0x22, 0xbc, 0x01, 0x0a, 0x17, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65,
+	0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x37, 0x0a, 0x05,
+	0x75, 0x73, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x61, 0x70,
+	0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x63, 0x6f, 0x6d,
+	0x6d, 0x65, 0x6e, 0x64, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x05,
+	0x75, 0x73, 0x65, 0x72, 0x73, 0x1a, 0x68, 0x0a, 0x04, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x17, 0x0a,
+	0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06,
+	0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64,
+	0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22,
+	0x4a, 0x0a, 0x1a, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x4d, 0x6f, 0x6e, 0x74, 0x68, 0x52, 0x65, 0x63,
+	0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a,
+	0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x70, 0x61, 0x67, 0x65, 0x22, 0xe7, 0x01, 0x0a, 0x18,
+	0x41, 0x64, 0x6d, 0x69, 0x6e, 0x4d, 0x6f, 0x6e, 0x74, 0x68, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d,
+	0x65, 0x6e, 0x64, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x38, 0x0a, 0x05, 0x75, 0x73, 0x65, 0x72,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64,
+	0x6d, 0x69, 0x6e, 0x4d, 0x6f, 0x6e, 0x74, 0x68, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e,
+	0x64, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x05, 0x75, 0x73, 0x65,
+	0x72, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x1a, 0x7b, 0x0a, 0x04, 0x4c, 0x69, 0x73, 0x74,
+	0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x2a, 0x0a, 0x10, 0x72, 0x65,
+	0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x72, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x41,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x64, 0x5f, 0x61, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0x40, 0x0a, 0x12, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x43, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x75,
+	0x73, 0x65, 0x72, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x75, 0x73, 0x65,
+	0x72, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x04, 0x70, 0x61, 0x67, 0x65, 0x22, 0x9e, 0x01, 0x0a, 0x10, 0x41, 0x64, 0x6d, 0x69,
+	0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x32, 0x0a, 0x06,
+	0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65,
+	0x70, 0x6c, 0x79, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x1a, 0x40, 0x0a, 0x04, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x0e,
+	0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12,
+	0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x12, 0x0a, 0x10, 0x41, 0x64, 0x6d, 0x69,
+	0x6e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x76, 0x0a, 0x0e,
+	0x41, 0x64, 0x6d, 0x69, 0x6e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x32,
+	0x0a, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x18, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x4c, 0x69, 0x73, 0x74, 0x52,
+	0x65, 0x70, 0x6c, 0x79, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x1a, 0x30, 0x0a, 0x04, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x63,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x63, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x22, 0x11, 0x0a, 0x0f, 0x41, 0x75, 0x74, 0x68, 0x4c, 0x69, 0x73, 0x74,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x7c, 0x0a, 0x0d, 0x41, 0x75, 0x74, 0x68, 0x4c,
+	0x69, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x2b, 0x0a, 0x04, 0x61, 0x75, 0x74, 0x68,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x75, 0x74,
+	0x68, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x52,
+	0x04, 0x61, 0x75, 0x74, 0x68, 0x1a, 0x3e, 0x0a, 0x04, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x0e, 0x0a,
+	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x70, 0x61, 0x74, 0x68, 0x22, 0x30, 0x0a, 0x13, 0x55, 0x73, 0x65, 0x72, 0x41, 0x75, 0x74,
+	0x68, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x08,
+	0x61, 0x64, 0x6d, 0x69, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07,
+	0x61, 0x64, 0x6d, 0x69, 0x6e, 0x49, 0x64, 0x22, 0x84, 0x01, 0x0a, 0x11, 0x55, 0x73, 0x65, 0x72,
+	0x41, 0x75, 0x74, 0x68, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x2f, 0x0a,
+	0x04, 0x61, 0x75, 0x74, 0x68, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x61, 0x70,
+	0x69, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x41, 0x75, 0x74, 0x68, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65,
+	0x70, 0x6c, 0x79, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x04, 0x61, 0x75, 0x74, 0x68, 0x1a, 0x3e,
+	0x0a, 0x04, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61,
+	0x74, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x22, 0x13,
+	0x0a, 0x11, 0x4d, 0x79, 0x41, 0x75, 0x74, 0x68, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x22, 0x96, 0x01, 0x0a, 0x0f, 0x4d, 0x79, 0x41, 0x75, 0x74, 0x68, 0x4c, 0x69,
+	0x73, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x2d, 0x0a, 0x04, 0x61, 0x75, 0x74, 0x68, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x4d, 0x79, 0x41, 0x75,
+	0x74, 0x68, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x2e, 0x4c, 0x69, 0x73, 0x74,
+	0x52, 0x04, 0x61, 0x75, 0x74, 0x68, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x75, 0x70, 0x65, 0x72, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x73, 0x75, 0x70, 0x65, 0x72, 0x1a, 0x3e, 0x0a, 0x04,
+	0x4c, 0x69, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x22, 0x91, 0x01, 0x0a,
+	0x18, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x55, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x43, 0x0a, 0x09, 0x73, 0x65, 0x6e,
+	0x64, 0x5f, 0x62, 0x6f, 0x64, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x55, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x53, 0x65, 0x6e, 0x64,
+	0x42, 0x6f, 0x64, 0x79, 0x52, 0x08, 0x73, 0x65, 0x6e, 0x64, 0x42, 0x6f, 0x64, 0x79, 0x1a, 0x30,
+	0x0a, 0x08, 0x53, 0x65, 0x6e, 0x64, 0x42, 0x6f, 0x64, 0x79, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x22, 0x18, 0x0a, 0x16, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x90, 0x01, 0x0a, 0x15, 0x41,
+	0x64, 0x6d, 0x69, 0x6e, 0x56, 0x69, 0x70, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x40, 0x0a, 0x09, 0x73, 0x65, 0x6e, 0x64, 0x5f, 0x62, 0x6f, 0x64,
+	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64,
+	0x6d, 0x69, 0x6e, 0x56, 0x69, 0x70, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x2e, 0x53, 0x65, 0x6e, 0x64, 0x42, 0x6f, 0x64, 0x79, 0x52, 0x08, 0x73, 0x65,
+	0x6e, 0x64, 0x42, 0x6f, 0x64, 0x79, 0x1a, 0x35, 0x0a, 0x08, 0x53, 0x65, 0x6e, 0x64, 0x42, 0x6f,
+	0x64, 0x79, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x10, 0x0a, 0x03, 0x76,
+	0x69, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x76, 0x69, 0x70, 0x22, 0x15, 0x0a,
+	0x13, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x56, 0x69, 0x70, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52,
+	0x65, 0x70, 0x6c, 0x79, 0x22, 0x94, 0x01, 0x0a, 0x16, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x55, 0x6e,
+	0x64, 0x6f, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x41, 0x0a, 0x09, 0x73, 0x65, 0x6e, 0x64, 0x5f, 0x62, 0x6f, 0x64, 0x79, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x24, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x55, 0x6e,
+	0x64, 0x6f, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e,
+	0x53, 0x65, 0x6e, 0x64, 0x42, 0x6f, 0x64, 0x79, 0x52, 0x08, 0x73, 0x65, 0x6e, 0x64, 0x42, 0x6f,
+	0x64, 0x79, 0x1a, 0x37, 0x0a, 0x08, 0x53, 0x65, 0x6e, 0x64, 0x42, 0x6f, 0x64, 0x79, 0x12, 0x17,
+	0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x6e, 0x64, 0x6f, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x75, 0x6e, 0x64, 0x6f, 0x22, 0x16, 0x0a, 0x14, 0x41,
+	0x64, 0x6d, 0x69, 0x6e, 0x55, 0x6e, 0x64, 0x6f, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65,
+	0x70, 0x6c, 0x79, 0x22, 0xa0, 0x01, 0x0a, 0x1b, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x41, 0x72, 0x65,
+	0x61, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x46, 0x0a, 0x09, 0x73, 0x65, 0x6e, 0x64, 0x5f, 0x62, 0x6f, 0x64, 0x79,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d,
+	0x69, 0x6e, 0x41, 0x72, 0x65, 0x61, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x55, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x53, 0x65, 0x6e, 0x64, 0x42, 0x6f, 0x64,
+	0x79, 0x52, 0x08, 0x73, 0x65, 0x6e, 0x64, 0x42, 0x6f, 0x64, 0x79, 0x1a, 0x39, 0x0a, 0x08, 0x53,
+	0x65, 0x6e, 0x64, 0x42, 0x6f, 0x64, 0x79, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64,
+	0x12, 0x14, 0x0a, 0x05, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x05, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x22, 0x1b, 0x0a, 0x19, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x41,
+	0x72, 0x65, 0x61, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65,
+	0x70, 0x6c, 0x79, 0x22, 0xa0, 0x01, 0x0a, 0x1a, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x4c, 0x6f, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x73, 0x65, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x45, 0x0a, 0x09, 0x73, 0x65, 0x6e, 0x64, 0x5f, 0x62, 0x6f, 0x64, 0x79, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69,
+	0x6e, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x73, 0x65, 0x72, 0x74, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x53, 0x65, 0x6e, 0x64, 0x42, 0x6f, 0x64, 0x79, 0x52,
+	0x08, 0x73, 0x65, 0x6e, 0x64, 0x42, 0x6f, 0x64, 0x79, 0x1a, 0x3b, 0x0a, 0x08, 0x53, 0x65, 0x6e,
+	0x64, 0x42, 0x6f, 0x64, 0x79, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x16,
+	0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06,
+	0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x3b, 0x0a, 0x18, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x4c,
+	0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x73, 0x65, 0x72, 0x74, 0x52, 0x65, 0x70,
+	0x6c, 0x79, 0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x5f, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61,
+	0x6c, 0x49, 0x64, 0x22, 0xf1, 0x01, 0x0a, 0x19, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x42, 0x61, 0x6c,
+	0x61, 0x6e, 0x63, 0x65, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x44, 0x0a, 0x09, 0x73, 0x65, 0x6e, 0x64, 0x5f, 0x62, 0x6f, 0x64, 0x79, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e,
+	0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x2e, 0x53, 0x65, 0x6e, 0x64, 0x42, 0x6f, 0x64, 0x79, 0x52, 0x08, 0x73,
+	0x65, 0x6e, 0x64, 0x42, 0x6f, 0x64, 0x79, 0x1a, 0x8d, 0x01, 0x0a, 0x08, 0x53, 0x65, 0x6e, 0x64,
+	0x42, 0x6f, 0x64, 0x79, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x16, 0x0a,
+	0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61,
+	0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x63, 0x6f, 0x69, 0x6e, 0x5f, 0x74, 0x79,
+	0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x6f, 0x69, 0x6e, 0x54, 0x79,
+	0x70, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x5f, 0x63, 0x6f, 0x64,
+	0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x43,
+	0x6f, 0x64, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x6f, 0x74, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x6e, 0x6f, 0x74, 0x65, 0x22, 0x57, 0x0a, 0x17, 0x41, 0x64, 0x6d, 0x69, 0x6e,
+	0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x70,
+	0x6c, 0x79, 0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x5f, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61,
+	0x6c, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x5f, 0x69, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x49, 0x64,
+	0x22, 0x9b, 0x01, 0x0a, 0x16, 0x41, 0x75, 0x74, 0x68, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x43, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x41, 0x0a, 0x09, 0x73,
+	0x65, 0x6e, 0x64, 0x5f, 0x62, 0x6f, 0x64, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24,
+	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x43, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x53, 0x65, 0x6e, 0x64,
+	0x42, 0x6f, 0x64, 0x79, 0x52, 0x08, 0x73, 0x65, 0x6e, 0x64, 0x42, 0x6f, 0x64, 0x79, 0x1a, 0x3e,
+	0x0a, 0x08, 0x53, 0x65, 0x6e, 0x64, 0x42, 0x6f, 0x64, 0x79, 0x12, 0x19, 0x0a, 0x08, 0x61, 0x64,
+	0x6d, 0x69, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x61, 0x64,
+	0x6d, 0x69, 0x6e, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x61, 0x75, 0x74, 0x68, 0x5f, 0x69, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x61, 0x75, 0x74, 0x68, 0x49, 0x64, 0x22, 0x16,
+	0x0a, 0x14, 0x41, 0x75, 0x74, 0x68, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x43, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x9b, 0x01, 0x0a, 0x16, 0x41, 0x75, 0x74, 0x68, 0x41,
+	0x64, 0x6d, 0x69, 0x6e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x41, 0x0a, 0x09, 0x73, 0x65, 0x6e, 0x64, 0x5f, 0x62, 0x6f, 0x64, 0x79, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x41,
+	0x64, 0x6d, 0x69, 0x6e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x2e, 0x53, 0x65, 0x6e, 0x64, 0x42, 0x6f, 0x64, 0x79, 0x52, 0x08, 0x73, 0x65, 0x6e, 0x64,
+	0x42, 0x6f, 0x64, 0x79, 0x1a, 0x3e, 0x0a, 0x08, 0x53, 0x65, 0x6e, 0x64, 0x42, 0x6f, 0x64, 0x79,
+	0x12, 0x19, 0x0a, 0x08, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x07, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x61,
+	0x75, 0x74, 0x68, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x61, 0x75,
+	0x74, 0x68, 0x49, 0x64, 0x22, 0x16, 0x0a, 0x14, 0x41, 0x75, 0x74, 0x68, 0x41, 0x64, 0x6d, 0x69,
+	0x6e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x24, 0x0a, 0x22,
+	0x43, 0x68, 0x65, 0x63, 0x6b, 0x41, 0x6e, 0x64, 0x49, 0x6e, 0x73, 0x65, 0x72, 0x74, 0x52, 0x65,
+	0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x41, 0x72, 0x65, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x22, 0x22, 0x0a, 0x20, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x41, 0x6e, 0x64, 0x49, 0x6e,
+	0x73, 0x65, 0x72, 0x74, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x41, 0x72, 0x65,
+	0x61, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x34, 0x0a, 0x20, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x44,
+	0x61, 0x69, 0x6c, 0x79, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x52, 0x65, 0x77,
+	0x61, 0x72, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x64, 0x61,
+	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x03, 0x64, 0x61, 0x79, 0x22, 0x20, 0x0a, 0x1e,
+	0x41, 0x64, 0x6d, 0x69, 0x6e, 0x44, 0x61, 0x69, 0x6c, 0x79, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d,
+	0x65, 0x6e, 0x64, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x37,
+	0x0a, 0x23, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x44, 0x61, 0x69, 0x6c, 0x79, 0x52, 0x65, 0x63, 0x6f,
+	0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x54, 0x6f, 0x70, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x64, 0x61, 0x79, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x03, 0x64, 0x61, 0x79, 0x22, 0x23, 0x0a, 0x21, 0x41, 0x64, 0x6d, 0x69, 0x6e,
+	0x44, 0x61, 0x69, 0x6c, 0x79, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x54, 0x6f,
+	0x70, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x33, 0x0a, 0x1f,
+	0x41, 0x64, 0x6d, 0x69, 0x6e, 0x44, 0x61, 0x69, 0x6c, 0x79, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72,
+	0x61, 0x77, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x10, 0x0a, 0x03, 0x64, 0x61, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x03, 0x64, 0x61,
+	0x79, 0x22, 0x1f, 0x0a, 0x1d, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x44, 0x61, 0x69, 0x6c, 0x79, 0x57,
+	0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x52, 0x65, 0x70,
+	0x6c, 0x79, 0x22, 0x36, 0x0a, 0x10, 0x46, 0x69, 0x78, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x69, 0x64, 0x31, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x03, 0x69, 0x64, 0x31, 0x12, 0x10, 0x0a, 0x03, 0x69, 0x64, 0x32, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x69, 0x64, 0x32, 0x22, 0x10, 0x0a, 0x0e, 0x46, 0x69,
+	0x78, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x15, 0x0a, 0x13,
+	0x46, 0x69, 0x78, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x22, 0x13, 0x0a, 0x11, 0x46, 0x69, 0x78, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x93, 0x01, 0x0a, 0x11, 0x41, 0x64, 0x6d,
+	0x69, 0x6e, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x3c,
+	0x0a, 0x09, 0x73, 0x65, 0x6e, 0x64, 0x5f, 0x62, 0x6f, 0x64, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1f, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x4c, 0x6f, 0x67,
+	0x69, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x53, 0x65, 0x6e, 0x64, 0x42, 0x6f,
+	0x64, 0x79, 0x52, 0x08, 0x73, 0x65, 0x6e, 0x64, 0x42, 0x6f, 0x64, 0x79, 0x1a, 0x40, 0x0a, 0x08,
+	0x53, 0x65, 0x6e, 0x64, 0x42, 0x6f, 0x64, 0x79, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x63, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x22, 0x27,
+	0x0a, 0x0f, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x70, 0x6c,
+	0x79, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0xa5, 0x01, 0x0a, 0x1a, 0x41, 0x64, 0x6d, 0x69,
+	0x6e, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x45, 0x0a, 0x09, 0x73, 0x65, 0x6e, 0x64, 0x5f, 0x62,
+	0x6f, 0x64, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x41, 0x64, 0x6d, 0x69, 0x6e, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x50, 0x61, 0x73, 0x73, 0x77,
+	0x6f, 0x72, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x53, 0x65, 0x6e, 0x64, 0x42,
+	0x6f, 0x64, 0x79, 0x52, 0x08, 0x73, 0x65, 0x6e, 0x64, 0x42, 0x6f, 0x64, 0x79, 0x1a, 0x40, 0x0a,
+	0x08, 0x53, 0x65, 0x6e, 0x64, 0x42, 0x6f, 0x64, 0x79, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x63, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x63, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x22,
+	0x1a, 0x0a, 0x18, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x50, 0x61,
+	0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0xa3, 0x01, 0x0a, 0x19,
+	0x41, 0x64, 0x6d, 0x69, 0x6e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x44, 0x0a, 0x09, 0x73, 0x65, 0x6e,
+	0x64, 0x5f, 0x62, 0x6f, 0x64, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x63,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x53, 0x65, 0x6e,
+	0x64, 0x42, 0x6f, 0x64, 0x79, 0x52, 0x08, 0x73, 0x65, 0x6e, 0x64, 0x42, 0x6f, 0x64, 0x79, 0x1a,
+	0x40, 0x0a, 0x08, 0x53, 0x65, 0x6e, 0x64, 0x42, 0x6f, 0x64, 0x79, 0x12, 0x18, 0x0a, 0x07, 0x61,
+	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x63,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72,
+	0x64, 0x22, 0x19, 0x0a, 0x17, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x67, 0x0a, 0x18,
+	0x41, 0x64, 0x6d, 0x69, 0x6e, 0x41, 0x75, 0x64, 0x69, 0x74, 0x4c, 0x6f, 0x67, 0x4c, 0x69, 0x73,
+	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x67, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x70, 0x61, 0x67, 0x65, 0x12, 0x19, 0x0a, 0x08,
+	0x61, 0x64, 0x6d, 0x69, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07,
+	0x61, 0x64, 0x6d, 0x69, 0x6e, 0x49, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x6f, 0x70, 0x65, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6f, 0x70, 0x65, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0xc7, 0x02, 0x0a, 0x16, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x41,
+	0x75, 0x64, 0x69, 0x74, 0x4c, 0x6f, 0x67, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79,
+	0x12, 0x34, 0x0a, 0x04, 0x6c, 0x6f, 0x67, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20,
+	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x41, 0x75, 0x64, 0x69, 0x74, 0x4c,
+	0x6f, 0x67, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x2e, 0x4c, 0x69, 0x73, 0x74,
+	0x52, 0x04, 0x6c, 0x6f, 0x67, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x1a, 0xe0, 0x01, 0x0a,
+	0x04, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x5f, 0x69,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x49, 0x64,
+	0x12, 0x18, 0x0a, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x6f, 0x70,
+	0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6f,
+	0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x61, 0x79, 0x6c,
+	0x6f, 0x61, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f,
+	0x61, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x62, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x62, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x61, 0x66,
+	0x74, 0x65, 0x72, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x61, 0x66, 0x74, 0x65, 0x72,
+	0x12, 0x0e, 0x0a, 0x02, 0x69, 0x70, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x70,
+	0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x09,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22,
+	0x46, 0x0a, 0x18, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c,
+	0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x70,
+	0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x70, 0x61, 0x67, 0x65, 0x12,
+	0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0xed, 0x02, 0x0a, 0x16, 0x41, 0x64, 0x6d, 0x69,
+	0x6e, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x70,
+	0x6c, 0x79, 0x12, 0x3e, 0x0a, 0x09, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69,
+	0x6e, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x70,
+	0x6c, 0x79, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61,
+	0x6c, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x1a, 0xfc, 0x01, 0x0a, 0x04, 0x4c, 0x69, 0x73,
+	0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69,
+	0x64, 0x12, 0x1c, 0x0a, 0x09, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x18, 0x0a, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x72, 0x6f,
+	0x70, 0x6f, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a,
+	0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x68,
+	0x65, 0x63, 0x6b, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09,
+	0x63, 0x68, 0x65, 0x63, 0x6b, 0x65, 0x72, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x75, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x75, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0x81, 0x01, 0x0a, 0x1b, 0x41, 0x64, 0x6d, 0x69,
+	0x6e, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x46, 0x0a, 0x09, 0x73, 0x65, 0x6e, 0x64, 0x5f,
+	0x62, 0x6f, 0x64, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x61, 0x70, 0x69,
+	0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x41, 0x70,
+	0x70, 0x72, 0x6f, 0x76, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x53, 0x65, 0x6e,
+	0x64, 0x42, 0x6f, 0x64, 0x79, 0x52, 0x08, 0x73, 0x65, 0x6e, 0x64, 0x42, 0x6f, 0x64, 0x79, 0x1a,
+	0x1a, 0x0a, 0x08, 0x53, 0x65, 0x6e, 0x64, 0x42, 0x6f, 0x64, 0x79, 0x12, 0x0e, 0x0a, 0x02, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x22, 0x4b, 0x0a, 0x19, 0x41,
+	0x64, 0x6d, 0x69, 0x6e, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x41, 0x70, 0x70, 0x72,
+	0x6f, 0x76, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x22, 0x97, 0x01, 0x0a, 0x1a, 0x41, 0x64, 0x6d,
+	0x69, 0x6e, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x52, 0x65, 0x6a, 0x65, 0x63, 0x74,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x45, 0x0a, 0x09, 0x73, 0x65, 0x6e, 0x64, 0x5f,
+	0x62, 0x6f, 0x64, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x61, 0x70, 0x69,
+	0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x52, 0x65,
+	0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x53, 0x65, 0x6e, 0x64,
+	0x42, 0x6f, 0x64, 0x79, 0x52, 0x08, 0x73, 0x65, 0x6e, 0x64, 0x42, 0x6f, 0x64, 0x79, 0x1a, 0x32,
+	0x0a, 0x08, 0x53, 0x65, 0x6e, 0x64, 0x42, 0x6f, 0x64, 0x79, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65,
+	0x61, 0x73, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73,
+	0x6f, 0x6e, 0x22, 0x1a, 0x0a, 0x18, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x50, 0x72, 0x6f, 0x70, 0x6f,
+	0x73, 0x61, 0x6c, 0x52, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x4c,
+	0x0a, 0x1e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x52,
+	0x65, 0x76, 0x69, 0x65, 0x77, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04,
+	0x70, 0x61, 0x67, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0xfc, 0x02, 0x0a,
+	0x1c, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x52, 0x65,
+	0x76, 0x69, 0x65, 0x77, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x40, 0x0a,
+	0x07, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x26,
+	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72,
+	0x61, 0x77, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6c,
+	0x79, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x07, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x73, 0x12,
+	0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x1a, 0x83, 0x02, 0x0a, 0x04, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x1f,
+	0x0a, 0x0b, 0x77, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x0a, 0x77, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x49, 0x64, 0x12,
+	0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f,
+	0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e,
+	0x74, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x75, 0x6c, 0x65, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x72, 0x75, 0x6c, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x64, 0x65, 0x74,
+	0x61, 0x69, 0x6c, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x64, 0x65, 0x74, 0x61, 0x69,
+	0x6c, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x19, 0x0a, 0x08, 0x61, 0x64, 0x6d,
+	0x69, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x61, 0x64, 0x6d,
+	0x69, 0x6e, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x09,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x1d, 0x0a, 0x0a,
+	0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0x8d, 0x01, 0x0a, 0x21,
+	0x41, 0x64, 0x6d, 0x69, 0x6e, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x52, 0x65, 0x76,
+	0x69, 0x65, 0x77, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x4c, 0x0a, 0x09, 0x73, 0x65, 0x6e, 0x64, 0x5f, 0x62, 0x6f, 0x64, 0x79, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x2f, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e,
+	0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x41, 0x70,
+	0x70, 0x72, 0x6f, 0x76, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x53, 0x65, 0x6e,
+	0x64, 0x42, 0x6f, 0x64, 0x79, 0x52, 0x08, 0x73, 0x65, 0x6e, 0x64, 0x42, 0x6f, 0x64, 0x79, 0x1a,
+	0x1a, 0x0a, 0x08, 0x53, 0x65, 0x6e, 0x64, 0x42, 0x6f, 0x64, 0x79, 0x12, 0x0e, 0x0a, 0x02, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x22, 0x21, 0x0a, 0x1f, 0x41,
+	0x64, 0x6d, 0x69, 0x6e, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x52, 0x65, 0x76, 0x69,
+	0x65, 0x77, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0xa3,
+	0x01, 0x0a, 0x20, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77,
+	0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x52, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x4b, 0x0a, 0x09, 0x73, 0x65, 0x6e, 0x64, 0x5f, 0x62, 0x6f, 0x64, 0x79,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d,
+	0x69, 0x6e, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77,
+	0x52, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x53, 0x65,
+	0x6e, 0x64, 0x42, 0x6f, 0x64, 0x79, 0x52, 0x08, 0x73, 0x65, 0x6e, 0x64, 0x42, 0x6f, 0x64, 0x79,
+	0x1a, 0x32, 0x0a, 0x08, 0x53, 0x65, 0x6e, 0x64, 0x42, 0x6f, 0x64, 0x79, 0x12, 0x0e, 0x0a, 0x02,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x16, 0x0a, 0x06,
+	0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65,
+	0x61, 0x73, 0x6f, 0x6e, 0x22, 0x20, 0x0a, 0x1e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x57, 0x69, 0x74,
+	0x68, 0x64, 0x72, 0x61, 0x77, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x52, 0x65, 0x6a, 0x65, 0x63,
+	0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x97, 0x01, 0x0a, 0x1a, 0x41, 0x64, 0x6d, 0x69, 0x6e,
+	0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x52, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x45, 0x0a, 0x09, 0x73, 0x65, 0x6e, 0x64, 0x5f, 0x62, 0x6f,
+	0x64, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41,
+	0x64, 0x6d, 0x69, 0x6e, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x52, 0x65, 0x6a, 0x65,
+	0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x53, 0x65, 0x6e, 0x64, 0x42, 0x6f,
+	0x64, 0x79, 0x52, 0x08, 0x73, 0x65, 0x6e, 0x64, 0x42, 0x6f, 0x64, 0x79, 0x1a, 0x32, 0x0a, 0x08,
+	0x53, 0x65, 0x6e, 0x64, 0x42, 0x6f, 0x64, 0x79, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73,
+	0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e,
+	0x22, 0x1a, 0x0a, 0x18, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61,
+	0x77, 0x52, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x40, 0x0a, 0x1d,
+	0x41, 0x64, 0x6d, 0x69, 0x6e, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x45, 0x76, 0x65,
+	0x6e, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a,
+	0x0b, 0x77, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x0a, 0x77, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x49, 0x64, 0x22, 0xc8,
+	0x02, 0x0a, 0x1b, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77,
+	0x45, 0x76, 0x65, 0x6e, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x3d,
+	0x0a, 0x06, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x25,
+	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72,
+	0x61, 0x77, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79,
+	0x2e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x06, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x1a, 0xe9, 0x01,
+	0x0a, 0x04, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x77, 0x69, 0x74, 0x68, 0x64, 0x72,
+	0x61, 0x77, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x77, 0x69, 0x74,
+	0x68, 0x64, 0x72, 0x61, 0x77, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x66, 0x72, 0x6f, 0x6d, 0x5f,
+	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x66, 0x72,
+	0x6f, 0x6d, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x74, 0x6f, 0x5f, 0x73,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x6f, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f,
+	0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f,
+	0x72, 0x12, 0x1f, 0x0a, 0x0b, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x69, 0x64,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72,
+	0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x6d, 0x61, 0x72, 0x6b, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x6d, 0x61, 0x72, 0x6b, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0x1a, 0x0a, 0x18, 0x41, 0x64, 0x6d,
+	0x69, 0x6e, 0x4c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0xe0, 0x01, 0x0a, 0x16, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x4c,
+	0x65, 0x64, 0x67, 0x65, 0x72, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x52, 0x65, 0x70, 0x6c, 0x79,
+	0x12, 0x40, 0x0a, 0x0a, 0x6d, 0x69, 0x73, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e,
+	0x4c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x52, 0x65, 0x70, 0x6c,
+	0x79, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x0a, 0x6d, 0x69, 0x73, 0x6d, 0x61, 0x74, 0x63, 0x68,
+	0x65, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x1a, 0x6e, 0x0a, 0x04, 0x4c, 0x69, 0x73, 0x74,
+	0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x63, 0x6f, 0x69,
+	0x6e, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x6f,
+	0x69, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65,
+	0x12, 0x16, 0x0a, 0x06, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x22, 0x67, 0x0a, 0x16, 0x41, 0x64, 0x6d, 0x69,
+	0x6e, 0x4c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x4f, 0x70, 0x65, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x41, 0x0a, 0x09, 0x73, 0x65, 0x6e, 0x64, 0x5f, 0x62, 0x6f, 0x64, 0x79, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69,
+	0x6e, 0x4c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x4f, 0x70, 0x65, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x2e, 0x53, 0x65, 0x6e, 0x64, 0x42, 0x6f, 0x64, 0x79, 0x52, 0x08, 0x73, 0x65, 0x6e,
+	0x64, 0x42, 0x6f, 0x64, 0x79, 0x1a, 0x0a, 0x0a, 0x08, 0x53, 0x65, 0x6e, 0x64, 0x42, 0x6f, 0x64,
+	0x79, 0x22, 0x2c, 0x0a, 0x14, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x4c, 0x65, 0x64, 0x67, 0x65, 0x72,
+	0x4f, 0x70, 0x65, 0x6e, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22,
+	0x17, 0x0a, 0x15, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x52, 0x65, 0x63, 0x6f, 0x6e, 0x63, 0x69, 0x6c,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x41, 0x0a, 0x13, 0x41, 0x64, 0x6d, 0x69,
+	0x6e, 0x52, 0x65, 0x63, 0x6f, 0x6e, 0x63, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12,
+	0x14, 0x0a, 0x05, 0x62, 0x61, 0x74, 0x63, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x62, 0x61, 0x74, 0x63, 0x68, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x91, 0x01, 0x0a, 0x1b,
+	0x41, 0x64, 0x6d, 0x69, 0x6e, 0x44, 0x69, 0x73, 0x63, 0x72, 0x65, 0x70, 0x61, 0x6e, 0x63, 0x79,
+	0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x70,
+	0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x70, 0x61, 0x67, 0x65, 0x12,
+	0x14, 0x0a, 0x05, 0x62, 0x61, 0x74, 0x63, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x62, 0x61, 0x74, 0x63, 0x68, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x12, 0x1a, 0x0a, 0x08, 0x73,
+	0x65, 0x76, 0x65, 0x72, 0x69, 0x74, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x73,
+	0x65, 0x76, 0x65, 0x72, 0x69, 0x74, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x65, 0x78, 0x70, 0x6f, 0x72,
+	0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x65, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x22,
+	0xef, 0x02, 0x0a, 0x19, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x44, 0x69, 0x73, 0x63, 0x72, 0x65, 0x70,
+	0x61, 0x6e, 0x63, 0x79, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x49, 0x0a,
+	0x0d, 0x64, 0x69, 0x73, 0x63, 0x72, 0x65, 0x70, 0x61, 0x6e, 0x63, 0x69, 0x65, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e,
+	0x44, 0x69, 0x73, 0x63, 0x72, 0x65, 0x70, 0x61, 0x6e, 0x63, 0x79, 0x4c, 0x69, 0x73, 0x74, 0x52,
+	0x65, 0x70, 0x6c, 0x79, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x0d, 0x64, 0x69, 0x73, 0x63, 0x72,
+	0x65, 0x70, 0x61, 0x6e, 0x63, 0x69, 0x65, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x14,
+	0x0a, 0x05, 0x62, 0x61, 0x74, 0x63, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x62,
+	0x61, 0x74, 0x63, 0x68, 0x12, 0x10, 0x0a, 0x03, 0x63, 0x73, 0x76, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x03, 0x63, 0x73, 0x76, 0x1a, 0xc8, 0x01, 0x0a, 0x04, 0x4c, 0x69, 0x73, 0x74, 0x12,
+	0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12,
+	0x14, 0x0a, 0x05, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x63, 0x68, 0x65, 0x63, 0x6b, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1a,
+	0x0a, 0x08, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x63,
+	0x74, 0x75, 0x61, 0x6c, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x63, 0x74, 0x75,
+	0x61, 0x6c, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x69, 0x66, 0x66, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x64, 0x69, 0x66, 0x66, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x65, 0x76, 0x65, 0x72, 0x69,
+	0x74, 0x79, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x73, 0x65, 0x76, 0x65, 0x72, 0x69,
+	0x74, 0x79, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74,
+	0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41,
+	0x74, 0x22, 0x44, 0x0a, 0x1a, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x52,
+	0x65, 0x63, 0x6f, 0x6e, 0x63, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x73, 0x74, 0x61, 0x72, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x65, 0x6e, 0x64, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x03, 0x65, 0x6e, 0x64, 0x22, 0xa8, 0x02, 0x0a, 0x18, 0x41, 0x64, 0x6d, 0x69,
+	0x6e, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x52, 0x65, 0x63, 0x6f, 0x6e, 0x63, 0x69, 0x6c, 0x65, 0x52,
+	0x65, 0x70, 0x6c, 0x79, 0x12, 0x38, 0x0a, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x43,
+	0x68, 0x61, 0x69, 0x6e, 0x52, 0x65, 0x63, 0x6f, 0x6e, 0x63, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x70,
+	0x6c, 0x79, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x12, 0x14,
+	0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x1a, 0xbb, 0x01, 0x0a, 0x04, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x12, 0x0a,
+	0x04, 0x6b, 0x69, 0x6e, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6b, 0x69, 0x6e,
+	0x64, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x73, 0x73, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x69, 0x73, 0x73, 0x75, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x68, 0x61, 0x73, 0x68, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x68, 0x61, 0x73, 0x68, 0x12, 0x18, 0x0a, 0x07, 0x61,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64,
+	0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x5f,
+	0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64,
+	0x49, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5f, 0x61, 0x6d, 0x6f, 0x75,
+	0x6e, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x41,
+	0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x64, 0x62, 0x5f, 0x61, 0x6d, 0x6f, 0x75,
+	0x6e, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x64, 0x62, 0x41, 0x6d, 0x6f, 0x75,
+	0x6e, 0x74, 0x22, 0x47, 0x0a, 0x1b, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x42, 0x61, 0x6c, 0x61, 0x6e,
+	0x63, 0x65, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x64, 0x61, 0x74, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x66, 0x6f, 0x72, 0x63, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x66, 0x6f, 0x72, 0x63, 0x65, 0x22, 0x4e, 0x0a, 0x19, 0x41,
+	0x64, 0x6d, 0x69, 0x6e, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x53, 0x6e, 0x61, 0x70, 0x73,
+	0x68, 0x6f, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x64, 0x61, 0x74, 0x65, 0x12, 0x1d, 0x0a, 0x0a,
+	0x75, 0x73, 0x65, 0x72, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x09, 0x75, 0x73, 0x65, 0x72, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x4d, 0x0a, 0x1e, 0x41,
+	0x64, 0x6d, 0x69, 0x6e, 0x55, 0x73, 0x65, 0x72, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x48,
+	0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a,
+	0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06,
+	0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x64, 0x61, 0x74, 0x65, 0x22, 0x97, 0x01, 0x0a, 0x1c, 0x41,
+	0x64, 0x6d, 0x69, 0x6e, 0x55, 0x73, 0x65, 0x72, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x48,
+	0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x64,
+	0x61, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x64, 0x61, 0x74, 0x65, 0x12,
+	0x21, 0x0a, 0x0c, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x75, 0x73, 0x64, 0x74, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x55, 0x73,
+	0x64, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x64, 0x68,
+	0x62, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65,
+	0x44, 0x68, 0x62, 0x12, 0x1f, 0x0a, 0x0b, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x62,
+	0x6e, 0x62, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63,
+	0x65, 0x42, 0x6e, 0x62, 0x22, 0x43, 0x0a, 0x19, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x4c, 0x69, 0x61,
+	0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x65, 0x6e, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x65, 0x6e, 0x64, 0x22, 0xbe, 0x03, 0x0a, 0x17, 0x41, 0x64,
+	0x6d, 0x69, 0x6e, 0x4c, 0x69, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x4c, 0x69, 0x73, 0x74,
+	0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x43, 0x0a, 0x0b, 0x6c, 0x69, 0x61, 0x62, 0x69, 0x6c, 0x69,
+	0x74, 0x69, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x61, 0x70, 0x69,
+	0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x4c, 0x69, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x4c,
+	0x69, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x0b, 0x6c,
+	0x69, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x69, 0x65, 0x73, 0x1a, 0xdd, 0x02, 0x0a, 0x04, 0x4c,
+	0x69, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x64, 0x61, 0x74, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x75, 0x73, 0x65, 0x72, 0x5f,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x75, 0x73, 0x65,
+	0x72, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63,
+	0x65, 0x5f, 0x75, 0x73, 0x64, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x62, 0x61,
+	0x6c, 0x61, 0x6e, 0x63, 0x65, 0x55, 0x73, 0x64, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x62, 0x61, 0x6c,
+	0x61, 0x6e, 0x63, 0x65, 0x5f, 0x64, 0x68, 0x62, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a,
+	0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x44, 0x68, 0x62, 0x12, 0x1f, 0x0a, 0x0b, 0x62, 0x61,
+	0x6c, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x62, 0x6e, 0x62, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0a, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x42, 0x6e, 0x62, 0x12, 0x29, 0x0a, 0x10, 0x77,
+	0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x69, 0x6e, 0x67, 0x5f, 0x75, 0x73, 0x64, 0x74, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x77, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x69,
+	0x6e, 0x67, 0x55, 0x73, 0x64, 0x74, 0x12, 0x27, 0x0a, 0x0f, 0x77, 0x69, 0x74, 0x68, 0x64, 0x72,
+	0x61, 0x77, 0x69, 0x6e, 0x67, 0x5f, 0x64, 0x68, 0x62, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0e, 0x77, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x69, 0x6e, 0x67, 0x44, 0x68, 0x62, 0x12,
+	0x25, 0x0a, 0x0e, 0x6c, 0x69, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x5f, 0x75, 0x73, 0x64,
+	0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6c, 0x69, 0x61, 0x62, 0x69, 0x6c, 0x69,
+	0x74, 0x79, 0x55, 0x73, 0x64, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x6c, 0x69, 0x61, 0x62, 0x69, 0x6c,
+	0x69, 0x74, 0x79, 0x5f, 0x64, 0x68, 0x62, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x6c,
+	0x69, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x44, 0x68, 0x62, 0x12, 0x1d, 0x0a, 0x0a, 0x63,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0x74, 0x0a, 0x19, 0x41, 0x64,
+	0x6d, 0x69, 0x6e, 0x55, 0x73, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x65, 0x6d, 0x65, 0x6e, 0x74,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64,
+	0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x65, 0x6e, 0x64, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x03, 0x65, 0x6e, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x66, 0x6f, 0x72, 0x6d,
+	0x61, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74,
+	0x22, 0x9f, 0x03, 0x0a, 0x17, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x55, 0x73, 0x65, 0x72, 0x53, 0x74,
+	0x61, 0x74, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x37, 0x0a, 0x05,
+	0x6c, 0x69, 0x6e, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x61, 0x70,
+	0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x55, 0x73, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x65,
+	0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x05,
+	0x6c, 0x69, 0x6e, 0x65, 0x73, 0x12, 0x10, 0x0a, 0x03, 0x63, 0x73, 0x76, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x03, 0x63, 0x73, 0x76, 0x1a, 0xb8, 0x02, 0x0a, 0x04, 0x4c, 0x69, 0x73, 0x74,
+	0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12,
+	0x16, 0x0a, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x72, 0x65, 0x63, 0x6f, 0x72,
+	0x64, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x72, 0x65, 0x63, 0x6f,
+	0x72, 0x64, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x63, 0x6f, 0x69, 0x6e,
+	0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x6f, 0x69,
+	0x6e, 0x54, 0x79, 0x70, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x16, 0x0a,
+	0x06, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x63,
+	0x68, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65,
+	0x5f, 0x75, 0x73, 0x64, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x62, 0x61, 0x6c,
+	0x61, 0x6e, 0x63, 0x65, 0x55, 0x73, 0x64, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x62, 0x61, 0x6c, 0x61,
+	0x6e, 0x63, 0x65, 0x5f, 0x64, 0x68, 0x62, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x62,
+	0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x44, 0x68, 0x62, 0x12, 0x1f, 0x0a, 0x0b, 0x62, 0x61, 0x6c,
+	0x61, 0x6e, 0x63, 0x65, 0x5f, 0x62, 0x6e, 0x62, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a,
+	0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x42, 0x6e, 0x62, 0x12, 0x16, 0x0a, 0x06, 0x64, 0x65,
+	0x74, 0x61, 0x69, 0x6c, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x64, 0x65, 0x74, 0x61,
+	0x69, 0x6c, 0x22, 0x16, 0x0a, 0x14, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x53, 0x6f, 0x6c, 0x76, 0x65,
+	0x6e, 0x63, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x73, 0x0a, 0x12, 0x41, 0x64,
+	0x6d, 0x69, 0x6e, 0x53, 0x6f, 0x6c, 0x76, 0x65, 0x6e, 0x63, 0x79, 0x52, 0x65, 0x70, 0x6c, 0x79,
+	0x12, 0x3c, 0x0a, 0x08, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x6e, 0x63, 0x79, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x20, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x53, 0x6f,
+	0x6c, 0x76, 0x65, 0x6e, 0x63, 0x79, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x2e,
+	0x4c, 0x69, 0x73, 0x74, 0x52, 0x08, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x6e, 0x63, 0x79, 0x12, 0x1f,
+	0x0a, 0x0b, 0x61, 0x6c, 0x61, 0x72, 0x6d, 0x5f, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0a, 0x61, 0x6c, 0x61, 0x72, 0x6d, 0x52, 0x61, 0x74, 0x69, 0x6f, 0x22,
+	0x1b, 0x0a, 0x19, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x53, 0x6f, 0x6c, 0x76, 0x65, 0x6e, 0x63, 0x79,
+	0x43, 0x68, 0x65, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x2f, 0x0a, 0x17,
+	0x41, 0x64, 0x6d, 0x69, 0x6e, 0x53, 0x6f, 0x6c, 0x76, 0x65, 0x6e, 0x63, 0x79, 0x43, 0x68, 0x65,
+	0x63, 0x6b, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x61, 0x6c, 0x61, 0x72, 0x6d,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x61, 0x6c, 0x61, 0x72, 0x6d, 0x22, 0x42, 0x0a,
+	0x18, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x53, 0x6f, 0x6c, 0x76, 0x65, 0x6e, 0x63, 0x79, 0x4c, 0x69,
+	0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x61,
+	0x72, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x12,
+	0x10, 0x0a, 0x03, 0x65, 0x6e, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x65, 0x6e,
+	0x64, 0x22, 0xab, 0x04, 0x0a, 0x16, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x53, 0x6f, 0x6c, 0x76, 0x65,
+	0x6e, 0x63, 0x79, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x40, 0x0a, 0x0a,
+	0x73, 0x6f, 0x6c, 0x76, 0x65, 0x6e, 0x63, 0x69, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x20, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x53, 0x6f, 0x6c, 0x76,
+	0x65, 0x6e, 0x63, 0x79, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x2e, 0x4c, 0x69,
+	0x73, 0x74, 0x52, 0x0a, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x6e, 0x63, 0x69, 0x65, 0x73, 0x1a, 0xce,
+	0x03, 0x0a, 0x04, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x31, 0x0a, 0x14, 0x6c, 0x6f, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x5f, 0x6f, 0x75, 0x74, 0x73, 0x74, 0x61, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x13, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4f,
+	0x75, 0x74, 0x73, 0x74, 0x61, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x12, 0x29, 0x0a, 0x10, 0x77, 0x69,
+	0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x69, 0x6e, 0x67, 0x5f, 0x75, 0x73, 0x64, 0x74, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x77, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x69, 0x6e,
+	0x67, 0x55, 0x73, 0x64, 0x74, 0x12, 0x27, 0x0a, 0x0f, 0x77, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61,
+	0x77, 0x69, 0x6e, 0x67, 0x5f, 0x64, 0x68, 0x62, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e,
+	0x77, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x69, 0x6e, 0x67, 0x44, 0x68, 0x62, 0x12, 0x21,
+	0x0a, 0x0c, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x75, 0x73, 0x64, 0x74, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x55, 0x73, 0x64,
+	0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x64, 0x68, 0x62,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x44,
+	0x68, 0x62, 0x12, 0x25, 0x0a, 0x0e, 0x6c, 0x69, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x5f,
+	0x75, 0x73, 0x64, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6c, 0x69, 0x61, 0x62,
+	0x69, 0x6c, 0x69, 0x74, 0x79, 0x55, 0x73, 0x64, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x6c, 0x69, 0x61,
+	0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x5f, 0x64, 0x68, 0x62, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0c, 0x6c, 0x69, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x44, 0x68, 0x62, 0x12, 0x1f,
+	0x0a, 0x0b, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x5f, 0x75, 0x73, 0x64, 0x74, 0x18, 0x08, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0a, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x55, 0x73, 0x64, 0x74, 0x12,
+	0x1d, 0x0a, 0x0a, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x5f, 0x64, 0x68, 0x62, 0x18, 0x09, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x44, 0x68, 0x62, 0x12, 0x1d,
+	0x0a, 0x0a, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x5f, 0x75, 0x73, 0x64, 0x74, 0x18, 0x0a, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x09, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x55, 0x73, 0x64, 0x74, 0x12, 0x1b, 0x0a,
+	0x09, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x5f, 0x64, 0x68, 0x62, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x44, 0x68, 0x62, 0x12, 0x14, 0x0a, 0x05, 0x61, 0x6c,
+	0x61, 0x72, 0x6d, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x61, 0x6c, 0x61, 0x72, 0x6d,
+	0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x0d,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22,
+	0xc9, 0x02, 0x0a, 0x1b, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74,
+	0x53, 0x69, 0x6d, 0x75, 0x6c, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75,
+	0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74,
+	0x12, 0x25, 0x0a, 0x0e, 0x72, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x5f, 0x6e, 0x65,
+	0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x72, 0x65, 0x63, 0x6f, 0x6d, 0x6d,
+	0x65, 0x6e, 0x64, 0x4e, 0x65, 0x65, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x6c, 0x6f, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x5f, 0x72, 0x6f, 0x77, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x6c,
+	0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x6f, 0x77, 0x12, 0x2a, 0x0a, 0x11, 0x72, 0x65,
+	0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0f, 0x72, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64,
+	0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x47, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64,
+	0x6d, 0x69, 0x6e, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x53, 0x69, 0x6d, 0x75, 0x6c, 0x61,
+	0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x73, 0x1a,
+	0x3a, 0x0a, 0x0c, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12,
+	0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65,
+	0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0xa2, 0x03, 0x0a, 0x19,
+	0x41, 0x64, 0x6d, 0x69, 0x6e, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x53, 0x69, 0x6d, 0x75,
+	0x6c, 0x61, 0x74, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x72, 0x6f, 0x77,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x72, 0x6f, 0x77, 0x12, 0x10, 0x0a, 0x03, 0x63,
+	0x6f, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x63, 0x6f, 0x6c, 0x12, 0x23, 0x0a,
+	0x0d, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x4c, 0x65, 0x76,
+	0x65, 0x6c, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x12, 0x1f, 0x0a, 0x0b,
+	0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x6d, 0x61, 0x78, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0a, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x4d, 0x61, 0x78, 0x12, 0x3d, 0x0a,
+	0x07, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x23,
+	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69,
+	0x74, 0x53, 0x69, 0x6d, 0x75, 0x6c, 0x61, 0x74, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x2e, 0x4c,
+	0x69, 0x73, 0x74, 0x52, 0x07, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x12, 0x23, 0x0a, 0x0d,
+	0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x5f, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0c, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x52, 0x65, 0x77, 0x61, 0x72,
+	0x64, 0x1a, 0x9c, 0x01, 0x0a, 0x04, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73,
+	0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x75, 0x73, 0x65,
+	0x72, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f,
+	0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75,
+	0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74,
+	0x12, 0x1a, 0x0a, 0x08, 0x63, 0x72, 0x65, 0x64, 0x69, 0x74, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x08, 0x63, 0x72, 0x65, 0x64, 0x69, 0x74, 0x65, 0x64, 0x12, 0x12, 0x0a, 0x04,
+	0x73, 0x74, 0x6f, 0x70, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x04, 0x73, 0x74, 0x6f, 0x70,
+	0x22, 0x25, 0x0a, 0x13, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x22, 0x82, 0x05, 0x0a, 0x11, 0x52, 0x65, 0x77, 0x61,
+	0x72, 0x64, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x0e, 0x0a,
+	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1d, 0x0a,
+	0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x16, 0x0a, 0x06,
+	0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x6d,
+	0x6f, 0x75, 0x6e, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73,
+	0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e,
+	0x12, 0x12, 0x0a, 0x04, 0x72, 0x75, 0x6c, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x72, 0x75, 0x6c, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x05, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x6f,
+	0x75, 0x72, 0x63, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x6f, 0x75, 0x72,
+	0x63, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69,
+	0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x49, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f,
+	0x72, 0x6f, 0x77, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x6c, 0x6f, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x6f, 0x77, 0x12, 0x21, 0x0a, 0x0c, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x5f, 0x63, 0x6f, 0x6c, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x6c, 0x6f,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6c, 0x12, 0x23, 0x0a, 0x0d, 0x70, 0x61, 0x79,
+	0x65, 0x72, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0c, 0x70, 0x61, 0x79, 0x65, 0x72, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x21,
+	0x0a, 0x0c, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x0d,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x48, 0x61, 0x73,
+	0x68, 0x12, 0x25, 0x0a, 0x0e, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x5f, 0x61, 0x6d, 0x6f,
+	0x75, 0x6e, 0x74, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x64, 0x65, 0x70, 0x6f, 0x73,
+	0x69, 0x74, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x2c, 0x0a, 0x12, 0x64, 0x65, 0x70, 0x6f,
+	0x73, 0x69, 0x74, 0x5f, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x0f,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x43, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x2c, 0x0a, 0x12, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64,
+	0x5f, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x10, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x10, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x49, 0x64, 0x12, 0x37, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x73, 0x18,
+	0x11, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x52, 0x65, 0x77, 0x61,
+	0x72, 0x64, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x2e, 0x43, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x73, 0x1a, 0x4d, 0x0a,
+	0x06, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x19, 0x0a, 0x08, 0x6b, 0x65, 0x79, 0x5f, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6b, 0x65, 0x79, 0x4e, 0x61,
+	0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x2a, 0x0a, 0x18,
+	0x41, 0x64, 0x6d, 0x69, 0x6e, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x44, 0x65, 0x74, 0x61, 0x69,
+	0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x22, 0xeb, 0x06, 0x0a, 0x16, 0x41, 0x64, 0x6d,
+	0x69, 0x6e, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x52, 0x65,
+	0x70, 0x6c, 0x79, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x02, 0x69, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x1d, 0x0a,
+	0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x16, 0x0a, 0x06,
+	0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x6d,
+	0x6f, 0x75, 0x6e, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73,
+	0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e,
+	0x12, 0x24, 0x0a, 0x0e, 0x74, 0x79, 0x70, 0x65, 0x5f, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x5f,
+	0x69, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x74, 0x79, 0x70, 0x65, 0x52, 0x65,
+	0x63, 0x6f, 0x72, 0x64, 0x49, 0x64, 0x12, 0x23, 0x0a, 0x0d, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x6c,
+	0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x72,
+	0x75, 0x6c, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x72, 0x75, 0x6c, 0x65, 0x12,
+	0x14, 0x0a, 0x05, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05,
+	0x6c, 0x65, 0x76, 0x65, 0x6c, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18,
+	0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x19, 0x0a,
+	0x08, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x07, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x6c, 0x6f, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x6c,
+	0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x6c, 0x6f, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x72, 0x6f, 0x77, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x0b, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x6f, 0x77, 0x12, 0x21, 0x0a, 0x0c,
+	0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x63, 0x6f, 0x6c, 0x18, 0x0f, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x0b, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6c, 0x12,
+	0x27, 0x0a, 0x0f, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x73, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x18, 0x10, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x70, 0x61, 0x79, 0x65,
+	0x72, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x11, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0c, 0x70, 0x61, 0x79, 0x65, 0x72, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x21, 0x0a,
+	0x0c, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x12, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x48, 0x61, 0x73, 0x68,
+	0x12, 0x25, 0x0a, 0x0e, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x5f, 0x61, 0x6d, 0x6f, 0x75,
+	0x6e, 0x74, 0x18, 0x13, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69,
+	0x74, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x2c, 0x0a, 0x12, 0x64, 0x65, 0x70, 0x6f, 0x73,
+	0x69, 0x74, 0x5f, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x14, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x10, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x2c, 0x0a, 0x12, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x5f,
+	0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x15, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x10, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x49, 0x64, 0x12, 0x34, 0x0a, 0x16, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x5f, 0x6c, 0x6f,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x16, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x14, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x4c, 0x6f, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x3c, 0x0a, 0x07, 0x63, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x73, 0x18, 0x17, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x61, 0x70, 0x69,
+	0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x44, 0x65, 0x74, 0x61,
+	0x69, 0x6c, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x07,
+	0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x73, 0x1a, 0x4d, 0x0a, 0x06, 0x43, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x12, 0x19, 0x0a, 0x08, 0x6b, 0x65, 0x79, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x6b, 0x65, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x17, 0x0a, 0x15, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x56,
+	0x69, 0x70, 0x52, 0x65, 0x63, 0x61, 0x6c, 0x63, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22,
+	0x45, 0x0a, 0x13, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x56, 0x69, 0x70, 0x52, 0x65, 0x63, 0x61, 0x6c,
+	0x63, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x18, 0x0a, 0x07,
+	0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x63,
+	0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x22, 0x16, 0x0a, 0x14, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x4c,
+	0x6f, 0x63, 0x6b, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0xaf,
+	0x01, 0x0a, 0x12, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x4c, 0x6f, 0x63, 0x6b, 0x4c, 0x69, 0x73, 0x74,
+	0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x32, 0x0a, 0x05, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e,
+	0x4c, 0x6f, 0x63, 0x6b, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x2e, 0x4c, 0x69,
+	0x73, 0x74, 0x52, 0x05, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x1a, 0x65, 0x0a, 0x04, 0x4c, 0x69, 0x73,
+	0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x68, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x12, 0x14, 0x0a,
+	0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x74, 0x6f,
+	0x6b, 0x65, 0x6e, 0x12, 0x1b, 0x0a, 0x09, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x5f, 0x61, 0x74,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x41, 0x74,
+	0x22, 0x9a, 0x01, 0x0a, 0x0f, 0x52, 0x65, 0x69, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x3a, 0x0a, 0x09, 0x73, 0x65, 0x6e, 0x64, 0x5f, 0x62, 0x6f, 0x64,
+	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x52, 0x65,
+	0x69, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x53, 0x65,
+	0x6e, 0x64, 0x42, 0x6f, 0x64, 0x79, 0x52, 0x08, 0x73, 0x65, 0x6e, 0x64, 0x42, 0x6f, 0x64, 0x79,
+	0x1a, 0x4b, 0x0a, 0x08, 0x53, 0x65, 0x6e, 0x64, 0x42, 0x6f, 0x64, 0x79, 0x12, 0x16, 0x0a, 0x06,
+	0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x61, 0x6d,
+	0x6f, 0x75, 0x6e, 0x74, 0x12, 0x27, 0x0a, 0x0f, 0x69, 0x64, 0x65, 0x6d, 0x70, 0x6f, 0x74, 0x65,
+	0x6e, 0x63, 0x79, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x69,
+	0x64, 0x65, 0x6d, 0x70, 0x6f, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x4b, 0x65, 0x79, 0x22, 0x3b, 0x0a,
+	0x0d, 0x52, 0x65, 0x69, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x16,
+	0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x68, 0x61, 0x73, 0x68, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x68, 0x61, 0x73, 0x68, 0x22, 0x19, 0x0a, 0x17, 0x4c, 0x6f,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0xa2, 0x04, 0x0a, 0x15, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12,
+	0x3d, 0x0a, 0x09, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x2e, 0x4c,
+	0x69, 0x73, 0x74, 0x52, 0x09, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x31,
+	0x0a, 0x15, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x73, 0x74, 0x6f, 0x70, 0x5f, 0x6c, 0x6f, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x12, 0x6c,
+	0x61, 0x73, 0x74, 0x53, 0x74, 0x6f, 0x70, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49,
+	0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x63, 0x61, 0x6e, 0x5f, 0x72, 0x65, 0x65, 0x6e, 0x74, 0x65, 0x72,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x63, 0x61, 0x6e, 0x52, 0x65, 0x65, 0x6e, 0x74,
+	0x65, 0x72, 0x12, 0x2b, 0x0a, 0x11, 0x72, 0x65, 0x65, 0x6e, 0x74, 0x65, 0x72, 0x5f, 0x72, 0x65,
+	0x6d, 0x61, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x10, 0x72,
+	0x65, 0x65, 0x6e, 0x74, 0x65, 0x72, 0x52, 0x65, 0x6d, 0x61, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x12,
+	0x1f, 0x0a, 0x0b, 0x72, 0x65, 0x65, 0x6e, 0x74, 0x65, 0x72, 0x5f, 0x65, 0x6e, 0x64, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x72, 0x65, 0x65, 0x6e, 0x74, 0x65, 0x72, 0x45, 0x6e, 0x64,
+	0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x61, 0x72, 0x72, 0x79, 0x5f, 0x6f, 0x76, 0x65, 0x72, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x61, 0x72, 0x72, 0x79, 0x4f, 0x76, 0x65, 0x72, 0x1a,
+	0x88, 0x02, 0x0a, 0x04, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x10, 0x0a, 0x03, 0x72, 0x6f, 0x77, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x72, 0x6f, 0x77, 0x12, 0x10, 0x0a, 0x03, 0x63, 0x6f,
+	0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x63, 0x6f, 0x6c, 0x12, 0x23, 0x0a, 0x0d,
+	0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x0c, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x4c, 0x65, 0x76, 0x65,
+	0x6c, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x63,
+	0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x6d, 0x61, 0x78, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0a, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x4d, 0x61, 0x78, 0x12, 0x18, 0x0a, 0x07,
+	0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x70,
+	0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1b,
+	0x0a, 0x09, 0x73, 0x74, 0x6f, 0x70, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x73, 0x74, 0x6f, 0x70, 0x44, 0x61, 0x74, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x63,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x32, 0xc3, 0x48, 0x0a, 0x03, 0x41,
+	0x70, 0x70, 0x12, 0x57, 0x0a, 0x08, 0x55, 0x73, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x14,
+	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x12, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x49,
+	0x6e, 0x66, 0x6f, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x21, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1b,
+	0x12, 0x19, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x70, 0x70, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x65,
+	0x72, 0x2f, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x12, 0x5f, 0x0a, 0x0a, 0x52,
+	0x65, 0x77, 0x61, 0x72, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x16, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x14, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x4c, 0x69,
+	0x73, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x23, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1d, 0x12,
+	0x1b, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x70, 0x70, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72,
+	0x2f, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x12, 0x84, 0x01, 0x0a,
+	0x13, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64,
+	0x4c, 0x69, 0x73, 0x74, 0x12, 0x1f, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x52, 0x65, 0x63, 0x6f, 0x6d,
+	0x6d, 0x65, 0x6e, 0x64, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x52, 0x65, 0x63, 0x6f,
+	0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x52,
+	0x65, 0x70, 0x6c, 0x79, 0x22, 0x2d, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x27, 0x12, 0x25, 0x2f, 0x61,
+	0x70, 0x69, 0x2f, 0x61, 0x70, 0x70, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2f, 0x72, 0x65,
+	0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x5f, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x5f, 0x6c,
+	0x69, 0x73, 0x74, 0x12, 0x6c, 0x0a, 0x0d, 0x46, 0x65, 0x65, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64,
+	0x4c, 0x69, 0x73, 0x74, 0x12, 0x19, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x46, 0x65, 0x65, 0x52, 0x65,
+	0x77, 0x61, 0x72, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x17, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x46, 0x65, 0x65, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x4c,
+	0x69, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x27, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x21,
+	0x12, 0x1f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x70, 0x70, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x65,
+	0x72, 0x2f, 0x66, 0x65, 0x65, 0x5f, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x5f, 0x6c, 0x69, 0x73,
+	0x74, 0x12, 0x67, 0x0a, 0x0c, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x4c, 0x69, 0x73,
+	0x74, 0x12, 0x18, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77,
+	0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x61, 0x70,
+	0x69, 0x2e, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65,
+	0x70, 0x6c, 0x79, 0x22, 0x25, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1f, 0x12, 0x1d, 0x2f, 0x61, 0x70,
+	0x69, 0x2f, 0x61, 0x70, 0x70, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2f, 0x77, 0x69, 0x74,
+	0x68, 0x64, 0x72, 0x61, 0x77, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x12, 0x6b, 0x0a, 0x0d, 0x52, 0x65,
+	0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x19, 0x2e, 0x61, 0x70,
+	0x69, 0x2e, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x52, 0x65, 0x63,
+	0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22,
+	0x26, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x20, 0x12, 0x1e, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x70,
+	0x70, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2f, 0x72, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65,
+	0x6e, 0x64, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x12, 0x61, 0x0a, 0x08, 0x57, 0x69, 0x74, 0x68, 0x64,
+	0x72, 0x61, 0x77, 0x12, 0x14, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72,
+	0x61, 0x77, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x12, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x2b, 0x82,
+	0xd3, 0xe4, 0x93, 0x02, 0x25, 0x22, 0x18, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x70, 0x70, 0x5f,
+	0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2f, 0x77, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x3a,
+	0x09, 0x73, 0x65, 0x6e, 0x64, 0x5f, 0x62, 0x6f, 0x64, 0x79, 0x12, 0x7a, 0x0a, 0x0e, 0x57, 0x69,
+	0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x12, 0x1a, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x43, 0x61, 0x6e, 0x63, 0x65,
+	0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x57,
+	0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x52, 0x65, 0x70,
+	0x6c, 0x79, 0x22, 0x32, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2c, 0x22, 0x1f, 0x2f, 0x61, 0x70, 0x69,
+	0x2f, 0x61, 0x70, 0x70, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2f, 0x77, 0x69, 0x74, 0x68,
+	0x64, 0x72, 0x61, 0x77, 0x5f, 0x63, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x3a, 0x09, 0x73, 0x65, 0x6e,
+	0x64, 0x5f, 0x62, 0x6f, 0x64, 0x79, 0x12, 0x51, 0x0a, 0x07, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69,
+	0x74, 0x12, 0x13, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x11, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x44, 0x65, 0x70,
+	0x6f, 0x73, 0x69, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x1e, 0x82, 0xd3, 0xe4, 0x93, 0x02,
+	0x18, 0x12, 0x16, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x5f, 0x64, 0x68,
+	0x62, 0x2f, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x12, 0x6d, 0x0a, 0x0f, 0x41, 0x64, 0x6d,
+	0x69, 0x6e, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x1b, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x4c, 0x69,
+	0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x41, 0x64, 0x6d, 0x69, 0x6e, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x52,
+	0x65, 0x70, 0x6c, 0x79, 0x22, 0x22, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1c, 0x12, 0x1a, 0x2f, 0x61,
+	0x70, 0x69, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x5f, 0x64, 0x68, 0x62, 0x2f, 0x72, 0x65, 0x77,
+	0x61, 0x72, 0x64, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x12, 0x7a, 0x0a, 0x12, 0x41, 0x64, 0x6d, 0x69,
+	0x6e, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x42, 0x6e, 0x62, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x1e,
+	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64,
+	0x42, 0x6e, 0x62, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c,
+	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64,
+	0x42, 0x6e, 0x62, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x26, 0x82, 0xd3,
+	0xe4, 0x93, 0x02, 0x20, 0x12, 0x1e, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e,
+	0x5f, 0x64, 0x68, 0x62, 0x2f, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x5f, 0x62, 0x6e, 0x62, 0x5f,
+	0x6c, 0x69, 0x73, 0x74, 0x12, 0x65, 0x0a, 0x0d, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x55, 0x73, 0x65,
+	0x72, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x19, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69,
+	0x6e, 0x55, 0x73, 0x65, 0x72, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x17, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x55, 0x73, 0x65, 0x72,
+	0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x20, 0x82, 0xd3, 0xe4, 0x93, 0x02,
+	0x1a, 0x12, 0x18, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x5f, 0x64, 0x68,
+	0x62, 0x2f, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x12, 0x80, 0x01, 0x0a, 0x12,
+	0x43, 0x68, 0x65, 0x63, 0x6b, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x55, 0x73, 0x65, 0x72, 0x41, 0x72,
+	0x65, 0x61, 0x12, 0x1e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x41, 0x64,
+	0x6d, 0x69, 0x6e, 0x55, 0x73, 0x65, 0x72, 0x41, 0x72, 0x65, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x41, 0x64,
+	0x6d, 0x69, 0x6e, 0x55, 0x73, 0x65, 0x72, 0x41, 0x72, 0x65, 0x61, 0x52, 0x65, 0x70, 0x6c, 0x79,
+	0x22, 0x2c, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x26, 0x12, 0x24, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61,
+	0x64, 0x6d, 0x69, 0x6e, 0x5f, 0x64, 0x68, 0x62, 0x2f, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x5f, 0x61,
+	0x64, 0x6d, 0x69, 0x6e, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x61, 0x72, 0x65, 0x61, 0x12, 0xca,
+	0x01, 0x0a, 0x24, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x41, 0x6e, 0x64, 0x49, 0x6e, 0x73, 0x65, 0x72,
+	0x74, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d,
+	0x65, 0x6e, 0x64, 0x55, 0x73, 0x65, 0x72, 0x12, 0x30, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x43, 0x68,
+	0x65, 0x63, 0x6b, 0x41, 0x6e, 0x64, 0x49, 0x6e, 0x73, 0x65, 0x72, 0x74, 0x4c, 0x6f, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x55, 0x73,
+	0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2e, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x43, 0x68, 0x65, 0x63, 0x6b, 0x41, 0x6e, 0x64, 0x49, 0x6e, 0x73, 0x65, 0x72, 0x74, 0x4c, 0x6f,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64,
+	0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x40, 0x82, 0xd3, 0xe4, 0x93, 0x02,
+	0x3a, 0x12, 0x38, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x5f, 0x64, 0x68,
+	0x62, 0x2f, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x5f, 0x61, 0x6e, 0x64, 0x5f, 0x69, 0x6e, 0x73, 0x65,
+	0x72, 0x74, 0x5f, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x5f, 0x72, 0x65, 0x63,
+	0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x12, 0x83, 0x01, 0x0a, 0x13,
+	0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x55,
+	0x73, 0x65, 0x72, 0x12, 0x1f, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64,
+	0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x55, 0x70, 0x6c, 0x6f, 0x61,
+	0x64, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65,
+	0x70, 0x6c, 0x79, 0x22, 0x2c, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x26, 0x12, 0x24, 0x2f, 0x61, 0x70,
+	0x69, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x5f, 0x64, 0x68, 0x62, 0x2f, 0x75, 0x70, 0x6c, 0x6f,
+	0x61, 0x64, 0x5f, 0x72, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x5f, 0x75, 0x73, 0x65,
+	0x72, 0x12, 0x75, 0x0a, 0x11, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x1d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d,
+	0x69, 0x6e, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69,
+	0x6e, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x70,
+	0x6c, 0x79, 0x22, 0x24, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1e, 0x12, 0x1c, 0x2f, 0x61, 0x70, 0x69,
+	0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x5f, 0x64, 0x68, 0x62, 0x2f, 0x6c, 0x6f, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x12, 0x82, 0x01, 0x0a, 0x14, 0x41, 0x64, 0x6d,
+	0x69, 0x6e, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x6c, 0x6c, 0x4c, 0x69, 0x73,
+	0x74, 0x12, 0x20, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x4c, 0x6f, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x6c, 0x6c, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x4c,
+	0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x6c, 0x6c, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65,
+	0x70, 0x6c, 0x79, 0x22, 0x28, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x22, 0x12, 0x20, 0x2f, 0x61, 0x70,
+	0x69, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x5f, 0x64, 0x68, 0x62, 0x2f, 0x6c, 0x6f, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x61, 0x6c, 0x6c, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x12, 0x75, 0x0a,
+	0x11, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x4c, 0x69,
+	0x73, 0x74, 0x12, 0x1d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x57, 0x69,
+	0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x1b, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x57, 0x69, 0x74,
+	0x68, 0x64, 0x72, 0x61, 0x77, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x24,
+	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1e, 0x12, 0x1c, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x64, 0x6d,
+	0x69, 0x6e, 0x5f, 0x64, 0x68, 0x62, 0x2f, 0x77, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x5f,
+	0x6c, 0x69, 0x73, 0x74, 0x12, 0x64, 0x0a, 0x0d, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x57, 0x69, 0x74,
+	0x68, 0x64, 0x72, 0x61, 0x77, 0x12, 0x19, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69,
+	0x6e, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x17, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x57, 0x69, 0x74, 0x68,
+	0x64, 0x72, 0x61, 0x77, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x1f, 0x82, 0xd3, 0xe4, 0x93, 0x02,
+	0x19, 0x12, 0x17, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x5f, 0x64, 0x68,
+	0x62, 0x2f, 0x77, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x12, 0xa3, 0x01, 0x0a, 0x1c, 0x41,
+	0x64, 0x6d, 0x69, 0x6e, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x44, 0x6f, 0x69, 0x6e,
+	0x67, 0x54, 0x6f, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x65, 0x64, 0x12, 0x28, 0x2e, 0x61, 0x70,
+	0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x44,
+	0x6f, 0x69, 0x6e, 0x67, 0x54, 0x6f, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x65, 0x64, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69,
+	0x6e, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x44, 0x6f, 0x69, 0x6e, 0x67, 0x54, 0x6f,
+	0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x65, 0x64, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x31, 0x82,
+	0xd3, 0xe4, 0x93, 0x02, 0x2b, 0x12, 0x29, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x64, 0x6d, 0x69,
+	0x6e, 0x5f, 0x64, 0x68, 0x62, 0x2f, 0x77, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x5f, 0x64,
+	0x6f, 0x69, 0x6e, 0x67, 0x5f, 0x74, 0x6f, 0x5f, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x65, 0x64,
+	0x12, 0x71, 0x0a, 0x10, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61,
+	0x77, 0x45, 0x74, 0x68, 0x12, 0x1c, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e,
+	0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x45, 0x74, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x57, 0x69,
+	0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x45, 0x74, 0x68, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x23,
+	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1d, 0x12, 0x1b, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x64, 0x6d,
+	0x69, 0x6e, 0x5f, 0x64, 0x68, 0x62, 0x2f, 0x77, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x5f,
+	0x65, 0x74, 0x68, 0x12, 0x50, 0x0a, 0x08, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x46, 0x65, 0x65, 0x12,
+	0x14, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x46, 0x65, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x12, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69,
+	0x6e, 0x46, 0x65, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x1a, 0x82, 0xd3, 0xe4, 0x93, 0x02,
+	0x14, 0x12, 0x12, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x5f, 0x64, 0x68,
+	0x62, 0x2f, 0x66, 0x65, 0x65, 0x12, 0x65, 0x0a, 0x0d, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x44, 0x61,
+	0x69, 0x6c, 0x79, 0x46, 0x65, 0x65, 0x12, 0x19, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d,
+	0x69, 0x6e, 0x44, 0x61, 0x69, 0x6c, 0x79, 0x46, 0x65, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x17, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x44, 0x61, 0x69,
+	0x6c, 0x79, 0x46, 0x65, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x20, 0x82, 0xd3, 0xe4, 0x93,
+	0x02, 0x1a, 0x12, 0x18, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x5f, 0x64,
+	0x68, 0x62, 0x2f, 0x64, 0x61, 0x69, 0x6c, 0x79, 0x5f, 0x66, 0x65, 0x65, 0x12, 0x50, 0x0a, 0x08,
+	0x41, 0x64, 0x6d, 0x69, 0x6e, 0x41, 0x6c, 0x6c, 0x12, 0x14, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41,
+	0x64, 0x6d, 0x69, 0x6e, 0x41, 0x6c, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x12,
+	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x41, 0x6c, 0x6c, 0x52, 0x65, 0x70,
+	0x6c, 0x79, 0x22, 0x1a, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x14, 0x12, 0x12, 0x2f, 0x61, 0x70, 0x69,
+	0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x5f, 0x64, 0x68, 0x62, 0x2f, 0x61, 0x6c, 0x6c, 0x12, 0x79,
+	0x0a, 0x12, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x63, 0x6f, 0x6d,
+	0x6d, 0x65, 0x6e, 0x64, 0x12, 0x1e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e,
+	0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e,
+	0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x52, 0x65, 0x70,
+	0x6c, 0x79, 0x22, 0x25, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1f, 0x12, 0x1d, 0x2f, 0x61, 0x70, 0x69,
+	0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x5f, 0x64, 0x68, 0x62, 0x2f, 0x75, 0x73, 0x65, 0x72, 0x5f,
+	0x72, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x12, 0x7d, 0x0a, 0x13, 0x41, 0x64, 0x6d,
+	0x69, 0x6e, 0x4d, 0x6f, 0x6e, 0x74, 0x68, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64,
+	0x12, 0x1f, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x4d, 0x6f, 0x6e, 0x74,
+	0x68, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x1d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x4d, 0x6f, 0x6e,
+	0x74, 0x68, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x52, 0x65, 0x70, 0x6c, 0x79,
+	0x22, 0x26, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x20, 0x12, 0x1e, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61,
+	0x64, 0x6d, 0x69, 0x6e, 0x5f, 0x64, 0x68, 0x62, 0x2f, 0x6d, 0x6f, 0x6e, 0x74, 0x68, 0x5f, 0x72,
+	0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x12, 0x5c, 0x0a, 0x0b, 0x41, 0x64, 0x6d, 0x69,
+	0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x17, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64,
+	0x6d, 0x69, 0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x15, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x43, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x1d, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x17, 0x12,
+	0x15, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x5f, 0x64, 0x68, 0x62, 0x2f,
+	0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x80, 0x01, 0x0a, 0x11, 0x41, 0x64, 0x6d, 0x69, 0x6e,
+	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x12, 0x1d, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x55, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x61, 0x70,
+	0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x2f, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x29,
+	0x22, 0x1c, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x5f, 0x64, 0x68, 0x62,
+	0x2f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x5f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x3a, 0x09,
+	0x73, 0x65, 0x6e, 0x64, 0x5f, 0x62, 0x6f, 0x64, 0x79, 0x12, 0x74, 0x0a, 0x0e, 0x41, 0x64, 0x6d,
+	0x69, 0x6e, 0x56, 0x69, 0x70, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x12, 0x1a, 0x2e, 0x61, 0x70,
+	0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x56, 0x69, 0x70, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64,
+	0x6d, 0x69, 0x6e, 0x56, 0x69, 0x70, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x70, 0x6c,
+	0x79, 0x22, 0x2c, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x26, 0x22, 0x19, 0x2f, 0x61, 0x70, 0x69, 0x2f,
+	0x61, 0x64, 0x6d, 0x69, 0x6e, 0x5f, 0x64, 0x68, 0x62, 0x2f, 0x76, 0x69, 0x70, 0x5f, 0x75, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x3a, 0x09, 0x73, 0x65, 0x6e, 0x64, 0x5f, 0x62, 0x6f, 0x64, 0x79, 0x12,
+	0x78, 0x0a, 0x0f, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x55, 0x6e, 0x64, 0x6f, 0x55, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x12, 0x1b, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x55, 0x6e,
+	0x64, 0x6f, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x19, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x55, 0x6e, 0x64, 0x6f, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x2d, 0x82, 0xd3, 0xe4, 0x93,
+	0x02, 0x27, 0x22, 0x1a, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x5f, 0x64,
+	0x68, 0x62, 0x2f, 0x75, 0x6e, 0x64, 0x6f, 0x5f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x3a, 0x09,
+	0x73, 0x65, 0x6e, 0x64, 0x5f, 0x62, 0x6f, 0x64, 0x79, 0x12, 0x88, 0x01, 0x0a, 0x14, 0x41, 0x64,
+	0x6d, 0x69, 0x6e, 0x41, 0x72, 0x65, 0x61, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x55, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x12, 0x20, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x41, 0x72,
+	0x65, 0x61, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e,
+	0x41, 0x72, 0x65, 0x61, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52,
+	0x65, 0x70, 0x6c, 0x79, 0x22, 0x2e, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x28, 0x22, 0x1b, 0x2f, 0x61,
+	0x70, 0x69, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x5f, 0x64, 0x68, 0x62, 0x2f, 0x6c, 0x65, 0x76,
+	0x65, 0x6c, 0x5f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x3a, 0x09, 0x73, 0x65, 0x6e, 0x64, 0x5f,
+	0x62, 0x6f, 0x64, 0x79, 0x12, 0x88, 0x01, 0x0a, 0x13, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x4c, 0x6f,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x73, 0x65, 0x72, 0x74, 0x12, 0x1f, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x49, 0x6e, 0x73, 0x65, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e,
+	0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x49, 0x6e, 0x73, 0x65, 0x72, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x31, 0x82, 0xd3,
+	0xe4, 0x93, 0x02, 0x2b, 0x22, 0x1e, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e,
+	0x5f, 0x64, 0x68, 0x62, 0x2f, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x6e,
+	0x73, 0x65, 0x72, 0x74, 0x3a, 0x09, 0x73, 0x65, 0x6e, 0x64, 0x5f, 0x62, 0x6f, 0x64, 0x79, 0x12,
+	0x84, 0x01, 0x0a, 0x12, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65,
+	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x12, 0x1e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d,
+	0x69, 0x6e, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d,
+	0x69, 0x6e, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52,
+	0x65, 0x70, 0x6c, 0x79, 0x22, 0x30, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2a, 0x22, 0x1d, 0x2f, 0x61,
+	0x70, 0x69, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x5f, 0x64, 0x68, 0x62, 0x2f, 0x62, 0x61, 0x6c,
+	0x61, 0x6e, 0x63, 0x65, 0x5f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x3a, 0x09, 0x73, 0x65, 0x6e,
+	0x64, 0x5f, 0x62, 0x6f, 0x64, 0x79, 0x12, 0x63, 0x0a, 0x0a, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x4c,
+	0x6f, 0x67, 0x69, 0x6e, 0x12, 0x16, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e,
+	0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x14, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x70,
+	0x6c, 0x79, 0x22, 0x27, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x21, 0x22, 0x14, 0x2f, 0x61, 0x70, 0x69,
+	0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x5f, 0x64, 0x68, 0x62, 0x2f, 0x6c, 0x6f, 0x67, 0x69, 0x6e,
+	0x3a, 0x09, 0x73, 0x65, 0x6e, 0x64, 0x5f, 0x62, 0x6f, 0x64, 0x79, 0x12, 0x84, 0x01, 0x0a, 0x12,
+	0x41, 0x64, 0x6d, 0x69, 0x6e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x12, 0x1e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x43, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x43, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79,
+	0x22, 0x30, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2a, 0x22, 0x1d, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61,
+	0x64, 0x6d, 0x69, 0x6e, 0x5f, 0x64, 0x68, 0x62, 0x2f, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x5f,
+	0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x3a, 0x09, 0x73, 0x65, 0x6e, 0x64, 0x5f, 0x62, 0x6f,
+	0x64, 0x79, 0x12, 0x88, 0x01, 0x0a, 0x13, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x43, 0x68, 0x61, 0x6e,
+	0x67, 0x65, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x1f, 0x2e, 0x61, 0x70, 0x69,
+	0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x50, 0x61, 0x73, 0x73,
+	0x77, 0x6f, 0x72, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x61, 0x70,
+	0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x50, 0x61, 0x73,
+	0x73, 0x77, 0x6f, 0x72, 0x64, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x31, 0x82, 0xd3, 0xe4, 0x93,
+	0x02, 0x2b, 0x22, 0x1e, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x5f, 0x64,
+	0x68, 0x62, 0x2f, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x5f, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f,
+	0x72, 0x64, 0x3a, 0x09, 0x73, 0x65, 0x6e, 0x64, 0x5f, 0x62, 0x6f, 0x64, 0x79, 0x12, 0x5a, 0x0a,
+	0x09, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x15, 0x2e, 0x61, 0x70, 0x69,
+	0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x13, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x4c, 0x69, 0x73,
+	0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x21, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1b, 0x12, 0x19,
+	0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x5f, 0x64, 0x68, 0x62, 0x2f, 0x61,
+	0x64, 0x6d, 0x69, 0x6e, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x12, 0x56, 0x0a, 0x08, 0x41, 0x75, 0x74,
+	0x68, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x14, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x75, 0x74, 0x68,
+	0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x12, 0x2e, 0x61, 0x70,
+	0x69, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22,
+	0x20, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1a, 0x12, 0x18, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x64,
+	0x6d, 0x69, 0x6e, 0x5f, 0x64, 0x68, 0x62, 0x2f, 0x61, 0x75, 0x74, 0x68, 0x5f, 0x6c, 0x69, 0x73,
+	0x74, 0x12, 0x5f, 0x0a, 0x0a, 0x4d, 0x79, 0x41, 0x75, 0x74, 0x68, 0x4c, 0x69, 0x73, 0x74, 0x12,
+	0x16, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x4d, 0x79, 0x41, 0x75, 0x74, 0x68, 0x4c, 0x69, 0x73, 0x74,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x14, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x4d, 0x79,
+	0x41, 0x75, 0x74, 0x68, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x23, 0x82,
+	0xd3, 0xe4, 0x93, 0x02, 0x1d, 0x12, 0x1b, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x64, 0x6d, 0x69,
+	0x6e, 0x5f, 0x64, 0x68, 0x62, 0x2f, 0x6d, 0x79, 0x5f, 0x61, 0x75, 0x74, 0x68, 0x5f, 0x6c, 0x69,
+	0x73, 0x74, 0x12, 0x67, 0x0a, 0x0c, 0x55, 0x73, 0x65, 0x72, 0x41, 0x75, 0x74, 0x68, 0x4c, 0x69,
+	0x73, 0x74, 0x12, 0x18, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x41, 0x75, 0x74,
+	0x68, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x41, 0x75, 0x74, 0x68, 0x4c, 0x69, 0x73, 0x74, 0x52,
+	0x65, 0x70, 0x6c, 0x79, 0x22, 0x25, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1f, 0x12, 0x1d, 0x2f, 0x61,
+	0x70, 0x69, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x5f, 0x64, 0x68, 0x62, 0x2f, 0x75, 0x73, 0x65,
+	0x72, 0x5f, 0x61, 0x75, 0x74, 0x68, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x12, 0x78, 0x0a, 0x0f, 0x41,
+	0x75, 0x74, 0x68, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x12, 0x1b,
+	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x43, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x61, 0x70,
+	0x69, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x43, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x2d, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x27, 0x22, 0x1a,
+	0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x5f, 0x64, 0x68, 0x62, 0x2f, 0x61,
+	0x75, 0x74, 0x68, 0x5f, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x3a, 0x09, 0x73, 0x65, 0x6e, 0x64,
+	0x5f, 0x62, 0x6f, 0x64, 0x79, 0x12, 0x78, 0x0a, 0x0f, 0x41, 0x75, 0x74, 0x68, 0x41, 0x64, 0x6d,
+	0x69, 0x6e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x12, 0x1b, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41,
+	0x75, 0x74, 0x68, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x75, 0x74, 0x68,
+	0x41, 0x64, 0x6d, 0x69, 0x6e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79,
+	0x22, 0x2d, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x27, 0x22, 0x1a, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61,
+	0x64, 0x6d, 0x69, 0x6e, 0x5f, 0x64, 0x68, 0x62, 0x2f, 0x61, 0x75, 0x74, 0x68, 0x5f, 0x64, 0x65,
+	0x6c, 0x65, 0x74, 0x65, 0x3a, 0x09, 0x73, 0x65, 0x6e, 0x64, 0x5f, 0x62, 0x6f, 0x64, 0x79, 0x12,
+	0xa5, 0x01, 0x0a, 0x1b, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x41, 0x6e, 0x64, 0x49, 0x6e, 0x73, 0x65,
+	0x72, 0x74, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x41, 0x72, 0x65, 0x61, 0x12,
+	0x27, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x41, 0x6e, 0x64, 0x49, 0x6e,
+	0x73, 0x65, 0x72, 0x74, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x41, 0x72, 0x65,
+	0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x43,
+	0x68, 0x65, 0x63, 0x6b, 0x41, 0x6e, 0x64, 0x49, 0x6e, 0x73, 0x65, 0x72, 0x74, 0x52, 0x65, 0x63,
+	0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x41, 0x72, 0x65, 0x61, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22,
+	0x36, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x30, 0x12, 0x2e, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x64,
+	0x6d, 0x69, 0x6e, 0x5f, 0x64, 0x68, 0x62, 0x2f, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x5f, 0x61, 0x6e,
+	0x64, 0x5f, 0x69, 0x6e, 0x73, 0x65, 0x72, 0x74, 0x5f, 0x72, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65,
+	0x6e, 0x64, 0x5f, 0x61, 0x72, 0x65, 0x61, 0x12, 0x96, 0x01, 0x0a, 0x19, 0x41, 0x64, 0x6d, 0x69,
+	0x6e, 0x44, 0x61, 0x69, 0x6c, 0x79, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x52,
+	0x65, 0x77, 0x61, 0x72, 0x64, 0x12, 0x25, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69,
+	0x6e, 0x44, 0x61, 0x69, 0x6c, 0x79, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x52,
+	0x65, 0x77, 0x61, 0x72, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x44, 0x61, 0x69, 0x6c, 0x79, 0x52, 0x65, 0x63,
+	0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x52, 0x65, 0x70, 0x6c,
+	0x79, 0x22, 0x2d, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x27, 0x12, 0x25, 0x2f, 0x61, 0x70, 0x69, 0x2f,
+	0x61, 0x64, 0x6d, 0x69, 0x6e, 0x5f, 0x64, 0x68, 0x62, 0x2f, 0x64, 0x61, 0x69, 0x6c, 0x79, 0x5f,
+	0x72, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x5f, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64,
+	0x12, 0xa3, 0x01, 0x0a, 0x1c, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x44, 0x61, 0x69, 0x6c, 0x79, 0x52,
+	0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x54, 0x6f, 0x70, 0x52, 0x65, 0x77, 0x61, 0x72,
+	0x64, 0x12, 0x28, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x44, 0x61, 0x69,
+	0x6c, 0x79, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x54, 0x6f, 0x70, 0x52, 0x65,
+	0x77, 0x61, 0x72, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x61, 0x70,
+	0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x44, 0x61, 0x69, 0x6c, 0x79, 0x52, 0x65, 0x63, 0x6f,
+	0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x54, 0x6f, 0x70, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x52, 0x65,
+	0x70, 0x6c, 0x79, 0x22, 0x31, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2b, 0x12, 0x29, 0x2f, 0x61, 0x70,
+	0x69, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x5f, 0x64, 0x68, 0x62, 0x2f, 0x64, 0x61, 0x69, 0x6c,
+	0x79, 0x5f, 0x72, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x5f, 0x74, 0x6f, 0x70, 0x5f,
+	0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x12, 0x92, 0x01, 0x0a, 0x18, 0x41, 0x64, 0x6d, 0x69, 0x6e,
+	0x44, 0x61, 0x69, 0x6c, 0x79, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x52, 0x65, 0x77,
+	0x61, 0x72, 0x64, 0x12, 0x24, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x44,
+	0x61, 0x69, 0x6c, 0x79, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x52, 0x65, 0x77, 0x61,
+	0x72, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x41, 0x64, 0x6d, 0x69, 0x6e, 0x44, 0x61, 0x69, 0x6c, 0x79, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72,
+	0x61, 0x77, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x2c, 0x82,
+	0xd3, 0xe4, 0x93, 0x02, 0x26, 0x12, 0x24, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x64, 0x6d, 0x69,
+	0x6e, 0x5f, 0x64, 0x68, 0x62, 0x2f, 0x64, 0x61, 0x69, 0x6c, 0x79, 0x5f, 0x77, 0x69, 0x74, 0x68,
+	0x64, 0x72, 0x61, 0x77, 0x5f, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x12, 0x5a, 0x0a, 0x09, 0x46,
+	0x69, 0x78, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x12, 0x15, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x46,
+	0x69, 0x78, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x13, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x46, 0x69, 0x78, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x52,
+	0x65, 0x70, 0x6c, 0x79, 0x22, 0x21, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1b, 0x12, 0x19, 0x2f, 0x61,
+	0x70, 0x69, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x5f, 0x64, 0x68, 0x62, 0x2f, 0x66, 0x69, 0x78,
+	0x5f, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x12, 0x66, 0x0a, 0x0c, 0x46, 0x69, 0x78, 0x4c, 0x6f,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x18, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x46, 0x69,
+	0x78, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x16, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x46, 0x69, 0x78, 0x4c, 0x6f, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x24, 0x82, 0xd3, 0xe4, 0x93, 0x02,
+	0x1e, 0x12, 0x1c, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x5f, 0x64, 0x68,
+	0x62, 0x2f, 0x66, 0x69, 0x78, 0x5f, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12,
+	0x76, 0x0a, 0x11, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x41, 0x75, 0x64, 0x69, 0x74, 0x4c, 0x6f, 0x67,
+	0x4c, 0x69, 0x73, 0x74, 0x12, 0x1d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e,
+	0x41, 0x75, 0x64, 0x69, 0x74, 0x4c, 0x6f, 0x67, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x41,
+	0x75, 0x64, 0x69, 0x74, 0x4c, 0x6f, 0x67, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79,
+	0x22, 0x25, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1f, 0x12, 0x1d, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61,
+	0x64, 0x6d, 0x69, 0x6e, 0x5f, 0x64, 0x68, 0x62, 0x2f, 0x61, 0x75, 0x64, 0x69, 0x74, 0x5f, 0x6c,
+	0x6f, 0x67, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x12, 0x75, 0x0a, 0x11, 0x41, 0x64, 0x6d, 0x69, 0x6e,
+	0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x1d, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c,
+	0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x61, 0x70,
+	0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x4c,
+	0x69, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x24, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1e,
+	0x12, 0x1c, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x5f, 0x64, 0x68, 0x62,
+	0x2f, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x12, 0x8c,
+	0x01, 0x0a, 0x14, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c,
+	0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x12, 0x20, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64,
+	0x6d, 0x69, 0x6e, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x41, 0x70, 0x70, 0x72, 0x6f,
+	0x76, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x41, 0x64, 0x6d, 0x69, 0x6e, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x41, 0x70, 0x70,
+	0x72, 0x6f, 0x76, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x32, 0x82, 0xd3, 0xe4, 0x93, 0x02,
+	0x2c, 0x22, 0x1f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x5f, 0x64, 0x68,
+	0x62, 0x2f, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x5f, 0x61, 0x70, 0x70, 0x72, 0x6f,
+	0x76, 0x65, 0x3a, 0x09, 0x73, 0x65, 0x6e, 0x64, 0x5f, 0x62, 0x6f, 0x64, 0x79, 0x12, 0x88, 0x01,
+	0x0a, 0x13, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x52,
+	0x65, 0x6a, 0x65, 0x63, 0x74, 0x12, 0x1f, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69,
+	0x6e, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x52, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d,
+	0x69, 0x6e, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x52, 0x65, 0x6a, 0x65, 0x63, 0x74,
+	0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x31, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2b, 0x22, 0x1e, 0x2f,
+	0x61, 0x70, 0x69, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x5f, 0x64, 0x68, 0x62, 0x2f, 0x70, 0x72,
+	0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x5f, 0x72, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x3a, 0x09, 0x73,
+	0x65, 0x6e, 0x64, 0x5f, 0x62, 0x6f, 0x64, 0x79, 0x12, 0x8e, 0x01, 0x0a, 0x17, 0x41, 0x64, 0x6d,
+	0x69, 0x6e, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77,
+	0x4c, 0x69, 0x73, 0x74, 0x12, 0x23, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e,
+	0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x4c, 0x69,
+	0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x41, 0x64, 0x6d, 0x69, 0x6e, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x52, 0x65, 0x76,
+	0x69, 0x65, 0x77, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x2b, 0x82, 0xd3,
+	0xe4, 0x93, 0x02, 0x25, 0x12, 0x23, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e,
+	0x5f, 0x64, 0x68, 0x62, 0x2f, 0x77, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x5f, 0x72, 0x65,
+	0x76, 0x69, 0x65, 0x77, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x12, 0xa5, 0x01, 0x0a, 0x1a, 0x41, 0x64,
+	0x6d, 0x69, 0x6e, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x52, 0x65, 0x76, 0x69, 0x65,
+	0x77, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x12, 0x26, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41,
+	0x64, 0x6d, 0x69, 0x6e, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x52, 0x65, 0x76, 0x69,
+	0x65, 0x77, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x24, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x57, 0x69, 0x74, 0x68,
+	0x64, 0x72, 0x61, 0x77, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76,
+	0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x39, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x33, 0x22, 0x26,
+	0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x5f, 0x64, 0x68, 0x62, 0x2f, 0x77,
+	0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x5f, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x5f, 0x61,
+	0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x3a, 0x09, 0x73, 0x65, 0x6e, 0x64, 0x5f, 0x62, 0x6f, 0x64,
+	0x79, 0x12, 0xa1, 0x01, 0x0a, 0x19, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x57, 0x69, 0x74, 0x68, 0x64,
+	0x72, 0x61, 0x77, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x52, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x12,
+	0x25, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x57, 0x69, 0x74, 0x68, 0x64,
+	0x72, 0x61, 0x77, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x52, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d,
+	0x69, 0x6e, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77,
+	0x52, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x38, 0x82, 0xd3, 0xe4,
+	0x93, 0x02, 0x32, 0x22, 0x25, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x5f,
+	0x64, 0x68, 0x62, 0x2f, 0x77, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x5f, 0x72, 0x65, 0x76,
+	0x69, 0x65, 0x77, 0x5f, 0x72, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x3a, 0x09, 0x73, 0x65, 0x6e, 0x64,
+	0x5f, 0x62, 0x6f, 0x64, 0x79, 0x12, 0x88, 0x01, 0x0a, 0x13, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x57,
+	0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x52, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x12, 0x1f, 0x2e,
+	0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61,
+	0x77, 0x52, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d,
+	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72,
+	0x61, 0x77, 0x52, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x31, 0x82,
+	0xd3, 0xe4, 0x93, 0x02, 0x2b, 0x22, 0x1e, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x64, 0x6d, 0x69,
+	0x6e, 0x5f, 0x64, 0x68, 0x62, 0x2f, 0x77, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x5f, 0x72,
+	0x65, 0x6a, 0x65, 0x63, 0x74, 0x3a, 0x09, 0x73, 0x65, 0x6e, 0x64, 0x5f, 0x62, 0x6f, 0x64, 0x79,
+	0x12, 0x8a, 0x01, 0x0a, 0x16, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72,
+	0x61, 0x77, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x22, 0x2e, 0x61, 0x70,
+	0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x45,
+	0x76, 0x65, 0x6e, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x20, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x57, 0x69, 0x74, 0x68, 0x64,
+	0x72, 0x61, 0x77, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6c,
+	0x79, 0x22, 0x2a, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x24, 0x12, 0x22, 0x2f, 0x61, 0x70, 0x69, 0x2f,
+	0x61, 0x64, 0x6d, 0x69, 0x6e, 0x5f, 0x64, 0x68, 0x62, 0x2f, 0x77, 0x69, 0x74, 0x68, 0x64, 0x72,
+	0x61, 0x77, 0x5f, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x12, 0x75, 0x0a,
+	0x11, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x4c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x56, 0x65, 0x72, 0x69,
+	0x66, 0x79, 0x12, 0x1d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x4c, 0x65,
+	0x64, 0x67, 0x65, 0x72, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x1b, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x4c, 0x65, 0x64,
+	0x67, 0x65, 0x72, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x24,
+	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1e, 0x12, 0x1c, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x64, 0x6d,
+	0x69, 0x6e, 0x5f, 0x64, 0x68, 0x62, 0x2f, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x5f, 0x76, 0x65,
+	0x72, 0x69, 0x66, 0x79, 0x12, 0x78, 0x0a, 0x0f, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x4c, 0x65, 0x64,
+	0x67, 0x65, 0x72, 0x4f, 0x70, 0x65, 0x6e, 0x12, 0x1b, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64,
+	0x6d, 0x69, 0x6e, 0x4c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x4f, 0x70, 0x65, 0x6e, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e,
+	0x4c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x4f, 0x70, 0x65, 0x6e, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22,
+	0x2d, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x27, 0x22, 0x1a, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x64,
+	0x6d, 0x69, 0x6e, 0x5f, 0x64, 0x68, 0x62, 0x2f, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x5f, 0x6f,
+	0x70, 0x65, 0x6e, 0x3a, 0x09, 0x73, 0x65, 0x6e, 0x64, 0x5f, 0x62, 0x6f, 0x64, 0x79, 0x12, 0x68,
+	0x0a, 0x0e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x52, 0x65, 0x63, 0x6f, 0x6e, 0x63, 0x69, 0x6c, 0x65,
+	0x12, 0x1a, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x52, 0x65, 0x63, 0x6f,
+	0x6e, 0x63, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x52, 0x65, 0x63, 0x6f, 0x6e, 0x63, 0x69, 0x6c,
+	0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x20, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1a, 0x12, 0x18,
+	0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x5f, 0x64, 0x68, 0x62, 0x2f, 0x72,
+	0x65, 0x63, 0x6f, 0x6e, 0x63, 0x69, 0x6c, 0x65, 0x12, 0x81, 0x01, 0x0a, 0x14, 0x41, 0x64, 0x6d,
+	0x69, 0x6e, 0x44, 0x69, 0x73, 0x63, 0x72, 0x65, 0x70, 0x61, 0x6e, 0x63, 0x79, 0x4c, 0x69, 0x73,
+	0x74, 0x12, 0x20, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x44, 0x69, 0x73,
+	0x63, 0x72, 0x65, 0x70, 0x61, 0x6e, 0x63, 0x79, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x44,
+	0x69, 0x73, 0x63, 0x72, 0x65, 0x70, 0x61, 0x6e, 0x63, 0x79, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65,
+	0x70, 0x6c, 0x79, 0x22, 0x27, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x21, 0x12, 0x1f, 0x2f, 0x61, 0x70,
+	0x69, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x5f, 0x64, 0x68, 0x62, 0x2f, 0x64, 0x69, 0x73, 0x63,
+	0x72, 0x65, 0x70, 0x61, 0x6e, 0x63, 0x79, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x12, 0x7d, 0x0a, 0x13,
+	0x41, 0x64, 0x6d, 0x69, 0x6e, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x52, 0x65, 0x63, 0x6f, 0x6e, 0x63,
+	0x69, 0x6c, 0x65, 0x12, 0x1f, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x43,
+	0x68, 0x61, 0x69, 0x6e, 0x52, 0x65, 0x63, 0x6f, 0x6e, 0x63, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e,
+	0x43, 0x68, 0x61, 0x69, 0x6e, 0x52, 0x65, 0x63, 0x6f, 0x6e, 0x63, 0x69, 0x6c, 0x65, 0x52, 0x65,
+	0x70, 0x6c, 0x79, 0x22, 0x26, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x20, 0x12, 0x1e, 0x2f, 0x61, 0x70,
+	0x69, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x5f, 0x64, 0x68, 0x62, 0x2f, 0x63, 0x68, 0x61, 0x69,
+	0x6e, 0x5f, 0x72, 0x65, 0x63, 0x6f, 0x6e, 0x63, 0x69, 0x6c, 0x65, 0x12, 0x81, 0x01, 0x0a, 0x14,
+	0x41, 0x64, 0x6d, 0x69, 0x6e, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x53, 0x6e, 0x61, 0x70,
+	0x73, 0x68, 0x6f, 0x74, 0x12, 0x20, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e,
+	0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d,
+	0x69, 0x6e, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f,
+	0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x27, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x21, 0x12, 0x1f,
+	0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x5f, 0x64, 0x68, 0x62, 0x2f, 0x62,
+	0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x12,
+	0x8e, 0x01, 0x0a, 0x17, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x55, 0x73, 0x65, 0x72, 0x42, 0x61, 0x6c,
+	0x61, 0x6e, 0x63, 0x65, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x23, 0x2e, 0x61, 0x70,
+	0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x55, 0x73, 0x65, 0x72, 0x42, 0x61, 0x6c, 0x61, 0x6e,
+	0x63, 0x65, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x21, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x55, 0x73, 0x65, 0x72,
+	0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65,
+	0x70, 0x6c, 0x79, 0x22, 0x2b, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x25, 0x12, 0x23, 0x2f, 0x61, 0x70,
+	0x69, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x5f, 0x64, 0x68, 0x62, 0x2f, 0x75, 0x73, 0x65, 0x72,
+	0x5f, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79,
+	0x12, 0x79, 0x0a, 0x12, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x4c, 0x69, 0x61, 0x62, 0x69, 0x6c, 0x69,
+	0x74, 0x79, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x1e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d,
+	0x69, 0x6e, 0x4c, 0x69, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x4c, 0x69, 0x73, 0x74, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d,
+	0x69, 0x6e, 0x4c, 0x69, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x4c, 0x69, 0x73, 0x74, 0x52,
+	0x65, 0x70, 0x6c, 0x79, 0x22, 0x25, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1f, 0x12, 0x1d, 0x2f, 0x61,
+	0x70, 0x69, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x5f, 0x64, 0x68, 0x62, 0x2f, 0x6c, 0x69, 0x61,
+	0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x12, 0x79, 0x0a, 0x12, 0x41,
+	0x64, 0x6d, 0x69, 0x6e, 0x55, 0x73, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x65, 0x6d, 0x65, 0x6e,
+	0x74, 0x12, 0x1e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x55, 0x73, 0x65,
+	0x72, 0x53, 0x74, 0x61, 0x74, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x1c, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x55, 0x73, 0x65,
+	0x72, 0x53, 0x74, 0x61, 0x74, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22,
+	0x25, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1f, 0x12, 0x1d, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x64,
+	0x6d, 0x69, 0x6e, 0x5f, 0x64, 0x68, 0x62, 0x2f, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x73, 0x74, 0x61,
+	0x74, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x64, 0x0a, 0x0d, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x53,
+	0x6f, 0x6c, 0x76, 0x65, 0x6e, 0x63, 0x79, 0x12, 0x19, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64,
+	0x6d, 0x69, 0x6e, 0x53, 0x6f, 0x6c, 0x76, 0x65, 0x6e, 0x63, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x17, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x53, 0x6f,
+	0x6c, 0x76, 0x65, 0x6e, 0x63, 0x79, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x1f, 0x82, 0xd3, 0xe4,
+	0x93, 0x02, 0x19, 0x12, 0x17, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x5f,
+	0x64, 0x68, 0x62, 0x2f, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x6e, 0x63, 0x79, 0x12, 0x79, 0x0a, 0x12,
+	0x41, 0x64, 0x6d, 0x69, 0x6e, 0x53, 0x6f, 0x6c, 0x76, 0x65, 0x6e, 0x63, 0x79, 0x43, 0x68, 0x65,
+	0x63, 0x6b, 0x12, 0x1e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x53, 0x6f,
+	0x6c, 0x76, 0x65, 0x6e, 0x63, 0x79, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x53, 0x6f,
+	0x6c, 0x76, 0x65, 0x6e, 0x63, 0x79, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x52, 0x65, 0x70, 0x6c, 0x79,
+	0x22, 0x25, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1f, 0x12, 0x1d, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61,
+	0x64, 0x6d, 0x69, 0x6e, 0x5f, 0x64, 0x68, 0x62, 0x2f, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x6e, 0x63,
+	0x79, 0x5f, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x12, 0x75, 0x0a, 0x11, 0x41, 0x64, 0x6d, 0x69, 0x6e,
+	0x53, 0x6f, 0x6c, 0x76, 0x65, 0x6e, 0x63, 0x79, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x1d, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x53, 0x6f, 0x6c, 0x76, 0x65, 0x6e, 0x63, 0x79,
+	0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x61, 0x70,
+	0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x53, 0x6f, 0x6c, 0x76, 0x65, 0x6e, 0x63, 0x79, 0x4c,
+	0x69, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x24, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1e,
+	0x12, 0x1c, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x5f, 0x64, 0x68, 0x62,
+	0x2f, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x6e, 0x63, 0x79, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x12, 0x81,
+	0x01, 0x0a, 0x14, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x53,
+	0x69, 0x6d, 0x75, 0x6c, 0x61, 0x74, 0x65, 0x12, 0x20, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64,
+	0x6d, 0x69, 0x6e, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x53, 0x69, 0x6d, 0x75, 0x6c, 0x61,
+	0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x41, 0x64, 0x6d, 0x69, 0x6e, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x53, 0x69, 0x6d, 0x75,
+	0x6c, 0x61, 0x74, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x27, 0x82, 0xd3, 0xe4, 0x93, 0x02,
+	0x21, 0x12, 0x1f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x5f, 0x64, 0x68,
+	0x62, 0x2f, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x5f, 0x73, 0x69, 0x6d, 0x75, 0x6c, 0x61,
+	0x74, 0x65, 0x12, 0x67, 0x0a, 0x0c, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x44, 0x65, 0x74, 0x61,
+	0x69, 0x6c, 0x12, 0x18, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x44,
+	0x65, 0x74, 0x61, 0x69, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x52,
+	0x65, 0x70, 0x6c, 0x79, 0x22, 0x25, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1f, 0x12, 0x1d, 0x2f, 0x61,
+	0x70, 0x69, 0x2f, 0x61, 0x70, 0x70, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2f, 0x72, 0x65,
+	0x77, 0x61, 0x72, 0x64, 0x5f, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x12, 0x75, 0x0a, 0x11, 0x41,
+	0x64, 0x6d, 0x69, 0x6e, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c,
+	0x12, 0x1d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x52, 0x65, 0x77, 0x61,
+	0x72, 0x64, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x1b, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x52, 0x65, 0x77, 0x61, 0x72,
+	0x64, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x24, 0x82, 0xd3,
+	0xe4, 0x93, 0x02, 0x1e, 0x12, 0x1c, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e,
+	0x5f, 0x64, 0x68, 0x62, 0x2f, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x5f, 0x64, 0x65, 0x74, 0x61,
+	0x69, 0x6c, 0x12, 0x69, 0x0a, 0x0e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x56, 0x69, 0x70, 0x52, 0x65,
+	0x63, 0x61, 0x6c, 0x63, 0x12, 0x1a, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e,
+	0x56, 0x69, 0x70, 0x52, 0x65, 0x63, 0x61, 0x6c, 0x63, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x18, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x56, 0x69, 0x70, 0x52,
+	0x65, 0x63, 0x61, 0x6c, 0x63, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x21, 0x82, 0xd3, 0xe4, 0x93,
+	0x02, 0x1b, 0x12, 0x19, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x5f, 0x64,
+	0x68, 0x62, 0x2f, 0x76, 0x69, 0x70, 0x5f, 0x72, 0x65, 0x63, 0x61, 0x6c, 0x63, 0x12, 0x65, 0x0a,
+	0x0d, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x4c, 0x6f, 0x63, 0x6b, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x19,
+	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x4c, 0x6f, 0x63, 0x6b, 0x4c, 0x69,
+	0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x41, 0x64, 0x6d, 0x69, 0x6e, 0x4c, 0x6f, 0x63, 0x6b, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x70,
+	0x6c, 0x79, 0x22, 0x20, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1a, 0x12, 0x18, 0x2f, 0x61, 0x70, 0x69,
+	0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x5f, 0x64, 0x68, 0x62, 0x2f, 0x6c, 0x6f, 0x63, 0x6b, 0x5f,
+	0x6c, 0x69, 0x73, 0x74, 0x12, 0x61, 0x0a, 0x08, 0x52, 0x65, 0x69, 0x6e, 0x76, 0x65, 0x73, 0x74,
+	0x12, 0x14, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x52, 0x65, 0x69, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x12, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x52, 0x65, 0x69,
+	0x6e, 0x76, 0x65, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x2b, 0x82, 0xd3, 0xe4, 0x93,
+	0x02, 0x25, 0x22, 0x18, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x70, 0x70, 0x5f, 0x73, 0x65, 0x72,
+	0x76, 0x65, 0x72, 0x2f, 0x72, 0x65, 0x69, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x3a, 0x09, 0x73, 0x65,
+	0x6e, 0x64, 0x5f, 0x62, 0x6f, 0x64, 0x79, 0x12, 0x77, 0x0a, 0x10, 0x4c, 0x6f, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x12, 0x1c, 0x2e, 0x61, 0x70,
+	0x69, 0x2e, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65,
+	0x73, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73,
+	0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x29, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x23, 0x12, 0x21, 0x2f,
+	0x61, 0x70, 0x69, 0x2f, 0x61, 0x70, 0x70, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2f, 0x6c,
+	0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x70, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73,
+	0x42, 0x11, 0x0a, 0x03, 0x61, 0x70, 0x69, 0x50, 0x01, 0x5a, 0x08, 0x2f, 0x61, 0x70, 0x69, 0x3b,
+	0x61, 0x70, 0x69, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_app_app_api_app_proto_rawDescOnce sync.Once
+	file_app_app_api_app_proto_rawDescData = file_app_app_api_app_proto_rawDesc
+)
+
+func file_app_app_api_app_proto_rawDescGZIP() []byte {
+	file_app_app_api_app_proto_rawDescOnce.Do(func() {
+		file_app_app_api_app_proto_rawDescData = protoimpl.X.CompressGZIP(file_app_app_api_app_proto_rawDescData)
+	})
+	return file_app_app_api_app_proto_rawDescData
+}
+
+var file_app_app_api_app_proto_msgTypes = make([]protoimpl.MessageInfo, 209)
+var file_app_app_api_app_proto_goTypes = []interface{}{
+	(*EthAuthorizeRequest)(nil),                         // 0: api.EthAuthorizeRequest
+	(*EthAuthorizeReply)(nil),                           // 1: api.EthAuthorizeReply
+	(*DepositRequest)(nil),                              // 2: api.DepositRequest
+	(*DepositReply)(nil),                                // 3: api.DepositReply
+	(*UserInfoRequest)(nil),                             // 4: api.UserInfoRequest
+	(*UserInfoReply)(nil),                               // 5: api.UserInfoReply
+	(*RewardListRequest)(nil),                           // 6: api.RewardListRequest
+	(*RewardListReply)(nil),                             // 7: api.RewardListReply
+	(*RecommendRewardListRequest)(nil),                  // 8: api.RecommendRewardListRequest
+	(*RecommendRewardListReply)(nil),                    // 9: api.RecommendRewardListReply
+	(*FeeRewardListRequest)(nil),                        // 10: api.FeeRewardListRequest
+	(*FeeRewardListReply)(nil),                          // 11: api.FeeRewardListReply
+	(*WithdrawListRequest)(nil),                         // 12: api.WithdrawListRequest
+	(*WithdrawListReply)(nil),                           // 13: api.WithdrawListReply
+	(*RecommendListRequest)(nil),                        // 14: api.RecommendListRequest
+	(*RecommendListReply)(nil),                          // 15: api.RecommendListReply
+	(*WithdrawRequest)(nil),                             // 16: api.WithdrawRequest
+	(*WithdrawReply)(nil),                               // 17: api.WithdrawReply
+	(*WithdrawCancelRequest)(nil),                       // 18: api.WithdrawCancelRequest
+	(*WithdrawCancelReply)(nil),                         // 19: api.WithdrawCancelReply
+	(*AdminRewardListRequest)(nil),                      // 20: api.AdminRewardListRequest
+	(*AdminRewardListReply)(nil),                        // 21: api.AdminRewardListReply
+	(*AdminRewardBnbListRequest)(nil),                   // 22: api.AdminRewardBnbListRequest
+	(*AdminRewardBnbListReply)(nil),                     // 23: api.AdminRewardBnbListReply
+	(*CheckAdminUserAreaRequest)(nil),                   // 24: api.CheckAdminUserAreaRequest
+	(*CheckAdminUserAreaReply)(nil),                     // 25: api.CheckAdminUserAreaReply
+	(*CheckAndInsertLocationsRecommendUserRequest)(nil), // 26: api.CheckAndInsertLocationsRecommendUserRequest
+	(*CheckAndInsertLocationsRecommendUserReply)(nil),   // 27: api.CheckAndInsertLocationsRecommendUserReply
+	(*UploadRecommendUserRequest)(nil),                  // 28: api.UploadRecommendUserRequest
+	(*UploadRecommendUserReply)(nil),                    // 29: api.UploadRecommendUserReply
+	(*AdminUserListRequest)(nil),                        // 30: api.AdminUserListRequest
+	(*AdminUserListReply)(nil),                          // 31: api.AdminUserListReply
+	(*AdminLocationListRequest)(nil),                    // 32: api.AdminLocationListRequest
+	(*AdminLocationListReply)(nil),                      // 33: api.AdminLocationListReply
+	(*AdminLocationAllListRequest)(nil),                 // 34: api.AdminLocationAllListRequest
+	(*AdminLocationAllListReply)(nil),                   // 35: api.AdminLocationAllListReply
+	(*AdminWithdrawListRequest)(nil),                    // 36: api.AdminWithdrawListRequest
+	(*AdminWithdrawListReply)(nil),                      // 37: api.AdminWithdrawListReply
+	(*AdminWithdrawRequest)(nil),                        // 38: api.AdminWithdrawRequest
+	(*AdminWithdrawReply)(nil),                          // 39: api.AdminWithdrawReply
+	(*AdminWithdrawDoingToRewardedRequest)(nil),         // 40: api.AdminWithdrawDoingToRewardedRequest
+	(*AdminWithdrawDoingToRewardedReply)(nil),           // 41: api.AdminWithdrawDoingToRewardedReply
+	(*AdminWithdrawEthRequest)(nil),                     // 42: api.AdminWithdrawEthRequest
+	(*AdminWithdrawEthReply)(nil),                       // 43: api.AdminWithdrawEthReply
+	(*AdminFeeRequest)(nil),                             // 44: api.AdminFeeRequest
+	(*AdminFeeReply)(nil),                               // 45: api.AdminFeeReply
+	(*AdminDailyFeeRequest)(nil),                        // 46: api.AdminDailyFeeRequest
+	(*AdminDailyFeeReply)(nil),                          // 47: api.AdminDailyFeeReply
+	(*AdminAllRequest)(nil),                             // 48: api.AdminAllRequest
+	(*AdminAllReply)(nil),                               // 49: api.AdminAllReply
+	(*AdminUserRecommendRequest)(nil),                   // 50: api.AdminUserRecommendRequest
+	(*AdminUserRecommendReply)(nil),                     // 51: api.AdminUserRecommendReply
+	(*AdminMonthRecommendRequest)(nil),                  // 52: api.AdminMonthRecommendRequest
+	(*AdminMonthRecommendReply)(nil),                    // 53: api.AdminMonthRecommendReply
+	(*AdminConfigRequest)(nil),                          // 54: api.AdminConfigRequest
+	(*AdminConfigReply)(nil),                            // 55: api.AdminConfigReply
+	(*AdminListRequest)(nil),                            // 56: api.AdminListRequest
+	(*AdminListReply)(nil),                              // 57: api.AdminListReply
+	(*AuthListRequest)(nil),                             // 58: api.AuthListRequest
+	(*AuthListReply)(nil),                               // 59: api.AuthListReply
+	(*UserAuthListRequest)(nil),                         // 60: api.UserAuthListRequest
+	(*UserAuthListReply)(nil),                           // 61: api.UserAuthListReply
+	(*MyAuthListRequest)(nil),                           // 62: api.MyAuthListRequest
+	(*MyAuthListReply)(nil),                             // 63: api.MyAuthListReply
+	(*AdminConfigUpdateRequest)(nil),                    // 64: api.AdminConfigUpdateRequest
+	(*AdminConfigUpdateReply)(nil),                      // 65: api.AdminConfigUpdateReply
+	(*AdminVipUpdateRequest)(nil),                       // 66: api.AdminVipUpdateRequest
+	(*AdminVipUpdateReply)(nil),                         // 67: api.AdminVipUpdateReply
+	(*AdminUndoUpdateRequest)(nil),                      // 68: api.AdminUndoUpdateRequest
+	(*AdminUndoUpdateReply)(nil),                        // 69: api.AdminUndoUpdateReply
+	(*AdminAreaLevelUpdateRequest)(nil),                 // 70: api.AdminAreaLevelUpdateRequest
+	(*AdminAreaLevelUpdateReply)(nil),                   // 71: api.AdminAreaLevelUpdateReply
+	(*AdminLocationInsertRequest)(nil),                  // 72: api.AdminLocationInsertRequest
+	(*AdminLocationInsertReply)(nil),                    // 73: api.AdminLocationInsertReply
+	(*AdminBalanceUpdateRequest)(nil),                   // 74: api.AdminBalanceUpdateRequest
+	(*AdminBalanceUpdateReply)(nil),                     // 75: api.AdminBalanceUpdateReply
+	(*AuthAdminCreateRequest)(nil),                      // 76: api.AuthAdminCreateRequest
+	(*AuthAdminCreateReply)(nil),                        // 77: api.AuthAdminCreateReply
+	(*AuthAdminDeleteRequest)(nil),                      // 78: api.AuthAdminDeleteRequest
+	(*AuthAdminDeleteReply)(nil),                        // 79: api.AuthAdminDeleteReply
+	(*CheckAndInsertRecommendAreaRequest)(nil),          // 80: api.CheckAndInsertRecommendAreaRequest
+	(*CheckAndInsertRecommendAreaReply)(nil),            // 81: api.CheckAndInsertRecommendAreaReply
+	(*AdminDailyRecommendRewardRequest)(nil),            // 82: api.AdminDailyRecommendRewardRequest
+	(*AdminDailyRecommendRewardReply)(nil),              // 83: api.AdminDailyRecommendRewardReply
+	(*AdminDailyRecommendTopRewardRequest)(nil),         // 84: api.AdminDailyRecommendTopRewardRequest
+	(*AdminDailyRecommendTopRewardReply)(nil),           // 85: api.AdminDailyRecommendTopRewardReply
+	(*AdminDailyWithdrawRewardRequest)(nil),             // 86: api.AdminDailyWithdrawRewardRequest
+	(*AdminDailyWithdrawRewardReply)(nil),               // 87: api.AdminDailyWithdrawRewardReply
+	(*FixRewardRequest)(nil),                            // 88: api.FixRewardRequest
+	(*FixRewardReply)(nil),                              // 89: api.FixRewardReply
+	(*FixLocationsRequest)(nil),                         // 90: api.FixLocationsRequest
+	(*FixLocationsReply)(nil),                           // 91: api.FixLocationsReply
+	(*AdminLoginRequest)(nil),                           // 92: api.AdminLoginRequest
+	(*AdminLoginReply)(nil),                             // 93: api.AdminLoginReply
+	(*AdminChangePasswordRequest)(nil),                  // 94: api.AdminChangePasswordRequest
+	(*AdminChangePasswordReply)(nil),                    // 95: api.AdminChangePasswordReply
+	(*AdminCreateAccountRequest)(nil),                   // 96: api.AdminCreateAccountRequest
+	(*AdminCreateAccountReply)(nil),                     // 97: api.AdminCreateAccountReply
+	(*AdminAuditLogListRequest)(nil),                    // 98: api.AdminAuditLogListRequest
+	(*AdminAuditLogListReply)(nil),                      // 99: api.AdminAuditLogListReply
+	(*AdminProposalListRequest)(nil),                    // 100: api.AdminProposalListRequest
+	(*AdminProposalListReply)(nil),                      // 101: api.AdminProposalListReply
+	(*AdminProposalApproveRequest)(nil),                 // 102: api.AdminProposalApproveRequest
+	(*AdminProposalApproveReply)(nil),                   // 103: api.AdminProposalApproveReply
+	(*AdminProposalRejectRequest)(nil),                  // 104: api.AdminProposalRejectRequest
+	(*AdminProposalRejectReply)(nil),                    // 105: api.AdminProposalRejectReply
+	(*AdminWithdrawReviewListRequest)(nil),              // 106: api.AdminWithdrawReviewListRequest
+	(*AdminWithdrawReviewListReply)(nil),                // 107: api.AdminWithdrawReviewListReply
+	(*AdminWithdrawReviewApproveRequest)(nil),           // 108: api.AdminWithdrawReviewApproveRequest
+	(*AdminWithdrawReviewApproveReply)(nil),             // 109: api.AdminWithdrawReviewApproveReply
+	(*AdminWithdrawReviewRejectRequest)(nil),            // 110: api.AdminWithdrawReviewRejectRequest
+	(*AdminWithdrawReviewRejectReply)(nil),              // 111: api.AdminWithdrawReviewRejectReply
+	(*AdminWithdrawRejectRequest)(nil),                  // 112: api.AdminWithdrawRejectRequest
+	(*AdminWithdrawRejectReply)(nil),                    // 113: api.AdminWithdrawRejectReply
+	(*AdminWithdrawEventListRequest)(nil),               // 114: api.AdminWithdrawEventListRequest
+	(*AdminWithdrawEventListReply)(nil),                 // 115: api.AdminWithdrawEventListReply
+	(*AdminLedgerVerifyRequest)(nil),                    // 116: api.AdminLedgerVerifyRequest
+	(*AdminLedgerVerifyReply)(nil),                      // 117: api.AdminLedgerVerifyReply
+	(*AdminLedgerOpenRequest)(nil),                      // 118: api.AdminLedgerOpenRequest
+	(*AdminLedgerOpenReply)(nil),                        // 119: api.AdminLedgerOpenReply
+	(*AdminReconcileRequest)(nil),                       // 120: api.AdminReconcileRequest
+	(*AdminReconcileReply)(nil),                         // 121: api.AdminReconcileReply
+	(*AdminDiscrepancyListRequest)(nil),                 // 122: api.AdminDiscrepancyListRequest
+	(*AdminDiscrepancyListReply)(nil),                   // 123: api.AdminDiscrepancyListReply
+	(*AdminChainReconcileRequest)(nil),                  // 124: api.AdminChainReconcileRequest
+	(*AdminChainReconcileReply)(nil),                    // 125: api.AdminChainReconcileReply
+	(*AdminBalanceSnapshotRequest)(nil),                 // 126: api.AdminBalanceSnapshotRequest
+	(*AdminBalanceSnapshotReply)(nil),                   // 127: api.AdminBalanceSnapshotReply
+	(*AdminUserBalanceHistoryRequest)(nil),              // 128: api.AdminUserBalanceHistoryRequest
+	(*AdminUserBalanceHistoryReply)(nil),                // 129: api.AdminUserBalanceHistoryReply
+	(*AdminLiabilityListRequest)(nil),                   // 130: api.AdminLiabilityListRequest
+	(*AdminLiabilityListReply)(nil),                     // 131: api.AdminLiabilityListReply
+	(*AdminUserStatementRequest)(nil),                   // 132: api.AdminUserStatementRequest
+	(*AdminUserStatementReply)(nil),                     // 133: api.AdminUserStatementReply
+	(*AdminSolvencyRequest)(nil),                        // 134: api.AdminSolvencyRequest
+	(*AdminSolvencyReply)(nil),                          // 135: api.AdminSolvencyReply
+	(*AdminSolvencyCheckRequest)(nil),                   // 136: api.AdminSolvencyCheckRequest
+	(*AdminSolvencyCheckReply)(nil),                     // 137: api.AdminSolvencyCheckReply
+	(*AdminSolvencyListRequest)(nil),                    // 138: api.AdminSolvencyListRequest
+	(*AdminSolvencyListReply)(nil),                      // 139: api.AdminSolvencyListReply
+	(*AdminDepositSimulateRequest)(nil),                 // 140: api.AdminDepositSimulateRequest
+	(*AdminDepositSimulateReply)(nil),                   // 141: api.AdminDepositSimulateReply
+	(*RewardDetailRequest)(nil),                         // 142: api.RewardDetailRequest
+	(*RewardDetailReply)(nil),                           // 143: api.RewardDetailReply
+	(*AdminRewardDetailRequest)(nil),                    // 144: api.AdminRewardDetailRequest
+	(*AdminRewardDetailReply)(nil),                      // 145: api.AdminRewardDetailReply
+	(*AdminVipRecalcRequest)(nil),                       // 146: api.AdminVipRecalcRequest
+	(*AdminVipRecalcReply)(nil),                         // 147: api.AdminVipRecalcReply
+	(*AdminLockListRequest)(nil),                        // 148: api.AdminLockListRequest
+	(*AdminLockListReply)(nil),                          // 149: api.AdminLockListReply
+	(*ReinvestRequest)(nil),                             // 150: api.ReinvestRequest
+	(*ReinvestReply)(nil),                               // 151: api.ReinvestReply
+	(*LocationProgressRequest)(nil),                     // 152: api.LocationProgressRequest
+	(*LocationProgressReply)(nil),                       // 153: api.LocationProgressReply
+	(*EthAuthorizeRequest_SendBody)(nil),                // 154: api.EthAuthorizeRequest.SendBody
+	(*RewardListReply_List)(nil),                        // 155: api.RewardListReply.List
+	(*RecommendRewardListReply_List)(nil),               // 156: api.RecommendRewardListReply.List
+	(*FeeRewardListReply_List)(nil),                     // 157: api.FeeRewardListReply.List
+	(*WithdrawListReply_List)(nil),                      // 158: api.WithdrawListReply.List
+	(*RecommendListReply_List)(nil),                     // 159: api.RecommendListReply.List
+	(*WithdrawRequest_SendBody)(nil),                    // 160: api.WithdrawRequest.SendBody
+	(*WithdrawCancelRequest_SendBody)(nil),              // 161: api.WithdrawCancelRequest.SendBody
+	(*AdminRewardListReply_List)(nil),                   // 162: api.AdminRewardListReply.List
+	(*AdminRewardBnbListReply_List)(nil),                // 163: api.AdminRewardBnbListReply.List
+	(*AdminUserListReply_UserList)(nil),                 // 164: api.AdminUserListReply.UserList
+	(*AdminLocationListReply_LocationList)(nil),         // 165: api.AdminLocationListReply.LocationList
+	(*AdminLocationAllListReply_LocationList)(nil),      // 166: api.AdminLocationAllListReply.LocationList
+	(*AdminWithdrawListReply_List)(nil),                 // 167: api.AdminWithdrawListReply.List
+	(*AdminUserRecommendReply_List)(nil),                // 168: api.AdminUserRecommendReply.List
+	(*AdminMonthRecommendReply_List)(nil),               // 169: api.AdminMonthRecommendReply.List
+	(*AdminConfigReply_List)(nil),                       // 170: api.AdminConfigReply.List
+	(*AdminListReply_List)(nil),                         // 171: api.AdminListReply.List
+	(*AuthListReply_List)(nil),                          // 172: api.AuthListReply.List
+	(*UserAuthListReply_List)(nil),                      // 173: api.UserAuthListReply.List
+	(*MyAuthListReply_List)(nil),                        // 174: api.MyAuthListReply.List
+	(*AdminConfigUpdateRequest_SendBody)(nil),           // 175: api.AdminConfigUpdateRequest.SendBody
+	(*AdminVipUpdateRequest_SendBody)(nil),              // 176: api.AdminVipUpdateRequest.SendBody
+	(*AdminUndoUpdateRequest_SendBody)(nil),             // 177: api.AdminUndoUpdateRequest.SendBody
+	(*AdminAreaLevelUpdateRequest_SendBody)(nil),        // 178: api.AdminAreaLevelUpdateRequest.SendBody
+	(*AdminLocationInsertRequest_SendBody)(nil),         // 179: api.AdminLocationInsertRequest.SendBody
+	(*AdminBalanceUpdateRequest_SendBody)(nil),          // 180: api.AdminBalanceUpdateRequest.SendBody
+	(*AuthAdminCreateRequest_SendBody)(nil),             // 181: api.AuthAdminCreateRequest.SendBody
+	(*AuthAdminDeleteRequest_SendBody)(nil),             // 182: api.AuthAdminDeleteRequest.SendBody
+	(*AdminLoginRequest_SendBody)(nil),                  // 183: api.AdminLoginRequest.SendBody
+	(*AdminChangePasswordRequest_SendBody)(nil),         // 184: api.AdminChangePasswordRequest.SendBody
+	(*AdminCreateAccountRequest_SendBody)(nil),          // 185: api.AdminCreateAccountRequest.SendBody
+	(*AdminAuditLogListReply_List)(nil),                 // 186: api.AdminAuditLogListReply.List
+	(*AdminProposalListReply_List)(nil),                 // 187: api.AdminProposalListReply.List
+	(*AdminProposalApproveRequest_SendBody)(nil),        // 188: api.AdminProposalApproveRequest.SendBody
+	(*AdminProposalRejectRequest_SendBody)(nil),         // 189: api.AdminProposalRejectRequest.SendBody
+	(*AdminWithdrawReviewListReply_List)(nil),           // 190: api.AdminWithdrawReviewListReply.List
+	(*AdminWithdrawReviewApproveRequest_SendBody)(nil),  // 191: api.AdminWithdrawReviewApproveRequest.SendBody
+	(*AdminWithdrawReviewRejectRequest_SendBody)(nil),   // 192: api.AdminWithdrawReviewRejectRequest.SendBody
+	(*AdminWithdrawRejectRequest_SendBody)(nil),         // 193: api.AdminWithdrawRejectRequest.SendBody
+	(*AdminWithdrawEventListReply_List)(nil),            // 194: api.AdminWithdrawEventListReply.List
+	(*AdminLedgerVerifyReply_List)(nil),                 // 195: api.AdminLedgerVerifyReply.List
+	(*AdminLedgerOpenRequest_SendBody)(nil),             // 196: api.AdminLedgerOpenRequest.SendBody
+	(*AdminDiscrepancyListReply_List)(nil),              // 197: api.AdminDiscrepancyListReply.List
+	(*AdminChainReconcileReply_List)(nil),               // 198: api.AdminChainReconcileReply.List
+	(*AdminLiabilityListReply_List)(nil),                // 199: api.AdminLiabilityListReply.List
+	(*AdminUserStatementReply_List)(nil),                // 200: api.AdminUserStatementReply.List
+	(*AdminSolvencyListReply_List)(nil),                 // 201: api.AdminSolvencyListReply.List
+	nil,                                                 // 202: api.AdminDepositSimulateRequest.ConfigsEntry
+	(*AdminDepositSimulateReply_List)(nil),              // 203: api.AdminDepositSimulateReply.List
+	(*RewardDetailReply_Config)(nil),                    // 204: api.RewardDetailReply.Config
+	(*AdminRewardDetailReply_Config)(nil),               // 205: api.AdminRewardDetailReply.Config
+	(*AdminLockListReply_List)(nil),                     // 206: api.AdminLockListReply.List
+	(*ReinvestRequest_SendBody)(nil),                    // 207: api.ReinvestRequest.SendBody
+	(*LocationProgressReply_List)(nil),                  // 208: api.LocationProgressReply.List
+}
+var file_app_app_api_app_proto_depIdxs = []int32{
+	154, // 0: api.EthAuthorizeRequest.send_body:type_name -> api.EthAuthorizeRequest.SendBody
+	155, // 1: api.RewardListReply.rewards:type_name -> api.RewardListReply.List
+	156, // 2: api.RecommendRewardListReply.rewards:type_name -> api.RecommendRewardListReply.List
+	157, // 3: api.FeeRewardListReply.rewards:type_name -> api.FeeRewardListReply.List
+	158, // 4: api.WithdrawListReply.withdraw:type_name -> api.WithdrawListReply.List
+	159, // 5: api.RecommendListReply.recommends:type_name -> api.RecommendListReply.List
+	160, // 6: api.WithdrawRequest.send_body:type_name -> api.WithdrawRequest.SendBody
+	161, // 7: api.WithdrawCancelRequest.send_body:type_name -> api.WithdrawCancelRequest.SendBody
+	162, // 8: api.AdminRewardListReply.rewards:type_name -> api.AdminRewardListReply.List
+	163, // 9: api.AdminRewardBnbListReply.rewards:type_name -> api.AdminRewardBnbListReply.List
+	164, // 10: api.AdminUserListReply.users:type_name -> api.AdminUserListReply.UserList
+	165, // 11: api.AdminLocationListReply.locations:type_name -> api.AdminLocationListReply.LocationList
+	166, // 12: api.AdminLocationAllListReply.locations:type_name -> api.AdminLocationAllListReply.LocationList
+	167, // 13: api.AdminWithdrawListReply.withdraw:type_name -> api.AdminWithdrawListReply.List
+	168, // 14: api.AdminUserRecommendReply.users:type_name -> api.AdminUserRecommendReply.List
+	169, // 15: api.AdminMonthRecommendReply.users:type_name -> api.AdminMonthRecommendReply.List
+	170, // 16: api.AdminConfigReply.config:type_name -> api.AdminConfigReply.List
+	171, // 17: api.AdminListReply.account:type_name -> api.AdminListReply.List
+	172, // 18: api.AuthListReply.auth:type_name -> api.AuthListReply.List
+	173, // 19: api.UserAuthListReply.auth:type_name -> api.UserAuthListReply.List
+	174, // 20: api.MyAuthListReply.auth:type_name -> api.MyAuthListReply.List
+	175, // 21: api.AdminConfigUpdateRequest.send_body:type_name -> api.AdminConfigUpdateRequest.SendBody
+	176, // 22: api.AdminVipUpdateRequest.send_body:type_name -> api.AdminVipUpdateRequest.SendBody
+	177, // 23: api.AdminUndoUpdateRequest.send_body:type_name -> api.AdminUndoUpdateRequest.SendBody
+	178, // 24: api.AdminAreaLevelUpdateRequest.send_body:type_name -> api.AdminAreaLevelUpdateRequest.SendBody
+	179, // 25: api.AdminLocationInsertRequest.send_body:type_name -> api.AdminLocationInsertRequest.SendBody
+	180, // 26: api.AdminBalanceUpdateRequest.send_body:type_name -> api.AdminBalanceUpdateRequest.SendBody
+	181, // 27: api.AuthAdminCreateRequest.send_body:type_name -> api.AuthAdminCreateRequest.SendBody
+	182, // 28: api.AuthAdminDeleteRequest.send_body:type_name -> api.AuthAdminDeleteRequest.SendBody
+	183, // 29: api.AdminLoginRequest.send_body:type_name -> api.AdminLoginRequest.SendBody
+	184, // 30: api.AdminChangePasswordRequest.send_body:type_name -> api.AdminChangePasswordRequest.SendBody
+	185, // 31: api.AdminCreateAccountRequest.send_body:type_name -> api.AdminCreateAccountRequest.SendBody
+	186, // 32: api.AdminAuditLogListReply.logs:type_name -> api.AdminAuditLogListReply.List
+	187, // 33: api.AdminProposalListReply.proposals:type_name -> api.AdminProposalListReply.List
+	188, // 34: api.AdminProposalApproveRequest.send_body:type_name -> api.AdminProposalApproveRequest.SendBody
+	189, // 35: api.AdminProposalRejectRequest.send_body:type_name -> api.AdminProposalRejectRequest.SendBody
+	190, // 36: api.AdminWithdrawReviewListReply.reviews:type_name -> api.AdminWithdrawReviewListReply.List
+	191, // 37: api.AdminWithdrawReviewApproveRequest.send_body:type_name -> api.AdminWithdrawReviewApproveRequest.SendBody
+	192, // 38: api.AdminWithdrawReviewRejectRequest.send_body:type_name -> api.AdminWithdrawReviewRejectRequest.SendBody
+	193, // 39: api.AdminWithdrawRejectRequest.send_body:type_name -> api.AdminWithdrawRejectRequest.SendBody
+	194, // 40: api.AdminWithdrawEventListReply.events:type_name -> api.AdminWithdrawEventListReply.List
+	195, // 41: api.AdminLedgerVerifyReply.mismatches:type_name -> api.AdminLedgerVerifyReply.List
+	196, // 42: api.AdminLedgerOpenRequest.send_body:type_name -> api.AdminLedgerOpenRequest.SendBody
+	197, // 43: api.AdminDiscrepancyListReply.discrepancies:type_name -> api.AdminDiscrepancyListReply.List
+	198, // 44: api.AdminChainReconcileReply.items:type_name -> api.AdminChainReconcileReply.List
+	199, // 45: api.AdminLiabilityListReply.liabilities:type_name -> api.AdminLiabilityListReply.List
+	200, // 46: api.AdminUserStatementReply.lines:type_name -> api.AdminUserStatementReply.List
+	201, // 47: api.AdminSolvencyReply.solvency:type_name -> api.AdminSolvencyListReply.List
+	201, // 48: api.AdminSolvencyListReply.solvencies:type_name -> api.AdminSolvencyListReply.List
+	202, // 49: api.AdminDepositSimulateRequest.configs:type_name -> api.AdminDepositSimulateRequest.ConfigsEntry
+	203, // 50: api.AdminDepositSimulateReply.rewards:type_name -> api.AdminDepositSimulateReply.List
+	204, // 51: api.RewardDetailReply.configs:type_name -> api.RewardDetailReply.Config
+	205, // 52: api.AdminRewardDetailReply.configs:type_name -> api.AdminRewardDetailReply.Config
+	206, // 53: api.AdminLockListReply.locks:type_name -> api.AdminLockListReply.List
+	207, // 54: api.ReinvestRequest.send_body:type_name -> api.ReinvestRequest.SendBody
+	208, // 55: api.LocationProgressReply.locations:type_name -> api.LocationProgressReply.List
+	4,   // 56: api.App.UserInfo:input_type -> api.UserInfoRequest
+	6,   // 57: api.App.RewardList:input_type -> api.RewardListRequest
+	8,   // 58: api.App.RecommendRewardList:input_type -> api.RecommendRewardListRequest
+	10,  // 59: api.App.FeeRewardList:input_type -> api.FeeRewardListRequest
+	12,  // 60: api.App.WithdrawList:input_type -> api.WithdrawListRequest
+	14,  // 61: api.App.RecommendList:input_type -> api.RecommendListRequest
+	16,  // 62: api.App.Withdraw:input_type -> api.WithdrawRequest
+	18,  // 63: api.App.WithdrawCancel:input_type -> api.WithdrawCancelRequest
+	2,   // 64: api.App.Deposit:input_type -> api.DepositRequest
+	20,  // 65: api.App.AdminRewardList:input_type -> api.AdminRewardListRequest
+	22,  // 66: api.App.AdminRewardBnbList:input_type -> api.AdminRewardBnbListRequest
+	30,  // 67: api.App.AdminUserList:input_type -> api.AdminUserListRequest
+	24,  // 68: api.App.CheckAdminUserArea:input_type -> api.CheckAdminUserAreaRequest
+	26,  // 69: api.App.CheckAndInsertLocationsRecommendUser:input_type -> api.CheckAndInsertLocationsRecommendUserRequest
+	28,  // 70: api.App.UploadRecommendUser:input_type -> api.UploadRecommendUserRequest
+	32,  // 71: api.App.AdminLocationList:input_type -> api.AdminLocationListRequest
+	34,  // 72: api.App.AdminLocationAllList:input_type -> api.AdminLocationAllListRequest
+	36,  // 73: api.App.AdminWithdrawList:input_type -> api.AdminWithdrawListRequest
+	38,  // 74: api.App.AdminWithdraw:input_type -> api.AdminWithdrawRequest
+	40,  // 75: api.App.AdminWithdrawDoingToRewarded:input_type -> api.AdminWithdrawDoingToRewardedRequest
+	42,  // 76: api.App.AdminWithdrawEth:input_type -> api.AdminWithdrawEthRequest
+	44,  // 77: api.App.AdminFee:input_type -> api.AdminFeeRequest
+	46,  // 78: api.App.AdminDailyFee:input_type -> api.AdminDailyFeeRequest
+	48,  // 79: api.App.AdminAll:input_type -> api.AdminAllRequest
+	50,  // 80: api.App.AdminUserRecommend:input_type -> api.AdminUserRecommendRequest
+	52,  // 81: api.App.AdminMonthRecommend:input_type -> api.AdminMonthRecommendRequest
+	54,  // 82: api.App.AdminConfig:input_type -> api.AdminConfigRequest
+	64,  // 83: api.App.AdminConfigUpdate:input_type -> api.AdminConfigUpdateRequest
+	66,  // 84: api.App.AdminVipUpdate:input_type -> api.AdminVipUpdateRequest
+	68,  // 85: api.App.AdminUndoUpdate:input_type -> api.AdminUndoUpdateRequest
+	70,  // 86: api.App.AdminAreaLevelUpdate:input_type -> api.AdminAreaLevelUpdateRequest
+	72,  // 87: api.App.AdminLocationInsert:input_type -> api.AdminLocationInsertRequest
+	74,  // 88: api.App.AdminBalanceUpdate:input_type -> api.AdminBalanceUpdateRequest
+	92,  // 89: api.App.AdminLogin:input_type -> api.AdminLoginRequest
+	96,  // 90: api.App.AdminCreateAccount:input_type -> api.AdminCreateAccountRequest
+	94,  // 91: api.App.AdminChangePassword:input_type -> api.AdminChangePasswordRequest
+	56,  // 92: api.App.AdminList:input_type -> api.AdminListRequest
+	58,  // 93: api.App.AuthList:input_type -> api.AuthListRequest
+	62,  // 94: api.App.MyAuthList:input_type -> api.MyAuthListRequest
+	60,  // 95: api.App.UserAuthList:input_type -> api.UserAuthListRequest
+	76,  // 96: api.App.AuthAdminCreate:input_type -> api.AuthAdminCreateRequest
+	78,  // 97: api.App.AuthAdminDelete:input_type -> api.AuthAdminDeleteRequest
+	80,  // 98: api.App.CheckAndInsertRecommendArea:input_type -> api.CheckAndInsertRecommendAreaRequest
+	82,  // 99: api.App.AdminDailyRecommendReward:input_type -> api.AdminDailyRecommendRewardRequest
+	84,  // 100: api.App.AdminDailyRecommendTopReward:input_type -> api.AdminDailyRecommendTopRewardRequest
+	86,  // 101: api.App.AdminDailyWithdrawReward:input_type -> api.AdminDailyWithdrawRewardRequest
+	88,  // 102: api.App.FixReward:input_type -> api.FixRewardRequest
+	90,  // 103: api.App.FixLocations:input_type -> api.FixLocationsRequest
+	98,  // 104: api.App.AdminAuditLogList:input_type -> api.AdminAuditLogListRequest
+	100, // 105: api.App.AdminProposalList:input_type -> api.AdminProposalListRequest
+	102, // 106: api.App.AdminProposalApprove:input_type -> api.AdminProposalApproveRequest
+	104, // 107: api.App.AdminProposalReject:input_type -> api.AdminProposalRejectRequest
+	106, // 108: api.App.AdminWithdrawReviewList:input_type -> api.AdminWithdrawReviewListRequest
+	108, // 109: api.App.AdminWithdrawReviewApprove:input_type -> api.AdminWithdrawReviewApproveRequest
+	110, // 110: api.App.AdminWithdrawReviewReject:input_type -> api.AdminWithdrawReviewRejectRequest
+	112, // 111: api.App.AdminWithdrawReject:input_type -> api.AdminWithdrawRejectRequest
+	114, // 112: api.App.AdminWithdrawEventList:input_type -> api.AdminWithdrawEventListRequest
+	116, // 113: api.App.AdminLedgerVerify:input_type -> api.AdminLedgerVerifyRequest
+	118, // 114: api.App.AdminLedgerOpen:input_type -> api.AdminLedgerOpenRequest
+	120, // 115: api.App.AdminReconcile:input_type -> api.AdminReconcileRequest
+	122, // 116: api.App.AdminDiscrepancyList:input_type -> api.AdminDiscrepancyListRequest
+	124, // 117: api.App.AdminChainReconcile:input_type -> api.AdminChainReconcileRequest
+	126, // 118: api.App.AdminBalanceSnapshot:input_type -> api.AdminBalanceSnapshotRequest
+	128, // 119: api.App.AdminUserBalanceHistory:input_type -> api.AdminUserBalanceHistoryRequest
+	130, // 120: api.App.AdminLiabilityList:input_type -> api.AdminLiabilityListRequest
+	132, // 121: api.App.AdminUserStatement:input_type -> api.AdminUserStatementRequest
+	134, // 122: api.App.AdminSolvency:input_type -> api.AdminSolvencyRequest
+	136, // 123: api.App.AdminSolvencyCheck:input_type -> api.AdminSolvencyCheckRequest
+	138, // 124: api.App.AdminSolvencyList:input_type -> api.AdminSolvencyListRequest
+	140, // 125: api.App.AdminDepositSimulate:input_type -> api.AdminDepositSimulateRequest
+	142, // 126: api.App.RewardDetail:input_type -> api.RewardDetailRequest
+	144, // 127: api.App.AdminRewardDetail:input_type -> api.AdminRewardDetailRequest
+	146, // 128: api.App.AdminVipRecalc:input_type -> api.AdminVipRecalcRequest
+	148, // 129: api.App.AdminLockList:input_type -> api.AdminLockListRequest
+	150, // 130: api.App.Reinvest:input_type -> api.ReinvestRequest
+	152, // 131: api.App.LocationProgress:input_type -> api.LocationProgressRequest
+	5,   // 132: api.App.UserInfo:output_type -> api.UserInfoReply
+	7,   // 133: api.App.RewardList:output_type -> api.RewardListReply
+	9,   // 134: api.App.RecommendRewardList:output_type -> api.RecommendRewardListReply
+	11,  // 135: api.App.FeeRewardList:output_type -> api.FeeRewardListReply
+	13,  // 136: api.App.WithdrawList:output_type -> api.WithdrawListReply
+	15,  // 137: api.App.RecommendList:output_type -> api.RecommendListReply
+	17,  // 138: api.App.Withdraw:output_type -> api.WithdrawReply
+	19,  // 139: api.App.WithdrawCancel:output_type -> api.WithdrawCancelReply
+	3,   // 140: api.App.Deposit:output_type -> api.DepositReply
+	21,  // 141: api.App.AdminRewardList:output_type -> api.AdminRewardListReply
+	23,  // 142: api.App.AdminRewardBnbList:output_type -> api.AdminRewardBnbListReply
+	31,  // 143: api.App.AdminUserList:output_type -> api.AdminUserListReply
+	25,  // 144: api.App.CheckAdminUserArea:output_type -> api.CheckAdminUserAreaReply
+	27,  // 145: api.App.CheckAndInsertLocationsRecommendUser:output_type -> api.CheckAndInsertLocationsRecommendUserReply
+	29,  // 146: api.App.UploadRecommendUser:output_type -> api.UploadRecommendUserReply
+	33,  // 147: api.App.AdminLocationList:output_type -> api.AdminLocationListReply
+	35,  // 148: api.App.AdminLocationAllList:output_type -> api.AdminLocationAllListReply
+	37,  // 149: api.App.AdminWithdrawList:output_type -> api.AdminWithdrawListReply
+	39,  // 150: api.App.AdminWithdraw:output_type -> api.AdminWithdrawReply
+	41,  // 151: api.App.AdminWithdrawDoingToRewarded:output_type -> api.AdminWithdrawDoingToRewardedReply
+	43,  // 152: api.App.AdminWithdrawEth:output_type -> api.AdminWithdrawEthReply
+	45,  // 153: api.App.AdminFee:output_type -> api.AdminFeeReply
+	47,  // 154: api.App.AdminDailyFee:output_type -> api.AdminDailyFeeReply
+	49,  // 155: api.App.AdminAll:output_type -> api.AdminAllReply
+	51,  // 156: api.App.AdminUserRecommend:output_type -> api.AdminUserRecommendReply
+	53,  // 157: api.App.AdminMonthRecommend:output_type -> api.AdminMonthRecommendReply
+	55,  // 158: api.App.AdminConfig:output_type -> api.AdminConfigReply
+	65,  // 159: api.App.AdminConfigUpdate:output_type -> api.AdminConfigUpdateReply
+	67,  // 160: api.App.AdminVipUpdate:output_type -> api.AdminVipUpdateReply
+	69,  // 161: api.App.AdminUndoUpdate:output_type -> api.AdminUndoUpdateReply
+	71,  // 162: api.App.AdminAreaLevelUpdate:output_type -> api.AdminAreaLevelUpdateReply
+	73,  // 163: api.App.AdminLocationInsert:output_type -> api.AdminLocationInsertReply
+	75,  // 164: api.App.AdminBalanceUpdate:output_type -> api.AdminBalanceUpdateReply
+	93,  // 165: api.App.AdminLogin:output_type -> api.AdminLoginReply
+	97,  // 166: api.App.AdminCreateAccount:output_type -> api.AdminCreateAccountReply
+	95,  // 167: api.App.AdminChangePassword:output_type -> api.AdminChangePasswordReply
+	57,  // 168: api.App.AdminList:output_type -> api.AdminListReply
+	59,  // 169: api.App.AuthList:output_type -> api.AuthListReply
+	63,  // 170: api.App.MyAuthList:output_type -> api.MyAuthListReply
+	61,  // 171: api.App.UserAuthList:output_type -> api.UserAuthListReply
+	77,  // 172: api.App.AuthAdminCreate:output_type -> api.AuthAdminCreateReply
+	79,  // 173: api.App.AuthAdminDelete:output_type -> api.AuthAdminDeleteReply
+	81,  // 174: api.App.CheckAndInsertRecommendArea:output_type -> api.CheckAndInsertRecommendAreaReply
+	83,  // 175: api.App.AdminDailyRecommendReward:output_type -> api.AdminDailyRecommendRewardReply
+	85,  // 176: api.App.AdminDailyRecommendTopReward:output_type -> api.AdminDailyRecommendTopRewardReply
+	87,  // 177: api.App.AdminDailyWithdrawReward:output_type -> api.AdminDailyWithdrawRewardReply
+	89,  // 178: api.App.FixReward:output_type -> api.FixRewardReply
+	91,  // 179: api.App.FixLocations:output_type -> api.FixLocationsReply
+	99,  // 180: api.App.AdminAuditLogList:output_type -> api.AdminAuditLogListReply
+	101, // 181: api.App.AdminProposalList:output_type -> api.AdminProposalListReply
+	103, // 182: api.App.AdminProposalApprove:output_type -> api.AdminProposalApproveReply
+	105, // 183: api.App.AdminProposalReject:output_type -> api.AdminProposalRejectReply
+	107, // 184: api.App.AdminWithdrawReviewList:output_type -> api.AdminWithdrawReviewListReply
+	109, // 185: api.App.AdminWithdrawReviewApprove:output_type -> api.AdminWithdrawReviewApproveReply
+	111, // 186: api.App.AdminWithdrawReviewReject:output_type -> api.AdminWithdrawReviewRejectReply
+	113, // 187: api.App.AdminWithdrawReject:output_type -> api.AdminWithdrawRejectReply
+	115, // 188: api.App.AdminWithdrawEventList:output_type -> api.AdminWithdrawEventListReply
+	117, // 189: api.App.AdminLedgerVerify:output_type -> api.AdminLedgerVerifyReply
+	119, // 190: api.App.AdminLedgerOpen:output_type -> api.AdminLedgerOpenReply
+	121, // 191: api.App.AdminReconcile:output_type -> api.AdminReconcileReply
+	123, // 192: api.App.AdminDiscrepancyList:output_type -> api.AdminDiscrepancyListReply
+	125, // 193: api.App.AdminChainReconcile:output_type -> api.AdminChainReconcileReply
+	127, // 194: api.App.AdminBalanceSnapshot:output_type -> api.AdminBalanceSnapshotReply
+	129, // 195: api.App.AdminUserBalanceHistory:output_type -> api.AdminUserBalanceHistoryReply
+	131, // 196: api.App.AdminLiabilityList:output_type -> api.AdminLiabilityListReply
+	133, // 197: api.App.AdminUserStatement:output_type -> api.AdminUserStatementReply
+	135, // 198: api.App.AdminSolvency:output_type -> api.AdminSolvencyReply
+	137, // 199: api.App.AdminSolvencyCheck:output_type -> api.AdminSolvencyCheckReply
+	139, // 200: api.App.AdminSolvencyList:output_type -> api.AdminSolvencyListReply
+	141, // 201: api.App.AdminDepositSimulate:output_type -> api.AdminDepositSimulateReply
+	143, // 202: api.App.RewardDetail:output_type -> api.RewardDetailReply
+	145, // 203: api.App.AdminRewardDetail:output_type -> api.AdminRewardDetailReply
+	147, // 204: api.App.AdminVipRecalc:output_type -> api.AdminVipRecalcReply
+	149, // 205: api.App.AdminLockList:output_type -> api.AdminLockListReply
+	151, // 206: api.App.Reinvest:output_type -> api.ReinvestReply
+	153, // 207: api.App.LocationProgress:output_type -> api.LocationProgressReply
+	132, // [132:208] is the sub-list for method output_type
+	56,  // [56:132] is the sub-list for method input_type
+	56,  // [56:56] is the sub-list for extension type_name
+	56,  // [56:56] is the sub-list for extension extendee
+	0,   // [0:56] is the sub-list for field type_name
+}
+
+func init() { file_app_app_api_app_proto_init() }
+func file_app_app_api_app_proto_init() {
+	if File_app_app_api_app_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_app_app_api_app_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EthAuthorizeRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_app_app_api_app_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EthAuthorizeReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_app_app_api_app_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DepositRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_app_app_api_app_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DepositReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_app_app_api_app_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UserInfoRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_app_app_api_app_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UserInfoReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_app_app_api_app_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RewardListRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_app_app_api_app_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RewardListReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_app_app_api_app_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RecommendRewardListRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_app_app_api_app_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RecommendRewardListReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_app_app_api_app_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FeeRewardListRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_app_app_api_app_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FeeRewardListReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_app_app_api_app_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WithdrawListRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_app_app_api_app_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WithdrawListReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_app_app_api_app_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RecommendListRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_app_app_api_app_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RecommendListReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_app_app_api_app_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WithdrawRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_app_app_api_app_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WithdrawReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_app_app_api_app_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WithdrawCancelRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_app_app_api_app_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WithdrawCancelReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_app_app_api_app_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminRewardListRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_app_app_api_app_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminRewardListReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_app_app_api_app_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminRewardBnbListRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_app_app_api_app_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminRewardBnbListReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_app_app_api_app_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CheckAdminUserAreaRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_app_app_api_app_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CheckAdminUserAreaReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_app_app_api_app_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CheckAndInsertLocationsRecommendUserRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_app_app_api_app_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CheckAndInsertLocationsRecommendUserReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_app_app_api_app_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UploadRecommendUserRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_app_app_api_app_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UploadRecommendUserReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_app_app_api_app_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminUserListRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_app_app_api_app_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminUserListReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_app_app_api_app_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminLocationListRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_app_app_api_app_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminLocationListReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_app_app_api_app_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminLocationAllListRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_app_app_api_app_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminLocationAllListReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_app_app_api_app_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminWithdrawListRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_app_app_api_app_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminWithdrawListReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_app_app_api_app_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminWithdrawRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_app_app_api_app_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminWithdrawReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_app_app_api_app_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminWithdrawDoingToRewardedRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_app_app_api_app_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminWithdrawDoingToRewardedReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_app_app_api_app_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminWithdrawEthRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_app_app_api_app_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminWithdrawEthReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_app_app_api_app_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminFeeRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_app_app_api_app_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminFeeReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_app_app_api_app_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminDailyFeeRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_app_app_api_app_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminDailyFeeReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_app_app_api_app_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminAllRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_app_app_api_app_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminAllReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_app_app_api_app_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminUserRecommendRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_app_app_api_app_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminUserRecommendReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_app_app_api_app_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminMonthRecommendRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_app_app_api_app_proto_msgTypes[53].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminMonthRecommendReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_app_app_api_app_proto_msgTypes[54].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminConfigRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_app_app_api_app_proto_msgTypes[55].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminConfigReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_app_app_api_app_proto_msgTypes[56].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminListRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_app_app_api_app_proto_msgTypes[57].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminListReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_app_app_api_app_proto_msgTypes[58].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AuthListRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_app_app_api_app_proto_msgTypes[59].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AuthListReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_app_app_api_app_proto_msgTypes[60].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UserAuthListRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_app_app_api_app_proto_msgTypes[61].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UserAuthListReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_app_app_api_app_proto_msgTypes[62].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MyAuthListRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_app_app_api_app_proto_msgTypes[63].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MyAuthListReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_app_app_api_app_proto_msgTypes[64].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminConfigUpdateRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_app_app_api_app_proto_msgTypes[65].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminConfigUpdateReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_app_app_api_app_proto_msgTypes[66].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminVipUpdateRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_app_app_api_app_proto_msgTypes[67].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminVipUpdateReply); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7998,8 +15200,8 @@ func file_app_app_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_app_app_api_app_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*EthAuthorizeReply); i {
+		file_app_app_api_app_proto_msgTypes[68].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminUndoUpdateRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8010,8 +15212,8 @@ func file_app_app_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_app_app_api_app_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DepositRequest); i {
+		file_app_app_api_app_proto_msgTypes[69].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminUndoUpdateReply); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8022,8 +15224,8 @@ func file_app_app_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_app_app_api_app_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DepositReply); i {
+		file_app_app_api_app_proto_msgTypes[70].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminAreaLevelUpdateRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8034,8 +15236,8 @@ func file_app_app_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_app_app_api_app_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UserInfoRequest); i {
+		file_app_app_api_app_proto_msgTypes[71].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminAreaLevelUpdateReply); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8046,8 +15248,8 @@ func file_app_app_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_app_app_api_app_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UserInfoReply); i {
+		file_app_app_api_app_proto_msgTypes[72].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminLocationInsertRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8058,8 +15260,8 @@ func file_app_app_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_app_app_api_app_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RewardListRequest); i {
+		file_app_app_api_app_proto_msgTypes[73].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminLocationInsertReply); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8070,8 +15272,8 @@ func file_app_app_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_app_app_api_app_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RewardListReply); i {
+		file_app_app_api_app_proto_msgTypes[74].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminBalanceUpdateRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8082,8 +15284,8 @@ func file_app_app_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_app_app_api_app_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RecommendRewardListRequest); i {
+		file_app_app_api_app_proto_msgTypes[75].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminBalanceUpdateReply); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8094,8 +15296,8 @@ func file_app_app_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_app_app_api_app_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RecommendRewardListReply); i {
+		file_app_app_api_app_proto_msgTypes[76].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AuthAdminCreateRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8106,8 +15308,8 @@ func file_app_app_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_app_app_api_app_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*FeeRewardListRequest); i {
+		file_app_app_api_app_proto_msgTypes[77].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AuthAdminCreateReply); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8118,8 +15320,8 @@ func file_app_app_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_app_app_api_app_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*FeeRewardListReply); i {
+		file_app_app_api_app_proto_msgTypes[78].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AuthAdminDeleteRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8130,8 +15332,8 @@ func file_app_app_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_app_app_api_app_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*WithdrawListRequest); i {
+		file_app_app_api_app_proto_msgTypes[79].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AuthAdminDeleteReply); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8142,8 +15344,8 @@ func file_app_app_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_app_app_api_app_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*WithdrawListReply); i {
+		file_app_app_api_app_proto_msgTypes[80].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CheckAndInsertRecommendAreaRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8154,8 +15356,8 @@ func file_app_app_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_app_app_api_app_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RecommendListRequest); i {
+		file_app_app_api_app_proto_msgTypes[81].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CheckAndInsertRecommendAreaReply); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8166,8 +15368,8 @@ func file_app_app_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_app_app_api_app_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RecommendListReply); i {
+		file_app_app_api_app_proto_msgTypes[82].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminDailyRecommendRewardRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8178,8 +15380,8 @@ func file_app_app_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_app_app_api_app_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*WithdrawRequest); i {
+		file_app_app_api_app_proto_msgTypes[83].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminDailyRecommendRewardReply); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8190,8 +15392,8 @@ func file_app_app_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_app_app_api_app_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*WithdrawReply); i {
+		file_app_app_api_app_proto_msgTypes[84].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminDailyRecommendTopRewardRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8202,8 +15404,8 @@ func file_app_app_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_app_app_api_app_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AdminRewardListRequest); i {
+		file_app_app_api_app_proto_msgTypes[85].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminDailyRecommendTopRewardReply); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8214,8 +15416,8 @@ func file_app_app_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_app_app_api_app_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AdminRewardListReply); i {
+		file_app_app_api_app_proto_msgTypes[86].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminDailyWithdrawRewardRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8226,8 +15428,8 @@ func file_app_app_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_app_app_api_app_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AdminRewardBnbListRequest); i {
+		file_app_app_api_app_proto_msgTypes[87].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminDailyWithdrawRewardReply); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8238,8 +15440,8 @@ func file_app_app_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_app_app_api_app_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AdminRewardBnbListReply); i {
+		file_app_app_api_app_proto_msgTypes[88].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FixRewardRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8250,8 +15452,8 @@ func file_app_app_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_app_app_api_app_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CheckAdminUserAreaRequest); i {
+		file_app_app_api_app_proto_msgTypes[89].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FixRewardReply); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8262,8 +15464,8 @@ func file_app_app_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_app_app_api_app_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CheckAdminUserAreaReply); i {
+		file_app_app_api_app_proto_msgTypes[90].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FixLocationsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8274,8 +15476,176 @@ func file_app_app_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_app_app_api_app_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CheckAndInsertLocationsRecommendUserRequest); i {
+		file_app_app_api_app_proto_msgTypes[91].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FixLocationsReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_app_app_api_app_proto_msgTypes[92].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminLoginRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_app_app_api_app_proto_msgTypes[93].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminLoginReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_app_app_api_app_proto_msgTypes[94].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminChangePasswordRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_app_app_api_app_proto_msgTypes[95].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminChangePasswordReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_app_app_api_app_proto_msgTypes[96].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminCreateAccountRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_app_app_api_app_proto_msgTypes[97].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminCreateAccountReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_app_app_api_app_proto_msgTypes[98].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminAuditLogListRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_app_app_api_app_proto_msgTypes[99].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminAuditLogListReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_app_app_api_app_proto_msgTypes[100].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminProposalListRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_app_app_api_app_proto_msgTypes[101].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminProposalListReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_app_app_api_app_proto_msgTypes[102].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminProposalApproveRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_app_app_api_app_proto_msgTypes[103].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminProposalApproveReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_app_app_api_app_proto_msgTypes[104].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminProposalRejectRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_app_app_api_app_proto_msgTypes[105].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminProposalRejectReply); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8286,8 +15656,8 @@ func file_app_app_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_app_app_api_app_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CheckAndInsertLocationsRecommendUserReply); i {
+		file_app_app_api_app_proto_msgTypes[106].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminWithdrawReviewListRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8298,8 +15668,8 @@ func file_app_app_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_app_app_api_app_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UploadRecommendUserRequest); i {
+		file_app_app_api_app_proto_msgTypes[107].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminWithdrawReviewListReply); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8310,8 +15680,8 @@ func file_app_app_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_app_app_api_app_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UploadRecommendUserReply); i {
+		file_app_app_api_app_proto_msgTypes[108].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminWithdrawReviewApproveRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8322,8 +15692,8 @@ func file_app_app_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_app_app_api_app_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AdminUserListRequest); i {
+		file_app_app_api_app_proto_msgTypes[109].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminWithdrawReviewApproveReply); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8334,8 +15704,8 @@ func file_app_app_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_app_app_api_app_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AdminUserListReply); i {
+		file_app_app_api_app_proto_msgTypes[110].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminWithdrawReviewRejectRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8346,8 +15716,8 @@ func file_app_app_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_app_app_api_app_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AdminLocationListRequest); i {
+		file_app_app_api_app_proto_msgTypes[111].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminWithdrawReviewRejectReply); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8358,8 +15728,8 @@ func file_app_app_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_app_app_api_app_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AdminLocationListReply); i {
+		file_app_app_api_app_proto_msgTypes[112].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminWithdrawRejectRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8370,8 +15740,8 @@ func file_app_app_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_app_app_api_app_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AdminLocationAllListRequest); i {
+		file_app_app_api_app_proto_msgTypes[113].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminWithdrawRejectReply); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8382,8 +15752,8 @@ func file_app_app_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_app_app_api_app_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AdminLocationAllListReply); i {
+		file_app_app_api_app_proto_msgTypes[114].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminWithdrawEventListRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8394,8 +15764,8 @@ func file_app_app_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_app_app_api_app_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AdminWithdrawListRequest); i {
+		file_app_app_api_app_proto_msgTypes[115].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminWithdrawEventListReply); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8406,8 +15776,8 @@ func file_app_app_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_app_app_api_app_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AdminWithdrawListReply); i {
+		file_app_app_api_app_proto_msgTypes[116].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminLedgerVerifyRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8418,8 +15788,8 @@ func file_app_app_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_app_app_api_app_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AdminWithdrawRequest); i {
+		file_app_app_api_app_proto_msgTypes[117].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminLedgerVerifyReply); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8430,8 +15800,8 @@ func file_app_app_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_app_app_api_app_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AdminWithdrawReply); i {
+		file_app_app_api_app_proto_msgTypes[118].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminLedgerOpenRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8442,8 +15812,8 @@ func file_app_app_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_app_app_api_app_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AdminWithdrawDoingToRewardedRequest); i {
+		file_app_app_api_app_proto_msgTypes[119].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminLedgerOpenReply); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8454,8 +15824,8 @@ func file_app_app_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_app_app_api_app_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AdminWithdrawDoingToRewardedReply); i {
+		file_app_app_api_app_proto_msgTypes[120].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminReconcileRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8466,8 +15836,8 @@ func file_app_app_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_app_app_api_app_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AdminWithdrawEthRequest); i {
+		file_app_app_api_app_proto_msgTypes[121].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminReconcileReply); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8478,8 +15848,8 @@ func file_app_app_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_app_app_api_app_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AdminWithdrawEthReply); i {
+		file_app_app_api_app_proto_msgTypes[122].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminDiscrepancyListRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8490,8 +15860,8 @@ func file_app_app_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_app_app_api_app_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AdminFeeRequest); i {
+		file_app_app_api_app_proto_msgTypes[123].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminDiscrepancyListReply); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8502,8 +15872,8 @@ func file_app_app_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_app_app_api_app_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AdminFeeReply); i {
+		file_app_app_api_app_proto_msgTypes[124].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminChainReconcileRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8514,8 +15884,8 @@ func file_app_app_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_app_app_api_app_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AdminDailyFeeRequest); i {
+		file_app_app_api_app_proto_msgTypes[125].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminChainReconcileReply); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8526,8 +15896,8 @@ func file_app_app_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_app_app_api_app_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AdminDailyFeeReply); i {
+		file_app_app_api_app_proto_msgTypes[126].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminBalanceSnapshotRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8538,8 +15908,8 @@ func file_app_app_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_app_app_api_app_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AdminAllRequest); i {
+		file_app_app_api_app_proto_msgTypes[127].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminBalanceSnapshotReply); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8550,8 +15920,8 @@ func file_app_app_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_app_app_api_app_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AdminAllReply); i {
+		file_app_app_api_app_proto_msgTypes[128].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminUserBalanceHistoryRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8562,8 +15932,8 @@ func file_app_app_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_app_app_api_app_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AdminUserRecommendRequest); i {
+		file_app_app_api_app_proto_msgTypes[129].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminUserBalanceHistoryReply); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8574,8 +15944,8 @@ func file_app_app_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_app_app_api_app_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AdminUserRecommendReply); i {
+		file_app_app_api_app_proto_msgTypes[130].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminLiabilityListRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8586,8 +15956,8 @@ func file_app_app_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_app_app_api_app_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AdminMonthRecommendRequest); i {
+		file_app_app_api_app_proto_msgTypes[131].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminLiabilityListReply); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8598,8 +15968,8 @@ func file_app_app_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_app_app_api_app_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AdminMonthRecommendReply); i {
+		file_app_app_api_app_proto_msgTypes[132].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminUserStatementRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8610,8 +15980,8 @@ func file_app_app_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_app_app_api_app_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AdminConfigRequest); i {
+		file_app_app_api_app_proto_msgTypes[133].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminUserStatementReply); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8622,8 +15992,8 @@ func file_app_app_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_app_app_api_app_proto_msgTypes[53].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AdminConfigReply); i {
+		file_app_app_api_app_proto_msgTypes[134].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminSolvencyRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8634,8 +16004,8 @@ func file_app_app_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_app_app_api_app_proto_msgTypes[54].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AdminListRequest); i {
+		file_app_app_api_app_proto_msgTypes[135].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminSolvencyReply); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8646,8 +16016,8 @@ func file_app_app_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_app_app_api_app_proto_msgTypes[55].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AdminListReply); i {
+		file_app_app_api_app_proto_msgTypes[136].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminSolvencyCheckRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8658,8 +16028,8 @@ func file_app_app_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_app_app_api_app_proto_msgTypes[56].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AuthListRequest); i {
+		file_app_app_api_app_proto_msgTypes[137].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminSolvencyCheckReply); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8670,8 +16040,8 @@ func file_app_app_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_app_app_api_app_proto_msgTypes[57].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AuthListReply); i {
+		file_app_app_api_app_proto_msgTypes[138].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminSolvencyListRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8682,8 +16052,8 @@ func file_app_app_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_app_app_api_app_proto_msgTypes[58].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UserAuthListRequest); i {
+		file_app_app_api_app_proto_msgTypes[139].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminSolvencyListReply); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8694,8 +16064,8 @@ func file_app_app_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_app_app_api_app_proto_msgTypes[59].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UserAuthListReply); i {
+		file_app_app_api_app_proto_msgTypes[140].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminDepositSimulateRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8706,8 +16076,8 @@ func file_app_app_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_app_app_api_app_proto_msgTypes[60].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MyAuthListRequest); i {
+		file_app_app_api_app_proto_msgTypes[141].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminDepositSimulateReply); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8718,8 +16088,8 @@ func file_app_app_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_app_app_api_app_proto_msgTypes[61].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MyAuthListReply); i {
+		file_app_app_api_app_proto_msgTypes[142].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RewardDetailRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8730,8 +16100,8 @@ func file_app_app_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_app_app_api_app_proto_msgTypes[62].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AdminConfigUpdateRequest); i {
+		file_app_app_api_app_proto_msgTypes[143].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RewardDetailReply); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8742,8 +16112,8 @@ func file_app_app_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_app_app_api_app_proto_msgTypes[63].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AdminConfigUpdateReply); i {
+		file_app_app_api_app_proto_msgTypes[144].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminRewardDetailRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8754,8 +16124,8 @@ func file_app_app_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_app_app_api_app_proto_msgTypes[64].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AdminVipUpdateRequest); i {
+		file_app_app_api_app_proto_msgTypes[145].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminRewardDetailReply); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8766,8 +16136,8 @@ func file_app_app_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_app_app_api_app_proto_msgTypes[65].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AdminVipUpdateReply); i {
+		file_app_app_api_app_proto_msgTypes[146].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminVipRecalcRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8778,8 +16148,8 @@ func file_app_app_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_app_app_api_app_proto_msgTypes[66].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AdminUndoUpdateRequest); i {
+		file_app_app_api_app_proto_msgTypes[147].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminVipRecalcReply); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8790,8 +16160,8 @@ func file_app_app_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_app_app_api_app_proto_msgTypes[67].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AdminUndoUpdateReply); i {
+		file_app_app_api_app_proto_msgTypes[148].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminLockListRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8802,8 +16172,8 @@ func file_app_app_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_app_app_api_app_proto_msgTypes[68].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AdminAreaLevelUpdateRequest); i {
+		file_app_app_api_app_proto_msgTypes[149].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminLockListReply); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8814,8 +16184,8 @@ func file_app_app_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_app_app_api_app_proto_msgTypes[69].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AdminAreaLevelUpdateReply); i {
+		file_app_app_api_app_proto_msgTypes[150].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReinvestRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8826,8 +16196,8 @@ func file_app_app_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_app_app_api_app_proto_msgTypes[70].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AdminLocationInsertRequest); i {
+		file_app_app_api_app_proto_msgTypes[151].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReinvestReply); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8838,8 +16208,8 @@ func file_app_app_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_app_app_api_app_proto_msgTypes[71].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AdminLocationInsertReply); i {
+		file_app_app_api_app_proto_msgTypes[152].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LocationProgressRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8850,8 +16220,8 @@ func file_app_app_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_app_app_api_app_proto_msgTypes[72].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AdminBalanceUpdateRequest); i {
+		file_app_app_api_app_proto_msgTypes[153].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LocationProgressReply); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8862,8 +16232,8 @@ func file_app_app_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_app_app_api_app_proto_msgTypes[73].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AdminBalanceUpdateReply); i {
+		file_app_app_api_app_proto_msgTypes[154].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EthAuthorizeRequest_SendBody); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8874,8 +16244,8 @@ func file_app_app_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_app_app_api_app_proto_msgTypes[74].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AuthAdminCreateRequest); i {
+		file_app_app_api_app_proto_msgTypes[155].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RewardListReply_List); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8886,8 +16256,8 @@ func file_app_app_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_app_app_api_app_proto_msgTypes[75].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AuthAdminCreateReply); i {
+		file_app_app_api_app_proto_msgTypes[156].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RecommendRewardListReply_List); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8898,8 +16268,8 @@ func file_app_app_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_app_app_api_app_proto_msgTypes[76].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AuthAdminDeleteRequest); i {
+		file_app_app_api_app_proto_msgTypes[157].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FeeRewardListReply_List); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8910,8 +16280,8 @@ func file_app_app_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_app_app_api_app_proto_msgTypes[77].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AuthAdminDeleteReply); i {
+		file_app_app_api_app_proto_msgTypes[158].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WithdrawListReply_List); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8922,8 +16292,8 @@ func file_app_app_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_app_app_api_app_proto_msgTypes[78].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CheckAndInsertRecommendAreaRequest); i {
+		file_app_app_api_app_proto_msgTypes[159].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RecommendListReply_List); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8934,8 +16304,8 @@ func file_app_app_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_app_app_api_app_proto_msgTypes[79].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CheckAndInsertRecommendAreaReply); i {
+		file_app_app_api_app_proto_msgTypes[160].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WithdrawRequest_SendBody); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8946,8 +16316,8 @@ func file_app_app_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_app_app_api_app_proto_msgTypes[80].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AdminDailyRecommendRewardRequest); i {
+		file_app_app_api_app_proto_msgTypes[161].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WithdrawCancelRequest_SendBody); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8958,8 +16328,8 @@ func file_app_app_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_app_app_api_app_proto_msgTypes[81].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AdminDailyRecommendRewardReply); i {
+		file_app_app_api_app_proto_msgTypes[162].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminRewardListReply_List); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8970,8 +16340,8 @@ func file_app_app_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_app_app_api_app_proto_msgTypes[82].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AdminDailyRecommendTopRewardRequest); i {
+		file_app_app_api_app_proto_msgTypes[163].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminRewardBnbListReply_List); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8982,8 +16352,8 @@ func file_app_app_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_app_app_api_app_proto_msgTypes[83].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AdminDailyRecommendTopRewardReply); i {
+		file_app_app_api_app_proto_msgTypes[164].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminUserListReply_UserList); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8994,8 +16364,8 @@ func file_app_app_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_app_app_api_app_proto_msgTypes[84].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AdminDailyWithdrawRewardRequest); i {
+		file_app_app_api_app_proto_msgTypes[165].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminLocationListReply_LocationList); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9006,8 +16376,8 @@ func file_app_app_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_app_app_api_app_proto_msgTypes[85].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AdminDailyWithdrawRewardReply); i {
+		file_app_app_api_app_proto_msgTypes[166].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminLocationAllListReply_LocationList); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9018,8 +16388,8 @@ func file_app_app_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_app_app_api_app_proto_msgTypes[86].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*FixRewardRequest); i {
+		file_app_app_api_app_proto_msgTypes[167].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminWithdrawListReply_List); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9030,8 +16400,8 @@ func file_app_app_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_app_app_api_app_proto_msgTypes[87].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*FixRewardReply); i {
+		file_app_app_api_app_proto_msgTypes[168].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminUserRecommendReply_List); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9042,8 +16412,8 @@ func file_app_app_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_app_app_api_app_proto_msgTypes[88].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*FixLocationsRequest); i {
+		file_app_app_api_app_proto_msgTypes[169].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminMonthRecommendReply_List); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9054,8 +16424,8 @@ func file_app_app_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_app_app_api_app_proto_msgTypes[89].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*FixLocationsReply); i {
+		file_app_app_api_app_proto_msgTypes[170].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminConfigReply_List); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9066,8 +16436,8 @@ func file_app_app_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_app_app_api_app_proto_msgTypes[90].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AdminLoginRequest); i {
+		file_app_app_api_app_proto_msgTypes[171].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminListReply_List); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9078,8 +16448,8 @@ func file_app_app_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_app_app_api_app_proto_msgTypes[91].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AdminLoginReply); i {
+		file_app_app_api_app_proto_msgTypes[172].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AuthListReply_List); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9090,8 +16460,8 @@ func file_app_app_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_app_app_api_app_proto_msgTypes[92].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AdminChangePasswordRequest); i {
+		file_app_app_api_app_proto_msgTypes[173].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UserAuthListReply_List); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9102,8 +16472,8 @@ func file_app_app_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_app_app_api_app_proto_msgTypes[93].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AdminChangePasswordReply); i {
+		file_app_app_api_app_proto_msgTypes[174].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MyAuthListReply_List); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9114,8 +16484,8 @@ func file_app_app_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_app_app_api_app_proto_msgTypes[94].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AdminCreateAccountRequest); i {
+		file_app_app_api_app_proto_msgTypes[175].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminConfigUpdateRequest_SendBody); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9126,8 +16496,8 @@ func file_app_app_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_app_app_api_app_proto_msgTypes[95].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AdminCreateAccountReply); i {
+		file_app_app_api_app_proto_msgTypes[176].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminVipUpdateRequest_SendBody); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9138,8 +16508,8 @@ func file_app_app_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_app_app_api_app_proto_msgTypes[96].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*EthAuthorizeRequest_SendBody); i {
+		file_app_app_api_app_proto_msgTypes[177].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminUndoUpdateRequest_SendBody); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9150,8 +16520,8 @@ func file_app_app_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_app_app_api_app_proto_msgTypes[97].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RewardListReply_List); i {
+		file_app_app_api_app_proto_msgTypes[178].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminAreaLevelUpdateRequest_SendBody); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9162,8 +16532,8 @@ func file_app_app_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_app_app_api_app_proto_msgTypes[98].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RecommendRewardListReply_List); i {
+		file_app_app_api_app_proto_msgTypes[179].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminLocationInsertRequest_SendBody); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9174,8 +16544,8 @@ func file_app_app_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_app_app_api_app_proto_msgTypes[99].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*FeeRewardListReply_List); i {
+		file_app_app_api_app_proto_msgTypes[180].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminBalanceUpdateRequest_SendBody); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9186,8 +16556,8 @@ func file_app_app_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_app_app_api_app_proto_msgTypes[100].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*WithdrawListReply_List); i {
+		file_app_app_api_app_proto_msgTypes[181].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AuthAdminCreateRequest_SendBody); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9198,8 +16568,8 @@ func file_app_app_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_app_app_api_app_proto_msgTypes[101].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RecommendListReply_List); i {
+		file_app_app_api_app_proto_msgTypes[182].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AuthAdminDeleteRequest_SendBody); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9210,8 +16580,8 @@ func file_app_app_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_app_app_api_app_proto_msgTypes[102].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*WithdrawRequest_SendBody); i {
+		file_app_app_api_app_proto_msgTypes[183].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminLoginRequest_SendBody); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9222,8 +16592,8 @@ func file_app_app_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_app_app_api_app_proto_msgTypes[103].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AdminRewardListReply_List); i {
+		file_app_app_api_app_proto_msgTypes[184].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminChangePasswordRequest_SendBody); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9234,8 +16604,8 @@ func file_app_app_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_app_app_api_app_proto_msgTypes[104].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AdminRewardBnbListReply_List); i {
+		file_app_app_api_app_proto_msgTypes[185].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminCreateAccountRequest_SendBody); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9246,8 +16616,8 @@ func file_app_app_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_app_app_api_app_proto_msgTypes[105].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AdminUserListReply_UserList); i {
+		file_app_app_api_app_proto_msgTypes[186].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminAuditLogListReply_List); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9258,8 +16628,8 @@ func file_app_app_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_app_app_api_app_proto_msgTypes[106].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AdminLocationListReply_LocationList); i {
+		file_app_app_api_app_proto_msgTypes[187].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminProposalListReply_List); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9270,8 +16640,8 @@ func file_app_app_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_app_app_api_app_proto_msgTypes[107].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AdminLocationAllListReply_LocationList); i {
+		file_app_app_api_app_proto_msgTypes[188].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminProposalApproveRequest_SendBody); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9282,8 +16652,8 @@ func file_app_app_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_app_app_api_app_proto_msgTypes[108].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AdminWithdrawListReply_List); i {
+		file_app_app_api_app_proto_msgTypes[189].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminProposalRejectRequest_SendBody); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9294,8 +16664,8 @@ func file_app_app_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_app_app_api_app_proto_msgTypes[109].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AdminUserRecommendReply_List); i {
+		file_app_app_api_app_proto_msgTypes[190].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminWithdrawReviewListReply_List); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9306,8 +16676,8 @@ func file_app_app_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_app_app_api_app_proto_msgTypes[110].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AdminMonthRecommendReply_List); i {
+		file_app_app_api_app_proto_msgTypes[191].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminWithdrawReviewApproveRequest_SendBody); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9318,8 +16688,8 @@ func file_app_app_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_app_app_api_app_proto_msgTypes[111].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AdminConfigReply_List); i {
+		file_app_app_api_app_proto_msgTypes[192].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminWithdrawReviewRejectRequest_SendBody); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9330,8 +16700,8 @@ func file_app_app_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_app_app_api_app_proto_msgTypes[112].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AdminListReply_List); i {
+		file_app_app_api_app_proto_msgTypes[193].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminWithdrawRejectRequest_SendBody); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9342,8 +16712,8 @@ func file_app_app_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_app_app_api_app_proto_msgTypes[113].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AuthListReply_List); i {
+		file_app_app_api_app_proto_msgTypes[194].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminWithdrawEventListReply_List); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9354,8 +16724,8 @@ func file_app_app_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_app_app_api_app_proto_msgTypes[114].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UserAuthListReply_List); i {
+		file_app_app_api_app_proto_msgTypes[195].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminLedgerVerifyReply_List); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9366,8 +16736,8 @@ func file_app_app_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_app_app_api_app_proto_msgTypes[115].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MyAuthListReply_List); i {
+		file_app_app_api_app_proto_msgTypes[196].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminLedgerOpenRequest_SendBody); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9378,8 +16748,8 @@ func file_app_app_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_app_app_api_app_proto_msgTypes[116].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AdminConfigUpdateRequest_SendBody); i {
+		file_app_app_api_app_proto_msgTypes[197].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminDiscrepancyListReply_List); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9390,8 +16760,8 @@ func file_app_app_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_app_app_api_app_proto_msgTypes[117].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AdminVipUpdateRequest_SendBody); i {
+		file_app_app_api_app_proto_msgTypes[198].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminChainReconcileReply_List); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9402,8 +16772,8 @@ func file_app_app_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_app_app_api_app_proto_msgTypes[118].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AdminUndoUpdateRequest_SendBody); i {
+		file_app_app_api_app_proto_msgTypes[199].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminLiabilityListReply_List); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9414,8 +16784,8 @@ func file_app_app_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_app_app_api_app_proto_msgTypes[119].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AdminAreaLevelUpdateRequest_SendBody); i {
+		file_app_app_api_app_proto_msgTypes[200].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminUserStatementReply_List); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9426,8 +16796,8 @@ func file_app_app_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_app_app_api_app_proto_msgTypes[120].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AdminLocationInsertRequest_SendBody); i {
+		file_app_app_api_app_proto_msgTypes[201].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminSolvencyListReply_List); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9438,8 +16808,8 @@ func file_app_app_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_app_app_api_app_proto_msgTypes[121].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AdminBalanceUpdateRequest_SendBody); i {
+		file_app_app_api_app_proto_msgTypes[203].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminDepositSimulateReply_List); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9450,8 +16820,8 @@ func file_app_app_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_app_app_api_app_proto_msgTypes[122].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AuthAdminCreateRequest_SendBody); i {
+		file_app_app_api_app_proto_msgTypes[204].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RewardDetailReply_Config); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9462,8 +16832,8 @@ func file_app_app_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_app_app_api_app_proto_msgTypes[123].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AuthAdminDeleteRequest_SendBody); i {
+		file_app_app_api_app_proto_msgTypes[205].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminRewardDetailReply_Config); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9474,8 +16844,8 @@ func file_app_app_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_app_app_api_app_proto_msgTypes[124].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AdminLoginRequest_SendBody); i {
+		file_app_app_api_app_proto_msgTypes[206].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminLockListReply_List); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9486,8 +16856,8 @@ func file_app_app_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_app_app_api_app_proto_msgTypes[125].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AdminChangePasswordRequest_SendBody); i {
+		file_app_app_api_app_proto_msgTypes[207].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReinvestRequest_SendBody); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9498,8 +16868,8 @@ func file_app_app_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_app_app_api_app_proto_msgTypes[126].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AdminCreateAccountRequest_SendBody); i {
+		file_app_app_api_app_proto_msgTypes[208].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LocationProgressReply_List); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9517,7 +16887,7 @@ func file_app_app_api_app_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_app_app_api_app_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   127,
+			NumMessages:   209,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
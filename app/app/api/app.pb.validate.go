@@ -2148,6 +2148,241 @@ var _ interface {
 	ErrorName() string
 } = WithdrawReplyValidationError{}
 
+// Validate checks the field values on WithdrawCancelRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *WithdrawCancelRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on WithdrawCancelRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// WithdrawCancelRequestMultiError, or nil if none found.
+func (m *WithdrawCancelRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *WithdrawCancelRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetSendBody()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, WithdrawCancelRequestValidationError{
+					field:  "SendBody",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, WithdrawCancelRequestValidationError{
+					field:  "SendBody",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSendBody()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return WithdrawCancelRequestValidationError{
+				field:  "SendBody",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return WithdrawCancelRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// WithdrawCancelRequestMultiError is an error wrapping multiple validation
+// errors returned by WithdrawCancelRequest.ValidateAll() if the designated
+// constraints aren't met.
+type WithdrawCancelRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m WithdrawCancelRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m WithdrawCancelRequestMultiError) AllErrors() []error { return m }
+
+// WithdrawCancelRequestValidationError is the validation error returned by
+// WithdrawCancelRequest.Validate if the designated constraints aren't met.
+type WithdrawCancelRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e WithdrawCancelRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e WithdrawCancelRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e WithdrawCancelRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e WithdrawCancelRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e WithdrawCancelRequestValidationError) ErrorName() string {
+	return "WithdrawCancelRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e WithdrawCancelRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sWithdrawCancelRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = WithdrawCancelRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = WithdrawCancelRequestValidationError{}
+
+// Validate checks the field values on WithdrawCancelReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *WithdrawCancelReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on WithdrawCancelReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// WithdrawCancelReplyMultiError, or nil if none found.
+func (m *WithdrawCancelReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *WithdrawCancelReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Status
+
+	if len(errors) > 0 {
+		return WithdrawCancelReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// WithdrawCancelReplyMultiError is an error wrapping multiple validation
+// errors returned by WithdrawCancelReply.ValidateAll() if the designated
+// constraints aren't met.
+type WithdrawCancelReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m WithdrawCancelReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m WithdrawCancelReplyMultiError) AllErrors() []error { return m }
+
+// WithdrawCancelReplyValidationError is the validation error returned by
+// WithdrawCancelReply.Validate if the designated constraints aren't met.
+type WithdrawCancelReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e WithdrawCancelReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e WithdrawCancelReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e WithdrawCancelReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e WithdrawCancelReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e WithdrawCancelReplyValidationError) ErrorName() string {
+	return "WithdrawCancelReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e WithdrawCancelReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sWithdrawCancelReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = WithdrawCancelReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = WithdrawCancelReplyValidationError{}
+
 // Validate checks the field values on AdminRewardListRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
@@ -8253,6 +8488,8 @@ func (m *AdminLocationInsertReply) validate(all bool) error {
 
 	var errors []error
 
+	// no validation rules for ProposalId
+
 	if len(errors) > 0 {
 		return AdminLocationInsertReplyMultiError(errors)
 	}
@@ -8486,6 +8723,10 @@ func (m *AdminBalanceUpdateReply) validate(all bool) error {
 
 	var errors []error
 
+	// no validation rules for ProposalId
+
+	// no validation rules for RecordId
+
 	if len(errors) > 0 {
 		return AdminBalanceUpdateReplyMultiError(errors)
 	}
@@ -10979,46 +11220,48 @@ var _ interface {
 	ErrorName() string
 } = AdminCreateAccountReplyValidationError{}
 
-// Validate checks the field values on EthAuthorizeRequest_SendBody with the
-// rules defined in the proto definition for this message. If any rules are
+// Validate checks the field values on AdminAuditLogListRequest with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *EthAuthorizeRequest_SendBody) Validate() error {
+func (m *AdminAuditLogListRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on EthAuthorizeRequest_SendBody with the
+// ValidateAll checks the field values on AdminAuditLogListRequest with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// EthAuthorizeRequest_SendBodyMultiError, or nil if none found.
-func (m *EthAuthorizeRequest_SendBody) ValidateAll() error {
+// AdminAuditLogListRequestMultiError, or nil if none found.
+func (m *AdminAuditLogListRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *EthAuthorizeRequest_SendBody) validate(all bool) error {
+func (m *AdminAuditLogListRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Address
+	// no validation rules for Page
 
-	// no validation rules for Code
+	// no validation rules for AdminId
+
+	// no validation rules for Operation
 
 	if len(errors) > 0 {
-		return EthAuthorizeRequest_SendBodyMultiError(errors)
+		return AdminAuditLogListRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// EthAuthorizeRequest_SendBodyMultiError is an error wrapping multiple
-// validation errors returned by EthAuthorizeRequest_SendBody.ValidateAll() if
-// the designated constraints aren't met.
-type EthAuthorizeRequest_SendBodyMultiError []error
+// AdminAuditLogListRequestMultiError is an error wrapping multiple validation
+// errors returned by AdminAuditLogListRequest.ValidateAll() if the designated
+// constraints aren't met.
+type AdminAuditLogListRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m EthAuthorizeRequest_SendBodyMultiError) Error() string {
+func (m AdminAuditLogListRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -11027,12 +11270,11 @@ func (m EthAuthorizeRequest_SendBodyMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m EthAuthorizeRequest_SendBodyMultiError) AllErrors() []error { return m }
+func (m AdminAuditLogListRequestMultiError) AllErrors() []error { return m }
 
-// EthAuthorizeRequest_SendBodyValidationError is the validation error returned
-// by EthAuthorizeRequest_SendBody.Validate if the designated constraints
-// aren't met.
-type EthAuthorizeRequest_SendBodyValidationError struct {
+// AdminAuditLogListRequestValidationError is the validation error returned by
+// AdminAuditLogListRequest.Validate if the designated constraints aren't met.
+type AdminAuditLogListRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -11040,24 +11282,24 @@ type EthAuthorizeRequest_SendBodyValidationError struct {
 }
 
 // Field function returns field value.
-func (e EthAuthorizeRequest_SendBodyValidationError) Field() string { return e.field }
+func (e AdminAuditLogListRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e EthAuthorizeRequest_SendBodyValidationError) Reason() string { return e.reason }
+func (e AdminAuditLogListRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e EthAuthorizeRequest_SendBodyValidationError) Cause() error { return e.cause }
+func (e AdminAuditLogListRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e EthAuthorizeRequest_SendBodyValidationError) Key() bool { return e.key }
+func (e AdminAuditLogListRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e EthAuthorizeRequest_SendBodyValidationError) ErrorName() string {
-	return "EthAuthorizeRequest_SendBodyValidationError"
+func (e AdminAuditLogListRequestValidationError) ErrorName() string {
+	return "AdminAuditLogListRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e EthAuthorizeRequest_SendBodyValidationError) Error() string {
+func (e AdminAuditLogListRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -11069,14 +11311,14 @@ func (e EthAuthorizeRequest_SendBodyValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sEthAuthorizeRequest_SendBody.%s: %s%s",
+		"invalid %sAdminAuditLogListRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = EthAuthorizeRequest_SendBodyValidationError{}
+var _ error = AdminAuditLogListRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -11084,52 +11326,80 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = EthAuthorizeRequest_SendBodyValidationError{}
+} = AdminAuditLogListRequestValidationError{}
 
-// Validate checks the field values on RewardListReply_List with the rules
+// Validate checks the field values on AdminAuditLogListReply with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *RewardListReply_List) Validate() error {
+func (m *AdminAuditLogListReply) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on RewardListReply_List with the rules
+// ValidateAll checks the field values on AdminAuditLogListReply with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// RewardListReply_ListMultiError, or nil if none found.
-func (m *RewardListReply_List) ValidateAll() error {
+// AdminAuditLogListReplyMultiError, or nil if none found.
+func (m *AdminAuditLogListReply) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *RewardListReply_List) validate(all bool) error {
+func (m *AdminAuditLogListReply) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for CreatedAt
+	for idx, item := range m.GetLogs() {
+		_, _ = idx, item
 
-	// no validation rules for Amount
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, AdminAuditLogListReplyValidationError{
+						field:  fmt.Sprintf("Logs[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, AdminAuditLogListReplyValidationError{
+						field:  fmt.Sprintf("Logs[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return AdminAuditLogListReplyValidationError{
+					field:  fmt.Sprintf("Logs[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
 
-	// no validation rules for LocationStatus
+	}
 
-	// no validation rules for Type
+	// no validation rules for Count
 
 	if len(errors) > 0 {
-		return RewardListReply_ListMultiError(errors)
+		return AdminAuditLogListReplyMultiError(errors)
 	}
 
 	return nil
 }
 
-// RewardListReply_ListMultiError is an error wrapping multiple validation
-// errors returned by RewardListReply_List.ValidateAll() if the designated
+// AdminAuditLogListReplyMultiError is an error wrapping multiple validation
+// errors returned by AdminAuditLogListReply.ValidateAll() if the designated
 // constraints aren't met.
-type RewardListReply_ListMultiError []error
+type AdminAuditLogListReplyMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m RewardListReply_ListMultiError) Error() string {
+func (m AdminAuditLogListReplyMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -11138,11 +11408,11 @@ func (m RewardListReply_ListMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m RewardListReply_ListMultiError) AllErrors() []error { return m }
+func (m AdminAuditLogListReplyMultiError) AllErrors() []error { return m }
 
-// RewardListReply_ListValidationError is the validation error returned by
-// RewardListReply_List.Validate if the designated constraints aren't met.
-type RewardListReply_ListValidationError struct {
+// AdminAuditLogListReplyValidationError is the validation error returned by
+// AdminAuditLogListReply.Validate if the designated constraints aren't met.
+type AdminAuditLogListReplyValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -11150,24 +11420,24 @@ type RewardListReply_ListValidationError struct {
 }
 
 // Field function returns field value.
-func (e RewardListReply_ListValidationError) Field() string { return e.field }
+func (e AdminAuditLogListReplyValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e RewardListReply_ListValidationError) Reason() string { return e.reason }
+func (e AdminAuditLogListReplyValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e RewardListReply_ListValidationError) Cause() error { return e.cause }
+func (e AdminAuditLogListReplyValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e RewardListReply_ListValidationError) Key() bool { return e.key }
+func (e AdminAuditLogListReplyValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e RewardListReply_ListValidationError) ErrorName() string {
-	return "RewardListReply_ListValidationError"
+func (e AdminAuditLogListReplyValidationError) ErrorName() string {
+	return "AdminAuditLogListReplyValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e RewardListReply_ListValidationError) Error() string {
+func (e AdminAuditLogListReplyValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -11179,14 +11449,14 @@ func (e RewardListReply_ListValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sRewardListReply_List.%s: %s%s",
+		"invalid %sAdminAuditLogListReply.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = RewardListReply_ListValidationError{}
+var _ error = AdminAuditLogListReplyValidationError{}
 
 var _ interface {
 	Field() string
@@ -11194,52 +11464,48 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = RewardListReply_ListValidationError{}
+} = AdminAuditLogListReplyValidationError{}
 
-// Validate checks the field values on RecommendRewardListReply_List with the
-// rules defined in the proto definition for this message. If any rules are
+// Validate checks the field values on AdminProposalListRequest with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *RecommendRewardListReply_List) Validate() error {
+func (m *AdminProposalListRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on RecommendRewardListReply_List with
-// the rules defined in the proto definition for this message. If any rules
-// are violated, the result is a list of violation errors wrapped in
-// RecommendRewardListReply_ListMultiError, or nil if none found.
-func (m *RecommendRewardListReply_List) ValidateAll() error {
+// ValidateAll checks the field values on AdminProposalListRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AdminProposalListRequestMultiError, or nil if none found.
+func (m *AdminProposalListRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *RecommendRewardListReply_List) validate(all bool) error {
+func (m *AdminProposalListRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for CreatedAt
-
-	// no validation rules for Amount
-
-	// no validation rules for Type
+	// no validation rules for Page
 
-	// no validation rules for Reason
+	// no validation rules for Status
 
 	if len(errors) > 0 {
-		return RecommendRewardListReply_ListMultiError(errors)
+		return AdminProposalListRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// RecommendRewardListReply_ListMultiError is an error wrapping multiple
-// validation errors returned by RecommendRewardListReply_List.ValidateAll()
-// if the designated constraints aren't met.
-type RecommendRewardListReply_ListMultiError []error
+// AdminProposalListRequestMultiError is an error wrapping multiple validation
+// errors returned by AdminProposalListRequest.ValidateAll() if the designated
+// constraints aren't met.
+type AdminProposalListRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m RecommendRewardListReply_ListMultiError) Error() string {
+func (m AdminProposalListRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -11248,12 +11514,11 @@ func (m RecommendRewardListReply_ListMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m RecommendRewardListReply_ListMultiError) AllErrors() []error { return m }
+func (m AdminProposalListRequestMultiError) AllErrors() []error { return m }
 
-// RecommendRewardListReply_ListValidationError is the validation error
-// returned by RecommendRewardListReply_List.Validate if the designated
-// constraints aren't met.
-type RecommendRewardListReply_ListValidationError struct {
+// AdminProposalListRequestValidationError is the validation error returned by
+// AdminProposalListRequest.Validate if the designated constraints aren't met.
+type AdminProposalListRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -11261,24 +11526,24 @@ type RecommendRewardListReply_ListValidationError struct {
 }
 
 // Field function returns field value.
-func (e RecommendRewardListReply_ListValidationError) Field() string { return e.field }
+func (e AdminProposalListRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e RecommendRewardListReply_ListValidationError) Reason() string { return e.reason }
+func (e AdminProposalListRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e RecommendRewardListReply_ListValidationError) Cause() error { return e.cause }
+func (e AdminProposalListRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e RecommendRewardListReply_ListValidationError) Key() bool { return e.key }
+func (e AdminProposalListRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e RecommendRewardListReply_ListValidationError) ErrorName() string {
-	return "RecommendRewardListReply_ListValidationError"
+func (e AdminProposalListRequestValidationError) ErrorName() string {
+	return "AdminProposalListRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e RecommendRewardListReply_ListValidationError) Error() string {
+func (e AdminProposalListRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -11290,14 +11555,14 @@ func (e RecommendRewardListReply_ListValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sRecommendRewardListReply_List.%s: %s%s",
+		"invalid %sAdminProposalListRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = RecommendRewardListReply_ListValidationError{}
+var _ error = AdminProposalListRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -11305,48 +11570,80 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = RecommendRewardListReply_ListValidationError{}
+} = AdminProposalListRequestValidationError{}
 
-// Validate checks the field values on FeeRewardListReply_List with the rules
+// Validate checks the field values on AdminProposalListReply with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *FeeRewardListReply_List) Validate() error {
+func (m *AdminProposalListReply) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on FeeRewardListReply_List with the
-// rules defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on AdminProposalListReply with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// FeeRewardListReply_ListMultiError, or nil if none found.
-func (m *FeeRewardListReply_List) ValidateAll() error {
+// AdminProposalListReplyMultiError, or nil if none found.
+func (m *AdminProposalListReply) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *FeeRewardListReply_List) validate(all bool) error {
+func (m *AdminProposalListReply) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for CreatedAt
+	for idx, item := range m.GetProposals() {
+		_, _ = idx, item
 
-	// no validation rules for Amount
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, AdminProposalListReplyValidationError{
+						field:  fmt.Sprintf("Proposals[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, AdminProposalListReplyValidationError{
+						field:  fmt.Sprintf("Proposals[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return AdminProposalListReplyValidationError{
+					field:  fmt.Sprintf("Proposals[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for Count
 
 	if len(errors) > 0 {
-		return FeeRewardListReply_ListMultiError(errors)
+		return AdminProposalListReplyMultiError(errors)
 	}
 
 	return nil
 }
 
-// FeeRewardListReply_ListMultiError is an error wrapping multiple validation
-// errors returned by FeeRewardListReply_List.ValidateAll() if the designated
+// AdminProposalListReplyMultiError is an error wrapping multiple validation
+// errors returned by AdminProposalListReply.ValidateAll() if the designated
 // constraints aren't met.
-type FeeRewardListReply_ListMultiError []error
+type AdminProposalListReplyMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m FeeRewardListReply_ListMultiError) Error() string {
+func (m AdminProposalListReplyMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -11355,11 +11652,11 @@ func (m FeeRewardListReply_ListMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m FeeRewardListReply_ListMultiError) AllErrors() []error { return m }
+func (m AdminProposalListReplyMultiError) AllErrors() []error { return m }
 
-// FeeRewardListReply_ListValidationError is the validation error returned by
-// FeeRewardListReply_List.Validate if the designated constraints aren't met.
-type FeeRewardListReply_ListValidationError struct {
+// AdminProposalListReplyValidationError is the validation error returned by
+// AdminProposalListReply.Validate if the designated constraints aren't met.
+type AdminProposalListReplyValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -11367,24 +11664,24 @@ type FeeRewardListReply_ListValidationError struct {
 }
 
 // Field function returns field value.
-func (e FeeRewardListReply_ListValidationError) Field() string { return e.field }
+func (e AdminProposalListReplyValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e FeeRewardListReply_ListValidationError) Reason() string { return e.reason }
+func (e AdminProposalListReplyValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e FeeRewardListReply_ListValidationError) Cause() error { return e.cause }
+func (e AdminProposalListReplyValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e FeeRewardListReply_ListValidationError) Key() bool { return e.key }
+func (e AdminProposalListReplyValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e FeeRewardListReply_ListValidationError) ErrorName() string {
-	return "FeeRewardListReply_ListValidationError"
+func (e AdminProposalListReplyValidationError) ErrorName() string {
+	return "AdminProposalListReplyValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e FeeRewardListReply_ListValidationError) Error() string {
+func (e AdminProposalListReplyValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -11396,14 +11693,14 @@ func (e FeeRewardListReply_ListValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sFeeRewardListReply_List.%s: %s%s",
+		"invalid %sAdminProposalListReply.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = FeeRewardListReply_ListValidationError{}
+var _ error = AdminProposalListReplyValidationError{}
 
 var _ interface {
 	Field() string
@@ -11411,52 +11708,73 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = FeeRewardListReply_ListValidationError{}
+} = AdminProposalListReplyValidationError{}
 
-// Validate checks the field values on WithdrawListReply_List with the rules
-// defined in the proto definition for this message. If any rules are
+// Validate checks the field values on AdminProposalApproveRequest with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *WithdrawListReply_List) Validate() error {
+func (m *AdminProposalApproveRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on WithdrawListReply_List with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on AdminProposalApproveRequest with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// WithdrawListReply_ListMultiError, or nil if none found.
-func (m *WithdrawListReply_List) ValidateAll() error {
+// AdminProposalApproveRequestMultiError, or nil if none found.
+func (m *AdminProposalApproveRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *WithdrawListReply_List) validate(all bool) error {
+func (m *AdminProposalApproveRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for CreatedAt
-
-	// no validation rules for Amount
-
-	// no validation rules for Type
-
-	// no validation rules for Status
+	if all {
+		switch v := interface{}(m.GetSendBody()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AdminProposalApproveRequestValidationError{
+					field:  "SendBody",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, AdminProposalApproveRequestValidationError{
+					field:  "SendBody",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSendBody()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AdminProposalApproveRequestValidationError{
+				field:  "SendBody",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
 
 	if len(errors) > 0 {
-		return WithdrawListReply_ListMultiError(errors)
+		return AdminProposalApproveRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// WithdrawListReply_ListMultiError is an error wrapping multiple validation
-// errors returned by WithdrawListReply_List.ValidateAll() if the designated
-// constraints aren't met.
-type WithdrawListReply_ListMultiError []error
+// AdminProposalApproveRequestMultiError is an error wrapping multiple
+// validation errors returned by AdminProposalApproveRequest.ValidateAll() if
+// the designated constraints aren't met.
+type AdminProposalApproveRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m WithdrawListReply_ListMultiError) Error() string {
+func (m AdminProposalApproveRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -11465,11 +11783,12 @@ func (m WithdrawListReply_ListMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m WithdrawListReply_ListMultiError) AllErrors() []error { return m }
+func (m AdminProposalApproveRequestMultiError) AllErrors() []error { return m }
 
-// WithdrawListReply_ListValidationError is the validation error returned by
-// WithdrawListReply_List.Validate if the designated constraints aren't met.
-type WithdrawListReply_ListValidationError struct {
+// AdminProposalApproveRequestValidationError is the validation error returned
+// by AdminProposalApproveRequest.Validate if the designated constraints
+// aren't met.
+type AdminProposalApproveRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -11477,24 +11796,24 @@ type WithdrawListReply_ListValidationError struct {
 }
 
 // Field function returns field value.
-func (e WithdrawListReply_ListValidationError) Field() string { return e.field }
+func (e AdminProposalApproveRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e WithdrawListReply_ListValidationError) Reason() string { return e.reason }
+func (e AdminProposalApproveRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e WithdrawListReply_ListValidationError) Cause() error { return e.cause }
+func (e AdminProposalApproveRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e WithdrawListReply_ListValidationError) Key() bool { return e.key }
+func (e AdminProposalApproveRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e WithdrawListReply_ListValidationError) ErrorName() string {
-	return "WithdrawListReply_ListValidationError"
+func (e AdminProposalApproveRequestValidationError) ErrorName() string {
+	return "AdminProposalApproveRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e WithdrawListReply_ListValidationError) Error() string {
+func (e AdminProposalApproveRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -11506,14 +11825,14 @@ func (e WithdrawListReply_ListValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sWithdrawListReply_List.%s: %s%s",
+		"invalid %sAdminProposalApproveRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = WithdrawListReply_ListValidationError{}
+var _ error = AdminProposalApproveRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -11521,48 +11840,48 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = WithdrawListReply_ListValidationError{}
+} = AdminProposalApproveRequestValidationError{}
 
-// Validate checks the field values on RecommendListReply_List with the rules
+// Validate checks the field values on AdminProposalApproveReply with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *RecommendListReply_List) Validate() error {
+func (m *AdminProposalApproveReply) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on RecommendListReply_List with the
+// ValidateAll checks the field values on AdminProposalApproveReply with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// RecommendListReply_ListMultiError, or nil if none found.
-func (m *RecommendListReply_List) ValidateAll() error {
+// AdminProposalApproveReplyMultiError, or nil if none found.
+func (m *AdminProposalApproveReply) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *RecommendListReply_List) validate(all bool) error {
+func (m *AdminProposalApproveReply) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Amount
-
 	// no validation rules for Status
 
+	// no validation rules for Result
+
 	if len(errors) > 0 {
-		return RecommendListReply_ListMultiError(errors)
+		return AdminProposalApproveReplyMultiError(errors)
 	}
 
 	return nil
 }
 
-// RecommendListReply_ListMultiError is an error wrapping multiple validation
-// errors returned by RecommendListReply_List.ValidateAll() if the designated
-// constraints aren't met.
-type RecommendListReply_ListMultiError []error
+// AdminProposalApproveReplyMultiError is an error wrapping multiple validation
+// errors returned by AdminProposalApproveReply.ValidateAll() if the
+// designated constraints aren't met.
+type AdminProposalApproveReplyMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m RecommendListReply_ListMultiError) Error() string {
+func (m AdminProposalApproveReplyMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -11571,11 +11890,11 @@ func (m RecommendListReply_ListMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m RecommendListReply_ListMultiError) AllErrors() []error { return m }
+func (m AdminProposalApproveReplyMultiError) AllErrors() []error { return m }
 
-// RecommendListReply_ListValidationError is the validation error returned by
-// RecommendListReply_List.Validate if the designated constraints aren't met.
-type RecommendListReply_ListValidationError struct {
+// AdminProposalApproveReplyValidationError is the validation error returned by
+// AdminProposalApproveReply.Validate if the designated constraints aren't met.
+type AdminProposalApproveReplyValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -11583,24 +11902,24 @@ type RecommendListReply_ListValidationError struct {
 }
 
 // Field function returns field value.
-func (e RecommendListReply_ListValidationError) Field() string { return e.field }
+func (e AdminProposalApproveReplyValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e RecommendListReply_ListValidationError) Reason() string { return e.reason }
+func (e AdminProposalApproveReplyValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e RecommendListReply_ListValidationError) Cause() error { return e.cause }
+func (e AdminProposalApproveReplyValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e RecommendListReply_ListValidationError) Key() bool { return e.key }
+func (e AdminProposalApproveReplyValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e RecommendListReply_ListValidationError) ErrorName() string {
-	return "RecommendListReply_ListValidationError"
+func (e AdminProposalApproveReplyValidationError) ErrorName() string {
+	return "AdminProposalApproveReplyValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e RecommendListReply_ListValidationError) Error() string {
+func (e AdminProposalApproveReplyValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -11612,14 +11931,14 @@ func (e RecommendListReply_ListValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sRecommendListReply_List.%s: %s%s",
+		"invalid %sAdminProposalApproveReply.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = RecommendListReply_ListValidationError{}
+var _ error = AdminProposalApproveReplyValidationError{}
 
 var _ interface {
 	Field() string
@@ -11627,48 +11946,73 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = RecommendListReply_ListValidationError{}
+} = AdminProposalApproveReplyValidationError{}
 
-// Validate checks the field values on WithdrawRequest_SendBody with the rules
-// defined in the proto definition for this message. If any rules are
+// Validate checks the field values on AdminProposalRejectRequest with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *WithdrawRequest_SendBody) Validate() error {
+func (m *AdminProposalRejectRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on WithdrawRequest_SendBody with the
+// ValidateAll checks the field values on AdminProposalRejectRequest with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// WithdrawRequest_SendBodyMultiError, or nil if none found.
-func (m *WithdrawRequest_SendBody) ValidateAll() error {
+// AdminProposalRejectRequestMultiError, or nil if none found.
+func (m *AdminProposalRejectRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *WithdrawRequest_SendBody) validate(all bool) error {
+func (m *AdminProposalRejectRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Type
-
-	// no validation rules for Amount
+	if all {
+		switch v := interface{}(m.GetSendBody()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AdminProposalRejectRequestValidationError{
+					field:  "SendBody",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, AdminProposalRejectRequestValidationError{
+					field:  "SendBody",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSendBody()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AdminProposalRejectRequestValidationError{
+				field:  "SendBody",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
 
 	if len(errors) > 0 {
-		return WithdrawRequest_SendBodyMultiError(errors)
+		return AdminProposalRejectRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// WithdrawRequest_SendBodyMultiError is an error wrapping multiple validation
-// errors returned by WithdrawRequest_SendBody.ValidateAll() if the designated
-// constraints aren't met.
-type WithdrawRequest_SendBodyMultiError []error
+// AdminProposalRejectRequestMultiError is an error wrapping multiple
+// validation errors returned by AdminProposalRejectRequest.ValidateAll() if
+// the designated constraints aren't met.
+type AdminProposalRejectRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m WithdrawRequest_SendBodyMultiError) Error() string {
+func (m AdminProposalRejectRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -11677,11 +12021,11 @@ func (m WithdrawRequest_SendBodyMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m WithdrawRequest_SendBodyMultiError) AllErrors() []error { return m }
+func (m AdminProposalRejectRequestMultiError) AllErrors() []error { return m }
 
-// WithdrawRequest_SendBodyValidationError is the validation error returned by
-// WithdrawRequest_SendBody.Validate if the designated constraints aren't met.
-type WithdrawRequest_SendBodyValidationError struct {
+// AdminProposalRejectRequestValidationError is the validation error returned
+// by AdminProposalRejectRequest.Validate if the designated constraints aren't met.
+type AdminProposalRejectRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -11689,24 +12033,24 @@ type WithdrawRequest_SendBodyValidationError struct {
 }
 
 // Field function returns field value.
-func (e WithdrawRequest_SendBodyValidationError) Field() string { return e.field }
+func (e AdminProposalRejectRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e WithdrawRequest_SendBodyValidationError) Reason() string { return e.reason }
+func (e AdminProposalRejectRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e WithdrawRequest_SendBodyValidationError) Cause() error { return e.cause }
+func (e AdminProposalRejectRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e WithdrawRequest_SendBodyValidationError) Key() bool { return e.key }
+func (e AdminProposalRejectRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e WithdrawRequest_SendBodyValidationError) ErrorName() string {
-	return "WithdrawRequest_SendBodyValidationError"
+func (e AdminProposalRejectRequestValidationError) ErrorName() string {
+	return "AdminProposalRejectRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e WithdrawRequest_SendBodyValidationError) Error() string {
+func (e AdminProposalRejectRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -11718,14 +12062,14 @@ func (e WithdrawRequest_SendBodyValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sWithdrawRequest_SendBody.%s: %s%s",
+		"invalid %sAdminProposalRejectRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = WithdrawRequest_SendBodyValidationError{}
+var _ error = AdminProposalRejectRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -11733,54 +12077,150 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = WithdrawRequest_SendBodyValidationError{}
+} = AdminProposalRejectRequestValidationError{}
 
-// Validate checks the field values on AdminRewardListReply_List with the rules
+// Validate checks the field values on AdminProposalRejectReply with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *AdminRewardListReply_List) Validate() error {
+func (m *AdminProposalRejectReply) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on AdminRewardListReply_List with the
+// ValidateAll checks the field values on AdminProposalRejectReply with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// AdminRewardListReply_ListMultiError, or nil if none found.
-func (m *AdminRewardListReply_List) ValidateAll() error {
+// AdminProposalRejectReplyMultiError, or nil if none found.
+func (m *AdminProposalRejectReply) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *AdminRewardListReply_List) validate(all bool) error {
+func (m *AdminProposalRejectReply) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for CreatedAt
+	if len(errors) > 0 {
+		return AdminProposalRejectReplyMultiError(errors)
+	}
 
-	// no validation rules for Amount
+	return nil
+}
 
-	// no validation rules for Type
+// AdminProposalRejectReplyMultiError is an error wrapping multiple validation
+// errors returned by AdminProposalRejectReply.ValidateAll() if the designated
+// constraints aren't met.
+type AdminProposalRejectReplyMultiError []error
 
-	// no validation rules for Address
+// Error returns a concatenation of all the error messages it wraps.
+func (m AdminProposalRejectReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
 
-	// no validation rules for Reason
+// AllErrors returns a list of validation violation errors.
+func (m AdminProposalRejectReplyMultiError) AllErrors() []error { return m }
+
+// AdminProposalRejectReplyValidationError is the validation error returned by
+// AdminProposalRejectReply.Validate if the designated constraints aren't met.
+type AdminProposalRejectReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AdminProposalRejectReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AdminProposalRejectReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AdminProposalRejectReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AdminProposalRejectReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AdminProposalRejectReplyValidationError) ErrorName() string {
+	return "AdminProposalRejectReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AdminProposalRejectReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAdminProposalRejectReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AdminProposalRejectReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AdminProposalRejectReplyValidationError{}
+
+// Validate checks the field values on AdminWithdrawReviewListRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AdminWithdrawReviewListRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AdminWithdrawReviewListRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// AdminWithdrawReviewListRequestMultiError, or nil if none found.
+func (m *AdminWithdrawReviewListRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AdminWithdrawReviewListRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Page
+
+	// no validation rules for Status
 
 	if len(errors) > 0 {
-		return AdminRewardListReply_ListMultiError(errors)
+		return AdminWithdrawReviewListRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// AdminRewardListReply_ListMultiError is an error wrapping multiple validation
-// errors returned by AdminRewardListReply_List.ValidateAll() if the
-// designated constraints aren't met.
-type AdminRewardListReply_ListMultiError []error
+// AdminWithdrawReviewListRequestMultiError is an error wrapping multiple
+// validation errors returned by AdminWithdrawReviewListRequest.ValidateAll()
+// if the designated constraints aren't met.
+type AdminWithdrawReviewListRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m AdminRewardListReply_ListMultiError) Error() string {
+func (m AdminWithdrawReviewListRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -11789,11 +12229,12 @@ func (m AdminRewardListReply_ListMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m AdminRewardListReply_ListMultiError) AllErrors() []error { return m }
+func (m AdminWithdrawReviewListRequestMultiError) AllErrors() []error { return m }
 
-// AdminRewardListReply_ListValidationError is the validation error returned by
-// AdminRewardListReply_List.Validate if the designated constraints aren't met.
-type AdminRewardListReply_ListValidationError struct {
+// AdminWithdrawReviewListRequestValidationError is the validation error
+// returned by AdminWithdrawReviewListRequest.Validate if the designated
+// constraints aren't met.
+type AdminWithdrawReviewListRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -11801,24 +12242,24 @@ type AdminRewardListReply_ListValidationError struct {
 }
 
 // Field function returns field value.
-func (e AdminRewardListReply_ListValidationError) Field() string { return e.field }
+func (e AdminWithdrawReviewListRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e AdminRewardListReply_ListValidationError) Reason() string { return e.reason }
+func (e AdminWithdrawReviewListRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e AdminRewardListReply_ListValidationError) Cause() error { return e.cause }
+func (e AdminWithdrawReviewListRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e AdminRewardListReply_ListValidationError) Key() bool { return e.key }
+func (e AdminWithdrawReviewListRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e AdminRewardListReply_ListValidationError) ErrorName() string {
-	return "AdminRewardListReply_ListValidationError"
+func (e AdminWithdrawReviewListRequestValidationError) ErrorName() string {
+	return "AdminWithdrawReviewListRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e AdminRewardListReply_ListValidationError) Error() string {
+func (e AdminWithdrawReviewListRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -11830,14 +12271,14 @@ func (e AdminRewardListReply_ListValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sAdminRewardListReply_List.%s: %s%s",
+		"invalid %sAdminWithdrawReviewListRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = AdminRewardListReply_ListValidationError{}
+var _ error = AdminWithdrawReviewListRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -11845,52 +12286,9104 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = AdminRewardListReply_ListValidationError{}
+} = AdminWithdrawReviewListRequestValidationError{}
 
-// Validate checks the field values on AdminRewardBnbListReply_List with the
+// Validate checks the field values on AdminWithdrawReviewListReply with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *AdminRewardBnbListReply_List) Validate() error {
+func (m *AdminWithdrawReviewListReply) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on AdminRewardBnbListReply_List with the
+// ValidateAll checks the field values on AdminWithdrawReviewListReply with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// AdminRewardBnbListReply_ListMultiError, or nil if none found.
-func (m *AdminRewardBnbListReply_List) ValidateAll() error {
+// AdminWithdrawReviewListReplyMultiError, or nil if none found.
+func (m *AdminWithdrawReviewListReply) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *AdminRewardBnbListReply_List) validate(all bool) error {
+func (m *AdminWithdrawReviewListReply) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for CreatedAt
+	for idx, item := range m.GetReviews() {
+		_, _ = idx, item
 
-	// no validation rules for Amount
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, AdminWithdrawReviewListReplyValidationError{
+						field:  fmt.Sprintf("Reviews[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, AdminWithdrawReviewListReplyValidationError{
+						field:  fmt.Sprintf("Reviews[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return AdminWithdrawReviewListReplyValidationError{
+					field:  fmt.Sprintf("Reviews[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
 
-	// no validation rules for BalanceAll
+	}
 
-	// no validation rules for Address
+	// no validation rules for Count
 
 	if len(errors) > 0 {
-		return AdminRewardBnbListReply_ListMultiError(errors)
+		return AdminWithdrawReviewListReplyMultiError(errors)
 	}
 
 	return nil
 }
 
-// AdminRewardBnbListReply_ListMultiError is an error wrapping multiple
-// validation errors returned by AdminRewardBnbListReply_List.ValidateAll() if
+// AdminWithdrawReviewListReplyMultiError is an error wrapping multiple
+// validation errors returned by AdminWithdrawReviewListReply.ValidateAll() if
 // the designated constraints aren't met.
-type AdminRewardBnbListReply_ListMultiError []error
+type AdminWithdrawReviewListReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AdminWithdrawReviewListReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AdminWithdrawReviewListReplyMultiError) AllErrors() []error { return m }
+
+// AdminWithdrawReviewListReplyValidationError is the validation error returned
+// by AdminWithdrawReviewListReply.Validate if the designated constraints
+// aren't met.
+type AdminWithdrawReviewListReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AdminWithdrawReviewListReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AdminWithdrawReviewListReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AdminWithdrawReviewListReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AdminWithdrawReviewListReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AdminWithdrawReviewListReplyValidationError) ErrorName() string {
+	return "AdminWithdrawReviewListReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AdminWithdrawReviewListReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAdminWithdrawReviewListReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AdminWithdrawReviewListReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AdminWithdrawReviewListReplyValidationError{}
+
+// Validate checks the field values on AdminWithdrawReviewApproveRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *AdminWithdrawReviewApproveRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AdminWithdrawReviewApproveRequest
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// AdminWithdrawReviewApproveRequestMultiError, or nil if none found.
+func (m *AdminWithdrawReviewApproveRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AdminWithdrawReviewApproveRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetSendBody()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AdminWithdrawReviewApproveRequestValidationError{
+					field:  "SendBody",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, AdminWithdrawReviewApproveRequestValidationError{
+					field:  "SendBody",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSendBody()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AdminWithdrawReviewApproveRequestValidationError{
+				field:  "SendBody",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return AdminWithdrawReviewApproveRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// AdminWithdrawReviewApproveRequestMultiError is an error wrapping multiple
+// validation errors returned by
+// AdminWithdrawReviewApproveRequest.ValidateAll() if the designated
+// constraints aren't met.
+type AdminWithdrawReviewApproveRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AdminWithdrawReviewApproveRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AdminWithdrawReviewApproveRequestMultiError) AllErrors() []error { return m }
+
+// AdminWithdrawReviewApproveRequestValidationError is the validation error
+// returned by AdminWithdrawReviewApproveRequest.Validate if the designated
+// constraints aren't met.
+type AdminWithdrawReviewApproveRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AdminWithdrawReviewApproveRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AdminWithdrawReviewApproveRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AdminWithdrawReviewApproveRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AdminWithdrawReviewApproveRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AdminWithdrawReviewApproveRequestValidationError) ErrorName() string {
+	return "AdminWithdrawReviewApproveRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AdminWithdrawReviewApproveRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAdminWithdrawReviewApproveRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AdminWithdrawReviewApproveRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AdminWithdrawReviewApproveRequestValidationError{}
+
+// Validate checks the field values on AdminWithdrawReviewApproveReply with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AdminWithdrawReviewApproveReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AdminWithdrawReviewApproveReply with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// AdminWithdrawReviewApproveReplyMultiError, or nil if none found.
+func (m *AdminWithdrawReviewApproveReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AdminWithdrawReviewApproveReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return AdminWithdrawReviewApproveReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// AdminWithdrawReviewApproveReplyMultiError is an error wrapping multiple
+// validation errors returned by AdminWithdrawReviewApproveReply.ValidateAll()
+// if the designated constraints aren't met.
+type AdminWithdrawReviewApproveReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AdminWithdrawReviewApproveReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AdminWithdrawReviewApproveReplyMultiError) AllErrors() []error { return m }
+
+// AdminWithdrawReviewApproveReplyValidationError is the validation error
+// returned by AdminWithdrawReviewApproveReply.Validate if the designated
+// constraints aren't met.
+type AdminWithdrawReviewApproveReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AdminWithdrawReviewApproveReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AdminWithdrawReviewApproveReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AdminWithdrawReviewApproveReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AdminWithdrawReviewApproveReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AdminWithdrawReviewApproveReplyValidationError) ErrorName() string {
+	return "AdminWithdrawReviewApproveReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AdminWithdrawReviewApproveReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAdminWithdrawReviewApproveReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AdminWithdrawReviewApproveReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AdminWithdrawReviewApproveReplyValidationError{}
+
+// Validate checks the field values on AdminWithdrawReviewRejectRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *AdminWithdrawReviewRejectRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AdminWithdrawReviewRejectRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// AdminWithdrawReviewRejectRequestMultiError, or nil if none found.
+func (m *AdminWithdrawReviewRejectRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AdminWithdrawReviewRejectRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetSendBody()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AdminWithdrawReviewRejectRequestValidationError{
+					field:  "SendBody",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, AdminWithdrawReviewRejectRequestValidationError{
+					field:  "SendBody",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSendBody()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AdminWithdrawReviewRejectRequestValidationError{
+				field:  "SendBody",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return AdminWithdrawReviewRejectRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// AdminWithdrawReviewRejectRequestMultiError is an error wrapping multiple
+// validation errors returned by
+// AdminWithdrawReviewRejectRequest.ValidateAll() if the designated
+// constraints aren't met.
+type AdminWithdrawReviewRejectRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AdminWithdrawReviewRejectRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AdminWithdrawReviewRejectRequestMultiError) AllErrors() []error { return m }
+
+// AdminWithdrawReviewRejectRequestValidationError is the validation error
+// returned by AdminWithdrawReviewRejectRequest.Validate if the designated
+// constraints aren't met.
+type AdminWithdrawReviewRejectRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AdminWithdrawReviewRejectRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AdminWithdrawReviewRejectRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AdminWithdrawReviewRejectRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AdminWithdrawReviewRejectRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AdminWithdrawReviewRejectRequestValidationError) ErrorName() string {
+	return "AdminWithdrawReviewRejectRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AdminWithdrawReviewRejectRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAdminWithdrawReviewRejectRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AdminWithdrawReviewRejectRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AdminWithdrawReviewRejectRequestValidationError{}
+
+// Validate checks the field values on AdminWithdrawReviewRejectReply with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AdminWithdrawReviewRejectReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AdminWithdrawReviewRejectReply with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// AdminWithdrawReviewRejectReplyMultiError, or nil if none found.
+func (m *AdminWithdrawReviewRejectReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AdminWithdrawReviewRejectReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return AdminWithdrawReviewRejectReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// AdminWithdrawReviewRejectReplyMultiError is an error wrapping multiple
+// validation errors returned by AdminWithdrawReviewRejectReply.ValidateAll()
+// if the designated constraints aren't met.
+type AdminWithdrawReviewRejectReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AdminWithdrawReviewRejectReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AdminWithdrawReviewRejectReplyMultiError) AllErrors() []error { return m }
+
+// AdminWithdrawReviewRejectReplyValidationError is the validation error
+// returned by AdminWithdrawReviewRejectReply.Validate if the designated
+// constraints aren't met.
+type AdminWithdrawReviewRejectReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AdminWithdrawReviewRejectReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AdminWithdrawReviewRejectReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AdminWithdrawReviewRejectReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AdminWithdrawReviewRejectReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AdminWithdrawReviewRejectReplyValidationError) ErrorName() string {
+	return "AdminWithdrawReviewRejectReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AdminWithdrawReviewRejectReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAdminWithdrawReviewRejectReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AdminWithdrawReviewRejectReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AdminWithdrawReviewRejectReplyValidationError{}
+
+// Validate checks the field values on AdminWithdrawRejectRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AdminWithdrawRejectRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AdminWithdrawRejectRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AdminWithdrawRejectRequestMultiError, or nil if none found.
+func (m *AdminWithdrawRejectRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AdminWithdrawRejectRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetSendBody()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AdminWithdrawRejectRequestValidationError{
+					field:  "SendBody",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, AdminWithdrawRejectRequestValidationError{
+					field:  "SendBody",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSendBody()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AdminWithdrawRejectRequestValidationError{
+				field:  "SendBody",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return AdminWithdrawRejectRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// AdminWithdrawRejectRequestMultiError is an error wrapping multiple
+// validation errors returned by AdminWithdrawRejectRequest.ValidateAll() if
+// the designated constraints aren't met.
+type AdminWithdrawRejectRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AdminWithdrawRejectRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AdminWithdrawRejectRequestMultiError) AllErrors() []error { return m }
+
+// AdminWithdrawRejectRequestValidationError is the validation error returned
+// by AdminWithdrawRejectRequest.Validate if the designated constraints aren't met.
+type AdminWithdrawRejectRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AdminWithdrawRejectRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AdminWithdrawRejectRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AdminWithdrawRejectRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AdminWithdrawRejectRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AdminWithdrawRejectRequestValidationError) ErrorName() string {
+	return "AdminWithdrawRejectRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AdminWithdrawRejectRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAdminWithdrawRejectRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AdminWithdrawRejectRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AdminWithdrawRejectRequestValidationError{}
+
+// Validate checks the field values on AdminWithdrawRejectReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AdminWithdrawRejectReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AdminWithdrawRejectReply with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AdminWithdrawRejectReplyMultiError, or nil if none found.
+func (m *AdminWithdrawRejectReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AdminWithdrawRejectReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return AdminWithdrawRejectReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// AdminWithdrawRejectReplyMultiError is an error wrapping multiple validation
+// errors returned by AdminWithdrawRejectReply.ValidateAll() if the designated
+// constraints aren't met.
+type AdminWithdrawRejectReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AdminWithdrawRejectReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AdminWithdrawRejectReplyMultiError) AllErrors() []error { return m }
+
+// AdminWithdrawRejectReplyValidationError is the validation error returned by
+// AdminWithdrawRejectReply.Validate if the designated constraints aren't met.
+type AdminWithdrawRejectReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AdminWithdrawRejectReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AdminWithdrawRejectReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AdminWithdrawRejectReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AdminWithdrawRejectReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AdminWithdrawRejectReplyValidationError) ErrorName() string {
+	return "AdminWithdrawRejectReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AdminWithdrawRejectReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAdminWithdrawRejectReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AdminWithdrawRejectReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AdminWithdrawRejectReplyValidationError{}
+
+// Validate checks the field values on AdminWithdrawEventListRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AdminWithdrawEventListRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AdminWithdrawEventListRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// AdminWithdrawEventListRequestMultiError, or nil if none found.
+func (m *AdminWithdrawEventListRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AdminWithdrawEventListRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for WithdrawId
+
+	if len(errors) > 0 {
+		return AdminWithdrawEventListRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// AdminWithdrawEventListRequestMultiError is an error wrapping multiple
+// validation errors returned by AdminWithdrawEventListRequest.ValidateAll()
+// if the designated constraints aren't met.
+type AdminWithdrawEventListRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AdminWithdrawEventListRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AdminWithdrawEventListRequestMultiError) AllErrors() []error { return m }
+
+// AdminWithdrawEventListRequestValidationError is the validation error
+// returned by AdminWithdrawEventListRequest.Validate if the designated
+// constraints aren't met.
+type AdminWithdrawEventListRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AdminWithdrawEventListRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AdminWithdrawEventListRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AdminWithdrawEventListRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AdminWithdrawEventListRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AdminWithdrawEventListRequestValidationError) ErrorName() string {
+	return "AdminWithdrawEventListRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AdminWithdrawEventListRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAdminWithdrawEventListRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AdminWithdrawEventListRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AdminWithdrawEventListRequestValidationError{}
+
+// Validate checks the field values on AdminWithdrawEventListReply with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AdminWithdrawEventListReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AdminWithdrawEventListReply with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AdminWithdrawEventListReplyMultiError, or nil if none found.
+func (m *AdminWithdrawEventListReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AdminWithdrawEventListReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetEvents() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, AdminWithdrawEventListReplyValidationError{
+						field:  fmt.Sprintf("Events[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, AdminWithdrawEventListReplyValidationError{
+						field:  fmt.Sprintf("Events[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return AdminWithdrawEventListReplyValidationError{
+					field:  fmt.Sprintf("Events[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return AdminWithdrawEventListReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// AdminWithdrawEventListReplyMultiError is an error wrapping multiple
+// validation errors returned by AdminWithdrawEventListReply.ValidateAll() if
+// the designated constraints aren't met.
+type AdminWithdrawEventListReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AdminWithdrawEventListReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AdminWithdrawEventListReplyMultiError) AllErrors() []error { return m }
+
+// AdminWithdrawEventListReplyValidationError is the validation error returned
+// by AdminWithdrawEventListReply.Validate if the designated constraints
+// aren't met.
+type AdminWithdrawEventListReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AdminWithdrawEventListReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AdminWithdrawEventListReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AdminWithdrawEventListReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AdminWithdrawEventListReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AdminWithdrawEventListReplyValidationError) ErrorName() string {
+	return "AdminWithdrawEventListReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AdminWithdrawEventListReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAdminWithdrawEventListReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AdminWithdrawEventListReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AdminWithdrawEventListReplyValidationError{}
+
+// Validate checks the field values on AdminLedgerVerifyRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AdminLedgerVerifyRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AdminLedgerVerifyRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AdminLedgerVerifyRequestMultiError, or nil if none found.
+func (m *AdminLedgerVerifyRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AdminLedgerVerifyRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return AdminLedgerVerifyRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// AdminLedgerVerifyRequestMultiError is an error wrapping multiple validation
+// errors returned by AdminLedgerVerifyRequest.ValidateAll() if the designated
+// constraints aren't met.
+type AdminLedgerVerifyRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AdminLedgerVerifyRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AdminLedgerVerifyRequestMultiError) AllErrors() []error { return m }
+
+// AdminLedgerVerifyRequestValidationError is the validation error returned by
+// AdminLedgerVerifyRequest.Validate if the designated constraints aren't met.
+type AdminLedgerVerifyRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AdminLedgerVerifyRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AdminLedgerVerifyRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AdminLedgerVerifyRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AdminLedgerVerifyRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AdminLedgerVerifyRequestValidationError) ErrorName() string {
+	return "AdminLedgerVerifyRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AdminLedgerVerifyRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAdminLedgerVerifyRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AdminLedgerVerifyRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AdminLedgerVerifyRequestValidationError{}
+
+// Validate checks the field values on AdminLedgerVerifyReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AdminLedgerVerifyReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AdminLedgerVerifyReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AdminLedgerVerifyReplyMultiError, or nil if none found.
+func (m *AdminLedgerVerifyReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AdminLedgerVerifyReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetMismatches() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, AdminLedgerVerifyReplyValidationError{
+						field:  fmt.Sprintf("Mismatches[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, AdminLedgerVerifyReplyValidationError{
+						field:  fmt.Sprintf("Mismatches[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return AdminLedgerVerifyReplyValidationError{
+					field:  fmt.Sprintf("Mismatches[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for Count
+
+	if len(errors) > 0 {
+		return AdminLedgerVerifyReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// AdminLedgerVerifyReplyMultiError is an error wrapping multiple validation
+// errors returned by AdminLedgerVerifyReply.ValidateAll() if the designated
+// constraints aren't met.
+type AdminLedgerVerifyReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AdminLedgerVerifyReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AdminLedgerVerifyReplyMultiError) AllErrors() []error { return m }
+
+// AdminLedgerVerifyReplyValidationError is the validation error returned by
+// AdminLedgerVerifyReply.Validate if the designated constraints aren't met.
+type AdminLedgerVerifyReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AdminLedgerVerifyReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AdminLedgerVerifyReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AdminLedgerVerifyReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AdminLedgerVerifyReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AdminLedgerVerifyReplyValidationError) ErrorName() string {
+	return "AdminLedgerVerifyReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AdminLedgerVerifyReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAdminLedgerVerifyReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AdminLedgerVerifyReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AdminLedgerVerifyReplyValidationError{}
+
+// Validate checks the field values on AdminLedgerOpenRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AdminLedgerOpenRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AdminLedgerOpenRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AdminLedgerOpenRequestMultiError, or nil if none found.
+func (m *AdminLedgerOpenRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AdminLedgerOpenRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetSendBody()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AdminLedgerOpenRequestValidationError{
+					field:  "SendBody",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, AdminLedgerOpenRequestValidationError{
+					field:  "SendBody",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSendBody()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AdminLedgerOpenRequestValidationError{
+				field:  "SendBody",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return AdminLedgerOpenRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// AdminLedgerOpenRequestMultiError is an error wrapping multiple validation
+// errors returned by AdminLedgerOpenRequest.ValidateAll() if the designated
+// constraints aren't met.
+type AdminLedgerOpenRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AdminLedgerOpenRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AdminLedgerOpenRequestMultiError) AllErrors() []error { return m }
+
+// AdminLedgerOpenRequestValidationError is the validation error returned by
+// AdminLedgerOpenRequest.Validate if the designated constraints aren't met.
+type AdminLedgerOpenRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AdminLedgerOpenRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AdminLedgerOpenRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AdminLedgerOpenRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AdminLedgerOpenRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AdminLedgerOpenRequestValidationError) ErrorName() string {
+	return "AdminLedgerOpenRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AdminLedgerOpenRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAdminLedgerOpenRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AdminLedgerOpenRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AdminLedgerOpenRequestValidationError{}
+
+// Validate checks the field values on AdminLedgerOpenReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AdminLedgerOpenReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AdminLedgerOpenReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AdminLedgerOpenReplyMultiError, or nil if none found.
+func (m *AdminLedgerOpenReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AdminLedgerOpenReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Count
+
+	if len(errors) > 0 {
+		return AdminLedgerOpenReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// AdminLedgerOpenReplyMultiError is an error wrapping multiple validation
+// errors returned by AdminLedgerOpenReply.ValidateAll() if the designated
+// constraints aren't met.
+type AdminLedgerOpenReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AdminLedgerOpenReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AdminLedgerOpenReplyMultiError) AllErrors() []error { return m }
+
+// AdminLedgerOpenReplyValidationError is the validation error returned by
+// AdminLedgerOpenReply.Validate if the designated constraints aren't met.
+type AdminLedgerOpenReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AdminLedgerOpenReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AdminLedgerOpenReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AdminLedgerOpenReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AdminLedgerOpenReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AdminLedgerOpenReplyValidationError) ErrorName() string {
+	return "AdminLedgerOpenReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AdminLedgerOpenReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAdminLedgerOpenReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AdminLedgerOpenReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AdminLedgerOpenReplyValidationError{}
+
+// Validate checks the field values on AdminReconcileRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AdminReconcileRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AdminReconcileRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AdminReconcileRequestMultiError, or nil if none found.
+func (m *AdminReconcileRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AdminReconcileRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return AdminReconcileRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// AdminReconcileRequestMultiError is an error wrapping multiple validation
+// errors returned by AdminReconcileRequest.ValidateAll() if the designated
+// constraints aren't met.
+type AdminReconcileRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AdminReconcileRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AdminReconcileRequestMultiError) AllErrors() []error { return m }
+
+// AdminReconcileRequestValidationError is the validation error returned by
+// AdminReconcileRequest.Validate if the designated constraints aren't met.
+type AdminReconcileRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AdminReconcileRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AdminReconcileRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AdminReconcileRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AdminReconcileRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AdminReconcileRequestValidationError) ErrorName() string {
+	return "AdminReconcileRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AdminReconcileRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAdminReconcileRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AdminReconcileRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AdminReconcileRequestValidationError{}
+
+// Validate checks the field values on AdminReconcileReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AdminReconcileReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AdminReconcileReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AdminReconcileReplyMultiError, or nil if none found.
+func (m *AdminReconcileReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AdminReconcileReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Batch
+
+	// no validation rules for Count
+
+	if len(errors) > 0 {
+		return AdminReconcileReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// AdminReconcileReplyMultiError is an error wrapping multiple validation
+// errors returned by AdminReconcileReply.ValidateAll() if the designated
+// constraints aren't met.
+type AdminReconcileReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AdminReconcileReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AdminReconcileReplyMultiError) AllErrors() []error { return m }
+
+// AdminReconcileReplyValidationError is the validation error returned by
+// AdminReconcileReply.Validate if the designated constraints aren't met.
+type AdminReconcileReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AdminReconcileReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AdminReconcileReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AdminReconcileReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AdminReconcileReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AdminReconcileReplyValidationError) ErrorName() string {
+	return "AdminReconcileReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AdminReconcileReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAdminReconcileReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AdminReconcileReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AdminReconcileReplyValidationError{}
+
+// Validate checks the field values on AdminDiscrepancyListRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AdminDiscrepancyListRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AdminDiscrepancyListRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AdminDiscrepancyListRequestMultiError, or nil if none found.
+func (m *AdminDiscrepancyListRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AdminDiscrepancyListRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Page
+
+	// no validation rules for Batch
+
+	// no validation rules for Check
+
+	// no validation rules for Severity
+
+	// no validation rules for Export
+
+	if len(errors) > 0 {
+		return AdminDiscrepancyListRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// AdminDiscrepancyListRequestMultiError is an error wrapping multiple
+// validation errors returned by AdminDiscrepancyListRequest.ValidateAll() if
+// the designated constraints aren't met.
+type AdminDiscrepancyListRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AdminDiscrepancyListRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AdminDiscrepancyListRequestMultiError) AllErrors() []error { return m }
+
+// AdminDiscrepancyListRequestValidationError is the validation error returned
+// by AdminDiscrepancyListRequest.Validate if the designated constraints
+// aren't met.
+type AdminDiscrepancyListRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AdminDiscrepancyListRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AdminDiscrepancyListRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AdminDiscrepancyListRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AdminDiscrepancyListRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AdminDiscrepancyListRequestValidationError) ErrorName() string {
+	return "AdminDiscrepancyListRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AdminDiscrepancyListRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAdminDiscrepancyListRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AdminDiscrepancyListRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AdminDiscrepancyListRequestValidationError{}
+
+// Validate checks the field values on AdminDiscrepancyListReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AdminDiscrepancyListReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AdminDiscrepancyListReply with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AdminDiscrepancyListReplyMultiError, or nil if none found.
+func (m *AdminDiscrepancyListReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AdminDiscrepancyListReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetDiscrepancies() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, AdminDiscrepancyListReplyValidationError{
+						field:  fmt.Sprintf("Discrepancies[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, AdminDiscrepancyListReplyValidationError{
+						field:  fmt.Sprintf("Discrepancies[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return AdminDiscrepancyListReplyValidationError{
+					field:  fmt.Sprintf("Discrepancies[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for Count
+
+	// no validation rules for Batch
+
+	// no validation rules for Csv
+
+	if len(errors) > 0 {
+		return AdminDiscrepancyListReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// AdminDiscrepancyListReplyMultiError is an error wrapping multiple validation
+// errors returned by AdminDiscrepancyListReply.ValidateAll() if the
+// designated constraints aren't met.
+type AdminDiscrepancyListReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AdminDiscrepancyListReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AdminDiscrepancyListReplyMultiError) AllErrors() []error { return m }
+
+// AdminDiscrepancyListReplyValidationError is the validation error returned by
+// AdminDiscrepancyListReply.Validate if the designated constraints aren't met.
+type AdminDiscrepancyListReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AdminDiscrepancyListReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AdminDiscrepancyListReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AdminDiscrepancyListReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AdminDiscrepancyListReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AdminDiscrepancyListReplyValidationError) ErrorName() string {
+	return "AdminDiscrepancyListReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AdminDiscrepancyListReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAdminDiscrepancyListReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AdminDiscrepancyListReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AdminDiscrepancyListReplyValidationError{}
+
+// Validate checks the field values on AdminChainReconcileRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AdminChainReconcileRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AdminChainReconcileRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AdminChainReconcileRequestMultiError, or nil if none found.
+func (m *AdminChainReconcileRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AdminChainReconcileRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Start
+
+	// no validation rules for End
+
+	if len(errors) > 0 {
+		return AdminChainReconcileRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// AdminChainReconcileRequestMultiError is an error wrapping multiple
+// validation errors returned by AdminChainReconcileRequest.ValidateAll() if
+// the designated constraints aren't met.
+type AdminChainReconcileRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AdminChainReconcileRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AdminChainReconcileRequestMultiError) AllErrors() []error { return m }
+
+// AdminChainReconcileRequestValidationError is the validation error returned
+// by AdminChainReconcileRequest.Validate if the designated constraints aren't met.
+type AdminChainReconcileRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AdminChainReconcileRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AdminChainReconcileRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AdminChainReconcileRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AdminChainReconcileRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AdminChainReconcileRequestValidationError) ErrorName() string {
+	return "AdminChainReconcileRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AdminChainReconcileRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAdminChainReconcileRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AdminChainReconcileRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AdminChainReconcileRequestValidationError{}
+
+// Validate checks the field values on AdminChainReconcileReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AdminChainReconcileReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AdminChainReconcileReply with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AdminChainReconcileReplyMultiError, or nil if none found.
+func (m *AdminChainReconcileReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AdminChainReconcileReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetItems() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, AdminChainReconcileReplyValidationError{
+						field:  fmt.Sprintf("Items[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, AdminChainReconcileReplyValidationError{
+						field:  fmt.Sprintf("Items[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return AdminChainReconcileReplyValidationError{
+					field:  fmt.Sprintf("Items[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for Count
+
+	if len(errors) > 0 {
+		return AdminChainReconcileReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// AdminChainReconcileReplyMultiError is an error wrapping multiple validation
+// errors returned by AdminChainReconcileReply.ValidateAll() if the designated
+// constraints aren't met.
+type AdminChainReconcileReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AdminChainReconcileReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AdminChainReconcileReplyMultiError) AllErrors() []error { return m }
+
+// AdminChainReconcileReplyValidationError is the validation error returned by
+// AdminChainReconcileReply.Validate if the designated constraints aren't met.
+type AdminChainReconcileReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AdminChainReconcileReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AdminChainReconcileReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AdminChainReconcileReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AdminChainReconcileReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AdminChainReconcileReplyValidationError) ErrorName() string {
+	return "AdminChainReconcileReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AdminChainReconcileReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAdminChainReconcileReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AdminChainReconcileReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AdminChainReconcileReplyValidationError{}
+
+// Validate checks the field values on AdminBalanceSnapshotRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AdminBalanceSnapshotRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AdminBalanceSnapshotRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AdminBalanceSnapshotRequestMultiError, or nil if none found.
+func (m *AdminBalanceSnapshotRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AdminBalanceSnapshotRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Date
+
+	// no validation rules for Force
+
+	if len(errors) > 0 {
+		return AdminBalanceSnapshotRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// AdminBalanceSnapshotRequestMultiError is an error wrapping multiple
+// validation errors returned by AdminBalanceSnapshotRequest.ValidateAll() if
+// the designated constraints aren't met.
+type AdminBalanceSnapshotRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AdminBalanceSnapshotRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AdminBalanceSnapshotRequestMultiError) AllErrors() []error { return m }
+
+// AdminBalanceSnapshotRequestValidationError is the validation error returned
+// by AdminBalanceSnapshotRequest.Validate if the designated constraints
+// aren't met.
+type AdminBalanceSnapshotRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AdminBalanceSnapshotRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AdminBalanceSnapshotRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AdminBalanceSnapshotRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AdminBalanceSnapshotRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AdminBalanceSnapshotRequestValidationError) ErrorName() string {
+	return "AdminBalanceSnapshotRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AdminBalanceSnapshotRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAdminBalanceSnapshotRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AdminBalanceSnapshotRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AdminBalanceSnapshotRequestValidationError{}
+
+// Validate checks the field values on AdminBalanceSnapshotReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AdminBalanceSnapshotReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AdminBalanceSnapshotReply with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AdminBalanceSnapshotReplyMultiError, or nil if none found.
+func (m *AdminBalanceSnapshotReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AdminBalanceSnapshotReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Date
+
+	// no validation rules for UserCount
+
+	if len(errors) > 0 {
+		return AdminBalanceSnapshotReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// AdminBalanceSnapshotReplyMultiError is an error wrapping multiple validation
+// errors returned by AdminBalanceSnapshotReply.ValidateAll() if the
+// designated constraints aren't met.
+type AdminBalanceSnapshotReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AdminBalanceSnapshotReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AdminBalanceSnapshotReplyMultiError) AllErrors() []error { return m }
+
+// AdminBalanceSnapshotReplyValidationError is the validation error returned by
+// AdminBalanceSnapshotReply.Validate if the designated constraints aren't met.
+type AdminBalanceSnapshotReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AdminBalanceSnapshotReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AdminBalanceSnapshotReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AdminBalanceSnapshotReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AdminBalanceSnapshotReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AdminBalanceSnapshotReplyValidationError) ErrorName() string {
+	return "AdminBalanceSnapshotReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AdminBalanceSnapshotReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAdminBalanceSnapshotReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AdminBalanceSnapshotReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AdminBalanceSnapshotReplyValidationError{}
+
+// Validate checks the field values on AdminUserBalanceHistoryRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AdminUserBalanceHistoryRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AdminUserBalanceHistoryRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// AdminUserBalanceHistoryRequestMultiError, or nil if none found.
+func (m *AdminUserBalanceHistoryRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AdminUserBalanceHistoryRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for UserId
+
+	// no validation rules for Date
+
+	if len(errors) > 0 {
+		return AdminUserBalanceHistoryRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// AdminUserBalanceHistoryRequestMultiError is an error wrapping multiple
+// validation errors returned by AdminUserBalanceHistoryRequest.ValidateAll()
+// if the designated constraints aren't met.
+type AdminUserBalanceHistoryRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AdminUserBalanceHistoryRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AdminUserBalanceHistoryRequestMultiError) AllErrors() []error { return m }
+
+// AdminUserBalanceHistoryRequestValidationError is the validation error
+// returned by AdminUserBalanceHistoryRequest.Validate if the designated
+// constraints aren't met.
+type AdminUserBalanceHistoryRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AdminUserBalanceHistoryRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AdminUserBalanceHistoryRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AdminUserBalanceHistoryRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AdminUserBalanceHistoryRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AdminUserBalanceHistoryRequestValidationError) ErrorName() string {
+	return "AdminUserBalanceHistoryRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AdminUserBalanceHistoryRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAdminUserBalanceHistoryRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AdminUserBalanceHistoryRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AdminUserBalanceHistoryRequestValidationError{}
+
+// Validate checks the field values on AdminUserBalanceHistoryReply with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AdminUserBalanceHistoryReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AdminUserBalanceHistoryReply with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AdminUserBalanceHistoryReplyMultiError, or nil if none found.
+func (m *AdminUserBalanceHistoryReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AdminUserBalanceHistoryReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Date
+
+	// no validation rules for BalanceUsdt
+
+	// no validation rules for BalanceDhb
+
+	// no validation rules for BalanceBnb
+
+	if len(errors) > 0 {
+		return AdminUserBalanceHistoryReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// AdminUserBalanceHistoryReplyMultiError is an error wrapping multiple
+// validation errors returned by AdminUserBalanceHistoryReply.ValidateAll() if
+// the designated constraints aren't met.
+type AdminUserBalanceHistoryReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AdminUserBalanceHistoryReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AdminUserBalanceHistoryReplyMultiError) AllErrors() []error { return m }
+
+// AdminUserBalanceHistoryReplyValidationError is the validation error returned
+// by AdminUserBalanceHistoryReply.Validate if the designated constraints
+// aren't met.
+type AdminUserBalanceHistoryReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AdminUserBalanceHistoryReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AdminUserBalanceHistoryReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AdminUserBalanceHistoryReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AdminUserBalanceHistoryReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AdminUserBalanceHistoryReplyValidationError) ErrorName() string {
+	return "AdminUserBalanceHistoryReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AdminUserBalanceHistoryReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAdminUserBalanceHistoryReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AdminUserBalanceHistoryReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AdminUserBalanceHistoryReplyValidationError{}
+
+// Validate checks the field values on AdminLiabilityListRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AdminLiabilityListRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AdminLiabilityListRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AdminLiabilityListRequestMultiError, or nil if none found.
+func (m *AdminLiabilityListRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AdminLiabilityListRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Start
+
+	// no validation rules for End
+
+	if len(errors) > 0 {
+		return AdminLiabilityListRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// AdminLiabilityListRequestMultiError is an error wrapping multiple validation
+// errors returned by AdminLiabilityListRequest.ValidateAll() if the
+// designated constraints aren't met.
+type AdminLiabilityListRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AdminLiabilityListRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AdminLiabilityListRequestMultiError) AllErrors() []error { return m }
+
+// AdminLiabilityListRequestValidationError is the validation error returned by
+// AdminLiabilityListRequest.Validate if the designated constraints aren't met.
+type AdminLiabilityListRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AdminLiabilityListRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AdminLiabilityListRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AdminLiabilityListRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AdminLiabilityListRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AdminLiabilityListRequestValidationError) ErrorName() string {
+	return "AdminLiabilityListRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AdminLiabilityListRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAdminLiabilityListRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AdminLiabilityListRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AdminLiabilityListRequestValidationError{}
+
+// Validate checks the field values on AdminLiabilityListReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AdminLiabilityListReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AdminLiabilityListReply with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AdminLiabilityListReplyMultiError, or nil if none found.
+func (m *AdminLiabilityListReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AdminLiabilityListReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetLiabilities() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, AdminLiabilityListReplyValidationError{
+						field:  fmt.Sprintf("Liabilities[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, AdminLiabilityListReplyValidationError{
+						field:  fmt.Sprintf("Liabilities[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return AdminLiabilityListReplyValidationError{
+					field:  fmt.Sprintf("Liabilities[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return AdminLiabilityListReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// AdminLiabilityListReplyMultiError is an error wrapping multiple validation
+// errors returned by AdminLiabilityListReply.ValidateAll() if the designated
+// constraints aren't met.
+type AdminLiabilityListReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AdminLiabilityListReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AdminLiabilityListReplyMultiError) AllErrors() []error { return m }
+
+// AdminLiabilityListReplyValidationError is the validation error returned by
+// AdminLiabilityListReply.Validate if the designated constraints aren't met.
+type AdminLiabilityListReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AdminLiabilityListReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AdminLiabilityListReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AdminLiabilityListReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AdminLiabilityListReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AdminLiabilityListReplyValidationError) ErrorName() string {
+	return "AdminLiabilityListReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AdminLiabilityListReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAdminLiabilityListReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AdminLiabilityListReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AdminLiabilityListReplyValidationError{}
+
+// Validate checks the field values on AdminUserStatementRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AdminUserStatementRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AdminUserStatementRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AdminUserStatementRequestMultiError, or nil if none found.
+func (m *AdminUserStatementRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AdminUserStatementRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for UserId
+
+	// no validation rules for Start
+
+	// no validation rules for End
+
+	// no validation rules for Format
+
+	if len(errors) > 0 {
+		return AdminUserStatementRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// AdminUserStatementRequestMultiError is an error wrapping multiple validation
+// errors returned by AdminUserStatementRequest.ValidateAll() if the
+// designated constraints aren't met.
+type AdminUserStatementRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AdminUserStatementRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AdminUserStatementRequestMultiError) AllErrors() []error { return m }
+
+// AdminUserStatementRequestValidationError is the validation error returned by
+// AdminUserStatementRequest.Validate if the designated constraints aren't met.
+type AdminUserStatementRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AdminUserStatementRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AdminUserStatementRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AdminUserStatementRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AdminUserStatementRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AdminUserStatementRequestValidationError) ErrorName() string {
+	return "AdminUserStatementRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AdminUserStatementRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAdminUserStatementRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AdminUserStatementRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AdminUserStatementRequestValidationError{}
+
+// Validate checks the field values on AdminUserStatementReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AdminUserStatementReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AdminUserStatementReply with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AdminUserStatementReplyMultiError, or nil if none found.
+func (m *AdminUserStatementReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AdminUserStatementReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetLines() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, AdminUserStatementReplyValidationError{
+						field:  fmt.Sprintf("Lines[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, AdminUserStatementReplyValidationError{
+						field:  fmt.Sprintf("Lines[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return AdminUserStatementReplyValidationError{
+					field:  fmt.Sprintf("Lines[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for Csv
+
+	if len(errors) > 0 {
+		return AdminUserStatementReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// AdminUserStatementReplyMultiError is an error wrapping multiple validation
+// errors returned by AdminUserStatementReply.ValidateAll() if the designated
+// constraints aren't met.
+type AdminUserStatementReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AdminUserStatementReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AdminUserStatementReplyMultiError) AllErrors() []error { return m }
+
+// AdminUserStatementReplyValidationError is the validation error returned by
+// AdminUserStatementReply.Validate if the designated constraints aren't met.
+type AdminUserStatementReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AdminUserStatementReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AdminUserStatementReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AdminUserStatementReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AdminUserStatementReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AdminUserStatementReplyValidationError) ErrorName() string {
+	return "AdminUserStatementReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AdminUserStatementReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAdminUserStatementReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AdminUserStatementReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AdminUserStatementReplyValidationError{}
+
+// Validate checks the field values on AdminSolvencyRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AdminSolvencyRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AdminSolvencyRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AdminSolvencyRequestMultiError, or nil if none found.
+func (m *AdminSolvencyRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AdminSolvencyRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return AdminSolvencyRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// AdminSolvencyRequestMultiError is an error wrapping multiple validation
+// errors returned by AdminSolvencyRequest.ValidateAll() if the designated
+// constraints aren't met.
+type AdminSolvencyRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AdminSolvencyRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AdminSolvencyRequestMultiError) AllErrors() []error { return m }
+
+// AdminSolvencyRequestValidationError is the validation error returned by
+// AdminSolvencyRequest.Validate if the designated constraints aren't met.
+type AdminSolvencyRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AdminSolvencyRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AdminSolvencyRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AdminSolvencyRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AdminSolvencyRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AdminSolvencyRequestValidationError) ErrorName() string {
+	return "AdminSolvencyRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AdminSolvencyRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAdminSolvencyRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AdminSolvencyRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AdminSolvencyRequestValidationError{}
+
+// Validate checks the field values on AdminSolvencyReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AdminSolvencyReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AdminSolvencyReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AdminSolvencyReplyMultiError, or nil if none found.
+func (m *AdminSolvencyReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AdminSolvencyReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetSolvency()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AdminSolvencyReplyValidationError{
+					field:  "Solvency",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, AdminSolvencyReplyValidationError{
+					field:  "Solvency",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSolvency()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AdminSolvencyReplyValidationError{
+				field:  "Solvency",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for AlarmRatio
+
+	if len(errors) > 0 {
+		return AdminSolvencyReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// AdminSolvencyReplyMultiError is an error wrapping multiple validation errors
+// returned by AdminSolvencyReply.ValidateAll() if the designated constraints
+// aren't met.
+type AdminSolvencyReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AdminSolvencyReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AdminSolvencyReplyMultiError) AllErrors() []error { return m }
+
+// AdminSolvencyReplyValidationError is the validation error returned by
+// AdminSolvencyReply.Validate if the designated constraints aren't met.
+type AdminSolvencyReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AdminSolvencyReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AdminSolvencyReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AdminSolvencyReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AdminSolvencyReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AdminSolvencyReplyValidationError) ErrorName() string {
+	return "AdminSolvencyReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AdminSolvencyReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAdminSolvencyReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AdminSolvencyReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AdminSolvencyReplyValidationError{}
+
+// Validate checks the field values on AdminSolvencyCheckRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AdminSolvencyCheckRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AdminSolvencyCheckRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AdminSolvencyCheckRequestMultiError, or nil if none found.
+func (m *AdminSolvencyCheckRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AdminSolvencyCheckRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return AdminSolvencyCheckRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// AdminSolvencyCheckRequestMultiError is an error wrapping multiple validation
+// errors returned by AdminSolvencyCheckRequest.ValidateAll() if the
+// designated constraints aren't met.
+type AdminSolvencyCheckRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AdminSolvencyCheckRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AdminSolvencyCheckRequestMultiError) AllErrors() []error { return m }
+
+// AdminSolvencyCheckRequestValidationError is the validation error returned by
+// AdminSolvencyCheckRequest.Validate if the designated constraints aren't met.
+type AdminSolvencyCheckRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AdminSolvencyCheckRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AdminSolvencyCheckRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AdminSolvencyCheckRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AdminSolvencyCheckRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AdminSolvencyCheckRequestValidationError) ErrorName() string {
+	return "AdminSolvencyCheckRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AdminSolvencyCheckRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAdminSolvencyCheckRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AdminSolvencyCheckRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AdminSolvencyCheckRequestValidationError{}
+
+// Validate checks the field values on AdminSolvencyCheckReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AdminSolvencyCheckReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AdminSolvencyCheckReply with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AdminSolvencyCheckReplyMultiError, or nil if none found.
+func (m *AdminSolvencyCheckReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AdminSolvencyCheckReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Alarm
+
+	if len(errors) > 0 {
+		return AdminSolvencyCheckReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// AdminSolvencyCheckReplyMultiError is an error wrapping multiple validation
+// errors returned by AdminSolvencyCheckReply.ValidateAll() if the designated
+// constraints aren't met.
+type AdminSolvencyCheckReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AdminSolvencyCheckReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AdminSolvencyCheckReplyMultiError) AllErrors() []error { return m }
+
+// AdminSolvencyCheckReplyValidationError is the validation error returned by
+// AdminSolvencyCheckReply.Validate if the designated constraints aren't met.
+type AdminSolvencyCheckReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AdminSolvencyCheckReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AdminSolvencyCheckReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AdminSolvencyCheckReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AdminSolvencyCheckReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AdminSolvencyCheckReplyValidationError) ErrorName() string {
+	return "AdminSolvencyCheckReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AdminSolvencyCheckReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAdminSolvencyCheckReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AdminSolvencyCheckReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AdminSolvencyCheckReplyValidationError{}
+
+// Validate checks the field values on AdminSolvencyListRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AdminSolvencyListRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AdminSolvencyListRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AdminSolvencyListRequestMultiError, or nil if none found.
+func (m *AdminSolvencyListRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AdminSolvencyListRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Start
+
+	// no validation rules for End
+
+	if len(errors) > 0 {
+		return AdminSolvencyListRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// AdminSolvencyListRequestMultiError is an error wrapping multiple validation
+// errors returned by AdminSolvencyListRequest.ValidateAll() if the designated
+// constraints aren't met.
+type AdminSolvencyListRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AdminSolvencyListRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AdminSolvencyListRequestMultiError) AllErrors() []error { return m }
+
+// AdminSolvencyListRequestValidationError is the validation error returned by
+// AdminSolvencyListRequest.Validate if the designated constraints aren't met.
+type AdminSolvencyListRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AdminSolvencyListRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AdminSolvencyListRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AdminSolvencyListRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AdminSolvencyListRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AdminSolvencyListRequestValidationError) ErrorName() string {
+	return "AdminSolvencyListRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AdminSolvencyListRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAdminSolvencyListRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AdminSolvencyListRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AdminSolvencyListRequestValidationError{}
+
+// Validate checks the field values on AdminSolvencyListReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AdminSolvencyListReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AdminSolvencyListReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AdminSolvencyListReplyMultiError, or nil if none found.
+func (m *AdminSolvencyListReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AdminSolvencyListReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetSolvencies() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, AdminSolvencyListReplyValidationError{
+						field:  fmt.Sprintf("Solvencies[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, AdminSolvencyListReplyValidationError{
+						field:  fmt.Sprintf("Solvencies[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return AdminSolvencyListReplyValidationError{
+					field:  fmt.Sprintf("Solvencies[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return AdminSolvencyListReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// AdminSolvencyListReplyMultiError is an error wrapping multiple validation
+// errors returned by AdminSolvencyListReply.ValidateAll() if the designated
+// constraints aren't met.
+type AdminSolvencyListReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AdminSolvencyListReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AdminSolvencyListReplyMultiError) AllErrors() []error { return m }
+
+// AdminSolvencyListReplyValidationError is the validation error returned by
+// AdminSolvencyListReply.Validate if the designated constraints aren't met.
+type AdminSolvencyListReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AdminSolvencyListReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AdminSolvencyListReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AdminSolvencyListReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AdminSolvencyListReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AdminSolvencyListReplyValidationError) ErrorName() string {
+	return "AdminSolvencyListReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AdminSolvencyListReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAdminSolvencyListReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AdminSolvencyListReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AdminSolvencyListReplyValidationError{}
+
+// Validate checks the field values on AdminDepositSimulateRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AdminDepositSimulateRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AdminDepositSimulateRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AdminDepositSimulateRequestMultiError, or nil if none found.
+func (m *AdminDepositSimulateRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AdminDepositSimulateRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for UserId
+
+	// no validation rules for Amount
+
+	// no validation rules for RecommendNeed
+
+	// no validation rules for LocationRow
+
+	// no validation rules for RecommendUserId
+
+	// no validation rules for Configs
+
+	if len(errors) > 0 {
+		return AdminDepositSimulateRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// AdminDepositSimulateRequestMultiError is an error wrapping multiple
+// validation errors returned by AdminDepositSimulateRequest.ValidateAll() if
+// the designated constraints aren't met.
+type AdminDepositSimulateRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AdminDepositSimulateRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AdminDepositSimulateRequestMultiError) AllErrors() []error { return m }
+
+// AdminDepositSimulateRequestValidationError is the validation error returned
+// by AdminDepositSimulateRequest.Validate if the designated constraints
+// aren't met.
+type AdminDepositSimulateRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AdminDepositSimulateRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AdminDepositSimulateRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AdminDepositSimulateRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AdminDepositSimulateRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AdminDepositSimulateRequestValidationError) ErrorName() string {
+	return "AdminDepositSimulateRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AdminDepositSimulateRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAdminDepositSimulateRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AdminDepositSimulateRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AdminDepositSimulateRequestValidationError{}
+
+// Validate checks the field values on AdminDepositSimulateReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AdminDepositSimulateReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AdminDepositSimulateReply with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AdminDepositSimulateReplyMultiError, or nil if none found.
+func (m *AdminDepositSimulateReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AdminDepositSimulateReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Row
+
+	// no validation rules for Col
+
+	// no validation rules for CurrentLevel
+
+	// no validation rules for Current
+
+	// no validation rules for CurrentMax
+
+	for idx, item := range m.GetRewards() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, AdminDepositSimulateReplyValidationError{
+						field:  fmt.Sprintf("Rewards[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, AdminDepositSimulateReplyValidationError{
+						field:  fmt.Sprintf("Rewards[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return AdminDepositSimulateReplyValidationError{
+					field:  fmt.Sprintf("Rewards[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for SystemReward
+
+	if len(errors) > 0 {
+		return AdminDepositSimulateReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// AdminDepositSimulateReplyMultiError is an error wrapping multiple validation
+// errors returned by AdminDepositSimulateReply.ValidateAll() if the
+// designated constraints aren't met.
+type AdminDepositSimulateReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AdminDepositSimulateReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AdminDepositSimulateReplyMultiError) AllErrors() []error { return m }
+
+// AdminDepositSimulateReplyValidationError is the validation error returned by
+// AdminDepositSimulateReply.Validate if the designated constraints aren't met.
+type AdminDepositSimulateReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AdminDepositSimulateReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AdminDepositSimulateReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AdminDepositSimulateReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AdminDepositSimulateReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AdminDepositSimulateReplyValidationError) ErrorName() string {
+	return "AdminDepositSimulateReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AdminDepositSimulateReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAdminDepositSimulateReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AdminDepositSimulateReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AdminDepositSimulateReplyValidationError{}
+
+// Validate checks the field values on RewardDetailRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RewardDetailRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RewardDetailRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RewardDetailRequestMultiError, or nil if none found.
+func (m *RewardDetailRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RewardDetailRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	if len(errors) > 0 {
+		return RewardDetailRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// RewardDetailRequestMultiError is an error wrapping multiple validation
+// errors returned by RewardDetailRequest.ValidateAll() if the designated
+// constraints aren't met.
+type RewardDetailRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RewardDetailRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RewardDetailRequestMultiError) AllErrors() []error { return m }
+
+// RewardDetailRequestValidationError is the validation error returned by
+// RewardDetailRequest.Validate if the designated constraints aren't met.
+type RewardDetailRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RewardDetailRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RewardDetailRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RewardDetailRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RewardDetailRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RewardDetailRequestValidationError) ErrorName() string {
+	return "RewardDetailRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RewardDetailRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRewardDetailRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RewardDetailRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RewardDetailRequestValidationError{}
+
+// Validate checks the field values on RewardDetailReply with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *RewardDetailReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RewardDetailReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RewardDetailReplyMultiError, or nil if none found.
+func (m *RewardDetailReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RewardDetailReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for CreatedAt
+
+	// no validation rules for Amount
+
+	// no validation rules for Type
+
+	// no validation rules for Reason
+
+	// no validation rules for Rule
+
+	// no validation rules for Level
+
+	// no validation rules for Source
+
+	// no validation rules for LocationId
+
+	// no validation rules for LocationRow
+
+	// no validation rules for LocationCol
+
+	// no validation rules for PayerAddress
+
+	// no validation rules for DepositHash
+
+	// no validation rules for DepositAmount
+
+	// no validation rules for DepositCreatedAt
+
+	// no validation rules for RewardLocationId
+
+	for idx, item := range m.GetConfigs() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, RewardDetailReplyValidationError{
+						field:  fmt.Sprintf("Configs[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, RewardDetailReplyValidationError{
+						field:  fmt.Sprintf("Configs[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return RewardDetailReplyValidationError{
+					field:  fmt.Sprintf("Configs[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return RewardDetailReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// RewardDetailReplyMultiError is an error wrapping multiple validation errors
+// returned by RewardDetailReply.ValidateAll() if the designated constraints
+// aren't met.
+type RewardDetailReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RewardDetailReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RewardDetailReplyMultiError) AllErrors() []error { return m }
+
+// RewardDetailReplyValidationError is the validation error returned by
+// RewardDetailReply.Validate if the designated constraints aren't met.
+type RewardDetailReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RewardDetailReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RewardDetailReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RewardDetailReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RewardDetailReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RewardDetailReplyValidationError) ErrorName() string {
+	return "RewardDetailReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RewardDetailReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRewardDetailReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RewardDetailReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RewardDetailReplyValidationError{}
+
+// Validate checks the field values on AdminRewardDetailRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AdminRewardDetailRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AdminRewardDetailRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AdminRewardDetailRequestMultiError, or nil if none found.
+func (m *AdminRewardDetailRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AdminRewardDetailRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	if len(errors) > 0 {
+		return AdminRewardDetailRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// AdminRewardDetailRequestMultiError is an error wrapping multiple validation
+// errors returned by AdminRewardDetailRequest.ValidateAll() if the designated
+// constraints aren't met.
+type AdminRewardDetailRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AdminRewardDetailRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AdminRewardDetailRequestMultiError) AllErrors() []error { return m }
+
+// AdminRewardDetailRequestValidationError is the validation error returned by
+// AdminRewardDetailRequest.Validate if the designated constraints aren't met.
+type AdminRewardDetailRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AdminRewardDetailRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AdminRewardDetailRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AdminRewardDetailRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AdminRewardDetailRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AdminRewardDetailRequestValidationError) ErrorName() string {
+	return "AdminRewardDetailRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AdminRewardDetailRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAdminRewardDetailRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AdminRewardDetailRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AdminRewardDetailRequestValidationError{}
+
+// Validate checks the field values on AdminRewardDetailReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AdminRewardDetailReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AdminRewardDetailReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AdminRewardDetailReplyMultiError, or nil if none found.
+func (m *AdminRewardDetailReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AdminRewardDetailReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for Address
+
+	// no validation rules for CreatedAt
+
+	// no validation rules for Amount
+
+	// no validation rules for Type
+
+	// no validation rules for Reason
+
+	// no validation rules for TypeRecordId
+
+	// no validation rules for LocationType
+
+	// no validation rules for Rule
+
+	// no validation rules for Level
+
+	// no validation rules for Source
+
+	// no validation rules for AdminId
+
+	// no validation rules for LocationId
+
+	// no validation rules for LocationRow
+
+	// no validation rules for LocationCol
+
+	// no validation rules for LocationStatus
+
+	// no validation rules for PayerAddress
+
+	// no validation rules for DepositHash
+
+	// no validation rules for DepositAmount
+
+	// no validation rules for DepositCreatedAt
+
+	// no validation rules for RewardLocationId
+
+	// no validation rules for RewardLocationStatus
+
+	for idx, item := range m.GetConfigs() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, AdminRewardDetailReplyValidationError{
+						field:  fmt.Sprintf("Configs[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, AdminRewardDetailReplyValidationError{
+						field:  fmt.Sprintf("Configs[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return AdminRewardDetailReplyValidationError{
+					field:  fmt.Sprintf("Configs[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return AdminRewardDetailReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// AdminRewardDetailReplyMultiError is an error wrapping multiple validation
+// errors returned by AdminRewardDetailReply.ValidateAll() if the designated
+// constraints aren't met.
+type AdminRewardDetailReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AdminRewardDetailReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AdminRewardDetailReplyMultiError) AllErrors() []error { return m }
+
+// AdminRewardDetailReplyValidationError is the validation error returned by
+// AdminRewardDetailReply.Validate if the designated constraints aren't met.
+type AdminRewardDetailReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AdminRewardDetailReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AdminRewardDetailReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AdminRewardDetailReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AdminRewardDetailReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AdminRewardDetailReplyValidationError) ErrorName() string {
+	return "AdminRewardDetailReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AdminRewardDetailReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAdminRewardDetailReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AdminRewardDetailReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AdminRewardDetailReplyValidationError{}
+
+// Validate checks the field values on AdminVipRecalcRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AdminVipRecalcRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AdminVipRecalcRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AdminVipRecalcRequestMultiError, or nil if none found.
+func (m *AdminVipRecalcRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AdminVipRecalcRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return AdminVipRecalcRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// AdminVipRecalcRequestMultiError is an error wrapping multiple validation
+// errors returned by AdminVipRecalcRequest.ValidateAll() if the designated
+// constraints aren't met.
+type AdminVipRecalcRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AdminVipRecalcRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AdminVipRecalcRequestMultiError) AllErrors() []error { return m }
+
+// AdminVipRecalcRequestValidationError is the validation error returned by
+// AdminVipRecalcRequest.Validate if the designated constraints aren't met.
+type AdminVipRecalcRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AdminVipRecalcRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AdminVipRecalcRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AdminVipRecalcRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AdminVipRecalcRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AdminVipRecalcRequestValidationError) ErrorName() string {
+	return "AdminVipRecalcRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AdminVipRecalcRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAdminVipRecalcRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AdminVipRecalcRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AdminVipRecalcRequestValidationError{}
+
+// Validate checks the field values on AdminVipRecalcReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AdminVipRecalcReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AdminVipRecalcReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AdminVipRecalcReplyMultiError, or nil if none found.
+func (m *AdminVipRecalcReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AdminVipRecalcReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Total
+
+	// no validation rules for Changed
+
+	if len(errors) > 0 {
+		return AdminVipRecalcReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// AdminVipRecalcReplyMultiError is an error wrapping multiple validation
+// errors returned by AdminVipRecalcReply.ValidateAll() if the designated
+// constraints aren't met.
+type AdminVipRecalcReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AdminVipRecalcReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AdminVipRecalcReplyMultiError) AllErrors() []error { return m }
+
+// AdminVipRecalcReplyValidationError is the validation error returned by
+// AdminVipRecalcReply.Validate if the designated constraints aren't met.
+type AdminVipRecalcReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AdminVipRecalcReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AdminVipRecalcReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AdminVipRecalcReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AdminVipRecalcReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AdminVipRecalcReplyValidationError) ErrorName() string {
+	return "AdminVipRecalcReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AdminVipRecalcReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAdminVipRecalcReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AdminVipRecalcReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AdminVipRecalcReplyValidationError{}
+
+// Validate checks the field values on AdminLockListRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AdminLockListRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AdminLockListRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AdminLockListRequestMultiError, or nil if none found.
+func (m *AdminLockListRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AdminLockListRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return AdminLockListRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// AdminLockListRequestMultiError is an error wrapping multiple validation
+// errors returned by AdminLockListRequest.ValidateAll() if the designated
+// constraints aren't met.
+type AdminLockListRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AdminLockListRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AdminLockListRequestMultiError) AllErrors() []error { return m }
+
+// AdminLockListRequestValidationError is the validation error returned by
+// AdminLockListRequest.Validate if the designated constraints aren't met.
+type AdminLockListRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AdminLockListRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AdminLockListRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AdminLockListRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AdminLockListRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AdminLockListRequestValidationError) ErrorName() string {
+	return "AdminLockListRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AdminLockListRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAdminLockListRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AdminLockListRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AdminLockListRequestValidationError{}
+
+// Validate checks the field values on AdminLockListReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AdminLockListReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AdminLockListReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AdminLockListReplyMultiError, or nil if none found.
+func (m *AdminLockListReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AdminLockListReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetLocks() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, AdminLockListReplyValidationError{
+						field:  fmt.Sprintf("Locks[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, AdminLockListReplyValidationError{
+						field:  fmt.Sprintf("Locks[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return AdminLockListReplyValidationError{
+					field:  fmt.Sprintf("Locks[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return AdminLockListReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// AdminLockListReplyMultiError is an error wrapping multiple validation errors
+// returned by AdminLockListReply.ValidateAll() if the designated constraints
+// aren't met.
+type AdminLockListReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AdminLockListReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AdminLockListReplyMultiError) AllErrors() []error { return m }
+
+// AdminLockListReplyValidationError is the validation error returned by
+// AdminLockListReply.Validate if the designated constraints aren't met.
+type AdminLockListReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AdminLockListReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AdminLockListReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AdminLockListReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AdminLockListReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AdminLockListReplyValidationError) ErrorName() string {
+	return "AdminLockListReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AdminLockListReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAdminLockListReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AdminLockListReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AdminLockListReplyValidationError{}
+
+// Validate checks the field values on ReinvestRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ReinvestRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ReinvestRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ReinvestRequestMultiError, or nil if none found.
+func (m *ReinvestRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ReinvestRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetSendBody()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ReinvestRequestValidationError{
+					field:  "SendBody",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ReinvestRequestValidationError{
+					field:  "SendBody",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSendBody()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ReinvestRequestValidationError{
+				field:  "SendBody",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return ReinvestRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ReinvestRequestMultiError is an error wrapping multiple validation errors
+// returned by ReinvestRequest.ValidateAll() if the designated constraints
+// aren't met.
+type ReinvestRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ReinvestRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ReinvestRequestMultiError) AllErrors() []error { return m }
+
+// ReinvestRequestValidationError is the validation error returned by
+// ReinvestRequest.Validate if the designated constraints aren't met.
+type ReinvestRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ReinvestRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ReinvestRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ReinvestRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ReinvestRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ReinvestRequestValidationError) ErrorName() string { return "ReinvestRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ReinvestRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sReinvestRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ReinvestRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ReinvestRequestValidationError{}
+
+// Validate checks the field values on ReinvestReply with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *ReinvestReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ReinvestReply with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in ReinvestReplyMultiError, or
+// nil if none found.
+func (m *ReinvestReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ReinvestReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Status
+
+	// no validation rules for Hash
+
+	if len(errors) > 0 {
+		return ReinvestReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// ReinvestReplyMultiError is an error wrapping multiple validation errors
+// returned by ReinvestReply.ValidateAll() if the designated constraints
+// aren't met.
+type ReinvestReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ReinvestReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ReinvestReplyMultiError) AllErrors() []error { return m }
+
+// ReinvestReplyValidationError is the validation error returned by
+// ReinvestReply.Validate if the designated constraints aren't met.
+type ReinvestReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ReinvestReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ReinvestReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ReinvestReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ReinvestReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ReinvestReplyValidationError) ErrorName() string { return "ReinvestReplyValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ReinvestReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sReinvestReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ReinvestReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ReinvestReplyValidationError{}
+
+// Validate checks the field values on LocationProgressRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *LocationProgressRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on LocationProgressRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// LocationProgressRequestMultiError, or nil if none found.
+func (m *LocationProgressRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *LocationProgressRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return LocationProgressRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// LocationProgressRequestMultiError is an error wrapping multiple validation
+// errors returned by LocationProgressRequest.ValidateAll() if the designated
+// constraints aren't met.
+type LocationProgressRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m LocationProgressRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m LocationProgressRequestMultiError) AllErrors() []error { return m }
+
+// LocationProgressRequestValidationError is the validation error returned by
+// LocationProgressRequest.Validate if the designated constraints aren't met.
+type LocationProgressRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e LocationProgressRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e LocationProgressRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e LocationProgressRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e LocationProgressRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e LocationProgressRequestValidationError) ErrorName() string {
+	return "LocationProgressRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e LocationProgressRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sLocationProgressRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = LocationProgressRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = LocationProgressRequestValidationError{}
+
+// Validate checks the field values on LocationProgressReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *LocationProgressReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on LocationProgressReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// LocationProgressReplyMultiError, or nil if none found.
+func (m *LocationProgressReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *LocationProgressReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetLocations() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, LocationProgressReplyValidationError{
+						field:  fmt.Sprintf("Locations[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, LocationProgressReplyValidationError{
+						field:  fmt.Sprintf("Locations[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return LocationProgressReplyValidationError{
+					field:  fmt.Sprintf("Locations[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for LastStopLocationId
+
+	// no validation rules for CanReenter
+
+	// no validation rules for ReenterRemaining
+
+	// no validation rules for ReenterEnd
+
+	// no validation rules for CarryOver
+
+	if len(errors) > 0 {
+		return LocationProgressReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// LocationProgressReplyMultiError is an error wrapping multiple validation
+// errors returned by LocationProgressReply.ValidateAll() if the designated
+// constraints aren't met.
+type LocationProgressReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m LocationProgressReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m LocationProgressReplyMultiError) AllErrors() []error { return m }
+
+// LocationProgressReplyValidationError is the validation error returned by
+// LocationProgressReply.Validate if the designated constraints aren't met.
+type LocationProgressReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e LocationProgressReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e LocationProgressReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e LocationProgressReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e LocationProgressReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e LocationProgressReplyValidationError) ErrorName() string {
+	return "LocationProgressReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e LocationProgressReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sLocationProgressReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = LocationProgressReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = LocationProgressReplyValidationError{}
+
+// Validate checks the field values on EthAuthorizeRequest_SendBody with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *EthAuthorizeRequest_SendBody) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on EthAuthorizeRequest_SendBody with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// EthAuthorizeRequest_SendBodyMultiError, or nil if none found.
+func (m *EthAuthorizeRequest_SendBody) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *EthAuthorizeRequest_SendBody) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Address
+
+	// no validation rules for Code
+
+	if len(errors) > 0 {
+		return EthAuthorizeRequest_SendBodyMultiError(errors)
+	}
+
+	return nil
+}
+
+// EthAuthorizeRequest_SendBodyMultiError is an error wrapping multiple
+// validation errors returned by EthAuthorizeRequest_SendBody.ValidateAll() if
+// the designated constraints aren't met.
+type EthAuthorizeRequest_SendBodyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m EthAuthorizeRequest_SendBodyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m EthAuthorizeRequest_SendBodyMultiError) AllErrors() []error { return m }
+
+// EthAuthorizeRequest_SendBodyValidationError is the validation error returned
+// by EthAuthorizeRequest_SendBody.Validate if the designated constraints
+// aren't met.
+type EthAuthorizeRequest_SendBodyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e EthAuthorizeRequest_SendBodyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e EthAuthorizeRequest_SendBodyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e EthAuthorizeRequest_SendBodyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e EthAuthorizeRequest_SendBodyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e EthAuthorizeRequest_SendBodyValidationError) ErrorName() string {
+	return "EthAuthorizeRequest_SendBodyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e EthAuthorizeRequest_SendBodyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sEthAuthorizeRequest_SendBody.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = EthAuthorizeRequest_SendBodyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = EthAuthorizeRequest_SendBodyValidationError{}
+
+// Validate checks the field values on RewardListReply_List with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RewardListReply_List) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RewardListReply_List with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RewardListReply_ListMultiError, or nil if none found.
+func (m *RewardListReply_List) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RewardListReply_List) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for CreatedAt
+
+	// no validation rules for Amount
+
+	// no validation rules for LocationStatus
+
+	// no validation rules for Type
+
+	// no validation rules for Id
+
+	if len(errors) > 0 {
+		return RewardListReply_ListMultiError(errors)
+	}
+
+	return nil
+}
+
+// RewardListReply_ListMultiError is an error wrapping multiple validation
+// errors returned by RewardListReply_List.ValidateAll() if the designated
+// constraints aren't met.
+type RewardListReply_ListMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RewardListReply_ListMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RewardListReply_ListMultiError) AllErrors() []error { return m }
+
+// RewardListReply_ListValidationError is the validation error returned by
+// RewardListReply_List.Validate if the designated constraints aren't met.
+type RewardListReply_ListValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RewardListReply_ListValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RewardListReply_ListValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RewardListReply_ListValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RewardListReply_ListValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RewardListReply_ListValidationError) ErrorName() string {
+	return "RewardListReply_ListValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RewardListReply_ListValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRewardListReply_List.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RewardListReply_ListValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RewardListReply_ListValidationError{}
+
+// Validate checks the field values on RecommendRewardListReply_List with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RecommendRewardListReply_List) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RecommendRewardListReply_List with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// RecommendRewardListReply_ListMultiError, or nil if none found.
+func (m *RecommendRewardListReply_List) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RecommendRewardListReply_List) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for CreatedAt
+
+	// no validation rules for Amount
+
+	// no validation rules for Type
+
+	// no validation rules for Reason
+
+	// no validation rules for Id
+
+	if len(errors) > 0 {
+		return RecommendRewardListReply_ListMultiError(errors)
+	}
+
+	return nil
+}
+
+// RecommendRewardListReply_ListMultiError is an error wrapping multiple
+// validation errors returned by RecommendRewardListReply_List.ValidateAll()
+// if the designated constraints aren't met.
+type RecommendRewardListReply_ListMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RecommendRewardListReply_ListMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RecommendRewardListReply_ListMultiError) AllErrors() []error { return m }
+
+// RecommendRewardListReply_ListValidationError is the validation error
+// returned by RecommendRewardListReply_List.Validate if the designated
+// constraints aren't met.
+type RecommendRewardListReply_ListValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RecommendRewardListReply_ListValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RecommendRewardListReply_ListValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RecommendRewardListReply_ListValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RecommendRewardListReply_ListValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RecommendRewardListReply_ListValidationError) ErrorName() string {
+	return "RecommendRewardListReply_ListValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RecommendRewardListReply_ListValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRecommendRewardListReply_List.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RecommendRewardListReply_ListValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RecommendRewardListReply_ListValidationError{}
+
+// Validate checks the field values on FeeRewardListReply_List with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *FeeRewardListReply_List) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on FeeRewardListReply_List with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// FeeRewardListReply_ListMultiError, or nil if none found.
+func (m *FeeRewardListReply_List) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *FeeRewardListReply_List) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for CreatedAt
+
+	// no validation rules for Amount
+
+	// no validation rules for Id
+
+	if len(errors) > 0 {
+		return FeeRewardListReply_ListMultiError(errors)
+	}
+
+	return nil
+}
+
+// FeeRewardListReply_ListMultiError is an error wrapping multiple validation
+// errors returned by FeeRewardListReply_List.ValidateAll() if the designated
+// constraints aren't met.
+type FeeRewardListReply_ListMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m FeeRewardListReply_ListMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m FeeRewardListReply_ListMultiError) AllErrors() []error { return m }
+
+// FeeRewardListReply_ListValidationError is the validation error returned by
+// FeeRewardListReply_List.Validate if the designated constraints aren't met.
+type FeeRewardListReply_ListValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e FeeRewardListReply_ListValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e FeeRewardListReply_ListValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e FeeRewardListReply_ListValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e FeeRewardListReply_ListValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e FeeRewardListReply_ListValidationError) ErrorName() string {
+	return "FeeRewardListReply_ListValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e FeeRewardListReply_ListValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sFeeRewardListReply_List.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = FeeRewardListReply_ListValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = FeeRewardListReply_ListValidationError{}
+
+// Validate checks the field values on WithdrawListReply_List with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *WithdrawListReply_List) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on WithdrawListReply_List with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// WithdrawListReply_ListMultiError, or nil if none found.
+func (m *WithdrawListReply_List) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *WithdrawListReply_List) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for CreatedAt
+
+	// no validation rules for Amount
+
+	// no validation rules for Type
+
+	// no validation rules for Status
+
+	// no validation rules for Reason
+
+	if len(errors) > 0 {
+		return WithdrawListReply_ListMultiError(errors)
+	}
+
+	return nil
+}
+
+// WithdrawListReply_ListMultiError is an error wrapping multiple validation
+// errors returned by WithdrawListReply_List.ValidateAll() if the designated
+// constraints aren't met.
+type WithdrawListReply_ListMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m WithdrawListReply_ListMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m WithdrawListReply_ListMultiError) AllErrors() []error { return m }
+
+// WithdrawListReply_ListValidationError is the validation error returned by
+// WithdrawListReply_List.Validate if the designated constraints aren't met.
+type WithdrawListReply_ListValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e WithdrawListReply_ListValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e WithdrawListReply_ListValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e WithdrawListReply_ListValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e WithdrawListReply_ListValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e WithdrawListReply_ListValidationError) ErrorName() string {
+	return "WithdrawListReply_ListValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e WithdrawListReply_ListValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sWithdrawListReply_List.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = WithdrawListReply_ListValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = WithdrawListReply_ListValidationError{}
+
+// Validate checks the field values on RecommendListReply_List with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RecommendListReply_List) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RecommendListReply_List with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RecommendListReply_ListMultiError, or nil if none found.
+func (m *RecommendListReply_List) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RecommendListReply_List) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Amount
+
+	// no validation rules for Status
+
+	if len(errors) > 0 {
+		return RecommendListReply_ListMultiError(errors)
+	}
+
+	return nil
+}
+
+// RecommendListReply_ListMultiError is an error wrapping multiple validation
+// errors returned by RecommendListReply_List.ValidateAll() if the designated
+// constraints aren't met.
+type RecommendListReply_ListMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RecommendListReply_ListMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RecommendListReply_ListMultiError) AllErrors() []error { return m }
+
+// RecommendListReply_ListValidationError is the validation error returned by
+// RecommendListReply_List.Validate if the designated constraints aren't met.
+type RecommendListReply_ListValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RecommendListReply_ListValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RecommendListReply_ListValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RecommendListReply_ListValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RecommendListReply_ListValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RecommendListReply_ListValidationError) ErrorName() string {
+	return "RecommendListReply_ListValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RecommendListReply_ListValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRecommendListReply_List.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RecommendListReply_ListValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RecommendListReply_ListValidationError{}
+
+// Validate checks the field values on WithdrawRequest_SendBody with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *WithdrawRequest_SendBody) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on WithdrawRequest_SendBody with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// WithdrawRequest_SendBodyMultiError, or nil if none found.
+func (m *WithdrawRequest_SendBody) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *WithdrawRequest_SendBody) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Type
+
+	// no validation rules for Amount
+
+	// no validation rules for IdempotencyKey
+
+	if len(errors) > 0 {
+		return WithdrawRequest_SendBodyMultiError(errors)
+	}
+
+	return nil
+}
+
+// WithdrawRequest_SendBodyMultiError is an error wrapping multiple validation
+// errors returned by WithdrawRequest_SendBody.ValidateAll() if the designated
+// constraints aren't met.
+type WithdrawRequest_SendBodyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m WithdrawRequest_SendBodyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m WithdrawRequest_SendBodyMultiError) AllErrors() []error { return m }
+
+// WithdrawRequest_SendBodyValidationError is the validation error returned by
+// WithdrawRequest_SendBody.Validate if the designated constraints aren't met.
+type WithdrawRequest_SendBodyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e WithdrawRequest_SendBodyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e WithdrawRequest_SendBodyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e WithdrawRequest_SendBodyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e WithdrawRequest_SendBodyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e WithdrawRequest_SendBodyValidationError) ErrorName() string {
+	return "WithdrawRequest_SendBodyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e WithdrawRequest_SendBodyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sWithdrawRequest_SendBody.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = WithdrawRequest_SendBodyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = WithdrawRequest_SendBodyValidationError{}
+
+// Validate checks the field values on WithdrawCancelRequest_SendBody with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *WithdrawCancelRequest_SendBody) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on WithdrawCancelRequest_SendBody with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// WithdrawCancelRequest_SendBodyMultiError, or nil if none found.
+func (m *WithdrawCancelRequest_SendBody) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *WithdrawCancelRequest_SendBody) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	if len(errors) > 0 {
+		return WithdrawCancelRequest_SendBodyMultiError(errors)
+	}
+
+	return nil
+}
+
+// WithdrawCancelRequest_SendBodyMultiError is an error wrapping multiple
+// validation errors returned by WithdrawCancelRequest_SendBody.ValidateAll()
+// if the designated constraints aren't met.
+type WithdrawCancelRequest_SendBodyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m WithdrawCancelRequest_SendBodyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m WithdrawCancelRequest_SendBodyMultiError) AllErrors() []error { return m }
+
+// WithdrawCancelRequest_SendBodyValidationError is the validation error
+// returned by WithdrawCancelRequest_SendBody.Validate if the designated
+// constraints aren't met.
+type WithdrawCancelRequest_SendBodyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e WithdrawCancelRequest_SendBodyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e WithdrawCancelRequest_SendBodyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e WithdrawCancelRequest_SendBodyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e WithdrawCancelRequest_SendBodyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e WithdrawCancelRequest_SendBodyValidationError) ErrorName() string {
+	return "WithdrawCancelRequest_SendBodyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e WithdrawCancelRequest_SendBodyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sWithdrawCancelRequest_SendBody.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = WithdrawCancelRequest_SendBodyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = WithdrawCancelRequest_SendBodyValidationError{}
+
+// Validate checks the field values on AdminRewardListReply_List with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AdminRewardListReply_List) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AdminRewardListReply_List with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AdminRewardListReply_ListMultiError, or nil if none found.
+func (m *AdminRewardListReply_List) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AdminRewardListReply_List) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for CreatedAt
+
+	// no validation rules for Amount
+
+	// no validation rules for Type
+
+	// no validation rules for Address
+
+	// no validation rules for Reason
+
+	// no validation rules for Id
+
+	if len(errors) > 0 {
+		return AdminRewardListReply_ListMultiError(errors)
+	}
+
+	return nil
+}
+
+// AdminRewardListReply_ListMultiError is an error wrapping multiple validation
+// errors returned by AdminRewardListReply_List.ValidateAll() if the
+// designated constraints aren't met.
+type AdminRewardListReply_ListMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AdminRewardListReply_ListMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AdminRewardListReply_ListMultiError) AllErrors() []error { return m }
+
+// AdminRewardListReply_ListValidationError is the validation error returned by
+// AdminRewardListReply_List.Validate if the designated constraints aren't met.
+type AdminRewardListReply_ListValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AdminRewardListReply_ListValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AdminRewardListReply_ListValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AdminRewardListReply_ListValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AdminRewardListReply_ListValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AdminRewardListReply_ListValidationError) ErrorName() string {
+	return "AdminRewardListReply_ListValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AdminRewardListReply_ListValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAdminRewardListReply_List.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AdminRewardListReply_ListValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AdminRewardListReply_ListValidationError{}
+
+// Validate checks the field values on AdminRewardBnbListReply_List with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AdminRewardBnbListReply_List) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AdminRewardBnbListReply_List with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AdminRewardBnbListReply_ListMultiError, or nil if none found.
+func (m *AdminRewardBnbListReply_List) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AdminRewardBnbListReply_List) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for CreatedAt
+
+	// no validation rules for Amount
+
+	// no validation rules for BalanceAll
+
+	// no validation rules for Address
+
+	if len(errors) > 0 {
+		return AdminRewardBnbListReply_ListMultiError(errors)
+	}
+
+	return nil
+}
+
+// AdminRewardBnbListReply_ListMultiError is an error wrapping multiple
+// validation errors returned by AdminRewardBnbListReply_List.ValidateAll() if
+// the designated constraints aren't met.
+type AdminRewardBnbListReply_ListMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AdminRewardBnbListReply_ListMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AdminRewardBnbListReply_ListMultiError) AllErrors() []error { return m }
+
+// AdminRewardBnbListReply_ListValidationError is the validation error returned
+// by AdminRewardBnbListReply_List.Validate if the designated constraints
+// aren't met.
+type AdminRewardBnbListReply_ListValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AdminRewardBnbListReply_ListValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AdminRewardBnbListReply_ListValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AdminRewardBnbListReply_ListValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AdminRewardBnbListReply_ListValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AdminRewardBnbListReply_ListValidationError) ErrorName() string {
+	return "AdminRewardBnbListReply_ListValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AdminRewardBnbListReply_ListValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAdminRewardBnbListReply_List.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AdminRewardBnbListReply_ListValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AdminRewardBnbListReply_ListValidationError{}
+
+// Validate checks the field values on AdminUserListReply_UserList with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AdminUserListReply_UserList) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AdminUserListReply_UserList with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AdminUserListReply_UserListMultiError, or nil if none found.
+func (m *AdminUserListReply_UserList) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AdminUserListReply_UserList) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for UserId
+
+	// no validation rules for CreatedAt
+
+	// no validation rules for Address
+
+	// no validation rules for BalanceUsdt
+
+	// no validation rules for BalanceDhb
+
+	// no validation rules for Vip
+
+	// no validation rules for MonthRecommend
+
+	// no validation rules for HistoryRecommend
+
+	// no validation rules for AreaAmount
+
+	// no validation rules for AreaMaxAmount
+
+	// no validation rules for BnbAmount
+
+	// no validation rules for BnbBalance
+
+	// no validation rules for VipCompute
+
+	// no validation rules for VipAdmin
+
+	if len(errors) > 0 {
+		return AdminUserListReply_UserListMultiError(errors)
+	}
+
+	return nil
+}
+
+// AdminUserListReply_UserListMultiError is an error wrapping multiple
+// validation errors returned by AdminUserListReply_UserList.ValidateAll() if
+// the designated constraints aren't met.
+type AdminUserListReply_UserListMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AdminUserListReply_UserListMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AdminUserListReply_UserListMultiError) AllErrors() []error { return m }
+
+// AdminUserListReply_UserListValidationError is the validation error returned
+// by AdminUserListReply_UserList.Validate if the designated constraints
+// aren't met.
+type AdminUserListReply_UserListValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AdminUserListReply_UserListValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AdminUserListReply_UserListValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AdminUserListReply_UserListValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AdminUserListReply_UserListValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AdminUserListReply_UserListValidationError) ErrorName() string {
+	return "AdminUserListReply_UserListValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AdminUserListReply_UserListValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAdminUserListReply_UserList.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AdminUserListReply_UserListValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AdminUserListReply_UserListValidationError{}
+
+// Validate checks the field values on AdminLocationListReply_LocationList with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *AdminLocationListReply_LocationList) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AdminLocationListReply_LocationList
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// AdminLocationListReply_LocationListMultiError, or nil if none found.
+func (m *AdminLocationListReply_LocationList) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AdminLocationListReply_LocationList) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for CreatedAt
+
+	// no validation rules for Address
+
+	// no validation rules for Row
+
+	// no validation rules for Col
+
+	// no validation rules for Status
+
+	// no validation rules for CurrentLevel
+
+	// no validation rules for Current
+
+	// no validation rules for CurrentMax
+
+	if len(errors) > 0 {
+		return AdminLocationListReply_LocationListMultiError(errors)
+	}
+
+	return nil
+}
+
+// AdminLocationListReply_LocationListMultiError is an error wrapping multiple
+// validation errors returned by
+// AdminLocationListReply_LocationList.ValidateAll() if the designated
+// constraints aren't met.
+type AdminLocationListReply_LocationListMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AdminLocationListReply_LocationListMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AdminLocationListReply_LocationListMultiError) AllErrors() []error { return m }
+
+// AdminLocationListReply_LocationListValidationError is the validation error
+// returned by AdminLocationListReply_LocationList.Validate if the designated
+// constraints aren't met.
+type AdminLocationListReply_LocationListValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AdminLocationListReply_LocationListValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AdminLocationListReply_LocationListValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AdminLocationListReply_LocationListValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AdminLocationListReply_LocationListValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AdminLocationListReply_LocationListValidationError) ErrorName() string {
+	return "AdminLocationListReply_LocationListValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AdminLocationListReply_LocationListValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAdminLocationListReply_LocationList.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AdminLocationListReply_LocationListValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AdminLocationListReply_LocationListValidationError{}
+
+// Validate checks the field values on AdminLocationAllListReply_LocationList
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the first error encountered is returned, or nil if
+// there are no violations.
+func (m *AdminLocationAllListReply_LocationList) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on
+// AdminLocationAllListReply_LocationList with the rules defined in the proto
+// definition for this message. If any rules are violated, the result is a
+// list of violation errors wrapped in
+// AdminLocationAllListReply_LocationListMultiError, or nil if none found.
+func (m *AdminLocationAllListReply_LocationList) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AdminLocationAllListReply_LocationList) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for CreatedAt
+
+	// no validation rules for Address
+
+	// no validation rules for Row
+
+	// no validation rules for Col
+
+	// no validation rules for Status
+
+	// no validation rules for CurrentLevel
+
+	// no validation rules for Current
+
+	// no validation rules for CurrentMax
+
+	if len(errors) > 0 {
+		return AdminLocationAllListReply_LocationListMultiError(errors)
+	}
+
+	return nil
+}
+
+// AdminLocationAllListReply_LocationListMultiError is an error wrapping
+// multiple validation errors returned by
+// AdminLocationAllListReply_LocationList.ValidateAll() if the designated
+// constraints aren't met.
+type AdminLocationAllListReply_LocationListMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AdminLocationAllListReply_LocationListMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AdminLocationAllListReply_LocationListMultiError) AllErrors() []error { return m }
+
+// AdminLocationAllListReply_LocationListValidationError is the validation
+// error returned by AdminLocationAllListReply_LocationList.Validate if the
+// designated constraints aren't met.
+type AdminLocationAllListReply_LocationListValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AdminLocationAllListReply_LocationListValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AdminLocationAllListReply_LocationListValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AdminLocationAllListReply_LocationListValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AdminLocationAllListReply_LocationListValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AdminLocationAllListReply_LocationListValidationError) ErrorName() string {
+	return "AdminLocationAllListReply_LocationListValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AdminLocationAllListReply_LocationListValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAdminLocationAllListReply_LocationList.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AdminLocationAllListReply_LocationListValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AdminLocationAllListReply_LocationListValidationError{}
+
+// Validate checks the field values on AdminWithdrawListReply_List with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AdminWithdrawListReply_List) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AdminWithdrawListReply_List with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AdminWithdrawListReply_ListMultiError, or nil if none found.
+func (m *AdminWithdrawListReply_List) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AdminWithdrawListReply_List) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Address
+
+	// no validation rules for Id
+
+	// no validation rules for CreatedAt
+
+	// no validation rules for Amount
+
+	// no validation rules for RelAmount
+
+	// no validation rules for Type
+
+	// no validation rules for Status
+
+	// no validation rules for Reason
+
+	if len(errors) > 0 {
+		return AdminWithdrawListReply_ListMultiError(errors)
+	}
+
+	return nil
+}
+
+// AdminWithdrawListReply_ListMultiError is an error wrapping multiple
+// validation errors returned by AdminWithdrawListReply_List.ValidateAll() if
+// the designated constraints aren't met.
+type AdminWithdrawListReply_ListMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AdminWithdrawListReply_ListMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AdminWithdrawListReply_ListMultiError) AllErrors() []error { return m }
+
+// AdminWithdrawListReply_ListValidationError is the validation error returned
+// by AdminWithdrawListReply_List.Validate if the designated constraints
+// aren't met.
+type AdminWithdrawListReply_ListValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AdminWithdrawListReply_ListValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AdminWithdrawListReply_ListValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AdminWithdrawListReply_ListValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AdminWithdrawListReply_ListValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AdminWithdrawListReply_ListValidationError) ErrorName() string {
+	return "AdminWithdrawListReply_ListValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AdminWithdrawListReply_ListValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAdminWithdrawListReply_List.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AdminWithdrawListReply_ListValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AdminWithdrawListReply_ListValidationError{}
+
+// Validate checks the field values on AdminUserRecommendReply_List with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AdminUserRecommendReply_List) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AdminUserRecommendReply_List with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AdminUserRecommendReply_ListMultiError, or nil if none found.
+func (m *AdminUserRecommendReply_List) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AdminUserRecommendReply_List) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for UserId
+
+	// no validation rules for Address
+
+	// no validation rules for Id
+
+	// no validation rules for CreatedAt
+
+	if len(errors) > 0 {
+		return AdminUserRecommendReply_ListMultiError(errors)
+	}
+
+	return nil
+}
+
+// AdminUserRecommendReply_ListMultiError is an error wrapping multiple
+// validation errors returned by AdminUserRecommendReply_List.ValidateAll() if
+// the designated constraints aren't met.
+type AdminUserRecommendReply_ListMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AdminUserRecommendReply_ListMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AdminUserRecommendReply_ListMultiError) AllErrors() []error { return m }
+
+// AdminUserRecommendReply_ListValidationError is the validation error returned
+// by AdminUserRecommendReply_List.Validate if the designated constraints
+// aren't met.
+type AdminUserRecommendReply_ListValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AdminUserRecommendReply_ListValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AdminUserRecommendReply_ListValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AdminUserRecommendReply_ListValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AdminUserRecommendReply_ListValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AdminUserRecommendReply_ListValidationError) ErrorName() string {
+	return "AdminUserRecommendReply_ListValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AdminUserRecommendReply_ListValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAdminUserRecommendReply_List.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AdminUserRecommendReply_ListValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AdminUserRecommendReply_ListValidationError{}
+
+// Validate checks the field values on AdminMonthRecommendReply_List with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AdminMonthRecommendReply_List) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AdminMonthRecommendReply_List with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// AdminMonthRecommendReply_ListMultiError, or nil if none found.
+func (m *AdminMonthRecommendReply_List) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AdminMonthRecommendReply_List) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Address
+
+	// no validation rules for RecommendAddress
+
+	// no validation rules for Id
+
+	// no validation rules for CreatedAt
+
+	if len(errors) > 0 {
+		return AdminMonthRecommendReply_ListMultiError(errors)
+	}
+
+	return nil
+}
+
+// AdminMonthRecommendReply_ListMultiError is an error wrapping multiple
+// validation errors returned by AdminMonthRecommendReply_List.ValidateAll()
+// if the designated constraints aren't met.
+type AdminMonthRecommendReply_ListMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AdminMonthRecommendReply_ListMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AdminMonthRecommendReply_ListMultiError) AllErrors() []error { return m }
+
+// AdminMonthRecommendReply_ListValidationError is the validation error
+// returned by AdminMonthRecommendReply_List.Validate if the designated
+// constraints aren't met.
+type AdminMonthRecommendReply_ListValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AdminMonthRecommendReply_ListValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AdminMonthRecommendReply_ListValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AdminMonthRecommendReply_ListValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AdminMonthRecommendReply_ListValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AdminMonthRecommendReply_ListValidationError) ErrorName() string {
+	return "AdminMonthRecommendReply_ListValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AdminMonthRecommendReply_ListValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAdminMonthRecommendReply_List.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AdminMonthRecommendReply_ListValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AdminMonthRecommendReply_ListValidationError{}
+
+// Validate checks the field values on AdminConfigReply_List with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AdminConfigReply_List) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AdminConfigReply_List with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AdminConfigReply_ListMultiError, or nil if none found.
+func (m *AdminConfigReply_List) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AdminConfigReply_List) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for Name
+
+	// no validation rules for Value
+
+	if len(errors) > 0 {
+		return AdminConfigReply_ListMultiError(errors)
+	}
+
+	return nil
+}
+
+// AdminConfigReply_ListMultiError is an error wrapping multiple validation
+// errors returned by AdminConfigReply_List.ValidateAll() if the designated
+// constraints aren't met.
+type AdminConfigReply_ListMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AdminConfigReply_ListMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AdminConfigReply_ListMultiError) AllErrors() []error { return m }
+
+// AdminConfigReply_ListValidationError is the validation error returned by
+// AdminConfigReply_List.Validate if the designated constraints aren't met.
+type AdminConfigReply_ListValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AdminConfigReply_ListValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AdminConfigReply_ListValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AdminConfigReply_ListValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AdminConfigReply_ListValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AdminConfigReply_ListValidationError) ErrorName() string {
+	return "AdminConfigReply_ListValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AdminConfigReply_ListValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAdminConfigReply_List.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AdminConfigReply_ListValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AdminConfigReply_ListValidationError{}
+
+// Validate checks the field values on AdminListReply_List with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AdminListReply_List) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AdminListReply_List with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AdminListReply_ListMultiError, or nil if none found.
+func (m *AdminListReply_List) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AdminListReply_List) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for Account
+
+	if len(errors) > 0 {
+		return AdminListReply_ListMultiError(errors)
+	}
+
+	return nil
+}
+
+// AdminListReply_ListMultiError is an error wrapping multiple validation
+// errors returned by AdminListReply_List.ValidateAll() if the designated
+// constraints aren't met.
+type AdminListReply_ListMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AdminListReply_ListMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AdminListReply_ListMultiError) AllErrors() []error { return m }
+
+// AdminListReply_ListValidationError is the validation error returned by
+// AdminListReply_List.Validate if the designated constraints aren't met.
+type AdminListReply_ListValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AdminListReply_ListValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AdminListReply_ListValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AdminListReply_ListValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AdminListReply_ListValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AdminListReply_ListValidationError) ErrorName() string {
+	return "AdminListReply_ListValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AdminListReply_ListValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAdminListReply_List.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AdminListReply_ListValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AdminListReply_ListValidationError{}
+
+// Validate checks the field values on AuthListReply_List with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AuthListReply_List) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AuthListReply_List with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AuthListReply_ListMultiError, or nil if none found.
+func (m *AuthListReply_List) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AuthListReply_List) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for Name
+
+	// no validation rules for Path
+
+	if len(errors) > 0 {
+		return AuthListReply_ListMultiError(errors)
+	}
+
+	return nil
+}
+
+// AuthListReply_ListMultiError is an error wrapping multiple validation errors
+// returned by AuthListReply_List.ValidateAll() if the designated constraints
+// aren't met.
+type AuthListReply_ListMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AuthListReply_ListMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AuthListReply_ListMultiError) AllErrors() []error { return m }
+
+// AuthListReply_ListValidationError is the validation error returned by
+// AuthListReply_List.Validate if the designated constraints aren't met.
+type AuthListReply_ListValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AuthListReply_ListValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AuthListReply_ListValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AuthListReply_ListValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AuthListReply_ListValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AuthListReply_ListValidationError) ErrorName() string {
+	return "AuthListReply_ListValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AuthListReply_ListValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAuthListReply_List.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AuthListReply_ListValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AuthListReply_ListValidationError{}
+
+// Validate checks the field values on UserAuthListReply_List with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UserAuthListReply_List) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UserAuthListReply_List with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UserAuthListReply_ListMultiError, or nil if none found.
+func (m *UserAuthListReply_List) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UserAuthListReply_List) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for Name
+
+	// no validation rules for Path
+
+	if len(errors) > 0 {
+		return UserAuthListReply_ListMultiError(errors)
+	}
+
+	return nil
+}
+
+// UserAuthListReply_ListMultiError is an error wrapping multiple validation
+// errors returned by UserAuthListReply_List.ValidateAll() if the designated
+// constraints aren't met.
+type UserAuthListReply_ListMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UserAuthListReply_ListMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UserAuthListReply_ListMultiError) AllErrors() []error { return m }
+
+// UserAuthListReply_ListValidationError is the validation error returned by
+// UserAuthListReply_List.Validate if the designated constraints aren't met.
+type UserAuthListReply_ListValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UserAuthListReply_ListValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UserAuthListReply_ListValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UserAuthListReply_ListValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UserAuthListReply_ListValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UserAuthListReply_ListValidationError) ErrorName() string {
+	return "UserAuthListReply_ListValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UserAuthListReply_ListValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUserAuthListReply_List.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UserAuthListReply_ListValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UserAuthListReply_ListValidationError{}
+
+// Validate checks the field values on MyAuthListReply_List with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *MyAuthListReply_List) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on MyAuthListReply_List with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// MyAuthListReply_ListMultiError, or nil if none found.
+func (m *MyAuthListReply_List) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *MyAuthListReply_List) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for Name
+
+	// no validation rules for Path
+
+	if len(errors) > 0 {
+		return MyAuthListReply_ListMultiError(errors)
+	}
+
+	return nil
+}
+
+// MyAuthListReply_ListMultiError is an error wrapping multiple validation
+// errors returned by MyAuthListReply_List.ValidateAll() if the designated
+// constraints aren't met.
+type MyAuthListReply_ListMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m MyAuthListReply_ListMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m MyAuthListReply_ListMultiError) AllErrors() []error { return m }
+
+// MyAuthListReply_ListValidationError is the validation error returned by
+// MyAuthListReply_List.Validate if the designated constraints aren't met.
+type MyAuthListReply_ListValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e MyAuthListReply_ListValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e MyAuthListReply_ListValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e MyAuthListReply_ListValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e MyAuthListReply_ListValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e MyAuthListReply_ListValidationError) ErrorName() string {
+	return "MyAuthListReply_ListValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e MyAuthListReply_ListValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sMyAuthListReply_List.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = MyAuthListReply_ListValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = MyAuthListReply_ListValidationError{}
+
+// Validate checks the field values on AdminConfigUpdateRequest_SendBody with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *AdminConfigUpdateRequest_SendBody) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AdminConfigUpdateRequest_SendBody
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// AdminConfigUpdateRequest_SendBodyMultiError, or nil if none found.
+func (m *AdminConfigUpdateRequest_SendBody) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AdminConfigUpdateRequest_SendBody) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for Value
+
+	if len(errors) > 0 {
+		return AdminConfigUpdateRequest_SendBodyMultiError(errors)
+	}
+
+	return nil
+}
+
+// AdminConfigUpdateRequest_SendBodyMultiError is an error wrapping multiple
+// validation errors returned by
+// AdminConfigUpdateRequest_SendBody.ValidateAll() if the designated
+// constraints aren't met.
+type AdminConfigUpdateRequest_SendBodyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AdminConfigUpdateRequest_SendBodyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AdminConfigUpdateRequest_SendBodyMultiError) AllErrors() []error { return m }
+
+// AdminConfigUpdateRequest_SendBodyValidationError is the validation error
+// returned by AdminConfigUpdateRequest_SendBody.Validate if the designated
+// constraints aren't met.
+type AdminConfigUpdateRequest_SendBodyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AdminConfigUpdateRequest_SendBodyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AdminConfigUpdateRequest_SendBodyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AdminConfigUpdateRequest_SendBodyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AdminConfigUpdateRequest_SendBodyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AdminConfigUpdateRequest_SendBodyValidationError) ErrorName() string {
+	return "AdminConfigUpdateRequest_SendBodyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AdminConfigUpdateRequest_SendBodyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAdminConfigUpdateRequest_SendBody.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AdminConfigUpdateRequest_SendBodyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AdminConfigUpdateRequest_SendBodyValidationError{}
+
+// Validate checks the field values on AdminVipUpdateRequest_SendBody with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AdminVipUpdateRequest_SendBody) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AdminVipUpdateRequest_SendBody with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// AdminVipUpdateRequest_SendBodyMultiError, or nil if none found.
+func (m *AdminVipUpdateRequest_SendBody) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AdminVipUpdateRequest_SendBody) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for UserId
+
+	// no validation rules for Vip
+
+	if len(errors) > 0 {
+		return AdminVipUpdateRequest_SendBodyMultiError(errors)
+	}
+
+	return nil
+}
+
+// AdminVipUpdateRequest_SendBodyMultiError is an error wrapping multiple
+// validation errors returned by AdminVipUpdateRequest_SendBody.ValidateAll()
+// if the designated constraints aren't met.
+type AdminVipUpdateRequest_SendBodyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AdminVipUpdateRequest_SendBodyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AdminVipUpdateRequest_SendBodyMultiError) AllErrors() []error { return m }
+
+// AdminVipUpdateRequest_SendBodyValidationError is the validation error
+// returned by AdminVipUpdateRequest_SendBody.Validate if the designated
+// constraints aren't met.
+type AdminVipUpdateRequest_SendBodyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AdminVipUpdateRequest_SendBodyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AdminVipUpdateRequest_SendBodyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AdminVipUpdateRequest_SendBodyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AdminVipUpdateRequest_SendBodyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AdminVipUpdateRequest_SendBodyValidationError) ErrorName() string {
+	return "AdminVipUpdateRequest_SendBodyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AdminVipUpdateRequest_SendBodyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAdminVipUpdateRequest_SendBody.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AdminVipUpdateRequest_SendBodyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AdminVipUpdateRequest_SendBodyValidationError{}
+
+// Validate checks the field values on AdminUndoUpdateRequest_SendBody with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AdminUndoUpdateRequest_SendBody) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AdminUndoUpdateRequest_SendBody with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// AdminUndoUpdateRequest_SendBodyMultiError, or nil if none found.
+func (m *AdminUndoUpdateRequest_SendBody) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AdminUndoUpdateRequest_SendBody) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for UserId
+
+	// no validation rules for Undo
+
+	if len(errors) > 0 {
+		return AdminUndoUpdateRequest_SendBodyMultiError(errors)
+	}
+
+	return nil
+}
+
+// AdminUndoUpdateRequest_SendBodyMultiError is an error wrapping multiple
+// validation errors returned by AdminUndoUpdateRequest_SendBody.ValidateAll()
+// if the designated constraints aren't met.
+type AdminUndoUpdateRequest_SendBodyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AdminUndoUpdateRequest_SendBodyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AdminUndoUpdateRequest_SendBodyMultiError) AllErrors() []error { return m }
+
+// AdminUndoUpdateRequest_SendBodyValidationError is the validation error
+// returned by AdminUndoUpdateRequest_SendBody.Validate if the designated
+// constraints aren't met.
+type AdminUndoUpdateRequest_SendBodyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AdminUndoUpdateRequest_SendBodyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AdminUndoUpdateRequest_SendBodyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AdminUndoUpdateRequest_SendBodyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AdminUndoUpdateRequest_SendBodyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AdminUndoUpdateRequest_SendBodyValidationError) ErrorName() string {
+	return "AdminUndoUpdateRequest_SendBodyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AdminUndoUpdateRequest_SendBodyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAdminUndoUpdateRequest_SendBody.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AdminUndoUpdateRequest_SendBodyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AdminUndoUpdateRequest_SendBodyValidationError{}
+
+// Validate checks the field values on AdminAreaLevelUpdateRequest_SendBody
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the first error encountered is returned, or nil if
+// there are no violations.
+func (m *AdminAreaLevelUpdateRequest_SendBody) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AdminAreaLevelUpdateRequest_SendBody
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// AdminAreaLevelUpdateRequest_SendBodyMultiError, or nil if none found.
+func (m *AdminAreaLevelUpdateRequest_SendBody) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AdminAreaLevelUpdateRequest_SendBody) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for UserId
+
+	// no validation rules for Level
+
+	if len(errors) > 0 {
+		return AdminAreaLevelUpdateRequest_SendBodyMultiError(errors)
+	}
+
+	return nil
+}
+
+// AdminAreaLevelUpdateRequest_SendBodyMultiError is an error wrapping multiple
+// validation errors returned by
+// AdminAreaLevelUpdateRequest_SendBody.ValidateAll() if the designated
+// constraints aren't met.
+type AdminAreaLevelUpdateRequest_SendBodyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AdminAreaLevelUpdateRequest_SendBodyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AdminAreaLevelUpdateRequest_SendBodyMultiError) AllErrors() []error { return m }
+
+// AdminAreaLevelUpdateRequest_SendBodyValidationError is the validation error
+// returned by AdminAreaLevelUpdateRequest_SendBody.Validate if the designated
+// constraints aren't met.
+type AdminAreaLevelUpdateRequest_SendBodyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AdminAreaLevelUpdateRequest_SendBodyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AdminAreaLevelUpdateRequest_SendBodyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AdminAreaLevelUpdateRequest_SendBodyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AdminAreaLevelUpdateRequest_SendBodyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AdminAreaLevelUpdateRequest_SendBodyValidationError) ErrorName() string {
+	return "AdminAreaLevelUpdateRequest_SendBodyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AdminAreaLevelUpdateRequest_SendBodyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAdminAreaLevelUpdateRequest_SendBody.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AdminAreaLevelUpdateRequest_SendBodyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AdminAreaLevelUpdateRequest_SendBodyValidationError{}
+
+// Validate checks the field values on AdminLocationInsertRequest_SendBody with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *AdminLocationInsertRequest_SendBody) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AdminLocationInsertRequest_SendBody
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// AdminLocationInsertRequest_SendBodyMultiError, or nil if none found.
+func (m *AdminLocationInsertRequest_SendBody) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AdminLocationInsertRequest_SendBody) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for UserId
+
+	// no validation rules for Amount
+
+	if len(errors) > 0 {
+		return AdminLocationInsertRequest_SendBodyMultiError(errors)
+	}
+
+	return nil
+}
+
+// AdminLocationInsertRequest_SendBodyMultiError is an error wrapping multiple
+// validation errors returned by
+// AdminLocationInsertRequest_SendBody.ValidateAll() if the designated
+// constraints aren't met.
+type AdminLocationInsertRequest_SendBodyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AdminLocationInsertRequest_SendBodyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AdminLocationInsertRequest_SendBodyMultiError) AllErrors() []error { return m }
+
+// AdminLocationInsertRequest_SendBodyValidationError is the validation error
+// returned by AdminLocationInsertRequest_SendBody.Validate if the designated
+// constraints aren't met.
+type AdminLocationInsertRequest_SendBodyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AdminLocationInsertRequest_SendBodyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AdminLocationInsertRequest_SendBodyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AdminLocationInsertRequest_SendBodyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AdminLocationInsertRequest_SendBodyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AdminLocationInsertRequest_SendBodyValidationError) ErrorName() string {
+	return "AdminLocationInsertRequest_SendBodyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AdminLocationInsertRequest_SendBodyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAdminLocationInsertRequest_SendBody.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AdminLocationInsertRequest_SendBodyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AdminLocationInsertRequest_SendBodyValidationError{}
+
+// Validate checks the field values on AdminBalanceUpdateRequest_SendBody with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *AdminBalanceUpdateRequest_SendBody) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AdminBalanceUpdateRequest_SendBody
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// AdminBalanceUpdateRequest_SendBodyMultiError, or nil if none found.
+func (m *AdminBalanceUpdateRequest_SendBody) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AdminBalanceUpdateRequest_SendBody) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for UserId
+
+	// no validation rules for Amount
+
+	// no validation rules for CoinType
+
+	// no validation rules for ReasonCode
+
+	// no validation rules for Note
+
+	if len(errors) > 0 {
+		return AdminBalanceUpdateRequest_SendBodyMultiError(errors)
+	}
+
+	return nil
+}
+
+// AdminBalanceUpdateRequest_SendBodyMultiError is an error wrapping multiple
+// validation errors returned by
+// AdminBalanceUpdateRequest_SendBody.ValidateAll() if the designated
+// constraints aren't met.
+type AdminBalanceUpdateRequest_SendBodyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AdminBalanceUpdateRequest_SendBodyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AdminBalanceUpdateRequest_SendBodyMultiError) AllErrors() []error { return m }
+
+// AdminBalanceUpdateRequest_SendBodyValidationError is the validation error
+// returned by AdminBalanceUpdateRequest_SendBody.Validate if the designated
+// constraints aren't met.
+type AdminBalanceUpdateRequest_SendBodyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AdminBalanceUpdateRequest_SendBodyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AdminBalanceUpdateRequest_SendBodyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AdminBalanceUpdateRequest_SendBodyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AdminBalanceUpdateRequest_SendBodyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AdminBalanceUpdateRequest_SendBodyValidationError) ErrorName() string {
+	return "AdminBalanceUpdateRequest_SendBodyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AdminBalanceUpdateRequest_SendBodyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAdminBalanceUpdateRequest_SendBody.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AdminBalanceUpdateRequest_SendBodyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AdminBalanceUpdateRequest_SendBodyValidationError{}
+
+// Validate checks the field values on AuthAdminCreateRequest_SendBody with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AuthAdminCreateRequest_SendBody) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AuthAdminCreateRequest_SendBody with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// AuthAdminCreateRequest_SendBodyMultiError, or nil if none found.
+func (m *AuthAdminCreateRequest_SendBody) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AuthAdminCreateRequest_SendBody) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for AdminId
+
+	// no validation rules for AuthId
+
+	if len(errors) > 0 {
+		return AuthAdminCreateRequest_SendBodyMultiError(errors)
+	}
+
+	return nil
+}
+
+// AuthAdminCreateRequest_SendBodyMultiError is an error wrapping multiple
+// validation errors returned by AuthAdminCreateRequest_SendBody.ValidateAll()
+// if the designated constraints aren't met.
+type AuthAdminCreateRequest_SendBodyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AuthAdminCreateRequest_SendBodyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AuthAdminCreateRequest_SendBodyMultiError) AllErrors() []error { return m }
+
+// AuthAdminCreateRequest_SendBodyValidationError is the validation error
+// returned by AuthAdminCreateRequest_SendBody.Validate if the designated
+// constraints aren't met.
+type AuthAdminCreateRequest_SendBodyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AuthAdminCreateRequest_SendBodyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AuthAdminCreateRequest_SendBodyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AuthAdminCreateRequest_SendBodyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AuthAdminCreateRequest_SendBodyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AuthAdminCreateRequest_SendBodyValidationError) ErrorName() string {
+	return "AuthAdminCreateRequest_SendBodyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AuthAdminCreateRequest_SendBodyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAuthAdminCreateRequest_SendBody.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AuthAdminCreateRequest_SendBodyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AuthAdminCreateRequest_SendBodyValidationError{}
+
+// Validate checks the field values on AuthAdminDeleteRequest_SendBody with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AuthAdminDeleteRequest_SendBody) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AuthAdminDeleteRequest_SendBody with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// AuthAdminDeleteRequest_SendBodyMultiError, or nil if none found.
+func (m *AuthAdminDeleteRequest_SendBody) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AuthAdminDeleteRequest_SendBody) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for AdminId
+
+	// no validation rules for AuthId
+
+	if len(errors) > 0 {
+		return AuthAdminDeleteRequest_SendBodyMultiError(errors)
+	}
+
+	return nil
+}
+
+// AuthAdminDeleteRequest_SendBodyMultiError is an error wrapping multiple
+// validation errors returned by AuthAdminDeleteRequest_SendBody.ValidateAll()
+// if the designated constraints aren't met.
+type AuthAdminDeleteRequest_SendBodyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AuthAdminDeleteRequest_SendBodyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AuthAdminDeleteRequest_SendBodyMultiError) AllErrors() []error { return m }
+
+// AuthAdminDeleteRequest_SendBodyValidationError is the validation error
+// returned by AuthAdminDeleteRequest_SendBody.Validate if the designated
+// constraints aren't met.
+type AuthAdminDeleteRequest_SendBodyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AuthAdminDeleteRequest_SendBodyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AuthAdminDeleteRequest_SendBodyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AuthAdminDeleteRequest_SendBodyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AuthAdminDeleteRequest_SendBodyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AuthAdminDeleteRequest_SendBodyValidationError) ErrorName() string {
+	return "AuthAdminDeleteRequest_SendBodyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AuthAdminDeleteRequest_SendBodyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAuthAdminDeleteRequest_SendBody.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AuthAdminDeleteRequest_SendBodyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AuthAdminDeleteRequest_SendBodyValidationError{}
+
+// Validate checks the field values on AdminLoginRequest_SendBody with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AdminLoginRequest_SendBody) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AdminLoginRequest_SendBody with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AdminLoginRequest_SendBodyMultiError, or nil if none found.
+func (m *AdminLoginRequest_SendBody) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AdminLoginRequest_SendBody) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Account
+
+	// no validation rules for Password
+
+	if len(errors) > 0 {
+		return AdminLoginRequest_SendBodyMultiError(errors)
+	}
+
+	return nil
+}
+
+// AdminLoginRequest_SendBodyMultiError is an error wrapping multiple
+// validation errors returned by AdminLoginRequest_SendBody.ValidateAll() if
+// the designated constraints aren't met.
+type AdminLoginRequest_SendBodyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AdminLoginRequest_SendBodyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AdminLoginRequest_SendBodyMultiError) AllErrors() []error { return m }
+
+// AdminLoginRequest_SendBodyValidationError is the validation error returned
+// by AdminLoginRequest_SendBody.Validate if the designated constraints aren't met.
+type AdminLoginRequest_SendBodyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AdminLoginRequest_SendBodyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AdminLoginRequest_SendBodyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AdminLoginRequest_SendBodyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AdminLoginRequest_SendBodyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AdminLoginRequest_SendBodyValidationError) ErrorName() string {
+	return "AdminLoginRequest_SendBodyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AdminLoginRequest_SendBodyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAdminLoginRequest_SendBody.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AdminLoginRequest_SendBodyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AdminLoginRequest_SendBodyValidationError{}
+
+// Validate checks the field values on AdminChangePasswordRequest_SendBody with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *AdminChangePasswordRequest_SendBody) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AdminChangePasswordRequest_SendBody
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// AdminChangePasswordRequest_SendBodyMultiError, or nil if none found.
+func (m *AdminChangePasswordRequest_SendBody) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AdminChangePasswordRequest_SendBody) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Account
+
+	// no validation rules for Password
+
+	if len(errors) > 0 {
+		return AdminChangePasswordRequest_SendBodyMultiError(errors)
+	}
+
+	return nil
+}
+
+// AdminChangePasswordRequest_SendBodyMultiError is an error wrapping multiple
+// validation errors returned by
+// AdminChangePasswordRequest_SendBody.ValidateAll() if the designated
+// constraints aren't met.
+type AdminChangePasswordRequest_SendBodyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AdminChangePasswordRequest_SendBodyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AdminChangePasswordRequest_SendBodyMultiError) AllErrors() []error { return m }
+
+// AdminChangePasswordRequest_SendBodyValidationError is the validation error
+// returned by AdminChangePasswordRequest_SendBody.Validate if the designated
+// constraints aren't met.
+type AdminChangePasswordRequest_SendBodyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AdminChangePasswordRequest_SendBodyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AdminChangePasswordRequest_SendBodyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AdminChangePasswordRequest_SendBodyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AdminChangePasswordRequest_SendBodyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AdminChangePasswordRequest_SendBodyValidationError) ErrorName() string {
+	return "AdminChangePasswordRequest_SendBodyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AdminChangePasswordRequest_SendBodyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAdminChangePasswordRequest_SendBody.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AdminChangePasswordRequest_SendBodyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AdminChangePasswordRequest_SendBodyValidationError{}
+
+// Validate checks the field values on AdminCreateAccountRequest_SendBody with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *AdminCreateAccountRequest_SendBody) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AdminCreateAccountRequest_SendBody
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// AdminCreateAccountRequest_SendBodyMultiError, or nil if none found.
+func (m *AdminCreateAccountRequest_SendBody) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AdminCreateAccountRequest_SendBody) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Account
+
+	// no validation rules for Password
+
+	if len(errors) > 0 {
+		return AdminCreateAccountRequest_SendBodyMultiError(errors)
+	}
+
+	return nil
+}
+
+// AdminCreateAccountRequest_SendBodyMultiError is an error wrapping multiple
+// validation errors returned by
+// AdminCreateAccountRequest_SendBody.ValidateAll() if the designated
+// constraints aren't met.
+type AdminCreateAccountRequest_SendBodyMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m AdminRewardBnbListReply_ListMultiError) Error() string {
+func (m AdminCreateAccountRequest_SendBodyMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -11899,12 +21392,12 @@ func (m AdminRewardBnbListReply_ListMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m AdminRewardBnbListReply_ListMultiError) AllErrors() []error { return m }
+func (m AdminCreateAccountRequest_SendBodyMultiError) AllErrors() []error { return m }
 
-// AdminRewardBnbListReply_ListValidationError is the validation error returned
-// by AdminRewardBnbListReply_List.Validate if the designated constraints
-// aren't met.
-type AdminRewardBnbListReply_ListValidationError struct {
+// AdminCreateAccountRequest_SendBodyValidationError is the validation error
+// returned by AdminCreateAccountRequest_SendBody.Validate if the designated
+// constraints aren't met.
+type AdminCreateAccountRequest_SendBodyValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -11912,24 +21405,24 @@ type AdminRewardBnbListReply_ListValidationError struct {
 }
 
 // Field function returns field value.
-func (e AdminRewardBnbListReply_ListValidationError) Field() string { return e.field }
+func (e AdminCreateAccountRequest_SendBodyValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e AdminRewardBnbListReply_ListValidationError) Reason() string { return e.reason }
+func (e AdminCreateAccountRequest_SendBodyValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e AdminRewardBnbListReply_ListValidationError) Cause() error { return e.cause }
+func (e AdminCreateAccountRequest_SendBodyValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e AdminRewardBnbListReply_ListValidationError) Key() bool { return e.key }
+func (e AdminCreateAccountRequest_SendBodyValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e AdminRewardBnbListReply_ListValidationError) ErrorName() string {
-	return "AdminRewardBnbListReply_ListValidationError"
+func (e AdminCreateAccountRequest_SendBodyValidationError) ErrorName() string {
+	return "AdminCreateAccountRequest_SendBodyValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e AdminRewardBnbListReply_ListValidationError) Error() string {
+func (e AdminCreateAccountRequest_SendBodyValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -11941,14 +21434,14 @@ func (e AdminRewardBnbListReply_ListValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sAdminRewardBnbListReply_List.%s: %s%s",
+		"invalid %sAdminCreateAccountRequest_SendBody.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = AdminRewardBnbListReply_ListValidationError{}
+var _ error = AdminCreateAccountRequest_SendBodyValidationError{}
 
 var _ interface {
 	Field() string
@@ -11956,68 +21449,62 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = AdminRewardBnbListReply_ListValidationError{}
+} = AdminCreateAccountRequest_SendBodyValidationError{}
 
-// Validate checks the field values on AdminUserListReply_UserList with the
+// Validate checks the field values on AdminAuditLogListReply_List with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *AdminUserListReply_UserList) Validate() error {
+func (m *AdminAuditLogListReply_List) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on AdminUserListReply_UserList with the
+// ValidateAll checks the field values on AdminAuditLogListReply_List with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// AdminUserListReply_UserListMultiError, or nil if none found.
-func (m *AdminUserListReply_UserList) ValidateAll() error {
+// AdminAuditLogListReply_ListMultiError, or nil if none found.
+func (m *AdminAuditLogListReply_List) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *AdminUserListReply_UserList) validate(all bool) error {
+func (m *AdminAuditLogListReply_List) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for UserId
-
-	// no validation rules for CreatedAt
-
-	// no validation rules for Address
-
-	// no validation rules for BalanceUsdt
+	// no validation rules for Id
 
-	// no validation rules for BalanceDhb
+	// no validation rules for AdminId
 
-	// no validation rules for Vip
+	// no validation rules for Account
 
-	// no validation rules for MonthRecommend
+	// no validation rules for Operation
 
-	// no validation rules for HistoryRecommend
+	// no validation rules for Payload
 
-	// no validation rules for AreaAmount
+	// no validation rules for Before
 
-	// no validation rules for AreaMaxAmount
+	// no validation rules for After
 
-	// no validation rules for BnbAmount
+	// no validation rules for Ip
 
-	// no validation rules for BnbBalance
+	// no validation rules for CreatedAt
 
 	if len(errors) > 0 {
-		return AdminUserListReply_UserListMultiError(errors)
+		return AdminAuditLogListReply_ListMultiError(errors)
 	}
 
 	return nil
 }
 
-// AdminUserListReply_UserListMultiError is an error wrapping multiple
-// validation errors returned by AdminUserListReply_UserList.ValidateAll() if
+// AdminAuditLogListReply_ListMultiError is an error wrapping multiple
+// validation errors returned by AdminAuditLogListReply_List.ValidateAll() if
 // the designated constraints aren't met.
-type AdminUserListReply_UserListMultiError []error
+type AdminAuditLogListReply_ListMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m AdminUserListReply_UserListMultiError) Error() string {
+func (m AdminAuditLogListReply_ListMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -12026,12 +21513,12 @@ func (m AdminUserListReply_UserListMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m AdminUserListReply_UserListMultiError) AllErrors() []error { return m }
+func (m AdminAuditLogListReply_ListMultiError) AllErrors() []error { return m }
 
-// AdminUserListReply_UserListValidationError is the validation error returned
-// by AdminUserListReply_UserList.Validate if the designated constraints
+// AdminAuditLogListReply_ListValidationError is the validation error returned
+// by AdminAuditLogListReply_List.Validate if the designated constraints
 // aren't met.
-type AdminUserListReply_UserListValidationError struct {
+type AdminAuditLogListReply_ListValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -12039,24 +21526,24 @@ type AdminUserListReply_UserListValidationError struct {
 }
 
 // Field function returns field value.
-func (e AdminUserListReply_UserListValidationError) Field() string { return e.field }
+func (e AdminAuditLogListReply_ListValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e AdminUserListReply_UserListValidationError) Reason() string { return e.reason }
+func (e AdminAuditLogListReply_ListValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e AdminUserListReply_UserListValidationError) Cause() error { return e.cause }
+func (e AdminAuditLogListReply_ListValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e AdminUserListReply_UserListValidationError) Key() bool { return e.key }
+func (e AdminAuditLogListReply_ListValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e AdminUserListReply_UserListValidationError) ErrorName() string {
-	return "AdminUserListReply_UserListValidationError"
+func (e AdminAuditLogListReply_ListValidationError) ErrorName() string {
+	return "AdminAuditLogListReply_ListValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e AdminUserListReply_UserListValidationError) Error() string {
+func (e AdminAuditLogListReply_ListValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -12068,14 +21555,14 @@ func (e AdminUserListReply_UserListValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sAdminUserListReply_UserList.%s: %s%s",
+		"invalid %sAdminAuditLogListReply_List.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = AdminUserListReply_UserListValidationError{}
+var _ error = AdminAuditLogListReply_ListValidationError{}
 
 var _ interface {
 	Field() string
@@ -12083,62 +21570,62 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = AdminUserListReply_UserListValidationError{}
+} = AdminAuditLogListReply_ListValidationError{}
 
-// Validate checks the field values on AdminLocationListReply_LocationList with
-// the rules defined in the proto definition for this message. If any rules
-// are violated, the first error encountered is returned, or nil if there are
-// no violations.
-func (m *AdminLocationListReply_LocationList) Validate() error {
+// Validate checks the field values on AdminProposalListReply_List with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AdminProposalListReply_List) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on AdminLocationListReply_LocationList
-// with the rules defined in the proto definition for this message. If any
-// rules are violated, the result is a list of violation errors wrapped in
-// AdminLocationListReply_LocationListMultiError, or nil if none found.
-func (m *AdminLocationListReply_LocationList) ValidateAll() error {
+// ValidateAll checks the field values on AdminProposalListReply_List with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AdminProposalListReply_ListMultiError, or nil if none found.
+func (m *AdminProposalListReply_List) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *AdminLocationListReply_LocationList) validate(all bool) error {
+func (m *AdminProposalListReply_List) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for CreatedAt
+	// no validation rules for Id
 
-	// no validation rules for Address
+	// no validation rules for Operation
 
-	// no validation rules for Row
+	// no validation rules for Payload
 
-	// no validation rules for Col
+	// no validation rules for ProposerId
+
+	// no validation rules for CheckerId
 
 	// no validation rules for Status
 
-	// no validation rules for CurrentLevel
+	// no validation rules for Result
 
-	// no validation rules for Current
+	// no validation rules for CreatedAt
 
-	// no validation rules for CurrentMax
+	// no validation rules for UpdatedAt
 
 	if len(errors) > 0 {
-		return AdminLocationListReply_LocationListMultiError(errors)
+		return AdminProposalListReply_ListMultiError(errors)
 	}
 
 	return nil
 }
 
-// AdminLocationListReply_LocationListMultiError is an error wrapping multiple
-// validation errors returned by
-// AdminLocationListReply_LocationList.ValidateAll() if the designated
-// constraints aren't met.
-type AdminLocationListReply_LocationListMultiError []error
+// AdminProposalListReply_ListMultiError is an error wrapping multiple
+// validation errors returned by AdminProposalListReply_List.ValidateAll() if
+// the designated constraints aren't met.
+type AdminProposalListReply_ListMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m AdminLocationListReply_LocationListMultiError) Error() string {
+func (m AdminProposalListReply_ListMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -12147,12 +21634,12 @@ func (m AdminLocationListReply_LocationListMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m AdminLocationListReply_LocationListMultiError) AllErrors() []error { return m }
+func (m AdminProposalListReply_ListMultiError) AllErrors() []error { return m }
 
-// AdminLocationListReply_LocationListValidationError is the validation error
-// returned by AdminLocationListReply_LocationList.Validate if the designated
-// constraints aren't met.
-type AdminLocationListReply_LocationListValidationError struct {
+// AdminProposalListReply_ListValidationError is the validation error returned
+// by AdminProposalListReply_List.Validate if the designated constraints
+// aren't met.
+type AdminProposalListReply_ListValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -12160,24 +21647,24 @@ type AdminLocationListReply_LocationListValidationError struct {
 }
 
 // Field function returns field value.
-func (e AdminLocationListReply_LocationListValidationError) Field() string { return e.field }
+func (e AdminProposalListReply_ListValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e AdminLocationListReply_LocationListValidationError) Reason() string { return e.reason }
+func (e AdminProposalListReply_ListValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e AdminLocationListReply_LocationListValidationError) Cause() error { return e.cause }
+func (e AdminProposalListReply_ListValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e AdminLocationListReply_LocationListValidationError) Key() bool { return e.key }
+func (e AdminProposalListReply_ListValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e AdminLocationListReply_LocationListValidationError) ErrorName() string {
-	return "AdminLocationListReply_LocationListValidationError"
+func (e AdminProposalListReply_ListValidationError) ErrorName() string {
+	return "AdminProposalListReply_ListValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e AdminLocationListReply_LocationListValidationError) Error() string {
+func (e AdminProposalListReply_ListValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -12189,14 +21676,14 @@ func (e AdminLocationListReply_LocationListValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sAdminLocationListReply_LocationList.%s: %s%s",
+		"invalid %sAdminProposalListReply_List.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = AdminLocationListReply_LocationListValidationError{}
+var _ error = AdminProposalListReply_ListValidationError{}
 
 var _ interface {
 	Field() string
@@ -12204,63 +21691,48 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = AdminLocationListReply_LocationListValidationError{}
+} = AdminProposalListReply_ListValidationError{}
 
-// Validate checks the field values on AdminLocationAllListReply_LocationList
+// Validate checks the field values on AdminProposalApproveRequest_SendBody
 // with the rules defined in the proto definition for this message. If any
 // rules are violated, the first error encountered is returned, or nil if
 // there are no violations.
-func (m *AdminLocationAllListReply_LocationList) Validate() error {
+func (m *AdminProposalApproveRequest_SendBody) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on
-// AdminLocationAllListReply_LocationList with the rules defined in the proto
-// definition for this message. If any rules are violated, the result is a
-// list of violation errors wrapped in
-// AdminLocationAllListReply_LocationListMultiError, or nil if none found.
-func (m *AdminLocationAllListReply_LocationList) ValidateAll() error {
+// ValidateAll checks the field values on AdminProposalApproveRequest_SendBody
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// AdminProposalApproveRequest_SendBodyMultiError, or nil if none found.
+func (m *AdminProposalApproveRequest_SendBody) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *AdminLocationAllListReply_LocationList) validate(all bool) error {
+func (m *AdminProposalApproveRequest_SendBody) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for CreatedAt
-
-	// no validation rules for Address
-
-	// no validation rules for Row
-
-	// no validation rules for Col
-
-	// no validation rules for Status
-
-	// no validation rules for CurrentLevel
-
-	// no validation rules for Current
-
-	// no validation rules for CurrentMax
+	// no validation rules for Id
 
 	if len(errors) > 0 {
-		return AdminLocationAllListReply_LocationListMultiError(errors)
+		return AdminProposalApproveRequest_SendBodyMultiError(errors)
 	}
 
 	return nil
 }
 
-// AdminLocationAllListReply_LocationListMultiError is an error wrapping
-// multiple validation errors returned by
-// AdminLocationAllListReply_LocationList.ValidateAll() if the designated
+// AdminProposalApproveRequest_SendBodyMultiError is an error wrapping multiple
+// validation errors returned by
+// AdminProposalApproveRequest_SendBody.ValidateAll() if the designated
 // constraints aren't met.
-type AdminLocationAllListReply_LocationListMultiError []error
+type AdminProposalApproveRequest_SendBodyMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m AdminLocationAllListReply_LocationListMultiError) Error() string {
+func (m AdminProposalApproveRequest_SendBodyMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -12269,12 +21741,12 @@ func (m AdminLocationAllListReply_LocationListMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m AdminLocationAllListReply_LocationListMultiError) AllErrors() []error { return m }
+func (m AdminProposalApproveRequest_SendBodyMultiError) AllErrors() []error { return m }
 
-// AdminLocationAllListReply_LocationListValidationError is the validation
-// error returned by AdminLocationAllListReply_LocationList.Validate if the
-// designated constraints aren't met.
-type AdminLocationAllListReply_LocationListValidationError struct {
+// AdminProposalApproveRequest_SendBodyValidationError is the validation error
+// returned by AdminProposalApproveRequest_SendBody.Validate if the designated
+// constraints aren't met.
+type AdminProposalApproveRequest_SendBodyValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -12282,24 +21754,24 @@ type AdminLocationAllListReply_LocationListValidationError struct {
 }
 
 // Field function returns field value.
-func (e AdminLocationAllListReply_LocationListValidationError) Field() string { return e.field }
+func (e AdminProposalApproveRequest_SendBodyValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e AdminLocationAllListReply_LocationListValidationError) Reason() string { return e.reason }
+func (e AdminProposalApproveRequest_SendBodyValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e AdminLocationAllListReply_LocationListValidationError) Cause() error { return e.cause }
+func (e AdminProposalApproveRequest_SendBodyValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e AdminLocationAllListReply_LocationListValidationError) Key() bool { return e.key }
+func (e AdminProposalApproveRequest_SendBodyValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e AdminLocationAllListReply_LocationListValidationError) ErrorName() string {
-	return "AdminLocationAllListReply_LocationListValidationError"
+func (e AdminProposalApproveRequest_SendBodyValidationError) ErrorName() string {
+	return "AdminProposalApproveRequest_SendBodyValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e AdminLocationAllListReply_LocationListValidationError) Error() string {
+func (e AdminProposalApproveRequest_SendBodyValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -12311,14 +21783,14 @@ func (e AdminLocationAllListReply_LocationListValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sAdminLocationAllListReply_LocationList.%s: %s%s",
+		"invalid %sAdminProposalApproveRequest_SendBody.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = AdminLocationAllListReply_LocationListValidationError{}
+var _ error = AdminProposalApproveRequest_SendBodyValidationError{}
 
 var _ interface {
 	Field() string
@@ -12326,58 +21798,50 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = AdminLocationAllListReply_LocationListValidationError{}
+} = AdminProposalApproveRequest_SendBodyValidationError{}
 
-// Validate checks the field values on AdminWithdrawListReply_List with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *AdminWithdrawListReply_List) Validate() error {
+// Validate checks the field values on AdminProposalRejectRequest_SendBody with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *AdminProposalRejectRequest_SendBody) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on AdminWithdrawListReply_List with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// AdminWithdrawListReply_ListMultiError, or nil if none found.
-func (m *AdminWithdrawListReply_List) ValidateAll() error {
+// ValidateAll checks the field values on AdminProposalRejectRequest_SendBody
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// AdminProposalRejectRequest_SendBodyMultiError, or nil if none found.
+func (m *AdminProposalRejectRequest_SendBody) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *AdminWithdrawListReply_List) validate(all bool) error {
+func (m *AdminProposalRejectRequest_SendBody) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Address
-
 	// no validation rules for Id
 
-	// no validation rules for CreatedAt
-
-	// no validation rules for Amount
-
-	// no validation rules for RelAmount
-
-	// no validation rules for Type
-
-	// no validation rules for Status
+	// no validation rules for Reason
 
 	if len(errors) > 0 {
-		return AdminWithdrawListReply_ListMultiError(errors)
+		return AdminProposalRejectRequest_SendBodyMultiError(errors)
 	}
 
 	return nil
 }
 
-// AdminWithdrawListReply_ListMultiError is an error wrapping multiple
-// validation errors returned by AdminWithdrawListReply_List.ValidateAll() if
-// the designated constraints aren't met.
-type AdminWithdrawListReply_ListMultiError []error
+// AdminProposalRejectRequest_SendBodyMultiError is an error wrapping multiple
+// validation errors returned by
+// AdminProposalRejectRequest_SendBody.ValidateAll() if the designated
+// constraints aren't met.
+type AdminProposalRejectRequest_SendBodyMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m AdminWithdrawListReply_ListMultiError) Error() string {
+func (m AdminProposalRejectRequest_SendBodyMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -12386,12 +21850,12 @@ func (m AdminWithdrawListReply_ListMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m AdminWithdrawListReply_ListMultiError) AllErrors() []error { return m }
+func (m AdminProposalRejectRequest_SendBodyMultiError) AllErrors() []error { return m }
 
-// AdminWithdrawListReply_ListValidationError is the validation error returned
-// by AdminWithdrawListReply_List.Validate if the designated constraints
-// aren't met.
-type AdminWithdrawListReply_ListValidationError struct {
+// AdminProposalRejectRequest_SendBodyValidationError is the validation error
+// returned by AdminProposalRejectRequest_SendBody.Validate if the designated
+// constraints aren't met.
+type AdminProposalRejectRequest_SendBodyValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -12399,24 +21863,24 @@ type AdminWithdrawListReply_ListValidationError struct {
 }
 
 // Field function returns field value.
-func (e AdminWithdrawListReply_ListValidationError) Field() string { return e.field }
+func (e AdminProposalRejectRequest_SendBodyValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e AdminWithdrawListReply_ListValidationError) Reason() string { return e.reason }
+func (e AdminProposalRejectRequest_SendBodyValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e AdminWithdrawListReply_ListValidationError) Cause() error { return e.cause }
+func (e AdminProposalRejectRequest_SendBodyValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e AdminWithdrawListReply_ListValidationError) Key() bool { return e.key }
+func (e AdminProposalRejectRequest_SendBodyValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e AdminWithdrawListReply_ListValidationError) ErrorName() string {
-	return "AdminWithdrawListReply_ListValidationError"
+func (e AdminProposalRejectRequest_SendBodyValidationError) ErrorName() string {
+	return "AdminProposalRejectRequest_SendBodyValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e AdminWithdrawListReply_ListValidationError) Error() string {
+func (e AdminProposalRejectRequest_SendBodyValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -12428,14 +21892,14 @@ func (e AdminWithdrawListReply_ListValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sAdminWithdrawListReply_List.%s: %s%s",
+		"invalid %sAdminProposalRejectRequest_SendBody.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = AdminWithdrawListReply_ListValidationError{}
+var _ error = AdminProposalRejectRequest_SendBodyValidationError{}
 
 var _ interface {
 	Field() string
@@ -12443,52 +21907,66 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = AdminWithdrawListReply_ListValidationError{}
+} = AdminProposalRejectRequest_SendBodyValidationError{}
 
-// Validate checks the field values on AdminUserRecommendReply_List with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *AdminUserRecommendReply_List) Validate() error {
+// Validate checks the field values on AdminWithdrawReviewListReply_List with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *AdminWithdrawReviewListReply_List) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on AdminUserRecommendReply_List with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// AdminUserRecommendReply_ListMultiError, or nil if none found.
-func (m *AdminUserRecommendReply_List) ValidateAll() error {
+// ValidateAll checks the field values on AdminWithdrawReviewListReply_List
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// AdminWithdrawReviewListReply_ListMultiError, or nil if none found.
+func (m *AdminWithdrawReviewListReply_List) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *AdminUserRecommendReply_List) validate(all bool) error {
-	if m == nil {
-		return nil
-	}
+func (m *AdminWithdrawReviewListReply_List) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for WithdrawId
+
+	// no validation rules for Address
+
+	// no validation rules for Amount
+
+	// no validation rules for Type
+
+	// no validation rules for Rule
 
-	var errors []error
+	// no validation rules for Detail
 
-	// no validation rules for UserId
+	// no validation rules for Status
 
-	// no validation rules for Address
+	// no validation rules for AdminId
 
-	// no validation rules for Id
+	// no validation rules for Reason
 
 	// no validation rules for CreatedAt
 
 	if len(errors) > 0 {
-		return AdminUserRecommendReply_ListMultiError(errors)
+		return AdminWithdrawReviewListReply_ListMultiError(errors)
 	}
 
 	return nil
 }
 
-// AdminUserRecommendReply_ListMultiError is an error wrapping multiple
-// validation errors returned by AdminUserRecommendReply_List.ValidateAll() if
-// the designated constraints aren't met.
-type AdminUserRecommendReply_ListMultiError []error
+// AdminWithdrawReviewListReply_ListMultiError is an error wrapping multiple
+// validation errors returned by
+// AdminWithdrawReviewListReply_List.ValidateAll() if the designated
+// constraints aren't met.
+type AdminWithdrawReviewListReply_ListMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m AdminUserRecommendReply_ListMultiError) Error() string {
+func (m AdminWithdrawReviewListReply_ListMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -12497,12 +21975,12 @@ func (m AdminUserRecommendReply_ListMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m AdminUserRecommendReply_ListMultiError) AllErrors() []error { return m }
+func (m AdminWithdrawReviewListReply_ListMultiError) AllErrors() []error { return m }
 
-// AdminUserRecommendReply_ListValidationError is the validation error returned
-// by AdminUserRecommendReply_List.Validate if the designated constraints
-// aren't met.
-type AdminUserRecommendReply_ListValidationError struct {
+// AdminWithdrawReviewListReply_ListValidationError is the validation error
+// returned by AdminWithdrawReviewListReply_List.Validate if the designated
+// constraints aren't met.
+type AdminWithdrawReviewListReply_ListValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -12510,24 +21988,24 @@ type AdminUserRecommendReply_ListValidationError struct {
 }
 
 // Field function returns field value.
-func (e AdminUserRecommendReply_ListValidationError) Field() string { return e.field }
+func (e AdminWithdrawReviewListReply_ListValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e AdminUserRecommendReply_ListValidationError) Reason() string { return e.reason }
+func (e AdminWithdrawReviewListReply_ListValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e AdminUserRecommendReply_ListValidationError) Cause() error { return e.cause }
+func (e AdminWithdrawReviewListReply_ListValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e AdminUserRecommendReply_ListValidationError) Key() bool { return e.key }
+func (e AdminWithdrawReviewListReply_ListValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e AdminUserRecommendReply_ListValidationError) ErrorName() string {
-	return "AdminUserRecommendReply_ListValidationError"
+func (e AdminWithdrawReviewListReply_ListValidationError) ErrorName() string {
+	return "AdminWithdrawReviewListReply_ListValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e AdminUserRecommendReply_ListValidationError) Error() string {
+func (e AdminWithdrawReviewListReply_ListValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -12539,14 +22017,14 @@ func (e AdminUserRecommendReply_ListValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sAdminUserRecommendReply_List.%s: %s%s",
+		"invalid %sAdminWithdrawReviewListReply_List.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = AdminUserRecommendReply_ListValidationError{}
+var _ error = AdminWithdrawReviewListReply_ListValidationError{}
 
 var _ interface {
 	Field() string
@@ -12554,52 +22032,49 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = AdminUserRecommendReply_ListValidationError{}
+} = AdminWithdrawReviewListReply_ListValidationError{}
 
-// Validate checks the field values on AdminMonthRecommendReply_List with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *AdminMonthRecommendReply_List) Validate() error {
+// Validate checks the field values on
+// AdminWithdrawReviewApproveRequest_SendBody with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *AdminWithdrawReviewApproveRequest_SendBody) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on AdminMonthRecommendReply_List with
-// the rules defined in the proto definition for this message. If any rules
-// are violated, the result is a list of violation errors wrapped in
-// AdminMonthRecommendReply_ListMultiError, or nil if none found.
-func (m *AdminMonthRecommendReply_List) ValidateAll() error {
+// ValidateAll checks the field values on
+// AdminWithdrawReviewApproveRequest_SendBody with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in
+// AdminWithdrawReviewApproveRequest_SendBodyMultiError, or nil if none found.
+func (m *AdminWithdrawReviewApproveRequest_SendBody) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *AdminMonthRecommendReply_List) validate(all bool) error {
+func (m *AdminWithdrawReviewApproveRequest_SendBody) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Address
-
-	// no validation rules for RecommendAddress
-
 	// no validation rules for Id
 
-	// no validation rules for CreatedAt
-
 	if len(errors) > 0 {
-		return AdminMonthRecommendReply_ListMultiError(errors)
+		return AdminWithdrawReviewApproveRequest_SendBodyMultiError(errors)
 	}
 
 	return nil
 }
 
-// AdminMonthRecommendReply_ListMultiError is an error wrapping multiple
-// validation errors returned by AdminMonthRecommendReply_List.ValidateAll()
-// if the designated constraints aren't met.
-type AdminMonthRecommendReply_ListMultiError []error
+// AdminWithdrawReviewApproveRequest_SendBodyMultiError is an error wrapping
+// multiple validation errors returned by
+// AdminWithdrawReviewApproveRequest_SendBody.ValidateAll() if the designated
+// constraints aren't met.
+type AdminWithdrawReviewApproveRequest_SendBodyMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m AdminMonthRecommendReply_ListMultiError) Error() string {
+func (m AdminWithdrawReviewApproveRequest_SendBodyMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -12608,12 +22083,12 @@ func (m AdminMonthRecommendReply_ListMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m AdminMonthRecommendReply_ListMultiError) AllErrors() []error { return m }
+func (m AdminWithdrawReviewApproveRequest_SendBodyMultiError) AllErrors() []error { return m }
 
-// AdminMonthRecommendReply_ListValidationError is the validation error
-// returned by AdminMonthRecommendReply_List.Validate if the designated
-// constraints aren't met.
-type AdminMonthRecommendReply_ListValidationError struct {
+// AdminWithdrawReviewApproveRequest_SendBodyValidationError is the validation
+// error returned by AdminWithdrawReviewApproveRequest_SendBody.Validate if
+// the designated constraints aren't met.
+type AdminWithdrawReviewApproveRequest_SendBodyValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -12621,24 +22096,24 @@ type AdminMonthRecommendReply_ListValidationError struct {
 }
 
 // Field function returns field value.
-func (e AdminMonthRecommendReply_ListValidationError) Field() string { return e.field }
+func (e AdminWithdrawReviewApproveRequest_SendBodyValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e AdminMonthRecommendReply_ListValidationError) Reason() string { return e.reason }
+func (e AdminWithdrawReviewApproveRequest_SendBodyValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e AdminMonthRecommendReply_ListValidationError) Cause() error { return e.cause }
+func (e AdminWithdrawReviewApproveRequest_SendBodyValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e AdminMonthRecommendReply_ListValidationError) Key() bool { return e.key }
+func (e AdminWithdrawReviewApproveRequest_SendBodyValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e AdminMonthRecommendReply_ListValidationError) ErrorName() string {
-	return "AdminMonthRecommendReply_ListValidationError"
+func (e AdminWithdrawReviewApproveRequest_SendBodyValidationError) ErrorName() string {
+	return "AdminWithdrawReviewApproveRequest_SendBodyValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e AdminMonthRecommendReply_ListValidationError) Error() string {
+func (e AdminWithdrawReviewApproveRequest_SendBodyValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -12650,14 +22125,14 @@ func (e AdminMonthRecommendReply_ListValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sAdminMonthRecommendReply_List.%s: %s%s",
+		"invalid %sAdminWithdrawReviewApproveRequest_SendBody.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = AdminMonthRecommendReply_ListValidationError{}
+var _ error = AdminWithdrawReviewApproveRequest_SendBodyValidationError{}
 
 var _ interface {
 	Field() string
@@ -12665,24 +22140,26 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = AdminMonthRecommendReply_ListValidationError{}
+} = AdminWithdrawReviewApproveRequest_SendBodyValidationError{}
 
-// Validate checks the field values on AdminConfigReply_List with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *AdminConfigReply_List) Validate() error {
+// Validate checks the field values on
+// AdminWithdrawReviewRejectRequest_SendBody with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *AdminWithdrawReviewRejectRequest_SendBody) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on AdminConfigReply_List with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// AdminConfigReply_ListMultiError, or nil if none found.
-func (m *AdminConfigReply_List) ValidateAll() error {
+// ValidateAll checks the field values on
+// AdminWithdrawReviewRejectRequest_SendBody with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in
+// AdminWithdrawReviewRejectRequest_SendBodyMultiError, or nil if none found.
+func (m *AdminWithdrawReviewRejectRequest_SendBody) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *AdminConfigReply_List) validate(all bool) error {
+func (m *AdminWithdrawReviewRejectRequest_SendBody) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -12691,24 +22168,23 @@ func (m *AdminConfigReply_List) validate(all bool) error {
 
 	// no validation rules for Id
 
-	// no validation rules for Name
-
-	// no validation rules for Value
+	// no validation rules for Reason
 
 	if len(errors) > 0 {
-		return AdminConfigReply_ListMultiError(errors)
+		return AdminWithdrawReviewRejectRequest_SendBodyMultiError(errors)
 	}
 
 	return nil
 }
 
-// AdminConfigReply_ListMultiError is an error wrapping multiple validation
-// errors returned by AdminConfigReply_List.ValidateAll() if the designated
+// AdminWithdrawReviewRejectRequest_SendBodyMultiError is an error wrapping
+// multiple validation errors returned by
+// AdminWithdrawReviewRejectRequest_SendBody.ValidateAll() if the designated
 // constraints aren't met.
-type AdminConfigReply_ListMultiError []error
+type AdminWithdrawReviewRejectRequest_SendBodyMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m AdminConfigReply_ListMultiError) Error() string {
+func (m AdminWithdrawReviewRejectRequest_SendBodyMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -12717,11 +22193,12 @@ func (m AdminConfigReply_ListMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m AdminConfigReply_ListMultiError) AllErrors() []error { return m }
+func (m AdminWithdrawReviewRejectRequest_SendBodyMultiError) AllErrors() []error { return m }
 
-// AdminConfigReply_ListValidationError is the validation error returned by
-// AdminConfigReply_List.Validate if the designated constraints aren't met.
-type AdminConfigReply_ListValidationError struct {
+// AdminWithdrawReviewRejectRequest_SendBodyValidationError is the validation
+// error returned by AdminWithdrawReviewRejectRequest_SendBody.Validate if the
+// designated constraints aren't met.
+type AdminWithdrawReviewRejectRequest_SendBodyValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -12729,24 +22206,24 @@ type AdminConfigReply_ListValidationError struct {
 }
 
 // Field function returns field value.
-func (e AdminConfigReply_ListValidationError) Field() string { return e.field }
+func (e AdminWithdrawReviewRejectRequest_SendBodyValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e AdminConfigReply_ListValidationError) Reason() string { return e.reason }
+func (e AdminWithdrawReviewRejectRequest_SendBodyValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e AdminConfigReply_ListValidationError) Cause() error { return e.cause }
+func (e AdminWithdrawReviewRejectRequest_SendBodyValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e AdminConfigReply_ListValidationError) Key() bool { return e.key }
+func (e AdminWithdrawReviewRejectRequest_SendBodyValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e AdminConfigReply_ListValidationError) ErrorName() string {
-	return "AdminConfigReply_ListValidationError"
+func (e AdminWithdrawReviewRejectRequest_SendBodyValidationError) ErrorName() string {
+	return "AdminWithdrawReviewRejectRequest_SendBodyValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e AdminConfigReply_ListValidationError) Error() string {
+func (e AdminWithdrawReviewRejectRequest_SendBodyValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -12758,14 +22235,14 @@ func (e AdminConfigReply_ListValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sAdminConfigReply_List.%s: %s%s",
+		"invalid %sAdminWithdrawReviewRejectRequest_SendBody.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = AdminConfigReply_ListValidationError{}
+var _ error = AdminWithdrawReviewRejectRequest_SendBodyValidationError{}
 
 var _ interface {
 	Field() string
@@ -12773,24 +22250,25 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = AdminConfigReply_ListValidationError{}
+} = AdminWithdrawReviewRejectRequest_SendBodyValidationError{}
 
-// Validate checks the field values on AdminListReply_List with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *AdminListReply_List) Validate() error {
+// Validate checks the field values on AdminWithdrawRejectRequest_SendBody with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *AdminWithdrawRejectRequest_SendBody) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on AdminListReply_List with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// AdminListReply_ListMultiError, or nil if none found.
-func (m *AdminListReply_List) ValidateAll() error {
+// ValidateAll checks the field values on AdminWithdrawRejectRequest_SendBody
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// AdminWithdrawRejectRequest_SendBodyMultiError, or nil if none found.
+func (m *AdminWithdrawRejectRequest_SendBody) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *AdminListReply_List) validate(all bool) error {
+func (m *AdminWithdrawRejectRequest_SendBody) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -12799,22 +22277,23 @@ func (m *AdminListReply_List) validate(all bool) error {
 
 	// no validation rules for Id
 
-	// no validation rules for Account
+	// no validation rules for Reason
 
 	if len(errors) > 0 {
-		return AdminListReply_ListMultiError(errors)
+		return AdminWithdrawRejectRequest_SendBodyMultiError(errors)
 	}
 
 	return nil
 }
 
-// AdminListReply_ListMultiError is an error wrapping multiple validation
-// errors returned by AdminListReply_List.ValidateAll() if the designated
+// AdminWithdrawRejectRequest_SendBodyMultiError is an error wrapping multiple
+// validation errors returned by
+// AdminWithdrawRejectRequest_SendBody.ValidateAll() if the designated
 // constraints aren't met.
-type AdminListReply_ListMultiError []error
+type AdminWithdrawRejectRequest_SendBodyMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m AdminListReply_ListMultiError) Error() string {
+func (m AdminWithdrawRejectRequest_SendBodyMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -12823,11 +22302,12 @@ func (m AdminListReply_ListMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m AdminListReply_ListMultiError) AllErrors() []error { return m }
+func (m AdminWithdrawRejectRequest_SendBodyMultiError) AllErrors() []error { return m }
 
-// AdminListReply_ListValidationError is the validation error returned by
-// AdminListReply_List.Validate if the designated constraints aren't met.
-type AdminListReply_ListValidationError struct {
+// AdminWithdrawRejectRequest_SendBodyValidationError is the validation error
+// returned by AdminWithdrawRejectRequest_SendBody.Validate if the designated
+// constraints aren't met.
+type AdminWithdrawRejectRequest_SendBodyValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -12835,24 +22315,24 @@ type AdminListReply_ListValidationError struct {
 }
 
 // Field function returns field value.
-func (e AdminListReply_ListValidationError) Field() string { return e.field }
+func (e AdminWithdrawRejectRequest_SendBodyValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e AdminListReply_ListValidationError) Reason() string { return e.reason }
+func (e AdminWithdrawRejectRequest_SendBodyValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e AdminListReply_ListValidationError) Cause() error { return e.cause }
+func (e AdminWithdrawRejectRequest_SendBodyValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e AdminListReply_ListValidationError) Key() bool { return e.key }
+func (e AdminWithdrawRejectRequest_SendBodyValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e AdminListReply_ListValidationError) ErrorName() string {
-	return "AdminListReply_ListValidationError"
+func (e AdminWithdrawRejectRequest_SendBodyValidationError) ErrorName() string {
+	return "AdminWithdrawRejectRequest_SendBodyValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e AdminListReply_ListValidationError) Error() string {
+func (e AdminWithdrawRejectRequest_SendBodyValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -12864,14 +22344,14 @@ func (e AdminListReply_ListValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sAdminListReply_List.%s: %s%s",
+		"invalid %sAdminWithdrawRejectRequest_SendBody.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = AdminListReply_ListValidationError{}
+var _ error = AdminWithdrawRejectRequest_SendBodyValidationError{}
 
 var _ interface {
 	Field() string
@@ -12879,24 +22359,25 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = AdminListReply_ListValidationError{}
+} = AdminWithdrawRejectRequest_SendBodyValidationError{}
 
-// Validate checks the field values on AuthListReply_List with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *AuthListReply_List) Validate() error {
+// Validate checks the field values on AdminWithdrawEventListReply_List with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *AdminWithdrawEventListReply_List) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on AuthListReply_List with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// AuthListReply_ListMultiError, or nil if none found.
-func (m *AuthListReply_List) ValidateAll() error {
+// ValidateAll checks the field values on AdminWithdrawEventListReply_List with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// AdminWithdrawEventListReply_ListMultiError, or nil if none found.
+func (m *AdminWithdrawEventListReply_List) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *AuthListReply_List) validate(all bool) error {
+func (m *AdminWithdrawEventListReply_List) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -12905,24 +22386,35 @@ func (m *AuthListReply_List) validate(all bool) error {
 
 	// no validation rules for Id
 
-	// no validation rules for Name
+	// no validation rules for WithdrawId
 
-	// no validation rules for Path
+	// no validation rules for FromStatus
+
+	// no validation rules for ToStatus
+
+	// no validation rules for Operator
+
+	// no validation rules for OperatorId
+
+	// no validation rules for Remark
+
+	// no validation rules for CreatedAt
 
 	if len(errors) > 0 {
-		return AuthListReply_ListMultiError(errors)
+		return AdminWithdrawEventListReply_ListMultiError(errors)
 	}
 
 	return nil
 }
 
-// AuthListReply_ListMultiError is an error wrapping multiple validation errors
-// returned by AuthListReply_List.ValidateAll() if the designated constraints
-// aren't met.
-type AuthListReply_ListMultiError []error
+// AdminWithdrawEventListReply_ListMultiError is an error wrapping multiple
+// validation errors returned by
+// AdminWithdrawEventListReply_List.ValidateAll() if the designated
+// constraints aren't met.
+type AdminWithdrawEventListReply_ListMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m AuthListReply_ListMultiError) Error() string {
+func (m AdminWithdrawEventListReply_ListMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -12931,11 +22423,12 @@ func (m AuthListReply_ListMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m AuthListReply_ListMultiError) AllErrors() []error { return m }
+func (m AdminWithdrawEventListReply_ListMultiError) AllErrors() []error { return m }
 
-// AuthListReply_ListValidationError is the validation error returned by
-// AuthListReply_List.Validate if the designated constraints aren't met.
-type AuthListReply_ListValidationError struct {
+// AdminWithdrawEventListReply_ListValidationError is the validation error
+// returned by AdminWithdrawEventListReply_List.Validate if the designated
+// constraints aren't met.
+type AdminWithdrawEventListReply_ListValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -12943,24 +22436,24 @@ type AuthListReply_ListValidationError struct {
 }
 
 // Field function returns field value.
-func (e AuthListReply_ListValidationError) Field() string { return e.field }
+func (e AdminWithdrawEventListReply_ListValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e AuthListReply_ListValidationError) Reason() string { return e.reason }
+func (e AdminWithdrawEventListReply_ListValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e AuthListReply_ListValidationError) Cause() error { return e.cause }
+func (e AdminWithdrawEventListReply_ListValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e AuthListReply_ListValidationError) Key() bool { return e.key }
+func (e AdminWithdrawEventListReply_ListValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e AuthListReply_ListValidationError) ErrorName() string {
-	return "AuthListReply_ListValidationError"
+func (e AdminWithdrawEventListReply_ListValidationError) ErrorName() string {
+	return "AdminWithdrawEventListReply_ListValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e AuthListReply_ListValidationError) Error() string {
+func (e AdminWithdrawEventListReply_ListValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -12972,14 +22465,14 @@ func (e AuthListReply_ListValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sAuthListReply_List.%s: %s%s",
+		"invalid %sAdminWithdrawEventListReply_List.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = AuthListReply_ListValidationError{}
+var _ error = AdminWithdrawEventListReply_ListValidationError{}
 
 var _ interface {
 	Field() string
@@ -12987,50 +22480,52 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = AuthListReply_ListValidationError{}
+} = AdminWithdrawEventListReply_ListValidationError{}
 
-// Validate checks the field values on UserAuthListReply_List with the rules
-// defined in the proto definition for this message. If any rules are
+// Validate checks the field values on AdminLedgerVerifyReply_List with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *UserAuthListReply_List) Validate() error {
+func (m *AdminLedgerVerifyReply_List) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on UserAuthListReply_List with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on AdminLedgerVerifyReply_List with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// UserAuthListReply_ListMultiError, or nil if none found.
-func (m *UserAuthListReply_List) ValidateAll() error {
+// AdminLedgerVerifyReply_ListMultiError, or nil if none found.
+func (m *AdminLedgerVerifyReply_List) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *UserAuthListReply_List) validate(all bool) error {
+func (m *AdminLedgerVerifyReply_List) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Id
+	// no validation rules for UserId
 
-	// no validation rules for Name
+	// no validation rules for CoinType
 
-	// no validation rules for Path
+	// no validation rules for Balance
+
+	// no validation rules for Ledger
 
 	if len(errors) > 0 {
-		return UserAuthListReply_ListMultiError(errors)
+		return AdminLedgerVerifyReply_ListMultiError(errors)
 	}
 
 	return nil
 }
 
-// UserAuthListReply_ListMultiError is an error wrapping multiple validation
-// errors returned by UserAuthListReply_List.ValidateAll() if the designated
-// constraints aren't met.
-type UserAuthListReply_ListMultiError []error
+// AdminLedgerVerifyReply_ListMultiError is an error wrapping multiple
+// validation errors returned by AdminLedgerVerifyReply_List.ValidateAll() if
+// the designated constraints aren't met.
+type AdminLedgerVerifyReply_ListMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m UserAuthListReply_ListMultiError) Error() string {
+func (m AdminLedgerVerifyReply_ListMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -13039,11 +22534,12 @@ func (m UserAuthListReply_ListMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m UserAuthListReply_ListMultiError) AllErrors() []error { return m }
+func (m AdminLedgerVerifyReply_ListMultiError) AllErrors() []error { return m }
 
-// UserAuthListReply_ListValidationError is the validation error returned by
-// UserAuthListReply_List.Validate if the designated constraints aren't met.
-type UserAuthListReply_ListValidationError struct {
+// AdminLedgerVerifyReply_ListValidationError is the validation error returned
+// by AdminLedgerVerifyReply_List.Validate if the designated constraints
+// aren't met.
+type AdminLedgerVerifyReply_ListValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -13051,24 +22547,24 @@ type UserAuthListReply_ListValidationError struct {
 }
 
 // Field function returns field value.
-func (e UserAuthListReply_ListValidationError) Field() string { return e.field }
+func (e AdminLedgerVerifyReply_ListValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e UserAuthListReply_ListValidationError) Reason() string { return e.reason }
+func (e AdminLedgerVerifyReply_ListValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e UserAuthListReply_ListValidationError) Cause() error { return e.cause }
+func (e AdminLedgerVerifyReply_ListValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e UserAuthListReply_ListValidationError) Key() bool { return e.key }
+func (e AdminLedgerVerifyReply_ListValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e UserAuthListReply_ListValidationError) ErrorName() string {
-	return "UserAuthListReply_ListValidationError"
+func (e AdminLedgerVerifyReply_ListValidationError) ErrorName() string {
+	return "AdminLedgerVerifyReply_ListValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e UserAuthListReply_ListValidationError) Error() string {
+func (e AdminLedgerVerifyReply_ListValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -13080,14 +22576,14 @@ func (e UserAuthListReply_ListValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sUserAuthListReply_List.%s: %s%s",
+		"invalid %sAdminLedgerVerifyReply_List.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = UserAuthListReply_ListValidationError{}
+var _ error = AdminLedgerVerifyReply_ListValidationError{}
 
 var _ interface {
 	Field() string
@@ -13095,50 +22591,44 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = UserAuthListReply_ListValidationError{}
-
-// Validate checks the field values on MyAuthListReply_List with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *MyAuthListReply_List) Validate() error {
-	return m.validate(false)
-}
+} = AdminLedgerVerifyReply_ListValidationError{}
 
-// ValidateAll checks the field values on MyAuthListReply_List with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// MyAuthListReply_ListMultiError, or nil if none found.
-func (m *MyAuthListReply_List) ValidateAll() error {
+// Validate checks the field values on AdminLedgerOpenRequest_SendBody with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AdminLedgerOpenRequest_SendBody) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AdminLedgerOpenRequest_SendBody with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// AdminLedgerOpenRequest_SendBodyMultiError, or nil if none found.
+func (m *AdminLedgerOpenRequest_SendBody) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *MyAuthListReply_List) validate(all bool) error {
+func (m *AdminLedgerOpenRequest_SendBody) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Id
-
-	// no validation rules for Name
-
-	// no validation rules for Path
-
 	if len(errors) > 0 {
-		return MyAuthListReply_ListMultiError(errors)
+		return AdminLedgerOpenRequest_SendBodyMultiError(errors)
 	}
 
 	return nil
 }
 
-// MyAuthListReply_ListMultiError is an error wrapping multiple validation
-// errors returned by MyAuthListReply_List.ValidateAll() if the designated
-// constraints aren't met.
-type MyAuthListReply_ListMultiError []error
+// AdminLedgerOpenRequest_SendBodyMultiError is an error wrapping multiple
+// validation errors returned by AdminLedgerOpenRequest_SendBody.ValidateAll()
+// if the designated constraints aren't met.
+type AdminLedgerOpenRequest_SendBodyMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m MyAuthListReply_ListMultiError) Error() string {
+func (m AdminLedgerOpenRequest_SendBodyMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -13147,11 +22637,12 @@ func (m MyAuthListReply_ListMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m MyAuthListReply_ListMultiError) AllErrors() []error { return m }
+func (m AdminLedgerOpenRequest_SendBodyMultiError) AllErrors() []error { return m }
 
-// MyAuthListReply_ListValidationError is the validation error returned by
-// MyAuthListReply_List.Validate if the designated constraints aren't met.
-type MyAuthListReply_ListValidationError struct {
+// AdminLedgerOpenRequest_SendBodyValidationError is the validation error
+// returned by AdminLedgerOpenRequest_SendBody.Validate if the designated
+// constraints aren't met.
+type AdminLedgerOpenRequest_SendBodyValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -13159,24 +22650,24 @@ type MyAuthListReply_ListValidationError struct {
 }
 
 // Field function returns field value.
-func (e MyAuthListReply_ListValidationError) Field() string { return e.field }
+func (e AdminLedgerOpenRequest_SendBodyValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e MyAuthListReply_ListValidationError) Reason() string { return e.reason }
+func (e AdminLedgerOpenRequest_SendBodyValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e MyAuthListReply_ListValidationError) Cause() error { return e.cause }
+func (e AdminLedgerOpenRequest_SendBodyValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e MyAuthListReply_ListValidationError) Key() bool { return e.key }
+func (e AdminLedgerOpenRequest_SendBodyValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e MyAuthListReply_ListValidationError) ErrorName() string {
-	return "MyAuthListReply_ListValidationError"
+func (e AdminLedgerOpenRequest_SendBodyValidationError) ErrorName() string {
+	return "AdminLedgerOpenRequest_SendBodyValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e MyAuthListReply_ListValidationError) Error() string {
+func (e AdminLedgerOpenRequest_SendBodyValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -13188,14 +22679,14 @@ func (e MyAuthListReply_ListValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sMyAuthListReply_List.%s: %s%s",
+		"invalid %sAdminLedgerOpenRequest_SendBody.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = MyAuthListReply_ListValidationError{}
+var _ error = AdminLedgerOpenRequest_SendBodyValidationError{}
 
 var _ interface {
 	Field() string
@@ -13203,25 +22694,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = MyAuthListReply_ListValidationError{}
+} = AdminLedgerOpenRequest_SendBodyValidationError{}
 
-// Validate checks the field values on AdminConfigUpdateRequest_SendBody with
-// the rules defined in the proto definition for this message. If any rules
-// are violated, the first error encountered is returned, or nil if there are
-// no violations.
-func (m *AdminConfigUpdateRequest_SendBody) Validate() error {
+// Validate checks the field values on AdminDiscrepancyListReply_List with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AdminDiscrepancyListReply_List) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on AdminConfigUpdateRequest_SendBody
-// with the rules defined in the proto definition for this message. If any
-// rules are violated, the result is a list of violation errors wrapped in
-// AdminConfigUpdateRequest_SendBodyMultiError, or nil if none found.
-func (m *AdminConfigUpdateRequest_SendBody) ValidateAll() error {
+// ValidateAll checks the field values on AdminDiscrepancyListReply_List with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// AdminDiscrepancyListReply_ListMultiError, or nil if none found.
+func (m *AdminDiscrepancyListReply_List) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *AdminConfigUpdateRequest_SendBody) validate(all bool) error {
+func (m *AdminDiscrepancyListReply_List) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -13230,23 +22720,34 @@ func (m *AdminConfigUpdateRequest_SendBody) validate(all bool) error {
 
 	// no validation rules for Id
 
-	// no validation rules for Value
+	// no validation rules for Check
+
+	// no validation rules for UserId
+
+	// no validation rules for Expected
+
+	// no validation rules for Actual
+
+	// no validation rules for Diff
+
+	// no validation rules for Severity
+
+	// no validation rules for CreatedAt
 
 	if len(errors) > 0 {
-		return AdminConfigUpdateRequest_SendBodyMultiError(errors)
+		return AdminDiscrepancyListReply_ListMultiError(errors)
 	}
 
 	return nil
 }
 
-// AdminConfigUpdateRequest_SendBodyMultiError is an error wrapping multiple
-// validation errors returned by
-// AdminConfigUpdateRequest_SendBody.ValidateAll() if the designated
-// constraints aren't met.
-type AdminConfigUpdateRequest_SendBodyMultiError []error
+// AdminDiscrepancyListReply_ListMultiError is an error wrapping multiple
+// validation errors returned by AdminDiscrepancyListReply_List.ValidateAll()
+// if the designated constraints aren't met.
+type AdminDiscrepancyListReply_ListMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m AdminConfigUpdateRequest_SendBodyMultiError) Error() string {
+func (m AdminDiscrepancyListReply_ListMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -13255,12 +22756,12 @@ func (m AdminConfigUpdateRequest_SendBodyMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m AdminConfigUpdateRequest_SendBodyMultiError) AllErrors() []error { return m }
+func (m AdminDiscrepancyListReply_ListMultiError) AllErrors() []error { return m }
 
-// AdminConfigUpdateRequest_SendBodyValidationError is the validation error
-// returned by AdminConfigUpdateRequest_SendBody.Validate if the designated
+// AdminDiscrepancyListReply_ListValidationError is the validation error
+// returned by AdminDiscrepancyListReply_List.Validate if the designated
 // constraints aren't met.
-type AdminConfigUpdateRequest_SendBodyValidationError struct {
+type AdminDiscrepancyListReply_ListValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -13268,24 +22769,24 @@ type AdminConfigUpdateRequest_SendBodyValidationError struct {
 }
 
 // Field function returns field value.
-func (e AdminConfigUpdateRequest_SendBodyValidationError) Field() string { return e.field }
+func (e AdminDiscrepancyListReply_ListValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e AdminConfigUpdateRequest_SendBodyValidationError) Reason() string { return e.reason }
+func (e AdminDiscrepancyListReply_ListValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e AdminConfigUpdateRequest_SendBodyValidationError) Cause() error { return e.cause }
+func (e AdminDiscrepancyListReply_ListValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e AdminConfigUpdateRequest_SendBodyValidationError) Key() bool { return e.key }
+func (e AdminDiscrepancyListReply_ListValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e AdminConfigUpdateRequest_SendBodyValidationError) ErrorName() string {
-	return "AdminConfigUpdateRequest_SendBodyValidationError"
+func (e AdminDiscrepancyListReply_ListValidationError) ErrorName() string {
+	return "AdminDiscrepancyListReply_ListValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e AdminConfigUpdateRequest_SendBodyValidationError) Error() string {
+func (e AdminDiscrepancyListReply_ListValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -13297,14 +22798,14 @@ func (e AdminConfigUpdateRequest_SendBodyValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sAdminConfigUpdateRequest_SendBody.%s: %s%s",
+		"invalid %sAdminDiscrepancyListReply_List.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = AdminConfigUpdateRequest_SendBodyValidationError{}
+var _ error = AdminDiscrepancyListReply_ListValidationError{}
 
 var _ interface {
 	Field() string
@@ -13312,48 +22813,58 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = AdminConfigUpdateRequest_SendBodyValidationError{}
+} = AdminDiscrepancyListReply_ListValidationError{}
 
-// Validate checks the field values on AdminVipUpdateRequest_SendBody with the
+// Validate checks the field values on AdminChainReconcileReply_List with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *AdminVipUpdateRequest_SendBody) Validate() error {
+func (m *AdminChainReconcileReply_List) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on AdminVipUpdateRequest_SendBody with
+// ValidateAll checks the field values on AdminChainReconcileReply_List with
 // the rules defined in the proto definition for this message. If any rules
 // are violated, the result is a list of violation errors wrapped in
-// AdminVipUpdateRequest_SendBodyMultiError, or nil if none found.
-func (m *AdminVipUpdateRequest_SendBody) ValidateAll() error {
+// AdminChainReconcileReply_ListMultiError, or nil if none found.
+func (m *AdminChainReconcileReply_List) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *AdminVipUpdateRequest_SendBody) validate(all bool) error {
+func (m *AdminChainReconcileReply_List) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for UserId
+	// no validation rules for Kind
 
-	// no validation rules for Vip
+	// no validation rules for Issue
+
+	// no validation rules for Hash
+
+	// no validation rules for Address
+
+	// no validation rules for RecordId
+
+	// no validation rules for ChainAmount
+
+	// no validation rules for DbAmount
 
 	if len(errors) > 0 {
-		return AdminVipUpdateRequest_SendBodyMultiError(errors)
+		return AdminChainReconcileReply_ListMultiError(errors)
 	}
 
 	return nil
 }
 
-// AdminVipUpdateRequest_SendBodyMultiError is an error wrapping multiple
-// validation errors returned by AdminVipUpdateRequest_SendBody.ValidateAll()
+// AdminChainReconcileReply_ListMultiError is an error wrapping multiple
+// validation errors returned by AdminChainReconcileReply_List.ValidateAll()
 // if the designated constraints aren't met.
-type AdminVipUpdateRequest_SendBodyMultiError []error
+type AdminChainReconcileReply_ListMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m AdminVipUpdateRequest_SendBodyMultiError) Error() string {
+func (m AdminChainReconcileReply_ListMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -13362,12 +22873,12 @@ func (m AdminVipUpdateRequest_SendBodyMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m AdminVipUpdateRequest_SendBodyMultiError) AllErrors() []error { return m }
+func (m AdminChainReconcileReply_ListMultiError) AllErrors() []error { return m }
 
-// AdminVipUpdateRequest_SendBodyValidationError is the validation error
-// returned by AdminVipUpdateRequest_SendBody.Validate if the designated
+// AdminChainReconcileReply_ListValidationError is the validation error
+// returned by AdminChainReconcileReply_List.Validate if the designated
 // constraints aren't met.
-type AdminVipUpdateRequest_SendBodyValidationError struct {
+type AdminChainReconcileReply_ListValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -13375,24 +22886,24 @@ type AdminVipUpdateRequest_SendBodyValidationError struct {
 }
 
 // Field function returns field value.
-func (e AdminVipUpdateRequest_SendBodyValidationError) Field() string { return e.field }
+func (e AdminChainReconcileReply_ListValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e AdminVipUpdateRequest_SendBodyValidationError) Reason() string { return e.reason }
+func (e AdminChainReconcileReply_ListValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e AdminVipUpdateRequest_SendBodyValidationError) Cause() error { return e.cause }
+func (e AdminChainReconcileReply_ListValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e AdminVipUpdateRequest_SendBodyValidationError) Key() bool { return e.key }
+func (e AdminChainReconcileReply_ListValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e AdminVipUpdateRequest_SendBodyValidationError) ErrorName() string {
-	return "AdminVipUpdateRequest_SendBodyValidationError"
+func (e AdminChainReconcileReply_ListValidationError) ErrorName() string {
+	return "AdminChainReconcileReply_ListValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e AdminVipUpdateRequest_SendBodyValidationError) Error() string {
+func (e AdminChainReconcileReply_ListValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -13404,14 +22915,14 @@ func (e AdminVipUpdateRequest_SendBodyValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sAdminVipUpdateRequest_SendBody.%s: %s%s",
+		"invalid %sAdminChainReconcileReply_List.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = AdminVipUpdateRequest_SendBodyValidationError{}
+var _ error = AdminChainReconcileReply_ListValidationError{}
 
 var _ interface {
 	Field() string
@@ -13419,48 +22930,64 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = AdminVipUpdateRequest_SendBodyValidationError{}
+} = AdminChainReconcileReply_ListValidationError{}
 
-// Validate checks the field values on AdminUndoUpdateRequest_SendBody with the
+// Validate checks the field values on AdminLiabilityListReply_List with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *AdminUndoUpdateRequest_SendBody) Validate() error {
+func (m *AdminLiabilityListReply_List) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on AdminUndoUpdateRequest_SendBody with
-// the rules defined in the proto definition for this message. If any rules
-// are violated, the result is a list of violation errors wrapped in
-// AdminUndoUpdateRequest_SendBodyMultiError, or nil if none found.
-func (m *AdminUndoUpdateRequest_SendBody) ValidateAll() error {
+// ValidateAll checks the field values on AdminLiabilityListReply_List with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AdminLiabilityListReply_ListMultiError, or nil if none found.
+func (m *AdminLiabilityListReply_List) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *AdminUndoUpdateRequest_SendBody) validate(all bool) error {
+func (m *AdminLiabilityListReply_List) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for UserId
+	// no validation rules for Date
 
-	// no validation rules for Undo
+	// no validation rules for UserCount
+
+	// no validation rules for BalanceUsdt
+
+	// no validation rules for BalanceDhb
+
+	// no validation rules for BalanceBnb
+
+	// no validation rules for WithdrawingUsdt
+
+	// no validation rules for WithdrawingDhb
+
+	// no validation rules for LiabilityUsdt
+
+	// no validation rules for LiabilityDhb
+
+	// no validation rules for CreatedAt
 
 	if len(errors) > 0 {
-		return AdminUndoUpdateRequest_SendBodyMultiError(errors)
+		return AdminLiabilityListReply_ListMultiError(errors)
 	}
 
 	return nil
 }
 
-// AdminUndoUpdateRequest_SendBodyMultiError is an error wrapping multiple
-// validation errors returned by AdminUndoUpdateRequest_SendBody.ValidateAll()
-// if the designated constraints aren't met.
-type AdminUndoUpdateRequest_SendBodyMultiError []error
+// AdminLiabilityListReply_ListMultiError is an error wrapping multiple
+// validation errors returned by AdminLiabilityListReply_List.ValidateAll() if
+// the designated constraints aren't met.
+type AdminLiabilityListReply_ListMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m AdminUndoUpdateRequest_SendBodyMultiError) Error() string {
+func (m AdminLiabilityListReply_ListMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -13469,12 +22996,12 @@ func (m AdminUndoUpdateRequest_SendBodyMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m AdminUndoUpdateRequest_SendBodyMultiError) AllErrors() []error { return m }
+func (m AdminLiabilityListReply_ListMultiError) AllErrors() []error { return m }
 
-// AdminUndoUpdateRequest_SendBodyValidationError is the validation error
-// returned by AdminUndoUpdateRequest_SendBody.Validate if the designated
-// constraints aren't met.
-type AdminUndoUpdateRequest_SendBodyValidationError struct {
+// AdminLiabilityListReply_ListValidationError is the validation error returned
+// by AdminLiabilityListReply_List.Validate if the designated constraints
+// aren't met.
+type AdminLiabilityListReply_ListValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -13482,24 +23009,24 @@ type AdminUndoUpdateRequest_SendBodyValidationError struct {
 }
 
 // Field function returns field value.
-func (e AdminUndoUpdateRequest_SendBodyValidationError) Field() string { return e.field }
+func (e AdminLiabilityListReply_ListValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e AdminUndoUpdateRequest_SendBodyValidationError) Reason() string { return e.reason }
+func (e AdminLiabilityListReply_ListValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e AdminUndoUpdateRequest_SendBodyValidationError) Cause() error { return e.cause }
+func (e AdminLiabilityListReply_ListValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e AdminUndoUpdateRequest_SendBodyValidationError) Key() bool { return e.key }
+func (e AdminLiabilityListReply_ListValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e AdminUndoUpdateRequest_SendBodyValidationError) ErrorName() string {
-	return "AdminUndoUpdateRequest_SendBodyValidationError"
+func (e AdminLiabilityListReply_ListValidationError) ErrorName() string {
+	return "AdminLiabilityListReply_ListValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e AdminUndoUpdateRequest_SendBodyValidationError) Error() string {
+func (e AdminLiabilityListReply_ListValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -13511,14 +23038,14 @@ func (e AdminUndoUpdateRequest_SendBodyValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sAdminUndoUpdateRequest_SendBody.%s: %s%s",
+		"invalid %sAdminLiabilityListReply_List.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = AdminUndoUpdateRequest_SendBodyValidationError{}
+var _ error = AdminLiabilityListReply_ListValidationError{}
 
 var _ interface {
 	Field() string
@@ -13526,50 +23053,66 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = AdminUndoUpdateRequest_SendBodyValidationError{}
+} = AdminLiabilityListReply_ListValidationError{}
 
-// Validate checks the field values on AdminAreaLevelUpdateRequest_SendBody
-// with the rules defined in the proto definition for this message. If any
-// rules are violated, the first error encountered is returned, or nil if
-// there are no violations.
-func (m *AdminAreaLevelUpdateRequest_SendBody) Validate() error {
+// Validate checks the field values on AdminUserStatementReply_List with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AdminUserStatementReply_List) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on AdminAreaLevelUpdateRequest_SendBody
-// with the rules defined in the proto definition for this message. If any
-// rules are violated, the result is a list of violation errors wrapped in
-// AdminAreaLevelUpdateRequest_SendBodyMultiError, or nil if none found.
-func (m *AdminAreaLevelUpdateRequest_SendBody) ValidateAll() error {
+// ValidateAll checks the field values on AdminUserStatementReply_List with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AdminUserStatementReply_ListMultiError, or nil if none found.
+func (m *AdminUserStatementReply_List) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *AdminAreaLevelUpdateRequest_SendBody) validate(all bool) error {
+func (m *AdminUserStatementReply_List) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for UserId
+	// no validation rules for CreatedAt
 
-	// no validation rules for Level
+	// no validation rules for Source
+
+	// no validation rules for RecordId
+
+	// no validation rules for Type
+
+	// no validation rules for CoinType
+
+	// no validation rules for Amount
+
+	// no validation rules for Change
+
+	// no validation rules for BalanceUsdt
+
+	// no validation rules for BalanceDhb
+
+	// no validation rules for BalanceBnb
+
+	// no validation rules for Detail
 
 	if len(errors) > 0 {
-		return AdminAreaLevelUpdateRequest_SendBodyMultiError(errors)
+		return AdminUserStatementReply_ListMultiError(errors)
 	}
 
 	return nil
 }
 
-// AdminAreaLevelUpdateRequest_SendBodyMultiError is an error wrapping multiple
-// validation errors returned by
-// AdminAreaLevelUpdateRequest_SendBody.ValidateAll() if the designated
-// constraints aren't met.
-type AdminAreaLevelUpdateRequest_SendBodyMultiError []error
+// AdminUserStatementReply_ListMultiError is an error wrapping multiple
+// validation errors returned by AdminUserStatementReply_List.ValidateAll() if
+// the designated constraints aren't met.
+type AdminUserStatementReply_ListMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m AdminAreaLevelUpdateRequest_SendBodyMultiError) Error() string {
+func (m AdminUserStatementReply_ListMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -13578,12 +23121,12 @@ func (m AdminAreaLevelUpdateRequest_SendBodyMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m AdminAreaLevelUpdateRequest_SendBodyMultiError) AllErrors() []error { return m }
+func (m AdminUserStatementReply_ListMultiError) AllErrors() []error { return m }
 
-// AdminAreaLevelUpdateRequest_SendBodyValidationError is the validation error
-// returned by AdminAreaLevelUpdateRequest_SendBody.Validate if the designated
-// constraints aren't met.
-type AdminAreaLevelUpdateRequest_SendBodyValidationError struct {
+// AdminUserStatementReply_ListValidationError is the validation error returned
+// by AdminUserStatementReply_List.Validate if the designated constraints
+// aren't met.
+type AdminUserStatementReply_ListValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -13591,24 +23134,24 @@ type AdminAreaLevelUpdateRequest_SendBodyValidationError struct {
 }
 
 // Field function returns field value.
-func (e AdminAreaLevelUpdateRequest_SendBodyValidationError) Field() string { return e.field }
+func (e AdminUserStatementReply_ListValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e AdminAreaLevelUpdateRequest_SendBodyValidationError) Reason() string { return e.reason }
+func (e AdminUserStatementReply_ListValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e AdminAreaLevelUpdateRequest_SendBodyValidationError) Cause() error { return e.cause }
+func (e AdminUserStatementReply_ListValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e AdminAreaLevelUpdateRequest_SendBodyValidationError) Key() bool { return e.key }
+func (e AdminUserStatementReply_ListValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e AdminAreaLevelUpdateRequest_SendBodyValidationError) ErrorName() string {
-	return "AdminAreaLevelUpdateRequest_SendBodyValidationError"
+func (e AdminUserStatementReply_ListValidationError) ErrorName() string {
+	return "AdminUserStatementReply_ListValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e AdminAreaLevelUpdateRequest_SendBodyValidationError) Error() string {
+func (e AdminUserStatementReply_ListValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -13620,14 +23163,14 @@ func (e AdminAreaLevelUpdateRequest_SendBodyValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sAdminAreaLevelUpdateRequest_SendBody.%s: %s%s",
+		"invalid %sAdminUserStatementReply_List.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = AdminAreaLevelUpdateRequest_SendBodyValidationError{}
+var _ error = AdminUserStatementReply_ListValidationError{}
 
 var _ interface {
 	Field() string
@@ -13635,50 +23178,70 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = AdminAreaLevelUpdateRequest_SendBodyValidationError{}
+} = AdminUserStatementReply_ListValidationError{}
 
-// Validate checks the field values on AdminLocationInsertRequest_SendBody with
-// the rules defined in the proto definition for this message. If any rules
-// are violated, the first error encountered is returned, or nil if there are
-// no violations.
-func (m *AdminLocationInsertRequest_SendBody) Validate() error {
+// Validate checks the field values on AdminSolvencyListReply_List with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AdminSolvencyListReply_List) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on AdminLocationInsertRequest_SendBody
-// with the rules defined in the proto definition for this message. If any
-// rules are violated, the result is a list of violation errors wrapped in
-// AdminLocationInsertRequest_SendBodyMultiError, or nil if none found.
-func (m *AdminLocationInsertRequest_SendBody) ValidateAll() error {
+// ValidateAll checks the field values on AdminSolvencyListReply_List with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AdminSolvencyListReply_ListMultiError, or nil if none found.
+func (m *AdminSolvencyListReply_List) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *AdminLocationInsertRequest_SendBody) validate(all bool) error {
+func (m *AdminSolvencyListReply_List) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for UserId
+	// no validation rules for LocationOutstanding
 
-	// no validation rules for Amount
+	// no validation rules for WithdrawingUsdt
+
+	// no validation rules for WithdrawingDhb
+
+	// no validation rules for BalanceUsdt
+
+	// no validation rules for BalanceDhb
+
+	// no validation rules for LiabilityUsdt
+
+	// no validation rules for LiabilityDhb
+
+	// no validation rules for WalletUsdt
+
+	// no validation rules for WalletDhb
+
+	// no validation rules for RatioUsdt
+
+	// no validation rules for RatioDhb
+
+	// no validation rules for Alarm
+
+	// no validation rules for CreatedAt
 
 	if len(errors) > 0 {
-		return AdminLocationInsertRequest_SendBodyMultiError(errors)
+		return AdminSolvencyListReply_ListMultiError(errors)
 	}
 
 	return nil
 }
 
-// AdminLocationInsertRequest_SendBodyMultiError is an error wrapping multiple
-// validation errors returned by
-// AdminLocationInsertRequest_SendBody.ValidateAll() if the designated
-// constraints aren't met.
-type AdminLocationInsertRequest_SendBodyMultiError []error
+// AdminSolvencyListReply_ListMultiError is an error wrapping multiple
+// validation errors returned by AdminSolvencyListReply_List.ValidateAll() if
+// the designated constraints aren't met.
+type AdminSolvencyListReply_ListMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m AdminLocationInsertRequest_SendBodyMultiError) Error() string {
+func (m AdminSolvencyListReply_ListMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -13687,12 +23250,12 @@ func (m AdminLocationInsertRequest_SendBodyMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m AdminLocationInsertRequest_SendBodyMultiError) AllErrors() []error { return m }
+func (m AdminSolvencyListReply_ListMultiError) AllErrors() []error { return m }
 
-// AdminLocationInsertRequest_SendBodyValidationError is the validation error
-// returned by AdminLocationInsertRequest_SendBody.Validate if the designated
-// constraints aren't met.
-type AdminLocationInsertRequest_SendBodyValidationError struct {
+// AdminSolvencyListReply_ListValidationError is the validation error returned
+// by AdminSolvencyListReply_List.Validate if the designated constraints
+// aren't met.
+type AdminSolvencyListReply_ListValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -13700,24 +23263,24 @@ type AdminLocationInsertRequest_SendBodyValidationError struct {
 }
 
 // Field function returns field value.
-func (e AdminLocationInsertRequest_SendBodyValidationError) Field() string { return e.field }
+func (e AdminSolvencyListReply_ListValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e AdminLocationInsertRequest_SendBodyValidationError) Reason() string { return e.reason }
+func (e AdminSolvencyListReply_ListValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e AdminLocationInsertRequest_SendBodyValidationError) Cause() error { return e.cause }
+func (e AdminSolvencyListReply_ListValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e AdminLocationInsertRequest_SendBodyValidationError) Key() bool { return e.key }
+func (e AdminSolvencyListReply_ListValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e AdminLocationInsertRequest_SendBodyValidationError) ErrorName() string {
-	return "AdminLocationInsertRequest_SendBodyValidationError"
+func (e AdminSolvencyListReply_ListValidationError) ErrorName() string {
+	return "AdminSolvencyListReply_ListValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e AdminLocationInsertRequest_SendBodyValidationError) Error() string {
+func (e AdminSolvencyListReply_ListValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -13729,14 +23292,14 @@ func (e AdminLocationInsertRequest_SendBodyValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sAdminLocationInsertRequest_SendBody.%s: %s%s",
+		"invalid %sAdminSolvencyListReply_List.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = AdminLocationInsertRequest_SendBodyValidationError{}
+var _ error = AdminSolvencyListReply_ListValidationError{}
 
 var _ interface {
 	Field() string
@@ -13744,25 +23307,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = AdminLocationInsertRequest_SendBodyValidationError{}
+} = AdminSolvencyListReply_ListValidationError{}
 
-// Validate checks the field values on AdminBalanceUpdateRequest_SendBody with
-// the rules defined in the proto definition for this message. If any rules
-// are violated, the first error encountered is returned, or nil if there are
-// no violations.
-func (m *AdminBalanceUpdateRequest_SendBody) Validate() error {
+// Validate checks the field values on AdminDepositSimulateReply_List with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AdminDepositSimulateReply_List) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on AdminBalanceUpdateRequest_SendBody
-// with the rules defined in the proto definition for this message. If any
-// rules are violated, the result is a list of violation errors wrapped in
-// AdminBalanceUpdateRequest_SendBodyMultiError, or nil if none found.
-func (m *AdminBalanceUpdateRequest_SendBody) ValidateAll() error {
+// ValidateAll checks the field values on AdminDepositSimulateReply_List with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// AdminDepositSimulateReply_ListMultiError, or nil if none found.
+func (m *AdminDepositSimulateReply_List) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *AdminBalanceUpdateRequest_SendBody) validate(all bool) error {
+func (m *AdminDepositSimulateReply_List) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -13771,23 +23333,30 @@ func (m *AdminBalanceUpdateRequest_SendBody) validate(all bool) error {
 
 	// no validation rules for UserId
 
+	// no validation rules for LocationId
+
+	// no validation rules for Type
+
 	// no validation rules for Amount
 
+	// no validation rules for Credited
+
+	// no validation rules for Stop
+
 	if len(errors) > 0 {
-		return AdminBalanceUpdateRequest_SendBodyMultiError(errors)
+		return AdminDepositSimulateReply_ListMultiError(errors)
 	}
 
 	return nil
 }
 
-// AdminBalanceUpdateRequest_SendBodyMultiError is an error wrapping multiple
-// validation errors returned by
-// AdminBalanceUpdateRequest_SendBody.ValidateAll() if the designated
-// constraints aren't met.
-type AdminBalanceUpdateRequest_SendBodyMultiError []error
+// AdminDepositSimulateReply_ListMultiError is an error wrapping multiple
+// validation errors returned by AdminDepositSimulateReply_List.ValidateAll()
+// if the designated constraints aren't met.
+type AdminDepositSimulateReply_ListMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m AdminBalanceUpdateRequest_SendBodyMultiError) Error() string {
+func (m AdminDepositSimulateReply_ListMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -13796,12 +23365,12 @@ func (m AdminBalanceUpdateRequest_SendBodyMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m AdminBalanceUpdateRequest_SendBodyMultiError) AllErrors() []error { return m }
+func (m AdminDepositSimulateReply_ListMultiError) AllErrors() []error { return m }
 
-// AdminBalanceUpdateRequest_SendBodyValidationError is the validation error
-// returned by AdminBalanceUpdateRequest_SendBody.Validate if the designated
+// AdminDepositSimulateReply_ListValidationError is the validation error
+// returned by AdminDepositSimulateReply_List.Validate if the designated
 // constraints aren't met.
-type AdminBalanceUpdateRequest_SendBodyValidationError struct {
+type AdminDepositSimulateReply_ListValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -13809,24 +23378,24 @@ type AdminBalanceUpdateRequest_SendBodyValidationError struct {
 }
 
 // Field function returns field value.
-func (e AdminBalanceUpdateRequest_SendBodyValidationError) Field() string { return e.field }
+func (e AdminDepositSimulateReply_ListValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e AdminBalanceUpdateRequest_SendBodyValidationError) Reason() string { return e.reason }
+func (e AdminDepositSimulateReply_ListValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e AdminBalanceUpdateRequest_SendBodyValidationError) Cause() error { return e.cause }
+func (e AdminDepositSimulateReply_ListValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e AdminBalanceUpdateRequest_SendBodyValidationError) Key() bool { return e.key }
+func (e AdminDepositSimulateReply_ListValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e AdminBalanceUpdateRequest_SendBodyValidationError) ErrorName() string {
-	return "AdminBalanceUpdateRequest_SendBodyValidationError"
+func (e AdminDepositSimulateReply_ListValidationError) ErrorName() string {
+	return "AdminDepositSimulateReply_ListValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e AdminBalanceUpdateRequest_SendBodyValidationError) Error() string {
+func (e AdminDepositSimulateReply_ListValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -13838,14 +23407,14 @@ func (e AdminBalanceUpdateRequest_SendBodyValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sAdminBalanceUpdateRequest_SendBody.%s: %s%s",
+		"invalid %sAdminDepositSimulateReply_List.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = AdminBalanceUpdateRequest_SendBodyValidationError{}
+var _ error = AdminDepositSimulateReply_ListValidationError{}
 
 var _ interface {
 	Field() string
@@ -13853,48 +23422,50 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = AdminBalanceUpdateRequest_SendBodyValidationError{}
+} = AdminDepositSimulateReply_ListValidationError{}
 
-// Validate checks the field values on AuthAdminCreateRequest_SendBody with the
-// rules defined in the proto definition for this message. If any rules are
+// Validate checks the field values on RewardDetailReply_Config with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *AuthAdminCreateRequest_SendBody) Validate() error {
+func (m *RewardDetailReply_Config) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on AuthAdminCreateRequest_SendBody with
-// the rules defined in the proto definition for this message. If any rules
-// are violated, the result is a list of violation errors wrapped in
-// AuthAdminCreateRequest_SendBodyMultiError, or nil if none found.
-func (m *AuthAdminCreateRequest_SendBody) ValidateAll() error {
+// ValidateAll checks the field values on RewardDetailReply_Config with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RewardDetailReply_ConfigMultiError, or nil if none found.
+func (m *RewardDetailReply_Config) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *AuthAdminCreateRequest_SendBody) validate(all bool) error {
+func (m *RewardDetailReply_Config) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for AdminId
+	// no validation rules for KeyName
 
-	// no validation rules for AuthId
+	// no validation rules for Name
+
+	// no validation rules for Value
 
 	if len(errors) > 0 {
-		return AuthAdminCreateRequest_SendBodyMultiError(errors)
+		return RewardDetailReply_ConfigMultiError(errors)
 	}
 
 	return nil
 }
 
-// AuthAdminCreateRequest_SendBodyMultiError is an error wrapping multiple
-// validation errors returned by AuthAdminCreateRequest_SendBody.ValidateAll()
-// if the designated constraints aren't met.
-type AuthAdminCreateRequest_SendBodyMultiError []error
+// RewardDetailReply_ConfigMultiError is an error wrapping multiple validation
+// errors returned by RewardDetailReply_Config.ValidateAll() if the designated
+// constraints aren't met.
+type RewardDetailReply_ConfigMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m AuthAdminCreateRequest_SendBodyMultiError) Error() string {
+func (m RewardDetailReply_ConfigMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -13903,12 +23474,11 @@ func (m AuthAdminCreateRequest_SendBodyMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m AuthAdminCreateRequest_SendBodyMultiError) AllErrors() []error { return m }
+func (m RewardDetailReply_ConfigMultiError) AllErrors() []error { return m }
 
-// AuthAdminCreateRequest_SendBodyValidationError is the validation error
-// returned by AuthAdminCreateRequest_SendBody.Validate if the designated
-// constraints aren't met.
-type AuthAdminCreateRequest_SendBodyValidationError struct {
+// RewardDetailReply_ConfigValidationError is the validation error returned by
+// RewardDetailReply_Config.Validate if the designated constraints aren't met.
+type RewardDetailReply_ConfigValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -13916,24 +23486,24 @@ type AuthAdminCreateRequest_SendBodyValidationError struct {
 }
 
 // Field function returns field value.
-func (e AuthAdminCreateRequest_SendBodyValidationError) Field() string { return e.field }
+func (e RewardDetailReply_ConfigValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e AuthAdminCreateRequest_SendBodyValidationError) Reason() string { return e.reason }
+func (e RewardDetailReply_ConfigValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e AuthAdminCreateRequest_SendBodyValidationError) Cause() error { return e.cause }
+func (e RewardDetailReply_ConfigValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e AuthAdminCreateRequest_SendBodyValidationError) Key() bool { return e.key }
+func (e RewardDetailReply_ConfigValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e AuthAdminCreateRequest_SendBodyValidationError) ErrorName() string {
-	return "AuthAdminCreateRequest_SendBodyValidationError"
+func (e RewardDetailReply_ConfigValidationError) ErrorName() string {
+	return "RewardDetailReply_ConfigValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e AuthAdminCreateRequest_SendBodyValidationError) Error() string {
+func (e RewardDetailReply_ConfigValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -13945,14 +23515,14 @@ func (e AuthAdminCreateRequest_SendBodyValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sAuthAdminCreateRequest_SendBody.%s: %s%s",
+		"invalid %sRewardDetailReply_Config.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = AuthAdminCreateRequest_SendBodyValidationError{}
+var _ error = RewardDetailReply_ConfigValidationError{}
 
 var _ interface {
 	Field() string
@@ -13960,48 +23530,50 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = AuthAdminCreateRequest_SendBodyValidationError{}
+} = RewardDetailReply_ConfigValidationError{}
 
-// Validate checks the field values on AuthAdminDeleteRequest_SendBody with the
+// Validate checks the field values on AdminRewardDetailReply_Config with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *AuthAdminDeleteRequest_SendBody) Validate() error {
+func (m *AdminRewardDetailReply_Config) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on AuthAdminDeleteRequest_SendBody with
+// ValidateAll checks the field values on AdminRewardDetailReply_Config with
 // the rules defined in the proto definition for this message. If any rules
 // are violated, the result is a list of violation errors wrapped in
-// AuthAdminDeleteRequest_SendBodyMultiError, or nil if none found.
-func (m *AuthAdminDeleteRequest_SendBody) ValidateAll() error {
+// AdminRewardDetailReply_ConfigMultiError, or nil if none found.
+func (m *AdminRewardDetailReply_Config) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *AuthAdminDeleteRequest_SendBody) validate(all bool) error {
+func (m *AdminRewardDetailReply_Config) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for AdminId
+	// no validation rules for KeyName
 
-	// no validation rules for AuthId
+	// no validation rules for Name
+
+	// no validation rules for Value
 
 	if len(errors) > 0 {
-		return AuthAdminDeleteRequest_SendBodyMultiError(errors)
+		return AdminRewardDetailReply_ConfigMultiError(errors)
 	}
 
 	return nil
 }
 
-// AuthAdminDeleteRequest_SendBodyMultiError is an error wrapping multiple
-// validation errors returned by AuthAdminDeleteRequest_SendBody.ValidateAll()
+// AdminRewardDetailReply_ConfigMultiError is an error wrapping multiple
+// validation errors returned by AdminRewardDetailReply_Config.ValidateAll()
 // if the designated constraints aren't met.
-type AuthAdminDeleteRequest_SendBodyMultiError []error
+type AdminRewardDetailReply_ConfigMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m AuthAdminDeleteRequest_SendBodyMultiError) Error() string {
+func (m AdminRewardDetailReply_ConfigMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -14010,12 +23582,12 @@ func (m AuthAdminDeleteRequest_SendBodyMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m AuthAdminDeleteRequest_SendBodyMultiError) AllErrors() []error { return m }
+func (m AdminRewardDetailReply_ConfigMultiError) AllErrors() []error { return m }
 
-// AuthAdminDeleteRequest_SendBodyValidationError is the validation error
-// returned by AuthAdminDeleteRequest_SendBody.Validate if the designated
+// AdminRewardDetailReply_ConfigValidationError is the validation error
+// returned by AdminRewardDetailReply_Config.Validate if the designated
 // constraints aren't met.
-type AuthAdminDeleteRequest_SendBodyValidationError struct {
+type AdminRewardDetailReply_ConfigValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -14023,24 +23595,24 @@ type AuthAdminDeleteRequest_SendBodyValidationError struct {
 }
 
 // Field function returns field value.
-func (e AuthAdminDeleteRequest_SendBodyValidationError) Field() string { return e.field }
+func (e AdminRewardDetailReply_ConfigValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e AuthAdminDeleteRequest_SendBodyValidationError) Reason() string { return e.reason }
+func (e AdminRewardDetailReply_ConfigValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e AuthAdminDeleteRequest_SendBodyValidationError) Cause() error { return e.cause }
+func (e AdminRewardDetailReply_ConfigValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e AuthAdminDeleteRequest_SendBodyValidationError) Key() bool { return e.key }
+func (e AdminRewardDetailReply_ConfigValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e AuthAdminDeleteRequest_SendBodyValidationError) ErrorName() string {
-	return "AuthAdminDeleteRequest_SendBodyValidationError"
+func (e AdminRewardDetailReply_ConfigValidationError) ErrorName() string {
+	return "AdminRewardDetailReply_ConfigValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e AuthAdminDeleteRequest_SendBodyValidationError) Error() string {
+func (e AdminRewardDetailReply_ConfigValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -14052,14 +23624,14 @@ func (e AuthAdminDeleteRequest_SendBodyValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sAuthAdminDeleteRequest_SendBody.%s: %s%s",
+		"invalid %sAdminRewardDetailReply_Config.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = AuthAdminDeleteRequest_SendBodyValidationError{}
+var _ error = AdminRewardDetailReply_ConfigValidationError{}
 
 var _ interface {
 	Field() string
@@ -14067,48 +23639,52 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = AuthAdminDeleteRequest_SendBodyValidationError{}
+} = AdminRewardDetailReply_ConfigValidationError{}
 
-// Validate checks the field values on AdminLoginRequest_SendBody with the
-// rules defined in the proto definition for this message. If any rules are
+// Validate checks the field values on AdminLockListReply_List with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *AdminLoginRequest_SendBody) Validate() error {
+func (m *AdminLockListReply_List) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on AdminLoginRequest_SendBody with the
+// ValidateAll checks the field values on AdminLockListReply_List with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// AdminLoginRequest_SendBodyMultiError, or nil if none found.
-func (m *AdminLoginRequest_SendBody) ValidateAll() error {
+// AdminLockListReply_ListMultiError, or nil if none found.
+func (m *AdminLockListReply_List) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *AdminLoginRequest_SendBody) validate(all bool) error {
+func (m *AdminLockListReply_List) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Account
+	// no validation rules for Name
 
-	// no validation rules for Password
+	// no validation rules for Holder
+
+	// no validation rules for Token
+
+	// no validation rules for ExpireAt
 
 	if len(errors) > 0 {
-		return AdminLoginRequest_SendBodyMultiError(errors)
+		return AdminLockListReply_ListMultiError(errors)
 	}
 
 	return nil
 }
 
-// AdminLoginRequest_SendBodyMultiError is an error wrapping multiple
-// validation errors returned by AdminLoginRequest_SendBody.ValidateAll() if
-// the designated constraints aren't met.
-type AdminLoginRequest_SendBodyMultiError []error
+// AdminLockListReply_ListMultiError is an error wrapping multiple validation
+// errors returned by AdminLockListReply_List.ValidateAll() if the designated
+// constraints aren't met.
+type AdminLockListReply_ListMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m AdminLoginRequest_SendBodyMultiError) Error() string {
+func (m AdminLockListReply_ListMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -14117,11 +23693,11 @@ func (m AdminLoginRequest_SendBodyMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m AdminLoginRequest_SendBodyMultiError) AllErrors() []error { return m }
+func (m AdminLockListReply_ListMultiError) AllErrors() []error { return m }
 
-// AdminLoginRequest_SendBodyValidationError is the validation error returned
-// by AdminLoginRequest_SendBody.Validate if the designated constraints aren't met.
-type AdminLoginRequest_SendBodyValidationError struct {
+// AdminLockListReply_ListValidationError is the validation error returned by
+// AdminLockListReply_List.Validate if the designated constraints aren't met.
+type AdminLockListReply_ListValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -14129,24 +23705,24 @@ type AdminLoginRequest_SendBodyValidationError struct {
 }
 
 // Field function returns field value.
-func (e AdminLoginRequest_SendBodyValidationError) Field() string { return e.field }
+func (e AdminLockListReply_ListValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e AdminLoginRequest_SendBodyValidationError) Reason() string { return e.reason }
+func (e AdminLockListReply_ListValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e AdminLoginRequest_SendBodyValidationError) Cause() error { return e.cause }
+func (e AdminLockListReply_ListValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e AdminLoginRequest_SendBodyValidationError) Key() bool { return e.key }
+func (e AdminLockListReply_ListValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e AdminLoginRequest_SendBodyValidationError) ErrorName() string {
-	return "AdminLoginRequest_SendBodyValidationError"
+func (e AdminLockListReply_ListValidationError) ErrorName() string {
+	return "AdminLockListReply_ListValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e AdminLoginRequest_SendBodyValidationError) Error() string {
+func (e AdminLockListReply_ListValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -14158,14 +23734,14 @@ func (e AdminLoginRequest_SendBodyValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sAdminLoginRequest_SendBody.%s: %s%s",
+		"invalid %sAdminLockListReply_List.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = AdminLoginRequest_SendBodyValidationError{}
+var _ error = AdminLockListReply_ListValidationError{}
 
 var _ interface {
 	Field() string
@@ -14173,50 +23749,48 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = AdminLoginRequest_SendBodyValidationError{}
+} = AdminLockListReply_ListValidationError{}
 
-// Validate checks the field values on AdminChangePasswordRequest_SendBody with
-// the rules defined in the proto definition for this message. If any rules
-// are violated, the first error encountered is returned, or nil if there are
-// no violations.
-func (m *AdminChangePasswordRequest_SendBody) Validate() error {
+// Validate checks the field values on ReinvestRequest_SendBody with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ReinvestRequest_SendBody) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on AdminChangePasswordRequest_SendBody
-// with the rules defined in the proto definition for this message. If any
-// rules are violated, the result is a list of violation errors wrapped in
-// AdminChangePasswordRequest_SendBodyMultiError, or nil if none found.
-func (m *AdminChangePasswordRequest_SendBody) ValidateAll() error {
+// ValidateAll checks the field values on ReinvestRequest_SendBody with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ReinvestRequest_SendBodyMultiError, or nil if none found.
+func (m *ReinvestRequest_SendBody) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *AdminChangePasswordRequest_SendBody) validate(all bool) error {
+func (m *ReinvestRequest_SendBody) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Account
+	// no validation rules for Amount
 
-	// no validation rules for Password
+	// no validation rules for IdempotencyKey
 
 	if len(errors) > 0 {
-		return AdminChangePasswordRequest_SendBodyMultiError(errors)
+		return ReinvestRequest_SendBodyMultiError(errors)
 	}
 
 	return nil
 }
 
-// AdminChangePasswordRequest_SendBodyMultiError is an error wrapping multiple
-// validation errors returned by
-// AdminChangePasswordRequest_SendBody.ValidateAll() if the designated
+// ReinvestRequest_SendBodyMultiError is an error wrapping multiple validation
+// errors returned by ReinvestRequest_SendBody.ValidateAll() if the designated
 // constraints aren't met.
-type AdminChangePasswordRequest_SendBodyMultiError []error
+type ReinvestRequest_SendBodyMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m AdminChangePasswordRequest_SendBodyMultiError) Error() string {
+func (m ReinvestRequest_SendBodyMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -14225,12 +23799,11 @@ func (m AdminChangePasswordRequest_SendBodyMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m AdminChangePasswordRequest_SendBodyMultiError) AllErrors() []error { return m }
+func (m ReinvestRequest_SendBodyMultiError) AllErrors() []error { return m }
 
-// AdminChangePasswordRequest_SendBodyValidationError is the validation error
-// returned by AdminChangePasswordRequest_SendBody.Validate if the designated
-// constraints aren't met.
-type AdminChangePasswordRequest_SendBodyValidationError struct {
+// ReinvestRequest_SendBodyValidationError is the validation error returned by
+// ReinvestRequest_SendBody.Validate if the designated constraints aren't met.
+type ReinvestRequest_SendBodyValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -14238,24 +23811,24 @@ type AdminChangePasswordRequest_SendBodyValidationError struct {
 }
 
 // Field function returns field value.
-func (e AdminChangePasswordRequest_SendBodyValidationError) Field() string { return e.field }
+func (e ReinvestRequest_SendBodyValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e AdminChangePasswordRequest_SendBodyValidationError) Reason() string { return e.reason }
+func (e ReinvestRequest_SendBodyValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e AdminChangePasswordRequest_SendBodyValidationError) Cause() error { return e.cause }
+func (e ReinvestRequest_SendBodyValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e AdminChangePasswordRequest_SendBodyValidationError) Key() bool { return e.key }
+func (e ReinvestRequest_SendBodyValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e AdminChangePasswordRequest_SendBodyValidationError) ErrorName() string {
-	return "AdminChangePasswordRequest_SendBodyValidationError"
+func (e ReinvestRequest_SendBodyValidationError) ErrorName() string {
+	return "ReinvestRequest_SendBodyValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e AdminChangePasswordRequest_SendBodyValidationError) Error() string {
+func (e ReinvestRequest_SendBodyValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -14267,14 +23840,14 @@ func (e AdminChangePasswordRequest_SendBodyValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sAdminChangePasswordRequest_SendBody.%s: %s%s",
+		"invalid %sReinvestRequest_SendBody.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = AdminChangePasswordRequest_SendBodyValidationError{}
+var _ error = ReinvestRequest_SendBodyValidationError{}
 
 var _ interface {
 	Field() string
@@ -14282,50 +23855,64 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = AdminChangePasswordRequest_SendBodyValidationError{}
+} = ReinvestRequest_SendBodyValidationError{}
 
-// Validate checks the field values on AdminCreateAccountRequest_SendBody with
-// the rules defined in the proto definition for this message. If any rules
-// are violated, the first error encountered is returned, or nil if there are
-// no violations.
-func (m *AdminCreateAccountRequest_SendBody) Validate() error {
+// Validate checks the field values on LocationProgressReply_List with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *LocationProgressReply_List) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on AdminCreateAccountRequest_SendBody
-// with the rules defined in the proto definition for this message. If any
-// rules are violated, the result is a list of violation errors wrapped in
-// AdminCreateAccountRequest_SendBodyMultiError, or nil if none found.
-func (m *AdminCreateAccountRequest_SendBody) ValidateAll() error {
+// ValidateAll checks the field values on LocationProgressReply_List with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// LocationProgressReply_ListMultiError, or nil if none found.
+func (m *LocationProgressReply_List) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *AdminCreateAccountRequest_SendBody) validate(all bool) error {
+func (m *LocationProgressReply_List) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Account
+	// no validation rules for Id
 
-	// no validation rules for Password
+	// no validation rules for Row
+
+	// no validation rules for Col
+
+	// no validation rules for CurrentLevel
+
+	// no validation rules for Current
+
+	// no validation rules for CurrentMax
+
+	// no validation rules for Percent
+
+	// no validation rules for Status
+
+	// no validation rules for StopDate
+
+	// no validation rules for CreatedAt
 
 	if len(errors) > 0 {
-		return AdminCreateAccountRequest_SendBodyMultiError(errors)
+		return LocationProgressReply_ListMultiError(errors)
 	}
 
 	return nil
 }
 
-// AdminCreateAccountRequest_SendBodyMultiError is an error wrapping multiple
-// validation errors returned by
-// AdminCreateAccountRequest_SendBody.ValidateAll() if the designated
-// constraints aren't met.
-type AdminCreateAccountRequest_SendBodyMultiError []error
+// LocationProgressReply_ListMultiError is an error wrapping multiple
+// validation errors returned by LocationProgressReply_List.ValidateAll() if
+// the designated constraints aren't met.
+type LocationProgressReply_ListMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m AdminCreateAccountRequest_SendBodyMultiError) Error() string {
+func (m LocationProgressReply_ListMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -14334,12 +23921,11 @@ func (m AdminCreateAccountRequest_SendBodyMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m AdminCreateAccountRequest_SendBodyMultiError) AllErrors() []error { return m }
+func (m LocationProgressReply_ListMultiError) AllErrors() []error { return m }
 
-// AdminCreateAccountRequest_SendBodyValidationError is the validation error
-// returned by AdminCreateAccountRequest_SendBody.Validate if the designated
-// constraints aren't met.
-type AdminCreateAccountRequest_SendBodyValidationError struct {
+// LocationProgressReply_ListValidationError is the validation error returned
+// by LocationProgressReply_List.Validate if the designated constraints aren't met.
+type LocationProgressReply_ListValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -14347,24 +23933,24 @@ type AdminCreateAccountRequest_SendBodyValidationError struct {
 }
 
 // Field function returns field value.
-func (e AdminCreateAccountRequest_SendBodyValidationError) Field() string { return e.field }
+func (e LocationProgressReply_ListValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e AdminCreateAccountRequest_SendBodyValidationError) Reason() string { return e.reason }
+func (e LocationProgressReply_ListValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e AdminCreateAccountRequest_SendBodyValidationError) Cause() error { return e.cause }
+func (e LocationProgressReply_ListValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e AdminCreateAccountRequest_SendBodyValidationError) Key() bool { return e.key }
+func (e LocationProgressReply_ListValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e AdminCreateAccountRequest_SendBodyValidationError) ErrorName() string {
-	return "AdminCreateAccountRequest_SendBodyValidationError"
+func (e LocationProgressReply_ListValidationError) ErrorName() string {
+	return "LocationProgressReply_ListValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e AdminCreateAccountRequest_SendBodyValidationError) Error() string {
+func (e LocationProgressReply_ListValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -14376,14 +23962,14 @@ func (e AdminCreateAccountRequest_SendBodyValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sAdminCreateAccountRequest_SendBody.%s: %s%s",
+		"invalid %sLocationProgressReply_List.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = AdminCreateAccountRequest_SendBodyValidationError{}
+var _ error = LocationProgressReply_ListValidationError{}
 
 var _ interface {
 	Field() string
@@ -14391,4 +23977,4 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = AdminCreateAccountRequest_SendBodyValidationError{}
+} = LocationProgressReply_ListValidationError{}
@@ -29,6 +29,7 @@ type AppClient interface {
 	WithdrawList(ctx context.Context, in *WithdrawListRequest, opts ...grpc.CallOption) (*WithdrawListReply, error)
 	RecommendList(ctx context.Context, in *RecommendListRequest, opts ...grpc.CallOption) (*RecommendListReply, error)
 	Withdraw(ctx context.Context, in *WithdrawRequest, opts ...grpc.CallOption) (*WithdrawReply, error)
+	WithdrawCancel(ctx context.Context, in *WithdrawCancelRequest, opts ...grpc.CallOption) (*WithdrawCancelReply, error)
 	Deposit(ctx context.Context, in *DepositRequest, opts ...grpc.CallOption) (*DepositReply, error)
 	AdminRewardList(ctx context.Context, in *AdminRewardListRequest, opts ...grpc.CallOption) (*AdminRewardListReply, error)
 	AdminRewardBnbList(ctx context.Context, in *AdminRewardBnbListRequest, opts ...grpc.CallOption) (*AdminRewardBnbListReply, error)
@@ -69,6 +70,34 @@ type AppClient interface {
 	AdminDailyWithdrawReward(ctx context.Context, in *AdminDailyWithdrawRewardRequest, opts ...grpc.CallOption) (*AdminDailyWithdrawRewardReply, error)
 	FixReward(ctx context.Context, in *FixRewardRequest, opts ...grpc.CallOption) (*FixRewardReply, error)
 	FixLocations(ctx context.Context, in *FixLocationsRequest, opts ...grpc.CallOption) (*FixLocationsReply, error)
+	AdminAuditLogList(ctx context.Context, in *AdminAuditLogListRequest, opts ...grpc.CallOption) (*AdminAuditLogListReply, error)
+	AdminProposalList(ctx context.Context, in *AdminProposalListRequest, opts ...grpc.CallOption) (*AdminProposalListReply, error)
+	AdminProposalApprove(ctx context.Context, in *AdminProposalApproveRequest, opts ...grpc.CallOption) (*AdminProposalApproveReply, error)
+	AdminProposalReject(ctx context.Context, in *AdminProposalRejectRequest, opts ...grpc.CallOption) (*AdminProposalRejectReply, error)
+	AdminWithdrawReviewList(ctx context.Context, in *AdminWithdrawReviewListRequest, opts ...grpc.CallOption) (*AdminWithdrawReviewListReply, error)
+	AdminWithdrawReviewApprove(ctx context.Context, in *AdminWithdrawReviewApproveRequest, opts ...grpc.CallOption) (*AdminWithdrawReviewApproveReply, error)
+	AdminWithdrawReviewReject(ctx context.Context, in *AdminWithdrawReviewRejectRequest, opts ...grpc.CallOption) (*AdminWithdrawReviewRejectReply, error)
+	AdminWithdrawReject(ctx context.Context, in *AdminWithdrawRejectRequest, opts ...grpc.CallOption) (*AdminWithdrawRejectReply, error)
+	AdminWithdrawEventList(ctx context.Context, in *AdminWithdrawEventListRequest, opts ...grpc.CallOption) (*AdminWithdrawEventListReply, error)
+	AdminLedgerVerify(ctx context.Context, in *AdminLedgerVerifyRequest, opts ...grpc.CallOption) (*AdminLedgerVerifyReply, error)
+	AdminLedgerOpen(ctx context.Context, in *AdminLedgerOpenRequest, opts ...grpc.CallOption) (*AdminLedgerOpenReply, error)
+	AdminReconcile(ctx context.Context, in *AdminReconcileRequest, opts ...grpc.CallOption) (*AdminReconcileReply, error)
+	AdminDiscrepancyList(ctx context.Context, in *AdminDiscrepancyListRequest, opts ...grpc.CallOption) (*AdminDiscrepancyListReply, error)
+	AdminChainReconcile(ctx context.Context, in *AdminChainReconcileRequest, opts ...grpc.CallOption) (*AdminChainReconcileReply, error)
+	AdminBalanceSnapshot(ctx context.Context, in *AdminBalanceSnapshotRequest, opts ...grpc.CallOption) (*AdminBalanceSnapshotReply, error)
+	AdminUserBalanceHistory(ctx context.Context, in *AdminUserBalanceHistoryRequest, opts ...grpc.CallOption) (*AdminUserBalanceHistoryReply, error)
+	AdminLiabilityList(ctx context.Context, in *AdminLiabilityListRequest, opts ...grpc.CallOption) (*AdminLiabilityListReply, error)
+	AdminUserStatement(ctx context.Context, in *AdminUserStatementRequest, opts ...grpc.CallOption) (*AdminUserStatementReply, error)
+	AdminSolvency(ctx context.Context, in *AdminSolvencyRequest, opts ...grpc.CallOption) (*AdminSolvencyReply, error)
+	AdminSolvencyCheck(ctx context.Context, in *AdminSolvencyCheckRequest, opts ...grpc.CallOption) (*AdminSolvencyCheckReply, error)
+	AdminSolvencyList(ctx context.Context, in *AdminSolvencyListRequest, opts ...grpc.CallOption) (*AdminSolvencyListReply, error)
+	AdminDepositSimulate(ctx context.Context, in *AdminDepositSimulateRequest, opts ...grpc.CallOption) (*AdminDepositSimulateReply, error)
+	RewardDetail(ctx context.Context, in *RewardDetailRequest, opts ...grpc.CallOption) (*RewardDetailReply, error)
+	AdminRewardDetail(ctx context.Context, in *AdminRewardDetailRequest, opts ...grpc.CallOption) (*AdminRewardDetailReply, error)
+	AdminVipRecalc(ctx context.Context, in *AdminVipRecalcRequest, opts ...grpc.CallOption) (*AdminVipRecalcReply, error)
+	AdminLockList(ctx context.Context, in *AdminLockListRequest, opts ...grpc.CallOption) (*AdminLockListReply, error)
+	Reinvest(ctx context.Context, in *ReinvestRequest, opts ...grpc.CallOption) (*ReinvestReply, error)
+	LocationProgress(ctx context.Context, in *LocationProgressRequest, opts ...grpc.CallOption) (*LocationProgressReply, error)
 }
 
 type appClient struct {
@@ -142,6 +171,15 @@ func (c *appClient) Withdraw(ctx context.Context, in *WithdrawRequest, opts ...g
 	return out, nil
 }
 
+func (c *appClient) WithdrawCancel(ctx context.Context, in *WithdrawCancelRequest, opts ...grpc.CallOption) (*WithdrawCancelReply, error) {
+	out := new(WithdrawCancelReply)
+	err := c.cc.Invoke(ctx, "/api.App/WithdrawCancel", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *appClient) Deposit(ctx context.Context, in *DepositRequest, opts ...grpc.CallOption) (*DepositReply, error) {
 	out := new(DepositReply)
 	err := c.cc.Invoke(ctx, "/api.App/Deposit", in, out, opts...)
@@ -502,6 +540,258 @@ func (c *appClient) FixLocations(ctx context.Context, in *FixLocationsRequest, o
 	return out, nil
 }
 
+func (c *appClient) AdminAuditLogList(ctx context.Context, in *AdminAuditLogListRequest, opts ...grpc.CallOption) (*AdminAuditLogListReply, error) {
+	out := new(AdminAuditLogListReply)
+	err := c.cc.Invoke(ctx, "/api.App/AdminAuditLogList", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *appClient) AdminProposalList(ctx context.Context, in *AdminProposalListRequest, opts ...grpc.CallOption) (*AdminProposalListReply, error) {
+	out := new(AdminProposalListReply)
+	err := c.cc.Invoke(ctx, "/api.App/AdminProposalList", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *appClient) AdminProposalApprove(ctx context.Context, in *AdminProposalApproveRequest, opts ...grpc.CallOption) (*AdminProposalApproveReply, error) {
+	out := new(AdminProposalApproveReply)
+	err := c.cc.Invoke(ctx, "/api.App/AdminProposalApprove", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *appClient) AdminProposalReject(ctx context.Context, in *AdminProposalRejectRequest, opts ...grpc.CallOption) (*AdminProposalRejectReply, error) {
+	out := new(AdminProposalRejectReply)
+	err := c.cc.Invoke(ctx, "/api.App/AdminProposalReject", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *appClient) AdminWithdrawReviewList(ctx context.Context, in *AdminWithdrawReviewListRequest, opts ...grpc.CallOption) (*AdminWithdrawReviewListReply, error) {
+	out := new(AdminWithdrawReviewListReply)
+	err := c.cc.Invoke(ctx, "/api.App/AdminWithdrawReviewList", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *appClient) AdminWithdrawReviewApprove(ctx context.Context, in *AdminWithdrawReviewApproveRequest, opts ...grpc.CallOption) (*AdminWithdrawReviewApproveReply, error) {
+	out := new(AdminWithdrawReviewApproveReply)
+	err := c.cc.Invoke(ctx, "/api.App/AdminWithdrawReviewApprove", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *appClient) AdminWithdrawReviewReject(ctx context.Context, in *AdminWithdrawReviewRejectRequest, opts ...grpc.CallOption) (*AdminWithdrawReviewRejectReply, error) {
+	out := new(AdminWithdrawReviewRejectReply)
+	err := c.cc.Invoke(ctx, "/api.App/AdminWithdrawReviewReject", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *appClient) AdminWithdrawReject(ctx context.Context, in *AdminWithdrawRejectRequest, opts ...grpc.CallOption) (*AdminWithdrawRejectReply, error) {
+	out := new(AdminWithdrawRejectReply)
+	err := c.cc.Invoke(ctx, "/api.App/AdminWithdrawReject", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *appClient) AdminWithdrawEventList(ctx context.Context, in *AdminWithdrawEventListRequest, opts ...grpc.CallOption) (*AdminWithdrawEventListReply, error) {
+	out := new(AdminWithdrawEventListReply)
+	err := c.cc.Invoke(ctx, "/api.App/AdminWithdrawEventList", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *appClient) AdminLedgerVerify(ctx context.Context, in *AdminLedgerVerifyRequest, opts ...grpc.CallOption) (*AdminLedgerVerifyReply, error) {
+	out := new(AdminLedgerVerifyReply)
+	err := c.cc.Invoke(ctx, "/api.App/AdminLedgerVerify", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *appClient) AdminLedgerOpen(ctx context.Context, in *AdminLedgerOpenRequest, opts ...grpc.CallOption) (*AdminLedgerOpenReply, error) {
+	out := new(AdminLedgerOpenReply)
+	err := c.cc.Invoke(ctx, "/api.App/AdminLedgerOpen", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *appClient) AdminReconcile(ctx context.Context, in *AdminReconcileRequest, opts ...grpc.CallOption) (*AdminReconcileReply, error) {
+	out := new(AdminReconcileReply)
+	err := c.cc.Invoke(ctx, "/api.App/AdminReconcile", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *appClient) AdminDiscrepancyList(ctx context.Context, in *AdminDiscrepancyListRequest, opts ...grpc.CallOption) (*AdminDiscrepancyListReply, error) {
+	out := new(AdminDiscrepancyListReply)
+	err := c.cc.Invoke(ctx, "/api.App/AdminDiscrepancyList", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *appClient) AdminChainReconcile(ctx context.Context, in *AdminChainReconcileRequest, opts ...grpc.CallOption) (*AdminChainReconcileReply, error) {
+	out := new(AdminChainReconcileReply)
+	err := c.cc.Invoke(ctx, "/api.App/AdminChainReconcile", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *appClient) AdminBalanceSnapshot(ctx context.Context, in *AdminBalanceSnapshotRequest, opts ...grpc.CallOption) (*AdminBalanceSnapshotReply, error) {
+	out := new(AdminBalanceSnapshotReply)
+	err := c.cc.Invoke(ctx, "/api.App/AdminBalanceSnapshot", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *appClient) AdminUserBalanceHistory(ctx context.Context, in *AdminUserBalanceHistoryRequest, opts ...grpc.CallOption) (*AdminUserBalanceHistoryReply, error) {
+	out := new(AdminUserBalanceHistoryReply)
+	err := c.cc.Invoke(ctx, "/api.App/AdminUserBalanceHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *appClient) AdminLiabilityList(ctx context.Context, in *AdminLiabilityListRequest, opts ...grpc.CallOption) (*AdminLiabilityListReply, error) {
+	out := new(AdminLiabilityListReply)
+	err := c.cc.Invoke(ctx, "/api.App/AdminLiabilityList", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *appClient) AdminUserStatement(ctx context.Context, in *AdminUserStatementRequest, opts ...grpc.CallOption) (*AdminUserStatementReply, error) {
+	out := new(AdminUserStatementReply)
+	err := c.cc.Invoke(ctx, "/api.App/AdminUserStatement", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *appClient) AdminSolvency(ctx context.Context, in *AdminSolvencyRequest, opts ...grpc.CallOption) (*AdminSolvencyReply, error) {
+	out := new(AdminSolvencyReply)
+	err := c.cc.Invoke(ctx, "/api.App/AdminSolvency", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *appClient) AdminSolvencyCheck(ctx context.Context, in *AdminSolvencyCheckRequest, opts ...grpc.CallOption) (*AdminSolvencyCheckReply, error) {
+	out := new(AdminSolvencyCheckReply)
+	err := c.cc.Invoke(ctx, "/api.App/AdminSolvencyCheck", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *appClient) AdminSolvencyList(ctx context.Context, in *AdminSolvencyListRequest, opts ...grpc.CallOption) (*AdminSolvencyListReply, error) {
+	out := new(AdminSolvencyListReply)
+	err := c.cc.Invoke(ctx, "/api.App/AdminSolvencyList", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *appClient) AdminDepositSimulate(ctx context.Context, in *AdminDepositSimulateRequest, opts ...grpc.CallOption) (*AdminDepositSimulateReply, error) {
+	out := new(AdminDepositSimulateReply)
+	err := c.cc.Invoke(ctx, "/api.App/AdminDepositSimulate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *appClient) RewardDetail(ctx context.Context, in *RewardDetailRequest, opts ...grpc.CallOption) (*RewardDetailReply, error) {
+	out := new(RewardDetailReply)
+	err := c.cc.Invoke(ctx, "/api.App/RewardDetail", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *appClient) AdminRewardDetail(ctx context.Context, in *AdminRewardDetailRequest, opts ...grpc.CallOption) (*AdminRewardDetailReply, error) {
+	out := new(AdminRewardDetailReply)
+	err := c.cc.Invoke(ctx, "/api.App/AdminRewardDetail", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *appClient) AdminVipRecalc(ctx context.Context, in *AdminVipRecalcRequest, opts ...grpc.CallOption) (*AdminVipRecalcReply, error) {
+	out := new(AdminVipRecalcReply)
+	err := c.cc.Invoke(ctx, "/api.App/AdminVipRecalc", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *appClient) AdminLockList(ctx context.Context, in *AdminLockListRequest, opts ...grpc.CallOption) (*AdminLockListReply, error) {
+	out := new(AdminLockListReply)
+	err := c.cc.Invoke(ctx, "/api.App/AdminLockList", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *appClient) Reinvest(ctx context.Context, in *ReinvestRequest, opts ...grpc.CallOption) (*ReinvestReply, error) {
+	out := new(ReinvestReply)
+	err := c.cc.Invoke(ctx, "/api.App/Reinvest", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *appClient) LocationProgress(ctx context.Context, in *LocationProgressRequest, opts ...grpc.CallOption) (*LocationProgressReply, error) {
+	out := new(LocationProgressReply)
+	err := c.cc.Invoke(ctx, "/api.App/LocationProgress", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // AppServer is the server API for App service.
 // All implementations must embed UnimplementedAppServer
 // for forward compatibility
@@ -513,6 +803,7 @@ type AppServer interface {
 	WithdrawList(context.Context, *WithdrawListRequest) (*WithdrawListReply, error)
 	RecommendList(context.Context, *RecommendListRequest) (*RecommendListReply, error)
 	Withdraw(context.Context, *WithdrawRequest) (*WithdrawReply, error)
+	WithdrawCancel(context.Context, *WithdrawCancelRequest) (*WithdrawCancelReply, error)
 	Deposit(context.Context, *DepositRequest) (*DepositReply, error)
 	AdminRewardList(context.Context, *AdminRewardListRequest) (*AdminRewardListReply, error)
 	AdminRewardBnbList(context.Context, *AdminRewardBnbListRequest) (*AdminRewardBnbListReply, error)
@@ -553,6 +844,34 @@ type AppServer interface {
 	AdminDailyWithdrawReward(context.Context, *AdminDailyWithdrawRewardRequest) (*AdminDailyWithdrawRewardReply, error)
 	FixReward(context.Context, *FixRewardRequest) (*FixRewardReply, error)
 	FixLocations(context.Context, *FixLocationsRequest) (*FixLocationsReply, error)
+	AdminAuditLogList(context.Context, *AdminAuditLogListRequest) (*AdminAuditLogListReply, error)
+	AdminProposalList(context.Context, *AdminProposalListRequest) (*AdminProposalListReply, error)
+	AdminProposalApprove(context.Context, *AdminProposalApproveRequest) (*AdminProposalApproveReply, error)
+	AdminProposalReject(context.Context, *AdminProposalRejectRequest) (*AdminProposalRejectReply, error)
+	AdminWithdrawReviewList(context.Context, *AdminWithdrawReviewListRequest) (*AdminWithdrawReviewListReply, error)
+	AdminWithdrawReviewApprove(context.Context, *AdminWithdrawReviewApproveRequest) (*AdminWithdrawReviewApproveReply, error)
+	AdminWithdrawReviewReject(context.Context, *AdminWithdrawReviewRejectRequest) (*AdminWithdrawReviewRejectReply, error)
+	AdminWithdrawReject(context.Context, *AdminWithdrawRejectRequest) (*AdminWithdrawRejectReply, error)
+	AdminWithdrawEventList(context.Context, *AdminWithdrawEventListRequest) (*AdminWithdrawEventListReply, error)
+	AdminLedgerVerify(context.Context, *AdminLedgerVerifyRequest) (*AdminLedgerVerifyReply, error)
+	AdminLedgerOpen(context.Context, *AdminLedgerOpenRequest) (*AdminLedgerOpenReply, error)
+	AdminReconcile(context.Context, *AdminReconcileRequest) (*AdminReconcileReply, error)
+	AdminDiscrepancyList(context.Context, *AdminDiscrepancyListRequest) (*AdminDiscrepancyListReply, error)
+	AdminChainReconcile(context.Context, *AdminChainReconcileRequest) (*AdminChainReconcileReply, error)
+	AdminBalanceSnapshot(context.Context, *AdminBalanceSnapshotRequest) (*AdminBalanceSnapshotReply, error)
+	AdminUserBalanceHistory(context.Context, *AdminUserBalanceHistoryRequest) (*AdminUserBalanceHistoryReply, error)
+	AdminLiabilityList(context.Context, *AdminLiabilityListRequest) (*AdminLiabilityListReply, error)
+	AdminUserStatement(context.Context, *AdminUserStatementRequest) (*AdminUserStatementReply, error)
+	AdminSolvency(context.Context, *AdminSolvencyRequest) (*AdminSolvencyReply, error)
+	AdminSolvencyCheck(context.Context, *AdminSolvencyCheckRequest) (*AdminSolvencyCheckReply, error)
+	AdminSolvencyList(context.Context, *AdminSolvencyListRequest) (*AdminSolvencyListReply, error)
+	AdminDepositSimulate(context.Context, *AdminDepositSimulateRequest) (*AdminDepositSimulateReply, error)
+	RewardDetail(context.Context, *RewardDetailRequest) (*RewardDetailReply, error)
+	AdminRewardDetail(context.Context, *AdminRewardDetailRequest) (*AdminRewardDetailReply, error)
+	AdminVipRecalc(context.Context, *AdminVipRecalcRequest) (*AdminVipRecalcReply, error)
+	AdminLockList(context.Context, *AdminLockListRequest) (*AdminLockListReply, error)
+	Reinvest(context.Context, *ReinvestRequest) (*ReinvestReply, error)
+	LocationProgress(context.Context, *LocationProgressRequest) (*LocationProgressReply, error)
 	mustEmbedUnimplementedAppServer()
 }
 
@@ -581,6 +900,9 @@ func (UnimplementedAppServer) RecommendList(context.Context, *RecommendListReque
 func (UnimplementedAppServer) Withdraw(context.Context, *WithdrawRequest) (*WithdrawReply, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Withdraw not implemented")
 }
+func (UnimplementedAppServer) WithdrawCancel(context.Context, *WithdrawCancelRequest) (*WithdrawCancelReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WithdrawCancel not implemented")
+}
 func (UnimplementedAppServer) Deposit(context.Context, *DepositRequest) (*DepositReply, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Deposit not implemented")
 }
@@ -701,6 +1023,90 @@ func (UnimplementedAppServer) FixReward(context.Context, *FixRewardRequest) (*Fi
 func (UnimplementedAppServer) FixLocations(context.Context, *FixLocationsRequest) (*FixLocationsReply, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method FixLocations not implemented")
 }
+func (UnimplementedAppServer) AdminAuditLogList(context.Context, *AdminAuditLogListRequest) (*AdminAuditLogListReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AdminAuditLogList not implemented")
+}
+func (UnimplementedAppServer) AdminProposalList(context.Context, *AdminProposalListRequest) (*AdminProposalListReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AdminProposalList not implemented")
+}
+func (UnimplementedAppServer) AdminProposalApprove(context.Context, *AdminProposalApproveRequest) (*AdminProposalApproveReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AdminProposalApprove not implemented")
+}
+func (UnimplementedAppServer) AdminProposalReject(context.Context, *AdminProposalRejectRequest) (*AdminProposalRejectReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AdminProposalReject not implemented")
+}
+func (UnimplementedAppServer) AdminWithdrawReviewList(context.Context, *AdminWithdrawReviewListRequest) (*AdminWithdrawReviewListReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AdminWithdrawReviewList not implemented")
+}
+func (UnimplementedAppServer) AdminWithdrawReviewApprove(context.Context, *AdminWithdrawReviewApproveRequest) (*AdminWithdrawReviewApproveReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AdminWithdrawReviewApprove not implemented")
+}
+func (UnimplementedAppServer) AdminWithdrawReviewReject(context.Context, *AdminWithdrawReviewRejectRequest) (*AdminWithdrawReviewRejectReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AdminWithdrawReviewReject not implemented")
+}
+func (UnimplementedAppServer) AdminWithdrawReject(context.Context, *AdminWithdrawRejectRequest) (*AdminWithdrawRejectReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AdminWithdrawReject not implemented")
+}
+func (UnimplementedAppServer) AdminWithdrawEventList(context.Context, *AdminWithdrawEventListRequest) (*AdminWithdrawEventListReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AdminWithdrawEventList not implemented")
+}
+func (UnimplementedAppServer) AdminLedgerVerify(context.Context, *AdminLedgerVerifyRequest) (*AdminLedgerVerifyReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AdminLedgerVerify not implemented")
+}
+func (UnimplementedAppServer) AdminLedgerOpen(context.Context, *AdminLedgerOpenRequest) (*AdminLedgerOpenReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AdminLedgerOpen not implemented")
+}
+func (UnimplementedAppServer) AdminReconcile(context.Context, *AdminReconcileRequest) (*AdminReconcileReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AdminReconcile not implemented")
+}
+func (UnimplementedAppServer) AdminDiscrepancyList(context.Context, *AdminDiscrepancyListRequest) (*AdminDiscrepancyListReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AdminDiscrepancyList not implemented")
+}
+func (UnimplementedAppServer) AdminChainReconcile(context.Context, *AdminChainReconcileRequest) (*AdminChainReconcileReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AdminChainReconcile not implemented")
+}
+func (UnimplementedAppServer) AdminBalanceSnapshot(context.Context, *AdminBalanceSnapshotRequest) (*AdminBalanceSnapshotReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AdminBalanceSnapshot not implemented")
+}
+func (UnimplementedAppServer) AdminUserBalanceHistory(context.Context, *AdminUserBalanceHistoryRequest) (*AdminUserBalanceHistoryReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AdminUserBalanceHistory not implemented")
+}
+func (UnimplementedAppServer) AdminLiabilityList(context.Context, *AdminLiabilityListRequest) (*AdminLiabilityListReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AdminLiabilityList not implemented")
+}
+func (UnimplementedAppServer) AdminUserStatement(context.Context, *AdminUserStatementRequest) (*AdminUserStatementReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AdminUserStatement not implemented")
+}
+func (UnimplementedAppServer) AdminSolvency(context.Context, *AdminSolvencyRequest) (*AdminSolvencyReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AdminSolvency not implemented")
+}
+func (UnimplementedAppServer) AdminSolvencyCheck(context.Context, *AdminSolvencyCheckRequest) (*AdminSolvencyCheckReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AdminSolvencyCheck not implemented")
+}
+func (UnimplementedAppServer) AdminSolvencyList(context.Context, *AdminSolvencyListRequest) (*AdminSolvencyListReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AdminSolvencyList not implemented")
+}
+func (UnimplementedAppServer) AdminDepositSimulate(context.Context, *AdminDepositSimulateRequest) (*AdminDepositSimulateReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AdminDepositSimulate not implemented")
+}
+func (UnimplementedAppServer) RewardDetail(context.Context, *RewardDetailRequest) (*RewardDetailReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RewardDetail not implemented")
+}
+func (UnimplementedAppServer) AdminRewardDetail(context.Context, *AdminRewardDetailRequest) (*AdminRewardDetailReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AdminRewardDetail not implemented")
+}
+func (UnimplementedAppServer) AdminVipRecalc(context.Context, *AdminVipRecalcRequest) (*AdminVipRecalcReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AdminVipRecalc not implemented")
+}
+func (UnimplementedAppServer) AdminLockList(context.Context, *AdminLockListRequest) (*AdminLockListReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AdminLockList not implemented")
+}
+func (UnimplementedAppServer) Reinvest(context.Context, *ReinvestRequest) (*ReinvestReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Reinvest not implemented")
+}
+func (UnimplementedAppServer) LocationProgress(context.Context, *LocationProgressRequest) (*LocationProgressReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LocationProgress not implemented")
+}
 func (UnimplementedAppServer) mustEmbedUnimplementedAppServer() {}
 
 // UnsafeAppServer may be embedded to opt out of forward compatibility for this service.
@@ -840,6 +1246,24 @@ func _App_Withdraw_Handler(srv interface{}, ctx context.Context, dec func(interf
 	return interceptor(ctx, in, info, handler)
 }
 
+func _App_WithdrawCancel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WithdrawCancelRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AppServer).WithdrawCancel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.App/WithdrawCancel",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AppServer).WithdrawCancel(ctx, req.(*WithdrawCancelRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _App_Deposit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(DepositRequest)
 	if err := dec(in); err != nil {
@@ -1560,46 +1984,554 @@ func _App_FixLocations_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
-// App_ServiceDesc is the grpc.ServiceDesc for App service.
-// It's only intended for direct use with grpc.RegisterService,
-// and not to be introspected or modified (even as a copy)
-var App_ServiceDesc = grpc.ServiceDesc{
-	ServiceName: "api.App",
-	HandlerType: (*AppServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "UserInfo",
-			Handler:    _App_UserInfo_Handler,
-		},
-		{
-			MethodName: "RewardList",
-			Handler:    _App_RewardList_Handler,
-		},
-		{
-			MethodName: "RecommendRewardList",
-			Handler:    _App_RecommendRewardList_Handler,
-		},
-		{
-			MethodName: "FeeRewardList",
-			Handler:    _App_FeeRewardList_Handler,
-		},
-		{
-			MethodName: "WithdrawList",
-			Handler:    _App_WithdrawList_Handler,
-		},
-		{
-			MethodName: "RecommendList",
-			Handler:    _App_RecommendList_Handler,
-		},
-		{
-			MethodName: "Withdraw",
-			Handler:    _App_Withdraw_Handler,
-		},
-		{
-			MethodName: "Deposit",
-			Handler:    _App_Deposit_Handler,
-		},
-		{
+func _App_AdminAuditLogList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdminAuditLogListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AppServer).AdminAuditLogList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.App/AdminAuditLogList",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AppServer).AdminAuditLogList(ctx, req.(*AdminAuditLogListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _App_AdminProposalList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdminProposalListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AppServer).AdminProposalList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.App/AdminProposalList",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AppServer).AdminProposalList(ctx, req.(*AdminProposalListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _App_AdminProposalApprove_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdminProposalApproveRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AppServer).AdminProposalApprove(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.App/AdminProposalApprove",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AppServer).AdminProposalApprove(ctx, req.(*AdminProposalApproveRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _App_AdminProposalReject_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdminProposalRejectRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AppServer).AdminProposalReject(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.App/AdminProposalReject",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AppServer).AdminProposalReject(ctx, req.(*AdminProposalRejectRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _App_AdminWithdrawReviewList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdminWithdrawReviewListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AppServer).AdminWithdrawReviewList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.App/AdminWithdrawReviewList",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AppServer).AdminWithdrawReviewList(ctx, req.(*AdminWithdrawReviewListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _App_AdminWithdrawReviewApprove_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdminWithdrawReviewApproveRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AppServer).AdminWithdrawReviewApprove(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.App/AdminWithdrawReviewApprove",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AppServer).AdminWithdrawReviewApprove(ctx, req.(*AdminWithdrawReviewApproveRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _App_AdminWithdrawReviewReject_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdminWithdrawReviewRejectRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AppServer).AdminWithdrawReviewReject(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.App/AdminWithdrawReviewReject",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AppServer).AdminWithdrawReviewReject(ctx, req.(*AdminWithdrawReviewRejectRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _App_AdminWithdrawReject_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdminWithdrawRejectRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AppServer).AdminWithdrawReject(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.App/AdminWithdrawReject",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AppServer).AdminWithdrawReject(ctx, req.(*AdminWithdrawRejectRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _App_AdminWithdrawEventList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdminWithdrawEventListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AppServer).AdminWithdrawEventList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.App/AdminWithdrawEventList",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AppServer).AdminWithdrawEventList(ctx, req.(*AdminWithdrawEventListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _App_AdminLedgerVerify_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdminLedgerVerifyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AppServer).AdminLedgerVerify(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.App/AdminLedgerVerify",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AppServer).AdminLedgerVerify(ctx, req.(*AdminLedgerVerifyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _App_AdminLedgerOpen_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdminLedgerOpenRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AppServer).AdminLedgerOpen(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.App/AdminLedgerOpen",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AppServer).AdminLedgerOpen(ctx, req.(*AdminLedgerOpenRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _App_AdminReconcile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdminReconcileRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AppServer).AdminReconcile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.App/AdminReconcile",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AppServer).AdminReconcile(ctx, req.(*AdminReconcileRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _App_AdminDiscrepancyList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdminDiscrepancyListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AppServer).AdminDiscrepancyList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.App/AdminDiscrepancyList",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AppServer).AdminDiscrepancyList(ctx, req.(*AdminDiscrepancyListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _App_AdminChainReconcile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdminChainReconcileRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AppServer).AdminChainReconcile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.App/AdminChainReconcile",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AppServer).AdminChainReconcile(ctx, req.(*AdminChainReconcileRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _App_AdminBalanceSnapshot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdminBalanceSnapshotRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AppServer).AdminBalanceSnapshot(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.App/AdminBalanceSnapshot",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AppServer).AdminBalanceSnapshot(ctx, req.(*AdminBalanceSnapshotRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _App_AdminUserBalanceHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdminUserBalanceHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AppServer).AdminUserBalanceHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.App/AdminUserBalanceHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AppServer).AdminUserBalanceHistory(ctx, req.(*AdminUserBalanceHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _App_AdminLiabilityList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdminLiabilityListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AppServer).AdminLiabilityList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.App/AdminLiabilityList",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AppServer).AdminLiabilityList(ctx, req.(*AdminLiabilityListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _App_AdminUserStatement_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdminUserStatementRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AppServer).AdminUserStatement(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.App/AdminUserStatement",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AppServer).AdminUserStatement(ctx, req.(*AdminUserStatementRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _App_AdminSolvency_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdminSolvencyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AppServer).AdminSolvency(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.App/AdminSolvency",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AppServer).AdminSolvency(ctx, req.(*AdminSolvencyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _App_AdminSolvencyCheck_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdminSolvencyCheckRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AppServer).AdminSolvencyCheck(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.App/AdminSolvencyCheck",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AppServer).AdminSolvencyCheck(ctx, req.(*AdminSolvencyCheckRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _App_AdminSolvencyList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdminSolvencyListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AppServer).AdminSolvencyList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.App/AdminSolvencyList",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AppServer).AdminSolvencyList(ctx, req.(*AdminSolvencyListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _App_AdminDepositSimulate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdminDepositSimulateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AppServer).AdminDepositSimulate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.App/AdminDepositSimulate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AppServer).AdminDepositSimulate(ctx, req.(*AdminDepositSimulateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _App_RewardDetail_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RewardDetailRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AppServer).RewardDetail(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.App/RewardDetail",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AppServer).RewardDetail(ctx, req.(*RewardDetailRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _App_AdminRewardDetail_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdminRewardDetailRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AppServer).AdminRewardDetail(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.App/AdminRewardDetail",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AppServer).AdminRewardDetail(ctx, req.(*AdminRewardDetailRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _App_AdminVipRecalc_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdminVipRecalcRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AppServer).AdminVipRecalc(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.App/AdminVipRecalc",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AppServer).AdminVipRecalc(ctx, req.(*AdminVipRecalcRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _App_AdminLockList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdminLockListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AppServer).AdminLockList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.App/AdminLockList",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AppServer).AdminLockList(ctx, req.(*AdminLockListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _App_Reinvest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReinvestRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AppServer).Reinvest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.App/Reinvest",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AppServer).Reinvest(ctx, req.(*ReinvestRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _App_LocationProgress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LocationProgressRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AppServer).LocationProgress(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.App/LocationProgress",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AppServer).LocationProgress(ctx, req.(*LocationProgressRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// App_ServiceDesc is the grpc.ServiceDesc for App service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var App_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "api.App",
+	HandlerType: (*AppServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "UserInfo",
+			Handler:    _App_UserInfo_Handler,
+		},
+		{
+			MethodName: "RewardList",
+			Handler:    _App_RewardList_Handler,
+		},
+		{
+			MethodName: "RecommendRewardList",
+			Handler:    _App_RecommendRewardList_Handler,
+		},
+		{
+			MethodName: "FeeRewardList",
+			Handler:    _App_FeeRewardList_Handler,
+		},
+		{
+			MethodName: "WithdrawList",
+			Handler:    _App_WithdrawList_Handler,
+		},
+		{
+			MethodName: "RecommendList",
+			Handler:    _App_RecommendList_Handler,
+		},
+		{
+			MethodName: "Withdraw",
+			Handler:    _App_Withdraw_Handler,
+		},
+		{
+			MethodName: "WithdrawCancel",
+			Handler:    _App_WithdrawCancel_Handler,
+		},
+		{
+			MethodName: "Deposit",
+			Handler:    _App_Deposit_Handler,
+		},
+		{
 			MethodName: "AdminRewardList",
 			Handler:    _App_AdminRewardList_Handler,
 		},
@@ -1755,6 +2687,118 @@ var App_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "FixLocations",
 			Handler:    _App_FixLocations_Handler,
 		},
+		{
+			MethodName: "AdminAuditLogList",
+			Handler:    _App_AdminAuditLogList_Handler,
+		},
+		{
+			MethodName: "AdminProposalList",
+			Handler:    _App_AdminProposalList_Handler,
+		},
+		{
+			MethodName: "AdminProposalApprove",
+			Handler:    _App_AdminProposalApprove_Handler,
+		},
+		{
+			MethodName: "AdminProposalReject",
+			Handler:    _App_AdminProposalReject_Handler,
+		},
+		{
+			MethodName: "AdminWithdrawReviewList",
+			Handler:    _App_AdminWithdrawReviewList_Handler,
+		},
+		{
+			MethodName: "AdminWithdrawReviewApprove",
+			Handler:    _App_AdminWithdrawReviewApprove_Handler,
+		},
+		{
+			MethodName: "AdminWithdrawReviewReject",
+			Handler:    _App_AdminWithdrawReviewReject_Handler,
+		},
+		{
+			MethodName: "AdminWithdrawReject",
+			Handler:    _App_AdminWithdrawReject_Handler,
+		},
+		{
+			MethodName: "AdminWithdrawEventList",
+			Handler:    _App_AdminWithdrawEventList_Handler,
+		},
+		{
+			MethodName: "AdminLedgerVerify",
+			Handler:    _App_AdminLedgerVerify_Handler,
+		},
+		{
+			MethodName: "AdminLedgerOpen",
+			Handler:    _App_AdminLedgerOpen_Handler,
+		},
+		{
+			MethodName: "AdminReconcile",
+			Handler:    _App_AdminReconcile_Handler,
+		},
+		{
+			MethodName: "AdminDiscrepancyList",
+			Handler:    _App_AdminDiscrepancyList_Handler,
+		},
+		{
+			MethodName: "AdminChainReconcile",
+			Handler:    _App_AdminChainReconcile_Handler,
+		},
+		{
+			MethodName: "AdminBalanceSnapshot",
+			Handler:    _App_AdminBalanceSnapshot_Handler,
+		},
+		{
+			MethodName: "AdminUserBalanceHistory",
+			Handler:    _App_AdminUserBalanceHistory_Handler,
+		},
+		{
+			MethodName: "AdminLiabilityList",
+			Handler:    _App_AdminLiabilityList_Handler,
+		},
+		{
+			MethodName: "AdminUserStatement",
+			Handler:    _App_AdminUserStatement_Handler,
+		},
+		{
+			MethodName: "AdminSolvency",
+			Handler:    _App_AdminSolvency_Handler,
+		},
+		{
+			MethodName: "AdminSolvencyCheck",
+			Handler:    _App_AdminSolvencyCheck_Handler,
+		},
+		{
+			MethodName: "AdminSolvencyList",
+			Handler:    _App_AdminSolvencyList_Handler,
+		},
+		{
+			MethodName: "AdminDepositSimulate",
+			Handler:    _App_AdminDepositSimulate_Handler,
+		},
+		{
+			MethodName: "RewardDetail",
+			Handler:    _App_RewardDetail_Handler,
+		},
+		{
+			MethodName: "AdminRewardDetail",
+			Handler:    _App_AdminRewardDetail_Handler,
+		},
+		{
+			MethodName: "AdminVipRecalc",
+			Handler:    _App_AdminVipRecalc_Handler,
+		},
+		{
+			MethodName: "AdminLockList",
+			Handler:    _App_AdminLockList_Handler,
+		},
+		{
+			MethodName: "Reinvest",
+			Handler:    _App_Reinvest_Handler,
+		},
+		{
+			MethodName: "LocationProgress",
+			Handler:    _App_LocationProgress_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "app/app/api/app.proto",
@@ -21,7 +21,10 @@ const _ = http.SupportPackageIsVersion1
 
 const OperationAppAdminAll = "/api.App/AdminAll"
 const OperationAppAdminAreaLevelUpdate = "/api.App/AdminAreaLevelUpdate"
+const OperationAppAdminAuditLogList = "/api.App/AdminAuditLogList"
+const OperationAppAdminBalanceSnapshot = "/api.App/AdminBalanceSnapshot"
 const OperationAppAdminBalanceUpdate = "/api.App/AdminBalanceUpdate"
+const OperationAppAdminChainReconcile = "/api.App/AdminChainReconcile"
 const OperationAppAdminChangePassword = "/api.App/AdminChangePassword"
 const OperationAppAdminConfig = "/api.App/AdminConfig"
 const OperationAppAdminConfigUpdate = "/api.App/AdminConfigUpdate"
@@ -30,23 +33,45 @@ const OperationAppAdminDailyFee = "/api.App/AdminDailyFee"
 const OperationAppAdminDailyRecommendReward = "/api.App/AdminDailyRecommendReward"
 const OperationAppAdminDailyRecommendTopReward = "/api.App/AdminDailyRecommendTopReward"
 const OperationAppAdminDailyWithdrawReward = "/api.App/AdminDailyWithdrawReward"
+const OperationAppAdminDepositSimulate = "/api.App/AdminDepositSimulate"
+const OperationAppAdminDiscrepancyList = "/api.App/AdminDiscrepancyList"
 const OperationAppAdminFee = "/api.App/AdminFee"
+const OperationAppAdminLedgerOpen = "/api.App/AdminLedgerOpen"
+const OperationAppAdminLedgerVerify = "/api.App/AdminLedgerVerify"
+const OperationAppAdminLiabilityList = "/api.App/AdminLiabilityList"
 const OperationAppAdminList = "/api.App/AdminList"
 const OperationAppAdminLocationAllList = "/api.App/AdminLocationAllList"
 const OperationAppAdminLocationInsert = "/api.App/AdminLocationInsert"
 const OperationAppAdminLocationList = "/api.App/AdminLocationList"
+const OperationAppAdminLockList = "/api.App/AdminLockList"
 const OperationAppAdminLogin = "/api.App/AdminLogin"
 const OperationAppAdminMonthRecommend = "/api.App/AdminMonthRecommend"
+const OperationAppAdminProposalApprove = "/api.App/AdminProposalApprove"
+const OperationAppAdminProposalList = "/api.App/AdminProposalList"
+const OperationAppAdminProposalReject = "/api.App/AdminProposalReject"
+const OperationAppAdminReconcile = "/api.App/AdminReconcile"
 const OperationAppAdminRewardBnbList = "/api.App/AdminRewardBnbList"
+const OperationAppAdminRewardDetail = "/api.App/AdminRewardDetail"
 const OperationAppAdminRewardList = "/api.App/AdminRewardList"
+const OperationAppAdminSolvency = "/api.App/AdminSolvency"
+const OperationAppAdminSolvencyCheck = "/api.App/AdminSolvencyCheck"
+const OperationAppAdminSolvencyList = "/api.App/AdminSolvencyList"
 const OperationAppAdminUndoUpdate = "/api.App/AdminUndoUpdate"
+const OperationAppAdminUserBalanceHistory = "/api.App/AdminUserBalanceHistory"
 const OperationAppAdminUserList = "/api.App/AdminUserList"
 const OperationAppAdminUserRecommend = "/api.App/AdminUserRecommend"
+const OperationAppAdminUserStatement = "/api.App/AdminUserStatement"
+const OperationAppAdminVipRecalc = "/api.App/AdminVipRecalc"
 const OperationAppAdminVipUpdate = "/api.App/AdminVipUpdate"
 const OperationAppAdminWithdraw = "/api.App/AdminWithdraw"
 const OperationAppAdminWithdrawDoingToRewarded = "/api.App/AdminWithdrawDoingToRewarded"
 const OperationAppAdminWithdrawEth = "/api.App/AdminWithdrawEth"
+const OperationAppAdminWithdrawEventList = "/api.App/AdminWithdrawEventList"
 const OperationAppAdminWithdrawList = "/api.App/AdminWithdrawList"
+const OperationAppAdminWithdrawReject = "/api.App/AdminWithdrawReject"
+const OperationAppAdminWithdrawReviewApprove = "/api.App/AdminWithdrawReviewApprove"
+const OperationAppAdminWithdrawReviewList = "/api.App/AdminWithdrawReviewList"
+const OperationAppAdminWithdrawReviewReject = "/api.App/AdminWithdrawReviewReject"
 const OperationAppAuthAdminCreate = "/api.App/AuthAdminCreate"
 const OperationAppAuthAdminDelete = "/api.App/AuthAdminDelete"
 const OperationAppAuthList = "/api.App/AuthList"
@@ -57,20 +82,27 @@ const OperationAppDeposit = "/api.App/Deposit"
 const OperationAppFeeRewardList = "/api.App/FeeRewardList"
 const OperationAppFixLocations = "/api.App/FixLocations"
 const OperationAppFixReward = "/api.App/FixReward"
+const OperationAppLocationProgress = "/api.App/LocationProgress"
 const OperationAppMyAuthList = "/api.App/MyAuthList"
 const OperationAppRecommendList = "/api.App/RecommendList"
 const OperationAppRecommendRewardList = "/api.App/RecommendRewardList"
+const OperationAppReinvest = "/api.App/Reinvest"
+const OperationAppRewardDetail = "/api.App/RewardDetail"
 const OperationAppRewardList = "/api.App/RewardList"
 const OperationAppUploadRecommendUser = "/api.App/UploadRecommendUser"
 const OperationAppUserAuthList = "/api.App/UserAuthList"
 const OperationAppUserInfo = "/api.App/UserInfo"
 const OperationAppWithdraw = "/api.App/Withdraw"
+const OperationAppWithdrawCancel = "/api.App/WithdrawCancel"
 const OperationAppWithdrawList = "/api.App/WithdrawList"
 
 type AppHTTPServer interface {
 	AdminAll(context.Context, *AdminAllRequest) (*AdminAllReply, error)
 	AdminAreaLevelUpdate(context.Context, *AdminAreaLevelUpdateRequest) (*AdminAreaLevelUpdateReply, error)
+	AdminAuditLogList(context.Context, *AdminAuditLogListRequest) (*AdminAuditLogListReply, error)
+	AdminBalanceSnapshot(context.Context, *AdminBalanceSnapshotRequest) (*AdminBalanceSnapshotReply, error)
 	AdminBalanceUpdate(context.Context, *AdminBalanceUpdateRequest) (*AdminBalanceUpdateReply, error)
+	AdminChainReconcile(context.Context, *AdminChainReconcileRequest) (*AdminChainReconcileReply, error)
 	AdminChangePassword(context.Context, *AdminChangePasswordRequest) (*AdminChangePasswordReply, error)
 	AdminConfig(context.Context, *AdminConfigRequest) (*AdminConfigReply, error)
 	AdminConfigUpdate(context.Context, *AdminConfigUpdateRequest) (*AdminConfigUpdateReply, error)
@@ -79,23 +111,45 @@ type AppHTTPServer interface {
 	AdminDailyRecommendReward(context.Context, *AdminDailyRecommendRewardRequest) (*AdminDailyRecommendRewardReply, error)
 	AdminDailyRecommendTopReward(context.Context, *AdminDailyRecommendTopRewardRequest) (*AdminDailyRecommendTopRewardReply, error)
 	AdminDailyWithdrawReward(context.Context, *AdminDailyWithdrawRewardRequest) (*AdminDailyWithdrawRewardReply, error)
+	AdminDepositSimulate(context.Context, *AdminDepositSimulateRequest) (*AdminDepositSimulateReply, error)
+	AdminDiscrepancyList(context.Context, *AdminDiscrepancyListRequest) (*AdminDiscrepancyListReply, error)
 	AdminFee(context.Context, *AdminFeeRequest) (*AdminFeeReply, error)
+	AdminLedgerOpen(context.Context, *AdminLedgerOpenRequest) (*AdminLedgerOpenReply, error)
+	AdminLedgerVerify(context.Context, *AdminLedgerVerifyRequest) (*AdminLedgerVerifyReply, error)
+	AdminLiabilityList(context.Context, *AdminLiabilityListRequest) (*AdminLiabilityListReply, error)
 	AdminList(context.Context, *AdminListRequest) (*AdminListReply, error)
 	AdminLocationAllList(context.Context, *AdminLocationAllListRequest) (*AdminLocationAllListReply, error)
 	AdminLocationInsert(context.Context, *AdminLocationInsertRequest) (*AdminLocationInsertReply, error)
 	AdminLocationList(context.Context, *AdminLocationListRequest) (*AdminLocationListReply, error)
+	AdminLockList(context.Context, *AdminLockListRequest) (*AdminLockListReply, error)
 	AdminLogin(context.Context, *AdminLoginRequest) (*AdminLoginReply, error)
 	AdminMonthRecommend(context.Context, *AdminMonthRecommendRequest) (*AdminMonthRecommendReply, error)
+	AdminProposalApprove(context.Context, *AdminProposalApproveRequest) (*AdminProposalApproveReply, error)
+	AdminProposalList(context.Context, *AdminProposalListRequest) (*AdminProposalListReply, error)
+	AdminProposalReject(context.Context, *AdminProposalRejectRequest) (*AdminProposalRejectReply, error)
+	AdminReconcile(context.Context, *AdminReconcileRequest) (*AdminReconcileReply, error)
 	AdminRewardBnbList(context.Context, *AdminRewardBnbListRequest) (*AdminRewardBnbListReply, error)
+	AdminRewardDetail(context.Context, *AdminRewardDetailRequest) (*AdminRewardDetailReply, error)
 	AdminRewardList(context.Context, *AdminRewardListRequest) (*AdminRewardListReply, error)
+	AdminSolvency(context.Context, *AdminSolvencyRequest) (*AdminSolvencyReply, error)
+	AdminSolvencyCheck(context.Context, *AdminSolvencyCheckRequest) (*AdminSolvencyCheckReply, error)
+	AdminSolvencyList(context.Context, *AdminSolvencyListRequest) (*AdminSolvencyListReply, error)
 	AdminUndoUpdate(context.Context, *AdminUndoUpdateRequest) (*AdminUndoUpdateReply, error)
+	AdminUserBalanceHistory(context.Context, *AdminUserBalanceHistoryRequest) (*AdminUserBalanceHistoryReply, error)
 	AdminUserList(context.Context, *AdminUserListRequest) (*AdminUserListReply, error)
 	AdminUserRecommend(context.Context, *AdminUserRecommendRequest) (*AdminUserRecommendReply, error)
+	AdminUserStatement(context.Context, *AdminUserStatementRequest) (*AdminUserStatementReply, error)
+	AdminVipRecalc(context.Context, *AdminVipRecalcRequest) (*AdminVipRecalcReply, error)
 	AdminVipUpdate(context.Context, *AdminVipUpdateRequest) (*AdminVipUpdateReply, error)
 	AdminWithdraw(context.Context, *AdminWithdrawRequest) (*AdminWithdrawReply, error)
 	AdminWithdrawDoingToRewarded(context.Context, *AdminWithdrawDoingToRewardedRequest) (*AdminWithdrawDoingToRewardedReply, error)
 	AdminWithdrawEth(context.Context, *AdminWithdrawEthRequest) (*AdminWithdrawEthReply, error)
+	AdminWithdrawEventList(context.Context, *AdminWithdrawEventListRequest) (*AdminWithdrawEventListReply, error)
 	AdminWithdrawList(context.Context, *AdminWithdrawListRequest) (*AdminWithdrawListReply, error)
+	AdminWithdrawReject(context.Context, *AdminWithdrawRejectRequest) (*AdminWithdrawRejectReply, error)
+	AdminWithdrawReviewApprove(context.Context, *AdminWithdrawReviewApproveRequest) (*AdminWithdrawReviewApproveReply, error)
+	AdminWithdrawReviewList(context.Context, *AdminWithdrawReviewListRequest) (*AdminWithdrawReviewListReply, error)
+	AdminWithdrawReviewReject(context.Context, *AdminWithdrawReviewRejectRequest) (*AdminWithdrawReviewRejectReply, error)
 	AuthAdminCreate(context.Context, *AuthAdminCreateRequest) (*AuthAdminCreateReply, error)
 	AuthAdminDelete(context.Context, *AuthAdminDeleteRequest) (*AuthAdminDeleteReply, error)
 	AuthList(context.Context, *AuthListRequest) (*AuthListReply, error)
@@ -106,14 +160,18 @@ type AppHTTPServer interface {
 	FeeRewardList(context.Context, *FeeRewardListRequest) (*FeeRewardListReply, error)
 	FixLocations(context.Context, *FixLocationsRequest) (*FixLocationsReply, error)
 	FixReward(context.Context, *FixRewardRequest) (*FixRewardReply, error)
+	LocationProgress(context.Context, *LocationProgressRequest) (*LocationProgressReply, error)
 	MyAuthList(context.Context, *MyAuthListRequest) (*MyAuthListReply, error)
 	RecommendList(context.Context, *RecommendListRequest) (*RecommendListReply, error)
 	RecommendRewardList(context.Context, *RecommendRewardListRequest) (*RecommendRewardListReply, error)
+	Reinvest(context.Context, *ReinvestRequest) (*ReinvestReply, error)
+	RewardDetail(context.Context, *RewardDetailRequest) (*RewardDetailReply, error)
 	RewardList(context.Context, *RewardListRequest) (*RewardListReply, error)
 	UploadRecommendUser(context.Context, *UploadRecommendUserRequest) (*UploadRecommendUserReply, error)
 	UserAuthList(context.Context, *UserAuthListRequest) (*UserAuthListReply, error)
 	UserInfo(context.Context, *UserInfoRequest) (*UserInfoReply, error)
 	Withdraw(context.Context, *WithdrawRequest) (*WithdrawReply, error)
+	WithdrawCancel(context.Context, *WithdrawCancelRequest) (*WithdrawCancelReply, error)
 	WithdrawList(context.Context, *WithdrawListRequest) (*WithdrawListReply, error)
 }
 
@@ -126,6 +184,7 @@ func RegisterAppHTTPServer(s *http.Server, srv AppHTTPServer) {
 	r.GET("/api/app_server/withdraw_list", _App_WithdrawList0_HTTP_Handler(srv))
 	r.GET("/api/app_server/recommend_list", _App_RecommendList0_HTTP_Handler(srv))
 	r.POST("/api/app_server/withdraw", _App_Withdraw0_HTTP_Handler(srv))
+	r.POST("/api/app_server/withdraw_cancel", _App_WithdrawCancel0_HTTP_Handler(srv))
 	r.GET("/api/admin_dhb/deposit", _App_Deposit0_HTTP_Handler(srv))
 	r.GET("/api/admin_dhb/reward_list", _App_AdminRewardList0_HTTP_Handler(srv))
 	r.GET("/api/admin_dhb/reward_bnb_list", _App_AdminRewardBnbList0_HTTP_Handler(srv))
@@ -166,6 +225,34 @@ func RegisterAppHTTPServer(s *http.Server, srv AppHTTPServer) {
 	r.GET("/api/admin_dhb/daily_withdraw_reward", _App_AdminDailyWithdrawReward0_HTTP_Handler(srv))
 	r.GET("/api/admin_dhb/fix_reward", _App_FixReward0_HTTP_Handler(srv))
 	r.GET("/api/admin_dhb/fix_locations", _App_FixLocations0_HTTP_Handler(srv))
+	r.GET("/api/admin_dhb/audit_log_list", _App_AdminAuditLogList0_HTTP_Handler(srv))
+	r.GET("/api/admin_dhb/proposal_list", _App_AdminProposalList0_HTTP_Handler(srv))
+	r.POST("/api/admin_dhb/proposal_approve", _App_AdminProposalApprove0_HTTP_Handler(srv))
+	r.POST("/api/admin_dhb/proposal_reject", _App_AdminProposalReject0_HTTP_Handler(srv))
+	r.GET("/api/admin_dhb/withdraw_review_list", _App_AdminWithdrawReviewList0_HTTP_Handler(srv))
+	r.POST("/api/admin_dhb/withdraw_review_approve", _App_AdminWithdrawReviewApprove0_HTTP_Handler(srv))
+	r.POST("/api/admin_dhb/withdraw_review_reject", _App_AdminWithdrawReviewReject0_HTTP_Handler(srv))
+	r.POST("/api/admin_dhb/withdraw_reject", _App_AdminWithdrawReject0_HTTP_Handler(srv))
+	r.GET("/api/admin_dhb/withdraw_event_list", _App_AdminWithdrawEventList0_HTTP_Handler(srv))
+	r.GET("/api/admin_dhb/ledger_verify", _App_AdminLedgerVerify0_HTTP_Handler(srv))
+	r.POST("/api/admin_dhb/ledger_open", _App_AdminLedgerOpen0_HTTP_Handler(srv))
+	r.GET("/api/admin_dhb/reconcile", _App_AdminReconcile0_HTTP_Handler(srv))
+	r.GET("/api/admin_dhb/discrepancy_list", _App_AdminDiscrepancyList0_HTTP_Handler(srv))
+	r.GET("/api/admin_dhb/chain_reconcile", _App_AdminChainReconcile0_HTTP_Handler(srv))
+	r.GET("/api/admin_dhb/balance_snapshot", _App_AdminBalanceSnapshot0_HTTP_Handler(srv))
+	r.GET("/api/admin_dhb/user_balance_history", _App_AdminUserBalanceHistory0_HTTP_Handler(srv))
+	r.GET("/api/admin_dhb/liability_list", _App_AdminLiabilityList0_HTTP_Handler(srv))
+	r.GET("/api/admin_dhb/user_statement", _App_AdminUserStatement0_HTTP_Handler(srv))
+	r.GET("/api/admin_dhb/solvency", _App_AdminSolvency0_HTTP_Handler(srv))
+	r.GET("/api/admin_dhb/solvency_check", _App_AdminSolvencyCheck0_HTTP_Handler(srv))
+	r.GET("/api/admin_dhb/solvency_list", _App_AdminSolvencyList0_HTTP_Handler(srv))
+	r.GET("/api/admin_dhb/deposit_simulate", _App_AdminDepositSimulate0_HTTP_Handler(srv))
+	r.GET("/api/app_server/reward_detail", _App_RewardDetail0_HTTP_Handler(srv))
+	r.GET("/api/admin_dhb/reward_detail", _App_AdminRewardDetail0_HTTP_Handler(srv))
+	r.GET("/api/admin_dhb/vip_recalc", _App_AdminVipRecalc0_HTTP_Handler(srv))
+	r.GET("/api/admin_dhb/lock_list", _App_AdminLockList0_HTTP_Handler(srv))
+	r.POST("/api/app_server/reinvest", _App_Reinvest0_HTTP_Handler(srv))
+	r.GET("/api/app_server/location_progress", _App_LocationProgress0_HTTP_Handler(srv))
 }
 
 func _App_UserInfo0_HTTP_Handler(srv AppHTTPServer) func(ctx http.Context) error {
@@ -304,6 +391,28 @@ func _App_Withdraw0_HTTP_Handler(srv AppHTTPServer) func(ctx http.Context) error
 	}
 }
 
+func _App_WithdrawCancel0_HTTP_Handler(srv AppHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in WithdrawCancelRequest
+		if err := ctx.Bind(&in.SendBody); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationAppWithdrawCancel)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.WithdrawCancel(ctx, req.(*WithdrawCancelRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*WithdrawCancelReply)
+		return ctx.Result(200, reply)
+	}
+}
+
 func _App_Deposit0_HTTP_Handler(srv AppHTTPServer) func(ctx http.Context) error {
 	return func(ctx http.Context) error {
 		var in DepositRequest
@@ -1097,152 +1206,773 @@ func _App_FixLocations0_HTTP_Handler(srv AppHTTPServer) func(ctx http.Context) e
 	}
 }
 
-type AppHTTPClient interface {
-	AdminAll(ctx context.Context, req *AdminAllRequest, opts ...http.CallOption) (rsp *AdminAllReply, err error)
-	AdminAreaLevelUpdate(ctx context.Context, req *AdminAreaLevelUpdateRequest, opts ...http.CallOption) (rsp *AdminAreaLevelUpdateReply, err error)
-	AdminBalanceUpdate(ctx context.Context, req *AdminBalanceUpdateRequest, opts ...http.CallOption) (rsp *AdminBalanceUpdateReply, err error)
-	AdminChangePassword(ctx context.Context, req *AdminChangePasswordRequest, opts ...http.CallOption) (rsp *AdminChangePasswordReply, err error)
-	AdminConfig(ctx context.Context, req *AdminConfigRequest, opts ...http.CallOption) (rsp *AdminConfigReply, err error)
-	AdminConfigUpdate(ctx context.Context, req *AdminConfigUpdateRequest, opts ...http.CallOption) (rsp *AdminConfigUpdateReply, err error)
-	AdminCreateAccount(ctx context.Context, req *AdminCreateAccountRequest, opts ...http.CallOption) (rsp *AdminCreateAccountReply, err error)
-	AdminDailyFee(ctx context.Context, req *AdminDailyFeeRequest, opts ...http.CallOption) (rsp *AdminDailyFeeReply, err error)
-	AdminDailyRecommendReward(ctx context.Context, req *AdminDailyRecommendRewardRequest, opts ...http.CallOption) (rsp *AdminDailyRecommendRewardReply, err error)
-	AdminDailyRecommendTopReward(ctx context.Context, req *AdminDailyRecommendTopRewardRequest, opts ...http.CallOption) (rsp *AdminDailyRecommendTopRewardReply, err error)
-	AdminDailyWithdrawReward(ctx context.Context, req *AdminDailyWithdrawRewardRequest, opts ...http.CallOption) (rsp *AdminDailyWithdrawRewardReply, err error)
-	AdminFee(ctx context.Context, req *AdminFeeRequest, opts ...http.CallOption) (rsp *AdminFeeReply, err error)
-	AdminList(ctx context.Context, req *AdminListRequest, opts ...http.CallOption) (rsp *AdminListReply, err error)
-	AdminLocationAllList(ctx context.Context, req *AdminLocationAllListRequest, opts ...http.CallOption) (rsp *AdminLocationAllListReply, err error)
-	AdminLocationInsert(ctx context.Context, req *AdminLocationInsertRequest, opts ...http.CallOption) (rsp *AdminLocationInsertReply, err error)
-	AdminLocationList(ctx context.Context, req *AdminLocationListRequest, opts ...http.CallOption) (rsp *AdminLocationListReply, err error)
-	AdminLogin(ctx context.Context, req *AdminLoginRequest, opts ...http.CallOption) (rsp *AdminLoginReply, err error)
-	AdminMonthRecommend(ctx context.Context, req *AdminMonthRecommendRequest, opts ...http.CallOption) (rsp *AdminMonthRecommendReply, err error)
-	AdminRewardBnbList(ctx context.Context, req *AdminRewardBnbListRequest, opts ...http.CallOption) (rsp *AdminRewardBnbListReply, err error)
-	AdminRewardList(ctx context.Context, req *AdminRewardListRequest, opts ...http.CallOption) (rsp *AdminRewardListReply, err error)
-	AdminUndoUpdate(ctx context.Context, req *AdminUndoUpdateRequest, opts ...http.CallOption) (rsp *AdminUndoUpdateReply, err error)
-	AdminUserList(ctx context.Context, req *AdminUserListRequest, opts ...http.CallOption) (rsp *AdminUserListReply, err error)
-	AdminUserRecommend(ctx context.Context, req *AdminUserRecommendRequest, opts ...http.CallOption) (rsp *AdminUserRecommendReply, err error)
-	AdminVipUpdate(ctx context.Context, req *AdminVipUpdateRequest, opts ...http.CallOption) (rsp *AdminVipUpdateReply, err error)
-	AdminWithdraw(ctx context.Context, req *AdminWithdrawRequest, opts ...http.CallOption) (rsp *AdminWithdrawReply, err error)
-	AdminWithdrawDoingToRewarded(ctx context.Context, req *AdminWithdrawDoingToRewardedRequest, opts ...http.CallOption) (rsp *AdminWithdrawDoingToRewardedReply, err error)
-	AdminWithdrawEth(ctx context.Context, req *AdminWithdrawEthRequest, opts ...http.CallOption) (rsp *AdminWithdrawEthReply, err error)
-	AdminWithdrawList(ctx context.Context, req *AdminWithdrawListRequest, opts ...http.CallOption) (rsp *AdminWithdrawListReply, err error)
-	AuthAdminCreate(ctx context.Context, req *AuthAdminCreateRequest, opts ...http.CallOption) (rsp *AuthAdminCreateReply, err error)
-	AuthAdminDelete(ctx context.Context, req *AuthAdminDeleteRequest, opts ...http.CallOption) (rsp *AuthAdminDeleteReply, err error)
-	AuthList(ctx context.Context, req *AuthListRequest, opts ...http.CallOption) (rsp *AuthListReply, err error)
-	CheckAdminUserArea(ctx context.Context, req *CheckAdminUserAreaRequest, opts ...http.CallOption) (rsp *CheckAdminUserAreaReply, err error)
-	CheckAndInsertLocationsRecommendUser(ctx context.Context, req *CheckAndInsertLocationsRecommendUserRequest, opts ...http.CallOption) (rsp *CheckAndInsertLocationsRecommendUserReply, err error)
-	CheckAndInsertRecommendArea(ctx context.Context, req *CheckAndInsertRecommendAreaRequest, opts ...http.CallOption) (rsp *CheckAndInsertRecommendAreaReply, err error)
-	Deposit(ctx context.Context, req *DepositRequest, opts ...http.CallOption) (rsp *DepositReply, err error)
-	FeeRewardList(ctx context.Context, req *FeeRewardListRequest, opts ...http.CallOption) (rsp *FeeRewardListReply, err error)
-	FixLocations(ctx context.Context, req *FixLocationsRequest, opts ...http.CallOption) (rsp *FixLocationsReply, err error)
-	FixReward(ctx context.Context, req *FixRewardRequest, opts ...http.CallOption) (rsp *FixRewardReply, err error)
-	MyAuthList(ctx context.Context, req *MyAuthListRequest, opts ...http.CallOption) (rsp *MyAuthListReply, err error)
-	RecommendList(ctx context.Context, req *RecommendListRequest, opts ...http.CallOption) (rsp *RecommendListReply, err error)
-	RecommendRewardList(ctx context.Context, req *RecommendRewardListRequest, opts ...http.CallOption) (rsp *RecommendRewardListReply, err error)
-	RewardList(ctx context.Context, req *RewardListRequest, opts ...http.CallOption) (rsp *RewardListReply, err error)
-	UploadRecommendUser(ctx context.Context, req *UploadRecommendUserRequest, opts ...http.CallOption) (rsp *UploadRecommendUserReply, err error)
-	UserAuthList(ctx context.Context, req *UserAuthListRequest, opts ...http.CallOption) (rsp *UserAuthListReply, err error)
-	UserInfo(ctx context.Context, req *UserInfoRequest, opts ...http.CallOption) (rsp *UserInfoReply, err error)
-	Withdraw(ctx context.Context, req *WithdrawRequest, opts ...http.CallOption) (rsp *WithdrawReply, err error)
-	WithdrawList(ctx context.Context, req *WithdrawListRequest, opts ...http.CallOption) (rsp *WithdrawListReply, err error)
+func _App_AdminAuditLogList0_HTTP_Handler(srv AppHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in AdminAuditLogListRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationAppAdminAuditLogList)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.AdminAuditLogList(ctx, req.(*AdminAuditLogListRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*AdminAuditLogListReply)
+		return ctx.Result(200, reply)
+	}
 }
 
-type AppHTTPClientImpl struct {
-	cc *http.Client
+func _App_AdminProposalList0_HTTP_Handler(srv AppHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in AdminProposalListRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationAppAdminProposalList)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.AdminProposalList(ctx, req.(*AdminProposalListRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*AdminProposalListReply)
+		return ctx.Result(200, reply)
+	}
 }
 
-func NewAppHTTPClient(client *http.Client) AppHTTPClient {
-	return &AppHTTPClientImpl{client}
+func _App_AdminProposalApprove0_HTTP_Handler(srv AppHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in AdminProposalApproveRequest
+		if err := ctx.Bind(&in.SendBody); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationAppAdminProposalApprove)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.AdminProposalApprove(ctx, req.(*AdminProposalApproveRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*AdminProposalApproveReply)
+		return ctx.Result(200, reply)
+	}
 }
 
-func (c *AppHTTPClientImpl) AdminAll(ctx context.Context, in *AdminAllRequest, opts ...http.CallOption) (*AdminAllReply, error) {
-	var out AdminAllReply
-	pattern := "/api/admin_dhb/all"
-	path := binding.EncodeURL(pattern, in, true)
-	opts = append(opts, http.Operation(OperationAppAdminAll))
-	opts = append(opts, http.PathTemplate(pattern))
-	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
-	if err != nil {
-		return nil, err
+func _App_AdminProposalReject0_HTTP_Handler(srv AppHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in AdminProposalRejectRequest
+		if err := ctx.Bind(&in.SendBody); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationAppAdminProposalReject)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.AdminProposalReject(ctx, req.(*AdminProposalRejectRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*AdminProposalRejectReply)
+		return ctx.Result(200, reply)
 	}
-	return &out, err
 }
 
-func (c *AppHTTPClientImpl) AdminAreaLevelUpdate(ctx context.Context, in *AdminAreaLevelUpdateRequest, opts ...http.CallOption) (*AdminAreaLevelUpdateReply, error) {
-	var out AdminAreaLevelUpdateReply
-	pattern := "/api/admin_dhb/level_update"
-	path := binding.EncodeURL(pattern, in, false)
-	opts = append(opts, http.Operation(OperationAppAdminAreaLevelUpdate))
-	opts = append(opts, http.PathTemplate(pattern))
-	err := c.cc.Invoke(ctx, "POST", path, in.SendBody, &out, opts...)
-	if err != nil {
-		return nil, err
+func _App_AdminWithdrawReviewList0_HTTP_Handler(srv AppHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in AdminWithdrawReviewListRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationAppAdminWithdrawReviewList)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.AdminWithdrawReviewList(ctx, req.(*AdminWithdrawReviewListRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*AdminWithdrawReviewListReply)
+		return ctx.Result(200, reply)
 	}
-	return &out, err
 }
 
-func (c *AppHTTPClientImpl) AdminBalanceUpdate(ctx context.Context, in *AdminBalanceUpdateRequest, opts ...http.CallOption) (*AdminBalanceUpdateReply, error) {
-	var out AdminBalanceUpdateReply
-	pattern := "/api/admin_dhb/balance_update"
-	path := binding.EncodeURL(pattern, in, false)
-	opts = append(opts, http.Operation(OperationAppAdminBalanceUpdate))
-	opts = append(opts, http.PathTemplate(pattern))
-	err := c.cc.Invoke(ctx, "POST", path, in.SendBody, &out, opts...)
-	if err != nil {
-		return nil, err
+func _App_AdminWithdrawReviewApprove0_HTTP_Handler(srv AppHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in AdminWithdrawReviewApproveRequest
+		if err := ctx.Bind(&in.SendBody); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationAppAdminWithdrawReviewApprove)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.AdminWithdrawReviewApprove(ctx, req.(*AdminWithdrawReviewApproveRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*AdminWithdrawReviewApproveReply)
+		return ctx.Result(200, reply)
 	}
-	return &out, err
 }
 
-func (c *AppHTTPClientImpl) AdminChangePassword(ctx context.Context, in *AdminChangePasswordRequest, opts ...http.CallOption) (*AdminChangePasswordReply, error) {
-	var out AdminChangePasswordReply
-	pattern := "/api/admin_dhb/change_password"
-	path := binding.EncodeURL(pattern, in, false)
-	opts = append(opts, http.Operation(OperationAppAdminChangePassword))
-	opts = append(opts, http.PathTemplate(pattern))
-	err := c.cc.Invoke(ctx, "POST", path, in.SendBody, &out, opts...)
-	if err != nil {
-		return nil, err
+func _App_AdminWithdrawReviewReject0_HTTP_Handler(srv AppHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in AdminWithdrawReviewRejectRequest
+		if err := ctx.Bind(&in.SendBody); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationAppAdminWithdrawReviewReject)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.AdminWithdrawReviewReject(ctx, req.(*AdminWithdrawReviewRejectRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*AdminWithdrawReviewRejectReply)
+		return ctx.Result(200, reply)
 	}
-	return &out, err
 }
 
-func (c *AppHTTPClientImpl) AdminConfig(ctx context.Context, in *AdminConfigRequest, opts ...http.CallOption) (*AdminConfigReply, error) {
-	var out AdminConfigReply
-	pattern := "/api/admin_dhb/config"
-	path := binding.EncodeURL(pattern, in, true)
-	opts = append(opts, http.Operation(OperationAppAdminConfig))
-	opts = append(opts, http.PathTemplate(pattern))
-	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
-	if err != nil {
-		return nil, err
+func _App_AdminWithdrawReject0_HTTP_Handler(srv AppHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in AdminWithdrawRejectRequest
+		if err := ctx.Bind(&in.SendBody); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationAppAdminWithdrawReject)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.AdminWithdrawReject(ctx, req.(*AdminWithdrawRejectRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*AdminWithdrawRejectReply)
+		return ctx.Result(200, reply)
 	}
-	return &out, err
 }
 
-func (c *AppHTTPClientImpl) AdminConfigUpdate(ctx context.Context, in *AdminConfigUpdateRequest, opts ...http.CallOption) (*AdminConfigUpdateReply, error) {
-	var out AdminConfigUpdateReply
-	pattern := "/api/admin_dhb/config_update"
-	path := binding.EncodeURL(pattern, in, false)
-	opts = append(opts, http.Operation(OperationAppAdminConfigUpdate))
-	opts = append(opts, http.PathTemplate(pattern))
-	err := c.cc.Invoke(ctx, "POST", path, in.SendBody, &out, opts...)
-	if err != nil {
-		return nil, err
+func _App_AdminWithdrawEventList0_HTTP_Handler(srv AppHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in AdminWithdrawEventListRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationAppAdminWithdrawEventList)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.AdminWithdrawEventList(ctx, req.(*AdminWithdrawEventListRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*AdminWithdrawEventListReply)
+		return ctx.Result(200, reply)
 	}
-	return &out, err
 }
 
-func (c *AppHTTPClientImpl) AdminCreateAccount(ctx context.Context, in *AdminCreateAccountRequest, opts ...http.CallOption) (*AdminCreateAccountReply, error) {
-	var out AdminCreateAccountReply
-	pattern := "/api/admin_dhb/create_account"
-	path := binding.EncodeURL(pattern, in, false)
-	opts = append(opts, http.Operation(OperationAppAdminCreateAccount))
-	opts = append(opts, http.PathTemplate(pattern))
-	err := c.cc.Invoke(ctx, "POST", path, in.SendBody, &out, opts...)
-	if err != nil {
-		return nil, err
-	}
+func _App_AdminLedgerVerify0_HTTP_Handler(srv AppHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in AdminLedgerVerifyRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationAppAdminLedgerVerify)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.AdminLedgerVerify(ctx, req.(*AdminLedgerVerifyRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*AdminLedgerVerifyReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _App_AdminLedgerOpen0_HTTP_Handler(srv AppHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in AdminLedgerOpenRequest
+		if err := ctx.Bind(&in.SendBody); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationAppAdminLedgerOpen)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.AdminLedgerOpen(ctx, req.(*AdminLedgerOpenRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*AdminLedgerOpenReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _App_AdminReconcile0_HTTP_Handler(srv AppHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in AdminReconcileRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationAppAdminReconcile)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.AdminReconcile(ctx, req.(*AdminReconcileRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*AdminReconcileReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _App_AdminDiscrepancyList0_HTTP_Handler(srv AppHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in AdminDiscrepancyListRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationAppAdminDiscrepancyList)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.AdminDiscrepancyList(ctx, req.(*AdminDiscrepancyListRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*AdminDiscrepancyListReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _App_AdminChainReconcile0_HTTP_Handler(srv AppHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in AdminChainReconcileRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationAppAdminChainReconcile)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.AdminChainReconcile(ctx, req.(*AdminChainReconcileRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*AdminChainReconcileReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _App_AdminBalanceSnapshot0_HTTP_Handler(srv AppHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in AdminBalanceSnapshotRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationAppAdminBalanceSnapshot)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.AdminBalanceSnapshot(ctx, req.(*AdminBalanceSnapshotRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*AdminBalanceSnapshotReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _App_AdminUserBalanceHistory0_HTTP_Handler(srv AppHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in AdminUserBalanceHistoryRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationAppAdminUserBalanceHistory)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.AdminUserBalanceHistory(ctx, req.(*AdminUserBalanceHistoryRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*AdminUserBalanceHistoryReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _App_AdminLiabilityList0_HTTP_Handler(srv AppHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in AdminLiabilityListRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationAppAdminLiabilityList)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.AdminLiabilityList(ctx, req.(*AdminLiabilityListRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*AdminLiabilityListReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _App_AdminUserStatement0_HTTP_Handler(srv AppHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in AdminUserStatementRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationAppAdminUserStatement)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.AdminUserStatement(ctx, req.(*AdminUserStatementRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*AdminUserStatementReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _App_AdminSolvency0_HTTP_Handler(srv AppHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in AdminSolvencyRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationAppAdminSolvency)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.AdminSolvency(ctx, req.(*AdminSolvencyRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*AdminSolvencyReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _App_AdminSolvencyCheck0_HTTP_Handler(srv AppHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in AdminSolvencyCheckRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationAppAdminSolvencyCheck)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.AdminSolvencyCheck(ctx, req.(*AdminSolvencyCheckRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*AdminSolvencyCheckReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _App_AdminSolvencyList0_HTTP_Handler(srv AppHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in AdminSolvencyListRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationAppAdminSolvencyList)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.AdminSolvencyList(ctx, req.(*AdminSolvencyListRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*AdminSolvencyListReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _App_AdminDepositSimulate0_HTTP_Handler(srv AppHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in AdminDepositSimulateRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationAppAdminDepositSimulate)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.AdminDepositSimulate(ctx, req.(*AdminDepositSimulateRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*AdminDepositSimulateReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _App_RewardDetail0_HTTP_Handler(srv AppHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in RewardDetailRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationAppRewardDetail)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.RewardDetail(ctx, req.(*RewardDetailRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*RewardDetailReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _App_AdminRewardDetail0_HTTP_Handler(srv AppHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in AdminRewardDetailRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationAppAdminRewardDetail)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.AdminRewardDetail(ctx, req.(*AdminRewardDetailRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*AdminRewardDetailReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _App_AdminVipRecalc0_HTTP_Handler(srv AppHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in AdminVipRecalcRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationAppAdminVipRecalc)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.AdminVipRecalc(ctx, req.(*AdminVipRecalcRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*AdminVipRecalcReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _App_AdminLockList0_HTTP_Handler(srv AppHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in AdminLockListRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationAppAdminLockList)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.AdminLockList(ctx, req.(*AdminLockListRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*AdminLockListReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _App_Reinvest0_HTTP_Handler(srv AppHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ReinvestRequest
+		if err := ctx.Bind(&in.SendBody); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationAppReinvest)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.Reinvest(ctx, req.(*ReinvestRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ReinvestReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _App_LocationProgress0_HTTP_Handler(srv AppHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in LocationProgressRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationAppLocationProgress)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.LocationProgress(ctx, req.(*LocationProgressRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*LocationProgressReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+type AppHTTPClient interface {
+	AdminAll(ctx context.Context, req *AdminAllRequest, opts ...http.CallOption) (rsp *AdminAllReply, err error)
+	AdminAreaLevelUpdate(ctx context.Context, req *AdminAreaLevelUpdateRequest, opts ...http.CallOption) (rsp *AdminAreaLevelUpdateReply, err error)
+	AdminAuditLogList(ctx context.Context, req *AdminAuditLogListRequest, opts ...http.CallOption) (rsp *AdminAuditLogListReply, err error)
+	AdminBalanceSnapshot(ctx context.Context, req *AdminBalanceSnapshotRequest, opts ...http.CallOption) (rsp *AdminBalanceSnapshotReply, err error)
+	AdminBalanceUpdate(ctx context.Context, req *AdminBalanceUpdateRequest, opts ...http.CallOption) (rsp *AdminBalanceUpdateReply, err error)
+	AdminChainReconcile(ctx context.Context, req *AdminChainReconcileRequest, opts ...http.CallOption) (rsp *AdminChainReconcileReply, err error)
+	AdminChangePassword(ctx context.Context, req *AdminChangePasswordRequest, opts ...http.CallOption) (rsp *AdminChangePasswordReply, err error)
+	AdminConfig(ctx context.Context, req *AdminConfigRequest, opts ...http.CallOption) (rsp *AdminConfigReply, err error)
+	AdminConfigUpdate(ctx context.Context, req *AdminConfigUpdateRequest, opts ...http.CallOption) (rsp *AdminConfigUpdateReply, err error)
+	AdminCreateAccount(ctx context.Context, req *AdminCreateAccountRequest, opts ...http.CallOption) (rsp *AdminCreateAccountReply, err error)
+	AdminDailyFee(ctx context.Context, req *AdminDailyFeeRequest, opts ...http.CallOption) (rsp *AdminDailyFeeReply, err error)
+	AdminDailyRecommendReward(ctx context.Context, req *AdminDailyRecommendRewardRequest, opts ...http.CallOption) (rsp *AdminDailyRecommendRewardReply, err error)
+	AdminDailyRecommendTopReward(ctx context.Context, req *AdminDailyRecommendTopRewardRequest, opts ...http.CallOption) (rsp *AdminDailyRecommendTopRewardReply, err error)
+	AdminDailyWithdrawReward(ctx context.Context, req *AdminDailyWithdrawRewardRequest, opts ...http.CallOption) (rsp *AdminDailyWithdrawRewardReply, err error)
+	AdminDepositSimulate(ctx context.Context, req *AdminDepositSimulateRequest, opts ...http.CallOption) (rsp *AdminDepositSimulateReply, err error)
+	AdminDiscrepancyList(ctx context.Context, req *AdminDiscrepancyListRequest, opts ...http.CallOption) (rsp *AdminDiscrepancyListReply, err error)
+	AdminFee(ctx context.Context, req *AdminFeeRequest, opts ...http.CallOption) (rsp *AdminFeeReply, err error)
+	AdminLedgerOpen(ctx context.Context, req *AdminLedgerOpenRequest, opts ...http.CallOption) (rsp *AdminLedgerOpenReply, err error)
+	AdminLedgerVerify(ctx context.Context, req *AdminLedgerVerifyRequest, opts ...http.CallOption) (rsp *AdminLedgerVerifyReply, err error)
+	AdminLiabilityList(ctx context.Context, req *AdminLiabilityListRequest, opts ...http.CallOption) (rsp *AdminLiabilityListReply, err error)
+	AdminList(ctx context.Context, req *AdminListRequest, opts ...http.CallOption) (rsp *AdminListReply, err error)
+	AdminLocationAllList(ctx context.Context, req *AdminLocationAllListRequest, opts ...http.CallOption) (rsp *AdminLocationAllListReply, err error)
+	AdminLocationInsert(ctx context.Context, req *AdminLocationInsertRequest, opts ...http.CallOption) (rsp *AdminLocationInsertReply, err error)
+	AdminLocationList(ctx context.Context, req *AdminLocationListRequest, opts ...http.CallOption) (rsp *AdminLocationListReply, err error)
+	AdminLockList(ctx context.Context, req *AdminLockListRequest, opts ...http.CallOption) (rsp *AdminLockListReply, err error)
+	AdminLogin(ctx context.Context, req *AdminLoginRequest, opts ...http.CallOption) (rsp *AdminLoginReply, err error)
+	AdminMonthRecommend(ctx context.Context, req *AdminMonthRecommendRequest, opts ...http.CallOption) (rsp *AdminMonthRecommendReply, err error)
+	AdminProposalApprove(ctx context.Context, req *AdminProposalApproveRequest, opts ...http.CallOption) (rsp *AdminProposalApproveReply, err error)
+	AdminProposalList(ctx context.Context, req *AdminProposalListRequest, opts ...http.CallOption) (rsp *AdminProposalListReply, err error)
+	AdminProposalReject(ctx context.Context, req *AdminProposalRejectRequest, opts ...http.CallOption) (rsp *AdminProposalRejectReply, err error)
+	AdminReconcile(ctx context.Context, req *AdminReconcileRequest, opts ...http.CallOption) (rsp *AdminReconcileReply, err error)
+	AdminRewardBnbList(ctx context.Context, req *AdminRewardBnbListRequest, opts ...http.CallOption) (rsp *AdminRewardBnbListReply, err error)
+	AdminRewardDetail(ctx context.Context, req *AdminRewardDetailRequest, opts ...http.CallOption) (rsp *AdminRewardDetailReply, err error)
+	AdminRewardList(ctx context.Context, req *AdminRewardListRequest, opts ...http.CallOption) (rsp *AdminRewardListReply, err error)
+	AdminSolvency(ctx context.Context, req *AdminSolvencyRequest, opts ...http.CallOption) (rsp *AdminSolvencyReply, err error)
+	AdminSolvencyCheck(ctx context.Context, req *AdminSolvencyCheckRequest, opts ...http.CallOption) (rsp *AdminSolvencyCheckReply, err error)
+	AdminSolvencyList(ctx context.Context, req *AdminSolvencyListRequest, opts ...http.CallOption) (rsp *AdminSolvencyListReply, err error)
+	AdminUndoUpdate(ctx context.Context, req *AdminUndoUpdateRequest, opts ...http.CallOption) (rsp *AdminUndoUpdateReply, err error)
+	AdminUserBalanceHistory(ctx context.Context, req *AdminUserBalanceHistoryRequest, opts ...http.CallOption) (rsp *AdminUserBalanceHistoryReply, err error)
+	AdminUserList(ctx context.Context, req *AdminUserListRequest, opts ...http.CallOption) (rsp *AdminUserListReply, err error)
+	AdminUserRecommend(ctx context.Context, req *AdminUserRecommendRequest, opts ...http.CallOption) (rsp *AdminUserRecommendReply, err error)
+	AdminUserStatement(ctx context.Context, req *AdminUserStatementRequest, opts ...http.CallOption) (rsp *AdminUserStatementReply, err error)
+	AdminVipRecalc(ctx context.Context, req *AdminVipRecalcRequest, opts ...http.CallOption) (rsp *AdminVipRecalcReply, err error)
+	AdminVipUpdate(ctx context.Context, req *AdminVipUpdateRequest, opts ...http.CallOption) (rsp *AdminVipUpdateReply, err error)
+	AdminWithdraw(ctx context.Context, req *AdminWithdrawRequest, opts ...http.CallOption) (rsp *AdminWithdrawReply, err error)
+	AdminWithdrawDoingToRewarded(ctx context.Context, req *AdminWithdrawDoingToRewardedRequest, opts ...http.CallOption) (rsp *AdminWithdrawDoingToRewardedReply, err error)
+	AdminWithdrawEth(ctx context.Context, req *AdminWithdrawEthRequest, opts ...http.CallOption) (rsp *AdminWithdrawEthReply, err error)
+	AdminWithdrawEventList(ctx context.Context, req *AdminWithdrawEventListRequest, opts ...http.CallOption) (rsp *AdminWithdrawEventListReply, err error)
+	AdminWithdrawList(ctx context.Context, req *AdminWithdrawListRequest, opts ...http.CallOption) (rsp *AdminWithdrawListReply, err error)
+	AdminWithdrawReject(ctx context.Context, req *AdminWithdrawRejectRequest, opts ...http.CallOption) (rsp *AdminWithdrawRejectReply, err error)
+	AdminWithdrawReviewApprove(ctx context.Context, req *AdminWithdrawReviewApproveRequest, opts ...http.CallOption) (rsp *AdminWithdrawReviewApproveReply, err error)
+	AdminWithdrawReviewList(ctx context.Context, req *AdminWithdrawReviewListRequest, opts ...http.CallOption) (rsp *AdminWithdrawReviewListReply, err error)
+	AdminWithdrawReviewReject(ctx context.Context, req *AdminWithdrawReviewRejectRequest, opts ...http.CallOption) (rsp *AdminWithdrawReviewRejectReply, err error)
+	AuthAdminCreate(ctx context.Context, req *AuthAdminCreateRequest, opts ...http.CallOption) (rsp *AuthAdminCreateReply, err error)
+	AuthAdminDelete(ctx context.Context, req *AuthAdminDeleteRequest, opts ...http.CallOption) (rsp *AuthAdminDeleteReply, err error)
+	AuthList(ctx context.Context, req *AuthListRequest, opts ...http.CallOption) (rsp *AuthListReply, err error)
+	CheckAdminUserArea(ctx context.Context, req *CheckAdminUserAreaRequest, opts ...http.CallOption) (rsp *CheckAdminUserAreaReply, err error)
+	CheckAndInsertLocationsRecommendUser(ctx context.Context, req *CheckAndInsertLocationsRecommendUserRequest, opts ...http.CallOption) (rsp *CheckAndInsertLocationsRecommendUserReply, err error)
+	CheckAndInsertRecommendArea(ctx context.Context, req *CheckAndInsertRecommendAreaRequest, opts ...http.CallOption) (rsp *CheckAndInsertRecommendAreaReply, err error)
+	Deposit(ctx context.Context, req *DepositRequest, opts ...http.CallOption) (rsp *DepositReply, err error)
+	FeeRewardList(ctx context.Context, req *FeeRewardListRequest, opts ...http.CallOption) (rsp *FeeRewardListReply, err error)
+	FixLocations(ctx context.Context, req *FixLocationsRequest, opts ...http.CallOption) (rsp *FixLocationsReply, err error)
+	FixReward(ctx context.Context, req *FixRewardRequest, opts ...http.CallOption) (rsp *FixRewardReply, err error)
+	LocationProgress(ctx context.Context, req *LocationProgressRequest, opts ...http.CallOption) (rsp *LocationProgressReply, err error)
+	MyAuthList(ctx context.Context, req *MyAuthListRequest, opts ...http.CallOption) (rsp *MyAuthListReply, err error)
+	RecommendList(ctx context.Context, req *RecommendListRequest, opts ...http.CallOption) (rsp *RecommendListReply, err error)
+	RecommendRewardList(ctx context.Context, req *RecommendRewardListRequest, opts ...http.CallOption) (rsp *RecommendRewardListReply, err error)
+	Reinvest(ctx context.Context, req *ReinvestRequest, opts ...http.CallOption) (rsp *ReinvestReply, err error)
+	RewardDetail(ctx context.Context, req *RewardDetailRequest, opts ...http.CallOption) (rsp *RewardDetailReply, err error)
+	RewardList(ctx context.Context, req *RewardListRequest, opts ...http.CallOption) (rsp *RewardListReply, err error)
+	UploadRecommendUser(ctx context.Context, req *UploadRecommendUserRequest, opts ...http.CallOption) (rsp *UploadRecommendUserReply, err error)
+	UserAuthList(ctx context.Context, req *UserAuthListRequest, opts ...http.CallOption) (rsp *UserAuthListReply, err error)
+	UserInfo(ctx context.Context, req *UserInfoRequest, opts ...http.CallOption) (rsp *UserInfoReply, err error)
+	Withdraw(ctx context.Context, req *WithdrawRequest, opts ...http.CallOption) (rsp *WithdrawReply, err error)
+	WithdrawCancel(ctx context.Context, req *WithdrawCancelRequest, opts ...http.CallOption) (rsp *WithdrawCancelReply, err error)
+	WithdrawList(ctx context.Context, req *WithdrawListRequest, opts ...http.CallOption) (rsp *WithdrawListReply, err error)
+}
+
+type AppHTTPClientImpl struct {
+	cc *http.Client
+}
+
+func NewAppHTTPClient(client *http.Client) AppHTTPClient {
+	return &AppHTTPClientImpl{client}
+}
+
+func (c *AppHTTPClientImpl) AdminAll(ctx context.Context, in *AdminAllRequest, opts ...http.CallOption) (*AdminAllReply, error) {
+	var out AdminAllReply
+	pattern := "/api/admin_dhb/all"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationAppAdminAll))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
+func (c *AppHTTPClientImpl) AdminAreaLevelUpdate(ctx context.Context, in *AdminAreaLevelUpdateRequest, opts ...http.CallOption) (*AdminAreaLevelUpdateReply, error) {
+	var out AdminAreaLevelUpdateReply
+	pattern := "/api/admin_dhb/level_update"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationAppAdminAreaLevelUpdate))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in.SendBody, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
+func (c *AppHTTPClientImpl) AdminAuditLogList(ctx context.Context, in *AdminAuditLogListRequest, opts ...http.CallOption) (*AdminAuditLogListReply, error) {
+	var out AdminAuditLogListReply
+	pattern := "/api/admin_dhb/audit_log_list"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationAppAdminAuditLogList))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
+func (c *AppHTTPClientImpl) AdminBalanceSnapshot(ctx context.Context, in *AdminBalanceSnapshotRequest, opts ...http.CallOption) (*AdminBalanceSnapshotReply, error) {
+	var out AdminBalanceSnapshotReply
+	pattern := "/api/admin_dhb/balance_snapshot"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationAppAdminBalanceSnapshot))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
+func (c *AppHTTPClientImpl) AdminBalanceUpdate(ctx context.Context, in *AdminBalanceUpdateRequest, opts ...http.CallOption) (*AdminBalanceUpdateReply, error) {
+	var out AdminBalanceUpdateReply
+	pattern := "/api/admin_dhb/balance_update"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationAppAdminBalanceUpdate))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in.SendBody, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
+func (c *AppHTTPClientImpl) AdminChainReconcile(ctx context.Context, in *AdminChainReconcileRequest, opts ...http.CallOption) (*AdminChainReconcileReply, error) {
+	var out AdminChainReconcileReply
+	pattern := "/api/admin_dhb/chain_reconcile"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationAppAdminChainReconcile))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
+func (c *AppHTTPClientImpl) AdminChangePassword(ctx context.Context, in *AdminChangePasswordRequest, opts ...http.CallOption) (*AdminChangePasswordReply, error) {
+	var out AdminChangePasswordReply
+	pattern := "/api/admin_dhb/change_password"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationAppAdminChangePassword))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in.SendBody, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
+func (c *AppHTTPClientImpl) AdminConfig(ctx context.Context, in *AdminConfigRequest, opts ...http.CallOption) (*AdminConfigReply, error) {
+	var out AdminConfigReply
+	pattern := "/api/admin_dhb/config"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationAppAdminConfig))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
+func (c *AppHTTPClientImpl) AdminConfigUpdate(ctx context.Context, in *AdminConfigUpdateRequest, opts ...http.CallOption) (*AdminConfigUpdateReply, error) {
+	var out AdminConfigUpdateReply
+	pattern := "/api/admin_dhb/config_update"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationAppAdminConfigUpdate))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in.SendBody, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
+func (c *AppHTTPClientImpl) AdminCreateAccount(ctx context.Context, in *AdminCreateAccountRequest, opts ...http.CallOption) (*AdminCreateAccountReply, error) {
+	var out AdminCreateAccountReply
+	pattern := "/api/admin_dhb/create_account"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationAppAdminCreateAccount))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in.SendBody, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
 	return &out, err
 }
 
@@ -1298,6 +2028,32 @@ func (c *AppHTTPClientImpl) AdminDailyWithdrawReward(ctx context.Context, in *Ad
 	return &out, err
 }
 
+func (c *AppHTTPClientImpl) AdminDepositSimulate(ctx context.Context, in *AdminDepositSimulateRequest, opts ...http.CallOption) (*AdminDepositSimulateReply, error) {
+	var out AdminDepositSimulateReply
+	pattern := "/api/admin_dhb/deposit_simulate"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationAppAdminDepositSimulate))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
+func (c *AppHTTPClientImpl) AdminDiscrepancyList(ctx context.Context, in *AdminDiscrepancyListRequest, opts ...http.CallOption) (*AdminDiscrepancyListReply, error) {
+	var out AdminDiscrepancyListReply
+	pattern := "/api/admin_dhb/discrepancy_list"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationAppAdminDiscrepancyList))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
 func (c *AppHTTPClientImpl) AdminFee(ctx context.Context, in *AdminFeeRequest, opts ...http.CallOption) (*AdminFeeReply, error) {
 	var out AdminFeeReply
 	pattern := "/api/admin_dhb/fee"
@@ -1311,6 +2067,45 @@ func (c *AppHTTPClientImpl) AdminFee(ctx context.Context, in *AdminFeeRequest, o
 	return &out, err
 }
 
+func (c *AppHTTPClientImpl) AdminLedgerOpen(ctx context.Context, in *AdminLedgerOpenRequest, opts ...http.CallOption) (*AdminLedgerOpenReply, error) {
+	var out AdminLedgerOpenReply
+	pattern := "/api/admin_dhb/ledger_open"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationAppAdminLedgerOpen))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in.SendBody, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
+func (c *AppHTTPClientImpl) AdminLedgerVerify(ctx context.Context, in *AdminLedgerVerifyRequest, opts ...http.CallOption) (*AdminLedgerVerifyReply, error) {
+	var out AdminLedgerVerifyReply
+	pattern := "/api/admin_dhb/ledger_verify"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationAppAdminLedgerVerify))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
+func (c *AppHTTPClientImpl) AdminLiabilityList(ctx context.Context, in *AdminLiabilityListRequest, opts ...http.CallOption) (*AdminLiabilityListReply, error) {
+	var out AdminLiabilityListReply
+	pattern := "/api/admin_dhb/liability_list"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationAppAdminLiabilityList))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
 func (c *AppHTTPClientImpl) AdminList(ctx context.Context, in *AdminListRequest, opts ...http.CallOption) (*AdminListReply, error) {
 	var out AdminListReply
 	pattern := "/api/admin_dhb/admin_list"
@@ -1363,6 +2158,19 @@ func (c *AppHTTPClientImpl) AdminLocationList(ctx context.Context, in *AdminLoca
 	return &out, err
 }
 
+func (c *AppHTTPClientImpl) AdminLockList(ctx context.Context, in *AdminLockListRequest, opts ...http.CallOption) (*AdminLockListReply, error) {
+	var out AdminLockListReply
+	pattern := "/api/admin_dhb/lock_list"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationAppAdminLockList))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
 func (c *AppHTTPClientImpl) AdminLogin(ctx context.Context, in *AdminLoginRequest, opts ...http.CallOption) (*AdminLoginReply, error) {
 	var out AdminLoginReply
 	pattern := "/api/admin_dhb/login"
@@ -1389,6 +2197,58 @@ func (c *AppHTTPClientImpl) AdminMonthRecommend(ctx context.Context, in *AdminMo
 	return &out, err
 }
 
+func (c *AppHTTPClientImpl) AdminProposalApprove(ctx context.Context, in *AdminProposalApproveRequest, opts ...http.CallOption) (*AdminProposalApproveReply, error) {
+	var out AdminProposalApproveReply
+	pattern := "/api/admin_dhb/proposal_approve"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationAppAdminProposalApprove))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in.SendBody, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
+func (c *AppHTTPClientImpl) AdminProposalList(ctx context.Context, in *AdminProposalListRequest, opts ...http.CallOption) (*AdminProposalListReply, error) {
+	var out AdminProposalListReply
+	pattern := "/api/admin_dhb/proposal_list"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationAppAdminProposalList))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
+func (c *AppHTTPClientImpl) AdminProposalReject(ctx context.Context, in *AdminProposalRejectRequest, opts ...http.CallOption) (*AdminProposalRejectReply, error) {
+	var out AdminProposalRejectReply
+	pattern := "/api/admin_dhb/proposal_reject"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationAppAdminProposalReject))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in.SendBody, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
+func (c *AppHTTPClientImpl) AdminReconcile(ctx context.Context, in *AdminReconcileRequest, opts ...http.CallOption) (*AdminReconcileReply, error) {
+	var out AdminReconcileReply
+	pattern := "/api/admin_dhb/reconcile"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationAppAdminReconcile))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
 func (c *AppHTTPClientImpl) AdminRewardBnbList(ctx context.Context, in *AdminRewardBnbListRequest, opts ...http.CallOption) (*AdminRewardBnbListReply, error) {
 	var out AdminRewardBnbListReply
 	pattern := "/api/admin_dhb/reward_bnb_list"
@@ -1402,6 +2262,19 @@ func (c *AppHTTPClientImpl) AdminRewardBnbList(ctx context.Context, in *AdminRew
 	return &out, err
 }
 
+func (c *AppHTTPClientImpl) AdminRewardDetail(ctx context.Context, in *AdminRewardDetailRequest, opts ...http.CallOption) (*AdminRewardDetailReply, error) {
+	var out AdminRewardDetailReply
+	pattern := "/api/admin_dhb/reward_detail"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationAppAdminRewardDetail))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
 func (c *AppHTTPClientImpl) AdminRewardList(ctx context.Context, in *AdminRewardListRequest, opts ...http.CallOption) (*AdminRewardListReply, error) {
 	var out AdminRewardListReply
 	pattern := "/api/admin_dhb/reward_list"
@@ -1415,6 +2288,45 @@ func (c *AppHTTPClientImpl) AdminRewardList(ctx context.Context, in *AdminReward
 	return &out, err
 }
 
+func (c *AppHTTPClientImpl) AdminSolvency(ctx context.Context, in *AdminSolvencyRequest, opts ...http.CallOption) (*AdminSolvencyReply, error) {
+	var out AdminSolvencyReply
+	pattern := "/api/admin_dhb/solvency"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationAppAdminSolvency))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
+func (c *AppHTTPClientImpl) AdminSolvencyCheck(ctx context.Context, in *AdminSolvencyCheckRequest, opts ...http.CallOption) (*AdminSolvencyCheckReply, error) {
+	var out AdminSolvencyCheckReply
+	pattern := "/api/admin_dhb/solvency_check"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationAppAdminSolvencyCheck))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
+func (c *AppHTTPClientImpl) AdminSolvencyList(ctx context.Context, in *AdminSolvencyListRequest, opts ...http.CallOption) (*AdminSolvencyListReply, error) {
+	var out AdminSolvencyListReply
+	pattern := "/api/admin_dhb/solvency_list"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationAppAdminSolvencyList))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
 func (c *AppHTTPClientImpl) AdminUndoUpdate(ctx context.Context, in *AdminUndoUpdateRequest, opts ...http.CallOption) (*AdminUndoUpdateReply, error) {
 	var out AdminUndoUpdateReply
 	pattern := "/api/admin_dhb/undo_update"
@@ -1428,6 +2340,19 @@ func (c *AppHTTPClientImpl) AdminUndoUpdate(ctx context.Context, in *AdminUndoUp
 	return &out, err
 }
 
+func (c *AppHTTPClientImpl) AdminUserBalanceHistory(ctx context.Context, in *AdminUserBalanceHistoryRequest, opts ...http.CallOption) (*AdminUserBalanceHistoryReply, error) {
+	var out AdminUserBalanceHistoryReply
+	pattern := "/api/admin_dhb/user_balance_history"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationAppAdminUserBalanceHistory))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
 func (c *AppHTTPClientImpl) AdminUserList(ctx context.Context, in *AdminUserListRequest, opts ...http.CallOption) (*AdminUserListReply, error) {
 	var out AdminUserListReply
 	pattern := "/api/admin_dhb/user_list"
@@ -1454,6 +2379,32 @@ func (c *AppHTTPClientImpl) AdminUserRecommend(ctx context.Context, in *AdminUse
 	return &out, err
 }
 
+func (c *AppHTTPClientImpl) AdminUserStatement(ctx context.Context, in *AdminUserStatementRequest, opts ...http.CallOption) (*AdminUserStatementReply, error) {
+	var out AdminUserStatementReply
+	pattern := "/api/admin_dhb/user_statement"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationAppAdminUserStatement))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
+func (c *AppHTTPClientImpl) AdminVipRecalc(ctx context.Context, in *AdminVipRecalcRequest, opts ...http.CallOption) (*AdminVipRecalcReply, error) {
+	var out AdminVipRecalcReply
+	pattern := "/api/admin_dhb/vip_recalc"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationAppAdminVipRecalc))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
 func (c *AppHTTPClientImpl) AdminVipUpdate(ctx context.Context, in *AdminVipUpdateRequest, opts ...http.CallOption) (*AdminVipUpdateReply, error) {
 	var out AdminVipUpdateReply
 	pattern := "/api/admin_dhb/vip_update"
@@ -1506,6 +2457,19 @@ func (c *AppHTTPClientImpl) AdminWithdrawEth(ctx context.Context, in *AdminWithd
 	return &out, err
 }
 
+func (c *AppHTTPClientImpl) AdminWithdrawEventList(ctx context.Context, in *AdminWithdrawEventListRequest, opts ...http.CallOption) (*AdminWithdrawEventListReply, error) {
+	var out AdminWithdrawEventListReply
+	pattern := "/api/admin_dhb/withdraw_event_list"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationAppAdminWithdrawEventList))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
 func (c *AppHTTPClientImpl) AdminWithdrawList(ctx context.Context, in *AdminWithdrawListRequest, opts ...http.CallOption) (*AdminWithdrawListReply, error) {
 	var out AdminWithdrawListReply
 	pattern := "/api/admin_dhb/withdraw_list"
@@ -1519,6 +2483,58 @@ func (c *AppHTTPClientImpl) AdminWithdrawList(ctx context.Context, in *AdminWith
 	return &out, err
 }
 
+func (c *AppHTTPClientImpl) AdminWithdrawReject(ctx context.Context, in *AdminWithdrawRejectRequest, opts ...http.CallOption) (*AdminWithdrawRejectReply, error) {
+	var out AdminWithdrawRejectReply
+	pattern := "/api/admin_dhb/withdraw_reject"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationAppAdminWithdrawReject))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in.SendBody, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
+func (c *AppHTTPClientImpl) AdminWithdrawReviewApprove(ctx context.Context, in *AdminWithdrawReviewApproveRequest, opts ...http.CallOption) (*AdminWithdrawReviewApproveReply, error) {
+	var out AdminWithdrawReviewApproveReply
+	pattern := "/api/admin_dhb/withdraw_review_approve"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationAppAdminWithdrawReviewApprove))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in.SendBody, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
+func (c *AppHTTPClientImpl) AdminWithdrawReviewList(ctx context.Context, in *AdminWithdrawReviewListRequest, opts ...http.CallOption) (*AdminWithdrawReviewListReply, error) {
+	var out AdminWithdrawReviewListReply
+	pattern := "/api/admin_dhb/withdraw_review_list"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationAppAdminWithdrawReviewList))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
+func (c *AppHTTPClientImpl) AdminWithdrawReviewReject(ctx context.Context, in *AdminWithdrawReviewRejectRequest, opts ...http.CallOption) (*AdminWithdrawReviewRejectReply, error) {
+	var out AdminWithdrawReviewRejectReply
+	pattern := "/api/admin_dhb/withdraw_review_reject"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationAppAdminWithdrawReviewReject))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in.SendBody, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
 func (c *AppHTTPClientImpl) AuthAdminCreate(ctx context.Context, in *AuthAdminCreateRequest, opts ...http.CallOption) (*AuthAdminCreateReply, error) {
 	var out AuthAdminCreateReply
 	pattern := "/api/admin_dhb/auth_create"
@@ -1649,6 +2665,19 @@ func (c *AppHTTPClientImpl) FixReward(ctx context.Context, in *FixRewardRequest,
 	return &out, err
 }
 
+func (c *AppHTTPClientImpl) LocationProgress(ctx context.Context, in *LocationProgressRequest, opts ...http.CallOption) (*LocationProgressReply, error) {
+	var out LocationProgressReply
+	pattern := "/api/app_server/location_progress"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationAppLocationProgress))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
 func (c *AppHTTPClientImpl) MyAuthList(ctx context.Context, in *MyAuthListRequest, opts ...http.CallOption) (*MyAuthListReply, error) {
 	var out MyAuthListReply
 	pattern := "/api/admin_dhb/my_auth_list"
@@ -1688,6 +2717,32 @@ func (c *AppHTTPClientImpl) RecommendRewardList(ctx context.Context, in *Recomme
 	return &out, err
 }
 
+func (c *AppHTTPClientImpl) Reinvest(ctx context.Context, in *ReinvestRequest, opts ...http.CallOption) (*ReinvestReply, error) {
+	var out ReinvestReply
+	pattern := "/api/app_server/reinvest"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationAppReinvest))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in.SendBody, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
+func (c *AppHTTPClientImpl) RewardDetail(ctx context.Context, in *RewardDetailRequest, opts ...http.CallOption) (*RewardDetailReply, error) {
+	var out RewardDetailReply
+	pattern := "/api/app_server/reward_detail"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationAppRewardDetail))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
 func (c *AppHTTPClientImpl) RewardList(ctx context.Context, in *RewardListRequest, opts ...http.CallOption) (*RewardListReply, error) {
 	var out RewardListReply
 	pattern := "/api/app_server/reward_list"
@@ -1753,6 +2808,19 @@ func (c *AppHTTPClientImpl) Withdraw(ctx context.Context, in *WithdrawRequest, o
 	return &out, err
 }
 
+func (c *AppHTTPClientImpl) WithdrawCancel(ctx context.Context, in *WithdrawCancelRequest, opts ...http.CallOption) (*WithdrawCancelReply, error) {
+	var out WithdrawCancelReply
+	pattern := "/api/app_server/withdraw_cancel"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationAppWithdrawCancel))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in.SendBody, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
 func (c *AppHTTPClientImpl) WithdrawList(ctx context.Context, in *WithdrawListRequest, opts ...http.CallOption) (*WithdrawListReply, error) {
 	var out WithdrawListReply
 	pattern := "/api/app_server/withdraw_list"
@@ -38,11 +38,21 @@ func wireApp(confServer *conf.Server, confData *conf.Data, auth *conf.Auth, logg
 	locationRepo := data.NewLocationRepo(dataData, logger)
 	userCurrentMonthRecommendRepo := data.NewUserCurrentMonthRecommendRepo(dataData, logger)
 	userBalanceRepo := data.NewUserBalanceRepo(dataData, logger)
-	userUseCase := biz.NewUserUseCase(userRepo, transaction, configRepo, userInfoRepo, userRecommendRepo, locationRepo, userCurrentMonthRecommendRepo, userBalanceRepo, logger)
+	adminAuditLogRepo := data.NewAdminAuditLogRepo(dataData, logger)
+	adminProposalRepo := data.NewAdminProposalRepo(dataData, logger)
+	ledgerRepo := data.NewLedgerRepo(dataData, logger)
+	reconcileRepo := data.NewReconcileRepo(dataData, logger)
+	balanceSnapshotRepo := data.NewBalanceSnapshotRepo(dataData, logger)
+	statementRepo := data.NewStatementRepo(dataData, logger)
+	solvencyRepo := data.NewSolvencyRepo(dataData, logger)
+	provenanceRepo := data.NewProvenanceRepo(dataData, logger)
+	lockRepo := data.NewLockRepo(dataData, logger)
+	userUseCase := biz.NewUserUseCase(userRepo, transaction, configRepo, userInfoRepo, userRecommendRepo, locationRepo, userCurrentMonthRecommendRepo, userBalanceRepo, adminAuditLogRepo, adminProposalRepo, ledgerRepo, reconcileRepo, balanceSnapshotRepo, statementRepo, solvencyRepo, provenanceRepo, lockRepo, logger)
 	ethUserRecordRepo := data.NewEthUserRecordRepo(dataData, logger)
-	recordUseCase := biz.NewRecordUseCase(ethUserRecordRepo, locationRepo, userBalanceRepo, userRecommendRepo, userInfoRepo, configRepo, userCurrentMonthRecommendRepo, transaction, logger)
+	recordUseCase := biz.NewRecordUseCase(ethUserRecordRepo, locationRepo, userBalanceRepo, userRecommendRepo, userInfoRepo, userRepo, configRepo, userCurrentMonthRecommendRepo, adminAuditLogRepo, lockRepo, transaction, logger)
 	appService := service.NewAppService(userUseCase, recordUseCase, logger, auth)
-	httpServer := server.NewHTTPServer(confServer, appService, logger)
+	store := data.NewIdempotencyRepo(dataData, logger)
+	httpServer := server.NewHTTPServer(confServer, appService, store, logger)
 	app := newApp(logger, httpServer)
 	return app, func() {
 		cleanup()
@@ -0,0 +1,78 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"os"
+
+	v1 "dhb/app/app/api"
+	"dhb/app/app/internal/conf"
+
+	"github.com/go-kratos/kratos/v2/config"
+	"github.com/go-kratos/kratos/v2/config/file"
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// 链上对账，日期为北京时间，包含结束日期当天，有对不上的记录时退出码为2，可用于定时任务告警
+// go run ./cmd/chainreconcile -conf ../../configs -start 2023-05-01 -end 2023-05-31 > chain_reconcile.json
+var (
+	// flagconf is the config flag.
+	flagconf string
+	start    string
+	end      string
+)
+
+func init() {
+	flag.StringVar(&flagconf, "conf", "../../configs", "config path, eg: -conf config.yaml")
+	flag.StringVar(&start, "start", "", "start date, eg: 2023-05-01")
+	flag.StringVar(&end, "end", "", "end date (inclusive), eg: 2023-05-31")
+}
+
+func main() {
+	flag.Parse()
+	logger := log.NewStdLogger(os.Stderr)
+	c := config.New(
+		config.WithSource(
+			file.NewSource(flagconf),
+		),
+	)
+	defer c.Close()
+
+	if err := c.Load(); err != nil {
+		panic(err)
+	}
+
+	var bc conf.Bootstrap
+	if err := c.Scan(&bc); err != nil {
+		panic(err)
+	}
+
+	a, cleanup, err := wireAppService(bc.Data, bc.Auth, logger)
+	if err != nil {
+		panic(err)
+	}
+	defer cleanup()
+
+	res, err := a.AdminChainReconcile(context.Background(), &v1.AdminChainReconcileRequest{
+		Start: start,
+		End:   end,
+	})
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+
+	b, err := json.MarshalIndent(res.Items, "", "  ")
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+	fmt.Println(string(b))
+
+	if 0 < res.Count {
+		cleanup()
+		os.Exit(2)
+	}
+}
@@ -0,0 +1,21 @@
+//go:build wireinject
+// +build wireinject
+
+// The build tag makes sure the stub is not built in the final build.
+
+package main
+
+import (
+	"dhb/app/app/internal/biz"
+	"dhb/app/app/internal/conf"
+	"dhb/app/app/internal/data"
+	"dhb/app/app/internal/service"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/google/wire"
+)
+
+// wireAppService init app service.
+func wireAppService(*conf.Data, *conf.Auth, log.Logger) (*service.AppService, func(), error) {
+	panic(wire.Build(data.ProviderSet, biz.ProviderSet, service.ProviderSet))
+}
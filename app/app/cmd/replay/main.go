@@ -0,0 +1,146 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"os"
+
+	"dhb/app/app/internal/biz"
+	"dhb/app/app/internal/conf"
+	"dhb/app/app/internal/data"
+
+	"github.com/go-kratos/kratos/v2/config"
+	"github.com/go-kratos/kratos/v2/config/file"
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// 从充值、后台入单和每日任务记录重建影子库并和生产对比，影子库需和生产在同一 mysql 实例
+// go run ./cmd/replay -conf ../../configs -shadow "root:123456@tcp(127.0.0.1:3306)/dhb_shadow?charset=utf8mb4&parseTime=True&loc=Local" > replay.json
+var (
+	// flagconf is the config flag.
+	flagconf string
+	shadow   string
+)
+
+func init() {
+	flag.StringVar(&flagconf, "conf", "../../configs", "config path, eg: -conf config.yaml")
+	flag.StringVar(&shadow, "shadow", "", "shadow database source")
+}
+
+// newReplayUseCase 生产库只读，重放写入影子库
+func newReplayUseCase(confData *conf.Data, shadowData *conf.Data, logger log.Logger) (*biz.ReplayUseCase, func(), error) {
+	dataProd, cleanupProd, err := data.NewData(confData, logger, data.NewDB(confData), data.NewRedis(confData))
+	if err != nil {
+		return nil, nil, err
+	}
+	dataShadow, cleanupShadow, err := data.NewData(shadowData, logger, data.NewDB(shadowData), data.NewRedis(shadowData))
+	if err != nil {
+		cleanupProd()
+		return nil, nil, err
+	}
+
+	locationRepo := data.NewLocationRepo(dataShadow, logger)
+	userBalanceRepo := data.NewUserBalanceRepo(dataShadow, logger)
+	userRecommendRepo := data.NewUserRecommendRepo(dataShadow, logger)
+	userInfoRepo := data.NewUserInfoRepo(dataShadow, logger)
+	configRepo := data.NewConfigRepo(dataShadow, logger)
+	userCurrentMonthRecommendRepo := data.NewUserCurrentMonthRecommendRepo(dataShadow, logger)
+	adminAuditLogRepo := data.NewAdminAuditLogRepo(dataShadow, logger)
+	lockRepo := data.NewLockRepo(dataShadow, logger)
+	userRepo := data.NewUserRepo(dataShadow, logger)
+	transaction := data.NewTransaction(dataShadow)
+	recordUseCase := biz.NewRecordUseCase(
+		data.NewEthUserRecordRepo(dataShadow, logger),
+		locationRepo,
+		userBalanceRepo,
+		userRecommendRepo,
+		userInfoRepo,
+		userRepo,
+		configRepo,
+		userCurrentMonthRecommendRepo,
+		adminAuditLogRepo,
+		lockRepo,
+		transaction,
+		logger,
+	)
+	// 每日任务在影子库重跑
+	userUseCase := biz.NewUserUseCase(
+		userRepo,
+		transaction,
+		configRepo,
+		userInfoRepo,
+		userRecommendRepo,
+		locationRepo,
+		userCurrentMonthRecommendRepo,
+		userBalanceRepo,
+		adminAuditLogRepo,
+		data.NewAdminProposalRepo(dataShadow, logger),
+		data.NewLedgerRepo(dataShadow, logger),
+		data.NewReconcileRepo(dataShadow, logger),
+		data.NewBalanceSnapshotRepo(dataShadow, logger),
+		data.NewStatementRepo(dataShadow, logger),
+		data.NewSolvencyRepo(dataShadow, logger),
+		data.NewProvenanceRepo(dataShadow, logger),
+		lockRepo,
+		logger,
+	)
+	replayUseCase := biz.NewReplayUseCase(data.NewReplayRepo(dataProd, logger), data.NewReplayRepo(dataShadow, logger), recordUseCase, userUseCase, transaction, logger)
+	return replayUseCase, func() {
+		cleanupShadow()
+		cleanupProd()
+	}, nil
+}
+
+func main() {
+	flag.Parse()
+	if "" == shadow {
+		fmt.Fprintln(os.Stderr, "-shadow is required")
+		os.Exit(1)
+	}
+
+	logger := log.NewStdLogger(os.Stderr)
+	c := config.New(
+		config.WithSource(
+			file.NewSource(flagconf),
+		),
+	)
+	defer c.Close()
+
+	if err := c.Load(); err != nil {
+		panic(err)
+	}
+
+	var bc conf.Bootstrap
+	if err := c.Scan(&bc); err != nil {
+		panic(err)
+	}
+
+	shadowData := &conf.Data{
+		Database: &conf.Data_Database{
+			Driver: bc.Data.Database.Driver,
+			Source: shadow,
+		},
+		Redis: bc.Data.Redis,
+	}
+
+	replayUseCase, cleanup, err := newReplayUseCase(bc.Data, shadowData, logger)
+	if err != nil {
+		panic(err)
+	}
+	defer cleanup()
+
+	report, err := replayUseCase.Replay(context.Background())
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+
+	b, err := json.MarshalIndent(report, "", "  ")
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+	fmt.Println(string(b))
+}
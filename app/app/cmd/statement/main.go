@@ -0,0 +1,84 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"os"
+
+	v1 "dhb/app/app/api"
+	"dhb/app/app/internal/conf"
+
+	"github.com/go-kratos/kratos/v2/config"
+	"github.com/go-kratos/kratos/v2/config/file"
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// 用户对账单导出
+// go run ./cmd/statement -conf ../../configs -user 1 -start 2023-05-01 -end 2023-05-31 -format csv > statement.csv
+var (
+	// flagconf is the config flag.
+	flagconf string
+	userId   int64
+	start    string
+	end      string
+	format   string
+)
+
+func init() {
+	flag.StringVar(&flagconf, "conf", "../../configs", "config path, eg: -conf config.yaml")
+	flag.Int64Var(&userId, "user", 0, "user id")
+	flag.StringVar(&start, "start", "", "start date, eg: 2023-05-01")
+	flag.StringVar(&end, "end", "", "end date (inclusive), eg: 2023-05-31")
+	flag.StringVar(&format, "format", "csv", "csv or json")
+}
+
+func main() {
+	flag.Parse()
+	logger := log.NewStdLogger(os.Stderr)
+	c := config.New(
+		config.WithSource(
+			file.NewSource(flagconf),
+		),
+	)
+	defer c.Close()
+
+	if err := c.Load(); err != nil {
+		panic(err)
+	}
+
+	var bc conf.Bootstrap
+	if err := c.Scan(&bc); err != nil {
+		panic(err)
+	}
+
+	uuc, cleanup, err := wireUserUseCase(bc.Data, logger)
+	if err != nil {
+		panic(err)
+	}
+	defer cleanup()
+
+	res, err := uuc.AdminUserStatement(context.Background(), &v1.AdminUserStatementRequest{
+		UserId: userId,
+		Start:  start,
+		End:    end,
+		Format: format,
+	})
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+
+	if "csv" == format {
+		fmt.Print(res.Csv)
+		return
+	}
+
+	b, err := json.MarshalIndent(res.Lines, "", "  ")
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+	fmt.Println(string(b))
+}
@@ -0,0 +1,139 @@
+package biz
+
+import (
+	"context"
+	v1 "dhb/app/app/api"
+	"dhb/app/app/internal/pkg/middleware/clientip"
+	"encoding/json"
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/middleware/auth/jwt"
+	jwt2 "github.com/golang-jwt/jwt/v4"
+	"time"
+)
+
+type AdminAuditLog struct {
+	ID        int64
+	AdminId   int64
+	Operation string
+	Payload   string
+	Before    string
+	After     string
+	Ip        string
+	CreatedAt time.Time
+}
+
+type AdminAuditLogRepo interface {
+	CreateAdminAuditLog(ctx context.Context, l *AdminAuditLog) (*AdminAuditLog, error)
+	GetAdminAuditLogs(ctx context.Context, b *Pagination, adminId int64, operation string) ([]*AdminAuditLog, error, int64)
+}
+
+// getAdminIdFromContext 在上下文 context 中取出 claims 对象
+func getAdminIdFromContext(ctx context.Context) (int64, error) {
+	var adminId int64
+	if claims, ok := jwt.FromContext(ctx); ok {
+		c := claims.(jwt2.MapClaims)
+		if c["UserId"] == nil {
+			return 0, errors.New(500, "ERROR_TOKEN", "无效TOKEN")
+		}
+		adminId = int64(c["UserId"].(float64))
+	}
+
+	return adminId, nil
+}
+
+// getClientIpFromContext 取 clientip 中间件解析的地址，只有可信代理转发的请求才采信代理头
+func getClientIpFromContext(ctx context.Context) string {
+	return clientip.FromContext(ctx)
+}
+
+// auditJson 审计快照序列化，失败时记空
+func auditJson(v interface{}) string {
+	if nil == v {
+		return ""
+	}
+
+	b, err := json.Marshal(v)
+	if nil != err {
+		return ""
+	}
+
+	return string(b)
+}
+
+// createAdminAuditLog 需在业务事务内调用，与业务数据一起提交或回滚
+func createAdminAuditLog(ctx context.Context, repo AdminAuditLogRepo, operation string, payload interface{}, before interface{}, after interface{}) error {
+	var (
+		adminId int64
+		err     error
+	)
+
+	adminId, err = getAdminIdFromContext(ctx)
+	if nil != err {
+		return err
+	}
+
+	_, err = repo.CreateAdminAuditLog(ctx, &AdminAuditLog{
+		AdminId:   adminId,
+		Operation: operation,
+		Payload:   auditJson(payload),
+		Before:    auditJson(before),
+		After:     auditJson(after),
+		Ip:        getClientIpFromContext(ctx),
+	})
+
+	return err
+}
+
+func (uuc *UserUseCase) AdminAuditLogList(ctx context.Context, req *v1.AdminAuditLogListRequest) (*v1.AdminAuditLogListReply, error) {
+	var (
+		logs     []*AdminAuditLog
+		adminIds []int64
+		admins   []*Admin
+		accounts map[int64]string
+		count    int64
+		err      error
+	)
+
+	res := &v1.AdminAuditLogListReply{
+		Logs: make([]*v1.AdminAuditLogListReply_List, 0),
+	}
+
+	logs, err, count = uuc.auditRepo.GetAdminAuditLogs(ctx, &Pagination{
+		PageNum:  int(req.Page),
+		PageSize: 10,
+	}, req.AdminId, req.Operation)
+	if nil != err {
+		return res, err
+	}
+	res.Count = count
+
+	for _, v := range logs {
+		adminIds = append(adminIds, v.AdminId)
+	}
+
+	accounts = make(map[int64]string, 0)
+	if 0 < len(adminIds) {
+		admins, err = uuc.repo.GetAdmins(ctx)
+		if nil == err {
+			for _, vAdmins := range admins {
+				accounts[vAdmins.ID] = vAdmins.Account
+			}
+		}
+	}
+
+	for _, v := range logs {
+		res.Logs = append(res.Logs, &v1.AdminAuditLogListReply_List{
+			Id:        v.ID,
+			AdminId:   v.AdminId,
+			Account:   accounts[v.AdminId],
+			Operation: v.Operation,
+			Payload:   v.Payload,
+			Before:    v.Before,
+			After:     v.After,
+			Ip:        v.Ip,
+			CreatedAt: v.CreatedAt.Add(8 * time.Hour).Format("2006-01-02 15:04:05"),
+		})
+	}
+
+	return res, nil
+}
@@ -11,4 +11,5 @@ var ProviderSet = wire.NewSet(NewUserUseCase, NewRecordUseCase)
 // Transaction 新增事务接口方法
 type Transaction interface {
 	ExecTx(context.Context, func(ctx context.Context) error) error
+	ReadTx(context.Context, func(ctx context.Context) error) error
 }
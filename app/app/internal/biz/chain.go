@@ -0,0 +1,269 @@
+package biz
+
+import (
+	"context"
+	"github.com/go-kratos/kratos/v2/errors"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ChainTransfer 链上代币转账，Value 为链上精度（18位）
+type ChainTransfer struct {
+	Hash  string
+	From  string
+	To    string
+	Token string
+	Value string
+	Time  time.Time
+}
+
+// ChainReconcileItem 链上与数据库对不上的记录，missing 链上有库里没有，extra 库里有链上没有
+type ChainReconcileItem struct {
+	Kind        string // deposit 充值，payout 提现打款
+	Issue       string // missing，extra，amount_mismatch
+	Hash        string
+	Address     string
+	RecordId    int64
+	ChainAmount string
+	DbAmount    string
+}
+
+// chainWei 系统10位精度转链上18位
+func chainWei(amount int64) string {
+	return strconv.FormatInt(amount, 10) + "00000000"
+}
+
+// GetPayoutAddress 打款钱包地址，配置项 payout_address
+func (uuc *UserUseCase) GetPayoutAddress(ctx context.Context) (string, error) {
+	configs, err := uuc.configRepo.GetConfigByKeys(ctx, "payout_address")
+	if nil != err {
+		return "", err
+	}
+
+	for _, vConfig := range configs {
+		if "payout_address" == vConfig.KeyName {
+			return strings.ToLower(vConfig.Value), nil
+		}
+	}
+
+	return "", errors.New(500, "PAYOUT_ADDRESS_ERROR", "未配置打款地址")
+}
+
+// ChainConfig 链上对账和热钱包余额用到的地址和接口配置
+type ChainConfig struct {
+	DepositAddress string
+	PayoutAddress  string
+	Tokens         map[string]string // usdt、dhb 合约地址
+	ApiKey         string
+}
+
+// defaultChainConfig 未配置时的代币合约地址，充值地址和接口 key 必须配置
+var defaultChainConfig = map[string]string{
+	"usdt_contract": "0x55d398326f99059fF775485246999027B3197955",
+	"dhb_contract":  "0x0f97F5da8C4715D017F597314DCCd00E0D605Ed8",
+}
+
+// GetChainConfig 配置项 deposit_address、usdt_contract、dhb_contract、bscscan_api_key，充值地址、打款地址和 bscscan_api_key 必须配置
+func (uuc *UserUseCase) GetChainConfig(ctx context.Context) (*ChainConfig, error) {
+	payoutAddress, err := uuc.GetPayoutAddress(ctx)
+	if nil != err {
+		return nil, err
+	}
+
+	configs, err := uuc.configRepo.GetConfigByKeys(ctx, "deposit_address", "usdt_contract", "dhb_contract", "bscscan_api_key")
+	if nil != err {
+		return nil, err
+	}
+	values := make(map[string]string, 0)
+	for k, v := range defaultChainConfig {
+		values[k] = v
+	}
+	for _, vConfig := range configs {
+		if "" != strings.TrimSpace(vConfig.Value) {
+			values[vConfig.KeyName] = strings.TrimSpace(vConfig.Value)
+		}
+	}
+	if "" == values["deposit_address"] {
+		return nil, errors.New(500, "DEPOSIT_ADDRESS_ERROR", "未配置充值地址")
+	}
+	if "" == values["bscscan_api_key"] {
+		return nil, errors.New(500, "BSCSCAN_API_KEY_ERROR", "未配置bscscan接口key")
+	}
+
+	return &ChainConfig{
+		DepositAddress: strings.ToLower(values["deposit_address"]),
+		PayoutAddress:  payoutAddress,
+		Tokens: map[string]string{
+			"usdt": values["usdt_contract"],
+			"dhb":  values["dhb_contract"],
+		},
+		ApiKey: values["bscscan_api_key"],
+	}, nil
+}
+
+func inTimeRange(t time.Time, start time.Time, end time.Time) bool {
+	return !t.Before(start) && t.Before(end)
+}
+
+// ChainReconcileDeposits 充值对账，transfers 需覆盖比 start,end 更宽的时间范围，避免入账延迟误报
+func (ruc *RecordUseCase) ChainReconcileDeposits(ctx context.Context, start time.Time, end time.Time, transfers []*ChainTransfer) ([]*ChainReconcileItem, error) {
+	var (
+		records      []*EthUserRecord
+		existRecords map[string]*EthUserRecord
+		hashes       []string
+		err          error
+	)
+
+	res := make([]*ChainReconcileItem, 0)
+	chainTransfers := make(map[string]*ChainTransfer, 0)
+	for _, v := range transfers {
+		chainTransfers[v.Hash] = v
+		if inTimeRange(v.Time, start, end) {
+			hashes = append(hashes, v.Hash)
+		}
+	}
+
+	// 库里的充值记录在链上找不到或金额不一致
+	records, err = ruc.ethUserRecordRepo.GetEthUserRecordsByTime(ctx, "deposit", start, end)
+	if nil != err {
+		return nil, err
+	}
+	for _, v := range records {
+		transfer, ok := chainTransfers[v.Hash]
+		if !ok {
+			res = append(res, &ChainReconcileItem{Kind: "deposit", Issue: "extra", Hash: v.Hash, RecordId: v.ID, DbAmount: v.Amount})
+			continue
+		}
+
+		if transfer.Value != v.Amount {
+			res = append(res, &ChainReconcileItem{Kind: "deposit", Issue: "amount_mismatch", Hash: v.Hash, Address: transfer.From, RecordId: v.ID, ChainAmount: transfer.Value, DbAmount: v.Amount})
+		}
+	}
+
+	// 链上的充值在库里没有记录
+	if 0 < len(hashes) {
+		existRecords, err = ruc.ethUserRecordRepo.GetEthUserRecordListByHash(ctx, hashes...)
+		if nil != err {
+			return nil, err
+		}
+	}
+	for _, hash := range hashes {
+		if _, ok := existRecords[hash]; ok {
+			continue
+		}
+
+		transfer := chainTransfers[hash]
+		res = append(res, &ChainReconcileItem{Kind: "deposit", Issue: "missing", Hash: hash, Address: transfer.From, ChainAmount: transfer.Value})
+	}
+
+	return res, nil
+}
+
+// ChainReconcilePayouts 提现打款对账，没有保存打款哈希，按币种、收款地址和金额匹配，transfers 需覆盖更宽的时间范围
+func (uuc *UserUseCase) ChainReconcilePayouts(ctx context.Context, start time.Time, end time.Time, tokens map[string]string, transfers []*ChainTransfer) ([]*ChainReconcileItem, error) {
+	var (
+		withdraws []*Withdraw
+		users     map[int64]*User
+		userIds   []int64
+		err       error
+	)
+
+	res := make([]*ChainReconcileItem, 0)
+
+	// 前后各放宽一小时取提现，用于消化边界上的链上转账
+	withdraws, err = uuc.ubRepo.GetWithdrawSuccessByTime(ctx, start.Add(-time.Hour), end.Add(time.Hour))
+	if nil != err {
+		return nil, err
+	}
+	for _, v := range withdraws {
+		userIds = append(userIds, v.UserId)
+	}
+	users = make(map[int64]*User, 0)
+	if 0 < len(userIds) {
+		users, err = uuc.repo.GetUserByUserIds(ctx, userIds...)
+		if nil != err {
+			return nil, err
+		}
+	}
+
+	sort.Slice(transfers, func(i, j int) bool {
+		return transfers[i].Time.Before(transfers[j].Time)
+	})
+	chainTransfers := make(map[string][]*ChainTransfer, 0)
+	for _, v := range transfers {
+		key := strings.ToLower(v.Token) + "|" + strings.ToLower(v.To)
+		chainTransfers[key] = append(chainTransfers[key], v)
+	}
+	used := make(map[string]bool, 0)
+
+	withdrawKey := func(v *Withdraw) (string, string, bool) {
+		token, ok := tokens[v.Type]
+		if !ok { // bnb 为原生币转账，不在代币记录里
+			return "", "", false
+		}
+		user, ok := users[v.UserId]
+		if !ok {
+			return "", "", false
+		}
+
+		value := chainWei(v.Amount)
+		if "usdt" == v.Type {
+			value = chainWei(v.RelAmount)
+		}
+		return strings.ToLower(token) + "|" + strings.ToLower(user.Address), value, true
+	}
+
+	// 先按金额精确匹配
+	unmatched := make([]*Withdraw, 0)
+	for _, v := range withdraws {
+		key, value, ok := withdrawKey(v)
+		if !ok {
+			continue
+		}
+
+		matched := false
+		for _, transfer := range chainTransfers[key] {
+			if !used[transfer.Hash] && value == transfer.Value {
+				used[transfer.Hash] = true
+				matched = true
+				break
+			}
+		}
+		if !matched && inTimeRange(v.UpdatedAt, start, end) {
+			unmatched = append(unmatched, v)
+		}
+	}
+
+	// 同地址有未匹配的转账视为金额不一致，否则链上没有打款
+	for _, v := range unmatched {
+		key, value, _ := withdrawKey(v)
+
+		var mismatch *ChainTransfer
+		for _, transfer := range chainTransfers[key] {
+			if !used[transfer.Hash] {
+				mismatch = transfer
+				break
+			}
+		}
+
+		if nil != mismatch {
+			used[mismatch.Hash] = true
+			res = append(res, &ChainReconcileItem{Kind: "payout", Issue: "amount_mismatch", Hash: mismatch.Hash, Address: mismatch.To, RecordId: v.ID, ChainAmount: mismatch.Value, DbAmount: value})
+		} else {
+			res = append(res, &ChainReconcileItem{Kind: "payout", Issue: "extra", Address: users[v.UserId].Address, RecordId: v.ID, DbAmount: value})
+		}
+	}
+
+	// 链上打款在库里没有对应的成功提现
+	for _, v := range transfers {
+		if used[v.Hash] || !inTimeRange(v.Time, start, end) {
+			continue
+		}
+
+		res = append(res, &ChainReconcileItem{Kind: "payout", Issue: "missing", Hash: v.Hash, Address: v.To, ChainAmount: v.Value})
+	}
+
+	return res, nil
+}
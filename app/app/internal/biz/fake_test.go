@@ -0,0 +1,464 @@
+package biz
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// testTx 事务直接执行，失败时不回滚，用例只校验成功路径的写入和失败时的返回
+type testTx struct{}
+
+func (testTx) ExecTx(ctx context.Context, fn func(ctx context.Context) error) error {
+	return fn(ctx)
+}
+
+func (testTx) ReadTx(ctx context.Context, fn func(ctx context.Context) error) error {
+	return fn(ctx)
+}
+
+type testAuditRepo struct {
+	AdminAuditLogRepo
+	logs []*AdminAuditLog
+}
+
+func (r *testAuditRepo) CreateAdminAuditLog(ctx context.Context, l *AdminAuditLog) (*AdminAuditLog, error) {
+	r.logs = append(r.logs, l)
+	return l, nil
+}
+
+// testUbRepo 提现和余额相关的内存实现，未用到的方法调用时 panic
+type testUbRepo struct {
+	UserBalanceRepo
+	withdraws map[int64]*Withdraw
+	balances  map[int64]map[string]int64
+	events    []*WithdrawEvent
+	refunds   []int64
+	reverses  [][2]int64 // fee, pool
+}
+
+func newTestUbRepo() *testUbRepo {
+	return &testUbRepo{
+		withdraws: make(map[int64]*Withdraw),
+		balances:  make(map[int64]map[string]int64),
+	}
+}
+
+func (r *testUbRepo) GetWithdrawById(ctx context.Context, id int64) (*Withdraw, error) {
+	w, ok := r.withdraws[id]
+	if !ok {
+		return nil, errors.NotFound("WITHDRAW_NOT_FOUND", "withdraw not found")
+	}
+	tmp := *w
+	return &tmp, nil
+}
+
+func (r *testUbRepo) UpdateWithdrawStatus(ctx context.Context, id int64, fromStatus string, toStatus string, reason string) (bool, error) {
+	w, ok := r.withdraws[id]
+	if !ok || fromStatus != w.Status {
+		return false, nil
+	}
+	w.Status = toStatus
+	if "" != reason {
+		w.Reason = reason
+	}
+	return true, nil
+}
+
+func (r *testUbRepo) CreateWithdrawEvent(ctx context.Context, e *WithdrawEvent) (*WithdrawEvent, error) {
+	r.events = append(r.events, e)
+	return e, nil
+}
+
+func (r *testUbRepo) UpdateWithdrawReview(ctx context.Context, withdrawId int64, status string, adminId int64, reason string) error {
+	return nil
+}
+
+func (r *testUbRepo) WithdrawRefund(ctx context.Context, userId int64, amount int64, coinType string) (int64, error) {
+	if _, ok := r.balances[userId]; !ok {
+		r.balances[userId] = make(map[string]int64)
+	}
+	r.balances[userId][coinType] += amount
+	r.refunds = append(r.refunds, amount)
+	return int64(len(r.refunds)), nil
+}
+
+func (r *testUbRepo) GetWithdrawDoingTimeout(ctx context.Context) ([]*Withdraw, error) {
+	res := make([]*Withdraw, 0)
+	for _, w := range r.withdraws {
+		if "doing" == w.Status {
+			tmp := *w
+			res = append(res, &tmp)
+		}
+	}
+	return res, nil
+}
+
+func (r *testUbRepo) WithdrawRewardedReverse(ctx context.Context, withdrawId int64, fee int64, pool int64) error {
+	r.reverses = append(r.reverses, [2]int64{fee, pool})
+	return nil
+}
+
+func newTestUserUseCase(ubRepo UserBalanceRepo) (*UserUseCase, *testAuditRepo) {
+	auditRepo := &testAuditRepo{}
+	return &UserUseCase{
+		tx:         testTx{},
+		ubRepo:     ubRepo,
+		auditRepo:  auditRepo,
+		ledgerRepo: newTestLedgerRepo(),
+		lockRepo:   &testLockRepo{},
+		log:        log.NewHelper(log.DefaultLogger),
+	}, auditRepo
+}
+
+// testLockRepo 租约按 token 递增发放，busy 时拿不到，expired 时续期失败
+type testLockRepo struct {
+	LockRepo
+	token    int64
+	busy     bool
+	expired  bool
+	acquired int
+	released int
+}
+
+func (r *testLockRepo) AcquireLease(ctx context.Context, name string, holder string, ttl time.Duration) (*Lease, error) {
+	r.acquired++
+	if r.busy {
+		return nil, nil
+	}
+	r.token++
+	return &Lease{Name: name, Holder: holder, Token: r.token, ExpireAt: time.Now().Add(ttl)}, nil
+}
+
+func (r *testLockRepo) RenewLease(ctx context.Context, l *Lease, ttl time.Duration) (bool, error) {
+	return !r.expired, nil
+}
+
+func (r *testLockRepo) ReleaseLease(ctx context.Context, l *Lease) error {
+	r.released++
+	return nil
+}
+
+// testFenceTx 和 data 的事务一样按租约 token 做 fencing，同名租约记最大 token，更小的 token 开事务时拒绝
+type testFenceTx struct {
+	testTx
+	fence map[string]int64
+}
+
+func (t *testFenceTx) ExecTx(ctx context.Context, fn func(ctx context.Context) error) error {
+	if l := LeaseFromContext(ctx); nil != l {
+		if l.Token > t.fence[l.Name] {
+			t.fence[l.Name] = l.Token
+		}
+		if l.Token < t.fence[l.Name] {
+			return errors.New(500, "LOCK ERROR", "锁已过期")
+		}
+	}
+	return fn(ctx)
+}
+
+// testLedgerRepo 和 postLedger 一样校验分录平衡，按科目累计余额
+type testLedgerRepo struct {
+	LedgerRepo
+	journals []string
+	accounts map[string]int64
+}
+
+func newTestLedgerRepo() *testLedgerRepo {
+	return &testLedgerRepo{accounts: make(map[string]int64)}
+}
+
+func (r *testLedgerRepo) PostLedger(ctx context.Context, event string, refId int64, legs ...*LedgerLeg) error {
+	sum := make(map[string]int64, 0)
+	for _, leg := range legs {
+		sum[leg.CoinType] += leg.Amount
+	}
+	for _, v := range sum {
+		if 0 != v {
+			return errors.New(500, "LEDGER_NOT_BALANCED", "记账分录不平")
+		}
+	}
+
+	r.journals = append(r.journals, event)
+	for _, leg := range legs {
+		r.accounts[leg.AccountType+"_"+leg.CoinType] += leg.Amount
+	}
+	return nil
+}
+
+// testLocationRepo 占位的内存实现，序号按 id 顺序数运行中占位
+type testLocationRepo struct {
+	LocationRepo
+	locations []*Location
+}
+
+func (r *testLocationRepo) running() []*Location {
+	res := make([]*Location, 0)
+	for _, v := range r.locations {
+		if "running" == v.Status {
+			res = append(res, v)
+		}
+	}
+	return res
+}
+
+func (r *testLocationRepo) GetLocationsByUserId(ctx context.Context, userId int64) ([]*Location, error) {
+	res := make([]*Location, 0)
+	for _, v := range r.locations {
+		if userId == v.UserId {
+			res = append(res, v)
+		}
+	}
+	return res, nil
+}
+
+func (r *testLocationRepo) GetLocationRunningCount(ctx context.Context) (int64, error) {
+	return int64(len(r.running())), nil
+}
+
+func (r *testLocationRepo) CreateLocation(ctx context.Context, l *Location) (*Location, error) {
+	tmp := *l
+	tmp.ID = int64(len(r.locations) + 1)
+	r.locations = append(r.locations, &tmp)
+	return &tmp, nil
+}
+
+func (r *testLocationRepo) GetMyStopLocationLast(ctx context.Context, userId int64) (*Location, error) {
+	var res *Location
+	for _, v := range r.locations {
+		if userId == v.UserId && "stop" == v.Status {
+			res = v
+		}
+	}
+	return res, nil
+}
+
+func (r *testLocationRepo) GetMyLocationLast(ctx context.Context, userId int64) (*Location, error) {
+	var res *Location
+	for _, v := range r.locations {
+		if userId == v.UserId {
+			tmp := *v
+			res = &tmp
+		}
+	}
+	return res, nil
+}
+
+func (r *testLocationRepo) GetRewardLocationByRowOrCol(ctx context.Context, row int64, col int64, locationRowConfig int64, width int64) ([]*Location, error) {
+	res := make([]*Location, 0)
+	for k, v := range r.running() {
+		tmp := *v
+		tmp.Row, tmp.Col = LocationPosition(int64(k), width)
+		if tmp.Row >= row-locationRowConfig && tmp.Row <= row+locationRowConfig {
+			res = append(res, &tmp)
+		}
+	}
+	return res, nil
+}
+
+func (r *testLocationRepo) UpdateLocation(ctx context.Context, id int64, status string, current int64, stopDate time.Time) error {
+	for _, v := range r.locations {
+		if id == v.ID {
+			v.Status = status
+			v.Current += current
+			v.StopDate = stopDate
+		}
+	}
+	return nil
+}
+
+// testUserRepo 用户、用户信息、推荐关系的内存实现，推荐码和 data 一致为上级推荐码加 D+上级 id
+type testUserRepo struct {
+	UserRepo
+	UserInfoRepo
+	UserRecommendRepo
+	UserCurrentMonthRecommendRepo
+	users      map[int64]*User
+	infos      map[int64]*UserInfo
+	recommends map[int64]*UserRecommend
+	areas      map[int64]int64
+}
+
+func newTestUserRepo() *testUserRepo {
+	return &testUserRepo{
+		users:      make(map[int64]*User),
+		infos:      make(map[int64]*UserInfo),
+		recommends: make(map[int64]*UserRecommend),
+		areas:      make(map[int64]int64),
+	}
+}
+
+func (r *testUserRepo) CreateUser(ctx context.Context, u *User) (*User, error) {
+	tmp := *u
+	tmp.ID = int64(len(r.users) + 1)
+	r.users[tmp.ID] = &tmp
+	return &tmp, nil
+}
+
+func (r *testUserRepo) CreateUserInfo(ctx context.Context, u *User) (*UserInfo, error) {
+	info := &UserInfo{UserId: u.ID, VipAdmin: vipAdminUnset}
+	r.infos[u.ID] = info
+	return info, nil
+}
+
+func (r *testUserRepo) GetUserInfoByUserId(ctx context.Context, userId int64) (*UserInfo, error) {
+	info, ok := r.infos[userId]
+	if !ok {
+		return nil, errors.NotFound("USERINFO_NOT_FOUND", "userinfo not found")
+	}
+	tmp := *info
+	return &tmp, nil
+}
+
+func (r *testUserRepo) UpdateUserInfo(ctx context.Context, u *UserInfo) (*UserInfo, error) {
+	tmp := *u
+	r.infos[u.UserId] = &tmp
+	return u, nil
+}
+
+func (r *testUserRepo) GetUserRecommendByUserId(ctx context.Context, userId int64) (*UserRecommend, error) {
+	recommend, ok := r.recommends[userId]
+	if !ok {
+		return nil, errors.NotFound("USER_RECOMMEND_NOT_FOUND", "user recommend not found")
+	}
+	return recommend, nil
+}
+
+func (r *testUserRepo) CreateUserRecommend(ctx context.Context, u *User, recommendUser *UserRecommend) (*UserRecommend, error) {
+	recommend := &UserRecommend{UserId: u.ID}
+	if nil != recommendUser {
+		recommend.RecommendCode = recommendUser.RecommendCode + "D" + strconv.FormatInt(recommendUser.UserId, 10)
+	}
+	r.recommends[u.ID] = recommend
+	return recommend, nil
+}
+
+func (r *testUserRepo) UpdateUserAreaSelfAmount(ctx context.Context, userId int64, amount int64) (bool, error) {
+	return true, nil
+}
+
+func (r *testUserRepo) UpdateUserAreaAmount(ctx context.Context, userId int64, amount int64) (bool, error) {
+	r.areas[userId] += amount
+	return true, nil
+}
+
+func (r *testUserRepo) CreateUserCurrentMonthRecommend(ctx context.Context, u *UserCurrentMonthRecommend) (*UserCurrentMonthRecommend, error) {
+	return u, nil
+}
+
+// testConfigRepo 配置的内存实现
+type testConfigRepo struct {
+	ConfigRepo
+	configs map[string]string
+}
+
+func (r *testConfigRepo) GetConfigByKeys(ctx context.Context, keys ...string) ([]*Config, error) {
+	res := make([]*Config, 0)
+	for _, key := range keys {
+		if value, ok := r.configs[key]; ok {
+			res = append(res, &Config{KeyName: key, Value: value})
+		}
+	}
+	return res, nil
+}
+
+// testEthUserRecordRepo 入单记录的内存实现
+type testEthUserRecordRepo struct {
+	EthUserRecordRepo
+	records []*EthUserRecord
+}
+
+func (r *testEthUserRecordRepo) CreateEthUserRecordListByHash(ctx context.Context, e *EthUserRecord) (*EthUserRecord, error) {
+	r.records = append(r.records, e)
+	return e, nil
+}
+
+// testRewardRepo 入单分配写入的余额和奖励，按用户累计入账的 usdt
+type testRewardRepo struct {
+	*testUbRepo
+	rewards map[int64]int64
+	system  int64
+}
+
+func newTestRewardRepo() *testRewardRepo {
+	return &testRewardRepo{testUbRepo: newTestUbRepo(), rewards: make(map[int64]int64)}
+}
+
+func (r *testRewardRepo) reward(userId int64, amount int64, status string) (int64, error) {
+	if "running" == status {
+		r.rewards[userId] += amount
+	}
+	return 0, nil
+}
+
+func (r *testRewardRepo) CreateUserBalance(ctx context.Context, u *User) (*UserBalance, error) {
+	r.balances[u.ID] = make(map[string]int64)
+	return &UserBalance{UserId: u.ID}, nil
+}
+
+func (r *testRewardRepo) Deposit(ctx context.Context, userId int64, amount int64, dhbAmount int64) (int64, error) {
+	r.balances[userId]["dhb"] += dhbAmount
+	return 0, nil
+}
+
+func (r *testRewardRepo) Reinvest(ctx context.Context, userId int64, amount int64, dhbAmount int64) (int64, error) {
+	if r.balances[userId]["usdt"] < amount {
+		return 0, errors.New(500, "REINVEST_BALANCE_NOT_ENOUGH", "余额不足")
+	}
+	r.balances[userId]["usdt"] -= amount
+	r.balances[userId]["dhb"] += dhbAmount
+	return 0, nil
+}
+
+func (r *testRewardRepo) GetUserBalance(ctx context.Context, userId int64) (*UserBalance, error) {
+	return &UserBalance{UserId: userId, BalanceUsdt: r.balances[userId]["usdt"], BalanceDhb: r.balances[userId]["dhb"]}, nil
+}
+
+func (r *testRewardRepo) LocationReward(ctx context.Context, userId int64, amount int64, locationId int64, myLocationId int64, locationType string, status string) (int64, error) {
+	return r.reward(userId, amount, status)
+}
+
+func (r *testRewardRepo) NormalRecommendReward(ctx context.Context, userId int64, amount int64, locationId int64, status string) (int64, error) {
+	return r.reward(userId, amount, status)
+}
+
+func (r *testRewardRepo) RecommendReward(ctx context.Context, userId int64, amount int64, locationId int64, status string) (int64, error) {
+	return r.reward(userId, amount, status)
+}
+
+func (r *testRewardRepo) RecommendTopReward(ctx context.Context, userId int64, amount int64, locationId int64, vip int64, status string) (int64, error) {
+	return r.reward(userId, amount, status)
+}
+
+func (r *testRewardRepo) DepositLast(ctx context.Context, userId int64, lastAmount int64, locationId int64) (int64, error) {
+	return r.reward(userId, lastAmount, "running")
+}
+
+func (r *testRewardRepo) SystemReward(ctx context.Context, amount int64, locationId int64) error {
+	r.system += amount
+	return nil
+}
+
+// newTestRecordUseCase 入单相关的内存实现，用户和占位由用例自己准备
+func newTestRecordUseCase(configs map[string]string) (*RecordUseCase, *testLocationRepo, *testUserRepo, *testRewardRepo) {
+	locationRepo := &testLocationRepo{}
+	userRepo := newTestUserRepo()
+	rewardRepo := newTestRewardRepo()
+
+	return &RecordUseCase{
+		ethUserRecordRepo:             &testEthUserRecordRepo{},
+		userRecommendRepo:             userRepo,
+		configRepo:                    &testConfigRepo{configs: configs},
+		locationRepo:                  locationRepo,
+		userBalanceRepo:               rewardRepo,
+		userInfoRepo:                  userRepo,
+		userRepo:                      userRepo,
+		userCurrentMonthRecommendRepo: userRepo,
+		lockRepo:                      &testLockRepo{},
+		tx:                            testTx{},
+		log:                           log.NewHelper(log.DefaultLogger),
+	}, locationRepo, userRepo, rewardRepo
+}
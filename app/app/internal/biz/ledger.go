@@ -0,0 +1,106 @@
+package biz
+
+import (
+	"context"
+	v1 "dhb/app/app/api"
+	"fmt"
+	"time"
+)
+
+// 账本科目，user 为用户余额，其余为系统科目
+const (
+	LedgerAccountUser     = "user"     // 用户余额
+	LedgerAccountPool     = "pool"     // 系统奖金池，入金进池，分红出池
+	LedgerAccountFee      = "fee"      // 平台手续费和系统分成
+	LedgerAccountPayout   = "payout"   // 提现在途，扣款后到链上打款前
+	LedgerAccountExternal = "external" // 链上，入金来源和打款去向
+	LedgerAccountAdjust   = "adjust"   // 后台调账和期初余额
+)
+
+// LedgerLeg 一条分录，Amount 为科目余额变动，同一凭证同币种合计必须为0
+type LedgerLeg struct {
+	AccountType string
+	UserId      int64
+	CoinType    string
+	Amount      int64
+}
+
+type LedgerJournal struct {
+	ID        int64
+	Event     string
+	RefId     int64
+	CreatedAt time.Time
+}
+
+// LedgerMismatch user_balance 与账本用户科目不一致的记录
+type LedgerMismatch struct {
+	UserId   int64
+	CoinType string
+	Balance  int64
+	Ledger   int64
+}
+
+type LedgerRepo interface {
+	PostLedger(ctx context.Context, event string, refId int64, legs ...*LedgerLeg) error
+	GetLedgerMismatches(ctx context.Context) ([]*LedgerMismatch, error)
+	OpenLedgerUserBalances(ctx context.Context) (int64, error)
+}
+
+// transferLedger 两个科目间转账的分录
+func transferLedger(coinType string, amount int64, fromType string, fromUserId int64, toType string, toUserId int64) []*LedgerLeg {
+	return []*LedgerLeg{
+		{AccountType: fromType, UserId: fromUserId, CoinType: coinType, Amount: -amount},
+		{AccountType: toType, UserId: toUserId, CoinType: coinType, Amount: amount},
+	}
+}
+
+func (uuc *UserUseCase) AdminLedgerVerify(ctx context.Context, req *v1.AdminLedgerVerifyRequest) (*v1.AdminLedgerVerifyReply, error) {
+	var (
+		mismatches []*LedgerMismatch
+		err        error
+	)
+
+	res := &v1.AdminLedgerVerifyReply{
+		Mismatches: make([]*v1.AdminLedgerVerifyReply_List, 0),
+	}
+
+	mismatches, err = uuc.ledgerRepo.GetLedgerMismatches(ctx)
+	if nil != err {
+		return res, err
+	}
+
+	for _, v := range mismatches {
+		res.Mismatches = append(res.Mismatches, &v1.AdminLedgerVerifyReply_List{
+			UserId:   v.UserId,
+			CoinType: v.CoinType,
+			Balance:  fmt.Sprintf("%.2f", float64(v.Balance)/float64(10000000000)),
+			Ledger:   fmt.Sprintf("%.2f", float64(v.Ledger)/float64(10000000000)),
+		})
+	}
+	res.Count = int64(len(mismatches))
+
+	return res, nil
+}
+
+// AdminLedgerOpen 为尚未入账的用户按当前余额记期初，可重复执行
+func (uuc *UserUseCase) AdminLedgerOpen(ctx context.Context, req *v1.AdminLedgerOpenRequest) (*v1.AdminLedgerOpenReply, error) {
+	var (
+		count int64
+		err   error
+	)
+
+	if err = uuc.tx.ExecTx(ctx, func(ctx context.Context) error { // 事务
+		count, err = uuc.ledgerRepo.OpenLedgerUserBalances(ctx)
+		if nil != err {
+			return err
+		}
+
+		return createAdminAuditLog(ctx, uuc.auditRepo, "AdminLedgerOpen", nil, nil, count)
+	}); nil != err {
+		return nil, err
+	}
+
+	return &v1.AdminLedgerOpenReply{
+		Count: count,
+	}, nil
+}
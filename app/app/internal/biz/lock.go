@@ -0,0 +1,141 @@
+package biz
+
+import (
+	"context"
+	v1 "dhb/app/app/api"
+	"fmt"
+	"github.com/go-kratos/kratos/v2/errors"
+	"os"
+	"time"
+)
+
+// Lease 租约锁，Token 每次获取递增，作为 fencing token 在事务里校验，过期持有者的写入会被拒绝
+type Lease struct {
+	Name     string
+	Holder   string
+	Token    int64
+	ExpireAt time.Time
+}
+
+type LockRepo interface {
+	AcquireLease(ctx context.Context, name string, holder string, ttl time.Duration) (*Lease, error)
+	RenewLease(ctx context.Context, l *Lease, ttl time.Duration) (bool, error)
+	ReleaseLease(ctx context.Context, l *Lease) error
+	GetLease(ctx context.Context, name string) (*Lease, error)
+}
+
+var (
+	// locationLease 入单、后台入单和每日任务，都会改占位
+	locationLease = "location"
+	// withdrawLease 提现处理和链上打款
+	withdrawLease = "withdraw"
+	// leaseNames 后台展示的锁
+	leaseNames = []string{locationLease, withdrawLease}
+)
+
+// 租约时长和重试，测试中缩短
+var (
+	leaseTTL        = 30 * time.Second
+	leaseRetry      = 3
+	leaseRetryDelay = 2 * time.Second
+)
+
+// 用来承载租约的上下文，事务开始时按租约做 fencing 校验
+type contextLeaseKey struct{}
+
+// LeaseFromContext .
+func LeaseFromContext(ctx context.Context) *Lease {
+	l, _ := ctx.Value(contextLeaseKey{}).(*Lease)
+	return l
+}
+
+// leaseHolder 操作名@主机:进程
+func leaseHolder(operation string) string {
+	hostname, _ := os.Hostname()
+	return fmt.Sprintf("%s@%s:%d", operation, hostname, os.Getpid())
+}
+
+// acquireLease 获取租约，拿不到时重试，返回带租约的 ctx 和释放函数；
+// 持有期间按 ttl/3 续期，续期失败取消 ctx，后续事务会被 fencing 拒绝
+func acquireLease(ctx context.Context, lockRepo LockRepo, name string, operation string) (context.Context, func(), error) {
+	var (
+		l   *Lease
+		err error
+	)
+
+	if l = LeaseFromContext(ctx); nil != l && name == l.Name { // 外层已持有同名租约，例如审批提案内执行后台入单
+		return ctx, func() {}, nil
+	}
+
+	holder := leaseHolder(operation)
+	ttl := leaseTTL
+	for i := 0; i < leaseRetry; i++ {
+		if 0 < i {
+			time.Sleep(leaseRetryDelay)
+		}
+		l, err = lockRepo.AcquireLease(ctx, name, holder, ttl)
+		if nil != err {
+			return ctx, nil, err
+		}
+		if nil != l {
+			break
+		}
+	}
+	if nil == l {
+		return ctx, nil, errors.New(500, "LOCK ERROR", "任务执行中，请稍后再试")
+	}
+
+	ctx, cancel := context.WithCancel(context.WithValue(ctx, contextLeaseKey{}, l))
+	done := make(chan struct{})
+	go func() {
+		ticker := time.NewTicker(ttl / 3)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-done:
+				return
+			case <-ticker.C:
+				ok, err := lockRepo.RenewLease(context.Background(), l, ttl)
+				if nil != err || !ok {
+					cancel()
+					return
+				}
+			}
+		}
+	}()
+
+	return ctx, func() {
+		close(done)
+		cancel()
+		_ = lockRepo.ReleaseLease(context.Background(), l)
+	}, nil
+}
+
+// AcquireWithdrawLease 链上打款在 service 层，提现锁
+func (uuc *UserUseCase) AcquireWithdrawLease(ctx context.Context, operation string) (context.Context, func(), error) {
+	return acquireLease(ctx, uuc.lockRepo, withdrawLease, operation)
+}
+
+// AdminLockList 当前锁的持有者和过期时间
+func (uuc *UserUseCase) AdminLockList(ctx context.Context, req *v1.AdminLockListRequest) (*v1.AdminLockListReply, error) {
+	res := &v1.AdminLockListReply{
+		Locks: make([]*v1.AdminLockListReply_List, 0),
+	}
+
+	for _, name := range leaseNames {
+		l, err := uuc.lockRepo.GetLease(ctx, name)
+		if nil != err {
+			return nil, err
+		}
+
+		item := &v1.AdminLockListReply_List{Name: name}
+		if nil != l {
+			item.Holder = l.Holder
+			item.Token = l.Token
+			item.ExpireAt = l.ExpireAt.Add(8 * time.Hour).Format("2006-01-02 15:04:05")
+		}
+		res.Locks = append(res.Locks, item)
+	}
+
+	return res, nil
+}
@@ -0,0 +1,89 @@
+package biz
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/errors"
+)
+
+func TestAcquireLease(t *testing.T) {
+	leaseTTL, leaseRetryDelay = 30*time.Millisecond, time.Millisecond
+	defer func() { leaseTTL, leaseRetryDelay = 30*time.Second, 2*time.Second }()
+
+	// 同名租约可重入，内层释放不释放外层
+	lockRepo := &testLockRepo{}
+	ctx, release, err := acquireLease(context.Background(), lockRepo, locationLease, "outer")
+	if nil != err {
+		t.Fatal(err)
+	}
+	innerCtx, innerRelease, err := acquireLease(ctx, lockRepo, locationLease, "inner")
+	if nil != err || 1 != lockRepo.acquired || LeaseFromContext(ctx) != LeaseFromContext(innerCtx) {
+		t.Errorf("reentrant: err %v, acquired %d", err, lockRepo.acquired)
+	}
+	innerRelease()
+	if 0 != lockRepo.released || nil != ctx.Err() {
+		t.Errorf("inner release released the outer lease")
+	}
+	release()
+	if 1 != lockRepo.released || nil == ctx.Err() {
+		t.Errorf("release: released %d, ctx %v", lockRepo.released, ctx.Err())
+	}
+
+	// 拿不到时重试后报错
+	lockRepo = &testLockRepo{busy: true}
+	if _, _, err = acquireLease(context.Background(), lockRepo, locationLease, "busy"); "LOCK ERROR" != errors.Reason(err) || leaseRetry != lockRepo.acquired {
+		t.Errorf("busy: err %v, acquired %d", err, lockRepo.acquired)
+	}
+
+	// 续期失败取消 ctx
+	lockRepo = &testLockRepo{expired: true}
+	ctx, release, err = acquireLease(context.Background(), lockRepo, locationLease, "expired")
+	if nil != err {
+		t.Fatal(err)
+	}
+	select {
+	case <-ctx.Done():
+	case <-time.After(time.Second):
+		t.Errorf("ctx not canceled after renew failed")
+	}
+	release()
+}
+
+// TestLeaseFencing 租约过期后别的进程拿到新租约，旧持有者的写入在事务开始时被拒绝
+func TestLeaseFencing(t *testing.T) {
+	ubRepo := newTestUbRepo()
+	ubRepo.withdraws[1] = &Withdraw{ID: 1, UserId: 7, Amount: 500, Type: "dhb", Status: "pass"}
+	uuc, _ := newTestUserUseCase(ubRepo)
+	tx := &testFenceTx{fence: make(map[string]int64)}
+	uuc.tx = tx
+
+	staleCtx, staleRelease, err := uuc.AcquireWithdrawLease(context.Background(), "stale")
+	if nil != err {
+		t.Fatal(err)
+	}
+	defer staleRelease()
+	ctx, release, err := uuc.AcquireWithdrawLease(context.Background(), "current")
+	if nil != err {
+		t.Fatal(err)
+	}
+	defer release()
+
+	w, _ := ubRepo.GetWithdrawById(ctx, 1)
+	if err = uuc.UpdateWithdrawDoing(ctx, w); nil != err {
+		t.Fatal(err)
+	}
+
+	w, _ = ubRepo.GetWithdrawById(ctx, 1)
+	if err = uuc.UpdateWithdrawSuccess(staleCtx, w); "LOCK ERROR" != errors.Reason(err) {
+		t.Errorf("stale holder: err = %v, want LOCK ERROR", err)
+	}
+	if "doing" != ubRepo.withdraws[1].Status || 0 != len(uuc.ledgerRepo.(*testLedgerRepo).journals) {
+		t.Errorf("stale holder wrote: status %q", ubRepo.withdraws[1].Status)
+	}
+
+	if err = uuc.UpdateWithdrawSuccess(ctx, w); nil != err || "success" != ubRepo.withdraws[1].Status {
+		t.Errorf("current holder: err %v, status %q", err, ubRepo.withdraws[1].Status)
+	}
+}
@@ -0,0 +1,95 @@
+package biz
+
+import (
+	"context"
+	v1 "dhb/app/app/api"
+	"fmt"
+	"strconv"
+	"time"
+)
+
+// locationPercent 已分额度占封顶的百分比，超出按100算
+func locationPercent(l *Location) string {
+	if 0 >= l.CurrentMax {
+		return "0.00"
+	}
+	if l.Current >= l.CurrentMax {
+		return "100.00"
+	}
+
+	return fmt.Sprintf("%.2f", float64(l.Current)*100/float64(l.CurrentMax))
+}
+
+// LocationProgress 用户全部占位进度，以及最后一个停止占位的复投窗口，
+// 窗口和补上额度的算法与入单时一致：停止时间+time_again分钟内入单补上 Current-CurrentMax
+func (uuc *UserUseCase) LocationProgress(ctx context.Context, req *v1.LocationProgressRequest, user *User) (*v1.LocationProgressReply, error) {
+	var (
+		locations          []*Location
+		myLastStopLocation *Location
+		configs            []*Config
+		timeAgain          int64
+		running            bool
+		err                error
+	)
+
+	res := &v1.LocationProgressReply{
+		Locations: make([]*v1.LocationProgressReply_List, 0),
+		CarryOver: "0.00",
+	}
+
+	locations, err = uuc.locationRepo.GetLocationsByUserId(ctx, user.ID)
+	if nil != err {
+		return nil, err
+	}
+	uuc.positionLocations(ctx, locations)
+
+	for _, v := range locations {
+		if "running" == v.Status {
+			running = true
+		}
+
+		item := &v1.LocationProgressReply_List{
+			Id:           v.ID,
+			Row:          v.Row,
+			Col:          v.Col,
+			CurrentLevel: v.CurrentLevel,
+			Current:      fmt.Sprintf("%.2f", float64(v.Current)/float64(10000000000)),
+			CurrentMax:   fmt.Sprintf("%.2f", float64(v.CurrentMax)/float64(10000000000)),
+			Percent:      locationPercent(v),
+			Status:       v.Status,
+			CreatedAt:    v.CreatedAt.Add(8 * time.Hour).Format("2006-01-02 15:04:05"),
+		}
+		if "stop" == v.Status {
+			item.StopDate = v.StopDate.Format("2006-01-02 15:04:05") // 停止时间存的就是东八区
+		}
+		res.Locations = append(res.Locations, item)
+	}
+
+	myLastStopLocation, _ = uuc.locationRepo.GetMyStopLocationLast(ctx, user.ID)
+	if nil == myLastStopLocation {
+		return res, nil
+	}
+
+	configs, _ = uuc.configRepo.GetConfigByKeys(ctx, "time_again")
+	for _, vConfig := range configs {
+		if "time_again" == vConfig.KeyName {
+			timeAgain, _ = strconv.ParseInt(vConfig.Value, 10, 64)
+		}
+	}
+
+	end := myLastStopLocation.StopDate.Add(time.Duration(timeAgain) * time.Minute)
+	now := bizNow(ctx).Add(8 * time.Hour)
+	res.LastStopLocationId = myLastStopLocation.ID
+	res.ReenterEnd = end.Format("2006-01-02 15:04:05")
+
+	// 有运行中占位时不能再入单，窗口不算
+	if !running && now.Before(end) {
+		res.CanReenter = true
+		res.ReenterRemaining = int64(end.Sub(now).Seconds())
+		if carryOver := myLastStopLocation.Current - myLastStopLocation.CurrentMax; 0 < carryOver {
+			res.CarryOver = fmt.Sprintf("%.2f", float64(carryOver)/float64(10000000000))
+		}
+	}
+
+	return res, nil
+}
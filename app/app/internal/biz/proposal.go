@@ -0,0 +1,277 @@
+package biz
+
+import (
+	"context"
+	v1 "dhb/app/app/api"
+	"github.com/go-kratos/kratos/v2/errors"
+	"strconv"
+	"time"
+)
+
+type AdminProposal struct {
+	ID         int64
+	Operation  string
+	Payload    string
+	ProposerId int64
+	CheckerId  int64
+	Status     string
+	Result     string
+	CreatedAt  time.Time
+	UpdatedAt  time.Time
+}
+
+type AdminProposalRepo interface {
+	CreateAdminProposal(ctx context.Context, p *AdminProposal) (*AdminProposal, error)
+	GetAdminProposalById(ctx context.Context, id int64) (*AdminProposal, error)
+	GetAdminProposals(ctx context.Context, b *Pagination, status string) ([]*AdminProposal, error, int64)
+	UpdateAdminProposalStatus(ctx context.Context, id int64, fromStatus string, toStatus string, checkerId int64, result string) (bool, error)
+}
+
+// 审批权限路径，超管之外拥有该权限的管理员可审批
+const adminProposalApprovePath = "/api/admin_dhb/proposal_approve"
+
+// ProposeAdminBalanceUpdate 非超管改余额超过阈值时生成待审批提案，返回0表示可直接执行
+func (uuc *UserUseCase) ProposeAdminBalanceUpdate(ctx context.Context, req *v1.AdminBalanceUpdateRequest) (int64, error) {
+	change, err := checkAdminBalanceAdjust(req.SendBody)
+	if nil != err {
+		return 0, err
+	}
+	if 0 > change {
+		change = -change
+	}
+
+	return uuc.proposeAdminAction(ctx, "AdminBalanceUpdate", req.SendBody, change, "proposal_balance_threshold")
+}
+
+// ProposeAdminLocationInsert 非超管后台入单超过阈值时生成待审批提案，返回0表示可直接执行
+func (uuc *UserUseCase) ProposeAdminLocationInsert(ctx context.Context, req *v1.AdminLocationInsertRequest) (int64, error) {
+	return uuc.proposeAdminAction(ctx, "AdminLocationInsert", req.SendBody, req.SendBody.Amount*10000000000, "proposal_location_threshold")
+}
+
+func (uuc *UserUseCase) proposeAdminAction(ctx context.Context, operation string, payload interface{}, change int64, thresholdKey string) (int64, error) {
+	var (
+		adminId   int64
+		myAdmin   *Admin
+		configs   []*Config
+		threshold int64
+		proposal  *AdminProposal
+		err       error
+	)
+
+	adminId, err = getAdminIdFromContext(ctx)
+	if nil != err {
+		return 0, err
+	}
+	myAdmin, err = uuc.repo.GetAdminById(ctx, adminId)
+	if nil == myAdmin {
+		return 0, errors.New(500, "ERROR_TOKEN", "管理员不存在")
+	}
+	if "super" == myAdmin.Type {
+		return 0, nil
+	}
+
+	// 配置，单位usdt，未配置时全部需要审批
+	configs, _ = uuc.configRepo.GetConfigByKeys(ctx, thresholdKey)
+	if nil != configs {
+		for _, vConfig := range configs {
+			if thresholdKey == vConfig.KeyName {
+				threshold, _ = strconv.ParseInt(vConfig.Value, 10, 64)
+			}
+		}
+	}
+	if 0 < threshold && change <= threshold*10000000000 {
+		return 0, nil
+	}
+
+	proposal, err = uuc.proposalRepo.CreateAdminProposal(ctx, &AdminProposal{
+		Operation:  operation,
+		Payload:    auditJson(payload),
+		ProposerId: adminId,
+		Status:     "pending",
+	})
+	if nil != err {
+		return 0, err
+	}
+
+	return proposal.ID, nil
+}
+
+// checkAdminProposalChecker 超管或拥有审批权限，且不能审批自己的提案
+func (uuc *UserUseCase) checkAdminProposalChecker(ctx context.Context, proposal *AdminProposal) (int64, error) {
+	var (
+		adminId   int64
+		myAdmin   *Admin
+		adminAuth []*AdminAuth
+		authIds   []int64
+		auths     map[int64]*Auth
+		err       error
+	)
+
+	adminId, err = getAdminIdFromContext(ctx)
+	if nil != err {
+		return 0, err
+	}
+	myAdmin, err = uuc.repo.GetAdminById(ctx, adminId)
+	if nil == myAdmin {
+		return 0, errors.New(500, "ERROR_TOKEN", "管理员不存在")
+	}
+	if adminId == proposal.ProposerId {
+		return 0, errors.New(500, "ERROR", "不能审批自己的提案")
+	}
+	if "super" == myAdmin.Type {
+		return adminId, nil
+	}
+
+	adminAuth, _ = uuc.repo.GetAdminAuth(ctx, adminId)
+	for _, v := range adminAuth {
+		authIds = append(authIds, v.AuthId)
+	}
+	if 0 < len(authIds) {
+		auths, _ = uuc.repo.GetAuthByIds(ctx, authIds...)
+		for _, v := range auths {
+			if adminProposalApprovePath == v.Path {
+				return adminId, nil
+			}
+		}
+	}
+
+	return 0, errors.New(500, "ERROR_TOKEN", "无审批权限")
+}
+
+// ApproveAdminProposal 审批通过并执行原操作，状态修改和原操作在同一事务，
+// 原操作失败时回滚后记为 failed，返回的提案带执行结果
+func (uuc *UserUseCase) ApproveAdminProposal(ctx context.Context, id int64, exec func(ctx context.Context, proposal *AdminProposal) error) (*AdminProposal, error) {
+	var (
+		proposal  *AdminProposal
+		checkerId int64
+		ok        bool
+		execErr   error
+		err       error
+	)
+
+	proposal, err = uuc.proposalRepo.GetAdminProposalById(ctx, id)
+	if nil == proposal {
+		return nil, err
+	}
+	if "pending" != proposal.Status {
+		return nil, errors.New(500, "ERROR", "提案已处理")
+	}
+
+	checkerId, err = uuc.checkAdminProposalChecker(ctx, proposal)
+	if nil != err {
+		return nil, err
+	}
+
+	// 占位锁持有到事务提交，原操作内再取同名锁直接复用
+	ctx, release, err := acquireLease(ctx, uuc.lockRepo, locationLease, "ApproveAdminProposal")
+	if nil != err {
+		return nil, err
+	}
+	defer release()
+
+	if err = uuc.tx.ExecTx(ctx, func(ctx context.Context) error { // 事务
+		ok, err = uuc.proposalRepo.UpdateAdminProposalStatus(ctx, id, "pending", "approved", checkerId, "success")
+		if nil != err {
+			return err
+		}
+		if !ok { // 条件更新锁住提案，并发审批只有一个能改成功
+			return errors.New(500, "ERROR", "提案已处理")
+		}
+
+		execErr = exec(ctx, proposal)
+		return execErr
+	}); nil != err {
+		if nil == execErr {
+			return nil, err
+		}
+
+		ok, err = uuc.proposalRepo.UpdateAdminProposalStatus(ctx, id, "pending", "failed", checkerId, execErr.Error())
+		if nil != err {
+			return nil, err
+		}
+		if !ok {
+			return nil, errors.New(500, "ERROR", "提案已处理")
+		}
+
+		proposal.Status = "failed"
+		proposal.CheckerId = checkerId
+		proposal.Result = execErr.Error()
+		return proposal, nil
+	}
+
+	proposal.Status = "approved"
+	proposal.CheckerId = checkerId
+	proposal.Result = "success"
+	return proposal, nil
+}
+
+func (uuc *UserUseCase) AdminProposalReject(ctx context.Context, req *v1.AdminProposalRejectRequest) (*v1.AdminProposalRejectReply, error) {
+	var (
+		proposal  *AdminProposal
+		checkerId int64
+		ok        bool
+		err       error
+	)
+
+	res := &v1.AdminProposalRejectReply{}
+
+	proposal, err = uuc.proposalRepo.GetAdminProposalById(ctx, req.SendBody.Id)
+	if nil == proposal {
+		return res, err
+	}
+	if "pending" != proposal.Status {
+		return res, errors.New(500, "ERROR", "提案已处理")
+	}
+
+	checkerId, err = uuc.checkAdminProposalChecker(ctx, proposal)
+	if nil != err {
+		return res, err
+	}
+
+	ok, err = uuc.proposalRepo.UpdateAdminProposalStatus(ctx, proposal.ID, "pending", "rejected", checkerId, req.SendBody.Reason)
+	if nil != err {
+		return res, err
+	}
+	if !ok {
+		return res, errors.New(500, "ERROR", "提案已处理")
+	}
+
+	return res, nil
+}
+
+func (uuc *UserUseCase) AdminProposalList(ctx context.Context, req *v1.AdminProposalListRequest) (*v1.AdminProposalListReply, error) {
+	var (
+		proposals []*AdminProposal
+		count     int64
+		err       error
+	)
+
+	res := &v1.AdminProposalListReply{
+		Proposals: make([]*v1.AdminProposalListReply_List, 0),
+	}
+
+	proposals, err, count = uuc.proposalRepo.GetAdminProposals(ctx, &Pagination{
+		PageNum:  int(req.Page),
+		PageSize: 10,
+	}, req.Status)
+	if nil != err {
+		return res, err
+	}
+	res.Count = count
+
+	for _, v := range proposals {
+		res.Proposals = append(res.Proposals, &v1.AdminProposalListReply_List{
+			Id:         v.ID,
+			Operation:  v.Operation,
+			Payload:    v.Payload,
+			ProposerId: v.ProposerId,
+			CheckerId:  v.CheckerId,
+			Status:     v.Status,
+			Result:     v.Result,
+			CreatedAt:  v.CreatedAt.Add(8 * time.Hour).Format("2006-01-02 15:04:05"),
+			UpdatedAt:  v.UpdatedAt.Add(8 * time.Hour).Format("2006-01-02 15:04:05"),
+		})
+	}
+
+	return res, nil
+}
@@ -0,0 +1,334 @@
+package biz
+
+import (
+	"context"
+	v1 "dhb/app/app/api"
+	"encoding/json"
+	"fmt"
+	"github.com/go-kratos/kratos/v2/errors"
+	"strconv"
+	"time"
+)
+
+type ProvenanceRepo interface {
+	GetRewardById(ctx context.Context, id int64) (*Reward, error)
+	GetLocationsByIds(ctx context.Context, ids ...int64) (map[int64]*Location, error)
+	GetDepositByLocation(ctx context.Context, userId int64, start time.Time, end time.Time) (*EthUserRecord, error)
+	GetAdminInsertByLocation(ctx context.Context, start time.Time, end time.Time) ([]*AdminAuditLog, error)
+	GetConfigUpdatesAfter(ctx context.Context, t time.Time) ([]*AdminAuditLog, error)
+}
+
+// provenanceWindow 入单记录和后台日志在占位之后同一事务内写入，按占位时间往后找
+const provenanceWindow = time.Minute
+
+// RewardProvenance 分红来源，Configs 为分红时生效的配置值
+type RewardProvenance struct {
+	Reward           *Reward
+	Rule             string // col/row 占位分红，recommend 直推，vip/vip_top 会员级差，recommend_top 每日代数，fee/fee_daily 手续费，area 区域，withdraw 提现池，last 复投余额，system 系统
+	Level            int64  // vip_top 为会员等级，recommend_top 为代数
+	Source           string // deposit 链上充值，reinvest 余额复投，admin_insert 后台入单
+	Location         *Location
+	PayerAddress     string
+	Deposit          *EthUserRecord
+	AdminId          int64
+	RewardLocation   *Location
+	Configs          []*Config
+	RecipientAddress string
+}
+
+// recommendTopConfigKey 每日推荐代数分红配置，和 AdminDailyRecommendTopReward 一致
+func recommendTopConfigKey(i int64) string {
+	switch {
+	case i >= 2 && i <= 5:
+		return "recommend_need_1_4"
+	case i == 6:
+		return "recommend_need_5"
+	case i == 7:
+		return "recommend_need_6"
+	case i >= 8 && i <= 11:
+		return "recommend_need_7_10"
+	case i == 12:
+		return "recommend_need_11"
+	case i == 13:
+		return "recommend_need_12"
+	case i >= 14 && i <= 17:
+		return "recommend_need_13_16"
+	case i == 18:
+		return "recommend_need_17"
+	case i == 19:
+		return "recommend_need_18"
+	}
+
+	return ""
+}
+
+// rewardRule 分红记录对应的规则、等级和相关配置项
+func rewardRule(reward *Reward) (string, int64, []string) {
+	switch reward.Reason {
+	case "location":
+		if "withdraw" == reward.Type {
+			return "withdraw", 0, nil
+		}
+		if "row" == reward.LocationType {
+			return "row", 0, []string{"location_reward_mode", "location_row_reward", "location_width", "location_row"}
+		}
+		return "col", 0, []string{"location_reward_mode", "location_col_reward", "location_width", "location_row"}
+	case "recommend":
+		return "recommend", 0, []string{"recommend_need"}
+	case "recommend_vip":
+		return "vip", 0, []string{"recommend_need_vip1", "recommend_need_vip2", "recommend_need_vip3", "recommend_need_vip4", "recommend_need_vip5"}
+	case "recommend_vip_top":
+		return "vip_top", reward.ReasonLocationId, []string{"recommend_need_vip" + strconv.FormatInt(reward.ReasonLocationId, 10)}
+	case "recommend_top":
+		if key := recommendTopConfigKey(reward.ReasonLocationId); "" != key {
+			return "recommend_top", reward.ReasonLocationId, []string{key}
+		}
+		return "recommend_top", reward.ReasonLocationId, nil
+	case "fee":
+		return "fee", 0, nil
+	case "fee_daily":
+		return "fee_daily", 0, nil
+	case "daily_recommend_area":
+		return "area", 0, []string{"recommend_area_one", "recommend_area_one_rate", "recommend_area_two", "recommend_area_two_rate",
+			"recommend_area_three", "recommend_area_three_rate", "recommend_area_four", "recommend_area_four_rate"}
+	case "last_reward":
+		return "last", 0, nil
+	}
+
+	return "system", 0, nil
+}
+
+// configsAt 配置在某时刻的值：之后第一次修改日志的修改前值，之后没改过的用当前值
+func (uuc *UserUseCase) configsAt(ctx context.Context, t time.Time, keys ...string) ([]*Config, error) {
+	configs, err := uuc.configRepo.GetConfigByKeys(ctx, keys...)
+	if nil != err {
+		return nil, err
+	}
+
+	auditLogs, err := uuc.provenanceRepo.GetConfigUpdatesAfter(ctx, t)
+	if nil != err {
+		return nil, err
+	}
+
+	before := make(map[string]*Config, 0)
+	for _, v := range auditLogs {
+		var c Config
+		if nil != json.Unmarshal([]byte(v.Before), &c) || "" == c.KeyName {
+			continue
+		}
+		if _, ok := before[c.KeyName]; !ok {
+			before[c.KeyName] = &c
+		}
+	}
+
+	for _, v := range configs {
+		if c, ok := before[v.KeyName]; ok {
+			v.Value = c.Value
+		}
+	}
+
+	return configs, nil
+}
+
+// GetRewardProvenance 解析分红记录：触发占位、出资人、充值记录、规则和分红时的配置
+func (uuc *UserUseCase) GetRewardProvenance(ctx context.Context, id int64) (*RewardProvenance, error) {
+	var (
+		reward    *Reward
+		locations map[int64]*Location
+		users     map[int64]*User
+		configs   []*Config
+		keys      []string
+		err       error
+	)
+
+	reward, err = uuc.provenanceRepo.GetRewardById(ctx, id)
+	if nil != err {
+		return nil, err
+	}
+	if nil == reward {
+		return nil, errors.New(500, "REWARD ERROR", "分红记录不存在")
+	}
+
+	res := &RewardProvenance{Reward: reward}
+	res.Rule, res.Level, keys = rewardRule(reward)
+
+	locationIds := make([]int64, 0)
+	if 0 < reward.TypeRecordId {
+		locationIds = append(locationIds, reward.TypeRecordId)
+	}
+	if "location" == reward.Reason && 0 < reward.ReasonLocationId {
+		locationIds = append(locationIds, reward.ReasonLocationId)
+	}
+	if 0 < len(locationIds) {
+		locations, err = uuc.provenanceRepo.GetLocationsByIds(ctx, locationIds...)
+		if nil != err {
+			return nil, err
+		}
+		if _, ok := locations[reward.TypeRecordId]; ok {
+			res.Location = locations[reward.TypeRecordId]
+		}
+		if _, ok := locations[reward.ReasonLocationId]; ok && "location" == reward.Reason {
+			res.RewardLocation = locations[reward.ReasonLocationId]
+		}
+
+		// 运行中占位的行列按当前序号算
+		tmpLocations := make([]*Location, 0, len(locations))
+		for _, v := range locations {
+			tmpLocations = append(tmpLocations, v)
+		}
+		uuc.positionLocations(ctx, tmpLocations)
+	}
+
+	userIds := []int64{reward.UserId}
+	if nil != res.Location {
+		userIds = append(userIds, res.Location.UserId)
+
+		// 触发占位的充值，没有时找后台入单
+		res.Deposit, err = uuc.provenanceRepo.GetDepositByLocation(ctx, res.Location.UserId, res.Location.CreatedAt, res.Location.CreatedAt.Add(provenanceWindow))
+		if nil != err {
+			return nil, err
+		}
+		if nil != res.Deposit {
+			res.Source = "deposit"
+			if "reinvest" == res.Deposit.Type {
+				res.Source = "reinvest"
+			}
+		} else {
+			var auditLogs []*AdminAuditLog
+			auditLogs, err = uuc.provenanceRepo.GetAdminInsertByLocation(ctx, res.Location.CreatedAt, res.Location.CreatedAt.Add(provenanceWindow))
+			if nil != err {
+				return nil, err
+			}
+			for _, v := range auditLogs {
+				var payload struct {
+					UserId int64 `json:"user_id"`
+				}
+				if nil != json.Unmarshal([]byte(v.Payload), &payload) || payload.UserId != res.Location.UserId {
+					continue
+				}
+				res.Source = "admin_insert"
+				res.AdminId = v.AdminId
+				break
+			}
+		}
+	}
+
+	users, err = uuc.repo.GetUserByUserIds(ctx, userIds...)
+	if nil != err {
+		return nil, err
+	}
+	if _, ok := users[reward.UserId]; ok {
+		res.RecipientAddress = users[reward.UserId].Address
+	}
+	if nil != res.Location {
+		if _, ok := users[res.Location.UserId]; ok {
+			res.PayerAddress = users[res.Location.UserId].Address
+		}
+	}
+
+	res.Configs = make([]*Config, 0)
+	if 0 < len(keys) {
+		configs, err = uuc.configsAt(ctx, reward.CreatedAt, keys...)
+		if nil != err {
+			return nil, err
+		}
+		res.Configs = configs
+	}
+
+	return res, nil
+}
+
+// RewardDetail 用户查看自己的分红来源
+func (uuc *UserUseCase) RewardDetail(ctx context.Context, req *v1.RewardDetailRequest, user *User) (*v1.RewardDetailReply, error) {
+	p, err := uuc.GetRewardProvenance(ctx, req.Id)
+	if nil != err {
+		return nil, err
+	}
+	if p.Reward.UserId != user.ID {
+		return nil, errors.New(500, "REWARD ERROR", "分红记录不存在")
+	}
+
+	res := &v1.RewardDetailReply{
+		Id:           p.Reward.ID,
+		CreatedAt:    p.Reward.CreatedAt.Add(8 * time.Hour).Format("2006-01-02 15:04:05"),
+		Amount:       fmt.Sprintf("%.2f", float64(p.Reward.Amount)/float64(10000000000)),
+		Type:         p.Reward.Type,
+		Reason:       p.Reward.Reason,
+		Rule:         p.Rule,
+		Level:        p.Level,
+		Source:       p.Source,
+		PayerAddress: p.PayerAddress,
+		Configs:      make([]*v1.RewardDetailReply_Config, 0),
+	}
+	if nil != p.Location {
+		res.LocationId = p.Location.ID
+		res.LocationRow = p.Location.Row
+		res.LocationCol = p.Location.Col
+	}
+	if nil != p.Deposit {
+		res.DepositHash = p.Deposit.Hash
+		res.DepositAmount = p.Deposit.Amount
+		res.DepositCreatedAt = p.Deposit.CreatedAt.Add(8 * time.Hour).Format("2006-01-02 15:04:05")
+	}
+	if nil != p.RewardLocation {
+		res.RewardLocationId = p.RewardLocation.ID
+	}
+	for _, v := range p.Configs {
+		res.Configs = append(res.Configs, &v1.RewardDetailReply_Config{
+			KeyName: v.KeyName,
+			Name:    v.Name,
+			Value:   v.Value,
+		})
+	}
+
+	return res, nil
+}
+
+// AdminRewardDetail 后台查看分红来源
+func (uuc *UserUseCase) AdminRewardDetail(ctx context.Context, req *v1.AdminRewardDetailRequest) (*v1.AdminRewardDetailReply, error) {
+	p, err := uuc.GetRewardProvenance(ctx, req.Id)
+	if nil != err {
+		return nil, err
+	}
+
+	res := &v1.AdminRewardDetailReply{
+		Id:           p.Reward.ID,
+		Address:      p.RecipientAddress,
+		CreatedAt:    p.Reward.CreatedAt.Add(8 * time.Hour).Format("2006-01-02 15:04:05"),
+		Amount:       fmt.Sprintf("%.2f", float64(p.Reward.Amount)/float64(10000000000)),
+		Type:         p.Reward.Type,
+		Reason:       p.Reward.Reason,
+		TypeRecordId: p.Reward.TypeRecordId,
+		LocationType: p.Reward.LocationType,
+		Rule:         p.Rule,
+		Level:        p.Level,
+		Source:       p.Source,
+		AdminId:      p.AdminId,
+		PayerAddress: p.PayerAddress,
+		Configs:      make([]*v1.AdminRewardDetailReply_Config, 0),
+	}
+	if nil != p.Location {
+		res.LocationId = p.Location.ID
+		res.LocationRow = p.Location.Row
+		res.LocationCol = p.Location.Col
+		res.LocationStatus = p.Location.Status
+	}
+	if nil != p.Deposit {
+		res.DepositHash = p.Deposit.Hash
+		res.DepositAmount = p.Deposit.Amount
+		res.DepositCreatedAt = p.Deposit.CreatedAt.Add(8 * time.Hour).Format("2006-01-02 15:04:05")
+	}
+	if nil != p.RewardLocation {
+		res.RewardLocationId = p.RewardLocation.ID
+		res.RewardLocationStatus = p.RewardLocation.Status
+	}
+	for _, v := range p.Configs {
+		res.Configs = append(res.Configs, &v1.AdminRewardDetailReply_Config{
+			KeyName: v.KeyName,
+			Name:    v.Name,
+			Value:   v.Value,
+		})
+	}
+
+	return res, nil
+}
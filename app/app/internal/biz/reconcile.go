@@ -0,0 +1,192 @@
+package biz
+
+import (
+	"bytes"
+	"context"
+	v1 "dhb/app/app/api"
+	"encoding/csv"
+	"fmt"
+	"strconv"
+	"time"
+)
+
+// 对账检查项
+const (
+	reconcileCheckBalance = "balance_record"   // 用户usdt余额与余额记录回放
+	reconcileCheckReward  = "reward_location"  // 用户分红总额与占位已分额度
+	reconcileCheckDeposit = "deposit_location" // 用户按档位应得的占位额度与占位额度
+)
+
+// ReconcileItem 按用户汇总的对账数据，Expected 为推算值，Actual 为当前值
+type ReconcileItem struct {
+	UserId   int64
+	Expected int64
+	Actual   int64
+}
+
+type Discrepancy struct {
+	ID        int64
+	Batch     string
+	Check     string
+	UserId    int64
+	Expected  int64
+	Actual    int64
+	Diff      int64
+	Severity  string
+	CreatedAt time.Time
+}
+
+type ReconcileRepo interface {
+	GetBalanceRecordReplay(ctx context.Context) ([]*ReconcileItem, error)
+	GetRewardLocationCurrent(ctx context.Context) ([]*ReconcileItem, error)
+	GetDepositLocationMax(ctx context.Context, tiers []*DepositTier) ([]*ReconcileItem, error)
+	CreateDiscrepancies(ctx context.Context, discrepancies []*Discrepancy) error
+	GetDiscrepancyLastBatch(ctx context.Context) (string, error)
+	GetDiscrepancies(ctx context.Context, b *Pagination, batch string, check string, severity string) ([]*Discrepancy, error, int64)
+	MigrateRewardStatus(ctx context.Context) (int64, error)
+}
+
+// discrepancySeverity 按差额分级，1 usdt 以下 low，100 usdt 以下 medium
+func discrepancySeverity(diff int64) string {
+	if 0 > diff {
+		diff = -diff
+	}
+
+	if 10000000000 > diff {
+		return "low"
+	} else if 1000000000000 > diff {
+		return "medium"
+	}
+
+	return "high"
+}
+
+// AdminReconcile 只读对账，各项检查在同一个一致性快照里读，不占锁，结果按批次写入差异表
+func (uuc *UserUseCase) AdminReconcile(ctx context.Context, req *v1.AdminReconcileRequest) (*v1.AdminReconcileReply, error) {
+	var (
+		items         []*ReconcileItem
+		discrepancies []*Discrepancy
+		err           error
+	)
+
+	batch := time.Now().UTC().Add(8 * time.Hour).Format("20060102150405")
+	checks := []struct {
+		name  string
+		items func(ctx context.Context) ([]*ReconcileItem, error)
+	}{
+		{reconcileCheckBalance, uuc.reconcileRepo.GetBalanceRecordReplay},
+		{reconcileCheckReward, uuc.reconcileRepo.GetRewardLocationCurrent},
+		{reconcileCheckDeposit, func(ctx context.Context) ([]*ReconcileItem, error) {
+			return uuc.reconcileRepo.GetDepositLocationMax(ctx, depositTiers)
+		}},
+	}
+
+	if err = uuc.tx.ReadTx(ctx, func(ctx context.Context) error {
+		for _, check := range checks {
+			items, err = check.items(ctx)
+			if nil != err {
+				return err
+			}
+
+			for _, v := range items {
+				if v.Expected == v.Actual {
+					continue
+				}
+
+				discrepancies = append(discrepancies, &Discrepancy{
+					Batch:    batch,
+					Check:    check.name,
+					UserId:   v.UserId,
+					Expected: v.Expected,
+					Actual:   v.Actual,
+					Diff:     v.Actual - v.Expected,
+					Severity: discrepancySeverity(v.Actual - v.Expected),
+				})
+			}
+		}
+
+		return nil
+	}); nil != err {
+		return nil, err
+	}
+
+	if 0 < len(discrepancies) {
+		err = uuc.reconcileRepo.CreateDiscrepancies(ctx, discrepancies)
+		if nil != err {
+			return nil, err
+		}
+	}
+
+	return &v1.AdminReconcileReply{
+		Batch: batch,
+		Count: int64(len(discrepancies)),
+	}, nil
+}
+
+// AdminDiscrepancyList 不传批次时取最近一次，export 时不分页并返回csv
+func (uuc *UserUseCase) AdminDiscrepancyList(ctx context.Context, req *v1.AdminDiscrepancyListRequest) (*v1.AdminDiscrepancyListReply, error) {
+	var (
+		discrepancies []*Discrepancy
+		batch         string
+		b             *Pagination
+		count         int64
+		err           error
+	)
+
+	res := &v1.AdminDiscrepancyListReply{
+		Discrepancies: make([]*v1.AdminDiscrepancyListReply_List, 0),
+	}
+
+	batch = req.Batch
+	if "" == batch {
+		batch, err = uuc.reconcileRepo.GetDiscrepancyLastBatch(ctx)
+		if nil != err || "" == batch {
+			return res, err
+		}
+	}
+	res.Batch = batch
+
+	if !req.Export {
+		b = &Pagination{
+			PageNum:  int(req.Page),
+			PageSize: 10,
+		}
+	}
+
+	discrepancies, err, count = uuc.reconcileRepo.GetDiscrepancies(ctx, b, batch, req.Check, req.Severity)
+	if nil != err {
+		return res, err
+	}
+	res.Count = count
+
+	for _, v := range discrepancies {
+		res.Discrepancies = append(res.Discrepancies, &v1.AdminDiscrepancyListReply_List{
+			Id:        v.ID,
+			Check:     v.Check,
+			UserId:    v.UserId,
+			Expected:  fmt.Sprintf("%.2f", float64(v.Expected)/float64(10000000000)),
+			Actual:    fmt.Sprintf("%.2f", float64(v.Actual)/float64(10000000000)),
+			Diff:      fmt.Sprintf("%.2f", float64(v.Diff)/float64(10000000000)),
+			Severity:  v.Severity,
+			CreatedAt: v.CreatedAt.Add(8 * time.Hour).Format("2006-01-02 15:04:05"),
+		})
+	}
+
+	if req.Export {
+		var buf bytes.Buffer
+		w := csv.NewWriter(&buf)
+		_ = w.Write([]string{"id", "check", "user_id", "expected", "actual", "diff", "severity", "created_at"})
+		for _, v := range res.Discrepancies {
+			_ = w.Write([]string{strconv.FormatInt(v.Id, 10), v.Check, strconv.FormatInt(v.UserId, 10), v.Expected, v.Actual, v.Diff, v.Severity, v.CreatedAt})
+		}
+		w.Flush()
+		res.Csv = buf.String()
+	}
+
+	return res, nil
+}
+
+// MigrateRewardStatus 分红记录加入账状态并补齐老数据，上线余额回放对账前执行一次
+func (uuc *UserUseCase) MigrateRewardStatus(ctx context.Context) (int64, error) {
+	return uuc.reconcileRepo.MigrateRewardStatus(ctx)
+}
@@ -11,13 +11,14 @@ import (
 )
 
 type EthUserRecord struct {
-	ID       int64
-	UserId   int64
-	Hash     string
-	Status   string
-	Type     string
-	Amount   string
-	CoinType string
+	ID        int64
+	UserId    int64
+	Hash      string
+	Status    string
+	Type      string
+	Amount    string
+	CoinType  string
+	CreatedAt time.Time
 }
 
 type Location struct {
@@ -34,9 +35,88 @@ type Location struct {
 	CreatedAt    time.Time
 }
 
-type GlobalLock struct {
-	ID     int64
-	Status int64
+// defaultLocationWidth 占位矩阵默认每行3个，配置项 location_width
+const defaultLocationWidth = 3
+
+// DepositTier 入单档位，Amount 单位U，其余为10位精度
+type DepositTier struct {
+	Amount     int64
+	Level      int64 // 占位等级
+	CurrentMax int64 // 占位封顶额度
+	Value      int64 // 参与分配的金额
+	DhbAmount  int64 // 赠送的dhb
+}
+
+// depositTiers 入单档位，链上充值、余额复投和对账共用
+var depositTiers = []*DepositTier{
+	{Amount: 100, Level: 1, CurrentMax: 5000000000000, Value: 1000000000000, DhbAmount: 1000000000000},
+	{Amount: 500, Level: 3, CurrentMax: 25000000000000, Value: 5000000000000, DhbAmount: 5000000000000},
+	{Amount: 1000, Level: 4, CurrentMax: 50000000000000, Value: 10000000000000, DhbAmount: 10000000000000},
+	{Amount: 3000, Level: 5, CurrentMax: 150000000000000, Value: 30000000000000, DhbAmount: 30000000000000},
+	{Amount: 5000, Level: 6, CurrentMax: 250000000000000, Value: 50000000000000, DhbAmount: 50000000000000},
+}
+
+// ChainAmount 链上18位精度的金额，和 eth_user_record.amount 一致
+func (t *DepositTier) ChainAmount() string {
+	return strconv.FormatInt(t.Amount, 10) + "000000000000000000"
+}
+
+// depositTierByChainAmount 按入单记录的金额找档位，不支持的金额返回 nil
+func depositTierByChainAmount(amount string) *DepositTier {
+	for _, v := range depositTiers {
+		if amount == v.ChainAmount() {
+			return v
+		}
+	}
+
+	return nil
+}
+
+// IsDepositAmount 链上金额是否为支持的入单档位
+func IsDepositAmount(amount string) bool {
+	return nil != depositTierByChainAmount(amount)
+}
+
+// LocationPosition 运行中占位按 id 排序的序号（从0开始）换算成行列，width 为每行个数
+func LocationPosition(rank int64, width int64) (int64, int64) {
+	return rank/width + 1, rank%width + 1
+}
+
+// getLocationWidth 占位矩阵每行个数
+func getLocationWidth(ctx context.Context, configRepo ConfigRepo) int64 {
+	width := int64(defaultLocationWidth)
+	configs, _ := configRepo.GetConfigByKeys(ctx, "location_width")
+	for _, vConfig := range configs {
+		if "location_width" == vConfig.KeyName {
+			if tmp, err := strconv.ParseInt(vConfig.Value, 10, 64); nil == err && 0 < tmp {
+				width = tmp
+			}
+		}
+	}
+
+	return width
+}
+
+// positionLocations 运行中占位的行列按当前序号和矩阵宽度计算，停止的占位不在矩阵里，行列为0
+func (uuc *UserUseCase) positionLocations(ctx context.Context, locations []*Location) {
+	width := getLocationWidth(ctx, uuc.configRepo)
+	ids := make([]int64, 0)
+	for _, v := range locations {
+		if "running" == v.Status {
+			ids = append(ids, v.ID)
+		}
+	}
+
+	ranks, err := uuc.locationRepo.GetLocationRunningRanks(ctx, ids...)
+	if nil != err {
+		return
+	}
+	for _, v := range locations {
+		v.Row, v.Col = 0, 0
+		if rank, ok := ranks[v.ID]; ok && "running" == v.Status {
+			v.Row, v.Col = LocationPosition(rank, width)
+		}
+	}
 }
 
 type RecordUseCase struct {
@@ -46,7 +126,10 @@ type RecordUseCase struct {
 	locationRepo                  LocationRepo
 	userBalanceRepo               UserBalanceRepo
 	userInfoRepo                  UserInfoRepo
+	userRepo                      UserRepo
 	userCurrentMonthRecommendRepo UserCurrentMonthRecommendRepo
+	auditRepo                     AdminAuditLogRepo
+	lockRepo                      LockRepo
 	tx                            Transaction
 	log                           *log.Helper
 }
@@ -54,11 +137,13 @@ type RecordUseCase struct {
 type EthUserRecordRepo interface {
 	GetEthUserRecordListByHash(ctx context.Context, hash ...string) (map[string]*EthUserRecord, error)
 	CreateEthUserRecordListByHash(ctx context.Context, r *EthUserRecord) (*EthUserRecord, error)
+	GetEthUserRecordsByTime(ctx context.Context, recordType string, start time.Time, end time.Time) ([]*EthUserRecord, error)
 }
 
 type LocationRepo interface {
 	CreateLocation(ctx context.Context, rel *Location) (*Location, error)
-	GetLocationLast(ctx context.Context) (*Location, error)
+	GetLocationRunningCount(ctx context.Context) (int64, error)
+	GetLocationRunningRanks(ctx context.Context, ids ...int64) (map[int64]int64, error)
 	GetMyLocationLast(ctx context.Context, userId int64) (*Location, error)
 	GetLocationDailyYesterday(ctx context.Context, day int) ([]*Location, error)
 	GetMyStopLocationLast(ctx context.Context, userId int64) (*Location, error)
@@ -69,7 +154,7 @@ type LocationRepo interface {
 	GetLocationsRunning(ctx context.Context) ([]*Location, error)
 	GetLocationsRunning2(ctx context.Context) (map[int64][]*Location, error)
 	GetLocationsByUserId(ctx context.Context, userId int64) ([]*Location, error)
-	GetRewardLocationByRowOrCol(ctx context.Context, row int64, col int64, locationRowConfig int64) ([]*Location, error)
+	GetRewardLocationByRowOrCol(ctx context.Context, row int64, col int64, locationRowConfig int64, width int64) ([]*Location, error)
 	GetRewardLocationByIds(ctx context.Context, ids ...int64) (map[int64]*Location, error)
 	UpdateLocation(ctx context.Context, id int64, status string, current int64, stopDate time.Time) error
 	UpdateSubCurrentLocation(ctx context.Context, id int64, amount int64) error
@@ -80,15 +165,12 @@ type LocationRepo interface {
 	GetLocationsAll(ctx context.Context, b *Pagination, userId int64) ([]*Location, error, int64)
 	UpdateLocationRowAndCol(ctx context.Context, id int64) error
 	GetLocationsStopNotUpdate(ctx context.Context) ([]*Location, error)
-	LockGlobalLocation(ctx context.Context) (bool, error)
-	UnLockGlobalLocation(ctx context.Context) (bool, error)
-	LockGlobalWithdraw(ctx context.Context) (bool, error)
-	UnLockGlobalWithdraw(ctx context.Context) (bool, error)
-	GetLockGlobalLocation(ctx context.Context) (*GlobalLock, error)
 	GetLocationUserCount(ctx context.Context) int64
 	GetLocationByIds(ctx context.Context, userIds ...int64) ([]*Location, error)
 	GetAllLocations(ctx context.Context) ([]*Location, error)
 	GetAllLocationsAfter(ctx context.Context) ([]*Location, error)
+	MigrateLocationRanks(ctx context.Context) error
+	RebuildLocationRanks(ctx context.Context) (int64, error)
 	GetLocationsByUserIds(ctx context.Context, userIds []int64) ([]*Location, error)
 }
 
@@ -98,8 +180,11 @@ func NewRecordUseCase(
 	userBalanceRepo UserBalanceRepo,
 	userRecommendRepo UserRecommendRepo,
 	userInfoRepo UserInfoRepo,
+	userRepo UserRepo,
 	configRepo ConfigRepo,
 	userCurrentMonthRecommendRepo UserCurrentMonthRecommendRepo,
+	auditRepo AdminAuditLogRepo,
+	lockRepo LockRepo,
 	tx Transaction,
 	logger log.Logger) *RecordUseCase {
 	return &RecordUseCase{
@@ -110,6 +195,9 @@ func NewRecordUseCase(
 		userBalanceRepo:               userBalanceRepo,
 		userCurrentMonthRecommendRepo: userCurrentMonthRecommendRepo,
 		userInfoRepo:                  userInfoRepo,
+		userRepo:                      userRepo,
+		auditRepo:                     auditRepo,
+		lockRepo:                      lockRepo,
 		tx:                            tx,
 		log:                           log.NewHelper(logger),
 	}
@@ -119,35 +207,36 @@ func (ruc *RecordUseCase) GetEthUserRecordByTxHash(ctx context.Context, txHash .
 	return ruc.ethUserRecordRepo.GetEthUserRecordListByHash(ctx, txHash...)
 }
 
-func (ruc *RecordUseCase) EthUserRecordHandle(ctx context.Context, ethUserRecord ...*EthUserRecord) (bool, error) {
+// depositConfig 入单分配用到的配置
+type depositConfig struct {
+	recommendNeed     int64
+	recommendNeedVip1 int64
+	recommendNeedVip2 int64
+	recommendNeedVip3 int64
+	recommendNeedVip4 int64
+	recommendNeedVip5 int64
+	timeAgain         int64
+	locationRowConfig int64
+	colReward         int64
+	rowReward         int64
+	rewardMode        string
+	width             int64
+	rewardStrategies  string
+	vipPolicy         *VipPolicy
+}
 
-	var (
-		configs       []*Config
-		recommendNeed int64
-		//recommendNeed1to4   int64
-		//recommendNeed5      int64
-		//recommendNeed6      int64
-		//recommendNeed7to10  int64
-		//recommendNeed11     int64
-		//recommendNeed12     int64
-		//recommendNeed13to16 int64
-		//recommendNeed17     int64
-		//recommendNeed18     int64
-		recommendNeedVip1 int64
-		recommendNeedVip2 int64
-		recommendNeedVip3 int64
-		recommendNeedVip4 int64
-		recommendNeedVip5 int64
-		timeAgain         int64
-		locationRowConfig int64
-	)
-	// 配置
+// getDepositConfig .
+func (ruc *RecordUseCase) getDepositConfig(ctx context.Context) *depositConfig {
+	var configs []*Config
+
+	c := &depositConfig{colReward: 1, rowReward: 1, rewardMode: "col", width: defaultLocationWidth}
 	configs, _ = ruc.configRepo.GetConfigByKeys(ctx, "recommend_need",
 		"recommend_need_1_4", "recommend_need_5", "recommend_need_6",
 		"recommend_need_7_10", "recommend_need_11", "recommend_need_12",
 		"recommend_need_13_17", "recommend_need_17", "recommend_need_18",
 		"recommend_need_vip1", "recommend_need_vip2",
-		"recommend_need_vip3", "recommend_need_vip4", "recommend_need_vip5", "time_again", "location_row")
+		"recommend_need_vip3", "recommend_need_vip4", "recommend_need_vip5", "time_again", "location_row",
+		"location_col_reward", "location_row_reward", "location_reward_mode", "location_width", "reward_strategies")
 	if nil != configs {
 		for _, vConfig := range configs {
 			//else if "recommend_need_1_4" == vConfig.KeyName {
@@ -169,642 +258,102 @@ func (ruc *RecordUseCase) EthUserRecordHandle(ctx context.Context, ethUserRecord
 			//} else if "recommend_need_18" == vConfig.KeyName {
 			//	recommendNeed18, _ = strconv.ParseInt(vConfig.Value, 10, 64)
 			//}
-			if "recommend_need" == vConfig.KeyName {
-				recommendNeed, _ = strconv.ParseInt(vConfig.Value, 10, 64)
-			} else if "recommend_need_vip1" == vConfig.KeyName {
-				recommendNeedVip1, _ = strconv.ParseInt(vConfig.Value, 10, 64)
-			} else if "recommend_need_vip2" == vConfig.KeyName {
-				recommendNeedVip2, _ = strconv.ParseInt(vConfig.Value, 10, 64)
-			} else if "recommend_need_vip3" == vConfig.KeyName {
-				recommendNeedVip3, _ = strconv.ParseInt(vConfig.Value, 10, 64)
-			} else if "recommend_need_vip4" == vConfig.KeyName {
-				recommendNeedVip4, _ = strconv.ParseInt(vConfig.Value, 10, 64)
-			} else if "recommend_need_vip5" == vConfig.KeyName {
-				recommendNeedVip5, _ = strconv.ParseInt(vConfig.Value, 10, 64)
-			} else if "time_again" == vConfig.KeyName {
-				timeAgain, _ = strconv.ParseInt(vConfig.Value, 10, 64)
-			} else if "location_row" == vConfig.KeyName {
-				locationRowConfig, _ = strconv.ParseInt(vConfig.Value, 10, 64)
-			}
+			c.set(vConfig.KeyName, vConfig.Value)
 		}
 	}
-	for _, v := range ethUserRecord {
-		fmt.Println(v)
-		var (
-			lastLocation                    *Location
-			myLocations                     []*Location
-			currentValue                    int64
-			amount                          int64
-			locationCurrentLevel            int64
-			locationCurrent                 int64
-			locationCurrentMax              int64
-			locationRow                     int64
-			locationCol                     int64
-			currentLocation                 *Location
-			rewardLocations                 []*Location
-			userRecommend                   *UserRecommend
-			myUserRecommendUserId           int64
-			myUserRecommendUserInfo         *UserInfo
-			myUserRecommendUserLocationLast *Location
-			stopLocations                   []*Location
-			myLastStopLocation              *Location
-			tmpRecommendUserIds             []string
-			dhbAmount                       int64
-			err                             error
-		)
-
-		//if "DHB" == v.CoinType {
-		//	continue
-		//}
-
-		// 获取当前用户的占位信息，已经有运行中的跳过
-		myLocations, err = ruc.locationRepo.GetLocationsByUserId(ctx, v.UserId)
-		if nil == myLocations { // 查询异常跳过本次循环
-			continue
-		}
-		if 0 < len(myLocations) { // 也代表复投
-			tmpStatusRunning := false
-			for _, vMyLocations := range myLocations {
-				if "running" == vMyLocations.Status {
-					tmpStatusRunning = true
-					break
-				}
-			}
-
-			if tmpStatusRunning { // 有运行中直接跳过本次循环
-				continue
-			}
-		}
-
-		// 先紧缩一次位置
-		stopLocations, err = ruc.locationRepo.GetLocationsStopNotUpdate(ctx)
-		if nil != stopLocations {
-			// 调整位置紧缩
-			for _, vStopLocations := range stopLocations {
-
-				if err = ruc.tx.ExecTx(ctx, func(ctx context.Context) error { // 事务
-					err = ruc.locationRepo.UpdateLocationRowAndCol(ctx, vStopLocations.ID)
-					if nil != err {
-						return err
-					}
-					return nil
-				}); nil != err {
-					continue
-				}
-			}
-		}
-
-		// 获取最后一行数据
-		lastLocation, err = ruc.locationRepo.GetLocationLast(ctx)
-		if nil == lastLocation {
-			locationRow = 1
-			locationCol = 1
-			fmt.Println(25, locationRow, locationRow)
-		} else {
-			if 3 > lastLocation.Col {
-				locationCol = lastLocation.Col + 1
-				locationRow = lastLocation.Row
-				fmt.Println(33, locationCol, locationRow)
-			} else {
-				locationCol = 1
-				locationRow = lastLocation.Row + 1
-				fmt.Println(22, locationRow, locationRow)
-			}
-		}
-
-		if "100000000000000000000" == v.Amount {
-			locationCurrentLevel = 1
-			locationCurrentMax = 5000000000000
-			currentValue = 1000000000000
-			dhbAmount = 1000000000000
-		} else if "500000000000000000000" == v.Amount {
-			locationCurrentLevel = 3
-			locationCurrentMax = 25000000000000
-			currentValue = 5000000000000
-			dhbAmount = 5000000000000
-		} else if "1000000000000000000000" == v.Amount {
-			locationCurrentLevel = 4
-			locationCurrentMax = 50000000000000
-			currentValue = 10000000000000
-			dhbAmount = 10000000000000
-		} else if "3000000000000000000000" == v.Amount {
-			locationCurrentLevel = 5
-			locationCurrentMax = 150000000000000
-			currentValue = 30000000000000
-			dhbAmount = 30000000000000
-		} else if "5000000000000000000000" == v.Amount {
-			locationCurrentLevel = 6
-			locationCurrentMax = 250000000000000
-			currentValue = 50000000000000
-			dhbAmount = 50000000000000
-		} else {
-			continue
-		}
-		amount = currentValue
+	c.vipPolicy = getVipPolicy(ctx, ruc.configRepo)
 
-		// 占位分红人
-		rewardLocations, err = ruc.locationRepo.GetRewardLocationByRowOrCol(ctx, locationRow, locationCol, locationRowConfig)
-
-		// 推荐人
-		userRecommend, err = ruc.userRecommendRepo.GetUserRecommendByUserId(ctx, v.UserId)
-		if nil != err {
-			continue
-		}
-		if "" != userRecommend.RecommendCode {
-			tmpRecommendUserIds = strings.Split(userRecommend.RecommendCode, "D")
-			if 2 <= len(tmpRecommendUserIds) {
-				myUserRecommendUserId, _ = strconv.ParseInt(tmpRecommendUserIds[len(tmpRecommendUserIds)-1], 10, 64) // 最后一位是直推人
-			}
-		}
-		if 0 < myUserRecommendUserId {
-			myUserRecommendUserInfo, err = ruc.userInfoRepo.GetUserInfoByUserId(ctx, myUserRecommendUserId)
-		}
+	return c
+}
 
-		// 冻结
-		myLastStopLocation, err = ruc.locationRepo.GetMyStopLocationLast(ctx, v.UserId)
-		now := time.Now().UTC().Add(8 * time.Hour)
-		if nil != myLastStopLocation && now.Before(myLastStopLocation.StopDate.Add(time.Duration(timeAgain)*time.Minute)) {
-			locationCurrent = myLastStopLocation.Current - myLastStopLocation.CurrentMax // 补上
+// set 按配置项改入单配置，会员等级的配置项交给 vipPolicy，不是入单用到的配置项时返回 false
+func (c *depositConfig) set(keyName string, value string) bool {
+	if "recommend_need" == keyName {
+		c.recommendNeed, _ = strconv.ParseInt(value, 10, 64)
+	} else if "recommend_need_vip1" == keyName {
+		c.recommendNeedVip1, _ = strconv.ParseInt(value, 10, 64)
+	} else if "recommend_need_vip2" == keyName {
+		c.recommendNeedVip2, _ = strconv.ParseInt(value, 10, 64)
+	} else if "recommend_need_vip3" == keyName {
+		c.recommendNeedVip3, _ = strconv.ParseInt(value, 10, 64)
+	} else if "recommend_need_vip4" == keyName {
+		c.recommendNeedVip4, _ = strconv.ParseInt(value, 10, 64)
+	} else if "recommend_need_vip5" == keyName {
+		c.recommendNeedVip5, _ = strconv.ParseInt(value, 10, 64)
+	} else if "time_again" == keyName {
+		c.timeAgain, _ = strconv.ParseInt(value, 10, 64)
+	} else if "location_row" == keyName {
+		c.locationRowConfig, _ = strconv.ParseInt(value, 10, 64)
+	} else if "location_col_reward" == keyName {
+		c.colReward, _ = strconv.ParseInt(value, 10, 64)
+	} else if "location_row_reward" == keyName {
+		c.rowReward, _ = strconv.ParseInt(value, 10, 64)
+	} else if "location_reward_mode" == keyName {
+		c.rewardMode = value
+	} else if "location_width" == keyName {
+		if tmp, err := strconv.ParseInt(value, 10, 64); nil == err && 0 < tmp {
+			c.width = tmp
 		}
+	} else if "reward_strategies" == keyName {
+		c.rewardStrategies = value
+	} else if nil != c.vipPolicy {
+		return c.vipPolicy.set(keyName, value)
+	} else {
+		return false
+	}
 
-		if err = ruc.tx.ExecTx(ctx, func(ctx context.Context) error { // 事务
-			currentLocation, err = ruc.locationRepo.CreateLocation(ctx, &Location{ // 占位
-				UserId:       v.UserId,
-				Status:       "running",
-				CurrentLevel: locationCurrentLevel,
-				Current:      locationCurrent,
-				CurrentMax:   locationCurrentMax,
-				Row:          locationRow,
-				Col:          locationCol,
-			})
-			if nil != err {
-				return err
-			}
-
-			// 占位分红人分红
-			if nil != rewardLocations {
-				for _, vRewardLocations := range rewardLocations {
-					if "running" != vRewardLocations.Status {
-						continue
-					}
-					if locationRow == vRewardLocations.Row && locationCol == vRewardLocations.Col { // 跳过自己
-						continue
-					}
-
-					var locationType string
-					var tmpAmount int64
-					if locationCol == vRewardLocations.Col { // 同列的人
-						tmpAmount = currentValue / 100
-						locationType = "col"
-					} else {
-						continue
-					}
-
-					tmpCurrentStatus := vRewardLocations.Status // 现在还在运行中
-
-					tmpBalanceAmount := tmpAmount
-					vRewardLocations.Status = "running"
-					vRewardLocations.Current += tmpAmount
-					if vRewardLocations.Current >= vRewardLocations.CurrentMax { // 占位分红人分满停止
-						if "running" == tmpCurrentStatus {
-							vRewardLocations.StopDate = time.Now().UTC().Add(8 * time.Hour)
-						}
-						vRewardLocations.Status = "stop"
-					}
-
-					if 0 < tmpBalanceAmount {
-						err = ruc.locationRepo.UpdateLocation(ctx, vRewardLocations.ID, vRewardLocations.Status, tmpBalanceAmount, vRewardLocations.StopDate) // 分红占位数据修改
-						if nil != err {
-							return err
-						}
-						amount -= tmpBalanceAmount // 扣除
-
-						if 0 < tmpBalanceAmount { // 这次还能分红
-							_, err = ruc.userBalanceRepo.LocationReward(ctx, vRewardLocations.UserId, tmpBalanceAmount, currentLocation.ID, vRewardLocations.ID, locationType, tmpCurrentStatus) // 分红信息修改
-							if nil != err {
-								return err
-							}
-						}
-					}
-				}
-			}
-
-			// 推荐人
-			if nil != myUserRecommendUserInfo {
-				if 0 == len(myLocations) { // vip 等级调整，被推荐人首次入单
-					myUserRecommendUserInfo.HistoryRecommend += 1
-					if myUserRecommendUserInfo.HistoryRecommend >= 10 {
-						myUserRecommendUserInfo.Vip = 5
-					} else if myUserRecommendUserInfo.HistoryRecommend >= 8 {
-						myUserRecommendUserInfo.Vip = 4
-					} else if myUserRecommendUserInfo.HistoryRecommend >= 6 {
-						myUserRecommendUserInfo.Vip = 3
-					} else if myUserRecommendUserInfo.HistoryRecommend >= 4 {
-						myUserRecommendUserInfo.Vip = 2
-					} else if myUserRecommendUserInfo.HistoryRecommend >= 2 {
-						myUserRecommendUserInfo.Vip = 1
-					}
-
-					_, err = ruc.userInfoRepo.UpdateUserInfo(ctx, myUserRecommendUserInfo) // 推荐人信息修改
-					if nil != err {
-						return err
-					}
-
-					_, err = ruc.userCurrentMonthRecommendRepo.CreateUserCurrentMonthRecommend(ctx, &UserCurrentMonthRecommend{ // 直推人本月推荐人数
-						UserId:          myUserRecommendUserId,
-						RecommendUserId: v.UserId,
-						Date:            time.Now().UTC().Add(8 * time.Hour),
-					})
-					if nil != err {
-						return err
-					}
-				}
-
-				// 有占位信息，推荐人第一代
-				myUserRecommendUserLocationLast, err = ruc.locationRepo.GetMyLocationLast(ctx, myUserRecommendUserInfo.UserId)
-				if nil != myUserRecommendUserLocationLast {
-					tmpStatus := myUserRecommendUserLocationLast.Status // 现在还在运行中
-
-					tmpBalanceAmount := currentValue / 100 * recommendNeed // 记录下一次
-					myUserRecommendUserLocationLast.Status = "running"
-					myUserRecommendUserLocationLast.Current += tmpBalanceAmount
-					if myUserRecommendUserLocationLast.Current >= myUserRecommendUserLocationLast.CurrentMax { // 占位分红人分满停止
-						myUserRecommendUserLocationLast.Status = "stop"
-						if "running" == tmpStatus {
-							myUserRecommendUserLocationLast.StopDate = time.Now().UTC().Add(8 * time.Hour)
-						}
-					}
-					if 0 < tmpBalanceAmount {
-						err = ruc.locationRepo.UpdateLocation(ctx, myUserRecommendUserLocationLast.ID, myUserRecommendUserLocationLast.Status, tmpBalanceAmount, myUserRecommendUserLocationLast.StopDate) // 分红占位数据修改
-						if nil != err {
-							return err
-						}
-					}
-					amount -= tmpBalanceAmount // 扣除
-
-					if 0 < tmpBalanceAmount { // 这次还能分红
-						_, err = ruc.userBalanceRepo.NormalRecommendReward(ctx, myUserRecommendUserId, tmpBalanceAmount, currentLocation.ID, tmpStatus) // 直推人奖励
-						if nil != err {
-							return err
-						}
-
-					}
-				}
-
-				var recommendNeedLast int64
-				var recommendLevel int64
-				if nil != myUserRecommendUserLocationLast {
-					var tmpMyRecommendAmount int64
-					if 5 == myUserRecommendUserInfo.Vip { // 会员等级分红
-						tmpMyRecommendAmount = currentValue / 100 * recommendNeedVip5
-						recommendNeedLast = recommendNeedVip5
-						recommendLevel = 5
-					} else if 4 == myUserRecommendUserInfo.Vip {
-						tmpMyRecommendAmount = currentValue / 100 * recommendNeedVip4
-						recommendNeedLast = recommendNeedVip4
-						recommendLevel = 4
-					} else if 3 == myUserRecommendUserInfo.Vip {
-						tmpMyRecommendAmount = currentValue / 100 * recommendNeedVip3
-						recommendNeedLast = recommendNeedVip3
-						recommendLevel = 3
-					} else if 2 == myUserRecommendUserInfo.Vip {
-						tmpMyRecommendAmount = currentValue / 100 * recommendNeedVip2
-						recommendNeedLast = recommendNeedVip2
-						recommendLevel = 2
-					} else if 1 == myUserRecommendUserInfo.Vip {
-						tmpMyRecommendAmount = currentValue / 100 * recommendNeedVip1
-						recommendNeedLast = recommendNeedVip1
-						recommendLevel = 1
-					}
-					if 0 < tmpMyRecommendAmount { // 扣除推荐人分红
-						tmpStatus := myUserRecommendUserLocationLast.Status // 现在还在运行中
-
-						tmpBalanceAmount := tmpMyRecommendAmount // 记录下一次
-						myUserRecommendUserLocationLast.Status = "running"
-						myUserRecommendUserLocationLast.Current += tmpBalanceAmount
-						if myUserRecommendUserLocationLast.Current >= myUserRecommendUserLocationLast.CurrentMax { // 占位分红人分满停止
-							myUserRecommendUserLocationLast.Status = "stop"
-							if "running" == tmpStatus {
-								myUserRecommendUserLocationLast.StopDate = time.Now().UTC().Add(8 * time.Hour)
-							}
-						}
-						if 0 < tmpBalanceAmount {
-							err = ruc.locationRepo.UpdateLocation(ctx, myUserRecommendUserLocationLast.ID, myUserRecommendUserLocationLast.Status, tmpBalanceAmount, myUserRecommendUserLocationLast.StopDate) // 分红占位数据修改
-							if nil != err {
-								return err
-							}
-						}
-						amount -= tmpBalanceAmount // 扣除
-						if 0 < tmpBalanceAmount {  // 这次还能分红
-							_, err = ruc.userBalanceRepo.RecommendReward(ctx, myUserRecommendUserId, tmpBalanceAmount, currentLocation.ID, tmpStatus) // 推荐人奖励
-							if nil != err {
-								return err
-							}
-
-						}
-					}
-				}
-
-				// 推荐人的推荐信息，往上找
-
-				if 2 <= len(tmpRecommendUserIds) {
-					//fmt.Println(tmpRecommendUserIds)
-					//lasAmount := currentValue / 100 * recommendNeed
-					//for i := 2; i <= 18; i++ {
-					//	// 有占位信息，推荐人推荐人的上一代
-					//	if len(tmpRecommendUserIds)-i < 1 { // 根据数据第一位是空字符串
-					//		break
-					//	}
-					//	tmpMyTopUserRecommendUserId, _ := strconv.ParseInt(tmpRecommendUserIds[len(tmpRecommendUserIds)-i], 10, 64) // 最后一位是直推人
-					//
-					//	var tmpMyTopUserRecommendUserLocationLastBalanceAmount int64
-					//	if i >= 2 && i <= 4 {
-					//		tmpMyTopUserRecommendUserLocationLastBalanceAmount = lasAmount / 100 * recommendNeed1to4 // 记录下一次
-					//	} else if i == 5 {
-					//		tmpMyTopUserRecommendUserLocationLastBalanceAmount = lasAmount / 100 * recommendNeed5 // 记录下一次
-					//	} else if i == 6 {
-					//		tmpMyTopUserRecommendUserLocationLastBalanceAmount = lasAmount / 100 * recommendNeed6 // 记录下一次
-					//	} else if i >= 7 && i <= 10 {
-					//		tmpMyTopUserRecommendUserLocationLastBalanceAmount = lasAmount / 100 * recommendNeed7to10 // 记录下一次
-					//	} else if i == 11 {
-					//		tmpMyTopUserRecommendUserLocationLastBalanceAmount = lasAmount / 100 * recommendNeed11 // 记录下一次
-					//	} else if i == 12 {
-					//		tmpMyTopUserRecommendUserLocationLastBalanceAmount = lasAmount / 100 * recommendNeed12 // 记录下一次
-					//	} else if i >= 13 && i <= 16 {
-					//		tmpMyTopUserRecommendUserLocationLastBalanceAmount = lasAmount / 100 * recommendNeed13to16 // 记录下一次
-					//	} else if i == 17 {
-					//		tmpMyTopUserRecommendUserLocationLastBalanceAmount = lasAmount / 100 * recommendNeed17 // 记录下一次
-					//	} else if i == 18 {
-					//		tmpMyTopUserRecommendUserLocationLastBalanceAmount = lasAmount / 100 * recommendNeed18 // 记录下一次
-					//	} else {
-					//		break
-					//	}
-					//
-					//	tmpMyTopUserRecommendUserLocationLast, _ := ruc.locationRepo.GetMyLocationLast(ctx, tmpMyTopUserRecommendUserId)
-					//	if nil != tmpMyTopUserRecommendUserLocationLast {
-					//		tmpMyTopUserRecommendUserLocationLastStatus := tmpMyTopUserRecommendUserLocationLast.Status // 现在还在运行中
-					//
-					//		tmpMyTopUserRecommendUserLocationLast.Status = "running"
-					//		tmpMyTopUserRecommendUserLocationLast.Current += tmpMyTopUserRecommendUserLocationLastBalanceAmount
-					//		if tmpMyTopUserRecommendUserLocationLast.Current >= tmpMyTopUserRecommendUserLocationLast.CurrentMax { // 占位分红人分满停止
-					//			tmpMyTopUserRecommendUserLocationLast.Status = "stop"
-					//			if "running" == tmpMyTopUserRecommendUserLocationLastStatus {
-					//				tmpMyTopUserRecommendUserLocationLast.StopDate = time.Now().UTC().Add(8 * time.Hour)
-					//			}
-					//		}
-					//		if 0 < tmpMyTopUserRecommendUserLocationLastBalanceAmount {
-					//			err = ruc.locationRepo.UpdateLocation(ctx, tmpMyTopUserRecommendUserLocationLast.ID, tmpMyTopUserRecommendUserLocationLast.Status, tmpMyTopUserRecommendUserLocationLastBalanceAmount, tmpMyTopUserRecommendUserLocationLast.StopDate) // 分红占位数据修改
-					//			if nil != err {
-					//				return err
-					//			}
-					//		}
-					//		amount -= tmpMyTopUserRecommendUserLocationLastBalanceAmount // 扣除
-					//
-					//		if 0 < tmpMyTopUserRecommendUserLocationLastBalanceAmount { // 这次还能分红
-					//			_, err = ruc.userBalanceRepo.NormalRecommendTopReward(ctx, tmpMyTopUserRecommendUserId, tmpMyTopUserRecommendUserLocationLastBalanceAmount, currentLocation.ID, int64(i), tmpMyTopUserRecommendUserLocationLastStatus) // 直推人奖励
-					//			if nil != err {
-					//				return err
-					//			}
-					//		}
-					//	}
-					//
-					//}
-
-					//fmt.Println(recommendNeedLast)
-
-					for i := 2; i <= len(tmpRecommendUserIds)-1; i++ {
-						// 有占位信息，推荐人推荐人的上一代
-						if len(tmpRecommendUserIds)-i < 1 { // 根据数据第一位是空字符串
-							break
-						}
-
-						tmpMyTopUserRecommendUserId, _ := strconv.ParseInt(tmpRecommendUserIds[len(tmpRecommendUserIds)-i], 10, 64) // 最后一位是直推人
-						if 0 >= tmpMyTopUserRecommendUserId || 0 >= 10-recommendNeedLast {
-							break
-						}
-						//fmt.Println(tmpMyTopUserRecommendUserId)
-
-						myUserTopRecommendUserInfo, _ := ruc.userInfoRepo.GetUserInfoByUserId(ctx, tmpMyTopUserRecommendUserId)
-						if nil == myUserTopRecommendUserInfo {
-							continue
-						}
-
-						if recommendLevel >= myUserTopRecommendUserInfo.Vip {
-							continue
-						}
-
-						tmpMyTopUserRecommendUserLocationLast, _ := ruc.locationRepo.GetMyLocationLast(ctx, tmpMyTopUserRecommendUserId)
-						if nil == tmpMyTopUserRecommendUserLocationLast {
-							continue
-						}
-
-						var tmpMyRecommendAmount int64
-						if 5 == myUserTopRecommendUserInfo.Vip { // 会员等级分红
-							tmpMyRecommendAmount = currentValue / 100 * (recommendNeedVip5 - recommendNeedLast)
-							recommendNeedLast = recommendNeedVip5
-						} else if 4 == myUserTopRecommendUserInfo.Vip {
-							tmpMyRecommendAmount = currentValue / 100 * (recommendNeedVip4 - recommendNeedLast)
-							recommendNeedLast = recommendNeedVip4
-						} else if 3 == myUserTopRecommendUserInfo.Vip {
-							tmpMyRecommendAmount = currentValue / 100 * (recommendNeedVip3 - recommendNeedLast)
-							recommendNeedLast = recommendNeedVip3
-						} else if 2 == myUserTopRecommendUserInfo.Vip {
-							tmpMyRecommendAmount = currentValue / 100 * (recommendNeedVip2 - recommendNeedLast)
-							recommendNeedLast = recommendNeedVip2
-						} else if 1 == myUserTopRecommendUserInfo.Vip {
-							tmpMyRecommendAmount = currentValue / 100 * (recommendNeedVip1 - recommendNeedLast)
-							recommendNeedLast = recommendNeedVip1
-						} else {
-							continue
-						}
-						recommendLevel = myUserTopRecommendUserInfo.Vip
-
-						//fmt.Println(tmpMyRecommendAmount)
-						if 0 < tmpMyRecommendAmount { // 扣除推荐人分红
-							tmpStatus := tmpMyTopUserRecommendUserLocationLast.Status // 现在还在运行中
-
-							tmpBalanceAmount := tmpMyRecommendAmount // 记录下一次
-							tmpMyTopUserRecommendUserLocationLast.Status = "running"
-							tmpMyTopUserRecommendUserLocationLast.Current += tmpBalanceAmount
-							if tmpMyTopUserRecommendUserLocationLast.Current >= tmpMyTopUserRecommendUserLocationLast.CurrentMax { // 占位分红人分满停止
-								tmpMyTopUserRecommendUserLocationLast.Status = "stop"
-								if "running" == tmpStatus {
-									tmpMyTopUserRecommendUserLocationLast.StopDate = time.Now().UTC().Add(8 * time.Hour)
-								}
-							}
-							if 0 < tmpBalanceAmount {
-								err = ruc.locationRepo.UpdateLocation(ctx, tmpMyTopUserRecommendUserLocationLast.ID, tmpMyTopUserRecommendUserLocationLast.Status, tmpBalanceAmount, tmpMyTopUserRecommendUserLocationLast.StopDate) // 分红占位数据修改
-								if nil != err {
-									return err
-								}
-							}
-							amount -= tmpBalanceAmount // 扣除
-							if 0 < tmpBalanceAmount {  // 这次还能分红
-								_, err = ruc.userBalanceRepo.RecommendTopReward(ctx, tmpMyTopUserRecommendUserId, tmpBalanceAmount, currentLocation.ID, recommendLevel, tmpStatus) // 推荐人奖励
-								if nil != err {
-									return err
-								}
-
-							}
-
-						}
-					}
-
-				}
-
-			}
-
-			// 修改用户推荐人区数据，修改自身区数据
-			_, err = ruc.userRecommendRepo.UpdateUserAreaSelfAmount(ctx, v.UserId, currentValue/10000000000)
-			if nil != err {
-				return err
-			}
-			for _, vTmpRecommendUserIds := range tmpRecommendUserIds {
-				vTmpRecommendUserId, _ := strconv.ParseInt(vTmpRecommendUserIds, 10, 64)
-				if vTmpRecommendUserId > 0 {
-					_, err = ruc.userRecommendRepo.UpdateUserAreaAmount(ctx, vTmpRecommendUserId, currentValue/10000000000)
-					if nil != err {
-						return err
-					}
-				}
-			}
-
-			_, err = ruc.userBalanceRepo.Deposit(ctx, v.UserId, currentValue, dhbAmount) // 充值
-			if nil != err {
-				return err
-			}
-
-			if 0 < locationCurrent && nil != myLastStopLocation {
-				var tmpCurrentAmount int64
-				if locationCurrent > locationCurrentMax {
-					tmpCurrentAmount = locationCurrentMax
-				} else {
-
-					tmpCurrentAmount = locationCurrent
-				}
-				_, err = ruc.userBalanceRepo.DepositLast(ctx, v.UserId, tmpCurrentAmount, myLastStopLocation.ID) // 充值
-				if nil != err {
-					return err
-				}
-			}
-
-			err = ruc.userBalanceRepo.SystemReward(ctx, amount, currentLocation.ID)
-			if nil != err {
-				return err
-			}
-
-			_, err = ruc.ethUserRecordRepo.CreateEthUserRecordListByHash(ctx, &EthUserRecord{
-				Hash:     v.Hash,
-				UserId:   v.UserId,
-				Status:   v.Status,
-				Type:     v.Type,
-				Amount:   v.Amount,
-				CoinType: v.CoinType,
-			})
-			if nil != err {
-				return err
-			}
+	return true
+}
 
-			//dhbAmount, _ := strconv.ParseInt(ethUserRecord[k+1].Amount, 10, 64)
-			//dhbAmount /= 100000000                                                             // 转换为系统精度
-			//_, err = ruc.userBalanceRepo.DepositDhb(ctx, ethUserRecord[k+1].UserId, dhbAmount) // 充值
-			//if nil != err {
-			//	return err
-			//}
-			//
-			//_, err = ruc.ethUserRecordRepo.CreateEthUserRecordListByHash(ctx, &EthUserRecord{
-			//	Hash:     ethUserRecord[k+1].Hash,
-			//	UserId:   ethUserRecord[k+1].UserId,
-			//	Status:   ethUserRecord[k+1].Status,
-			//	Type:     ethUserRecord[k+1].Type,
-			//	Amount:   ethUserRecord[k+1].Amount,
-			//	CoinType: ethUserRecord[k+1].CoinType,
-			//})
-			//if nil != err {
-			//	return err
-			//}
+func (ruc *RecordUseCase) EthUserRecordHandle(ctx context.Context, ethUserRecord ...*EthUserRecord) (bool, error) {
+	// 占位锁，防止并发入单占位错乱
+	ctx, release, err := acquireLease(ctx, ruc.lockRepo, locationLease, "EthUserRecordHandle")
+	if nil != err {
+		return false, err
+	}
+	defer release()
 
-			return nil
-		}); nil != err {
+	// 配置
+	c := ruc.getDepositConfig(ctx)
+	for _, v := range ethUserRecord {
+		fmt.Println(v)
+		if err := ruc.depositLocation(ctx, v, c, ruc.tx.ExecTx, nil); nil != err {
 			continue
 		}
-
-		// 调整位置紧缩
-		stopLocations, err = ruc.locationRepo.GetLocationsStopNotUpdate(ctx)
-		if nil != stopLocations {
-			// 调整位置紧缩
-			for _, vStopLocations := range stopLocations {
-
-				if err = ruc.tx.ExecTx(ctx, func(ctx context.Context) error { // 事务
-					err = ruc.locationRepo.UpdateLocationRowAndCol(ctx, vStopLocations.ID)
-					if nil != err {
-						return err
-					}
-					return nil
-				}); nil != err {
-					continue
-				}
-			}
-		}
 	}
 
 	return true, nil
 }
 
-func (ruc *RecordUseCase) AdminLocationInsert(ctx context.Context, userId int64, amount int64) (bool, error) {
-
+// depositLocation 单笔入单：占位、分红、推荐奖励、入金，exec 为事务执行方式，sim 不为空时记录分配明细
+func (ruc *RecordUseCase) depositLocation(ctx context.Context, v *EthUserRecord, c *depositConfig, exec func(context.Context, func(ctx context.Context) error) error, sim *DepositSimulation) error {
 	var (
-		lastLocation            *Location
+		runningCount            int64
 		myLocations             []*Location
+		currentValue            int64
 		locationCurrentLevel    int64
 		locationCurrent         int64
 		locationCurrentMax      int64
 		locationRow             int64
 		locationCol             int64
 		currentLocation         *Location
-		myLastStopLocation      *Location
-		err                     error
-		configs                 []*Config
-		stopLocations           []*Location
 		userRecommend           *UserRecommend
-		tmpRecommendUserIds     []string
-		myUserRecommendUserInfo *UserInfo
 		myUserRecommendUserId   int64
-		currentValue            int64
-		timeAgain               int64
+		myUserRecommendUserInfo *UserInfo
+		myLastStopLocation      *Location
+		tmpRecommendUserIds     []string
+		dhbAmount               int64
+		err                     error
 	)
-	// 配置
-	configs, _ = ruc.configRepo.GetConfigByKeys(ctx, "time_again")
-	if nil != configs {
-		for _, vConfig := range configs {
-			if "time_again" == vConfig.KeyName {
-				timeAgain, _ = strconv.ParseInt(vConfig.Value, 10, 64)
-			}
-		}
-	}
-
-	// 调整位置紧缩
-	stopLocations, err = ruc.locationRepo.GetLocationsStopNotUpdate(ctx)
-	if nil != stopLocations {
-		// 调整位置紧缩
-		for _, vStopLocations := range stopLocations {
 
-			if err = ruc.tx.ExecTx(ctx, func(ctx context.Context) error { // 事务
-				err = ruc.locationRepo.UpdateLocationRowAndCol(ctx, vStopLocations.ID)
-				if nil != err {
-					return err
-				}
-				return nil
-			}); nil != err {
-				continue
-			}
-		}
-	}
+	//if "DHB" == v.CoinType {
+	//	continue
+	//}
 
 	// 获取当前用户的占位信息，已经有运行中的跳过
-	myLocations, err = ruc.locationRepo.GetLocationsByUserId(ctx, userId)
+	myLocations, err = ruc.locationRepo.GetLocationsByUserId(ctx, v.UserId)
 	if nil == myLocations { // 查询异常跳过本次循环
-		return false, errors.New(500, "ERROR", "查询错误，重试")
+		return errors.New(500, "LOCATION_ERROR", "占位查询失败")
 	}
 	if 0 < len(myLocations) { // 也代表复投
 		tmpStatusRunning := false
@@ -816,98 +365,51 @@ func (ruc *RecordUseCase) AdminLocationInsert(ctx context.Context, userId int64,
 		}
 
 		if tmpStatusRunning { // 有运行中直接跳过本次循环
-			return false, errors.New(500, "ERROR", "已存在运行中位置信息")
-		}
-	}
-
-	// 获取最后一行数据
-	lastLocation, err = ruc.locationRepo.GetLocationLast(ctx)
-	if nil == lastLocation {
-		locationRow = 1
-		locationCol = 1
-		fmt.Println(25, locationRow, locationRow)
-	} else {
-		if 3 > lastLocation.Col {
-			locationCol = lastLocation.Col + 1
-			locationRow = lastLocation.Row
-			fmt.Println(33, locationCol, locationRow)
-		} else {
-			locationCol = 1
-			locationRow = lastLocation.Row + 1
-			fmt.Println(22, locationRow, locationRow)
+			return errors.New(500, "LOCATION_RUNNING", "已有运行中的占位")
 		}
 	}
 
-	if 100 == amount {
-		locationCurrentLevel = 1
-		locationCurrentMax = 5000000000000
-		currentValue = 1000000000000
-	} else if 500 == amount {
-		locationCurrentLevel = 3
-		locationCurrentMax = 25000000000000
-		currentValue = 5000000000000
-	} else if 1000 == amount {
-		locationCurrentLevel = 4
-		locationCurrentMax = 50000000000000
-		currentValue = 10000000000000
-	} else if 3000 == amount {
-		locationCurrentLevel = 5
-		locationCurrentMax = 150000000000000
-		currentValue = 30000000000000
-	} else if 5000 == amount {
-		locationCurrentLevel = 6
-		locationCurrentMax = 250000000000000
-		currentValue = 50000000000000
-	} else {
-		return false, errors.New(500, "ERROR", "输入金额错误，重试")
+	// 新占位排在运行中占位最后
+	runningCount, err = ruc.locationRepo.GetLocationRunningCount(ctx)
+	if nil != err {
+		return err
 	}
+	locationRow, locationCol = LocationPosition(runningCount, c.width)
 
-	// 冻结
-	myLastStopLocation, err = ruc.locationRepo.GetMyStopLocationLast(ctx, userId)
-	now := time.Now().UTC().Add(8 * time.Hour)
-	if nil != myLastStopLocation && now.Before(myLastStopLocation.StopDate.Add(time.Duration(timeAgain)*time.Minute)) {
-		locationCurrent = myLastStopLocation.Current - myLastStopLocation.CurrentMax // 补上
+	tier := depositTierByChainAmount(v.Amount)
+	if nil == tier {
+		return errors.New(500, "DEPOSIT_AMOUNT_ERROR", "充值金额不支持")
 	}
+	locationCurrentLevel = tier.Level
+	locationCurrentMax = tier.CurrentMax
+	currentValue = tier.Value
+	dhbAmount = tier.DhbAmount
 
 	// 推荐人
-	userRecommend, err = ruc.userRecommendRepo.GetUserRecommendByUserId(ctx, userId)
+	userRecommend, err = ruc.userRecommendRepo.GetUserRecommendByUserId(ctx, v.UserId)
 	if nil != err {
-		return false, errors.New(500, "ERROR", "输入金额错误，重试")
+		return err
 	}
-	if nil != userRecommend {
-		if "" != userRecommend.RecommendCode {
-			tmpRecommendUserIds = strings.Split(userRecommend.RecommendCode, "D")
-			if 2 <= len(tmpRecommendUserIds) {
-				myUserRecommendUserId, _ = strconv.ParseInt(tmpRecommendUserIds[len(tmpRecommendUserIds)-1], 10, 64) // 最后一位是直推人
-			}
+	if "" != userRecommend.RecommendCode {
+		tmpRecommendUserIds = strings.Split(userRecommend.RecommendCode, "D")
+		if 2 <= len(tmpRecommendUserIds) {
+			myUserRecommendUserId, _ = strconv.ParseInt(tmpRecommendUserIds[len(tmpRecommendUserIds)-1], 10, 64) // 最后一位是直推人
 		}
-
 	}
-
 	if 0 < myUserRecommendUserId {
 		myUserRecommendUserInfo, err = ruc.userInfoRepo.GetUserInfoByUserId(ctx, myUserRecommendUserId)
 	}
-	// 推荐人
-	if nil != myUserRecommendUserInfo {
-		if 0 == len(myLocations) { // vip 等级调整，被推荐人首次入单
-			myUserRecommendUserInfo.HistoryRecommend += 1
-			if myUserRecommendUserInfo.HistoryRecommend >= 10 {
-				myUserRecommendUserInfo.Vip = 5
-			} else if myUserRecommendUserInfo.HistoryRecommend >= 8 {
-				myUserRecommendUserInfo.Vip = 4
-			} else if myUserRecommendUserInfo.HistoryRecommend >= 6 {
-				myUserRecommendUserInfo.Vip = 3
-			} else if myUserRecommendUserInfo.HistoryRecommend >= 4 {
-				myUserRecommendUserInfo.Vip = 2
-			} else if myUserRecommendUserInfo.HistoryRecommend >= 2 {
-				myUserRecommendUserInfo.Vip = 1
-			}
-		}
+
+	// 冻结
+	myLastStopLocation, err = ruc.locationRepo.GetMyStopLocationLast(ctx, v.UserId)
+	now := bizNow(ctx).Add(8 * time.Hour)
+	if nil != myLastStopLocation && now.Before(myLastStopLocation.StopDate.Add(time.Duration(c.timeAgain)*time.Minute)) {
+		locationCurrent = myLastStopLocation.Current - myLastStopLocation.CurrentMax // 补上
 	}
 
-	if err = ruc.tx.ExecTx(ctx, func(ctx context.Context) error { // 事务
+	return exec(ctx, func(ctx context.Context) error { // 事务
 		currentLocation, err = ruc.locationRepo.CreateLocation(ctx, &Location{ // 占位
-			UserId:       userId,
+			UserId:       v.UserId,
 			Status:       "running",
 			CurrentLevel: locationCurrentLevel,
 			Current:      locationCurrent,
@@ -918,88 +420,138 @@ func (ruc *RecordUseCase) AdminLocationInsert(ctx context.Context, userId int64,
 		if nil != err {
 			return err
 		}
+		if nil != sim {
+			sim.Row = locationRow
+			sim.Col = locationCol
+			sim.CurrentLevel = locationCurrentLevel
+			sim.Current = locationCurrent
+			sim.CurrentMax = locationCurrentMax
+		}
 
+		// 推荐人
 		if nil != myUserRecommendUserInfo {
-			_, err = ruc.userInfoRepo.UpdateUserInfo(ctx, myUserRecommendUserInfo) // 推荐人信息修改
-			if nil != err {
-				return err
-			}
+			if 0 == len(myLocations) { // vip 等级调整，被推荐人首次入单
+				upgradeVip(ctx, c.vipPolicy, ruc.userRecommendRepo, ruc.locationRepo, myUserRecommendUserInfo, currentValue/10000000000)
 
-			_, err = ruc.userCurrentMonthRecommendRepo.CreateUserCurrentMonthRecommend(ctx, &UserCurrentMonthRecommend{ // 直推人本月推荐人数
-				UserId:          myUserRecommendUserId,
-				RecommendUserId: userId,
-				Date:            time.Now().UTC().Add(8 * time.Hour),
-			})
-			if nil != err {
-				return err
+				_, err = ruc.userInfoRepo.UpdateUserInfo(ctx, myUserRecommendUserInfo) // 推荐人信息修改
+				if nil != err {
+					return err
+				}
+
+				_, err = ruc.userCurrentMonthRecommendRepo.CreateUserCurrentMonthRecommend(ctx, &UserCurrentMonthRecommend{ // 直推人本月推荐人数
+					UserId:          myUserRecommendUserId,
+					RecommendUserId: v.UserId,
+					Date:            bizNow(ctx).Add(8 * time.Hour),
+				})
+				if nil != err {
+					return err
+				}
 			}
 		}
 
+		// 分红、推荐奖励，剩余进系统
+		err = ruc.distributeRewards(ctx, &RewardDistribution{
+			UserId:            v.UserId,
+			Location:          currentLocation,
+			CurrentValue:      currentValue,
+			Remain:            currentValue,
+			RecommendUserIds:  tmpRecommendUserIds,
+			RecommendUserId:   myUserRecommendUserId,
+			RecommendUserInfo: myUserRecommendUserInfo,
+			Sim:               sim,
+		}, c)
+		if nil != err {
+			return err
+		}
+
+		switch v.Type {
+		case "reinvest": // 余额复投，扣usdt余额
+			_, err = ruc.userBalanceRepo.Reinvest(ctx, v.UserId, currentValue, dhbAmount)
+		case "admin_insert": // 后台入单不入金
+		default:
+			_, err = ruc.userBalanceRepo.Deposit(ctx, v.UserId, currentValue, dhbAmount) // 充值
+		}
+		if nil != err {
+			return err
+		}
+
 		if 0 < locationCurrent && nil != myLastStopLocation {
-			_, err = ruc.userBalanceRepo.DepositLast(ctx, userId, locationCurrent, myLastStopLocation.ID) // 充值
+			var tmpCurrentAmount int64
+			if locationCurrent > locationCurrentMax {
+				tmpCurrentAmount = locationCurrentMax
+			} else {
+
+				tmpCurrentAmount = locationCurrent
+			}
+			_, err = ruc.userBalanceRepo.DepositLast(ctx, v.UserId, tmpCurrentAmount, myLastStopLocation.ID) // 充值
 			if nil != err {
 				return err
 			}
+			sim.addReward(v.UserId, myLastStopLocation.ID, "last", tmpCurrentAmount, "running", "")
+		}
+
+		if "admin_insert" == v.Type { // 操作日志代替入单记录，回放按日志重放
+			return createAdminAuditLog(ctx, ruc.auditRepo, "AdminLocationInsert", map[string]int64{"user_id": v.UserId, "amount": currentValue / 10000000000}, myLocations, currentLocation)
 		}
 
-		// 修改用户推荐人区数据，修改自身区数据
-		_, err = ruc.userRecommendRepo.UpdateUserAreaSelfAmount(ctx, userId, currentValue/10000000000)
+		_, err = ruc.ethUserRecordRepo.CreateEthUserRecordListByHash(ctx, &EthUserRecord{
+			Hash:     v.Hash,
+			UserId:   v.UserId,
+			Status:   v.Status,
+			Type:     v.Type,
+			Amount:   v.Amount,
+			CoinType: v.CoinType,
+		})
 		if nil != err {
 			return err
 		}
-		for _, vTmpRecommendUserIds := range tmpRecommendUserIds {
-			vTmpRecommendUserId, _ := strconv.ParseInt(vTmpRecommendUserIds, 10, 64)
-			if vTmpRecommendUserId > 0 {
-				_, err = ruc.userRecommendRepo.UpdateUserAreaAmount(ctx, vTmpRecommendUserId, currentValue/10000000000)
-				if nil != err {
-					return err
-				}
-			}
-		}
+
+		//dhbAmount, _ := strconv.ParseInt(ethUserRecord[k+1].Amount, 10, 64)
+		//dhbAmount /= 100000000                                                             // 转换为系统精度
+		//_, err = ruc.userBalanceRepo.DepositDhb(ctx, ethUserRecord[k+1].UserId, dhbAmount) // 充值
+		//if nil != err {
+		//	return err
+		//}
+		//
+		//_, err = ruc.ethUserRecordRepo.CreateEthUserRecordListByHash(ctx, &EthUserRecord{
+		//	Hash:     ethUserRecord[k+1].Hash,
+		//	UserId:   ethUserRecord[k+1].UserId,
+		//	Status:   ethUserRecord[k+1].Status,
+		//	Type:     ethUserRecord[k+1].Type,
+		//	Amount:   ethUserRecord[k+1].Amount,
+		//	CoinType: ethUserRecord[k+1].CoinType,
+		//})
+		//if nil != err {
+		//	return err
+		//}
 
 		return nil
-	}); nil != err {
-		return false, errors.New(500, "ERROR", "错误，重试")
+	})
+}
 
+// AdminLocationInsert 后台入单，生成 admin_insert 类型的入单记录，和链上充值走同一套占位分配，
+// 不入金，不写入单记录，操作日志和占位在同一事务
+func (ruc *RecordUseCase) AdminLocationInsert(ctx context.Context, userId int64, amount int64) (bool, error) {
+	// 占位锁
+	ctx, release, err := acquireLease(ctx, ruc.lockRepo, locationLease, "AdminLocationInsert")
+	if nil != err {
+		return false, err
 	}
-
-	// 调整位置紧缩
-	stopLocations, err = ruc.locationRepo.GetLocationsStopNotUpdate(ctx)
-	if nil != stopLocations {
-		// 调整位置紧缩
-		for _, vStopLocations := range stopLocations {
-
-			if err = ruc.tx.ExecTx(ctx, func(ctx context.Context) error { // 事务
-				err = ruc.locationRepo.UpdateLocationRowAndCol(ctx, vStopLocations.ID)
-				if nil != err {
-					return err
-				}
-				return nil
-			}); nil != err {
-				continue
-			}
-		}
+	defer release()
+
+	v := &EthUserRecord{
+		UserId:   userId,
+		Hash:     fmt.Sprintf("admin_insert_%d_%d", userId, bizNow(ctx).UnixNano()),
+		Status:   "success",
+		Type:     "admin_insert",
+		Amount:   strconv.FormatInt(amount, 10) + "000000000000000000",
+		CoinType: "USDT",
 	}
 
-	return true, nil
-}
-
-func (ruc *RecordUseCase) LockEthUserRecordHandle(ctx context.Context, ethUserRecord ...*EthUserRecord) (bool, error) {
-	var (
-		lock bool
-	)
-	// todo 全局锁
-	for i := 0; i < 3; i++ {
-		lock, _ = ruc.locationRepo.LockGlobalLocation(ctx)
-		if lock {
-			return true, nil
-		}
-		time.Sleep(5 * time.Second)
+	c := ruc.getDepositConfig(ctx)
+	if err = ruc.depositLocation(ctx, v, c, ruc.tx.ExecTx, nil); nil != err {
+		return false, err
 	}
 
-	return false, nil
-}
-
-func (ruc *RecordUseCase) UnLockEthUserRecordHandle(ctx context.Context, ethUserRecord ...*EthUserRecord) (bool, error) {
-	return ruc.locationRepo.UnLockGlobalLocation(ctx)
+	return true, nil
 }
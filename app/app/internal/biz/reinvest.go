@@ -0,0 +1,65 @@
+package biz
+
+import (
+	"context"
+	v1 "dhb/app/app/api"
+	"dhb/app/app/internal/pkg/middleware/idempotency"
+	"fmt"
+	"github.com/go-kratos/kratos/v2/errors"
+	"strconv"
+)
+
+// Reinvest 用usdt余额复投，生成 reinvest 类型的入单记录，和链上充值走同一套占位分配和档位，
+// 已有运行中占位时不能复投
+func (ruc *RecordUseCase) Reinvest(ctx context.Context, req *v1.ReinvestRequest, user *User) (*v1.ReinvestReply, error) {
+	var (
+		userBalance *UserBalance
+		err         error
+	)
+
+	amount := req.SendBody.Amount // 档位由 depositLocation 按入单档位表校验
+
+	// 占位锁，和入单互斥
+	ctx, release, err := acquireLease(ctx, ruc.lockRepo, locationLease, "Reinvest")
+	if nil != err {
+		return nil, err
+	}
+	defer release()
+
+	userBalance, err = ruc.userBalanceRepo.GetUserBalance(ctx, user.ID)
+	if nil != err {
+		return nil, err
+	}
+	if userBalance.BalanceUsdt < amount*10000000000 {
+		return nil, errors.New(500, "REINVEST_BALANCE_NOT_ENOUGH", "余额不足")
+	}
+
+	// 没有链上交易，生成唯一的复投编号
+	v := &EthUserRecord{
+		UserId:   user.ID,
+		Hash:     fmt.Sprintf("reinvest_%d_%d", user.ID, bizNow(ctx).UnixNano()),
+		Status:   "success",
+		Type:     "reinvest",
+		Amount:   strconv.FormatInt(amount, 10) + "000000000000000000",
+		CoinType: "USDT",
+	}
+
+	res := &v1.ReinvestReply{
+		Status: "ok",
+		Hash:   v.Hash,
+	}
+	c := ruc.getDepositConfig(ctx)
+	if err = ruc.depositLocation(ctx, v, c, func(ctx context.Context, fn func(ctx context.Context) error) error {
+		return ruc.tx.ExecTx(ctx, func(ctx context.Context) error {
+			if err := fn(ctx); nil != err {
+				return err
+			}
+			// 幂等结果和扣款在同一事务提交
+			return idempotency.Commit(ctx, res)
+		})
+	}, nil); nil != err {
+		return nil, err
+	}
+
+	return res, nil
+}
@@ -0,0 +1,61 @@
+package biz
+
+import (
+	"context"
+	"testing"
+
+	v1 "dhb/app/app/api"
+	"github.com/go-kratos/kratos/v2/errors"
+)
+
+func TestReinvest(t *testing.T) {
+	tests := []struct {
+		name    string
+		userId  int64
+		amount  int64
+		balance int64
+		code    string
+	}{
+		{"tier", 3, 100, 1500000000000, ""},
+		{"not a tier", 3, 200, 5000000000000, "DEPOSIT_AMOUNT_ERROR"},
+		{"balance", 3, 500, 1500000000000, "REINVEST_BALANCE_NOT_ENOUGH"},
+		{"running", 1, 100, 5000000000000, "LOCATION_RUNNING"},
+	}
+
+	for _, tt := range tests {
+		ruc, locationRepo, userRepo, rewardRepo := newTestRecordUseCase(map[string]string{})
+		newTestDepositUsers(userRepo, locationRepo, rewardRepo)
+		// 用户3由用户1推荐，占位已出局
+		user, _ := ruc.createSimulateUser(context.Background(), 1)
+		locationRepo.CreateLocation(context.Background(), &Location{UserId: user, Status: "stop", CurrentMax: 5000000000000})
+		rewardRepo.balances[tt.userId]["usdt"] = tt.balance
+		locations := len(locationRepo.locations)
+
+		_, err := ruc.Reinvest(context.Background(), &v1.ReinvestRequest{SendBody: &v1.ReinvestRequest_SendBody{Amount: tt.amount}}, &User{ID: tt.userId})
+		if "" != tt.code {
+			if tt.code != errors.Reason(err) {
+				t.Errorf("%s: err = %v, want %s", tt.name, err, tt.code)
+			}
+			if tt.balance != rewardRepo.balances[tt.userId]["usdt"] || locations != len(locationRepo.locations) {
+				t.Errorf("%s: balance %d locations %d after failed reinvest", tt.name, rewardRepo.balances[tt.userId]["usdt"], len(locationRepo.locations))
+			}
+			continue
+		}
+
+		if nil != err {
+			t.Errorf("%s: %v", tt.name, err)
+			continue
+		}
+		if tt.balance-1000000000000 != rewardRepo.balances[tt.userId]["usdt"] || 1000000000000 != rewardRepo.balances[tt.userId]["dhb"] {
+			t.Errorf("%s: balance usdt %d dhb %d", tt.name, rewardRepo.balances[tt.userId]["usdt"], rewardRepo.balances[tt.userId]["dhb"])
+		}
+		l := locationRepo.locations[len(locationRepo.locations)-1]
+		if tt.userId != l.UserId || "running" != l.Status || 5000000000000 != l.CurrentMax {
+			t.Errorf("%s: location %+v", tt.name, l)
+		}
+		// 复投不是首次入单，推荐人直推人数不变
+		if 3 != userRepo.infos[1].HistoryRecommend {
+			t.Errorf("%s: referrer history %d, want 3", tt.name, userRepo.infos[1].HistoryRecommend)
+		}
+	}
+}
@@ -0,0 +1,346 @@
+package biz
+
+import (
+	"context"
+	v1 "dhb/app/app/api"
+	"fmt"
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+	"sort"
+	"strconv"
+	"time"
+)
+
+// ReplayEvent 重放输入事件
+type ReplayEvent struct {
+	Kind     string // deposit 充值入单，admin_insert 后台入单，daily 每日任务，balance 其他余额变动（提现、调账）
+	Seq      int64  // 源记录id，每日任务为当次第一条分红id
+	Time     time.Time
+	Record   *EthUserRecord
+	Job      string // 每日任务方法名
+	UserId   int64
+	Amount   int64
+	CoinType string
+}
+
+// ReplayState 重放后对比的状态
+type ReplayState struct {
+	Locations []*Location
+	Rewards   []*Reward
+	Balances  map[int64]*UserBalance
+	UserInfos map[int64]*UserInfo
+	UserAreas map[int64]*UserArea
+}
+
+// ReplayDiff 生产和影子库的一处差异，Key 为位置/奖励的顺序号或用户id
+type ReplayDiff struct {
+	Table  string
+	Key    int64
+	Field  string
+	Prod   string
+	Shadow string
+}
+
+// ReplayReport 重放结果
+type ReplayReport struct {
+	Events          int
+	Failed          []string
+	FirstDivergence string
+	Diffs           []*ReplayDiff
+}
+
+type ReplayRepo interface {
+	GetSchemaName(ctx context.Context) (string, error)
+	GetReplayEvents(ctx context.Context) ([]*ReplayEvent, error)
+	ResetShadow(ctx context.Context, source string) error
+	ApplyBalanceChange(ctx context.Context, userId int64, coinType string, amount int64) error
+	GetLastRewardId(ctx context.Context) (int64, error)
+	GetReplayState(ctx context.Context) (*ReplayState, error)
+}
+
+// ReplayUseCase 从充值、后台入单和每日任务记录重建影子库，再和生产对比。
+// prodRepo 读生产，其余都指向影子库。
+type ReplayUseCase struct {
+	prodRepo     ReplayRepo
+	shadowRepo   ReplayRepo
+	shadowRecord *RecordUseCase
+	shadowUser   *UserUseCase
+	tx           Transaction
+	log          *log.Helper
+}
+
+// replayDiffLimit 每张表最多列出的差异数
+const replayDiffLimit = 100
+
+type replayTimeKey struct{}
+
+// withReplayTime 重放时按事件发生时间计算停止时间、复投时限等
+func withReplayTime(ctx context.Context, t time.Time) context.Context {
+	return context.WithValue(ctx, replayTimeKey{}, t)
+}
+
+// bizNow 当前时间（UTC），重放时取事件发生时间
+func bizNow(ctx context.Context) time.Time {
+	if t, ok := ctx.Value(replayTimeKey{}).(time.Time); ok {
+		return t.UTC()
+	}
+
+	return time.Now().UTC()
+}
+
+// Now 数据层按日期统计时用，重放时为事件发生时间
+func Now(ctx context.Context) time.Time {
+	return bizNow(ctx)
+}
+
+func NewReplayUseCase(prodRepo ReplayRepo, shadowRepo ReplayRepo, shadowRecord *RecordUseCase, shadowUser *UserUseCase, tx Transaction, logger log.Logger) *ReplayUseCase {
+	return &ReplayUseCase{
+		prodRepo:     prodRepo,
+		shadowRepo:   shadowRepo,
+		shadowRecord: shadowRecord,
+		shadowUser:   shadowUser,
+		tx:           tx,
+		log:          log.NewHelper(logger),
+	}
+}
+
+// runDailyJob 在影子库按记录的执行时间重跑每日任务，任务按当时的昨日数据重新计算
+func (r *ReplayUseCase) runDailyJob(ctx context.Context, job string) error {
+	var err error
+	switch job {
+	case "AdminFee":
+		_, err = r.shadowUser.AdminFee(ctx, &v1.AdminFeeRequest{})
+	case "AdminFeeDaily":
+		_, err = r.shadowUser.AdminFeeDaily(ctx, &v1.AdminDailyFeeRequest{})
+	case "AdminDailyWithdrawReward":
+		_, err = r.shadowUser.AdminDailyWithdrawReward(ctx, &v1.AdminDailyWithdrawRewardRequest{})
+	case "AdminDailyRecommendTopReward":
+		_, err = r.shadowUser.AdminDailyRecommendTopReward(ctx, &v1.AdminDailyRecommendTopRewardRequest{})
+	case "AdminDailyRecommendReward":
+		_, err = r.shadowUser.AdminDailyRecommendReward(ctx, &v1.AdminDailyRecommendRewardRequest{})
+	default:
+		return errors.New(500, "REPLAY_JOB_ERROR", "不支持重放的任务："+job)
+	}
+
+	return err
+}
+
+// replayEvent 重放单个事件，入单和后台入单走和线上相同的入口
+func (r *ReplayUseCase) replayEvent(ctx context.Context, e *ReplayEvent, c *depositConfig) error {
+	ctx = withReplayTime(ctx, e.Time)
+
+	switch e.Kind {
+	case "deposit":
+		return r.shadowRecord.depositLocation(ctx, e.Record, c, r.tx.ExecTx, nil)
+	case "admin_insert":
+		_, err := r.shadowRecord.AdminLocationInsert(ctx, e.UserId, e.Amount)
+		return err
+	case "daily":
+		return r.runDailyJob(ctx, e.Job)
+	case "balance":
+		return r.shadowRepo.ApplyBalanceChange(ctx, e.UserId, e.CoinType, e.Amount)
+	}
+
+	return errors.New(500, "REPLAY_EVENT_ERROR", "未知事件："+e.Kind)
+}
+
+// Replay 清空影子库后按时间顺序重放全部事件，再和生产对比。
+// 配置取影子库当前值，历史上改过的配置会表现为差异。
+func (r *ReplayUseCase) Replay(ctx context.Context) (*ReplayReport, error) {
+	var (
+		source string
+		target string
+		events []*ReplayEvent
+		prod   *ReplayState
+		shadow *ReplayState
+		err    error
+	)
+
+	source, err = r.prodRepo.GetSchemaName(ctx)
+	if nil != err {
+		return nil, err
+	}
+	target, err = r.shadowRepo.GetSchemaName(ctx)
+	if nil != err {
+		return nil, err
+	}
+	if source == target { // 影子库会被清空，不能指向生产
+		return nil, errors.New(500, "REPLAY_SHADOW_ERROR", "影子库不能和生产库相同")
+	}
+	if err = r.shadowRepo.ResetShadow(ctx, source); nil != err {
+		return nil, err
+	}
+
+	events, err = r.prodRepo.GetReplayEvents(ctx)
+	if nil != err {
+		return nil, err
+	}
+	sort.SliceStable(events, func(i, j int) bool {
+		return events[i].Time.Before(events[j].Time)
+	})
+
+	report := &ReplayReport{
+		Events: len(events),
+		Failed: make([]string, 0),
+		Diffs:  make([]*ReplayDiff, 0),
+	}
+
+	c := r.shadowRecord.getDepositConfig(ctx)
+	rewardEnd := make([]int64, len(events)) // 每个事件之后影子库最后一条奖励id
+	for k, e := range events {
+		if err = r.replayEvent(ctx, e, c); nil != err {
+			report.Failed = append(report.Failed, fmt.Sprintf("%s %d: %s", e.Kind, e.Seq, err.Error()))
+		}
+
+		rewardEnd[k], err = r.shadowRepo.GetLastRewardId(ctx)
+		if nil != err {
+			return nil, err
+		}
+	}
+
+	prod, err = r.prodRepo.GetReplayState(ctx)
+	if nil != err {
+		return nil, err
+	}
+	shadow, err = r.shadowRepo.GetReplayState(ctx)
+	if nil != err {
+		return nil, err
+	}
+
+	report.Diffs = append(report.Diffs, diffRewards(prod.Rewards, shadow.Rewards)...)
+	report.Diffs = append(report.Diffs, diffLocations(prod.Locations, shadow.Locations)...)
+	report.Diffs = append(report.Diffs, diffUserStates(prod, shadow)...)
+	report.FirstDivergence = firstDivergence(events, rewardEnd, prod.Rewards, shadow.Rewards)
+
+	return report, nil
+}
+
+// firstDivergence 奖励只增不改，按顺序第一条不一致的奖励定位到产生它的事件
+func firstDivergence(events []*ReplayEvent, rewardEnd []int64, prod []*Reward, shadow []*Reward) string {
+	for i := 0; i < len(prod) || i < len(shadow); i++ {
+		if i < len(prod) && i < len(shadow) && sameReward(prod[i], shadow[i]) {
+			continue
+		}
+
+		if i < len(shadow) {
+			for k, end := range rewardEnd {
+				if shadow[i].ID <= end {
+					return replayEventName(events[k])
+				}
+			}
+		}
+		for k := len(events) - 1; k >= 0; k-- { // 影子库少了奖励，按生产奖励时间找事件
+			if !events[k].Time.After(prod[i].CreatedAt) {
+				return replayEventName(events[k])
+			}
+		}
+
+		return fmt.Sprintf("reward #%d", i)
+	}
+
+	return ""
+}
+
+func replayEventName(e *ReplayEvent) string {
+	if "daily" == e.Kind {
+		return fmt.Sprintf("%s %s at %s", e.Kind, e.Job, e.Time.Add(8*time.Hour).Format("2006-01-02 15:04:05"))
+	}
+	return fmt.Sprintf("%s %d at %s", e.Kind, e.Seq, e.Time.Add(8*time.Hour).Format("2006-01-02 15:04:05"))
+}
+
+func sameReward(a *Reward, b *Reward) bool {
+	return a.UserId == b.UserId && a.Amount == b.Amount && a.Type == b.Type && a.Reason == b.Reason
+}
+
+func diffRewards(prod []*Reward, shadow []*Reward) []*ReplayDiff {
+	res := make([]*ReplayDiff, 0)
+	if len(prod) != len(shadow) {
+		res = append(res, &ReplayDiff{Table: "reward", Field: "count", Prod: strconv.Itoa(len(prod)), Shadow: strconv.Itoa(len(shadow))})
+	}
+
+	for i := 0; i < len(prod) && i < len(shadow) && replayDiffLimit > len(res); i++ {
+		if sameReward(prod[i], shadow[i]) {
+			continue
+		}
+		res = append(res, &ReplayDiff{
+			Table:  "reward",
+			Key:    int64(i),
+			Field:  "user_id/amount/type/reason",
+			Prod:   fmt.Sprintf("%d/%d/%s/%s", prod[i].UserId, prod[i].Amount, prod[i].Type, prod[i].Reason),
+			Shadow: fmt.Sprintf("%d/%d/%s/%s", shadow[i].UserId, shadow[i].Amount, shadow[i].Type, shadow[i].Reason),
+		})
+	}
+
+	return res
+}
+
+// diffLocations 行列由运行中序号计算，不对比
+func diffLocations(prod []*Location, shadow []*Location) []*ReplayDiff {
+	res := make([]*ReplayDiff, 0)
+	if len(prod) != len(shadow) {
+		res = append(res, &ReplayDiff{Table: "location", Field: "count", Prod: strconv.Itoa(len(prod)), Shadow: strconv.Itoa(len(shadow))})
+	}
+
+	for i := 0; i < len(prod) && i < len(shadow) && replayDiffLimit > len(res); i++ {
+		p := fmt.Sprintf("%d/%s/%d/%d", prod[i].UserId, prod[i].Status, prod[i].Current, prod[i].CurrentMax)
+		s := fmt.Sprintf("%d/%s/%d/%d", shadow[i].UserId, shadow[i].Status, shadow[i].Current, shadow[i].CurrentMax)
+		if p != s {
+			res = append(res, &ReplayDiff{Table: "location", Key: int64(i), Field: "user_id/status/current/current_max", Prod: p, Shadow: s})
+		}
+	}
+
+	return res
+}
+
+func diffUserStates(prod *ReplayState, shadow *ReplayState) []*ReplayDiff {
+	res := make([]*ReplayDiff, 0)
+
+	userIds := make([]int64, 0, len(prod.Balances))
+	for userId := range prod.Balances {
+		userIds = append(userIds, userId)
+	}
+	sort.Slice(userIds, func(i, j int) bool { return userIds[i] < userIds[j] })
+
+	var balanceNum, infoNum, areaNum int
+	for _, userId := range userIds {
+		if p, s := prod.Balances[userId], shadow.Balances[userId]; nil != s && replayDiffLimit > balanceNum {
+			pBnb, sBnb := fmt.Sprintf("%.8f", p.BnbAmount), fmt.Sprintf("%.8f", s.BnbAmount)
+			if p.BalanceUsdt != s.BalanceUsdt || p.BalanceDhb != s.BalanceDhb || pBnb != sBnb {
+				balanceNum++
+				res = append(res, &ReplayDiff{
+					Table:  "user_balance",
+					Key:    userId,
+					Field:  "balance_usdt/balance_dhb/bnb_amount",
+					Prod:   fmt.Sprintf("%d/%d/%s", p.BalanceUsdt, p.BalanceDhb, pBnb),
+					Shadow: fmt.Sprintf("%d/%d/%s", s.BalanceUsdt, s.BalanceDhb, sBnb),
+				})
+			}
+		}
+		if p, s := prod.UserInfos[userId], shadow.UserInfos[userId]; nil != p && nil != s && replayDiffLimit > infoNum {
+			if p.Vip != s.Vip || p.HistoryRecommend != s.HistoryRecommend {
+				infoNum++
+				res = append(res, &ReplayDiff{
+					Table:  "user_info",
+					Key:    userId,
+					Field:  "vip/history_recommend",
+					Prod:   fmt.Sprintf("%d/%d", p.Vip, p.HistoryRecommend),
+					Shadow: fmt.Sprintf("%d/%d", s.Vip, s.HistoryRecommend),
+				})
+			}
+		}
+		if p, s := prod.UserAreas[userId], shadow.UserAreas[userId]; nil != p && nil != s && replayDiffLimit > areaNum {
+			if p.Amount != s.Amount || p.SelfAmount != s.SelfAmount {
+				areaNum++
+				res = append(res, &ReplayDiff{
+					Table:  "user_area",
+					Key:    userId,
+					Field:  "amount/self_amount",
+					Prod:   fmt.Sprintf("%d/%d", p.Amount, p.SelfAmount),
+					Shadow: fmt.Sprintf("%d/%d", s.Amount, s.SelfAmount),
+				})
+			}
+		}
+	}
+
+	return res
+}
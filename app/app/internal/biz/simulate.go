@@ -0,0 +1,164 @@
+package biz
+
+import (
+	"context"
+	v1 "dhb/app/app/api"
+	"fmt"
+	"github.com/go-kratos/kratos/v2/errors"
+	"strconv"
+)
+
+// DepositSimulation 模拟入单结果
+type DepositSimulation struct {
+	Row          int64
+	Col          int64
+	CurrentLevel int64
+	Current      int64
+	CurrentMax   int64
+	Rewards      []*DepositSimulationReward
+	SystemReward int64
+}
+
+// DepositSimulationReward 模拟入单的单笔奖励，Credited 为是否实际入账（占位已停止的只记录不入账），Stop 为本次分完停止占位
+type DepositSimulationReward struct {
+	UserId     int64
+	LocationId int64
+	Type       string
+	Amount     int64
+	Credited   bool
+	Stop       bool
+}
+
+// errDepositSimulationRollback 模拟结束后用于回滚事务
+var errDepositSimulationRollback = errors.New(500, "DEPOSIT_SIMULATION_ROLLBACK", "模拟入单回滚")
+
+func (sim *DepositSimulation) addReward(userId int64, locationId int64, rewardType string, amount int64, fromStatus string, toStatus string) {
+	if nil == sim {
+		return
+	}
+
+	sim.Rewards = append(sim.Rewards, &DepositSimulationReward{
+		UserId:     userId,
+		LocationId: locationId,
+		Type:       rewardType,
+		Amount:     amount,
+		Credited:   "running" == fromStatus,
+		Stop:       "running" == fromStatus && "stop" == toStatus,
+	})
+}
+
+// createSimulateUser 模拟入单用的新用户，和注册一样建用户、用户信息、推荐关系和余额，随模拟事务回滚
+func (ruc *RecordUseCase) createSimulateUser(ctx context.Context, recommendUserId int64) (int64, error) {
+	var (
+		recommendUser *UserRecommend
+		user          *User
+		err           error
+	)
+
+	if 0 < recommendUserId {
+		recommendUser, err = ruc.userRecommendRepo.GetUserRecommendByUserId(ctx, recommendUserId)
+		if nil != err {
+			return 0, errors.New(500, "USER_ERROR", "推荐人不存在")
+		}
+	}
+
+	user, err = ruc.userRepo.CreateUser(ctx, &User{Address: fmt.Sprintf("simulate_%d", bizNow(ctx).UnixNano())})
+	if nil != err {
+		return 0, err
+	}
+	if _, err = ruc.userInfoRepo.CreateUserInfo(ctx, user); nil != err {
+		return 0, err
+	}
+	if _, err = ruc.userRecommendRepo.CreateUserRecommend(ctx, user, recommendUser); nil != err {
+		return 0, err
+	}
+	if _, err = ruc.userBalanceRepo.CreateUserBalance(ctx, user); nil != err {
+		return 0, err
+	}
+
+	return user.ID, nil
+}
+
+// SimulateDeposit 按当前数据模拟一次入单，全部写入在同一事务内执行后回滚；userId 为0时模拟 recommendUserId 推荐的新用户。
+// 持有占位锁，避免和真实入单、每日任务交错读到中间状态
+func (ruc *RecordUseCase) SimulateDeposit(ctx context.Context, userId int64, recommendUserId int64, amount int64, c *depositConfig) (*DepositSimulation, error) {
+	ctx, release, err := acquireLease(ctx, ruc.lockRepo, locationLease, "SimulateDeposit")
+	if nil != err {
+		return nil, err
+	}
+	defer release()
+
+	sim := &DepositSimulation{Rewards: make([]*DepositSimulationReward, 0)}
+	v := &EthUserRecord{
+		Status:   "success",
+		Type:     "deposit",
+		Amount:   strconv.FormatInt(amount, 10) + "000000000000000000",
+		CoinType: "USDT",
+	}
+
+	err = ruc.tx.ExecTx(ctx, func(ctx context.Context) error { // 事务
+		if 0 == userId {
+			if userId, err = ruc.createSimulateUser(ctx, recommendUserId); nil != err {
+				return err
+			}
+		}
+		v.UserId = userId
+		v.Hash = "simulate" + strconv.FormatInt(userId, 10)
+
+		if err := ruc.depositLocation(ctx, v, c, func(ctx context.Context, fn func(ctx context.Context) error) error {
+			return fn(ctx) // 共用外层事务
+		}, sim); nil != err {
+			return err
+		}
+
+		return errDepositSimulationRollback
+	})
+	if nil != err && !errors.Is(err, errDepositSimulationRollback) {
+		return nil, err
+	}
+
+	return sim, nil
+}
+
+// AdminDepositSimulate 模拟入单，recommend_need、location_row 不为0时覆盖当前配置，configs 按配置项名覆盖任意入单配置
+func (ruc *RecordUseCase) AdminDepositSimulate(ctx context.Context, req *v1.AdminDepositSimulateRequest) (*v1.AdminDepositSimulateReply, error) {
+	c := ruc.getDepositConfig(ctx)
+	if 0 < req.RecommendNeed {
+		c.recommendNeed = req.RecommendNeed
+	}
+	if 0 < req.LocationRow {
+		c.locationRowConfig = req.LocationRow
+	}
+	for keyName, value := range req.Configs {
+		if !c.set(keyName, value) {
+			return nil, errors.New(500, "PARAM_ERROR", "不支持的配置项："+keyName)
+		}
+	}
+
+	sim, err := ruc.SimulateDeposit(ctx, req.UserId, req.RecommendUserId, req.Amount, c)
+	if nil != err {
+		return nil, err
+	}
+
+	res := &v1.AdminDepositSimulateReply{
+		Row:          sim.Row,
+		Col:          sim.Col,
+		CurrentLevel: sim.CurrentLevel,
+		Current:      fmt.Sprintf("%.2f", float64(sim.Current)/float64(10000000000)),
+		CurrentMax:   fmt.Sprintf("%.2f", float64(sim.CurrentMax)/float64(10000000000)),
+		SystemReward: fmt.Sprintf("%.2f", float64(sim.SystemReward)/float64(10000000000)),
+		Rewards:      make([]*v1.AdminDepositSimulateReply_List, 0),
+	}
+	for _, vReward := range sim.Rewards {
+		res.Rewards = append(res.Rewards, &v1.AdminDepositSimulateReply_List{
+			UserId:     vReward.UserId,
+			LocationId: vReward.LocationId,
+			Type:       vReward.Type,
+			Amount:     fmt.Sprintf("%.2f", float64(vReward.Amount)/float64(10000000000)),
+			Credited:   vReward.Credited,
+			Stop:       vReward.Stop,
+		})
+	}
+
+	return res, nil
+}
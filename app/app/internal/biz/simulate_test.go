@@ -0,0 +1,100 @@
+package biz
+
+import (
+	"context"
+	"testing"
+
+	v1 "dhb/app/app/api"
+	"github.com/go-kratos/kratos/v2/errors"
+)
+
+// newTestDepositUsers 用户1是推荐人（vip1，已有3个直推入单），用户2、1依次有运行中占位
+func newTestDepositUsers(userRepo *testUserRepo, locationRepo *testLocationRepo, rewardRepo *testRewardRepo) {
+	for _, id := range []int64{1, 2} {
+		user, _ := userRepo.CreateUser(context.Background(), &User{})
+		userRepo.CreateUserInfo(context.Background(), user)
+		userRepo.CreateUserRecommend(context.Background(), user, nil)
+		rewardRepo.CreateUserBalance(context.Background(), user)
+		if 1 == id {
+			userRepo.infos[id].Vip = 1
+			userRepo.infos[id].VipCompute = 1
+			userRepo.infos[id].HistoryRecommend = 3
+		}
+	}
+	locationRepo.CreateLocation(context.Background(), &Location{UserId: 2, Status: "running", CurrentMax: 5000000000000})
+	locationRepo.CreateLocation(context.Background(), &Location{UserId: 1, Status: "running", CurrentMax: 5000000000000})
+}
+
+func TestAdminDepositSimulateNewUser(t *testing.T) {
+	ruc, locationRepo, userRepo, rewardRepo := newTestRecordUseCase(map[string]string{
+		"recommend_need":      "10",
+		"location_col_reward": "1",
+		"recommend_need_vip1": "3",
+		"recommend_need_vip2": "6",
+	})
+	newTestDepositUsers(userRepo, locationRepo, rewardRepo)
+
+	// 覆盖配置：每行2个，新占位在第2行第1列，和用户2同列
+	res, err := ruc.AdminDepositSimulate(context.Background(), &v1.AdminDepositSimulateRequest{
+		RecommendUserId: 1,
+		Amount:          100,
+		Configs:         map[string]string{"location_width": "2", "location_row": "1", "location_col_reward": "5", "recommend_need": "20"},
+	})
+	if nil != err {
+		t.Fatal(err)
+	}
+	if 2 != res.Row || 1 != res.Col || 1 != res.CurrentLevel || "500.00" != res.CurrentMax {
+		t.Errorf("location = row %d col %d level %d max %s, want row 2 col 1 level 1 max 500.00", res.Row, res.Col, res.CurrentLevel, res.CurrentMax)
+	}
+
+	// 新用户首次入单，推荐人第4个直推升到 vip2
+	want := map[string]struct {
+		userId int64
+		amount string
+	}{
+		"col":           {2, "5.00"},
+		"recommend":     {1, "20.00"},
+		"recommend_vip": {1, "6.00"},
+	}
+	if len(want) != len(res.Rewards) {
+		t.Fatalf("rewards = %v, want %d", res.Rewards, len(want))
+	}
+	for _, v := range res.Rewards {
+		w, ok := want[v.Type]
+		if !ok || w.userId != v.UserId || w.amount != v.Amount || !v.Credited {
+			t.Errorf("reward %s = user %d amount %s credited %v, want user %d amount %s", v.Type, v.UserId, v.Amount, v.Credited, w.userId, w.amount)
+		}
+	}
+	if "69.00" != res.SystemReward {
+		t.Errorf("system reward = %s, want 69.00", res.SystemReward)
+	}
+	if 4 != userRepo.infos[1].HistoryRecommend || 2 != userRepo.infos[1].Vip {
+		t.Errorf("referrer history %d vip %d, want 4 and 2", userRepo.infos[1].HistoryRecommend, userRepo.infos[1].Vip)
+	}
+	if 3 != len(userRepo.users) || "D1" != userRepo.recommends[3].RecommendCode {
+		t.Errorf("simulated user not created under referrer 1: %v", userRepo.recommends[3])
+	}
+}
+
+func TestAdminDepositSimulateErrors(t *testing.T) {
+	tests := []struct {
+		name string
+		req  *v1.AdminDepositSimulateRequest
+		code string
+	}{
+		{"unknown config", &v1.AdminDepositSimulateRequest{RecommendUserId: 1, Amount: 100, Configs: map[string]string{"location_colum": "2"}}, "PARAM_ERROR"},
+		{"unknown referrer", &v1.AdminDepositSimulateRequest{RecommendUserId: 9, Amount: 100}, "USER_ERROR"},
+		{"running user", &v1.AdminDepositSimulateRequest{UserId: 1, Amount: 100}, "LOCATION_RUNNING"},
+		{"amount", &v1.AdminDepositSimulateRequest{RecommendUserId: 1, Amount: 200}, "DEPOSIT_AMOUNT_ERROR"},
+	}
+
+	for _, tt := range tests {
+		ruc, locationRepo, userRepo, rewardRepo := newTestRecordUseCase(map[string]string{})
+		newTestDepositUsers(userRepo, locationRepo, rewardRepo)
+
+		_, err := ruc.AdminDepositSimulate(context.Background(), tt.req)
+		if tt.code != errors.Reason(err) {
+			t.Errorf("%s: err = %v, want %s", tt.name, err, tt.code)
+		}
+	}
+}
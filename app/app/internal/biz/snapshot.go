@@ -0,0 +1,161 @@
+package biz
+
+import (
+	"context"
+	v1 "dhb/app/app/api"
+	"fmt"
+	"github.com/go-kratos/kratos/v2/errors"
+	"time"
+)
+
+type BalanceSnapshot struct {
+	Date        string
+	UserId      int64
+	BalanceUsdt int64
+	BalanceDhb  int64
+	BnbAmount   float64
+}
+
+// BalanceSnapshotTotal 全网汇总，Withdrawing 为已扣余额未打款的提现
+type BalanceSnapshotTotal struct {
+	Date            string
+	UserCount       int64
+	BalanceUsdt     int64
+	BalanceDhb      int64
+	BnbAmount       float64
+	WithdrawingUsdt int64
+	WithdrawingDhb  int64
+	CreatedAt       time.Time
+}
+
+type BalanceSnapshotRepo interface {
+	CreateBalanceSnapshot(ctx context.Context, date string) (*BalanceSnapshotTotal, error)
+	GetUserBalanceSnapshot(ctx context.Context, userId int64, date string) (*BalanceSnapshot, error)
+	GetBalanceSnapshotTotals(ctx context.Context, start string, end string) ([]*BalanceSnapshotTotal, error)
+}
+
+// snapshotDate 最近一次已过的日结（UTC 14:00）的日期，和每日任务的昨日区间一致
+func snapshotDate(now time.Time) string {
+	if 14 > now.Hour() {
+		now = now.AddDate(0, 0, -1)
+	}
+
+	return now.Format("2006-01-02")
+}
+
+// AdminBalanceSnapshot 日终余额快照，余额取执行时的值，只能记最近一次日结（UTC 14:00），日结后尽快执行；
+// 更早的日期不补记，当天已有快照时需 force 才覆盖
+func (uuc *UserUseCase) AdminBalanceSnapshot(ctx context.Context, req *v1.AdminBalanceSnapshotRequest) (*v1.AdminBalanceSnapshotReply, error) {
+	var (
+		total    *BalanceSnapshotTotal
+		existing []*BalanceSnapshotTotal
+		err      error
+	)
+
+	now := bizNow(ctx)
+	date := snapshotDate(now)
+	if "" != req.Date {
+		var t time.Time
+		t, err = time.Parse("2006-01-02", req.Date)
+		if nil != err {
+			return nil, errors.New(500, "PARAM_ERROR", "日期格式错误")
+		}
+		if t.Add(14 * time.Hour).After(now) {
+			return nil, errors.New(500, "PARAM_ERROR", "该日还未日结")
+		}
+		if date != req.Date { // 快照取当前余额，历史日期记下的不是当时的余额
+			return nil, errors.New(500, "PARAM_ERROR", "只能记录最近一次日结的快照")
+		}
+	}
+
+	ctx, release, err := acquireLease(ctx, uuc.lockRepo, locationLease, "AdminBalanceSnapshot")
+	if nil != err {
+		return nil, err
+	}
+	defer release()
+
+	if err = uuc.tx.ExecTx(ctx, func(ctx context.Context) error { // 事务
+		existing, err = uuc.snapshotRepo.GetBalanceSnapshotTotals(ctx, date, date)
+		if nil != err {
+			return err
+		}
+		if 0 < len(existing) && !req.Force {
+			return errors.New(500, "BALANCE_SNAPSHOT_EXIST", "该日快照已存在")
+		}
+
+		total, err = uuc.snapshotRepo.CreateBalanceSnapshot(ctx, date)
+		return err
+	}); nil != err {
+		return nil, err
+	}
+
+	return &v1.AdminBalanceSnapshotReply{
+		Date:      date,
+		UserCount: total.UserCount,
+	}, nil
+}
+
+// AdminUserBalanceHistory 用户在某日日终的余额，取该日及之前最近的一次快照
+func (uuc *UserUseCase) AdminUserBalanceHistory(ctx context.Context, req *v1.AdminUserBalanceHistoryRequest) (*v1.AdminUserBalanceHistoryReply, error) {
+	var (
+		snapshot *BalanceSnapshot
+		err      error
+	)
+
+	if _, err = time.Parse("2006-01-02", req.Date); nil != err {
+		return nil, errors.New(500, "PARAM_ERROR", "日期格式错误")
+	}
+
+	snapshot, err = uuc.snapshotRepo.GetUserBalanceSnapshot(ctx, req.UserId, req.Date)
+	if nil != err {
+		return nil, err
+	}
+
+	return &v1.AdminUserBalanceHistoryReply{
+		Date:        snapshot.Date,
+		BalanceUsdt: fmt.Sprintf("%.2f", float64(snapshot.BalanceUsdt)/float64(10000000000)),
+		BalanceDhb:  fmt.Sprintf("%.2f", float64(snapshot.BalanceDhb)/float64(10000000000)),
+		BalanceBnb:  fmt.Sprintf("%.4f", snapshot.BnbAmount),
+	}, nil
+}
+
+// AdminLiabilityList 每日对用户负债的时间序列，负债为余额加提现中
+func (uuc *UserUseCase) AdminLiabilityList(ctx context.Context, req *v1.AdminLiabilityListRequest) (*v1.AdminLiabilityListReply, error) {
+	var (
+		totals []*BalanceSnapshotTotal
+		err    error
+	)
+
+	res := &v1.AdminLiabilityListReply{
+		Liabilities: make([]*v1.AdminLiabilityListReply_List, 0),
+	}
+
+	if _, err = time.Parse("2006-01-02", req.Start); nil != err {
+		return nil, errors.New(500, "PARAM_ERROR", "开始日期格式错误")
+	}
+	if _, err = time.Parse("2006-01-02", req.End); nil != err {
+		return nil, errors.New(500, "PARAM_ERROR", "结束日期格式错误")
+	}
+
+	totals, err = uuc.snapshotRepo.GetBalanceSnapshotTotals(ctx, req.Start, req.End)
+	if nil != err {
+		return res, err
+	}
+
+	for _, v := range totals {
+		res.Liabilities = append(res.Liabilities, &v1.AdminLiabilityListReply_List{
+			Date:            v.Date,
+			UserCount:       v.UserCount,
+			BalanceUsdt:     fmt.Sprintf("%.2f", float64(v.BalanceUsdt)/float64(10000000000)),
+			BalanceDhb:      fmt.Sprintf("%.2f", float64(v.BalanceDhb)/float64(10000000000)),
+			BalanceBnb:      fmt.Sprintf("%.4f", v.BnbAmount),
+			WithdrawingUsdt: fmt.Sprintf("%.2f", float64(v.WithdrawingUsdt)/float64(10000000000)),
+			WithdrawingDhb:  fmt.Sprintf("%.2f", float64(v.WithdrawingDhb)/float64(10000000000)),
+			LiabilityUsdt:   fmt.Sprintf("%.2f", float64(v.BalanceUsdt+v.WithdrawingUsdt)/float64(10000000000)),
+			LiabilityDhb:    fmt.Sprintf("%.2f", float64(v.BalanceDhb+v.WithdrawingDhb)/float64(10000000000)),
+			CreatedAt:       v.CreatedAt.Add(8 * time.Hour).Format("2006-01-02 15:04:05"),
+		})
+	}
+
+	return res, nil
+}
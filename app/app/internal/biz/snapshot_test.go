@@ -0,0 +1,91 @@
+package biz
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	v1 "dhb/app/app/api"
+	"github.com/go-kratos/kratos/v2/errors"
+)
+
+// testSnapshotRepo 记录每次写快照的日期
+type testSnapshotRepo struct {
+	BalanceSnapshotRepo
+	dates map[string]int
+}
+
+func (r *testSnapshotRepo) GetBalanceSnapshotTotals(ctx context.Context, start string, end string) ([]*BalanceSnapshotTotal, error) {
+	res := make([]*BalanceSnapshotTotal, 0)
+	for date := range r.dates {
+		if date >= start && date <= end {
+			res = append(res, &BalanceSnapshotTotal{Date: date})
+		}
+	}
+	return res, nil
+}
+
+func (r *testSnapshotRepo) CreateBalanceSnapshot(ctx context.Context, date string) (*BalanceSnapshotTotal, error) {
+	r.dates[date]++
+	return &BalanceSnapshotTotal{Date: date, UserCount: 1}, nil
+}
+
+func TestAdminBalanceSnapshot(t *testing.T) {
+	// 2023-03-10 15:00 UTC，最近一次日结是 03-10
+	now := time.Date(2023, 3, 10, 15, 0, 0, 0, time.UTC)
+	// 2023-03-11 13:00 UTC，当天还未日结，最近一次仍是 03-10
+	beforeCutoff := time.Date(2023, 3, 11, 13, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name     string
+		now      time.Time
+		existing []string
+		req      *v1.AdminBalanceSnapshotRequest
+		reason   string
+		written  string
+	}{
+		{"default date", now, nil, &v1.AdminBalanceSnapshotRequest{}, "", "2023-03-10"},
+		{"default before cutoff", beforeCutoff, nil, &v1.AdminBalanceSnapshotRequest{}, "", "2023-03-10"},
+		{"latest cutoff", now, nil, &v1.AdminBalanceSnapshotRequest{Date: "2023-03-10"}, "", "2023-03-10"},
+		// 历史日期按当前余额记会得到错误的数据
+		{"backfill", now, nil, &v1.AdminBalanceSnapshotRequest{Date: "2023-03-09"}, "PARAM_ERROR", ""},
+		{"backfill with force", now, []string{"2023-02-28"}, &v1.AdminBalanceSnapshotRequest{Date: "2023-02-28", Force: true}, "PARAM_ERROR", ""},
+		{"not yet cut off", beforeCutoff, nil, &v1.AdminBalanceSnapshotRequest{Date: "2023-03-11"}, "PARAM_ERROR", ""},
+		{"bad date", now, nil, &v1.AdminBalanceSnapshotRequest{Date: "2023/03/10"}, "PARAM_ERROR", ""},
+		{"exists", now, []string{"2023-03-10"}, &v1.AdminBalanceSnapshotRequest{}, "BALANCE_SNAPSHOT_EXIST", ""},
+		{"exists with force", now, []string{"2023-03-10"}, &v1.AdminBalanceSnapshotRequest{Force: true}, "", "2023-03-10"},
+	}
+
+	for _, tt := range tests {
+		snapshotRepo := &testSnapshotRepo{dates: make(map[string]int)}
+		for _, date := range tt.existing {
+			snapshotRepo.dates[date] = 1
+		}
+		uuc, _ := newTestUserUseCase(newTestUbRepo())
+		uuc.snapshotRepo = snapshotRepo
+
+		res, err := uuc.AdminBalanceSnapshot(withReplayTime(context.Background(), tt.now), tt.req)
+		if "" != tt.reason {
+			if tt.reason != errors.Reason(err) {
+				t.Errorf("%s: err = %v, want %s", tt.name, err, tt.reason)
+			}
+			for _, date := range tt.existing {
+				if 1 != snapshotRepo.dates[date] {
+					t.Errorf("%s: snapshot %s overwritten", tt.name, date)
+				}
+			}
+			continue
+		}
+
+		if nil != err {
+			t.Errorf("%s: %v", tt.name, err)
+			continue
+		}
+		if tt.written != res.Date {
+			t.Errorf("%s: date = %s, want %s", tt.name, res.Date, tt.written)
+		}
+		if 0 == snapshotRepo.dates[tt.written] {
+			t.Errorf("%s: snapshot %s not written", tt.name, tt.written)
+		}
+	}
+}
@@ -0,0 +1,174 @@
+package biz
+
+import (
+	"context"
+	v1 "dhb/app/app/api"
+	"fmt"
+	"strconv"
+	"time"
+)
+
+// Solvency 偿付能力，负债为占位未分完额度、提现中和用户余额，Wallet 为热钱包链上余额
+type Solvency struct {
+	ID                  int64
+	LocationOutstanding int64
+	WithdrawingUsdt     int64
+	WithdrawingDhb      int64
+	BalanceUsdt         int64
+	BalanceDhb          int64
+	WalletUsdt          int64
+	WalletDhb           int64
+	RatioUsdt           int64 // 覆盖率，百分比
+	RatioDhb            int64
+	Alarm               bool
+	CreatedAt           time.Time
+}
+
+type SolvencyRepo interface {
+	GetLocationOutstanding(ctx context.Context) (int64, error)
+	GetWithdrawingTotal(ctx context.Context, coinType string) (int64, error)
+	CreateSolvency(ctx context.Context, s *Solvency) (*Solvency, error)
+	GetSolvencies(ctx context.Context, start time.Time, end time.Time) ([]*Solvency, error)
+}
+
+// solvencyRatio 覆盖率百分比，没有负债时按 -1 表示无穷
+func solvencyRatio(asset int64, liability int64) int64 {
+	if 0 >= liability {
+		return -1
+	}
+
+	return asset * 100 / liability
+}
+
+func formatSolvencyRatio(ratio int64) string {
+	if 0 > ratio {
+		return "-"
+	}
+
+	return strconv.FormatInt(ratio, 10) + "%"
+}
+
+// getSolvencyAlarmRatio 覆盖率告警阈值，配置项 solvency_alarm_ratio（百分比），默认100
+func (uuc *UserUseCase) getSolvencyAlarmRatio(ctx context.Context) int64 {
+	var alarmRatio int64 = 100
+	configs, _ := uuc.configRepo.GetConfigByKeys(ctx, "solvency_alarm_ratio")
+	for _, vConfig := range configs {
+		if "solvency_alarm_ratio" == vConfig.KeyName {
+			if tmp, err := strconv.ParseInt(vConfig.Value, 10, 64); nil == err && 0 < tmp {
+				alarmRatio = tmp
+			}
+		}
+	}
+
+	return alarmRatio
+}
+
+// GetSolvency 按当前数据和热钱包余额计算偿付能力，覆盖率低于阈值时告警
+func (uuc *UserUseCase) GetSolvency(ctx context.Context, walletUsdt int64, walletDhb int64) (*Solvency, int64, error) {
+	var err error
+
+	s := &Solvency{WalletUsdt: walletUsdt, WalletDhb: walletDhb}
+
+	s.LocationOutstanding, err = uuc.solvencyRepo.GetLocationOutstanding(ctx)
+	if nil != err {
+		return nil, 0, err
+	}
+	s.WithdrawingUsdt, err = uuc.solvencyRepo.GetWithdrawingTotal(ctx, "usdt")
+	if nil != err {
+		return nil, 0, err
+	}
+	s.WithdrawingDhb, err = uuc.solvencyRepo.GetWithdrawingTotal(ctx, "dhb")
+	if nil != err {
+		return nil, 0, err
+	}
+	s.BalanceUsdt, err = uuc.ubRepo.GetUserBalanceUsdtTotal(ctx)
+	if nil != err {
+		return nil, 0, err
+	}
+	s.BalanceDhb, err = uuc.ubRepo.GetUserBalanceBnb4Total(ctx)
+	if nil != err {
+		return nil, 0, err
+	}
+
+	s.RatioUsdt = solvencyRatio(s.WalletUsdt, s.LocationOutstanding+s.WithdrawingUsdt+s.BalanceUsdt)
+	s.RatioDhb = solvencyRatio(s.WalletDhb, s.WithdrawingDhb+s.BalanceDhb)
+
+	alarmRatio := uuc.getSolvencyAlarmRatio(ctx)
+	if (0 <= s.RatioUsdt && alarmRatio > s.RatioUsdt) || (0 <= s.RatioDhb && alarmRatio > s.RatioDhb) {
+		s.Alarm = true // 只写日志和记录，没有外部通知，需监控日志或查历史
+		uuc.log.Warnf("solvency alarm: usdt ratio %d%%, dhb ratio %d%%, alarm ratio %d%%", s.RatioUsdt, s.RatioDhb, alarmRatio)
+	}
+
+	return s, alarmRatio, nil
+}
+
+// SolvencyCheck 定时检查并记录偿付能力，用于历史曲线，只读汇总不占锁，
+// 和入单同时执行时数据可能差一笔
+func (uuc *UserUseCase) SolvencyCheck(ctx context.Context, walletUsdt int64, walletDhb int64) (*Solvency, error) {
+	s, _, err := uuc.GetSolvency(ctx, walletUsdt, walletDhb)
+	if nil != err {
+		return nil, err
+	}
+
+	return uuc.solvencyRepo.CreateSolvency(ctx, s)
+}
+
+func solvencyReply(s *Solvency) *v1.AdminSolvencyListReply_List {
+	return &v1.AdminSolvencyListReply_List{
+		LocationOutstanding: fmt.Sprintf("%.2f", float64(s.LocationOutstanding)/float64(10000000000)),
+		WithdrawingUsdt:     fmt.Sprintf("%.2f", float64(s.WithdrawingUsdt)/float64(10000000000)),
+		WithdrawingDhb:      fmt.Sprintf("%.2f", float64(s.WithdrawingDhb)/float64(10000000000)),
+		BalanceUsdt:         fmt.Sprintf("%.2f", float64(s.BalanceUsdt)/float64(10000000000)),
+		BalanceDhb:          fmt.Sprintf("%.2f", float64(s.BalanceDhb)/float64(10000000000)),
+		LiabilityUsdt:       fmt.Sprintf("%.2f", float64(s.LocationOutstanding+s.WithdrawingUsdt+s.BalanceUsdt)/float64(10000000000)),
+		LiabilityDhb:        fmt.Sprintf("%.2f", float64(s.WithdrawingDhb+s.BalanceDhb)/float64(10000000000)),
+		WalletUsdt:          fmt.Sprintf("%.2f", float64(s.WalletUsdt)/float64(10000000000)),
+		WalletDhb:           fmt.Sprintf("%.2f", float64(s.WalletDhb)/float64(10000000000)),
+		RatioUsdt:           formatSolvencyRatio(s.RatioUsdt),
+		RatioDhb:            formatSolvencyRatio(s.RatioDhb),
+		Alarm:               s.Alarm,
+		CreatedAt:           s.CreatedAt.Add(8 * time.Hour).Format("2006-01-02 15:04:05"),
+	}
+}
+
+// AdminSolvency 实时偿付能力
+func (uuc *UserUseCase) AdminSolvency(ctx context.Context, walletUsdt int64, walletDhb int64) (*v1.AdminSolvencyReply, error) {
+	s, alarmRatio, err := uuc.GetSolvency(ctx, walletUsdt, walletDhb)
+	if nil != err {
+		return nil, err
+	}
+	s.CreatedAt = time.Now().UTC()
+
+	return &v1.AdminSolvencyReply{
+		Solvency:   solvencyReply(s),
+		AlarmRatio: strconv.FormatInt(alarmRatio, 10) + "%",
+	}, nil
+}
+
+// AdminSolvencyList 偿付能力历史
+func (uuc *UserUseCase) AdminSolvencyList(ctx context.Context, req *v1.AdminSolvencyListRequest) (*v1.AdminSolvencyListReply, error) {
+	var (
+		solvencies []*Solvency
+		err        error
+	)
+
+	res := &v1.AdminSolvencyListReply{
+		Solvencies: make([]*v1.AdminSolvencyListReply_List, 0),
+	}
+
+	start, end, err := parseDateRange(req.Start, req.End)
+	if nil != err {
+		return nil, err
+	}
+
+	solvencies, err = uuc.solvencyRepo.GetSolvencies(ctx, start, end)
+	if nil != err {
+		return res, err
+	}
+
+	for _, v := range solvencies {
+		res.Solvencies = append(res.Solvencies, solvencyReply(v))
+	}
+
+	return res, nil
+}
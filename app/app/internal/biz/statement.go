@@ -0,0 +1,299 @@
+package biz
+
+import (
+	"bytes"
+	"context"
+	v1 "dhb/app/app/api"
+	"encoding/csv"
+	"fmt"
+	"github.com/go-kratos/kratos/v2/errors"
+	"sort"
+	"strconv"
+	"time"
+)
+
+// UserBalanceRecord 余额变动记录，Balance 为变动后该币种余额
+type UserBalanceRecord struct {
+	ID        int64
+	UserId    int64
+	Balance   int64
+	Amount    int64
+	Type      string
+	CoinType  string
+	Reason    string
+	Remark    string
+	CreatedAt time.Time
+}
+
+type StatementRepo interface {
+	GetUserBalanceRecordsByTime(ctx context.Context, userId int64, start time.Time, end time.Time) ([]*UserBalanceRecord, error)
+	GetUserBalanceRecordLast(ctx context.Context, userId int64, coinTypes []string, before time.Time) (*UserBalanceRecord, error)
+	GetUserRewardsByTime(ctx context.Context, userId int64, start time.Time, end time.Time) ([]*Reward, error)
+	GetUserWithdrawsByTime(ctx context.Context, userId int64, start time.Time, end time.Time) ([]*Withdraw, error)
+	GetUserEthRecordsByTime(ctx context.Context, userId int64, start time.Time, end time.Time) ([]*EthUserRecord, error)
+}
+
+// StatementLine 对账单一行，Change 为对余额的影响，Balance 为该行之后的余额
+type StatementLine struct {
+	Time        time.Time
+	Source      string // balance_record，reward，withdraw，eth_user_record
+	RecordId    int64
+	Type        string
+	CoinType    string
+	Amount      int64
+	Change      int64
+	BalanceUsdt int64
+	BalanceDhb  int64
+	BalanceBnb  int64
+	Detail      string
+}
+
+// parseDateRange 北京时间日期，包含结束日期当天，返回 [start, end) 的UTC时间
+func parseDateRange(startDate string, endDate string) (time.Time, time.Time, error) {
+	start, err := time.Parse("2006-01-02", startDate)
+	if nil != err {
+		return start, start, errors.New(500, "PARAM_ERROR", "开始日期格式错误")
+	}
+	end, err := time.Parse("2006-01-02", endDate)
+	if nil != err {
+		return start, end, errors.New(500, "PARAM_ERROR", "结束日期格式错误")
+	}
+
+	start = start.Add(-8 * time.Hour)
+	end = end.Add(16 * time.Hour)
+	if !start.Before(end) {
+		return start, end, errors.New(500, "PARAM_ERROR", "日期范围错误")
+	}
+
+	return start, end, nil
+}
+
+// statementCoinType 分红记录未写币种按usdt算
+func statementCoinType(coinType string) string {
+	if "" == coinType {
+		return "usdt"
+	}
+
+	return coinType
+}
+
+// statementChange 余额记录对余额的影响，提现和复投记录金额为正，usdt入金只占位不计余额
+func statementChange(record *UserBalanceRecord) int64 {
+	if "withdraw" == record.Type || "reinvest" == record.Type {
+		return -record.Amount
+	} else if "deposit" == record.Type && "usdt" == record.CoinType {
+		return 0
+	}
+
+	return record.Amount
+}
+
+// UserStatement 用户对账单，以余额记录为主，分红和提现按 balance_record_id 合并，链上充值只列出不计余额
+func (uuc *UserUseCase) UserStatement(ctx context.Context, userId int64, start time.Time, end time.Time) ([]*StatementLine, error) {
+	var (
+		records    []*UserBalanceRecord
+		rewards    []*Reward
+		withdraws  []*Withdraw
+		ethRecords []*EthUserRecord
+		last       *UserBalanceRecord
+		err        error
+	)
+
+	res := make([]*StatementLine, 0)
+
+	// 期初余额取开始前最后一条记录
+	balances := make(map[string]int64, 0)
+	for coinType, coinTypes := range map[string][]string{"usdt": {"", "usdt"}, "dhb": {"dhb"}, "bnb": {"bnb"}} {
+		last, err = uuc.statementRepo.GetUserBalanceRecordLast(ctx, userId, coinTypes, start)
+		if nil != err {
+			return nil, err
+		}
+		if nil != last {
+			balances[coinType] = last.Balance
+		}
+	}
+	res = append(res, &StatementLine{
+		Time:        start,
+		Source:      "opening",
+		Type:        "opening",
+		BalanceUsdt: balances["usdt"],
+		BalanceDhb:  balances["dhb"],
+		BalanceBnb:  balances["bnb"],
+	})
+
+	records, err = uuc.statementRepo.GetUserBalanceRecordsByTime(ctx, userId, start, end)
+	if nil != err {
+		return nil, err
+	}
+	rewards, err = uuc.statementRepo.GetUserRewardsByTime(ctx, userId, start, end)
+	if nil != err {
+		return nil, err
+	}
+	withdraws, err = uuc.statementRepo.GetUserWithdrawsByTime(ctx, userId, start, end)
+	if nil != err {
+		return nil, err
+	}
+	ethRecords, err = uuc.statementRepo.GetUserEthRecordsByTime(ctx, userId, start, end)
+	if nil != err {
+		return nil, err
+	}
+
+	recordRewards := make(map[int64]*Reward, 0)
+	for _, v := range rewards {
+		if 0 < v.BalanceRecordId {
+			recordRewards[v.BalanceRecordId] = v
+		}
+	}
+	recordWithdraws := make(map[int64]*Withdraw, 0)
+	for _, v := range withdraws {
+		if 0 < v.BalanceRecordId {
+			recordWithdraws[v.BalanceRecordId] = v
+		}
+	}
+
+	lines := make([]*StatementLine, 0)
+	merged := make(map[string]bool, 0)
+	for _, v := range records {
+		line := &StatementLine{
+			Time:     v.CreatedAt,
+			Source:   "balance_record",
+			RecordId: v.ID,
+			Type:     v.Type,
+			CoinType: statementCoinType(v.CoinType),
+			Amount:   v.Amount,
+			Change:   statementChange(v),
+			Detail:   v.Reason,
+		}
+		if "" != v.Remark {
+			line.Detail = v.Reason + " " + v.Remark
+		}
+
+		if reward, ok := recordRewards[v.ID]; ok {
+			line.Detail = fmt.Sprintf("分红 %s/%s #%d", reward.Type, reward.Reason, reward.ID)
+			merged["reward"+strconv.FormatInt(reward.ID, 10)] = true
+		}
+		if withdraw, ok := recordWithdraws[v.ID]; ok {
+			line.Detail = fmt.Sprintf("提现 #%d %s", withdraw.ID, withdraw.Status)
+			merged["withdraw"+strconv.FormatInt(withdraw.ID, 10)] = true
+		}
+
+		lines = append(lines, line)
+	}
+
+	// 没有余额记录的分红和提现单列，不计余额
+	for _, v := range rewards {
+		if merged["reward"+strconv.FormatInt(v.ID, 10)] {
+			continue
+		}
+		lines = append(lines, &StatementLine{
+			Time:     v.CreatedAt,
+			Source:   "reward",
+			RecordId: v.ID,
+			Type:     v.Type,
+			CoinType: "usdt",
+			Amount:   v.Amount,
+			Detail:   v.Reason,
+		})
+	}
+	for _, v := range withdraws {
+		if merged["withdraw"+strconv.FormatInt(v.ID, 10)] {
+			continue
+		}
+		lines = append(lines, &StatementLine{
+			Time:     v.CreatedAt,
+			Source:   "withdraw",
+			RecordId: v.ID,
+			Type:     "withdraw",
+			CoinType: v.Type,
+			Amount:   v.Amount,
+			Detail:   v.Status,
+		})
+	}
+	for _, v := range ethRecords {
+		var amount int64
+		if 8 < len(v.Amount) { // 链上18位精度转10位
+			amount, _ = strconv.ParseInt(v.Amount[:len(v.Amount)-8], 10, 64)
+		}
+		lines = append(lines, &StatementLine{
+			Time:     v.CreatedAt,
+			Source:   "eth_user_record",
+			RecordId: v.ID,
+			Type:     v.Type,
+			CoinType: v.CoinType,
+			Amount:   amount,
+			Detail:   v.Hash,
+		})
+	}
+
+	sort.SliceStable(lines, func(i, j int) bool {
+		return lines[i].Time.Before(lines[j].Time)
+	})
+
+	// 按时间顺序重算每行之后的余额，有余额记录的行以记录里的余额为准
+	balances = map[string]int64{"usdt": res[0].BalanceUsdt, "dhb": res[0].BalanceDhb, "bnb": res[0].BalanceBnb}
+	recordBalances := make(map[int64]int64, 0)
+	for _, v := range records {
+		recordBalances[v.ID] = v.Balance
+	}
+	for _, line := range lines {
+		if "balance_record" == line.Source {
+			balances[line.CoinType] = recordBalances[line.RecordId]
+		}
+		line.BalanceUsdt = balances["usdt"]
+		line.BalanceDhb = balances["dhb"]
+		line.BalanceBnb = balances["bnb"]
+		res = append(res, line)
+	}
+
+	return res, nil
+}
+
+// AdminUserStatement 用户对账单导出，format 为 csv 时同时返回csv
+func (uuc *UserUseCase) AdminUserStatement(ctx context.Context, req *v1.AdminUserStatementRequest) (*v1.AdminUserStatementReply, error) {
+	var (
+		lines []*StatementLine
+		err   error
+	)
+
+	start, end, err := parseDateRange(req.Start, req.End)
+	if nil != err {
+		return nil, err
+	}
+
+	lines, err = uuc.UserStatement(ctx, req.UserId, start, end)
+	if nil != err {
+		return nil, err
+	}
+
+	res := &v1.AdminUserStatementReply{
+		Lines: make([]*v1.AdminUserStatementReply_List, 0),
+	}
+	for _, v := range lines {
+		res.Lines = append(res.Lines, &v1.AdminUserStatementReply_List{
+			CreatedAt:   v.Time.Add(8 * time.Hour).Format("2006-01-02 15:04:05"),
+			Source:      v.Source,
+			RecordId:    v.RecordId,
+			Type:        v.Type,
+			CoinType:    v.CoinType,
+			Amount:      fmt.Sprintf("%.2f", float64(v.Amount)/float64(10000000000)),
+			Change:      fmt.Sprintf("%.2f", float64(v.Change)/float64(10000000000)),
+			BalanceUsdt: fmt.Sprintf("%.2f", float64(v.BalanceUsdt)/float64(10000000000)),
+			BalanceDhb:  fmt.Sprintf("%.2f", float64(v.BalanceDhb)/float64(10000000000)),
+			BalanceBnb:  fmt.Sprintf("%.4f", float64(v.BalanceBnb)/float64(10000000000)),
+			Detail:      v.Detail,
+		})
+	}
+
+	if "csv" == req.Format {
+		var buf bytes.Buffer
+		w := csv.NewWriter(&buf)
+		_ = w.Write([]string{"created_at", "source", "record_id", "type", "coin_type", "amount", "change", "balance_usdt", "balance_dhb", "balance_bnb", "detail"})
+		for _, v := range res.Lines {
+			_ = w.Write([]string{v.CreatedAt, v.Source, strconv.FormatInt(v.RecordId, 10), v.Type, v.CoinType, v.Amount, v.Change, v.BalanceUsdt, v.BalanceDhb, v.BalanceBnb, v.Detail})
+		}
+		w.Flush()
+		res.Csv = buf.String()
+	}
+
+	return res, nil
+}
@@ -0,0 +1,336 @@
+package biz
+
+import (
+	"context"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// RewardStrategy 入单分配策略组件，按配置顺序依次执行，从 Remain 中扣除分出去的部分
+type RewardStrategy interface {
+	Name() string
+	Distribute(ctx context.Context, d *RewardDistribution) error
+}
+
+// RewardDistribution 单次入单的分配上下文
+type RewardDistribution struct {
+	UserId            int64
+	Location          *Location // 本次新占位
+	CurrentValue      int64
+	Remain            int64    // 未分配部分，最后进系统
+	RecommendUserIds  []string // 推荐链，最后一位是直推人
+	RecommendUserId   int64
+	RecommendUserInfo *UserInfo
+	RecommendLocation *Location // 直推人最新占位，直推和会员分红共用
+	RecommendNeedLast int64     // 已分出的会员比例，级差往上用
+	RecommendLevel    int64
+	Sim               *DepositSimulation
+}
+
+// defaultRewardStrategies 默认分配顺序：占位分红、直推、会员级差、团队区业绩
+var defaultRewardStrategies = []string{"location", "recommend", "vip", "area"}
+
+// addLocationCurrent 占位累加分红额度，分满停止，返回累加前的状态
+func addLocationCurrent(ctx context.Context, locationRepo LocationRepo, l *Location, amount int64) (string, error) {
+	status := l.Status // 现在还在运行中
+
+	l.Status = "running"
+	l.Current += amount
+	if l.Current >= l.CurrentMax { // 占位分红人分满停止
+		if "running" == status {
+			l.StopDate = bizNow(ctx).Add(8 * time.Hour)
+		}
+		l.Status = "stop"
+	}
+	if 0 < amount {
+		if err := locationRepo.UpdateLocation(ctx, l.ID, l.Status, amount, l.StopDate); nil != err { // 分红占位数据修改
+			return status, err
+		}
+	}
+
+	return status, nil
+}
+
+// locationRewardStrategy 占位分红，location_reward_mode 为 col 同列、row 同行、both 两者，
+// 比例分别是 location_col_reward、location_row_reward（百分比）
+type locationRewardStrategy struct {
+	locationRepo      LocationRepo
+	userBalanceRepo   UserBalanceRepo
+	mode              string
+	colPercent        int64
+	rowPercent        int64
+	locationRowConfig int64
+	width             int64
+}
+
+func (s *locationRewardStrategy) Name() string {
+	return "location"
+}
+
+func (s *locationRewardStrategy) Distribute(ctx context.Context, d *RewardDistribution) error {
+	rewardLocations, _ := s.locationRepo.GetRewardLocationByRowOrCol(ctx, d.Location.Row, d.Location.Col, s.locationRowConfig, s.width)
+	for _, vRewardLocations := range rewardLocations {
+		if "running" != vRewardLocations.Status {
+			continue
+		}
+		if d.Location.ID == vRewardLocations.ID { // 跳过自己
+			continue
+		}
+
+		var locationType string
+		var tmpAmount int64
+		if ("col" == s.mode || "both" == s.mode) && d.Location.Col == vRewardLocations.Col { // 同列的人
+			tmpAmount = d.CurrentValue / 100 * s.colPercent
+			locationType = "col"
+		} else if ("row" == s.mode || "both" == s.mode) && d.Location.Row == vRewardLocations.Row { // 同行的人
+			tmpAmount = d.CurrentValue / 100 * s.rowPercent
+			locationType = "row"
+		} else {
+			continue
+		}
+		if 0 >= tmpAmount {
+			continue
+		}
+
+		tmpStatus, err := addLocationCurrent(ctx, s.locationRepo, vRewardLocations, tmpAmount)
+		if nil != err {
+			return err
+		}
+		d.Remain -= tmpAmount // 扣除
+
+		_, err = s.userBalanceRepo.LocationReward(ctx, vRewardLocations.UserId, tmpAmount, d.Location.ID, vRewardLocations.ID, locationType, tmpStatus) // 分红信息修改
+		if nil != err {
+			return err
+		}
+		d.Sim.addReward(vRewardLocations.UserId, vRewardLocations.ID, locationType, tmpAmount, tmpStatus, vRewardLocations.Status)
+	}
+
+	return nil
+}
+
+// recommendRewardStrategy 直推人奖励，配置项 recommend_need（百分比）
+type recommendRewardStrategy struct {
+	locationRepo    LocationRepo
+	userBalanceRepo UserBalanceRepo
+	percent         int64
+}
+
+func (s *recommendRewardStrategy) Name() string {
+	return "recommend"
+}
+
+func (s *recommendRewardStrategy) Distribute(ctx context.Context, d *RewardDistribution) error {
+	if nil == d.RecommendLocation {
+		return nil
+	}
+
+	tmpAmount := d.CurrentValue / 100 * s.percent
+	tmpStatus, err := addLocationCurrent(ctx, s.locationRepo, d.RecommendLocation, tmpAmount)
+	if nil != err {
+		return err
+	}
+	d.Remain -= tmpAmount // 扣除
+
+	if 0 < tmpAmount { // 这次还能分红
+		_, err = s.userBalanceRepo.NormalRecommendReward(ctx, d.RecommendUserId, tmpAmount, d.Location.ID, tmpStatus) // 直推人奖励
+		if nil != err {
+			return err
+		}
+		d.Sim.addReward(d.RecommendUserId, d.RecommendLocation.ID, "recommend", tmpAmount, tmpStatus, d.RecommendLocation.Status)
+	}
+
+	return nil
+}
+
+// vipRewardStrategy 会员等级分红，直推人按自身等级，往上按级差，配置项 recommend_need_vip1-5（百分比）
+type vipRewardStrategy struct {
+	locationRepo    LocationRepo
+	userBalanceRepo UserBalanceRepo
+	userInfoRepo    UserInfoRepo
+	percents        map[int64]int64
+}
+
+func (s *vipRewardStrategy) Name() string {
+	return "vip"
+}
+
+func (s *vipRewardStrategy) Distribute(ctx context.Context, d *RewardDistribution) error {
+	if nil == d.RecommendUserInfo || nil == d.RecommendLocation {
+		return nil
+	}
+
+	// 直推人会员等级分红
+	if percent, ok := s.percents[d.RecommendUserInfo.Vip]; ok {
+		d.RecommendNeedLast = percent
+		d.RecommendLevel = d.RecommendUserInfo.Vip
+
+		tmpAmount := d.CurrentValue / 100 * percent
+		if 0 < tmpAmount { // 扣除推荐人分红
+			tmpStatus, err := addLocationCurrent(ctx, s.locationRepo, d.RecommendLocation, tmpAmount)
+			if nil != err {
+				return err
+			}
+			d.Remain -= tmpAmount // 扣除
+
+			_, err = s.userBalanceRepo.RecommendReward(ctx, d.RecommendUserId, tmpAmount, d.Location.ID, tmpStatus) // 推荐人奖励
+			if nil != err {
+				return err
+			}
+			d.Sim.addReward(d.RecommendUserId, d.RecommendLocation.ID, "recommend_vip", tmpAmount, tmpStatus, d.RecommendLocation.Status)
+		}
+	}
+
+	// 推荐人的推荐信息，往上找，级差
+	for i := 2; i <= len(d.RecommendUserIds)-1; i++ {
+		tmpMyTopUserRecommendUserId, _ := strconv.ParseInt(d.RecommendUserIds[len(d.RecommendUserIds)-i], 10, 64)
+		if 0 >= tmpMyTopUserRecommendUserId || 0 >= 10-d.RecommendNeedLast {
+			break
+		}
+
+		myUserTopRecommendUserInfo, _ := s.userInfoRepo.GetUserInfoByUserId(ctx, tmpMyTopUserRecommendUserId)
+		if nil == myUserTopRecommendUserInfo {
+			continue
+		}
+		if d.RecommendLevel >= myUserTopRecommendUserInfo.Vip {
+			continue
+		}
+
+		tmpMyTopUserRecommendUserLocationLast, _ := s.locationRepo.GetMyLocationLast(ctx, tmpMyTopUserRecommendUserId)
+		if nil == tmpMyTopUserRecommendUserLocationLast {
+			continue
+		}
+
+		percent, ok := s.percents[myUserTopRecommendUserInfo.Vip]
+		if !ok {
+			continue
+		}
+		tmpAmount := d.CurrentValue / 100 * (percent - d.RecommendNeedLast)
+		d.RecommendNeedLast = percent
+		d.RecommendLevel = myUserTopRecommendUserInfo.Vip
+
+		if 0 < tmpAmount { // 扣除推荐人分红
+			tmpStatus, err := addLocationCurrent(ctx, s.locationRepo, tmpMyTopUserRecommendUserLocationLast, tmpAmount)
+			if nil != err {
+				return err
+			}
+			d.Remain -= tmpAmount // 扣除
+
+			_, err = s.userBalanceRepo.RecommendTopReward(ctx, tmpMyTopUserRecommendUserId, tmpAmount, d.Location.ID, d.RecommendLevel, tmpStatus) // 推荐人奖励
+			if nil != err {
+				return err
+			}
+			d.Sim.addReward(tmpMyTopUserRecommendUserId, tmpMyTopUserRecommendUserLocationLast.ID, "recommend_top", tmpAmount, tmpStatus, tmpMyTopUserRecommendUserLocationLast.Status)
+		}
+	}
+
+	return nil
+}
+
+// areaStrategy 团队区业绩，自身和整条推荐链累加本次入单金额（U），
+// 大小区等级和每日区域分红都按这个算，不从 Remain 扣
+type areaStrategy struct {
+	userRecommendRepo UserRecommendRepo
+}
+
+func (s *areaStrategy) Name() string {
+	return "area"
+}
+
+func (s *areaStrategy) Distribute(ctx context.Context, d *RewardDistribution) error {
+	_, err := s.userRecommendRepo.UpdateUserAreaSelfAmount(ctx, d.UserId, d.CurrentValue/10000000000)
+	if nil != err {
+		return err
+	}
+	for _, vTmpRecommendUserIds := range d.RecommendUserIds {
+		vTmpRecommendUserId, _ := strconv.ParseInt(vTmpRecommendUserIds, 10, 64)
+		if vTmpRecommendUserId > 0 {
+			_, err = s.userRecommendRepo.UpdateUserAreaAmount(ctx, vTmpRecommendUserId, d.CurrentValue/10000000000)
+			if nil != err {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// getRewardStrategies 分配策略，配置项 reward_strategies 逗号分隔，未配置时按默认顺序，
+// 团队区业绩是统计数据，旧配置里没有 area 时补在最后
+func (ruc *RecordUseCase) getRewardStrategies(c *depositConfig) []RewardStrategy {
+	names := defaultRewardStrategies
+	if "" != c.rewardStrategies {
+		names = strings.Split(c.rewardStrategies, ",")
+	}
+
+	area := false
+	res := make([]RewardStrategy, 0, len(names)+1)
+	for _, name := range names {
+		switch strings.TrimSpace(name) {
+		case "location", "col": // col 为旧配置
+			res = append(res, &locationRewardStrategy{
+				locationRepo:      ruc.locationRepo,
+				userBalanceRepo:   ruc.userBalanceRepo,
+				mode:              c.rewardMode,
+				colPercent:        c.colReward,
+				rowPercent:        c.rowReward,
+				locationRowConfig: c.locationRowConfig,
+				width:             c.width,
+			})
+		case "recommend":
+			res = append(res, &recommendRewardStrategy{
+				locationRepo:    ruc.locationRepo,
+				userBalanceRepo: ruc.userBalanceRepo,
+				percent:         c.recommendNeed,
+			})
+		case "vip":
+			res = append(res, &vipRewardStrategy{
+				locationRepo:    ruc.locationRepo,
+				userBalanceRepo: ruc.userBalanceRepo,
+				userInfoRepo:    ruc.userInfoRepo,
+				percents: map[int64]int64{
+					1: c.recommendNeedVip1,
+					2: c.recommendNeedVip2,
+					3: c.recommendNeedVip3,
+					4: c.recommendNeedVip4,
+					5: c.recommendNeedVip5,
+				},
+			})
+		case "area":
+			if area {
+				continue
+			}
+			area = true
+			res = append(res, &areaStrategy{userRecommendRepo: ruc.userRecommendRepo})
+		default:
+			ruc.log.Warnf("unknown reward strategy: %s", name)
+		}
+	}
+	if !area {
+		res = append(res, &areaStrategy{userRecommendRepo: ruc.userRecommendRepo})
+	}
+
+	return res
+}
+
+// distributeRewards 按策略顺序分配本次入单，剩余进系统，需在事务内调用
+func (ruc *RecordUseCase) distributeRewards(ctx context.Context, d *RewardDistribution, c *depositConfig) error {
+	if nil != d.RecommendUserInfo {
+		d.RecommendLocation, _ = ruc.locationRepo.GetMyLocationLast(ctx, d.RecommendUserInfo.UserId) // 有占位信息，推荐人第一代
+	}
+
+	for _, s := range ruc.getRewardStrategies(c) {
+		if err := s.Distribute(ctx, d); nil != err {
+			return err
+		}
+	}
+
+	if err := ruc.userBalanceRepo.SystemReward(ctx, d.Remain, d.Location.ID); nil != err {
+		return err
+	}
+	if nil != d.Sim {
+		d.Sim.SystemReward = d.Remain
+	}
+
+	return nil
+}
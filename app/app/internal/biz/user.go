@@ -5,6 +5,7 @@ import (
 	"crypto/md5"
 	v1 "dhb/app/app/api"
 	"dhb/app/app/internal/pkg/middleware/auth"
+	"dhb/app/app/internal/pkg/middleware/idempotency"
 	"encoding/base64"
 	"fmt"
 	"github.com/go-kratos/kratos/v2/errors"
@@ -19,6 +20,7 @@ import (
 type User struct {
 	ID        int64
 	Address   string
+	Undo      int64
 	CreatedAt time.Time
 }
 
@@ -59,8 +61,10 @@ type Auth struct {
 type UserInfo struct {
 	ID               int64
 	UserId           int64
-	Vip              int64
+	Vip              int64 // 生效等级
 	HistoryRecommend int64
+	VipCompute       int64 // 规则计算的等级
+	VipAdmin         int64 // 后台设置的等级，-1为未设置
 }
 
 type UserRecommendArea struct {
@@ -106,7 +110,9 @@ type Withdraw struct {
 	BalanceRecordId int64
 	Status          string
 	Type            string
+	Reason          string
 	CreatedAt       time.Time
+	UpdatedAt       time.Time
 }
 
 type UserUseCase struct {
@@ -117,6 +123,15 @@ type UserUseCase struct {
 	ubRepo                        UserBalanceRepo
 	locationRepo                  LocationRepo
 	userCurrentMonthRecommendRepo UserCurrentMonthRecommendRepo
+	auditRepo                     AdminAuditLogRepo
+	proposalRepo                  AdminProposalRepo
+	ledgerRepo                    LedgerRepo
+	reconcileRepo                 ReconcileRepo
+	snapshotRepo                  BalanceSnapshotRepo
+	statementRepo                 StatementRepo
+	solvencyRepo                  SolvencyRepo
+	provenanceRepo                ProvenanceRepo
+	lockRepo                      LockRepo
 	tx                            Transaction
 	log                           *log.Helper
 }
@@ -183,6 +198,7 @@ type UserBalanceRepo interface {
 	NormalWithdrawRecommendTopReward(ctx context.Context, userId int64, amount int64, locationId int64, reasonId int64, status string) (int64, error)
 	Deposit(ctx context.Context, userId int64, amount int64, dhbAmount int64) (int64, error)
 	DepositLast(ctx context.Context, userId int64, lastAmount int64, locationId int64) (int64, error)
+	Reinvest(ctx context.Context, userId int64, amount int64, dhbAmount int64) (int64, error)
 	DepositDhb(ctx context.Context, userId int64, amount int64) (int64, error)
 	GetUserBalance(ctx context.Context, userId int64) (*UserBalance, error)
 	GetUserRewardByUserId(ctx context.Context, userId int64) ([]*Reward, error)
@@ -202,8 +218,8 @@ type UserBalanceRepo interface {
 	GetWithdraws(ctx context.Context, b *Pagination, userId int64, typeWithdraw string) ([]*Withdraw, error, int64)
 	GetWithdrawPassOrRewarded(ctx context.Context) ([]*Withdraw, error)
 	GetWithdrawPassOrRewardedFirst(ctx context.Context) (*Withdraw, error)
-	UpdateWithdraw(ctx context.Context, id int64, status string) (*Withdraw, error)
-	UpdateWithdrawDoingToRewarded(ctx context.Context) error
+	GetWithdrawDoingTimeout(ctx context.Context) ([]*Withdraw, error)
+	GetWithdrawSuccessByTime(ctx context.Context, start time.Time, end time.Time) ([]*Withdraw, error)
 	GetWithdrawById(ctx context.Context, id int64) (*Withdraw, error)
 	GetWithdrawNotDeal(ctx context.Context) ([]*Withdraw, error)
 	GetUserBalanceRecordUsdtTotal(ctx context.Context) (int64, error)
@@ -216,9 +232,22 @@ type UserBalanceRepo interface {
 	GetUserWithdrawBnb4Total(ctx context.Context) (int64, error)
 	GetUserRewardUsdtTotal(ctx context.Context) (int64, error)
 	GetSystemRewardUsdtTotal(ctx context.Context) (int64, error)
-	UpdateWithdrawAmount(ctx context.Context, id int64, status string, amount int64) (*Withdraw, error)
+	UpdateWithdrawRelAmount(ctx context.Context, id int64, status string, amount int64) (bool, error)
 	GetUserRewardRecommendSort(ctx context.Context) ([]*UserSortRecommendReward, error)
-	UpdateBalance(ctx context.Context, userId int64, amount int64) (bool, error)
+	AdjustBalance(ctx context.Context, userId int64, coinType string, amount int64, reason string, remark string) (int64, error)
+	GetUserWithdrawToday(ctx context.Context, userId int64, coinType string) (int64, int64, error)
+	GetWithdrawTotalToday(ctx context.Context, coinType string) (int64, error)
+	LockUserBalance(ctx context.Context, userId int64) error
+	LockWithdrawDaily(ctx context.Context, coinType string) error
+	UpdateWithdrawStatus(ctx context.Context, id int64, fromStatus string, toStatus string, reason string) (bool, error)
+	CreateWithdrawEvent(ctx context.Context, e *WithdrawEvent) (*WithdrawEvent, error)
+	GetWithdrawEvents(ctx context.Context, withdrawId int64) ([]*WithdrawEvent, error)
+	CreateWithdrawReview(ctx context.Context, r *WithdrawReview) (*WithdrawReview, error)
+	UpdateWithdrawReview(ctx context.Context, withdrawId int64, status string, adminId int64, reason string) error
+	GetWithdrawReviews(ctx context.Context, b *Pagination, status string) ([]*WithdrawReview, error, int64)
+	WithdrawRefund(ctx context.Context, userId int64, amount int64, coinType string) (int64, error)
+	WithdrawRewardedReverse(ctx context.Context, withdrawId int64, fee int64, pool int64) error
+	GetUserDepositUsdtTotal(ctx context.Context, userId int64) (int64, error)
 }
 
 type UserRecommendRepo interface {
@@ -276,7 +305,7 @@ type UserRepo interface {
 	UpdateAdminPassword(ctx context.Context, account string, password string) (*Admin, error)
 }
 
-func NewUserUseCase(repo UserRepo, tx Transaction, configRepo ConfigRepo, uiRepo UserInfoRepo, urRepo UserRecommendRepo, locationRepo LocationRepo, userCurrentMonthRecommendRepo UserCurrentMonthRecommendRepo, ubRepo UserBalanceRepo, logger log.Logger) *UserUseCase {
+func NewUserUseCase(repo UserRepo, tx Transaction, configRepo ConfigRepo, uiRepo UserInfoRepo, urRepo UserRecommendRepo, locationRepo LocationRepo, userCurrentMonthRecommendRepo UserCurrentMonthRecommendRepo, ubRepo UserBalanceRepo, auditRepo AdminAuditLogRepo, proposalRepo AdminProposalRepo, ledgerRepo LedgerRepo, reconcileRepo ReconcileRepo, snapshotRepo BalanceSnapshotRepo, statementRepo StatementRepo, solvencyRepo SolvencyRepo, provenanceRepo ProvenanceRepo, lockRepo LockRepo, logger log.Logger) *UserUseCase {
 	return &UserUseCase{
 		repo:                          repo,
 		tx:                            tx,
@@ -286,6 +315,15 @@ func NewUserUseCase(repo UserRepo, tx Transaction, configRepo ConfigRepo, uiRepo
 		uiRepo:                        uiRepo,
 		urRepo:                        urRepo,
 		ubRepo:                        ubRepo,
+		auditRepo:                     auditRepo,
+		proposalRepo:                  proposalRepo,
+		ledgerRepo:                    ledgerRepo,
+		reconcileRepo:                 reconcileRepo,
+		snapshotRepo:                  snapshotRepo,
+		statementRepo:                 statementRepo,
+		solvencyRepo:                  solvencyRepo,
+		provenanceRepo:                provenanceRepo,
+		lockRepo:                      lockRepo,
 		log:                           log.NewHelper(logger),
 	}
 }
@@ -405,6 +443,7 @@ func (uuc *UserUseCase) RewardList(ctx context.Context, req *v1.RewardListReques
 				}
 
 				res.Rewards = append(res.Rewards, &v1.RewardListReply_List{
+					Id:             vUserReward.ID,
 					CreatedAt:      vUserReward.CreatedAt.Add(8 * time.Hour).Format("2006-01-02 15:04:05"),
 					Amount:         fmt.Sprintf("%.2f", float64(vUserReward.Amount)/float64(10000000000)),
 					LocationStatus: locations[vUserReward.ReasonLocationId].Status,
@@ -434,6 +473,7 @@ func (uuc *UserUseCase) RecommendRewardList(ctx context.Context, user *User) (*v
 	for _, vUserReward := range userRewards {
 		if "recommend" == vUserReward.Reason || "recommend_vip" == vUserReward.Reason {
 			res.Rewards = append(res.Rewards, &v1.RecommendRewardListReply_List{
+				Id:        vUserReward.ID,
 				CreatedAt: vUserReward.CreatedAt.Add(8 * time.Hour).Format("2006-01-02 15:04:05"),
 				Amount:    fmt.Sprintf("%.2f", float64(vUserReward.Amount)/float64(10000000000)),
 				Type:      vUserReward.Type,
@@ -462,6 +502,7 @@ func (uuc *UserUseCase) FeeRewardList(ctx context.Context, user *User) (*v1.FeeR
 	for _, vUserReward := range userRewards {
 		if "fee" == vUserReward.Reason {
 			res.Rewards = append(res.Rewards, &v1.FeeRewardListReply_List{
+				Id:        vUserReward.ID,
 				CreatedAt: vUserReward.CreatedAt.Add(8 * time.Hour).Format("2006-01-02 15:04:05"),
 				Amount:    fmt.Sprintf("%.2f", float64(vUserReward.Amount)/float64(10000000000)),
 			})
@@ -493,6 +534,7 @@ func (uuc *UserUseCase) WithdrawList(ctx context.Context, user *User) (*v1.Withd
 			Amount:    fmt.Sprintf("%.2f", float64(v.Amount)/float64(10000000000)),
 			Status:    v.Status,
 			Type:      v.Type,
+			Reason:    v.Reason,
 		})
 	}
 
@@ -503,12 +545,13 @@ func (uuc *UserUseCase) Withdraw(ctx context.Context, req *v1.WithdrawRequest, u
 	var (
 		err         error
 		userBalance *UserBalance
+		userInfo    *UserInfo
+		limit       *WithdrawLimit
+		vip         int64
 	)
 
 	if "dhb" != req.SendBody.Type && "usdt" != req.SendBody.Type {
-		return &v1.WithdrawReply{
-			Status: "fail",
-		}, nil
+		return nil, errors.New(500, "WITHDRAW_TYPE_ERROR", "不支持的提现币种")
 	}
 
 	userBalance, err = uuc.ubRepo.GetUserBalance(ctx, user.ID)
@@ -520,23 +563,35 @@ func (uuc *UserUseCase) Withdraw(ctx context.Context, req *v1.WithdrawRequest, u
 	amountFloat *= 10000000000
 	amount, _ := strconv.ParseInt(strconv.FormatFloat(amountFloat, 'f', -1, 64), 10, 64)
 	if 0 >= amount {
-		return &v1.WithdrawReply{
-			Status: "fail",
-		}, nil
+		return nil, errors.New(500, "WITHDRAW_AMOUNT_ERROR", "提现金额错误")
 	}
 
 	if "dhb" == req.SendBody.Type && userBalance.BalanceDhb < amount {
-		return &v1.WithdrawReply{
-			Status: "fail",
-		}, nil
+		return nil, errors.New(500, "WITHDRAW_BALANCE_NOT_ENOUGH", "余额不足")
 	}
 
 	if "usdt" == req.SendBody.Type && userBalance.BalanceUsdt < amount {
-		return &v1.WithdrawReply{
-			Status: "fail",
-		}, nil
+		return nil, errors.New(500, "WITHDRAW_BALANCE_NOT_ENOUGH", "余额不足")
+	}
+
+	// 提现限制，按vip等级覆盖
+	userInfo, _ = uuc.uiRepo.GetUserInfoByUserId(ctx, user.ID)
+	if nil != userInfo {
+		vip = userInfo.Vip
+	}
+	limit = uuc.getWithdrawLimit(ctx, req.SendBody.Type, vip)
+	if err = checkWithdrawAmountLimit(limit, amount); nil != err {
+		return nil, err
+	}
+
+	res := &v1.WithdrawReply{
+		Status: "ok",
 	}
 	if err = uuc.tx.ExecTx(ctx, func(ctx context.Context) error { // 事务
+		err = uuc.checkWithdrawDailyLimit(ctx, limit, user.ID, req.SendBody.Type, amount)
+		if nil != err {
+			return err
+		}
 
 		if "usdt" == req.SendBody.Type {
 			err = uuc.ubRepo.WithdrawUsdt(ctx, user.ID, amount) // 提现
@@ -559,14 +614,13 @@ func (uuc *UserUseCase) Withdraw(ctx context.Context, req *v1.WithdrawRequest, u
 			}
 		}
 
-		return nil
+		// 幂等结果和扣款在同一事务提交，重复请求不会再次扣款
+		return idempotency.Commit(ctx, res)
 	}); nil != err {
 		return nil, err
 	}
 
-	return &v1.WithdrawReply{
-		Status: "ok",
-	}, nil
+	return res, nil
 }
 
 func (uuc *UserUseCase) AdminRewardBnbList(ctx context.Context, req *v1.AdminRewardBnbListRequest) (*v1.AdminRewardBnbListReply, error) {
@@ -681,6 +735,7 @@ func (uuc *UserUseCase) AdminRewardList(ctx context.Context, req *v1.AdminReward
 		}
 
 		res.Rewards = append(res.Rewards, &v1.AdminRewardListReply_List{
+			Id:        vUserReward.ID,
 			CreatedAt: vUserReward.CreatedAt.Add(8 * time.Hour).Format("2006-01-02 15:04:05"),
 			Amount:    fmt.Sprintf("%.2f", float64(vUserReward.Amount)/float64(10000000000)),
 			Type:      vUserReward.Type,
@@ -806,6 +861,8 @@ func (uuc *UserUseCase) AdminUserList(ctx context.Context, req *v1.AdminUserList
 			AreaAmount:       areaAmount,
 			AreaMaxAmount:    maxAreaAmount,
 			HistoryRecommend: userInfos[v.ID].HistoryRecommend,
+			VipCompute:       userInfos[v.ID].VipCompute,
+			VipAdmin:         userInfos[v.ID].VipAdmin,
 			BnbBalance:       fmt.Sprintf("%.5f", tmpBnbBalance),
 			BnbAmount:        fmt.Sprintf("%.5f", userBalances[v.ID].BnbAmount),
 		})
@@ -832,8 +889,19 @@ func (uuc *UserUseCase) AdminUndoUpdate(ctx context.Context, req *v1.AdminUndoUp
 		undo = 0
 	}
 
-	_, err = uuc.repo.UndoUser(ctx, req.SendBody.UserId, undo)
-	if nil != err {
+	user, _ := uuc.repo.GetUserById(ctx, req.SendBody.UserId)
+	if err = uuc.tx.ExecTx(ctx, func(ctx context.Context) error { // 事务
+		_, err = uuc.repo.UndoUser(ctx, req.SendBody.UserId, undo)
+		if nil != err {
+			return err
+		}
+
+		var after *User
+		if nil != user {
+			after = &User{ID: user.ID, Address: user.Address, Undo: undo, CreatedAt: user.CreatedAt}
+		}
+		return createAdminAuditLog(ctx, uuc.auditRepo, "AdminUndoUpdate", req.SendBody, user, after)
+	}); nil != err {
 		return res, err
 	}
 
@@ -847,8 +915,19 @@ func (uuc *UserUseCase) AdminAreaLevelUpdate(ctx context.Context, req *v1.AdminA
 
 	res := &v1.AdminAreaLevelUpdateReply{}
 
-	_, err = uuc.urRepo.UpdateUserAreaLevel(ctx, req.SendBody.UserId, req.SendBody.Level)
-	if nil != err {
+	userArea, _ := uuc.urRepo.GetUserArea(ctx, req.SendBody.UserId)
+	if err = uuc.tx.ExecTx(ctx, func(ctx context.Context) error { // 事务
+		_, err = uuc.urRepo.UpdateUserAreaLevel(ctx, req.SendBody.UserId, req.SendBody.Level)
+		if nil != err {
+			return err
+		}
+
+		var after *UserArea
+		if nil != userArea {
+			after = &UserArea{ID: userArea.ID, UserId: userArea.UserId, Amount: userArea.Amount, SelfAmount: userArea.SelfAmount, Level: req.SendBody.Level}
+		}
+		return createAdminAuditLog(ctx, uuc.auditRepo, "AdminAreaLevelUpdate", req.SendBody, userArea, after)
+	}); nil != err {
 		return res, err
 	}
 
@@ -888,6 +967,7 @@ func (uuc *UserUseCase) AdminLocationList(ctx context.Context, req *v1.AdminLoca
 		return res, nil
 	}
 	res.Count = count
+	uuc.positionLocations(ctx, locations)
 
 	userIdsMap = make(map[int64]int64, 0)
 	for _, vLocations := range locations {
@@ -956,6 +1036,7 @@ func (uuc *UserUseCase) AdminLocationAllList(ctx context.Context, req *v1.AdminL
 		return res, nil
 	}
 	res.Count = count
+	uuc.positionLocations(ctx, locations)
 
 	userIdsMap = make(map[int64]int64, 0)
 	for _, vLocations := range locations {
@@ -1143,8 +1224,27 @@ func (uuc *UserUseCase) AdminConfigUpdate(ctx context.Context, req *v1.AdminConf
 
 	res := &v1.AdminConfigUpdateReply{}
 
-	_, err = uuc.configRepo.UpdateConfig(ctx, req.SendBody.Id, req.SendBody.Value)
-	if nil != err {
+	var before *Config
+	configs, _ := uuc.configRepo.GetConfigs(ctx)
+	for _, vConfigs := range configs {
+		if req.SendBody.Id == vConfigs.ID {
+			before = vConfigs
+			break
+		}
+	}
+
+	if err = uuc.tx.ExecTx(ctx, func(ctx context.Context) error { // 事务
+		_, err = uuc.configRepo.UpdateConfig(ctx, req.SendBody.Id, req.SendBody.Value)
+		if nil != err {
+			return err
+		}
+
+		var after *Config
+		if nil != before {
+			after = &Config{ID: before.ID, KeyName: before.KeyName, Name: before.Name, Value: req.SendBody.Value}
+		}
+		return createAdminAuditLog(ctx, uuc.auditRepo, "AdminConfigUpdate", req.SendBody, before, after)
+	}); nil != err {
 		return res, err
 	}
 
@@ -1163,50 +1263,101 @@ func (uuc *UserUseCase) AdminVipUpdate(ctx context.Context, req *v1.AdminVipUpda
 	}
 
 	res := &v1.AdminVipUpdateReply{}
+	before := &UserInfo{ID: userInfo.ID, UserId: userInfo.UserId, Vip: userInfo.Vip, VipCompute: userInfo.VipCompute, VipAdmin: userInfo.VipAdmin, HistoryRecommend: userInfo.HistoryRecommend}
 
-	if 5 == req.SendBody.Vip {
-		userInfo.Vip = 5
-		userInfo.HistoryRecommend = 10
-	} else if 4 == req.SendBody.Vip {
-		userInfo.Vip = 4
-		userInfo.HistoryRecommend = 8
-	} else if 3 == req.SendBody.Vip {
-		userInfo.Vip = 3
-		userInfo.HistoryRecommend = 6
-	} else if 2 == req.SendBody.Vip {
-		userInfo.Vip = 2
-		userInfo.HistoryRecommend = 4
-	} else if 1 == req.SendBody.Vip {
-		userInfo.Vip = 1
-		userInfo.HistoryRecommend = 2
-	}
-
-	_, err = uuc.uiRepo.UpdateUserInfo(ctx, userInfo) // 推荐人信息修改
-	if nil != err {
+	// 后台等级单独保存，可以设为0，传-1取消后台设置，恢复计算等级
+	if vipAdminUnset > req.SendBody.Vip || maxVip < req.SendBody.Vip {
+		return res, errors.New(500, "ERROR", "等级错误")
+	}
+	userInfo.VipAdmin = req.SendBody.Vip
+	applyVip(userInfo, userInfo.VipCompute)
+
+	if err = uuc.tx.ExecTx(ctx, func(ctx context.Context) error { // 事务
+		_, err = uuc.uiRepo.UpdateUserInfo(ctx, userInfo) // 推荐人信息修改
+		if nil != err {
+			return err
+		}
+
+		return createAdminAuditLog(ctx, uuc.auditRepo, "AdminVipUpdate", req.SendBody, before, userInfo)
+	}); nil != err {
 		return res, err
 	}
 
 	return res, nil
 }
 
+// AdminBalanceUpdate 后台调账，按正负金额加减余额并记录调账原因
 func (uuc *UserUseCase) AdminBalanceUpdate(ctx context.Context, req *v1.AdminBalanceUpdateRequest) (*v1.AdminBalanceUpdateReply, error) {
 	var (
-		err error
+		amount      int64
+		userBalance *UserBalance
+		err         error
 	)
 	res := &v1.AdminBalanceUpdateReply{}
 
-	amountFloat, _ := strconv.ParseFloat(req.SendBody.Amount, 10)
-	amountFloat *= 10000000000
-	amount, _ := strconv.ParseInt(strconv.FormatFloat(amountFloat, 'f', -1, 64), 10, 64)
-
-	_, err = uuc.ubRepo.UpdateBalance(ctx, req.SendBody.UserId, amount) // 推荐人信息修改
+	amount, err = checkAdminBalanceAdjust(req.SendBody)
 	if nil != err {
 		return res, err
 	}
 
+	userBalance, err = uuc.ubRepo.GetUserBalance(ctx, req.SendBody.UserId)
+	if nil == userBalance {
+		return res, err
+	}
+
+	if err = uuc.tx.ExecTx(ctx, func(ctx context.Context) error { // 事务
+		res.RecordId, err = uuc.ubRepo.AdjustBalance(ctx, req.SendBody.UserId, req.SendBody.CoinType, amount, req.SendBody.ReasonCode, req.SendBody.Note)
+		if nil != err {
+			return err
+		}
+
+		after := *userBalance
+		if "usdt" == req.SendBody.CoinType {
+			after.BalanceUsdt += amount
+		} else if "dhb" == req.SendBody.CoinType {
+			after.BalanceDhb += amount
+		} else {
+			after.BnbAmount += float64(amount) / float64(10000000000)
+		}
+		return createAdminAuditLog(ctx, uuc.auditRepo, "AdminBalanceUpdate", req.SendBody, userBalance, &after)
+	}); nil != err {
+		return res, err
+	}
+
 	return res, nil
 }
 
+// 调账原因
+var adminBalanceAdjustReasons = map[string]string{
+	"compensation": "补偿",
+	"correction":   "错账更正",
+	"deduction":    "违规扣除",
+	"activity":     "活动奖励",
+	"other":        "其他",
+}
+
+// checkAdminBalanceAdjust 校验调账参数，返回放大1e10后的有符号金额
+func checkAdminBalanceAdjust(sendBody *v1.AdminBalanceUpdateRequest_SendBody) (int64, error) {
+	if "usdt" != sendBody.CoinType && "dhb" != sendBody.CoinType && "bnb" != sendBody.CoinType {
+		return 0, errors.New(500, "COIN_TYPE_ERROR", "不支持的币种")
+	}
+	if _, ok := adminBalanceAdjustReasons[sendBody.ReasonCode]; !ok {
+		return 0, errors.New(500, "REASON_ERROR", "请选择调账原因")
+	}
+	if "" == strings.TrimSpace(sendBody.Note) {
+		return 0, errors.New(500, "NOTE_ERROR", "请填写调账说明")
+	}
+
+	amountFloat, _ := strconv.ParseFloat(sendBody.Amount, 10)
+	amountFloat *= 10000000000
+	amount, _ := strconv.ParseInt(strconv.FormatFloat(amountFloat, 'f', -1, 64), 10, 64)
+	if 0 == amount {
+		return 0, errors.New(500, "AMOUNT_ERROR", "调账金额错误")
+	}
+
+	return amount, nil
+}
+
 func (uuc *UserUseCase) AdminLogin(ctx context.Context, req *v1.AdminLoginRequest, ca string) (*v1.AdminLoginReply, error) {
 	var (
 		admin *Admin
@@ -1520,9 +1671,18 @@ func (uuc *UserUseCase) AuthAdminCreate(ctx context.Context, req *v1.AuthAdminCr
 		return nil, errors.New(500, "ERROR_TOKEN", "非超管")
 	}
 
-	_, err = uuc.repo.CreateAdminAuth(ctx, req.SendBody.AdminId, req.SendBody.AuthId)
-	if nil != err {
-		return nil, errors.New(500, "ERROR_TOKEN", "创建失败")
+	before, _ := uuc.repo.GetAdminAuth(ctx, req.SendBody.AdminId)
+	if err = uuc.tx.ExecTx(ctx, func(ctx context.Context) error { // 事务
+		_, err = uuc.repo.CreateAdminAuth(ctx, req.SendBody.AdminId, req.SendBody.AuthId)
+		if nil != err {
+			return errors.New(500, "ERROR_TOKEN", "创建失败")
+		}
+
+		after := append(make([]*AdminAuth, 0), before...)
+		after = append(after, &AdminAuth{AdminId: req.SendBody.AdminId, AuthId: req.SendBody.AuthId})
+		return createAdminAuditLog(ctx, uuc.auditRepo, "AuthAdminCreate", req.SendBody, before, after)
+	}); nil != err {
+		return nil, err
 	}
 
 	return res, err
@@ -1553,9 +1713,22 @@ func (uuc *UserUseCase) AuthAdminDelete(ctx context.Context, req *v1.AuthAdminDe
 		return nil, errors.New(500, "ERROR_TOKEN", "非超管")
 	}
 
-	_, err = uuc.repo.DeleteAdminAuth(ctx, req.SendBody.AdminId, req.SendBody.AuthId)
-	if nil != err {
-		return nil, errors.New(500, "ERROR_TOKEN", "删除失败")
+	before, _ := uuc.repo.GetAdminAuth(ctx, req.SendBody.AdminId)
+	if err = uuc.tx.ExecTx(ctx, func(ctx context.Context) error { // 事务
+		_, err = uuc.repo.DeleteAdminAuth(ctx, req.SendBody.AdminId, req.SendBody.AuthId)
+		if nil != err {
+			return errors.New(500, "ERROR_TOKEN", "删除失败")
+		}
+
+		after := make([]*AdminAuth, 0)
+		for _, vBefore := range before {
+			if req.SendBody.AuthId != vBefore.AuthId {
+				after = append(after, vBefore)
+			}
+		}
+		return createAdminAuditLog(ctx, uuc.auditRepo, "AuthAdminDelete", req.SendBody, before, after)
+	}); nil != err {
+		return nil, err
 	}
 
 	return res, err
@@ -1569,18 +1742,6 @@ func (uuc *UserUseCase) GetWithdrawPassOrRewardedFirst(ctx context.Context) (*Wi
 	return uuc.ubRepo.GetWithdrawPassOrRewardedFirst(ctx)
 }
 
-func (uuc *UserUseCase) UpdateWithdrawDoingToRewarded(ctx context.Context) error {
-	return uuc.ubRepo.UpdateWithdrawDoingToRewarded(ctx)
-}
-
-func (uuc *UserUseCase) UpdateWithdrawDoing(ctx context.Context, id int64) (*Withdraw, error) {
-	return uuc.ubRepo.UpdateWithdraw(ctx, id, "doing")
-}
-
-func (uuc *UserUseCase) UpdateWithdrawSuccess(ctx context.Context, id int64) (*Withdraw, error) {
-	return uuc.ubRepo.UpdateWithdraw(ctx, id, "success")
-}
-
 func (uuc *UserUseCase) AdminWithdrawList(ctx context.Context, req *v1.AdminWithdrawListRequest) (*v1.AdminWithdrawListReply, error) {
 	var (
 		withdraws  []*Withdraw
@@ -1640,6 +1801,7 @@ func (uuc *UserUseCase) AdminWithdrawList(ctx context.Context, req *v1.AdminWith
 			Type:      v.Type,
 			Address:   users[v.UserId].Address,
 			RelAmount: fmt.Sprintf("%.2f", float64(v.RelAmount)/float64(10000000000)),
+			Reason:    v.Reason,
 		})
 	}
 
@@ -1658,6 +1820,12 @@ func (uuc *UserUseCase) AdminFee(ctx context.Context, req *v1.AdminFeeRequest) (
 		err            error
 	)
 
+	ctx, release, err := acquireLease(ctx, uuc.lockRepo, locationLease, "AdminFee")
+	if nil != err {
+		return nil, err
+	}
+	defer release()
+
 	userIds, err = uuc.userCurrentMonthRecommendRepo.GetUserLastMonthRecommend(ctx)
 	if nil != err {
 		return nil, err
@@ -1699,7 +1867,7 @@ func (uuc *UserUseCase) AdminFee(ctx context.Context, req *v1.AdminFeeRequest) (
 			myLocationLast.Current += fee
 			if myLocationLast.Current >= myLocationLast.CurrentMax { // 占位分红人分满停止
 				if "running" == tmpCurrentStatus {
-					myLocationLast.StopDate = time.Now().UTC().Add(8 * time.Hour)
+					myLocationLast.StopDate = bizNow(ctx).Add(8 * time.Hour)
 				}
 				myLocationLast.Status = "stop"
 			}
@@ -1745,6 +1913,12 @@ func (uuc *UserUseCase) AdminFeeDaily(ctx context.Context, req *v1.AdminDailyFee
 		err                      error
 	)
 
+	ctx, release, err := acquireLease(ctx, uuc.lockRepo, locationLease, "AdminFeeDaily")
+	if nil != err {
+		return nil, err
+	}
+	defer release()
+
 	userSortRecommendRewards, err = uuc.ubRepo.GetUserRewardRecommendSort(ctx)
 	if nil != err {
 		return nil, err
@@ -1803,7 +1977,7 @@ func (uuc *UserUseCase) AdminFeeDaily(ctx context.Context, req *v1.AdminDailyFee
 			myLocationLast.Current += tmpFee
 			if myLocationLast.Current >= myLocationLast.CurrentMax { // 占位分红人分满停止
 				if "running" == tmpCurrentStatus {
-					myLocationLast.StopDate = time.Now().UTC().Add(8 * time.Hour)
+					myLocationLast.StopDate = bizNow(ctx).Add(8 * time.Hour)
 				}
 				myLocationLast.Status = "stop"
 			}
@@ -2392,72 +2566,124 @@ func (uuc *UserUseCase) AdminAll(ctx context.Context, req *v1.AdminAllRequest) (
 func (uuc *UserUseCase) AdminWithdraw(ctx context.Context, req *v1.AdminWithdrawRequest) (*v1.AdminWithdrawReply, error) {
 	//time.Sleep(30 * time.Second) // 错开时间和充值
 	var (
-		currentValue    int64
-		myLocationLast  *Location
-		withdrawAmount  int64
 		withdrawNotDeal []*Withdraw
+		riskRules       []*withdrawRiskRule
 		err             error
 	)
 
+	ctx, release, err := acquireLease(ctx, uuc.lockRepo, withdrawLease, "AdminWithdraw")
+	if nil != err {
+		return nil, err
+	}
+	defer release()
+
 	withdrawNotDeal, err = uuc.ubRepo.GetWithdrawNotDeal(ctx)
 	if nil == withdrawNotDeal {
 		//_, _ = uuc.locationRepo.UnLockGlobalWithdraw(ctx)
 		return &v1.AdminWithdrawReply{}, nil
 	}
 
+	riskRules = uuc.getWithdrawRiskRules(ctx)
 	for _, withdraw := range withdrawNotDeal {
 		if "" != withdraw.Status {
 			continue
 		}
 
-		currentValue = withdraw.Amount
-
-		if "dhb" == withdraw.Type || "bnb" == withdraw.Type { // 提现dhb
+		// 风控规则，命中转人工审核
+		if hitRules, hitDetails := uuc.checkWithdrawRisk(ctx, riskRules, withdraw); 0 < len(hitRules) {
 			if err = uuc.tx.ExecTx(ctx, func(ctx context.Context) error { // 事务
-				_, err = uuc.ubRepo.UpdateWithdraw(ctx, withdraw.ID, "pass")
+				err := uuc.transitWithdraw(ctx, withdraw, "review", withdrawOperatorSystem, 0, strings.Join(hitRules, ","))
 				if nil != err {
 					return err
 				}
 
-				return nil
+				_, err = uuc.ubRepo.CreateWithdrawReview(ctx, &WithdrawReview{
+					WithdrawId: withdraw.ID,
+					UserId:     withdraw.UserId,
+					Rule:       strings.Join(hitRules, ","),
+					Detail:     strings.Join(hitDetails, ";"),
+					Status:     "review",
+				})
+				return err
 			}); nil != err {
-
-				return nil, err
+				uuc.log.Errorf("withdraw %d review: %v", withdraw.ID, err)
 			}
 
 			continue
 		}
 
-		// 获取当前用户的占位信息，已经有运行中的跳过
-		myLocationLast, err = uuc.locationRepo.GetMyLocationLast(ctx, withdraw.UserId)
-		if nil == myLocationLast { // 无占位信息
+		err = uuc.adminWithdrawDeal(ctx, withdraw, withdrawOperatorSystem, 0, nil)
+		if nil != err {
+			uuc.log.Errorf("withdraw %d deal: %v", withdraw.ID, err)
 			continue
 		}
+	}
 
-		if err = uuc.tx.ExecTx(ctx, func(ctx context.Context) error { // 事务
-			//fmt.Println(withdraw.Amount)
-			currentValue -= withdraw.Amount / 100 * 5 // 手续费
+	return &v1.AdminWithdrawReply{}, nil
+}
 
-			// 手续费记录
-			err = uuc.ubRepo.SystemFee(ctx, withdraw.Amount/100*5, myLocationLast.ID)
-			if nil != err {
-				return err
-			}
+// adminWithdrawDeal 提现审核通过，dhb直接通过，usdt扣手续费后进入分红，after 在同一事务内执行
+func (uuc *UserUseCase) adminWithdrawDeal(ctx context.Context, withdraw *Withdraw, operator string, operatorId int64, after func(ctx context.Context) error) error {
+	var (
+		currentValue   int64
+		myLocationLast *Location
+		withdrawAmount int64
+	)
+
+	currentValue = withdraw.Amount
 
-			withdrawAmount = currentValue / 100 * 50 // 百分之50重新分配
-			_, err = uuc.ubRepo.UpdateWithdrawAmount(ctx, withdraw.ID, "rewarded", withdrawAmount)
+	if "dhb" == withdraw.Type || "bnb" == withdraw.Type { // 提现dhb
+		return uuc.tx.ExecTx(ctx, func(ctx context.Context) error { // 事务
+			err := uuc.transitWithdraw(ctx, withdraw, "pass", operator, operatorId, "")
 			if nil != err {
 				return err
 			}
 
+			if nil != after {
+				return after(ctx)
+			}
 			return nil
-		}); nil != err {
-			fmt.Println(err)
-			continue
-		}
+		})
 	}
 
-	return &v1.AdminWithdrawReply{}, nil
+	// 获取当前用户的占位信息，已经有运行中的跳过
+	myLocationLast, _ = uuc.locationRepo.GetMyLocationLast(ctx, withdraw.UserId)
+	if nil == myLocationLast { // 无占位信息
+		return errors.New(500, "ERROR", "无占位信息")
+	}
+
+	return uuc.tx.ExecTx(ctx, func(ctx context.Context) error { // 事务
+		err := uuc.transitWithdraw(ctx, withdraw, "rewarded", operator, operatorId, "")
+		if nil != err {
+			return err
+		}
+
+		//fmt.Println(withdraw.Amount)
+		currentValue -= withdraw.Amount / 100 * 5 // 手续费
+
+		// 手续费记录
+		err = uuc.ubRepo.SystemFee(ctx, withdraw.Amount/100*5, myLocationLast.ID)
+		if nil != err {
+			return err
+		}
+
+		withdrawAmount = currentValue / 100 * 50 // 百分之50重新分配
+		_, err = uuc.ubRepo.UpdateWithdrawRelAmount(ctx, withdraw.ID, "rewarded", withdrawAmount)
+		if nil != err {
+			return err
+		}
+
+		// 扣除手续费和实际打款后的部分回到奖金池
+		err = uuc.ledgerRepo.PostLedger(ctx, "withdraw_redistribute", withdraw.ID, transferLedger("usdt", currentValue-withdrawAmount, LedgerAccountPayout, 0, LedgerAccountPool, 0)...)
+		if nil != err {
+			return err
+		}
+
+		if nil != after {
+			return after(ctx)
+		}
+		return nil
+	})
 }
 
 func (uuc *UserUseCase) AdminDailyWithdrawReward(ctx context.Context, req *v1.AdminDailyWithdrawRewardRequest) (*v1.AdminDailyWithdrawRewardReply, error) {
@@ -2476,6 +2702,12 @@ func (uuc *UserUseCase) AdminDailyWithdrawReward(ctx context.Context, req *v1.Ad
 		allLocationAmount int64
 	)
 
+	ctx, release, err := acquireLease(ctx, uuc.lockRepo, locationLease, "AdminDailyWithdrawReward")
+	if nil != err {
+		return nil, err
+	}
+	defer release()
+
 	if 1 == req.Day {
 		day = 0
 	}
@@ -2607,7 +2839,7 @@ func (uuc *UserUseCase) AdminDailyWithdrawReward(ctx context.Context, req *v1.Ad
 			current += tmpCurrent
 			if current >= vRewardLocations.CurrentMax { // 占位分红人分满停止
 				if "running" == tmpCurrentStatus {
-					StopDate = time.Now().UTC().Add(8 * time.Hour)
+					StopDate = bizNow(ctx).Add(8 * time.Hour)
 				}
 				status = "stop"
 			}
@@ -2657,6 +2889,12 @@ func (uuc *UserUseCase) AdminDailyRecommendTopReward(ctx context.Context, req *v
 		err                 error
 	)
 
+	ctx, release, err := acquireLease(ctx, uuc.lockRepo, locationLease, "AdminDailyRecommendTopReward")
+	if nil != err {
+		return nil, err
+	}
+	defer release()
+
 	if 1 == req.Day {
 		day = 0
 	}
@@ -2816,7 +3054,7 @@ func (uuc *UserUseCase) AdminDailyRecommendTopReward(ctx context.Context, req *v
 						if tmpMyTopUserRecommendUserLocationLast.Current >= tmpMyTopUserRecommendUserLocationLast.CurrentMax { // 占位分红人分满停止
 							tmpMyTopUserRecommendUserLocationLast.Status = "stop"
 							if "running" == tmpMyTopUserRecommendUserLocationLastStatus {
-								tmpMyTopUserRecommendUserLocationLast.StopDate = time.Now().UTC().Add(8 * time.Hour)
+								tmpMyTopUserRecommendUserLocationLast.StopDate = bizNow(ctx).Add(8 * time.Hour)
 							}
 						}
 						if 0 < tmpMyTopUserRecommendUserLocationLastBalanceAmount {
@@ -2866,6 +3104,12 @@ func (uuc *UserUseCase) AdminDailyRecommendReward(ctx context.Context, req *v1.A
 		err                    error
 	)
 
+	ctx, release, err := acquireLease(ctx, uuc.lockRepo, locationLease, "AdminDailyRecommendReward")
+	if nil != err {
+		return nil, err
+	}
+	defer release()
+
 	if 1 == req.Day {
 		day = 0
 	} else if 2 == req.Day {
@@ -3035,7 +3279,7 @@ func (uuc *UserUseCase) AdminDailyRecommendReward(ctx context.Context, req *v1.A
 				myLocationLast.Current += feeLevel1
 				if myLocationLast.Current >= myLocationLast.CurrentMax { // 占位分红人分满停止
 					if "running" == tmpCurrentStatus {
-						myLocationLast.StopDate = time.Now().UTC().Add(8 * time.Hour)
+						myLocationLast.StopDate = bizNow(ctx).Add(8 * time.Hour)
 					}
 					myLocationLast.Status = "stop"
 				}
@@ -3405,6 +3649,7 @@ func (uuc *UserUseCase) CheckAndInsertLocationsRecommendUser(ctx context.Context
 		err       error
 	)
 	locations, err = uuc.locationRepo.GetAllLocations(ctx)
+	vipPolicy := getVipPolicy(ctx, uuc.configRepo)
 
 	for _, v := range locations {
 		var (
@@ -3438,18 +3683,13 @@ func (uuc *UserUseCase) CheckAndInsertLocationsRecommendUser(ctx context.Context
 		// 推荐人
 		if nil != myUserRecommendUserInfo {
 			if 1 == len(myLocations) { // vip 等级调整，被推荐人首次入单
-				myUserRecommendUserInfo.HistoryRecommend += 1
-				if myUserRecommendUserInfo.HistoryRecommend >= 10 {
-					myUserRecommendUserInfo.Vip = 5
-				} else if myUserRecommendUserInfo.HistoryRecommend >= 8 {
-					myUserRecommendUserInfo.Vip = 4
-				} else if myUserRecommendUserInfo.HistoryRecommend >= 6 {
-					myUserRecommendUserInfo.Vip = 3
-				} else if myUserRecommendUserInfo.HistoryRecommend >= 4 {
-					myUserRecommendUserInfo.Vip = 2
-				} else if myUserRecommendUserInfo.HistoryRecommend >= 2 {
-					myUserRecommendUserInfo.Vip = 1
+				// 补数据按历史人数算，降级由 AdminVipRecalc 处理
+				var areaAmount int64
+				if userArea, _ := uuc.urRepo.GetUserArea(ctx, myUserRecommendUserId); nil != userArea {
+					areaAmount = userArea.Amount
 				}
+				myUserRecommendUserInfo.HistoryRecommend += 1
+				applyVip(myUserRecommendUserInfo, vipPolicy.Level(myUserRecommendUserInfo.HistoryRecommend, areaAmount))
 				if err = uuc.tx.ExecTx(ctx, func(ctx context.Context) error { // 事务
 					_, err = uuc.uiRepo.UpdateUserInfo(ctx, myUserRecommendUserInfo) // 推荐人信息修改
 					if nil != err {
@@ -3636,35 +3876,46 @@ func (uuc *UserUseCase) FixReward(ctx context.Context, req *v1.FixRewardRequest)
 	return &v1.FixRewardReply{}, nil
 }
 
+// FixLocations 行列按运行中序号计算不落库，这里按 location 表重建运行中分桶计数
 func (uuc *UserUseCase) FixLocations(ctx context.Context, req *v1.FixLocationsRequest) (*v1.FixLocationsReply, error) {
+	_, err := uuc.RebuildLocationRanks(ctx)
+	if nil != err {
+		return nil, err
+	}
+
+	return &v1.FixLocationsReply{}, nil
+}
+
+// RebuildLocationRanks 持有占位锁重建运行中分桶计数，返回运行中占位数
+func (uuc *UserUseCase) RebuildLocationRanks(ctx context.Context) (int64, error) {
 	var (
-		locations []*Location
-		col       = int64(1)
-		row       = int64(1)
-		err       error
+		total int64
+		err   error
 	)
 
-	locations, err = uuc.locationRepo.GetLocationsRunning(ctx)
+	ctx, release, err := acquireLease(ctx, uuc.lockRepo, locationLease, "RebuildLocationRanks")
 	if nil != err {
-		return nil, err
+		return 0, err
 	}
+	defer release()
 
-	for _, vLocations := range locations {
-		err = uuc.locationRepo.UpdateLocationFixRowAndCol(ctx, vLocations.ID, col, row)
-		if nil != err {
-			return nil, err
-		}
+	if err = uuc.tx.ExecTx(ctx, func(ctx context.Context) error { // 事务
+		total, err = uuc.locationRepo.RebuildLocationRanks(ctx)
+		return err
+	}); nil != err {
+		return 0, err
+	}
 
-		if 3 > col {
-			col = col + 1
-		} else {
-			col = 1
-			row = row + 1
-		}
+	return total, nil
+}
 
+// MigrateLocationRanks 建分桶计数表和索引后重建计数，上线时执行一次
+func (uuc *UserUseCase) MigrateLocationRanks(ctx context.Context) (int64, error) {
+	if err := uuc.locationRepo.MigrateLocationRanks(ctx); nil != err {
+		return 0, err
 	}
 
-	return &v1.FixLocationsReply{}, nil
+	return uuc.RebuildLocationRanks(ctx)
 }
 
 func (uuc *UserUseCase) UploadRecommendUser(ctx context.Context, req *v1.UploadRecommendUserRequest) (*v1.UploadRecommendUserReply, error) {
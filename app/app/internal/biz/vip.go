@@ -0,0 +1,373 @@
+package biz
+
+import (
+	"context"
+	v1 "dhb/app/app/api"
+	"strconv"
+	"strings"
+)
+
+// maxVip 会员分红只配置了 recommend_need_vip1-5
+const maxVip = 5
+
+// vipAdminUnset 后台未设置等级，0是有效的后台等级
+const vipAdminUnset = -1
+
+// defaultVipThresholds 原固定规则，直推入单2/4/6/8/10人对应vip1-5
+var defaultVipThresholds = []int64{2, 4, 6, 8, 10}
+
+// VipPolicy 会员等级规则
+// vip_thresholds 每级需要的直推入单人数，逗号分隔；
+// vip_area_thresholds 每级需要的团队业绩（user_area.amount，U），为空不要求；
+// vip_downgrade 为1时只算有运行中占位的直推，直推出局后等级可下降
+type VipPolicy struct {
+	Thresholds     []int64
+	AreaThresholds []int64
+	Downgrade      bool
+}
+
+// parseVipThresholds 逗号分隔的非负整数，解析失败返回nil
+func parseVipThresholds(value string) []int64 {
+	res := make([]int64, 0)
+	for _, v := range strings.Split(value, ",") {
+		if "" == strings.TrimSpace(v) {
+			continue
+		}
+		tmp, err := strconv.ParseInt(strings.TrimSpace(v), 10, 64)
+		if nil != err || 0 > tmp {
+			return nil
+		}
+		res = append(res, tmp)
+	}
+	if maxVip < len(res) {
+		res = res[:maxVip]
+	}
+
+	return res
+}
+
+// getVipPolicy .
+func getVipPolicy(ctx context.Context, configRepo ConfigRepo) *VipPolicy {
+	p := &VipPolicy{Thresholds: defaultVipThresholds}
+	configs, _ := configRepo.GetConfigByKeys(ctx, "vip_thresholds", "vip_area_thresholds", "vip_downgrade")
+	for _, vConfig := range configs {
+		p.set(vConfig.KeyName, vConfig.Value)
+	}
+
+	return p
+}
+
+// set 按配置项改等级规则，不是等级配置项时返回 false
+func (p *VipPolicy) set(keyName string, value string) bool {
+	if "vip_thresholds" == keyName {
+		if tmp := parseVipThresholds(value); 0 < len(tmp) {
+			p.Thresholds = tmp
+		}
+	} else if "vip_area_thresholds" == keyName {
+		p.AreaThresholds = parseVipThresholds(value)
+	} else if "vip_downgrade" == keyName {
+		p.Downgrade = "1" == value
+	} else {
+		return false
+	}
+
+	return true
+}
+
+// Level 按直推人数和团队业绩算等级，每级都要同时满足
+func (p *VipPolicy) Level(recommend int64, areaAmount int64) int64 {
+	var level int64
+	for i, threshold := range p.Thresholds {
+		if recommend < threshold {
+			break
+		}
+		if i < len(p.AreaThresholds) && areaAmount < p.AreaThresholds[i] {
+			break
+		}
+		level = int64(i + 1)
+	}
+
+	return level
+}
+
+// applyVip 写入计算等级，后台设置了等级时以后台为准
+func applyVip(userInfo *UserInfo, computed int64) {
+	userInfo.VipCompute = computed
+	userInfo.Vip = computed
+	if vipAdminUnset < userInfo.VipAdmin {
+		userInfo.Vip = userInfo.VipAdmin
+	}
+}
+
+// vipRecommendCount 直推中入过单的人数和有运行中占位的人数
+func vipRecommendCount(ctx context.Context, urRepo UserRecommendRepo, locationRepo LocationRepo, userId int64) (int64, int64, error) {
+	var (
+		userRecommend  *UserRecommend
+		userRecommends []*UserRecommend
+		locations      []*Location
+		history        int64
+		active         int64
+		err            error
+	)
+
+	userRecommend, err = urRepo.GetUserRecommendByUserId(ctx, userId)
+	if nil != err {
+		return 0, 0, err
+	}
+
+	userRecommends, err = urRepo.GetUserRecommendByCode(ctx, userRecommend.RecommendCode+"D"+strconv.FormatInt(userId, 10))
+	if nil != err || 0 == len(userRecommends) {
+		return 0, 0, nil
+	}
+
+	userIds := make([]int64, 0)
+	for _, v := range userRecommends {
+		userIds = append(userIds, v.UserId)
+	}
+	locations, _ = locationRepo.GetLocationsByUserIds(ctx, userIds)
+
+	userLocations := make(map[int64]string, 0)
+	for _, v := range locations {
+		if "running" == v.Status || "" == userLocations[v.UserId] {
+			userLocations[v.UserId] = v.Status
+		}
+	}
+	for _, status := range userLocations {
+		history++
+		if "running" == status {
+			active++
+		}
+	}
+
+	return history, active, nil
+}
+
+// upgradeVip 被推荐人首次入单时调整推荐人等级，areaAdd 为本次入单还未计入的团队业绩
+// 直推人数和业绩下降引起的降级由 AdminVipRecalc 处理
+func upgradeVip(ctx context.Context, p *VipPolicy, urRepo UserRecommendRepo, locationRepo LocationRepo, userInfo *UserInfo, areaAdd int64) {
+	var areaAmount int64
+	userInfo.HistoryRecommend += 1
+
+	recommend := userInfo.HistoryRecommend
+	if p.Downgrade {
+		// 被推荐人本次占位还在事务内，不在统计里
+		if _, active, err := vipRecommendCount(ctx, urRepo, locationRepo, userInfo.UserId); nil == err {
+			recommend = active + 1
+		}
+	}
+	if 0 < len(p.AreaThresholds) {
+		if userArea, err := urRepo.GetUserArea(ctx, userInfo.UserId); nil == err && nil != userArea {
+			areaAmount = userArea.Amount
+		}
+		areaAmount += areaAdd
+	}
+
+	computed := p.Level(recommend, areaAmount)
+	if !p.Downgrade && computed < userInfo.VipCompute { // 不降级时只升不降
+		computed = userInfo.VipCompute
+	}
+	applyVip(userInfo, computed)
+}
+
+// vipRecommendStats 所有用户的直推中入过单的人数和有运行中占位的人数
+func (uuc *UserUseCase) vipRecommendStats(ctx context.Context) ([]int64, map[int64]int64, map[int64]int64, error) {
+	var (
+		userRecommends []*UserRecommend
+		locations      []*Location
+		err            error
+	)
+
+	userRecommends, err = uuc.urRepo.GetUserRecommends(ctx)
+	if nil != err {
+		return nil, nil, nil, err
+	}
+	locations, err = uuc.locationRepo.GetAllLocations(ctx)
+	if nil != err {
+		return nil, nil, nil, err
+	}
+
+	// 每个用户的占位状态，有一个运行中就算运行中
+	userLocations := make(map[int64]string, 0)
+	for _, v := range locations {
+		if "running" == v.Status || "" == userLocations[v.UserId] {
+			userLocations[v.UserId] = v.Status
+		}
+	}
+
+	userIds := make([]int64, 0, len(userRecommends))
+	history := make(map[int64]int64, 0)
+	active := make(map[int64]int64, 0)
+	for _, v := range userRecommends {
+		userIds = append(userIds, v.UserId)
+		if "" == v.RecommendCode {
+			continue
+		}
+		tmpRecommendUserIds := strings.Split(v.RecommendCode, "D")
+		myUserRecommendUserId, _ := strconv.ParseInt(tmpRecommendUserIds[len(tmpRecommendUserIds)-1], 10, 64) // 最后一位是直推人
+		if 0 >= myUserRecommendUserId {
+			continue
+		}
+
+		if status, ok := userLocations[v.UserId]; ok {
+			history[myUserRecommendUserId]++
+			if "running" == status {
+				active[myUserRecommendUserId]++
+			}
+		}
+	}
+
+	return userIds, history, active, nil
+}
+
+// updateVipChanged 等级或推荐人数有变化时保存
+func (uuc *UserUseCase) updateVipChanged(ctx context.Context, userInfo *UserInfo, before *UserInfo) (bool, error) {
+	if before.HistoryRecommend == userInfo.HistoryRecommend && before.Vip == userInfo.Vip &&
+		before.VipCompute == userInfo.VipCompute && before.VipAdmin == userInfo.VipAdmin {
+		return false, nil
+	}
+
+	if err := uuc.tx.ExecTx(ctx, func(ctx context.Context) error { // 事务
+		_, err := uuc.uiRepo.UpdateUserInfo(ctx, userInfo)
+		return err
+	}); nil != err {
+		return false, err
+	}
+
+	return true, nil
+}
+
+// RecalcVip 按真实推荐数据重算所有用户的 history_recommend 和等级，后台设置的等级保留；
+// vip_downgrade 未开启时和入单一样只升不降
+func (uuc *UserUseCase) RecalcVip(ctx context.Context) (int64, int64, error) {
+	var (
+		userIds   []int64
+		history   map[int64]int64
+		active    map[int64]int64
+		userInfos map[int64]*UserInfo
+		userAreas []*UserArea
+		changed   int64
+		err       error
+	)
+
+	ctx, release, err := acquireLease(ctx, uuc.lockRepo, locationLease, "RecalcVip")
+	if nil != err {
+		return 0, 0, err
+	}
+	defer release()
+
+	p := getVipPolicy(ctx, uuc.configRepo)
+
+	userIds, history, active, err = uuc.vipRecommendStats(ctx)
+	if nil != err {
+		return 0, 0, err
+	}
+	if 0 == len(userIds) {
+		return 0, 0, nil
+	}
+
+	userInfos, err = uuc.uiRepo.GetUserInfoByUserIds(ctx, userIds...)
+	if nil != err {
+		return 0, 0, err
+	}
+	areaAmounts := make(map[int64]int64, 0)
+	if 0 < len(p.AreaThresholds) {
+		userAreas, err = uuc.urRepo.GetUserAreas(ctx, userIds)
+		if nil != err {
+			return 0, 0, err
+		}
+		for _, v := range userAreas {
+			areaAmounts[v.UserId] = v.Amount
+		}
+	}
+
+	for _, userInfo := range userInfos {
+		recommend := history[userInfo.UserId]
+		if p.Downgrade {
+			recommend = active[userInfo.UserId]
+		}
+
+		before := &UserInfo{ID: userInfo.ID, UserId: userInfo.UserId, Vip: userInfo.Vip, VipCompute: userInfo.VipCompute, VipAdmin: userInfo.VipAdmin, HistoryRecommend: userInfo.HistoryRecommend}
+		computed := p.Level(recommend, areaAmounts[userInfo.UserId])
+		if !p.Downgrade && computed < userInfo.VipCompute { // 不降级时只升不降
+			computed = userInfo.VipCompute
+		}
+		userInfo.HistoryRecommend = history[userInfo.UserId]
+		applyVip(userInfo, computed)
+
+		ok, err := uuc.updateVipChanged(ctx, userInfo, before)
+		if nil != err {
+			uuc.log.Warnf("vip recalc %d: %s", userInfo.UserId, err.Error())
+			continue
+		}
+		if ok {
+			uuc.log.Infof("vip recalc %d: vip %d->%d history_recommend %d->%d", userInfo.UserId, before.Vip, userInfo.Vip, before.HistoryRecommend, userInfo.HistoryRecommend)
+			changed++
+		}
+	}
+
+	return int64(len(userInfos)), changed, nil
+}
+
+// MigrateVip 上线 vip_compute/vip_admin 时回填一次：按原固定规则（直推入单2/4/6/8/10人）和真实推荐数据算出计算等级，
+// 当前等级和计算等级不同的是原后台改的等级（原后台改等级会伪造 history_recommend），移到 vip_admin；
+// history_recommend 改回真实人数，vip 不变。重复执行结果相同
+func (uuc *UserUseCase) MigrateVip(ctx context.Context) (int64, int64, error) {
+	var (
+		userIds   []int64
+		history   map[int64]int64
+		userInfos map[int64]*UserInfo
+		moved     int64
+		err       error
+	)
+
+	ctx, release, err := acquireLease(ctx, uuc.lockRepo, locationLease, "MigrateVip")
+	if nil != err {
+		return 0, 0, err
+	}
+	defer release()
+
+	legacy := &VipPolicy{Thresholds: defaultVipThresholds}
+
+	userIds, history, _, err = uuc.vipRecommendStats(ctx)
+	if nil != err {
+		return 0, 0, err
+	}
+	if 0 == len(userIds) {
+		return 0, 0, nil
+	}
+
+	userInfos, err = uuc.uiRepo.GetUserInfoByUserIds(ctx, userIds...)
+	if nil != err {
+		return 0, 0, err
+	}
+
+	for _, userInfo := range userInfos {
+		before := &UserInfo{ID: userInfo.ID, UserId: userInfo.UserId, Vip: userInfo.Vip, VipCompute: userInfo.VipCompute, VipAdmin: userInfo.VipAdmin, HistoryRecommend: userInfo.HistoryRecommend}
+		userInfo.HistoryRecommend = history[userInfo.UserId]
+		userInfo.VipCompute = legacy.Level(userInfo.HistoryRecommend, 0)
+		userInfo.VipAdmin = vipAdminUnset
+		if userInfo.Vip != userInfo.VipCompute {
+			userInfo.VipAdmin = userInfo.Vip
+			moved++
+		}
+
+		if _, err = uuc.updateVipChanged(ctx, userInfo, before); nil != err {
+			return int64(len(userInfos)), moved, err
+		}
+		if vipAdminUnset != userInfo.VipAdmin {
+			uuc.log.Infof("vip migrate %d: vip %d vip_compute %d history_recommend %d->%d", userInfo.UserId, userInfo.Vip, userInfo.VipCompute, before.HistoryRecommend, userInfo.HistoryRecommend)
+		}
+	}
+
+	return int64(len(userInfos)), moved, nil
+}
+
+// AdminVipRecalc 重算会员等级，定时任务调用
+func (uuc *UserUseCase) AdminVipRecalc(ctx context.Context, req *v1.AdminVipRecalcRequest) (*v1.AdminVipRecalcReply, error) {
+	total, changed, err := uuc.RecalcVip(ctx)
+	if nil != err {
+		return nil, err
+	}
+
+	return &v1.AdminVipRecalcReply{Total: total, Changed: changed}, nil
+}
@@ -0,0 +1,532 @@
+package biz
+
+import (
+	"context"
+	v1 "dhb/app/app/api"
+	"fmt"
+	"github.com/go-kratos/kratos/v2/errors"
+	"strconv"
+	"strings"
+	"time"
+)
+
+type WithdrawReview struct {
+	ID         int64
+	WithdrawId int64
+	UserId     int64
+	Rule       string
+	Detail     string
+	Status     string
+	AdminId    int64
+	Reason     string
+	CreatedAt  time.Time
+	UpdatedAt  time.Time
+}
+
+// WithdrawLimit 提现限制，金额已放大1e10，0表示不限制
+type WithdrawLimit struct {
+	Min             int64
+	Step            int64
+	Max             int64
+	UserDailyCount  int64
+	UserDailyAmount int64
+	DailyTotal      int64
+}
+
+// getWithdrawLimit 读取币种提现限制，vip 配置了 withdraw_{coin}_{name}_vip{n} 时覆盖通用配置
+func (uuc *UserUseCase) getWithdrawLimit(ctx context.Context, coinType string, vip int64) *WithdrawLimit {
+	var (
+		configs []*Config
+		keys    []string
+		values  map[string]string
+	)
+
+	names := []string{"min", "step", "max", "user_daily_count", "user_daily_amount", "daily_total"}
+	for _, name := range names {
+		keys = append(keys, "withdraw_"+coinType+"_"+name)
+		if 0 < vip {
+			keys = append(keys, "withdraw_"+coinType+"_"+name+"_vip"+strconv.FormatInt(vip, 10))
+		}
+	}
+
+	values = make(map[string]string, 0)
+	configs, _ = uuc.configRepo.GetConfigByKeys(ctx, keys...)
+	if nil != configs {
+		for _, vConfig := range configs {
+			values[vConfig.KeyName] = vConfig.Value
+		}
+	}
+
+	value := func(name string) string {
+		if v, ok := values["withdraw_"+coinType+"_"+name+"_vip"+strconv.FormatInt(vip, 10)]; ok && 0 < vip {
+			return v
+		}
+		return values["withdraw_"+coinType+"_"+name]
+	}
+	amount := func(name string) int64 {
+		tmp, _ := strconv.ParseFloat(value(name), 10)
+		tmp *= 10000000000
+		res, _ := strconv.ParseInt(strconv.FormatFloat(tmp, 'f', 0, 64), 10, 64)
+		return res
+	}
+
+	userDailyCount, _ := strconv.ParseInt(value("user_daily_count"), 10, 64)
+	return &WithdrawLimit{
+		Min:             amount("min"),
+		Step:            amount("step"),
+		Max:             amount("max"),
+		UserDailyCount:  userDailyCount,
+		UserDailyAmount: amount("user_daily_amount"),
+		DailyTotal:      amount("daily_total"),
+	}
+}
+
+// checkWithdrawAmountLimit 单笔限制
+func checkWithdrawAmountLimit(limit *WithdrawLimit, amount int64) error {
+	if 0 < limit.Min && amount < limit.Min {
+		return errors.New(500, "WITHDRAW_AMOUNT_TOO_SMALL", fmt.Sprintf("单笔最低提现%.2f", float64(limit.Min)/float64(10000000000)))
+	}
+
+	if 0 < limit.Step && 0 != amount%limit.Step {
+		return errors.New(500, "WITHDRAW_AMOUNT_STEP", fmt.Sprintf("提现金额需为%.2f的整数倍", float64(limit.Step)/float64(10000000000)))
+	}
+
+	if 0 < limit.Max && amount > limit.Max {
+		return errors.New(500, "WITHDRAW_AMOUNT_TOO_LARGE", fmt.Sprintf("单笔最高提现%.2f", float64(limit.Max)/float64(10000000000)))
+	}
+
+	return nil
+}
+
+// checkWithdrawDailyLimit 每日限制，需在事务内、扣减余额之前调用。
+// 先锁用户余额行，同一用户的提现串行；有平台总额度时再锁币种计数行，同币种提现串行，
+// 锁住之后再统计当日已提现，并发请求不会都通过检查
+func (uuc *UserUseCase) checkWithdrawDailyLimit(ctx context.Context, limit *WithdrawLimit, userId int64, coinType string, amount int64) error {
+	var (
+		count int64
+		total int64
+		err   error
+	)
+
+	if err = uuc.ubRepo.LockUserBalance(ctx, userId); nil != err {
+		return err
+	}
+	if 0 < limit.DailyTotal {
+		if err = uuc.ubRepo.LockWithdrawDaily(ctx, coinType); nil != err {
+			return err
+		}
+	}
+
+	if 0 < limit.UserDailyCount || 0 < limit.UserDailyAmount {
+		count, total, err = uuc.ubRepo.GetUserWithdrawToday(ctx, userId, coinType)
+		if nil != err {
+			return err
+		}
+
+		if 0 < limit.UserDailyCount && count+1 > limit.UserDailyCount {
+			return errors.New(500, "WITHDRAW_USER_DAILY_COUNT", fmt.Sprintf("每日最多提现%d次", limit.UserDailyCount))
+		}
+
+		if 0 < limit.UserDailyAmount && total+amount > limit.UserDailyAmount {
+			return errors.New(500, "WITHDRAW_USER_DAILY_AMOUNT", fmt.Sprintf("每日最多提现%.2f，今日剩余%.2f", float64(limit.UserDailyAmount)/float64(10000000000), float64(limit.UserDailyAmount-total)/float64(10000000000)))
+		}
+	}
+
+	if 0 < limit.DailyTotal {
+		total, err = uuc.ubRepo.GetWithdrawTotalToday(ctx, coinType)
+		if nil != err {
+			return err
+		}
+
+		if total+amount > limit.DailyTotal {
+			return errors.New(500, "WITHDRAW_DAILY_TOTAL", "今日平台提现额度已满，请明日再试")
+		}
+	}
+
+	return nil
+}
+
+// withdrawRiskRule 提现风控规则，命中返回说明
+type withdrawRiskRule struct {
+	name  string
+	check func(ctx context.Context, withdraw *Withdraw) (string, bool)
+}
+
+// getWithdrawRiskRules 按配置启用规则，未配置的规则不生效
+func (uuc *UserUseCase) getWithdrawRiskRules(ctx context.Context) []*withdrawRiskRule {
+	var (
+		configs         []*Config
+		accountMinDays  int64
+		depositMultiple int64
+		rewardCheck     int64
+		rewardTolerance int64
+		blockAddresses  map[string]bool
+		res             []*withdrawRiskRule
+	)
+
+	blockAddresses = make(map[string]bool, 0)
+	configs, _ = uuc.configRepo.GetConfigByKeys(ctx, "risk_account_min_days", "risk_deposit_multiple",
+		"risk_reward_check", "risk_reward_tolerance", "risk_block_address")
+	if nil != configs {
+		for _, vConfig := range configs {
+			if "risk_account_min_days" == vConfig.KeyName {
+				accountMinDays, _ = strconv.ParseInt(vConfig.Value, 10, 64)
+			} else if "risk_deposit_multiple" == vConfig.KeyName {
+				depositMultiple, _ = strconv.ParseInt(vConfig.Value, 10, 64)
+			} else if "risk_reward_check" == vConfig.KeyName {
+				rewardCheck, _ = strconv.ParseInt(vConfig.Value, 10, 64)
+			} else if "risk_reward_tolerance" == vConfig.KeyName {
+				rewardTolerance, _ = strconv.ParseInt(vConfig.Value, 10, 64)
+			} else if "risk_block_address" == vConfig.KeyName {
+				for _, vAddress := range strings.Split(vConfig.Value, ",") {
+					if "" != strings.TrimSpace(vAddress) {
+						blockAddresses[strings.ToLower(strings.TrimSpace(vAddress))] = true
+					}
+				}
+			}
+		}
+	}
+
+	// 注册时间不足N天
+	if 0 < accountMinDays {
+		res = append(res, &withdrawRiskRule{name: "account_age", check: func(ctx context.Context, withdraw *Withdraw) (string, bool) {
+			user, err := uuc.repo.GetUserById(ctx, withdraw.UserId)
+			if nil != err || nil == user {
+				return "用户不存在", true
+			}
+			if time.Now().UTC().Before(user.CreatedAt.AddDate(0, 0, int(accountMinDays))) {
+				return fmt.Sprintf("注册不足%d天", accountMinDays), true
+			}
+			return "", false
+		}})
+	}
+
+	// 提现超过累计充值的M倍
+	if 0 < depositMultiple {
+		res = append(res, &withdrawRiskRule{name: "deposit_multiple", check: func(ctx context.Context, withdraw *Withdraw) (string, bool) {
+			if "usdt" != withdraw.Type {
+				return "", false
+			}
+			depositTotal, err := uuc.ubRepo.GetUserDepositUsdtTotal(ctx, withdraw.UserId)
+			if nil != err {
+				return "充值查询失败", true
+			}
+			if withdraw.Amount > depositTotal*depositMultiple {
+				return fmt.Sprintf("提现%.2f超过累计充值%.2f的%d倍", float64(withdraw.Amount)/float64(10000000000), float64(depositTotal)/float64(10000000000), depositMultiple), true
+			}
+			return "", false
+		}})
+	}
+
+	// 奖励总额与占位累计收益不一致
+	if 1 == rewardCheck {
+		res = append(res, &withdrawRiskRule{name: "reward_mismatch", check: func(ctx context.Context, withdraw *Withdraw) (string, bool) {
+			rewardTotal, err := uuc.ubRepo.GetUserRewardTotal(ctx, withdraw.UserId)
+			if nil != err {
+				return "奖励查询失败", true
+			}
+			locations, err := uuc.locationRepo.GetLocationsByUserId(ctx, withdraw.UserId)
+			if nil != err {
+				return "占位查询失败", true
+			}
+			var current int64
+			for _, vLocations := range locations {
+				current += vLocations.Current
+			}
+			diff := rewardTotal - current
+			if 0 > diff {
+				diff = -diff
+			}
+			if diff > rewardTolerance*10000000000 {
+				return fmt.Sprintf("奖励%.2f与占位收益%.2f不一致", float64(rewardTotal)/float64(10000000000), float64(current)/float64(10000000000)), true
+			}
+			return "", false
+		}})
+	}
+
+	// 地址黑名单
+	if 0 < len(blockAddresses) {
+		res = append(res, &withdrawRiskRule{name: "block_address", check: func(ctx context.Context, withdraw *Withdraw) (string, bool) {
+			user, err := uuc.repo.GetUserById(ctx, withdraw.UserId)
+			if nil != err || nil == user {
+				return "用户不存在", true
+			}
+			if blockAddresses[strings.ToLower(user.Address)] {
+				return "地址在黑名单", true
+			}
+			return "", false
+		}})
+	}
+
+	return res
+}
+
+// checkWithdrawRisk 返回命中的规则和说明
+func (uuc *UserUseCase) checkWithdrawRisk(ctx context.Context, rules []*withdrawRiskRule, withdraw *Withdraw) ([]string, []string) {
+	var (
+		hitRules   []string
+		hitDetails []string
+	)
+
+	for _, rule := range rules {
+		if detail, hit := rule.check(ctx, withdraw); hit {
+			hitRules = append(hitRules, rule.name)
+			hitDetails = append(hitDetails, detail)
+		}
+	}
+
+	return hitRules, hitDetails
+}
+
+func (uuc *UserUseCase) AdminWithdrawReviewList(ctx context.Context, req *v1.AdminWithdrawReviewListRequest) (*v1.AdminWithdrawReviewListReply, error) {
+	var (
+		reviews   []*WithdrawReview
+		userIds   []int64
+		users     map[int64]*User
+		withdraws map[int64]*Withdraw
+		count     int64
+		err       error
+	)
+
+	res := &v1.AdminWithdrawReviewListReply{
+		Reviews: make([]*v1.AdminWithdrawReviewListReply_List, 0),
+	}
+
+	reviews, err, count = uuc.ubRepo.GetWithdrawReviews(ctx, &Pagination{
+		PageNum:  int(req.Page),
+		PageSize: 10,
+	}, req.Status)
+	if nil != err {
+		return res, err
+	}
+	res.Count = count
+
+	withdraws = make(map[int64]*Withdraw, 0)
+	for _, v := range reviews {
+		userIds = append(userIds, v.UserId)
+		if withdraw, err := uuc.ubRepo.GetWithdrawById(ctx, v.WithdrawId); nil == err {
+			withdraws[v.WithdrawId] = withdraw
+		}
+	}
+
+	users, err = uuc.repo.GetUserByUserIds(ctx, userIds...)
+	if nil != err {
+		return res, nil
+	}
+
+	for _, v := range reviews {
+		var (
+			address string
+			amount  string
+			coin    string
+		)
+		if _, ok := users[v.UserId]; ok {
+			address = users[v.UserId].Address
+		}
+		if _, ok := withdraws[v.WithdrawId]; ok {
+			amount = fmt.Sprintf("%.2f", float64(withdraws[v.WithdrawId].Amount)/float64(10000000000))
+			coin = withdraws[v.WithdrawId].Type
+		}
+
+		res.Reviews = append(res.Reviews, &v1.AdminWithdrawReviewListReply_List{
+			WithdrawId: v.WithdrawId,
+			Address:    address,
+			Amount:     amount,
+			Type:       coin,
+			Rule:       v.Rule,
+			Detail:     v.Detail,
+			Status:     v.Status,
+			AdminId:    v.AdminId,
+			Reason:     v.Reason,
+			CreatedAt:  v.CreatedAt.Add(8 * time.Hour).Format("2006-01-02 15:04:05"),
+		})
+	}
+
+	return res, nil
+}
+
+// AdminWithdrawReviewApprove 人工审核通过，按正常流程处理
+func (uuc *UserUseCase) AdminWithdrawReviewApprove(ctx context.Context, req *v1.AdminWithdrawReviewApproveRequest) (*v1.AdminWithdrawReviewApproveReply, error) {
+	var (
+		withdraw *Withdraw
+		adminId  int64
+		err      error
+	)
+
+	res := &v1.AdminWithdrawReviewApproveReply{}
+
+	adminId, err = getAdminIdFromContext(ctx)
+	if nil != err {
+		return res, err
+	}
+
+	withdraw, err = uuc.ubRepo.GetWithdrawById(ctx, req.SendBody.Id)
+	if nil == withdraw {
+		return res, err
+	}
+	if "review" != withdraw.Status {
+		return res, errors.New(500, "ERROR", "提现不在审核中")
+	}
+
+	before := *withdraw
+	if err = uuc.adminWithdrawDeal(ctx, withdraw, withdrawOperatorAdmin, adminId, func(ctx context.Context) error {
+		err = uuc.ubRepo.UpdateWithdrawReview(ctx, withdraw.ID, "approved", adminId, "")
+		if nil != err {
+			return err
+		}
+
+		return createAdminAuditLog(ctx, uuc.auditRepo, "AdminWithdrawReviewApprove", req.SendBody, &before, withdraw)
+	}); nil != err {
+		return res, err
+	}
+
+	return res, nil
+}
+
+// AdminWithdrawReviewReject 人工审核拒绝，退回余额
+func (uuc *UserUseCase) AdminWithdrawReviewReject(ctx context.Context, req *v1.AdminWithdrawReviewRejectRequest) (*v1.AdminWithdrawReviewRejectReply, error) {
+	var (
+		withdraw *Withdraw
+		adminId  int64
+		err      error
+	)
+
+	res := &v1.AdminWithdrawReviewRejectReply{}
+
+	adminId, err = getAdminIdFromContext(ctx)
+	if nil != err {
+		return res, err
+	}
+
+	withdraw, err = uuc.ubRepo.GetWithdrawById(ctx, req.SendBody.Id)
+	if nil == withdraw {
+		return res, err
+	}
+	if "review" != withdraw.Status {
+		return res, errors.New(500, "ERROR", "提现不在审核中")
+	}
+
+	before := *withdraw
+	if err = uuc.rejectWithdraw(ctx, withdraw, adminId, req.SendBody.Reason, func(ctx context.Context) error {
+		err = uuc.ubRepo.UpdateWithdrawReview(ctx, withdraw.ID, "rejected", adminId, req.SendBody.Reason)
+		if nil != err {
+			return err
+		}
+
+		return createAdminAuditLog(ctx, uuc.auditRepo, "AdminWithdrawReviewReject", req.SendBody, &before, withdraw)
+	}); nil != err {
+		return res, err
+	}
+
+	return res, nil
+}
+
+// WithdrawCancel 用户撤销未处理的提现，余额退回
+func (uuc *UserUseCase) WithdrawCancel(ctx context.Context, req *v1.WithdrawCancelRequest, user *User) (*v1.WithdrawCancelReply, error) {
+	var (
+		withdraw *Withdraw
+		err      error
+	)
+
+	withdraw, err = uuc.ubRepo.GetWithdrawById(ctx, req.SendBody.Id)
+	if nil == withdraw {
+		return nil, err
+	}
+	if user.ID != withdraw.UserId {
+		return nil, errors.New(500, "WITHDRAW_NOT_FOUND", "提现记录不存在")
+	}
+	if "" != withdraw.Status {
+		return nil, errors.New(500, "WITHDRAW_STATUS_ERROR", "提现已处理，不能撤销")
+	}
+
+	if err = uuc.tx.ExecTx(ctx, func(ctx context.Context) error { // 事务
+		// 与AdminWithdraw竞争同一条件更新，只有一方能成功
+		err := uuc.transitWithdraw(ctx, withdraw, "canceled", withdrawOperatorUser, user.ID, "")
+		if nil != err {
+			return err
+		}
+
+		_, err = uuc.ubRepo.WithdrawRefund(ctx, withdraw.UserId, withdraw.Amount, withdraw.Type)
+		return err
+	}); nil != err {
+		return nil, err
+	}
+
+	return &v1.WithdrawCancelReply{
+		Status: "ok",
+	}, nil
+}
+
+// rejectWithdraw 驳回提现并退回余额，after 在同一事务内执行；
+// 已分红（rewarded）的 usdt 提现先冲回手续费和回池部分，驳回后不再计入当日提现分红
+func (uuc *UserUseCase) rejectWithdraw(ctx context.Context, withdraw *Withdraw, adminId int64, reason string, after func(ctx context.Context) error) error {
+	return uuc.tx.ExecTx(ctx, func(ctx context.Context) error { // 事务
+		fromStatus := withdraw.Status
+		err := uuc.transitWithdraw(ctx, withdraw, "rejected", withdrawOperatorAdmin, adminId, reason)
+		if nil != err {
+			return err
+		}
+
+		if "rewarded" == fromStatus {
+			fee := withdraw.Amount / 100 * 5 // 和 adminWithdrawDeal 的手续费一致
+			err = uuc.ubRepo.WithdrawRewardedReverse(ctx, withdraw.ID, fee, withdraw.Amount-fee-withdraw.RelAmount)
+			if nil != err {
+				return err
+			}
+		}
+
+		_, err = uuc.ubRepo.WithdrawRefund(ctx, withdraw.UserId, withdraw.Amount, withdraw.Type)
+		if nil != err {
+			return err
+		}
+
+		if nil != after {
+			return after(ctx)
+		}
+		return nil
+	})
+}
+
+// AdminWithdrawReject 驳回未打款的提现，打款中或已成功的不能驳回
+func (uuc *UserUseCase) AdminWithdrawReject(ctx context.Context, req *v1.AdminWithdrawRejectRequest) (*v1.AdminWithdrawRejectReply, error) {
+	var (
+		withdraw *Withdraw
+		adminId  int64
+		err      error
+	)
+
+	res := &v1.AdminWithdrawRejectReply{}
+
+	if "" == req.SendBody.Reason {
+		return res, errors.New(500, "ERROR", "请填写驳回原因")
+	}
+
+	adminId, err = getAdminIdFromContext(ctx)
+	if nil != err {
+		return res, err
+	}
+
+	withdraw, err = uuc.ubRepo.GetWithdrawById(ctx, req.SendBody.Id)
+	if nil == withdraw {
+		return res, err
+	}
+	if !canWithdrawTransit(withdraw.Status, "rejected") {
+		return res, errors.New(500, "ERROR", "当前状态不能驳回")
+	}
+
+	before := *withdraw
+	if err = uuc.rejectWithdraw(ctx, withdraw, adminId, req.SendBody.Reason, func(ctx context.Context) error {
+		if "review" == before.Status {
+			err = uuc.ubRepo.UpdateWithdrawReview(ctx, withdraw.ID, "rejected", adminId, req.SendBody.Reason)
+			if nil != err {
+				return err
+			}
+		}
+
+		return createAdminAuditLog(ctx, uuc.auditRepo, "AdminWithdrawReject", req.SendBody, &before, withdraw)
+	}); nil != err {
+		return res, err
+	}
+
+	return res, nil
+}
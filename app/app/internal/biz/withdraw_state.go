@@ -0,0 +1,181 @@
+package biz
+
+import (
+	"context"
+	v1 "dhb/app/app/api"
+	"github.com/go-kratos/kratos/v2/errors"
+	"time"
+)
+
+type WithdrawEvent struct {
+	ID         int64
+	WithdrawId int64
+	FromStatus string
+	ToStatus   string
+	Operator   string
+	OperatorId int64
+	Remark     string
+	CreatedAt  time.Time
+}
+
+// 提现状态：""待处理，review人工审核，pass待打款(dhb,bnb)，rewarded已分红待打款(usdt)，
+// doing打款中，success成功，rejected驳回，canceled用户撤销；
+// rewarded 驳回时冲回手续费和回池部分后全额退款
+var withdrawTransitions = map[string][]string{
+	"":         {"pass", "rewarded", "review", "rejected", "canceled"},
+	"review":   {"pass", "rewarded", "rejected"},
+	"pass":     {"doing", "rejected"},
+	"rewarded": {"doing", "rejected"},
+	"doing":    {"success", "pass", "rewarded"}, // 打款超时退回待打款
+}
+
+// 提现事件操作人
+const (
+	withdrawOperatorSystem = "system"
+	withdrawOperatorAdmin  = "admin"
+	withdrawOperatorUser   = "user"
+)
+
+func canWithdrawTransit(fromStatus string, toStatus string) bool {
+	for _, v := range withdrawTransitions[fromStatus] {
+		if toStatus == v {
+			return true
+		}
+	}
+
+	return false
+}
+
+// transitWithdraw 提现状态流转，按当前状态条件更新并记录事件，需在事务内调用，成功后更新 withdraw.Status
+func (uuc *UserUseCase) transitWithdraw(ctx context.Context, withdraw *Withdraw, toStatus string, operator string, operatorId int64, remark string) error {
+	var (
+		ok     bool
+		reason string
+		err    error
+	)
+
+	if !canWithdrawTransit(withdraw.Status, toStatus) {
+		return errors.New(500, "WITHDRAW_STATUS_ERROR", "提现状态不允许变更")
+	}
+
+	if "rejected" == toStatus {
+		reason = remark
+	}
+
+	ok, err = uuc.ubRepo.UpdateWithdrawStatus(ctx, withdraw.ID, withdraw.Status, toStatus, reason)
+	if nil != err {
+		return err
+	}
+	if !ok {
+		return errors.New(500, "WITHDRAW_STATUS_ERROR", "提现状态已变更")
+	}
+
+	_, err = uuc.ubRepo.CreateWithdrawEvent(ctx, &WithdrawEvent{
+		WithdrawId: withdraw.ID,
+		FromStatus: withdraw.Status,
+		ToStatus:   toStatus,
+		Operator:   operator,
+		OperatorId: operatorId,
+		Remark:     remark,
+	})
+	if nil != err {
+		return err
+	}
+
+	withdraw.Status = toStatus
+	if "" != reason {
+		withdraw.Reason = reason
+	}
+
+	return nil
+}
+
+// UpdateWithdrawDoing 开始打款
+func (uuc *UserUseCase) UpdateWithdrawDoing(ctx context.Context, withdraw *Withdraw) error {
+	return uuc.tx.ExecTx(ctx, func(ctx context.Context) error { // 事务
+		return uuc.transitWithdraw(ctx, withdraw, "doing", withdrawOperatorSystem, 0, "")
+	})
+}
+
+// UpdateWithdrawSuccess 打款成功，在途转出到链上
+func (uuc *UserUseCase) UpdateWithdrawSuccess(ctx context.Context, withdraw *Withdraw) error {
+	return uuc.tx.ExecTx(ctx, func(ctx context.Context) error { // 事务
+		err := uuc.transitWithdraw(ctx, withdraw, "success", withdrawOperatorSystem, 0, "")
+		if nil != err {
+			return err
+		}
+
+		if "usdt" == withdraw.Type {
+			return uuc.ledgerRepo.PostLedger(ctx, "withdraw_success", withdraw.ID, transferLedger("usdt", withdraw.RelAmount, LedgerAccountPayout, 0, LedgerAccountExternal, 0)...)
+		} else if "dhb" == withdraw.Type {
+			return uuc.ledgerRepo.PostLedger(ctx, "withdraw_success", withdraw.ID, transferLedger("dhb", withdraw.Amount, LedgerAccountPayout, 0, LedgerAccountExternal, 0)...)
+		}
+
+		return nil
+	})
+}
+
+// UpdateWithdrawDoingToRewarded 打款超时的退回待打款，dhb和bnb回到pass，usdt回到rewarded
+func (uuc *UserUseCase) UpdateWithdrawDoingToRewarded(ctx context.Context) error {
+	var (
+		withdraws []*Withdraw
+		err       error
+	)
+
+	ctx, release, err := acquireLease(ctx, uuc.lockRepo, withdrawLease, "UpdateWithdrawDoingToRewarded")
+	if nil != err {
+		return err
+	}
+	defer release()
+
+	withdraws, err = uuc.ubRepo.GetWithdrawDoingTimeout(ctx)
+	if nil != err {
+		return err
+	}
+
+	for _, withdraw := range withdraws {
+		toStatus := "pass"
+		if "usdt" == withdraw.Type {
+			toStatus = "rewarded"
+		}
+
+		if err = uuc.tx.ExecTx(ctx, func(ctx context.Context) error { // 事务
+			return uuc.transitWithdraw(ctx, withdraw, toStatus, withdrawOperatorSystem, 0, "打款超时")
+		}); nil != err {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (uuc *UserUseCase) AdminWithdrawEventList(ctx context.Context, req *v1.AdminWithdrawEventListRequest) (*v1.AdminWithdrawEventListReply, error) {
+	var (
+		events []*WithdrawEvent
+		err    error
+	)
+
+	res := &v1.AdminWithdrawEventListReply{
+		Events: make([]*v1.AdminWithdrawEventListReply_List, 0),
+	}
+
+	events, err = uuc.ubRepo.GetWithdrawEvents(ctx, req.WithdrawId)
+	if nil != err {
+		return res, err
+	}
+
+	for _, v := range events {
+		res.Events = append(res.Events, &v1.AdminWithdrawEventListReply_List{
+			Id:         v.ID,
+			WithdrawId: v.WithdrawId,
+			FromStatus: v.FromStatus,
+			ToStatus:   v.ToStatus,
+			Operator:   v.Operator,
+			OperatorId: v.OperatorId,
+			Remark:     v.Remark,
+			CreatedAt:  v.CreatedAt.Add(8 * time.Hour).Format("2006-01-02 15:04:05"),
+		})
+	}
+
+	return res, nil
+}
@@ -0,0 +1,180 @@
+package biz
+
+import (
+	"context"
+	"testing"
+
+	v1 "dhb/app/app/api"
+)
+
+func TestAdminWithdrawReject(t *testing.T) {
+	const amount = 1000 * 10000000000
+	fee := int64(amount / 100 * 5)
+	relAmount := (amount - fee) / 100 * 50
+
+	tests := []struct {
+		name     string
+		withdraw *Withdraw
+		ok       bool
+		reverses [][2]int64
+	}{
+		{"pending usdt", &Withdraw{ID: 1, UserId: 7, Amount: amount, Type: "usdt", Status: ""}, true, nil},
+		{"pass dhb", &Withdraw{ID: 1, UserId: 7, Amount: amount, Type: "dhb", Status: "pass"}, true, nil},
+		// 已分红的 usdt 冲回手续费和回池部分后全额退款
+		{"rewarded usdt", &Withdraw{ID: 1, UserId: 7, Amount: amount, RelAmount: relAmount, Type: "usdt", Status: "rewarded"}, true,
+			[][2]int64{{fee, amount - fee - relAmount}}},
+		{"doing", &Withdraw{ID: 1, UserId: 7, Amount: amount, Type: "usdt", Status: "doing"}, false, nil},
+		{"success", &Withdraw{ID: 1, UserId: 7, Amount: amount, Type: "usdt", Status: "success"}, false, nil},
+		{"canceled", &Withdraw{ID: 1, UserId: 7, Amount: amount, Type: "usdt", Status: "canceled"}, false, nil},
+	}
+
+	for _, tt := range tests {
+		ubRepo := newTestUbRepo()
+		ubRepo.withdraws[tt.withdraw.ID] = tt.withdraw
+		uuc, auditRepo := newTestUserUseCase(ubRepo)
+		fromStatus := tt.withdraw.Status
+
+		_, err := uuc.AdminWithdrawReject(context.Background(), &v1.AdminWithdrawRejectRequest{
+			SendBody: &v1.AdminWithdrawRejectRequest_SendBody{Id: tt.withdraw.ID, Reason: "test"},
+		})
+		if !tt.ok {
+			if nil == err {
+				t.Errorf("%s: reject should fail", tt.name)
+			}
+			if 0 != len(ubRepo.refunds) || fromStatus != ubRepo.withdraws[1].Status {
+				t.Errorf("%s: refunded %v, status %q after failed reject", tt.name, ubRepo.refunds, ubRepo.withdraws[1].Status)
+			}
+			continue
+		}
+
+		if nil != err {
+			t.Errorf("%s: %v", tt.name, err)
+			continue
+		}
+		if "rejected" != ubRepo.withdraws[1].Status {
+			t.Errorf("%s: status = %q, want rejected", tt.name, ubRepo.withdraws[1].Status)
+		}
+		if 1 != len(ubRepo.refunds) || amount != ubRepo.refunds[0] {
+			t.Errorf("%s: refunds = %v, want [%d]", tt.name, ubRepo.refunds, int64(amount))
+		}
+		if len(tt.reverses) != len(ubRepo.reverses) || (0 < len(tt.reverses) && tt.reverses[0] != ubRepo.reverses[0]) {
+			t.Errorf("%s: reverses = %v, want %v", tt.name, ubRepo.reverses, tt.reverses)
+		}
+		if 1 != len(ubRepo.events) || fromStatus != ubRepo.events[0].FromStatus {
+			t.Errorf("%s: events = %v", tt.name, ubRepo.events)
+		}
+		if 1 != len(auditRepo.logs) {
+			t.Errorf("%s: audit logs = %d, want 1", tt.name, len(auditRepo.logs))
+		}
+
+		// 重放驳回不再退款
+		_, err = uuc.AdminWithdrawReject(context.Background(), &v1.AdminWithdrawRejectRequest{
+			SendBody: &v1.AdminWithdrawRejectRequest_SendBody{Id: tt.withdraw.ID, Reason: "test"},
+		})
+		if nil == err || 1 != len(ubRepo.refunds) || 1 != len(ubRepo.events) {
+			t.Errorf("%s: replayed reject err %v, refunds %v, events %d", tt.name, err, ubRepo.refunds, len(ubRepo.events))
+		}
+	}
+}
+
+func TestWithdrawCancel(t *testing.T) {
+	tests := []struct {
+		name   string
+		status string
+		userId int64
+		ok     bool
+	}{
+		{"pending", "", 7, true},
+		{"other user", "", 8, false},
+		{"review", "review", 7, false},
+		{"pass", "pass", 7, false},
+		{"rewarded", "rewarded", 7, false},
+		{"canceled", "canceled", 7, false},
+	}
+
+	for _, tt := range tests {
+		for _, coinType := range []string{"usdt", "dhb", "bnb"} {
+			ubRepo := newTestUbRepo()
+			ubRepo.withdraws[1] = &Withdraw{ID: 1, UserId: 7, Amount: 500, Type: coinType, Status: tt.status}
+			uuc, _ := newTestUserUseCase(ubRepo)
+
+			_, err := uuc.WithdrawCancel(context.Background(), &v1.WithdrawCancelRequest{
+				SendBody: &v1.WithdrawCancelRequest_SendBody{Id: 1},
+			}, &User{ID: tt.userId})
+			if !tt.ok {
+				if nil == err || 0 != len(ubRepo.refunds) || tt.status != ubRepo.withdraws[1].Status {
+					t.Errorf("%s %s: err %v, refunds %v, status %q", tt.name, coinType, err, ubRepo.refunds, ubRepo.withdraws[1].Status)
+				}
+				continue
+			}
+
+			if nil != err {
+				t.Errorf("%s %s: %v", tt.name, coinType, err)
+				continue
+			}
+			if "canceled" != ubRepo.withdraws[1].Status || 500 != ubRepo.balances[7][coinType] {
+				t.Errorf("%s %s: status %q, balance %d", tt.name, coinType, ubRepo.withdraws[1].Status, ubRepo.balances[7][coinType])
+			}
+			if 1 != len(ubRepo.events) || withdrawOperatorUser != ubRepo.events[0].Operator {
+				t.Errorf("%s %s: events %v", tt.name, coinType, ubRepo.events)
+			}
+		}
+	}
+}
+
+func TestWithdrawPayout(t *testing.T) {
+	const amount = 1000 * 10000000000
+	const relAmount = 900 * 10000000000
+
+	tests := []struct {
+		name    string
+		status  string
+		coin    string
+		payout  int64 // 在途科目转出到链上的金额
+		timeout string
+	}{
+		{"rewarded usdt", "rewarded", "usdt", relAmount, "rewarded"},
+		{"pass dhb", "pass", "dhb", amount, "pass"},
+		{"pass bnb", "pass", "bnb", 0, "pass"},
+	}
+
+	for _, tt := range tests {
+		ubRepo := newTestUbRepo()
+		ubRepo.withdraws[1] = &Withdraw{ID: 1, UserId: 7, Amount: amount, RelAmount: relAmount, Type: tt.coin, Status: tt.status}
+		uuc, _ := newTestUserUseCase(ubRepo)
+		ledgerRepo := uuc.ledgerRepo.(*testLedgerRepo)
+
+		// 打款超时退回待打款，可再次打款
+		w, _ := ubRepo.GetWithdrawById(context.Background(), 1)
+		if err := uuc.UpdateWithdrawDoing(context.Background(), w); nil != err {
+			t.Fatalf("%s: doing: %v", tt.name, err)
+		}
+		if err := uuc.UpdateWithdrawDoingToRewarded(context.Background()); nil != err || tt.timeout != ubRepo.withdraws[1].Status {
+			t.Errorf("%s: timeout err %v, status %q, want %q", tt.name, err, ubRepo.withdraws[1].Status, tt.timeout)
+		}
+		w, _ = ubRepo.GetWithdrawById(context.Background(), 1)
+		if err := uuc.UpdateWithdrawDoing(context.Background(), w); nil != err {
+			t.Fatalf("%s: doing again: %v", tt.name, err)
+		}
+
+		if err := uuc.UpdateWithdrawSuccess(context.Background(), w); nil != err || "success" != ubRepo.withdraws[1].Status {
+			t.Fatalf("%s: success err %v, status %q", tt.name, err, ubRepo.withdraws[1].Status)
+		}
+		// 重放打款成功被状态拒绝，不重复记账
+		w, _ = ubRepo.GetWithdrawById(context.Background(), 1)
+		if err := uuc.UpdateWithdrawSuccess(context.Background(), w); nil == err {
+			t.Errorf("%s: replayed success should fail", tt.name)
+		}
+
+		journals := 0
+		if 0 < tt.payout {
+			journals = 1
+		}
+		if journals != len(ledgerRepo.journals) || -tt.payout != ledgerRepo.accounts["payout_"+tt.coin] || tt.payout != ledgerRepo.accounts["external_"+tt.coin] {
+			t.Errorf("%s: journals %v, accounts %v", tt.name, ledgerRepo.journals, ledgerRepo.accounts)
+		}
+		if 4 != len(ubRepo.events) || "doing" != ubRepo.events[3].FromStatus || "success" != ubRepo.events[3].ToStatus {
+			t.Errorf("%s: events %d", tt.name, len(ubRepo.events))
+		}
+	}
+}
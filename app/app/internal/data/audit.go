@@ -0,0 +1,104 @@
+package data
+
+import (
+	"context"
+	"dhb/app/app/internal/biz"
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+	"gorm.io/gorm"
+	"time"
+)
+
+type AdminAuditLog struct {
+	ID        int64     `gorm:"primarykey;type:int"`
+	AdminId   int64     `gorm:"type:int;not null"`
+	Operation string    `gorm:"type:varchar(45);not null"`
+	Payload   string    `gorm:"type:text;not null"`
+	Before    string    `gorm:"type:text;not null"`
+	After     string    `gorm:"type:text;not null"`
+	Ip        string    `gorm:"type:varchar(45);not null"`
+	CreatedAt time.Time `gorm:"type:datetime;not null"`
+	UpdatedAt time.Time `gorm:"type:datetime;not null"`
+}
+
+type AdminAuditLogRepo struct {
+	data *Data
+	log  *log.Helper
+}
+
+func NewAdminAuditLogRepo(data *Data, logger log.Logger) biz.AdminAuditLogRepo {
+	return &AdminAuditLogRepo{
+		data: data,
+		log:  log.NewHelper(logger),
+	}
+}
+
+// CreateAdminAuditLog .
+func (a *AdminAuditLogRepo) CreateAdminAuditLog(ctx context.Context, l *biz.AdminAuditLog) (*biz.AdminAuditLog, error) {
+	var adminAuditLog AdminAuditLog
+	adminAuditLog.AdminId = l.AdminId
+	adminAuditLog.Operation = l.Operation
+	adminAuditLog.Payload = l.Payload
+	adminAuditLog.Before = l.Before
+	adminAuditLog.After = l.After
+	adminAuditLog.Ip = l.Ip
+
+	res := a.data.DB(ctx).Table("admin_audit_log").Create(&adminAuditLog)
+	if res.Error != nil {
+		return nil, errors.New(500, "CREATE_ADMIN_AUDIT_LOG_ERROR", "操作日志创建失败")
+	}
+
+	return &biz.AdminAuditLog{
+		ID:        adminAuditLog.ID,
+		AdminId:   adminAuditLog.AdminId,
+		Operation: adminAuditLog.Operation,
+		Payload:   adminAuditLog.Payload,
+		Before:    adminAuditLog.Before,
+		After:     adminAuditLog.After,
+		Ip:        adminAuditLog.Ip,
+		CreatedAt: adminAuditLog.CreatedAt,
+	}, nil
+}
+
+// GetAdminAuditLogs .
+func (a *AdminAuditLogRepo) GetAdminAuditLogs(ctx context.Context, b *biz.Pagination, adminId int64, operation string) ([]*biz.AdminAuditLog, error, int64) {
+	var (
+		adminAuditLogs []*AdminAuditLog
+		count          int64
+	)
+	res := make([]*biz.AdminAuditLog, 0)
+
+	instance := a.data.db.Table("admin_audit_log")
+
+	if 0 < adminId {
+		instance = instance.Where("admin_id=?", adminId)
+	}
+
+	if "" != operation {
+		instance = instance.Where("operation=?", operation)
+	}
+
+	instance = instance.Count(&count)
+	if err := instance.Scopes(Paginate(b.PageNum, b.PageSize)).Order("id desc").Find(&adminAuditLogs).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return res, errors.NotFound("ADMIN_AUDIT_LOG_NOT_FOUND", "admin audit log not found"), 0
+		}
+
+		return nil, errors.New(500, "ADMIN AUDIT LOG ERROR", err.Error()), 0
+	}
+
+	for _, v := range adminAuditLogs {
+		res = append(res, &biz.AdminAuditLog{
+			ID:        v.ID,
+			AdminId:   v.AdminId,
+			Operation: v.Operation,
+			Payload:   v.Payload,
+			Before:    v.Before,
+			After:     v.After,
+			Ip:        v.Ip,
+			CreatedAt: v.CreatedAt,
+		})
+	}
+
+	return res, nil, count
+}
@@ -2,6 +2,7 @@ package data
 
 import (
 	"context"
+	"database/sql"
 	"dhb/app/app/internal/biz"
 	"dhb/app/app/internal/conf"
 	"github.com/go-kratos/kratos/v2/log"
@@ -17,7 +18,7 @@ import (
 )
 
 // ProviderSet is data providers.
-var ProviderSet = wire.NewSet(NewData, NewDB, NewRedis, NewTransaction, NewUserRepo, NewUserInfoRepo, NewUserBalanceRepo, NewConfigRepo, NewUserRecommendRepo, NewEthUserRecordRepo, NewLocationRepo, NewUserCurrentMonthRecommendRepo)
+var ProviderSet = wire.NewSet(NewData, NewDB, NewRedis, NewTransaction, NewUserRepo, NewUserInfoRepo, NewUserBalanceRepo, NewConfigRepo, NewUserRecommendRepo, NewEthUserRecordRepo, NewLocationRepo, NewUserCurrentMonthRecommendRepo, NewAdminAuditLogRepo, NewAdminProposalRepo, NewIdempotencyRepo, NewLedgerRepo, NewReconcileRepo, NewBalanceSnapshotRepo, NewStatementRepo, NewSolvencyRepo, NewProvenanceRepo, NewLockRepo)
 
 type Data struct {
 	db  *gorm.DB
@@ -31,6 +32,9 @@ type contextTxKey struct{}
 func NewData(c *conf.Data, logger log.Logger, db *gorm.DB, rdb *redis.Client) (*Data, func(), error) {
 	cleanup := func() {
 		log.NewHelper(logger).Info("closing the data resources")
+		if err := rdb.Close(); err != nil {
+			log.NewHelper(logger).Error(err)
+		}
 	}
 	return &Data{
 		db:  db,
@@ -45,12 +49,33 @@ func NewTransaction(d *Data) biz.Transaction {
 
 // ExecTx gorm Transaction
 func (d *Data) ExecTx(ctx context.Context, fn func(ctx context.Context) error) error {
-	return d.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+	db := d.db.WithContext(ctx)
+	if tx, ok := ctx.Value(contextTxKey{}).(*gorm.DB); ok { // 已在事务内，嵌套为 savepoint，和外层一起提交
+		db = tx
+	}
+
+	return db.Transaction(func(tx *gorm.DB) error {
 		ctx = context.WithValue(ctx, contextTxKey{}, tx)
+		if l := biz.LeaseFromContext(ctx); nil != l {
+			if err := fenceLease(tx, l); nil != err {
+				return err
+			}
+		}
 		return fn(ctx)
 	})
 }
 
+// ReadTx 只读事务，可重复读下事务内的查询都读第一次查询时的快照，不加锁不阻塞入单；已在事务内时直接执行
+func (d *Data) ReadTx(ctx context.Context, fn func(ctx context.Context) error) error {
+	if _, ok := ctx.Value(contextTxKey{}).(*gorm.DB); ok {
+		return fn(ctx)
+	}
+
+	return d.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		return fn(context.WithValue(ctx, contextTxKey{}, tx))
+	}, &sql.TxOptions{Isolation: sql.LevelRepeatableRead, ReadOnly: true})
+}
+
 // DB 根据此方法来判断当前的 db 是不是使用 事务的 DB
 func (d *Data) DB(ctx context.Context) *gorm.DB {
 	tx, ok := ctx.Value(contextTxKey{}).(*gorm.DB)
@@ -97,9 +122,6 @@ func NewRedis(c *conf.Data) *redis.Client {
 		ReadTimeout:  c.Redis.ReadTimeout.AsDuration(),
 	})
 
-	if err := rdb.Close(); err != nil {
-		log.Error(err)
-	}
 	return rdb
 }
 
@@ -0,0 +1,101 @@
+package data
+
+import (
+	"context"
+	"dhb/app/app/internal/pkg/middleware/idempotency"
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+	"gorm.io/gorm"
+	"time"
+)
+
+type IdempotencyRecord struct {
+	ID          int64     `gorm:"primarykey;type:int"`
+	UserId      int64     `gorm:"type:int;not null;uniqueIndex:uk_user_key"`
+	IdemKey     string    `gorm:"type:varchar(64);not null;uniqueIndex:uk_user_key"`
+	Operation   string    `gorm:"type:varchar(100);not null"`
+	Fingerprint string    `gorm:"type:varchar(64);not null;default:''"`
+	Status      string    `gorm:"type:varchar(45);not null"`
+	Reply       string    `gorm:"type:text;not null"`
+	CreatedAt   time.Time `gorm:"type:datetime;not null"`
+	UpdatedAt   time.Time `gorm:"type:datetime;not null"`
+}
+
+type IdempotencyRepo struct {
+	data *Data
+	log  *log.Helper
+}
+
+func NewIdempotencyRepo(data *Data, logger log.Logger) idempotency.Store {
+	return &IdempotencyRepo{
+		data: data,
+		log:  log.NewHelper(logger),
+	}
+}
+
+// Acquire .
+func (i *IdempotencyRepo) Acquire(ctx context.Context, userId int64, operation string, key string, fingerprint string) (*idempotency.Record, bool, error) {
+	var record IdempotencyRecord
+	record.UserId = userId
+	record.IdemKey = key
+	record.Operation = operation
+	record.Fingerprint = fingerprint
+	record.Status = "doing"
+
+	created := &idempotency.Record{
+		UserId:      userId,
+		Operation:   operation,
+		Key:         key,
+		Fingerprint: fingerprint,
+		Status:      "doing",
+	}
+	if err := i.data.DB(ctx).Table("idempotency_record").Create(&record).Error; nil == err {
+		return created, true, nil
+	}
+
+	// 唯一索引冲突，取已有记录
+	var exist IdempotencyRecord
+	if err := i.data.DB(ctx).Table("idempotency_record").Where("user_id=? and idem_key=?", userId, key).First(&exist).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, false, errors.New(500, "CREATE_IDEMPOTENCY_RECORD_ERROR", "幂等记录创建失败")
+		}
+
+		return nil, false, errors.New(500, "IDEMPOTENCY RECORD ERROR", err.Error())
+	}
+
+	return &idempotency.Record{
+		UserId:      exist.UserId,
+		Operation:   exist.Operation,
+		Key:         exist.IdemKey,
+		Fingerprint: exist.Fingerprint,
+		Status:      exist.Status,
+		Reply:       exist.Reply,
+	}, false, nil
+}
+
+// Save 只更新处理中的记录，没有更新到说明 key 已被释放或已完成
+func (i *IdempotencyRepo) Save(ctx context.Context, userId int64, key string, reply string) error {
+	res := i.data.DB(ctx).Table("idempotency_record").
+		Where("user_id=? and idem_key=? and status=?", userId, key, "doing").
+		Updates(map[string]interface{}{"status": "done", "reply": reply})
+	if res.Error != nil {
+		return errors.New(500, "UPDATE_IDEMPOTENCY_RECORD_ERROR", "幂等记录修改失败")
+	}
+	if 0 == res.RowsAffected {
+		return errors.New(500, "UPDATE_IDEMPOTENCY_RECORD_ERROR", "幂等记录不是处理中")
+	}
+
+	return nil
+}
+
+// Release .
+func (i *IdempotencyRepo) Release(ctx context.Context, userId int64, key string) error {
+	res := i.data.DB(ctx).Table("idempotency_record").
+		Where("user_id=? and idem_key=? and status=?", userId, key, "doing").
+		Delete(&IdempotencyRecord{})
+	if res.Error != nil {
+		return errors.New(500, "DELETE_IDEMPOTENCY_RECORD_ERROR", "幂等记录删除失败")
+	}
+
+	return nil
+}
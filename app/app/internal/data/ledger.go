@@ -0,0 +1,249 @@
+package data
+
+import (
+	"context"
+	"dhb/app/app/internal/biz"
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	"sort"
+	"time"
+)
+
+// ledgerBalanceColumns 入账本的币种和 user_balance 上对应的余额列，这些列是用户科目分录的汇总，
+// 只由 postLedger 修改；bnb 不入账本
+var ledgerBalanceColumns = map[string]string{"usdt": "balance_usdt", "dhb": "balance_dhb"}
+
+// LedgerAccount 科目不存余额，系统科目余额按分录汇总，避免每笔入金都改奖金池等同一行
+type LedgerAccount struct {
+	ID          int64     `gorm:"primarykey;type:int"`
+	AccountType string    `gorm:"type:varchar(45);not null;uniqueIndex:uk_account"`
+	UserId      int64     `gorm:"type:int;not null;uniqueIndex:uk_account"`
+	CoinType    string    `gorm:"type:varchar(45);not null;uniqueIndex:uk_account"`
+	CreatedAt   time.Time `gorm:"type:datetime;not null"`
+	UpdatedAt   time.Time `gorm:"type:datetime;not null"`
+}
+
+type LedgerJournal struct {
+	ID        int64     `gorm:"primarykey;type:int"`
+	Event     string    `gorm:"type:varchar(45);not null"`
+	RefId     int64     `gorm:"type:int;not null;index"`
+	CreatedAt time.Time `gorm:"type:datetime;not null"`
+	UpdatedAt time.Time `gorm:"type:datetime;not null"`
+}
+
+type LedgerEntry struct {
+	ID        int64     `gorm:"primarykey;type:int"`
+	JournalId int64     `gorm:"type:int;not null;index"`
+	AccountId int64     `gorm:"type:int;not null;index"`
+	CoinType  string    `gorm:"type:varchar(45);not null"`
+	Amount    int64     `gorm:"type:bigint;not null"`
+	CreatedAt time.Time `gorm:"type:datetime;not null"`
+	UpdatedAt time.Time `gorm:"type:datetime;not null"`
+}
+
+type LedgerMismatch struct {
+	UserId  int64
+	Balance int64
+	Ledger  int64
+}
+
+type LedgerRepo struct {
+	data *Data
+	log  *log.Helper
+}
+
+func NewLedgerRepo(data *Data, logger log.Logger) biz.LedgerRepo {
+	return &LedgerRepo{
+		data: data,
+		log:  log.NewHelper(logger),
+	}
+}
+
+// lockUserBalance 锁用户余额行并返回记账前的余额，流水上的变动后余额按它加本次变动算，需在事务内调用
+func lockUserBalance(ctx context.Context, data *Data, userId int64) (*UserBalance, error) {
+	var userBalance UserBalance
+	if err := data.DB(ctx).Table("user_balance").
+		Clauses(clause.Locking{Strength: "UPDATE"}).
+		Where("user_id=?", userId).
+		Take(&userBalance).Error; nil != err {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errors.NotFound("user balance err", "user balance not found")
+		}
+
+		return nil, errors.New(500, "USER BALANCE ERROR", err.Error())
+	}
+
+	return &userBalance, nil
+}
+
+// postLedger 记一张凭证并把用户科目的分录加到 user_balance，是入账币种余额唯一的写入口，需在事务内调用，
+// 分录不平或扣减后余额为负时报错回滚
+func postLedger(ctx context.Context, data *Data, event string, refId int64, legs ...*biz.LedgerLeg) error {
+	if err := postJournal(ctx, data, event, refId, legs...); nil != err {
+		return err
+	}
+
+	for _, leg := range legs {
+		if biz.LedgerAccountUser != leg.AccountType || 0 == leg.Amount {
+			continue
+		}
+
+		column := ledgerBalanceColumns[leg.CoinType]
+		res := data.DB(ctx).Table("user_balance").
+			Where("user_id=? and "+column+"+?>=0", leg.UserId, leg.Amount).
+			Updates(map[string]interface{}{column: gorm.Expr(column+" + ?", leg.Amount)})
+		if nil != res.Error {
+			return errors.New(500, "UPDATE_USER_BALANCE_ERROR", "用户余额修改失败")
+		}
+		if 0 == res.RowsAffected {
+			return errors.New(500, "BALANCE_NOT_ENOUGH", "余额不足")
+		}
+	}
+
+	return nil
+}
+
+// postJournal 只记凭证和分录，不改 user_balance，除期初外都走 postLedger
+func postJournal(ctx context.Context, data *Data, event string, refId int64, legs ...*biz.LedgerLeg) error {
+	sum := make(map[string]int64, 0)
+	for _, leg := range legs {
+		if _, ok := ledgerBalanceColumns[leg.CoinType]; !ok {
+			return errors.New(500, "LEDGER_COIN_ERROR", "该币种不入账本")
+		}
+		sum[leg.CoinType] += leg.Amount
+	}
+	for _, v := range sum {
+		if 0 != v {
+			return errors.New(500, "LEDGER_NOT_BALANCED", "记账分录不平")
+		}
+	}
+
+	var journal LedgerJournal
+	journal.Event = event
+	journal.RefId = refId
+	if err := data.DB(ctx).Table("ledger_journal").Create(&journal).Error; nil != err {
+		return errors.New(500, "CREATE_LEDGER_JOURNAL_ERROR", "记账凭证创建失败")
+	}
+
+	for _, leg := range legs {
+		if 0 == leg.Amount {
+			continue
+		}
+
+		var account LedgerAccount
+		if err := data.DB(ctx).Table("ledger_account").
+			Where("account_type=? and user_id=? and coin_type=?", leg.AccountType, leg.UserId, leg.CoinType).
+			Attrs(LedgerAccount{AccountType: leg.AccountType, UserId: leg.UserId, CoinType: leg.CoinType}).
+			FirstOrCreate(&account).Error; nil != err {
+			return errors.New(500, "CREATE_LEDGER_ACCOUNT_ERROR", "记账科目创建失败")
+		}
+
+		var entry LedgerEntry
+		entry.JournalId = journal.ID
+		entry.AccountId = account.ID
+		entry.CoinType = leg.CoinType
+		entry.Amount = leg.Amount
+		if err := data.DB(ctx).Table("ledger_entry").Create(&entry).Error; nil != err {
+			return errors.New(500, "CREATE_LEDGER_ENTRY_ERROR", "记账分录创建失败")
+		}
+	}
+
+	return nil
+}
+
+// userLedger 用户余额与系统科目之间的转账分录，amount 为用户余额变动
+func userLedger(userId int64, coinType string, amount int64, accountType string) []*biz.LedgerLeg {
+	return []*biz.LedgerLeg{
+		{AccountType: biz.LedgerAccountUser, UserId: userId, CoinType: coinType, Amount: amount},
+		{AccountType: accountType, CoinType: coinType, Amount: -amount},
+	}
+}
+
+// systemLedger 系统科目之间的转账分录
+func systemLedger(coinType string, amount int64, fromType string, toType string) []*biz.LedgerLeg {
+	return []*biz.LedgerLeg{
+		{AccountType: fromType, CoinType: coinType, Amount: -amount},
+		{AccountType: toType, CoinType: coinType, Amount: amount},
+	}
+}
+
+// PostLedger .
+func (l *LedgerRepo) PostLedger(ctx context.Context, event string, refId int64, legs ...*biz.LedgerLeg) error {
+	return postLedger(ctx, l.data, event, refId, legs...)
+}
+
+// GetLedgerMismatches 每个入账币种逐用户对比 user_balance 与账本分录汇总，有分录没有余额行的用户按余额0算
+func (l *LedgerRepo) GetLedgerMismatches(ctx context.Context) ([]*biz.LedgerMismatch, error) {
+	res := make([]*biz.LedgerMismatch, 0)
+
+	coinTypes := make([]string, 0, len(ledgerBalanceColumns))
+	for coinType := range ledgerBalanceColumns {
+		coinTypes = append(coinTypes, coinType)
+	}
+	sort.Strings(coinTypes)
+
+	for _, coinType := range coinTypes {
+		var mismatches []*LedgerMismatch
+		column := ledgerBalanceColumns[coinType]
+		entries := "(select la.user_id, sum(le.amount) as total from ledger_entry le join ledger_account la on la.id=le.account_id " +
+			"where la.account_type=? and la.coin_type=? group by la.user_id)"
+		if err := l.data.db.Raw("select ub.user_id as user_id, ub."+column+" as balance, coalesce(e.total, 0) as ledger from user_balance ub "+
+			"left join "+entries+" e on e.user_id=ub.user_id where ub."+column+"<>coalesce(e.total, 0) "+
+			"union all select e.user_id as user_id, 0 as balance, e.total as ledger from "+entries+" e "+
+			"where e.total<>0 and e.user_id not in (select user_id from user_balance)",
+			biz.LedgerAccountUser, coinType, biz.LedgerAccountUser, coinType).
+			Scan(&mismatches).Error; nil != err {
+			return nil, errors.New(500, "LEDGER ERROR", err.Error())
+		}
+
+		for _, v := range mismatches {
+			res = append(res, &biz.LedgerMismatch{
+				UserId:   v.UserId,
+				CoinType: coinType,
+				Balance:  v.Balance,
+				Ledger:   v.Ledger,
+			})
+		}
+	}
+
+	return res, nil
+}
+
+// OpenLedgerUserBalances 未记期初的用户按 user_balance 与已入账的差额记期初，返回处理的用户数
+func (l *LedgerRepo) OpenLedgerUserBalances(ctx context.Context) (int64, error) {
+	var (
+		userBalances []*UserBalance
+		count        int64
+	)
+
+	if err := l.data.DB(ctx).Table("user_balance").
+		Where("user_id not in (?)", l.data.DB(ctx).Table("ledger_journal").Select("ref_id").Where("event=?", "opening")).
+		Find(&userBalances).Error; nil != err {
+		return 0, errors.New(500, "USER BALANCE ERROR", err.Error())
+	}
+
+	for _, v := range userBalances {
+		var legs []*biz.LedgerLeg
+		for coinType, balance := range map[string]int64{"usdt": v.BalanceUsdt, "dhb": v.BalanceDhb} {
+			var total UserBalanceTotal
+			if err := l.data.DB(ctx).Table("ledger_entry").
+				Joins("join ledger_account on ledger_account.id=ledger_entry.account_id").
+				Where("ledger_account.account_type=? and ledger_account.user_id=? and ledger_account.coin_type=?", biz.LedgerAccountUser, v.UserId, coinType).
+				Select("coalesce(sum(ledger_entry.amount), 0) as total").Take(&total).Error; nil != err {
+				return count, errors.New(500, "LEDGER ERROR", err.Error())
+			}
+
+			legs = append(legs, userLedger(v.UserId, coinType, balance-total.Total, biz.LedgerAccountAdjust)...)
+		}
+
+		// 期初是把已有余额补记进账本，余额不变
+		if err := postJournal(ctx, l.data, "opening", v.UserId, legs...); nil != err {
+			return count, err
+		}
+		count++
+	}
+
+	return count, nil
+}
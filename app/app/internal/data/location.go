@@ -14,7 +14,7 @@ type Location struct {
 	UserId       int64     `gorm:"type:int;not null"`
 	Row          int64     `gorm:"type:int;not null"`
 	Col          int64     `gorm:"type:int;not null"`
-	Status       string    `gorm:"type:varchar(45);not null"`
+	Status       string    `gorm:"type:varchar(45);not null;index"` // 运行中序号见 LocationRankBucket
 	CurrentLevel int64     `gorm:"type:int;not null"`
 	Current      int64     `gorm:"type:bigint;not null"`
 	CurrentMax   int64     `gorm:"type:bigint;not null"`
@@ -24,11 +24,6 @@ type Location struct {
 	UpdatedAt    time.Time `gorm:"type:datetime;not null"`
 }
 
-type GlobalLock struct {
-	ID     int64 `gorm:"primarykey;type:int"`
-	Status int64 `gorm:"type:int;not null"`
-}
-
 type LocationRepo struct {
 	data *Data
 	log  *log.Helper
@@ -41,11 +36,9 @@ func NewLocationRepo(data *Data, logger log.Logger) biz.LocationRepo {
 	}
 }
 
-// CreateLocation .
+// CreateLocation 行列不落库，按运行中序号计算，运行中的同时计入分桶计数
 func (lr *LocationRepo) CreateLocation(ctx context.Context, rel *biz.Location) (*biz.Location, error) {
 	var location Location
-	location.Col = rel.Col
-	location.Row = rel.Row
 	location.Status = rel.Status
 	location.Current = rel.Current
 	location.CurrentMax = rel.CurrentMax
@@ -55,6 +48,11 @@ func (lr *LocationRepo) CreateLocation(ctx context.Context, rel *biz.Location) (
 	if res.Error != nil {
 		return nil, errors.New(500, "CREATE_LOCATION_ERROR", "占位信息创建失败")
 	}
+	if "running" == location.Status {
+		if err := addLocationRank(ctx, lr.data, location.ID, 1); nil != err {
+			return nil, err
+		}
+	}
 
 	return &biz.Location{
 		ID:           location.ID,
@@ -63,8 +61,8 @@ func (lr *LocationRepo) CreateLocation(ctx context.Context, rel *biz.Location) (
 		CurrentLevel: location.CurrentLevel,
 		Current:      location.Current,
 		CurrentMax:   location.CurrentMax,
-		Row:          location.Row,
-		Col:          location.Col,
+		Row:          rel.Row,
+		Col:          rel.Col,
 	}, nil
 }
 
@@ -74,7 +72,7 @@ func (lr *LocationRepo) GetLocationDailyYesterday(ctx context.Context, day int)
 	res := make([]*biz.Location, 0)
 	instance := lr.data.db.Table("location")
 
-	now := time.Now().UTC().AddDate(0, 0, day)
+	now := biz.Now(ctx).AddDate(0, 0, day)
 	var startDate time.Time
 	var endDate time.Time
 	if 14 <= now.Hour() {
@@ -112,33 +110,10 @@ func (lr *LocationRepo) GetLocationDailyYesterday(ctx context.Context, day int)
 	return res, nil
 }
 
-// GetLocationLast .
-func (lr *LocationRepo) GetLocationLast(ctx context.Context) (*biz.Location, error) {
-	var location Location
-	if err := lr.data.db.Table("location").Where("status=?", "running").Order("id desc").First(&location).Error; err != nil {
-		if errors.Is(err, gorm.ErrRecordNotFound) {
-			return nil, errors.NotFound("LOCATION_NOT_FOUND", "location not found")
-		}
-
-		return nil, errors.New(500, "LOCATION ERROR", err.Error())
-	}
-
-	return &biz.Location{
-		ID:           location.ID,
-		UserId:       location.UserId,
-		Status:       location.Status,
-		CurrentLevel: location.CurrentLevel,
-		Current:      location.Current,
-		CurrentMax:   location.CurrentMax,
-		Row:          location.Row,
-		Col:          location.Col,
-	}, nil
-}
-
 // GetMyLocationLast .
 func (lr *LocationRepo) GetMyLocationLast(ctx context.Context, userId int64) (*biz.Location, error) {
 	var location Location
-	if err := lr.data.db.Table("location").Where("user_id", userId).Order("id desc").First(&location).Error; err != nil {
+	if err := lr.data.DB(ctx).Table("location").Where("user_id", userId).Order("id desc").First(&location).Error; err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
 			return nil, errors.NotFound("LOCATION_NOT_FOUND", "location not found")
 		}
@@ -163,7 +138,7 @@ func (lr *LocationRepo) GetMyLocationLast(ctx context.Context, userId int64) (*b
 // GetMyStopLocationLast .
 func (lr *LocationRepo) GetMyStopLocationLast(ctx context.Context, userId int64) (*biz.Location, error) {
 	var location Location
-	if err := lr.data.db.Table("location").
+	if err := lr.data.DB(ctx).Table("location").
 		Where("status=?", "stop").
 		Where("user_id", userId).Order("id desc").First(&location).Error; err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
@@ -464,7 +439,7 @@ func (lr *LocationRepo) GetAllLocationsAfter(ctx context.Context) ([]*biz.Locati
 func (lr *LocationRepo) GetLocationsByUserId(ctx context.Context, userId int64) ([]*biz.Location, error) {
 	var locations []*Location
 	res := make([]*biz.Location, 0)
-	if err := lr.data.db.Table("location").
+	if err := lr.data.DB(ctx).Table("location").
 		Where("user_id=?", userId).
 		Order("id desc").Find(&locations).Error; err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
@@ -484,6 +459,8 @@ func (lr *LocationRepo) GetLocationsByUserId(ctx context.Context, userId int64)
 			CurrentMax:   location.CurrentMax,
 			Row:          location.Row,
 			Col:          location.Col,
+			StopDate:     location.StopDate,
+			CreatedAt:    location.CreatedAt,
 		})
 	}
 
@@ -494,7 +471,7 @@ func (lr *LocationRepo) GetLocationsByUserId(ctx context.Context, userId int64)
 func (lr *LocationRepo) GetLocationsStopNotUpdate(ctx context.Context) ([]*biz.Location, error) {
 	var locations []*Location
 	res := make([]*biz.Location, 0)
-	if err := lr.data.db.Table("location").
+	if err := lr.data.DB(ctx).Table("location").
 		Where("status=?", "stop").
 		Where("stop_is_update=?", 0).
 		Find(&locations).Error; err != nil {
@@ -521,84 +498,11 @@ func (lr *LocationRepo) GetLocationsStopNotUpdate(ctx context.Context) ([]*biz.L
 	return res, nil
 }
 
-// LockGlobalLocation .
-func (lr *LocationRepo) LockGlobalLocation(ctx context.Context) (bool, error) {
-	res := lr.data.DB(ctx).Where("id=? and status<=?", 1, 2).
-		Table("global_lock").
-		Updates(map[string]interface{}{"status": 1})
-
-	if 0 <= res.RowsAffected {
-		return true, nil
-	}
-
-	return false, res.Error
-
-}
-
-// UnLockGlobalLocation .
-func (lr *LocationRepo) UnLockGlobalLocation(ctx context.Context) (bool, error) {
-	res := lr.data.DB(ctx).Where("id=? and status=?", 1, 1).
-		Table("global_lock").
-		Updates(map[string]interface{}{"status": 2})
-
-	if 0 <= res.RowsAffected {
-		return true, nil
-	}
-
-	return false, res.Error
-}
-
-// LockGlobalWithdraw .
-func (lr *LocationRepo) LockGlobalWithdraw(ctx context.Context) (bool, error) {
-	res := lr.data.DB(ctx).Where("id=? and status>=?", 1, 2).
-		Table("global_lock").
-		Updates(map[string]interface{}{"status": 3})
-
-	if 0 <= res.RowsAffected {
-		return true, nil
-	}
-
-	return false, res.Error
-}
-
-// GetLockGlobalLocation .
-func (lr *LocationRepo) GetLockGlobalLocation(ctx context.Context) (*biz.GlobalLock, error) {
-	var globalLock GlobalLock
-	if res := lr.data.DB(ctx).Where("id=?", 1).
-		Table("global_lock").
-		First(&globalLock); res.Error != nil {
-		return nil, res.Error
-	}
-
-	return &biz.GlobalLock{
-		ID:     globalLock.ID,
-		Status: globalLock.Status,
-	}, nil
-}
-
-// UnLockGlobalWithdraw .
-func (lr *LocationRepo) UnLockGlobalWithdraw(ctx context.Context) (bool, error) {
-	res := lr.data.DB(ctx).Where("id=? and status=?", 1, 3).
-		Table("global_lock").
-		Updates(map[string]interface{}{"status": 2})
-
-	if 0 <= res.RowsAffected {
-		return true, nil
-	}
-
-	return false, res.Error
-}
-
 // UpdateLocation .
 func (lr *LocationRepo) UpdateLocation(ctx context.Context, id int64, status string, current int64, stopDate time.Time) error {
 
 	if "stop" == status {
-		res := lr.data.DB(ctx).Table("location").
-			Where("id=?", id).
-			Updates(map[string]interface{}{"current": gorm.Expr("current + ?", current), "status": "stop", "stop_date": stopDate})
-		if 0 == res.RowsAffected || res.Error != nil {
-			return res.Error
-		}
+		return setLocationStatus(ctx, lr.data, id, "stop", map[string]interface{}{"current": gorm.Expr("current + ?", current), "stop_date": stopDate})
 	} else {
 		res := lr.data.DB(ctx).Table("location").
 			Where("id=?", id).
@@ -636,19 +540,11 @@ func (lr *LocationRepo) UpdateSubCurrentLocation(ctx context.Context, id int64,
 
 // UpdateSubCurrentLocation2 .
 func (lr *LocationRepo) UpdateSubCurrentLocation2(ctx context.Context, id int64, amount int64, status string, stopIsUpdate int64) error {
-	res := lr.data.DB(ctx).Table("location").
-		Where("id=?", id).
-		Updates(map[string]interface{}{
-			"current_max":    gorm.Expr("current_max + ?", amount),
-			"status":         status,
-			"stop_is_update": stopIsUpdate,
-			"stop_date":      "0000-00-00 00:00:00",
-		})
-	if 0 == res.RowsAffected || res.Error != nil {
-		return res.Error
-	}
-
-	return nil
+	return setLocationStatus(ctx, lr.data, id, status, map[string]interface{}{
+		"current_max":    gorm.Expr("current_max + ?", amount),
+		"stop_is_update": stopIsUpdate,
+		"stop_date":      "0000-00-00 00:00:00",
+	})
 }
 
 // UpdateSubCurrentLocation3 .
@@ -667,29 +563,7 @@ func (lr *LocationRepo) UpdateSubCurrentLocation3(ctx context.Context, id int64,
 
 // UpdateLocationRowAndCol 事务中使用 .
 func (lr *LocationRepo) UpdateLocationRowAndCol(ctx context.Context, id int64) error {
-
-	if res := lr.data.DB(ctx).Table("location").
-		Where("id>?", id).
-		Where("col > 1").
-		Where("update_status=?", 0).
-		Updates(map[string]interface{}{"col": gorm.Expr("col - ?", 1), "update_status": 1}); res.Error != nil {
-		return res.Error
-	}
-
-	if res := lr.data.DB(ctx).Table("location").
-		Where("id>?", id).
-		Where("col = 1").
-		Where("update_status=?", 0).
-		Updates(map[string]interface{}{"row": gorm.Expr("row - ?", 1), "col": 3, "update_status": 1}); res.Error != nil {
-		return res.Error
-	}
-
-	if res := lr.data.DB(ctx).Table("location").
-		Where("id>?", id).
-		Updates(map[string]interface{}{"update_status": 0}); res.Error != nil {
-		return res.Error
-	}
-
+	// 行列按运行中序号计算，停止后其它占位自动前移，这里只标记已处理
 	if res := lr.data.DB(ctx).Table("location").
 		Where("id=?", id).
 		Updates(map[string]interface{}{"stop_is_update": 1}); res.Error != nil {
@@ -698,47 +572,6 @@ func (lr *LocationRepo) UpdateLocationRowAndCol(ctx context.Context, id int64) e
 	return nil
 }
 
-// GetRewardLocationByRowOrCol .
-func (lr *LocationRepo) GetRewardLocationByRowOrCol(ctx context.Context, row int64, col int64, locationRowConfig int64) ([]*biz.Location, error) {
-	var (
-		rowMin    int64 = 1
-		rowMax    int64
-		locations []*Location
-	)
-	if row > locationRowConfig {
-		rowMin = row - locationRowConfig
-	}
-	rowMax = row + locationRowConfig
-
-	if err := lr.data.db.Table("location").
-		Where("status=?", "running").
-		Where("col=? and row>=? and row<=?", col, rowMin, rowMax).
-		Find(&locations).Error; err != nil {
-		if errors.Is(err, gorm.ErrRecordNotFound) {
-			return nil, errors.NotFound("LOCATION_NOT_FOUND", "location not found")
-		}
-
-		return nil, errors.New(500, "LOCATION ERROR", err.Error())
-	}
-
-	res := make([]*biz.Location, 0)
-	for _, location := range locations {
-		res = append(res, &biz.Location{
-			ID:           location.ID,
-			UserId:       location.UserId,
-			Status:       location.Status,
-			CurrentLevel: location.CurrentLevel,
-			Current:      location.Current,
-			CurrentMax:   location.CurrentMax,
-			Row:          location.Row,
-			Col:          location.Col,
-			StopDate:     location.StopDate,
-		})
-	}
-
-	return res, nil
-}
-
 // GetRewardLocationByIds .
 func (lr *LocationRepo) GetRewardLocationByIds(ctx context.Context, ids ...int64) (map[int64]*biz.Location, error) {
 	var locations []*Location
@@ -0,0 +1,262 @@
+package data
+
+import (
+	"context"
+	"dhb/app/app/internal/biz"
+	"github.com/go-kratos/kratos/v2/errors"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	"time"
+)
+
+// locationRankBucketSize 每个桶覆盖的占位 id 数
+const locationRankBucketSize = 1024
+
+// LocationRankBucket 运行中占位按 id 分桶计数，桶号为 id/locationRankBucketSize。
+// 序号 = 前面各桶计数之和 + 本桶内 id 更小的运行中占位数，代价是桶数加一个桶的范围计数，
+// 占位状态变化时在同一事务内改对应桶的计数
+type LocationRankBucket struct {
+	Bucket    int64     `gorm:"primarykey;type:int;autoIncrement:false"`
+	Running   int64     `gorm:"type:int;not null"`
+	CreatedAt time.Time `gorm:"type:datetime;not null"`
+	UpdatedAt time.Time `gorm:"type:datetime;not null"`
+}
+
+// locationRankBucket .
+func locationRankBucket(id int64) int64 {
+	return id / locationRankBucketSize
+}
+
+// bucketRankBefore 按桶号升序的计数，bucket 之前各桶的运行中占位数
+func bucketRankBefore(buckets []*LocationRankBucket, bucket int64) int64 {
+	var total int64
+	for _, v := range buckets {
+		if v.Bucket >= bucket {
+			break
+		}
+		total += v.Running
+	}
+
+	return total
+}
+
+// bucketOfRank 按桶号升序的计数，序号 rank 所在的桶和之前各桶的运行中占位数，超出时返回 false
+func bucketOfRank(buckets []*LocationRankBucket, rank int64) (int64, int64, bool) {
+	var before int64
+	for _, v := range buckets {
+		if rank < before+v.Running {
+			return v.Bucket, before, true
+		}
+		before += v.Running
+	}
+
+	return 0, before, false
+}
+
+// addLocationRank 运行中占位增减，需和占位状态修改在同一事务内
+func addLocationRank(ctx context.Context, data *Data, id int64, delta int64) error {
+	if 0 == delta {
+		return nil
+	}
+
+	bucket := LocationRankBucket{Bucket: locationRankBucket(id), Running: delta}
+	if err := data.DB(ctx).Table("location_rank_bucket").Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "bucket"}},
+		DoUpdates: clause.Assignments(map[string]interface{}{"running": gorm.Expr("running + ?", delta), "updated_at": time.Now()}),
+	}).Create(&bucket).Error; nil != err {
+		return errors.New(500, "LOCATION_RANK_ERROR", "占位序号计数修改失败")
+	}
+
+	return nil
+}
+
+// setLocationStatus 修改占位状态，进出 running 时同步分桶计数，需在事务内调用
+func setLocationStatus(ctx context.Context, data *Data, id int64, status string, updates map[string]interface{}) error {
+	var location Location
+	if err := data.DB(ctx).Table("location").Clauses(clause.Locking{Strength: "UPDATE"}).
+		Select("id", "status").Where("id=?", id).Take(&location).Error; nil != err {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return errors.NotFound("LOCATION_NOT_FOUND", "location not found")
+		}
+
+		return errors.New(500, "LOCATION ERROR", err.Error())
+	}
+
+	updates["status"] = status
+	if err := data.DB(ctx).Table("location").Where("id=?", id).Updates(updates).Error; nil != err {
+		return errors.New(500, "UPDATE_LOCATION_ERROR", "占位信息修改失败")
+	}
+
+	var delta int64
+	if "running" != location.Status && "running" == status {
+		delta = 1
+	} else if "running" == location.Status && "running" != status {
+		delta = -1
+	}
+
+	return addLocationRank(ctx, data, id, delta)
+}
+
+// getLocationRankBuckets 按桶号升序的计数
+func (lr *LocationRepo) getLocationRankBuckets(ctx context.Context) ([]*LocationRankBucket, error) {
+	var buckets []*LocationRankBucket
+	if err := lr.data.DB(ctx).Table("location_rank_bucket").Where("running>?", 0).Order("bucket asc").Find(&buckets).Error; nil != err {
+		return nil, errors.New(500, "LOCATION_RANK_ERROR", err.Error())
+	}
+
+	return buckets, nil
+}
+
+// RebuildLocationRanks 按 location 表重算分桶计数，上线或计数异常时执行，需持有占位锁
+func (lr *LocationRepo) RebuildLocationRanks(ctx context.Context) (int64, error) {
+	var (
+		buckets []*LocationRankBucket
+		total   int64
+	)
+
+	if err := lr.data.DB(ctx).Table("location").
+		Select("floor(id/?) as bucket, count(*) as running", locationRankBucketSize).
+		Where("status=?", "running").Group("bucket").Scan(&buckets).Error; nil != err {
+		return 0, errors.New(500, "LOCATION ERROR", err.Error())
+	}
+
+	if err := lr.data.DB(ctx).Exec("delete from location_rank_bucket").Error; nil != err {
+		return 0, errors.New(500, "LOCATION_RANK_ERROR", err.Error())
+	}
+	for _, v := range buckets {
+		v.CreatedAt = time.Now()
+		v.UpdatedAt = time.Now()
+		total += v.Running
+	}
+	if 0 < len(buckets) {
+		if err := lr.data.DB(ctx).Table("location_rank_bucket").CreateInBatches(buckets, 500).Error; nil != err {
+			return 0, errors.New(500, "LOCATION_RANK_ERROR", err.Error())
+		}
+	}
+
+	return total, nil
+}
+
+// MigrateLocationRanks 建分桶计数表和 location(status, id) 索引，可重复执行
+func (lr *LocationRepo) MigrateLocationRanks(ctx context.Context) error {
+	var count int64
+
+	if !lr.data.db.Migrator().HasTable("location_rank_bucket") {
+		if err := lr.data.db.Table("location_rank_bucket").Migrator().CreateTable(&LocationRankBucket{}); nil != err {
+			return errors.New(500, "LOCATION_RANK_ERROR", err.Error())
+		}
+	}
+
+	if err := lr.data.db.Raw("select count(*) from information_schema.statistics where table_schema=database() and table_name=? and index_name=?",
+		"location", "idx_location_status_id").Scan(&count).Error; nil != err {
+		return errors.New(500, "LOCATION ERROR", err.Error())
+	}
+	if 0 == count {
+		if err := lr.data.db.Exec("create index idx_location_status_id on location (status, id)").Error; nil != err {
+			return errors.New(500, "LOCATION ERROR", err.Error())
+		}
+	}
+
+	return nil
+}
+
+// GetLocationRunningCount 运行中占位数，也是新占位的序号
+func (lr *LocationRepo) GetLocationRunningCount(ctx context.Context) (int64, error) {
+	var count int64
+	buckets, err := lr.getLocationRankBuckets(ctx)
+	if nil != err {
+		return 0, err
+	}
+	for _, v := range buckets {
+		count += v.Running
+	}
+
+	return count, nil
+}
+
+// LocationRank .
+type LocationRank struct {
+	ID   int64
+	Rank int64
+}
+
+// GetLocationRunningRanks 运行中占位的序号（从0开始），前面各桶取计数，本桶内走 (status, id) 索引范围计数
+func (lr *LocationRepo) GetLocationRunningRanks(ctx context.Context, ids ...int64) (map[int64]int64, error) {
+	var ranks []*LocationRank
+	res := make(map[int64]int64, 0)
+	if 0 == len(ids) {
+		return res, nil
+	}
+
+	buckets, err := lr.getLocationRankBuckets(ctx)
+	if nil != err {
+		return nil, err
+	}
+
+	if err = lr.data.DB(ctx).Raw("select l.id as id, (select count(*) from location r where r.status=? and r.id>=floor(l.id/?)*? and r.id<l.id) as `rank` "+
+		"from location l where l.id in (?)", "running", locationRankBucketSize, locationRankBucketSize, ids).Scan(&ranks).Error; err != nil {
+		return nil, errors.New(500, "LOCATION ERROR", err.Error())
+	}
+
+	for _, v := range ranks {
+		res[v.ID] = bucketRankBefore(buckets, locationRankBucket(v.ID)) + v.Rank
+	}
+
+	return res, nil
+}
+
+// GetRewardLocationByRowOrCol 前后 locationRowConfig 行内的运行中占位，按分桶计数定位窗口起点所在的桶，
+// 从桶起点按 (status, id) 索引顺序读，同行同列由调用方筛选
+func (lr *LocationRepo) GetRewardLocationByRowOrCol(ctx context.Context, row int64, col int64, locationRowConfig int64, width int64) ([]*biz.Location, error) {
+	var (
+		rowMin    int64 = 1
+		rowMax    int64
+		locations []*Location
+	)
+	res := make([]*biz.Location, 0)
+	if row > locationRowConfig {
+		rowMin = row - locationRowConfig
+	}
+	rowMax = row + locationRowConfig
+
+	buckets, err := lr.getLocationRankBuckets(ctx)
+	if nil != err {
+		return nil, err
+	}
+
+	start := (rowMin - 1) * width
+	bucket, before, ok := bucketOfRank(buckets, start)
+	if !ok {
+		return res, nil
+	}
+
+	skip := start - before
+	if err = lr.data.DB(ctx).Table("location").
+		Where("status=? and id>=?", "running", bucket*locationRankBucketSize).
+		Order("id asc").
+		Limit(int(skip + (rowMax-rowMin+1)*width)).
+		Find(&locations).Error; err != nil {
+		return nil, errors.New(500, "LOCATION ERROR", err.Error())
+	}
+
+	for k, location := range locations {
+		if int64(k) < skip {
+			continue
+		}
+
+		locationRow, locationCol := biz.LocationPosition(start+int64(k)-skip, width)
+		res = append(res, &biz.Location{
+			ID:           location.ID,
+			UserId:       location.UserId,
+			Status:       location.Status,
+			CurrentLevel: location.CurrentLevel,
+			Current:      location.Current,
+			CurrentMax:   location.CurrentMax,
+			Row:          locationRow,
+			Col:          locationCol,
+			StopDate:     location.StopDate,
+		})
+	}
+
+	return res, nil
+}
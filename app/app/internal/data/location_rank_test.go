@@ -0,0 +1,78 @@
+package data
+
+import (
+	"math/rand"
+	"testing"
+)
+
+// testRankBuckets 按运行状态算分桶计数，和 addLocationRank 维护的结果一致
+func testRankBuckets(running map[int64]bool, maxId int64) []*LocationRankBucket {
+	buckets := make([]*LocationRankBucket, 0)
+	for id := int64(1); id <= maxId; id++ {
+		if !running[id] {
+			continue
+		}
+		bucket := locationRankBucket(id)
+		if 0 == len(buckets) || bucket != buckets[len(buckets)-1].Bucket {
+			buckets = append(buckets, &LocationRankBucket{Bucket: bucket})
+		}
+		buckets[len(buckets)-1].Running++
+	}
+
+	return buckets
+}
+
+// TestLocationRankBuckets 分桶计数算出的序号和窗口起点与逐个计数一致
+func TestLocationRankBuckets(t *testing.T) {
+	const maxId = 5 * locationRankBucketSize
+	r := rand.New(rand.NewSource(1))
+	running := make(map[int64]bool, maxId)
+	for id := int64(1); id <= maxId; id++ {
+		running[id] = true
+	}
+	// 随机停止，中间整桶停空
+	for id := int64(1); id <= maxId; id++ {
+		if 0 == r.Intn(3) || 2 == locationRankBucket(id) {
+			running[id] = false
+		}
+	}
+	buckets := testRankBuckets(running, maxId)
+
+	var rank int64
+	ranks := make([]int64, 0)
+	for id := int64(1); id <= maxId; id++ {
+		if !running[id] {
+			continue
+		}
+
+		var inBucket int64
+		for j := locationRankBucket(id) * locationRankBucketSize; j < id; j++ {
+			if running[j] {
+				inBucket++
+			}
+		}
+		if got := bucketRankBefore(buckets, locationRankBucket(id)) + inBucket; rank != got {
+			t.Fatalf("rank of %d = %d, want %d", id, got, rank)
+		}
+		ranks = append(ranks, id)
+		rank++
+	}
+
+	for _, start := range []int64{0, 1, 100, rank / 2, rank - 1} {
+		bucket, before, ok := bucketOfRank(buckets, start)
+		if !ok {
+			t.Fatalf("rank %d not found", start)
+		}
+		id := ranks[start]
+		if locationRankBucket(id) != bucket {
+			t.Errorf("bucket of rank %d = %d, want %d", start, bucket, locationRankBucket(id))
+		}
+		if start < before || bucketRankBefore(buckets, bucket) != before {
+			t.Errorf("before of rank %d = %d", start, before)
+		}
+	}
+
+	if _, before, ok := bucketOfRank(buckets, rank); ok || rank != before {
+		t.Errorf("rank %d past the end: ok %v, before %d", rank, ok, before)
+	}
+}
@@ -0,0 +1,143 @@
+package data
+
+import (
+	"context"
+	"dhb/app/app/internal/biz"
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/go-redis/redis/v8"
+	"gorm.io/gorm"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// LockFence 每把锁已见过的最大 token，事务内校验
+type LockFence struct {
+	Name      string    `gorm:"primarykey;type:varchar(45)"`
+	Token     int64     `gorm:"type:bigint;not null"`
+	CreatedAt time.Time `gorm:"type:datetime;not null"`
+	UpdatedAt time.Time `gorm:"type:datetime;not null"`
+}
+
+type LockRepo struct {
+	data   *Data
+	prefix string
+	log    *log.Helper
+}
+
+var (
+	// 值和持有者一致才续期
+	renewLeaseScript = redis.NewScript(`if redis.call("get", KEYS[1]) == ARGV[1] then return redis.call("pexpire", KEYS[1], ARGV[2]) else return 0 end`)
+	// 值和持有者一致才删除
+	releaseLeaseScript = redis.NewScript(`if redis.call("get", KEYS[1]) == ARGV[1] then return redis.call("del", KEYS[1]) else return 0 end`)
+)
+
+// NewLockRepo 锁的 key 带库名，影子库重放不会和生产抢锁
+func NewLockRepo(data *Data, logger log.Logger) biz.LockRepo {
+	var schema string
+	if err := data.db.Raw("select database()").Scan(&schema).Error; nil != err {
+		log.NewHelper(logger).Errorf("lock schema: %s", err.Error())
+	}
+
+	return &LockRepo{
+		data:   data,
+		prefix: "dhb:lock:" + schema + ":",
+		log:    log.NewHelper(logger),
+	}
+}
+
+// leaseValue token|holder
+func leaseValue(l *biz.Lease) string {
+	return strconv.FormatInt(l.Token, 10) + "|" + l.Holder
+}
+
+// AcquireLease 已被持有时返回nil
+func (lr *LockRepo) AcquireLease(ctx context.Context, name string, holder string, ttl time.Duration) (*biz.Lease, error) {
+	token, err := lr.data.rdb.Incr(ctx, lr.prefix+name+":token").Result()
+	if nil != err {
+		return nil, errors.New(500, "LOCK ERROR", err.Error())
+	}
+
+	l := &biz.Lease{
+		Name:     name,
+		Holder:   holder,
+		Token:    token,
+		ExpireAt: time.Now().UTC().Add(ttl),
+	}
+	ok, err := lr.data.rdb.SetNX(ctx, lr.prefix+name, leaseValue(l), ttl).Result()
+	if nil != err {
+		return nil, errors.New(500, "LOCK ERROR", err.Error())
+	}
+	if !ok {
+		return nil, nil
+	}
+
+	return l, nil
+}
+
+// RenewLease .
+func (lr *LockRepo) RenewLease(ctx context.Context, l *biz.Lease, ttl time.Duration) (bool, error) {
+	res, err := renewLeaseScript.Run(ctx, lr.data.rdb, []string{lr.prefix + l.Name}, leaseValue(l), ttl.Milliseconds()).Int64()
+	if nil != err {
+		return false, errors.New(500, "LOCK ERROR", err.Error())
+	}
+	if 1 != res {
+		lr.log.Warnf("lease lost: %s %s", l.Name, leaseValue(l))
+		return false, nil
+	}
+
+	l.ExpireAt = time.Now().UTC().Add(ttl)
+	return true, nil
+}
+
+// ReleaseLease .
+func (lr *LockRepo) ReleaseLease(ctx context.Context, l *biz.Lease) error {
+	if err := releaseLeaseScript.Run(ctx, lr.data.rdb, []string{lr.prefix + l.Name}, leaseValue(l)).Err(); nil != err {
+		return errors.New(500, "LOCK ERROR", err.Error())
+	}
+
+	return nil
+}
+
+// GetLease 未被持有时返回nil
+func (lr *LockRepo) GetLease(ctx context.Context, name string) (*biz.Lease, error) {
+	value, err := lr.data.rdb.Get(ctx, lr.prefix+name).Result()
+	if nil != err {
+		if redis.Nil == err {
+			return nil, nil
+		}
+
+		return nil, errors.New(500, "LOCK ERROR", err.Error())
+	}
+	ttl, err := lr.data.rdb.PTTL(ctx, lr.prefix+name).Result()
+	if nil != err {
+		return nil, errors.New(500, "LOCK ERROR", err.Error())
+	}
+
+	l := &biz.Lease{Name: name, ExpireAt: time.Now().UTC().Add(ttl)}
+	if i := strings.Index(value, "|"); 0 < i {
+		l.Token, _ = strconv.ParseInt(value[:i], 10, 64)
+		l.Holder = value[i+1:]
+	}
+
+	return l, nil
+}
+
+// fenceLease 事务内记录 token，比已见过的小说明租约已过期被别人拿走，拒绝写入；
+// 同时行锁持有到事务结束，持有同一把锁的事务串行
+func fenceLease(tx *gorm.DB, l *biz.Lease) error {
+	var token int64
+	if err := tx.Exec("insert into lock_fence (name, token, created_at, updated_at) values (?, ?, now(), now()) "+
+		"on duplicate key update token=greatest(token, values(token)), updated_at=now()", l.Name, l.Token).Error; nil != err {
+		return errors.New(500, "LOCK ERROR", err.Error())
+	}
+	if err := tx.Raw("select token from lock_fence where name=?", l.Name).Scan(&token).Error; nil != err {
+		return errors.New(500, "LOCK ERROR", err.Error())
+	}
+	if token > l.Token {
+		return errors.New(500, "LOCK ERROR", "锁已过期")
+	}
+
+	return nil
+}
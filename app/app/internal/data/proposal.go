@@ -0,0 +1,136 @@
+package data
+
+import (
+	"context"
+	"dhb/app/app/internal/biz"
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+	"gorm.io/gorm"
+	"time"
+)
+
+type AdminProposal struct {
+	ID         int64     `gorm:"primarykey;type:int"`
+	Operation  string    `gorm:"type:varchar(45);not null"`
+	Payload    string    `gorm:"type:text;not null"`
+	ProposerId int64     `gorm:"type:int;not null"`
+	CheckerId  int64     `gorm:"type:int;not null"`
+	Status     string    `gorm:"type:varchar(45);not null"`
+	Result     string    `gorm:"type:varchar(500);not null"`
+	CreatedAt  time.Time `gorm:"type:datetime;not null"`
+	UpdatedAt  time.Time `gorm:"type:datetime;not null"`
+}
+
+type AdminProposalRepo struct {
+	data *Data
+	log  *log.Helper
+}
+
+func NewAdminProposalRepo(data *Data, logger log.Logger) biz.AdminProposalRepo {
+	return &AdminProposalRepo{
+		data: data,
+		log:  log.NewHelper(logger),
+	}
+}
+
+// CreateAdminProposal .
+func (a *AdminProposalRepo) CreateAdminProposal(ctx context.Context, p *biz.AdminProposal) (*biz.AdminProposal, error) {
+	var adminProposal AdminProposal
+	adminProposal.Operation = p.Operation
+	adminProposal.Payload = p.Payload
+	adminProposal.ProposerId = p.ProposerId
+	adminProposal.Status = p.Status
+
+	res := a.data.DB(ctx).Table("admin_proposal").Create(&adminProposal)
+	if res.Error != nil {
+		return nil, errors.New(500, "CREATE_ADMIN_PROPOSAL_ERROR", "审批提案创建失败")
+	}
+
+	return &biz.AdminProposal{
+		ID:         adminProposal.ID,
+		Operation:  adminProposal.Operation,
+		Payload:    adminProposal.Payload,
+		ProposerId: adminProposal.ProposerId,
+		CheckerId:  adminProposal.CheckerId,
+		Status:     adminProposal.Status,
+		Result:     adminProposal.Result,
+		CreatedAt:  adminProposal.CreatedAt,
+		UpdatedAt:  adminProposal.UpdatedAt,
+	}, nil
+}
+
+// GetAdminProposalById .
+func (a *AdminProposalRepo) GetAdminProposalById(ctx context.Context, id int64) (*biz.AdminProposal, error) {
+	var adminProposal AdminProposal
+	if err := a.data.DB(ctx).Table("admin_proposal").Where("id=?", id).First(&adminProposal).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errors.NotFound("ADMIN_PROPOSAL_NOT_FOUND", "admin proposal not found")
+		}
+
+		return nil, errors.New(500, "ADMIN PROPOSAL ERROR", err.Error())
+	}
+
+	return &biz.AdminProposal{
+		ID:         adminProposal.ID,
+		Operation:  adminProposal.Operation,
+		Payload:    adminProposal.Payload,
+		ProposerId: adminProposal.ProposerId,
+		CheckerId:  adminProposal.CheckerId,
+		Status:     adminProposal.Status,
+		Result:     adminProposal.Result,
+		CreatedAt:  adminProposal.CreatedAt,
+		UpdatedAt:  adminProposal.UpdatedAt,
+	}, nil
+}
+
+// GetAdminProposals .
+func (a *AdminProposalRepo) GetAdminProposals(ctx context.Context, b *biz.Pagination, status string) ([]*biz.AdminProposal, error, int64) {
+	var (
+		adminProposals []*AdminProposal
+		count          int64
+	)
+	res := make([]*biz.AdminProposal, 0)
+
+	instance := a.data.db.Table("admin_proposal")
+
+	if "" != status {
+		instance = instance.Where("status=?", status)
+	}
+
+	instance = instance.Count(&count)
+	if err := instance.Scopes(Paginate(b.PageNum, b.PageSize)).Order("id desc").Find(&adminProposals).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return res, errors.NotFound("ADMIN_PROPOSAL_NOT_FOUND", "admin proposal not found"), 0
+		}
+
+		return nil, errors.New(500, "ADMIN PROPOSAL ERROR", err.Error()), 0
+	}
+
+	for _, v := range adminProposals {
+		res = append(res, &biz.AdminProposal{
+			ID:         v.ID,
+			Operation:  v.Operation,
+			Payload:    v.Payload,
+			ProposerId: v.ProposerId,
+			CheckerId:  v.CheckerId,
+			Status:     v.Status,
+			Result:     v.Result,
+			CreatedAt:  v.CreatedAt,
+			UpdatedAt:  v.UpdatedAt,
+		})
+	}
+
+	return res, nil, count
+}
+
+// UpdateAdminProposalStatus 按原状态条件更新，防止重复审批
+func (a *AdminProposalRepo) UpdateAdminProposalStatus(ctx context.Context, id int64, fromStatus string, toStatus string, checkerId int64, result string) (bool, error) {
+	res := a.data.DB(ctx).Table("admin_proposal").
+		Where("id=? and status=?", id, fromStatus).
+		Updates(map[string]interface{}{"status": toStatus, "checker_id": checkerId, "result": result, "updated_at": time.Now()})
+	if res.Error != nil {
+		return false, errors.New(500, "UPDATE_ADMIN_PROPOSAL_ERROR", "审批提案修改失败")
+	}
+
+	return 0 < res.RowsAffected, nil
+}
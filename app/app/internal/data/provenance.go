@@ -0,0 +1,152 @@
+package data
+
+import (
+	"context"
+	"dhb/app/app/internal/biz"
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+	"gorm.io/gorm"
+	"time"
+)
+
+type ProvenanceRepo struct {
+	data *Data
+	log  *log.Helper
+}
+
+func NewProvenanceRepo(data *Data, logger log.Logger) biz.ProvenanceRepo {
+	return &ProvenanceRepo{
+		data: data,
+		log:  log.NewHelper(logger),
+	}
+}
+
+// GetRewardById 不存在时返回nil
+func (p *ProvenanceRepo) GetRewardById(ctx context.Context, id int64) (*biz.Reward, error) {
+	var reward Reward
+	if err := p.data.db.Table("reward").Where("id=?", id).First(&reward).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+
+		return nil, errors.New(500, "REWARD ERROR", err.Error())
+	}
+
+	return &biz.Reward{
+		ID:               reward.ID,
+		UserId:           reward.UserId,
+		Amount:           reward.Amount,
+		BalanceRecordId:  reward.BalanceRecordId,
+		Type:             reward.Type,
+		TypeRecordId:     reward.TypeRecordId,
+		Reason:           reward.Reason,
+		ReasonLocationId: reward.ReasonLocationId,
+		LocationType:     reward.LocationType,
+		CreatedAt:        reward.CreatedAt,
+	}, nil
+}
+
+// GetLocationsByIds 不限状态
+func (p *ProvenanceRepo) GetLocationsByIds(ctx context.Context, ids ...int64) (map[int64]*biz.Location, error) {
+	var locations []*Location
+	res := make(map[int64]*biz.Location, 0)
+	if err := p.data.db.Table("location").Where("id IN (?)", ids).Find(&locations).Error; err != nil {
+		return nil, errors.New(500, "LOCATION ERROR", err.Error())
+	}
+
+	for _, location := range locations {
+		res[location.ID] = &biz.Location{
+			ID:           location.ID,
+			UserId:       location.UserId,
+			Status:       location.Status,
+			CurrentLevel: location.CurrentLevel,
+			Current:      location.Current,
+			CurrentMax:   location.CurrentMax,
+			Row:          location.Row,
+			Col:          location.Col,
+			StopDate:     location.StopDate,
+			CreatedAt:    location.CreatedAt,
+		}
+	}
+
+	return res, nil
+}
+
+// GetDepositByLocation 占位之后第一条充值或复投记录，没有时返回nil
+func (p *ProvenanceRepo) GetDepositByLocation(ctx context.Context, userId int64, start time.Time, end time.Time) (*biz.EthUserRecord, error) {
+	var ethUserRecord EthUserRecord
+	if err := p.data.db.Table("eth_user_record").
+		Where("user_id=? and type in (?)", userId, []string{"deposit", "reinvest"}).
+		Where("created_at>=? and created_at<?", start, end).
+		Order("id asc").
+		First(&ethUserRecord).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+
+		return nil, errors.New(500, "ETH USER RECORD ERROR", err.Error())
+	}
+
+	return &biz.EthUserRecord{
+		ID:        ethUserRecord.ID,
+		UserId:    ethUserRecord.UserId,
+		Hash:      ethUserRecord.Hash,
+		Status:    ethUserRecord.Status,
+		Type:      ethUserRecord.Type,
+		Amount:    ethUserRecord.Amount,
+		CoinType:  ethUserRecord.CoinType,
+		CreatedAt: ethUserRecord.CreatedAt,
+	}, nil
+}
+
+// GetAdminInsertByLocation 时间段内的后台入单日志
+func (p *ProvenanceRepo) GetAdminInsertByLocation(ctx context.Context, start time.Time, end time.Time) ([]*biz.AdminAuditLog, error) {
+	var auditLogs []*AdminAuditLog
+	res := make([]*biz.AdminAuditLog, 0)
+	if err := p.data.db.Table("admin_audit_log").
+		Where("operation=?", "AdminLocationInsert").
+		Where("created_at>=? and created_at<?", start, end).
+		Order("id asc").
+		Find(&auditLogs).Error; err != nil {
+		return nil, errors.New(500, "ADMIN AUDIT LOG ERROR", err.Error())
+	}
+
+	for _, v := range auditLogs {
+		res = append(res, &biz.AdminAuditLog{
+			ID:        v.ID,
+			AdminId:   v.AdminId,
+			Operation: v.Operation,
+			Payload:   v.Payload,
+			CreatedAt: v.CreatedAt,
+		})
+	}
+
+	return res, nil
+}
+
+// GetConfigUpdatesAfter 某时间之后的配置修改日志，按时间正序
+func (p *ProvenanceRepo) GetConfigUpdatesAfter(ctx context.Context, t time.Time) ([]*biz.AdminAuditLog, error) {
+	var auditLogs []*AdminAuditLog
+	res := make([]*biz.AdminAuditLog, 0)
+	if err := p.data.db.Table("admin_audit_log").
+		Where("operation=?", "AdminConfigUpdate").
+		Where("created_at>?", t).
+		Order("id asc").
+		Find(&auditLogs).Error; err != nil {
+		return nil, errors.New(500, "ADMIN AUDIT LOG ERROR", err.Error())
+	}
+
+	for _, v := range auditLogs {
+		res = append(res, &biz.AdminAuditLog{
+			ID:        v.ID,
+			AdminId:   v.AdminId,
+			Operation: v.Operation,
+			Payload:   v.Payload,
+			Before:    v.Before,
+			After:     v.After,
+			CreatedAt: v.CreatedAt,
+		})
+	}
+
+	return res, nil
+}
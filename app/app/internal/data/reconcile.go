@@ -0,0 +1,248 @@
+package data
+
+import (
+	"context"
+	"dhb/app/app/internal/biz"
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+	"gorm.io/gorm"
+	"time"
+)
+
+type Discrepancy struct {
+	ID        int64     `gorm:"primarykey;type:int"`
+	Batch     string    `gorm:"type:varchar(45);not null;index"`
+	CheckName string    `gorm:"type:varchar(45);not null"`
+	UserId    int64     `gorm:"type:int;not null"`
+	Expected  int64     `gorm:"type:bigint;not null"`
+	Actual    int64     `gorm:"type:bigint;not null"`
+	Diff      int64     `gorm:"type:bigint;not null"`
+	Severity  string    `gorm:"type:varchar(45);not null"`
+	CreatedAt time.Time `gorm:"type:datetime;not null"`
+	UpdatedAt time.Time `gorm:"type:datetime;not null"`
+}
+
+type ReconcileItem struct {
+	UserId   int64
+	Expected int64
+	Actual   int64
+}
+
+type ReconcileRepo struct {
+	data *Data
+	log  *log.Helper
+}
+
+func NewReconcileRepo(data *Data, logger log.Logger) biz.ReconcileRepo {
+	return &ReconcileRepo{
+		data: data,
+		log:  log.NewHelper(logger),
+	}
+}
+
+func (r *ReconcileRepo) scanReconcileItems(ctx context.Context, sql string, values ...interface{}) ([]*biz.ReconcileItem, error) {
+	var items []*ReconcileItem
+	res := make([]*biz.ReconcileItem, 0)
+	if err := r.data.DB(ctx).Raw(sql, values...).Scan(&items).Error; nil != err {
+		return nil, errors.New(500, "RECONCILE ERROR", err.Error())
+	}
+
+	for _, v := range items {
+		res = append(res, &biz.ReconcileItem{
+			UserId:   v.UserId,
+			Expected: v.Expected,
+			Actual:   v.Actual,
+		})
+	}
+
+	return res, nil
+}
+
+// GetBalanceRecordReplay 余额记录回放，分红记录未写币种按usdt算，usdt充值进占位不计余额；
+// 占位已停止时分红也会写记录但不加余额，按对应 reward 的 status 跳过
+func (r *ReconcileRepo) GetBalanceRecordReplay(ctx context.Context) ([]*biz.ReconcileItem, error) {
+	var userBalances []*UserBalance
+
+	rows, err := r.data.DB(ctx).Table("user_balance_record r").
+		Select("r.user_id, r.type, r.amount, coalesce(rw.status, '')").
+		Joins("left join reward rw on rw.balance_record_id=r.id and rw.user_id=r.user_id").
+		Where("r.coin_type in (?)", []string{"", "usdt"}).
+		Order("r.user_id asc, r.id asc").Rows()
+	if nil != err {
+		return nil, errors.New(500, "RECONCILE ERROR", err.Error())
+	}
+	defer rows.Close()
+
+	expected := make(map[int64]int64, 0)
+	for rows.Next() {
+		var (
+			userId       int64
+			recordType   string
+			amount       int64
+			rewardStatus string
+		)
+		if err = rows.Scan(&userId, &recordType, &amount, &rewardStatus); nil != err {
+			return nil, errors.New(500, "RECONCILE ERROR", err.Error())
+		}
+
+		switch recordType {
+		case "withdraw", "reinvest":
+			expected[userId] -= amount
+		case "deposit":
+		case "reward":
+			if "running" == rewardStatus {
+				expected[userId] += amount
+			}
+		default:
+			expected[userId] += amount
+		}
+	}
+
+	if err = r.data.DB(ctx).Table("user_balance").Find(&userBalances).Error; nil != err {
+		return nil, errors.New(500, "RECONCILE ERROR", err.Error())
+	}
+
+	res := make([]*biz.ReconcileItem, 0)
+	for _, v := range userBalances {
+		res = append(res, &biz.ReconcileItem{
+			UserId:   v.UserId,
+			Expected: expected[v.UserId],
+			Actual:   v.BalanceUsdt,
+		})
+	}
+
+	return res, nil
+}
+
+// GetRewardLocationCurrent 用户分红总额与各占位已分额度之和，分满时超出封顶的部分也记在 current 上
+func (r *ReconcileRepo) GetRewardLocationCurrent(ctx context.Context) ([]*biz.ReconcileItem, error) {
+	return r.scanReconcileItems(ctx, "select l.user_id as user_id, cast(sum(l.current) as signed) as expected, coalesce(rw.total, 0) as actual from location l "+
+		"left join (select user_id, sum(amount) as total from reward where user_id<>? group by user_id) rw on rw.user_id=l.user_id "+
+		"group by l.user_id, rw.total", 999999999)
+}
+
+// GetDepositLocationMax 用户链上充值和余额复投按档位应得的封顶额度之和与占位封顶额度之和，不在档位内的金额按0算
+func (r *ReconcileRepo) GetDepositLocationMax(ctx context.Context, tiers []*biz.DepositTier) ([]*biz.ReconcileItem, error) {
+	var (
+		caps   string
+		values []interface{}
+	)
+	for _, v := range tiers {
+		caps += " when ? then ?"
+		values = append(values, v.ChainAmount(), v.CurrentMax)
+	}
+	values = append(values, []string{"deposit", "reinvest"}, "success", "USDT")
+
+	return r.scanReconcileItems(ctx, "select l.user_id as user_id, coalesce(d.total, 0) as expected, cast(sum(l.current_max) as signed) as actual from location l "+
+		"left join (select user_id, cast(sum(case amount"+caps+" else 0 end) as signed) as total from eth_user_record "+
+		"where type in (?) and status=? and coin_type=? group by user_id) d on d.user_id=l.user_id "+
+		"group by l.user_id, d.total", values...)
+}
+
+// CreateDiscrepancies .
+func (r *ReconcileRepo) CreateDiscrepancies(ctx context.Context, discrepancies []*biz.Discrepancy) error {
+	var rows []*Discrepancy
+	for _, v := range discrepancies {
+		rows = append(rows, &Discrepancy{
+			Batch:     v.Batch,
+			CheckName: v.Check,
+			UserId:    v.UserId,
+			Expected:  v.Expected,
+			Actual:    v.Actual,
+			Diff:      v.Diff,
+			Severity:  v.Severity,
+		})
+	}
+
+	if err := r.data.DB(ctx).Table("discrepancy").CreateInBatches(&rows, 200).Error; nil != err {
+		return errors.New(500, "CREATE_DISCREPANCY_ERROR", "对账差异记录创建失败")
+	}
+
+	return nil
+}
+
+// GetDiscrepancyLastBatch .
+func (r *ReconcileRepo) GetDiscrepancyLastBatch(ctx context.Context) (string, error) {
+	var discrepancy Discrepancy
+	if err := r.data.db.Table("discrepancy").Order("id desc").First(&discrepancy).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return "", nil
+		}
+
+		return "", errors.New(500, "DISCREPANCY ERROR", err.Error())
+	}
+
+	return discrepancy.Batch, nil
+}
+
+// GetDiscrepancies b 为空时不分页
+func (r *ReconcileRepo) GetDiscrepancies(ctx context.Context, b *biz.Pagination, batch string, check string, severity string) ([]*biz.Discrepancy, error, int64) {
+	var (
+		discrepancies []*Discrepancy
+		count         int64
+	)
+	res := make([]*biz.Discrepancy, 0)
+
+	instance := r.data.db.Table("discrepancy").Where("batch=?", batch)
+
+	if "" != check {
+		instance = instance.Where("check_name=?", check)
+	}
+
+	if "" != severity {
+		instance = instance.Where("severity=?", severity)
+	}
+
+	instance = instance.Count(&count)
+	if nil != b {
+		instance = instance.Scopes(Paginate(b.PageNum, b.PageSize))
+	}
+	if err := instance.Order("id desc").Find(&discrepancies).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return res, errors.NotFound("DISCREPANCY_NOT_FOUND", "discrepancy not found"), 0
+		}
+
+		return nil, errors.New(500, "DISCREPANCY ERROR", err.Error()), 0
+	}
+
+	for _, v := range discrepancies {
+		res = append(res, &biz.Discrepancy{
+			ID:        v.ID,
+			Batch:     v.Batch,
+			Check:     v.CheckName,
+			UserId:    v.UserId,
+			Expected:  v.Expected,
+			Actual:    v.Actual,
+			Diff:      v.Diff,
+			Severity:  v.Severity,
+			CreatedAt: v.CreatedAt,
+		})
+	}
+
+	return res, nil, count
+}
+
+// MigrateRewardStatus 给 reward 加 status 列并补老数据，老数据没有分红时的占位状态，
+// 按对应余额记录的余额和该用户上一条 usdt 记录相同判为 stop；只补空状态的用户分红，可重复执行，返回补齐的条数
+func (r *ReconcileRepo) MigrateRewardStatus(ctx context.Context) (int64, error) {
+	var count int64
+	if err := r.data.db.Raw("select count(*) from information_schema.columns where table_schema=database() and table_name=? and column_name=?",
+		"reward", "status").Scan(&count).Error; nil != err {
+		return 0, errors.New(500, "REWARD ERROR", err.Error())
+	}
+	if 0 == count {
+		if err := r.data.db.Exec("alter table reward add column status varchar(45) not null default ''").Error; nil != err {
+			return 0, errors.New(500, "REWARD ERROR", err.Error())
+		}
+	}
+
+	res := r.data.db.Exec("update reward rw join user_balance_record r on r.id=rw.balance_record_id and r.user_id=rw.user_id "+
+		"set rw.status=if(r.balance=coalesce((select p.balance from user_balance_record p "+
+		"where p.user_id=r.user_id and p.coin_type in (?) and p.id<r.id order by p.id desc limit 1), 0), ?, ?) "+
+		"where rw.status=? and rw.user_id<>?", []string{"", "usdt"}, "stop", "running", "", 999999999)
+	if nil != res.Error {
+		return 0, errors.New(500, "REWARD ERROR", res.Error.Error())
+	}
+
+	return res.RowsAffected, nil
+}
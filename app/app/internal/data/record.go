@@ -59,6 +59,37 @@ func (e *EthUserRecordRepo) GetEthUserRecordListByHash(ctx context.Context, hash
 	return res, nil
 }
 
+// GetEthUserRecordsByTime 按创建时间取链上记录，[start, end)
+func (e *EthUserRecordRepo) GetEthUserRecordsByTime(ctx context.Context, recordType string, start time.Time, end time.Time) ([]*biz.EthUserRecord, error) {
+	var ethUserRecord []*EthUserRecord
+	res := make([]*biz.EthUserRecord, 0)
+	if err := e.data.db.Table("eth_user_record").
+		Where("type=?", recordType).
+		Where("created_at>=? and created_at<?", start, end).
+		Order("id asc").
+		Find(&ethUserRecord).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return res, nil
+		}
+
+		return nil, errors.New(500, "ETH USER RECORD ERROR", err.Error())
+	}
+
+	for _, item := range ethUserRecord {
+		res = append(res, &biz.EthUserRecord{
+			ID:       item.ID,
+			UserId:   item.UserId,
+			Hash:     item.Hash,
+			Status:   item.Status,
+			Type:     item.Type,
+			Amount:   item.Amount,
+			CoinType: item.CoinType,
+		})
+	}
+
+	return res, nil
+}
+
 func (e *EthUserRecordRepo) CreateEthUserRecordListByHash(ctx context.Context, r *biz.EthUserRecord) (*biz.EthUserRecord, error) {
 	var ethUserRecord EthUserRecord
 	ethUserRecord.UserId = r.UserId
@@ -0,0 +1,316 @@
+package data
+
+import (
+	"context"
+	"dhb/app/app/internal/biz"
+	"encoding/json"
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+	"gorm.io/gorm"
+	"time"
+)
+
+type ReplayRepo struct {
+	data *Data
+	log  *log.Helper
+}
+
+func NewReplayRepo(data *Data, logger log.Logger) biz.ReplayRepo {
+	return &ReplayRepo{
+		data: data,
+		log:  log.NewHelper(logger),
+	}
+}
+
+var (
+	// replayCopyTables 从生产原样复制的基础数据
+	replayCopyTables = []string{"user", "user_recommend", "config", "user_info", "user_balance", "user_area", "withdraw"}
+	// replayClearTables 重放产生的数据，清空后重建
+	replayClearTables = []string{"location", "reward", "user_balance_record", "eth_user_record", "user_current_month_recommend",
+		"admin_audit_log", "ledger_account", "ledger_entry", "ledger_journal", "lock_fence", "location_rank_bucket"}
+	// replayDailyReasons 每日任务产生的分红
+	replayDailyReasons = []string{"daily_recommend_area", "fee", "system_fee", "fee_daily", "system_fee_daily", "recommend_top"}
+)
+
+// replayDailyJob 分红记录对应的每日任务
+func replayDailyJob(v *Reward) string {
+	if "withdraw_reject" == v.Type { // 驳回冲回的手续费，不是任务产生的
+		return ""
+	}
+	if "withdraw" == v.Type {
+		return "AdminDailyWithdrawReward"
+	}
+
+	switch v.Reason {
+	case "fee", "system_fee":
+		return "AdminFee"
+	case "fee_daily", "system_fee_daily":
+		return "AdminFeeDaily"
+	case "recommend_top":
+		return "AdminDailyRecommendTopReward"
+	case "daily_recommend_area":
+		return "AdminDailyRecommendReward"
+	}
+
+	return ""
+}
+
+// GetSchemaName .
+func (r *ReplayRepo) GetSchemaName(ctx context.Context) (string, error) {
+	var name string
+	if err := r.data.db.Raw("select database()").Scan(&name).Error; nil != err {
+		return "", errors.New(500, "REPLAY ERROR", err.Error())
+	}
+
+	return name, nil
+}
+
+// ResetShadow 影子库和生产库在同一实例，按生产建表，复制基础数据并清零重放会累加的字段
+func (r *ReplayRepo) ResetShadow(ctx context.Context, source string) error {
+	var err error
+	for _, table := range append(replayCopyTables, replayClearTables...) {
+		if err = r.data.db.Exec("create table if not exists `" + table + "` like `" + source + "`.`" + table + "`").Error; nil != err {
+			return errors.New(500, "REPLAY ERROR", err.Error())
+		}
+		if err = r.data.db.Exec("truncate table `" + table + "`").Error; nil != err {
+			return errors.New(500, "REPLAY ERROR", err.Error())
+		}
+	}
+
+	for _, table := range replayCopyTables {
+		if err = r.data.db.Exec("insert into `" + table + "` select * from `" + source + "`.`" + table + "`").Error; nil != err {
+			return errors.New(500, "REPLAY ERROR", err.Error())
+		}
+	}
+
+	if err = r.data.db.Table("user_info").Where("1=1").
+		Updates(map[string]interface{}{"vip": 0, "history_recommend": 0, "vip_compute": 0}).Error; nil != err {
+		return errors.New(500, "REPLAY ERROR", err.Error())
+	}
+	if err = r.data.db.Table("user_balance").Where("1=1").
+		Updates(map[string]interface{}{"balance_usdt": 0, "balance_dhb": 0, "bnb_amount": 0}).Error; nil != err {
+		return errors.New(500, "REPLAY ERROR", err.Error())
+	}
+	if err = r.data.db.Table("user_area").Where("1=1").
+		Updates(map[string]interface{}{"amount": 0, "self_amount": 0}).Error; nil != err {
+		return errors.New(500, "REPLAY ERROR", err.Error())
+	}
+
+	return nil
+}
+
+// GetReplayEvents 充值记录、后台入单操作日志、每日任务分红和其他余额变动，未排序
+func (r *ReplayRepo) GetReplayEvents(ctx context.Context) ([]*biz.ReplayEvent, error) {
+	var (
+		ethUserRecords []*EthUserRecord
+		auditLogs      []*AdminAuditLog
+		rewards        []*Reward
+		records        []*UserBalanceRecord
+	)
+	res := make([]*biz.ReplayEvent, 0)
+
+	if err := r.data.db.Table("eth_user_record").Where("type in (?)", []string{"deposit", "reinvest"}).Order("id asc").Find(&ethUserRecords).Error; nil != err {
+		return nil, errors.New(500, "ETH USER RECORD ERROR", err.Error())
+	}
+	for _, v := range ethUserRecords {
+		res = append(res, &biz.ReplayEvent{
+			Kind: "deposit",
+			Seq:  v.ID,
+			Time: v.CreatedAt,
+			Record: &biz.EthUserRecord{
+				UserId:   v.UserId,
+				Hash:     v.Hash,
+				Status:   v.Status,
+				Type:     v.Type,
+				Amount:   v.Amount,
+				CoinType: v.CoinType,
+			},
+		})
+	}
+
+	if err := r.data.db.Table("admin_audit_log").Where("operation=?", "AdminLocationInsert").Order("id asc").Find(&auditLogs).Error; nil != err {
+		return nil, errors.New(500, "ADMIN AUDIT LOG ERROR", err.Error())
+	}
+	for _, v := range auditLogs {
+		var payload struct {
+			UserId int64 `json:"user_id"`
+			Amount int64 `json:"amount"`
+		}
+		if err := json.Unmarshal([]byte(v.Payload), &payload); nil != err {
+			r.log.Warnf("replay: bad audit payload %d: %s", v.ID, err.Error())
+			continue
+		}
+		res = append(res, &biz.ReplayEvent{
+			Kind:   "admin_insert",
+			Seq:    v.ID,
+			Time:   v.CreatedAt,
+			UserId: payload.UserId,
+			Amount: payload.Amount,
+		})
+	}
+
+	if err := r.data.db.Table("reward").
+		Where("reason in (?) or (type=? and reason=?)", replayDailyReasons, "withdraw", "location").
+		Order("id asc").Find(&rewards).Error; nil != err {
+		return nil, errors.New(500, "REWARD ERROR", err.Error())
+	}
+	// 同一任务同一天（东八区）的分红是一次执行，按第一条分红的时间重跑
+	jobRuns := make(map[string]bool, 0)
+	for _, v := range rewards {
+		job := replayDailyJob(v)
+		if "" == job {
+			continue
+		}
+		run := job + v.CreatedAt.UTC().Add(8*time.Hour).Format("2006-01-02")
+		if jobRuns[run] {
+			continue
+		}
+		jobRuns[run] = true
+
+		res = append(res, &biz.ReplayEvent{
+			Kind: "daily",
+			Seq:  v.ID,
+			Time: v.CreatedAt,
+			Job:  job,
+		})
+	}
+
+	// 入单和分红之外的余额变动，按同币种上一条记录的余额算出变动额
+	if err := r.data.db.Table("user_balance_record").Order("id asc").Find(&records).Error; nil != err {
+		return nil, errors.New(500, "USER BALANCE RECORD ERROR", err.Error())
+	}
+	lastBalance := make(map[int64]map[string]int64, 0)
+	for _, v := range records {
+		coinType := v.CoinType
+		if "" == coinType {
+			coinType = "usdt"
+		}
+		if _, ok := lastBalance[v.UserId]; !ok {
+			lastBalance[v.UserId] = make(map[string]int64, 0)
+		}
+		change := v.Balance - lastBalance[v.UserId][coinType]
+		lastBalance[v.UserId][coinType] = v.Balance
+
+		if "reward" == v.Type || "deposit" == v.Type || "reinvest" == v.Type || 0 == change {
+			continue
+		}
+		res = append(res, &biz.ReplayEvent{
+			Kind:     "balance",
+			Seq:      v.ID,
+			Time:     v.CreatedAt,
+			UserId:   v.UserId,
+			Amount:   change,
+			CoinType: coinType,
+		})
+	}
+
+	return res, nil
+}
+
+// ApplyBalanceChange bnb 记录的余额是 bnb_amount 乘 1e10
+func (r *ReplayRepo) ApplyBalanceChange(ctx context.Context, userId int64, coinType string, amount int64) error {
+	var updates map[string]interface{}
+	switch coinType {
+	case "usdt":
+		updates = map[string]interface{}{"balance_usdt": gorm.Expr("balance_usdt + ?", amount)}
+	case "dhb":
+		updates = map[string]interface{}{"balance_dhb": gorm.Expr("balance_dhb + ?", amount)}
+	case "bnb":
+		updates = map[string]interface{}{"bnb_amount": gorm.Expr("bnb_amount + ?", float64(amount)/float64(10000000000))}
+	default:
+		return errors.New(500, "COIN_TYPE_ERROR", "不支持的币种")
+	}
+
+	if err := r.data.DB(ctx).Table("user_balance").Where("user_id=?", userId).
+		Updates(updates).Error; nil != err {
+		return errors.New(500, "USER BALANCE ERROR", err.Error())
+	}
+
+	return nil
+}
+
+// GetLastRewardId .
+func (r *ReplayRepo) GetLastRewardId(ctx context.Context) (int64, error) {
+	var reward Reward
+	if err := r.data.db.Table("reward").Order("id desc").First(&reward).Error; nil != err {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return 0, nil
+		}
+
+		return 0, errors.New(500, "REWARD ERROR", err.Error())
+	}
+
+	return reward.ID, nil
+}
+
+// GetReplayState .
+func (r *ReplayRepo) GetReplayState(ctx context.Context) (*biz.ReplayState, error) {
+	var (
+		locations    []*Location
+		rewards      []*Reward
+		userBalances []*UserBalance
+		userInfos    []*UserInfo
+		userAreas    []*UserArea
+	)
+
+	res := &biz.ReplayState{
+		Locations: make([]*biz.Location, 0),
+		Rewards:   make([]*biz.Reward, 0),
+		Balances:  make(map[int64]*biz.UserBalance, 0),
+		UserInfos: make(map[int64]*biz.UserInfo, 0),
+		UserAreas: make(map[int64]*biz.UserArea, 0),
+	}
+
+	if err := r.data.db.Table("location").Order("id asc").Find(&locations).Error; nil != err {
+		return nil, errors.New(500, "LOCATION ERROR", err.Error())
+	}
+	for _, v := range locations {
+		res.Locations = append(res.Locations, &biz.Location{
+			ID:           v.ID,
+			UserId:       v.UserId,
+			Status:       v.Status,
+			CurrentLevel: v.CurrentLevel,
+			Current:      v.Current,
+			CurrentMax:   v.CurrentMax,
+			Row:          v.Row,
+			Col:          v.Col,
+		})
+	}
+
+	if err := r.data.db.Table("reward").Order("id asc").Find(&rewards).Error; nil != err {
+		return nil, errors.New(500, "REWARD ERROR", err.Error())
+	}
+	for _, v := range rewards {
+		res.Rewards = append(res.Rewards, &biz.Reward{
+			ID:        v.ID,
+			UserId:    v.UserId,
+			Amount:    v.Amount,
+			Type:      v.Type,
+			Reason:    v.Reason,
+			CreatedAt: v.CreatedAt,
+		})
+	}
+
+	if err := r.data.db.Table("user_balance").Find(&userBalances).Error; nil != err {
+		return nil, errors.New(500, "USER BALANCE ERROR", err.Error())
+	}
+	for _, v := range userBalances {
+		res.Balances[v.UserId] = &biz.UserBalance{UserId: v.UserId, BalanceUsdt: v.BalanceUsdt, BalanceDhb: v.BalanceDhb, BnbAmount: v.BnbAmount}
+	}
+
+	if err := r.data.db.Table("user_info").Find(&userInfos).Error; nil != err {
+		return nil, errors.New(500, "USER INFO ERROR", err.Error())
+	}
+	for _, v := range userInfos {
+		res.UserInfos[v.UserId] = &biz.UserInfo{UserId: v.UserId, Vip: v.Vip, HistoryRecommend: v.HistoryRecommend}
+	}
+
+	if err := r.data.db.Table("user_area").Find(&userAreas).Error; nil != err {
+		return nil, errors.New(500, "USER AREA ERROR", err.Error())
+	}
+	for _, v := range userAreas {
+		res.UserAreas[v.UserId] = &biz.UserArea{UserId: v.UserId, Amount: v.Amount, SelfAmount: v.SelfAmount}
+	}
+
+	return res, nil
+}
@@ -0,0 +1,173 @@
+package data
+
+import (
+	"context"
+	"dhb/app/app/internal/biz"
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+	"gorm.io/gorm"
+	"time"
+)
+
+type BalanceSnapshot struct {
+	ID          int64     `gorm:"primarykey;type:int"`
+	Date        string    `gorm:"type:varchar(10);not null;uniqueIndex:uk_snapshot"`
+	UserId      int64     `gorm:"type:int;not null;uniqueIndex:uk_snapshot"`
+	BalanceUsdt int64     `gorm:"type:bigint;not null"`
+	BalanceDhb  int64     `gorm:"type:bigint;not null"`
+	BnbAmount   float64   `gorm:"type:decimal(65,18);not null"`
+	CreatedAt   time.Time `gorm:"type:datetime;not null"`
+	UpdatedAt   time.Time `gorm:"type:datetime;not null"`
+}
+
+type BalanceSnapshotTotal struct {
+	ID              int64     `gorm:"primarykey;type:int"`
+	Date            string    `gorm:"type:varchar(10);not null;uniqueIndex"`
+	UserCount       int64     `gorm:"type:int;not null"`
+	BalanceUsdt     int64     `gorm:"type:bigint;not null"`
+	BalanceDhb      int64     `gorm:"type:bigint;not null"`
+	BnbAmount       float64   `gorm:"type:decimal(65,18);not null"`
+	WithdrawingUsdt int64     `gorm:"type:bigint;not null"`
+	WithdrawingDhb  int64     `gorm:"type:bigint;not null"`
+	CreatedAt       time.Time `gorm:"type:datetime;not null"`
+	UpdatedAt       time.Time `gorm:"type:datetime;not null"`
+}
+
+type BalanceSnapshotRepo struct {
+	data *Data
+	log  *log.Helper
+}
+
+func NewBalanceSnapshotRepo(data *Data, logger log.Logger) biz.BalanceSnapshotRepo {
+	return &BalanceSnapshotRepo{
+		data: data,
+		log:  log.NewHelper(logger),
+	}
+}
+
+// withdrawingTotal 已扣余额未打款的提现，usdt 分配后按实际打款金额
+func withdrawingTotal(ctx context.Context, data *Data, coinType string) (int64, error) {
+	var total UserBalanceTotal
+	if err := data.DB(ctx).Table("withdraw").
+		Where("type=? and status in (?)", coinType, []string{"", "review", "pass", "rewarded", "doing"}).
+		Select("coalesce(sum(if(rel_amount>0, rel_amount, amount)), 0) as total").Take(&total).Error; nil != err {
+		return 0, errors.New(500, "WITHDRAW ERROR", err.Error())
+	}
+
+	return total.Total, nil
+}
+
+// CreateBalanceSnapshot 先删当天快照再重写，需在事务内调用
+func (b *BalanceSnapshotRepo) CreateBalanceSnapshot(ctx context.Context, date string) (*biz.BalanceSnapshotTotal, error) {
+	var total BalanceSnapshotTotal
+
+	if err := b.data.DB(ctx).Table("balance_snapshot").Where("date=?", date).Delete(&BalanceSnapshot{}).Error; nil != err {
+		return nil, errors.New(500, "DELETE_BALANCE_SNAPSHOT_ERROR", "余额快照删除失败")
+	}
+	if err := b.data.DB(ctx).Table("balance_snapshot_total").Where("date=?", date).Delete(&BalanceSnapshotTotal{}).Error; nil != err {
+		return nil, errors.New(500, "DELETE_BALANCE_SNAPSHOT_ERROR", "余额快照删除失败")
+	}
+
+	now := time.Now().UTC()
+	if err := b.data.DB(ctx).Exec("insert into balance_snapshot (date, user_id, balance_usdt, balance_dhb, bnb_amount, created_at, updated_at) "+
+		"select ?, user_id, balance_usdt, balance_dhb, bnb_amount, ?, ? from user_balance", date, now, now).Error; nil != err {
+		return nil, errors.New(500, "CREATE_BALANCE_SNAPSHOT_ERROR", "余额快照创建失败")
+	}
+
+	if err := b.data.DB(ctx).Table("balance_snapshot").Where("date=?", date).
+		Select("count(*) as user_count, coalesce(sum(balance_usdt), 0) as balance_usdt, coalesce(sum(balance_dhb), 0) as balance_dhb, coalesce(sum(bnb_amount), 0) as bnb_amount").
+		Take(&total).Error; nil != err {
+		return nil, errors.New(500, "BALANCE SNAPSHOT ERROR", err.Error())
+	}
+
+	withdrawingUsdt, err := withdrawingTotal(ctx, b.data, "usdt")
+	if nil != err {
+		return nil, err
+	}
+	withdrawingDhb, err := withdrawingTotal(ctx, b.data, "dhb")
+	if nil != err {
+		return nil, err
+	}
+	total.WithdrawingUsdt = withdrawingUsdt
+	total.WithdrawingDhb = withdrawingDhb
+
+	total.ID = 0
+	total.Date = date
+	if err := b.data.DB(ctx).Table("balance_snapshot_total").Create(&total).Error; nil != err {
+		return nil, errors.New(500, "CREATE_BALANCE_SNAPSHOT_ERROR", "余额快照创建失败")
+	}
+
+	return &biz.BalanceSnapshotTotal{
+		Date:            total.Date,
+		UserCount:       total.UserCount,
+		BalanceUsdt:     total.BalanceUsdt,
+		BalanceDhb:      total.BalanceDhb,
+		BnbAmount:       total.BnbAmount,
+		WithdrawingUsdt: total.WithdrawingUsdt,
+		WithdrawingDhb:  total.WithdrawingDhb,
+		CreatedAt:       total.CreatedAt,
+	}, nil
+}
+
+// GetUserBalanceSnapshot 取 date 及之前最近一次快照，快照时用户未注册的按0返回
+func (b *BalanceSnapshotRepo) GetUserBalanceSnapshot(ctx context.Context, userId int64, date string) (*biz.BalanceSnapshot, error) {
+	var (
+		snapshot BalanceSnapshot
+		total    BalanceSnapshotTotal
+	)
+
+	if err := b.data.db.Table("balance_snapshot_total").Where("date<=?", date).Order("date desc").First(&total).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errors.NotFound("BALANCE_SNAPSHOT_NOT_FOUND", "该日期之前没有余额快照")
+		}
+
+		return nil, errors.New(500, "BALANCE SNAPSHOT ERROR", err.Error())
+	}
+
+	if err := b.data.db.Table("balance_snapshot").Where("date=? and user_id=?", total.Date, userId).First(&snapshot).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return &biz.BalanceSnapshot{Date: total.Date, UserId: userId}, nil
+		}
+
+		return nil, errors.New(500, "BALANCE SNAPSHOT ERROR", err.Error())
+	}
+
+	return &biz.BalanceSnapshot{
+		Date:        snapshot.Date,
+		UserId:      snapshot.UserId,
+		BalanceUsdt: snapshot.BalanceUsdt,
+		BalanceDhb:  snapshot.BalanceDhb,
+		BnbAmount:   snapshot.BnbAmount,
+	}, nil
+}
+
+// GetBalanceSnapshotTotals .
+func (b *BalanceSnapshotRepo) GetBalanceSnapshotTotals(ctx context.Context, start string, end string) ([]*biz.BalanceSnapshotTotal, error) {
+	var totals []*BalanceSnapshotTotal
+	res := make([]*biz.BalanceSnapshotTotal, 0)
+	if err := b.data.db.Table("balance_snapshot_total").
+		Where("date>=? and date<=?", start, end).
+		Order("date asc").
+		Find(&totals).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return res, nil
+		}
+
+		return nil, errors.New(500, "BALANCE SNAPSHOT ERROR", err.Error())
+	}
+
+	for _, v := range totals {
+		res = append(res, &biz.BalanceSnapshotTotal{
+			Date:            v.Date,
+			UserCount:       v.UserCount,
+			BalanceUsdt:     v.BalanceUsdt,
+			BalanceDhb:      v.BalanceDhb,
+			BnbAmount:       v.BnbAmount,
+			WithdrawingUsdt: v.WithdrawingUsdt,
+			WithdrawingDhb:  v.WithdrawingDhb,
+			CreatedAt:       v.CreatedAt,
+		})
+	}
+
+	return res, nil
+}
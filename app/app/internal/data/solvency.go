@@ -0,0 +1,112 @@
+package data
+
+import (
+	"context"
+	"dhb/app/app/internal/biz"
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+	"gorm.io/gorm"
+	"time"
+)
+
+type Solvency struct {
+	ID                  int64     `gorm:"primarykey;type:int"`
+	LocationOutstanding int64     `gorm:"type:bigint;not null"`
+	WithdrawingUsdt     int64     `gorm:"type:bigint;not null"`
+	WithdrawingDhb      int64     `gorm:"type:bigint;not null"`
+	BalanceUsdt         int64     `gorm:"type:bigint;not null"`
+	BalanceDhb          int64     `gorm:"type:bigint;not null"`
+	WalletUsdt          int64     `gorm:"type:bigint;not null"`
+	WalletDhb           int64     `gorm:"type:bigint;not null"`
+	RatioUsdt           int64     `gorm:"type:int;not null"`
+	RatioDhb            int64     `gorm:"type:int;not null"`
+	Alarm               bool      `gorm:"type:tinyint(1);not null"`
+	CreatedAt           time.Time `gorm:"type:datetime;not null;index"`
+	UpdatedAt           time.Time `gorm:"type:datetime;not null"`
+}
+
+type SolvencyRepo struct {
+	data *Data
+	log  *log.Helper
+}
+
+func NewSolvencyRepo(data *Data, logger log.Logger) biz.SolvencyRepo {
+	return &SolvencyRepo{
+		data: data,
+		log:  log.NewHelper(logger),
+	}
+}
+
+// GetLocationOutstanding 运行中占位还未分完的额度
+func (s *SolvencyRepo) GetLocationOutstanding(ctx context.Context) (int64, error) {
+	var total UserBalanceTotal
+	if err := s.data.db.Table("location").
+		Where("status=? and current_max>current", "running").
+		Select("coalesce(sum(current_max-current), 0) as total").Take(&total).Error; nil != err {
+		return 0, errors.New(500, "LOCATION ERROR", err.Error())
+	}
+
+	return total.Total, nil
+}
+
+// GetWithdrawingTotal .
+func (s *SolvencyRepo) GetWithdrawingTotal(ctx context.Context, coinType string) (int64, error) {
+	return withdrawingTotal(ctx, s.data, coinType)
+}
+
+// CreateSolvency .
+func (s *SolvencyRepo) CreateSolvency(ctx context.Context, sv *biz.Solvency) (*biz.Solvency, error) {
+	var solvency Solvency
+	solvency.LocationOutstanding = sv.LocationOutstanding
+	solvency.WithdrawingUsdt = sv.WithdrawingUsdt
+	solvency.WithdrawingDhb = sv.WithdrawingDhb
+	solvency.BalanceUsdt = sv.BalanceUsdt
+	solvency.BalanceDhb = sv.BalanceDhb
+	solvency.WalletUsdt = sv.WalletUsdt
+	solvency.WalletDhb = sv.WalletDhb
+	solvency.RatioUsdt = sv.RatioUsdt
+	solvency.RatioDhb = sv.RatioDhb
+	solvency.Alarm = sv.Alarm
+	if err := s.data.DB(ctx).Table("solvency").Create(&solvency).Error; nil != err {
+		return nil, errors.New(500, "CREATE_SOLVENCY_ERROR", "偿付能力记录创建失败")
+	}
+
+	sv.ID = solvency.ID
+	sv.CreatedAt = solvency.CreatedAt
+	return sv, nil
+}
+
+// GetSolvencies .
+func (s *SolvencyRepo) GetSolvencies(ctx context.Context, start time.Time, end time.Time) ([]*biz.Solvency, error) {
+	var solvencies []*Solvency
+	res := make([]*biz.Solvency, 0)
+	if err := s.data.db.Table("solvency").
+		Where("created_at>=? and created_at<?", start, end).
+		Order("id asc").
+		Find(&solvencies).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return res, nil
+		}
+
+		return nil, errors.New(500, "SOLVENCY ERROR", err.Error())
+	}
+
+	for _, v := range solvencies {
+		res = append(res, &biz.Solvency{
+			ID:                  v.ID,
+			LocationOutstanding: v.LocationOutstanding,
+			WithdrawingUsdt:     v.WithdrawingUsdt,
+			WithdrawingDhb:      v.WithdrawingDhb,
+			BalanceUsdt:         v.BalanceUsdt,
+			BalanceDhb:          v.BalanceDhb,
+			WalletUsdt:          v.WalletUsdt,
+			WalletDhb:           v.WalletDhb,
+			RatioUsdt:           v.RatioUsdt,
+			RatioDhb:            v.RatioDhb,
+			Alarm:               v.Alarm,
+			CreatedAt:           v.CreatedAt,
+		})
+	}
+
+	return res, nil
+}
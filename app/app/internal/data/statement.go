@@ -0,0 +1,183 @@
+package data
+
+import (
+	"context"
+	"dhb/app/app/internal/biz"
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+	"gorm.io/gorm"
+	"time"
+)
+
+type StatementRepo struct {
+	data *Data
+	log  *log.Helper
+}
+
+func NewStatementRepo(data *Data, logger log.Logger) biz.StatementRepo {
+	return &StatementRepo{
+		data: data,
+		log:  log.NewHelper(logger),
+	}
+}
+
+// GetUserBalanceRecordsByTime .
+func (s *StatementRepo) GetUserBalanceRecordsByTime(ctx context.Context, userId int64, start time.Time, end time.Time) ([]*biz.UserBalanceRecord, error) {
+	var records []*UserBalanceRecord
+	res := make([]*biz.UserBalanceRecord, 0)
+	if err := s.data.db.Table("user_balance_record").
+		Where("user_id=?", userId).
+		Where("created_at>=? and created_at<?", start, end).
+		Order("id asc").
+		Find(&records).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return res, nil
+		}
+
+		return nil, errors.New(500, "USER BALANCE RECORD ERROR", err.Error())
+	}
+
+	for _, v := range records {
+		res = append(res, &biz.UserBalanceRecord{
+			ID:        v.ID,
+			UserId:    v.UserId,
+			Balance:   v.Balance,
+			Amount:    v.Amount,
+			Type:      v.Type,
+			CoinType:  v.CoinType,
+			Reason:    v.Reason,
+			Remark:    v.Remark,
+			CreatedAt: v.CreatedAt,
+		})
+	}
+
+	return res, nil
+}
+
+// GetUserBalanceRecordLast before 之前该币种最后一条记录，没有时返回nil
+func (s *StatementRepo) GetUserBalanceRecordLast(ctx context.Context, userId int64, coinTypes []string, before time.Time) (*biz.UserBalanceRecord, error) {
+	var record UserBalanceRecord
+	if err := s.data.db.Table("user_balance_record").
+		Where("user_id=? and coin_type in (?)", userId, coinTypes).
+		Where("created_at<?", before).
+		Order("id desc").
+		First(&record).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+
+		return nil, errors.New(500, "USER BALANCE RECORD ERROR", err.Error())
+	}
+
+	return &biz.UserBalanceRecord{
+		ID:        record.ID,
+		UserId:    record.UserId,
+		Balance:   record.Balance,
+		Amount:    record.Amount,
+		Type:      record.Type,
+		CoinType:  record.CoinType,
+		Reason:    record.Reason,
+		Remark:    record.Remark,
+		CreatedAt: record.CreatedAt,
+	}, nil
+}
+
+// GetUserRewardsByTime .
+func (s *StatementRepo) GetUserRewardsByTime(ctx context.Context, userId int64, start time.Time, end time.Time) ([]*biz.Reward, error) {
+	var rewards []*Reward
+	res := make([]*biz.Reward, 0)
+	if err := s.data.db.Table("reward").
+		Where("user_id=?", userId).
+		Where("created_at>=? and created_at<?", start, end).
+		Order("id asc").
+		Find(&rewards).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return res, nil
+		}
+
+		return nil, errors.New(500, "REWARD ERROR", err.Error())
+	}
+
+	for _, reward := range rewards {
+		res = append(res, &biz.Reward{
+			ID:               reward.ID,
+			UserId:           reward.UserId,
+			Amount:           reward.Amount,
+			BalanceRecordId:  reward.BalanceRecordId,
+			Type:             reward.Type,
+			TypeRecordId:     reward.TypeRecordId,
+			Reason:           reward.Reason,
+			ReasonLocationId: reward.ReasonLocationId,
+			LocationType:     reward.LocationType,
+			CreatedAt:        reward.CreatedAt,
+		})
+	}
+
+	return res, nil
+}
+
+// GetUserWithdrawsByTime .
+func (s *StatementRepo) GetUserWithdrawsByTime(ctx context.Context, userId int64, start time.Time, end time.Time) ([]*biz.Withdraw, error) {
+	var withdraws []*Withdraw
+	res := make([]*biz.Withdraw, 0)
+	if err := s.data.db.Table("withdraw").
+		Where("user_id=?", userId).
+		Where("created_at>=? and created_at<?", start, end).
+		Order("id asc").
+		Find(&withdraws).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return res, nil
+		}
+
+		return nil, errors.New(500, "WITHDRAW ERROR", err.Error())
+	}
+
+	for _, withdraw := range withdraws {
+		res = append(res, &biz.Withdraw{
+			ID:              withdraw.ID,
+			UserId:          withdraw.UserId,
+			Amount:          withdraw.Amount,
+			RelAmount:       withdraw.RelAmount,
+			BalanceRecordId: withdraw.BalanceRecordId,
+			Status:          withdraw.Status,
+			Type:            withdraw.Type,
+			Reason:          withdraw.Reason,
+			CreatedAt:       withdraw.CreatedAt,
+			UpdatedAt:       withdraw.UpdatedAt,
+		})
+	}
+
+	return res, nil
+}
+
+// GetUserEthRecordsByTime .
+func (s *StatementRepo) GetUserEthRecordsByTime(ctx context.Context, userId int64, start time.Time, end time.Time) ([]*biz.EthUserRecord, error) {
+	var ethUserRecords []*EthUserRecord
+	res := make([]*biz.EthUserRecord, 0)
+	if err := s.data.db.Table("eth_user_record").
+		Where("user_id=?", userId).
+		Where("created_at>=? and created_at<?", start, end).
+		Order("id asc").
+		Find(&ethUserRecords).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return res, nil
+		}
+
+		return nil, errors.New(500, "ETH USER RECORD ERROR", err.Error())
+	}
+
+	for _, item := range ethUserRecords {
+		res = append(res, &biz.EthUserRecord{
+			ID:        item.ID,
+			UserId:    item.UserId,
+			Hash:      item.Hash,
+			Status:    item.Status,
+			Type:      item.Type,
+			Amount:    item.Amount,
+			CoinType:  item.CoinType,
+			CreatedAt: item.CreatedAt,
+		})
+	}
+
+	return res, nil
+}
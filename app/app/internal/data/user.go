@@ -13,6 +13,7 @@ import (
 type User struct {
 	ID        int64     `gorm:"primarykey;type:int"`
 	Address   string    `gorm:"type:varchar(100)"`
+	Undo      int64     `gorm:"type:int;not null"`
 	CreatedAt time.Time `gorm:"type:datetime;not null"`
 	UpdatedAt time.Time `gorm:"type:datetime;not null"`
 }
@@ -40,6 +41,8 @@ type UserInfo struct {
 	UserId           int64     `gorm:"type:int;not null"`
 	Vip              int64     `gorm:"type:int;not null"`
 	HistoryRecommend int64     `gorm:"type:int;not null"`
+	VipCompute       int64     `gorm:"type:int;not null"`            // 规则计算的等级
+	VipAdmin         int64     `gorm:"type:int;not null;default:-1"` // 后台设置的等级，-1为未设置
 	CreatedAt        time.Time `gorm:"type:datetime;not null"`
 	UpdatedAt        time.Time `gorm:"type:datetime;not null"`
 }
@@ -97,6 +100,7 @@ type Withdraw struct {
 	Status          string    `gorm:"type:varchar(45);not null"`
 	Type            string    `gorm:"type:varchar(45);not null"`
 	BalanceRecordId int64     `gorm:"type:int"`
+	Reason          string    `gorm:"type:varchar(500);not null"`
 	CreatedAt       time.Time `gorm:"type:datetime;not null"`
 	UpdatedAt       time.Time `gorm:"type:datetime;not null"`
 }
@@ -108,6 +112,8 @@ type UserBalanceRecord struct {
 	Amount    int64     `gorm:"type:bigint"`
 	Type      string    `gorm:"type:varchar(45);not null"`
 	CoinType  string    `gorm:"type:varchar(45);not null"`
+	Reason    string    `gorm:"type:varchar(45);not null"`
+	Remark    string    `gorm:"type:varchar(500);not null"`
 	CreatedAt time.Time `gorm:"type:datetime;not null"`
 	UpdatedAt time.Time `gorm:"type:datetime;not null"`
 }
@@ -122,6 +128,7 @@ type Reward struct {
 	Reason           string    `gorm:"type:varchar(45);not null"`
 	ReasonLocationId int64     `gorm:"type:int;not null"`
 	LocationType     string    `gorm:"type:varchar(45);not null"`
+	Status           string    `gorm:"type:varchar(45);not null"` // 分红时收益占位的状态，非 running 时只记录不入余额，系统分红为空
 	CreatedAt        time.Time `gorm:"type:datetime;not null"`
 	UpdatedAt        time.Time `gorm:"type:datetime;not null"`
 }
@@ -317,8 +324,10 @@ func (u *UserRepo) GetUserById(ctx context.Context, Id int64) (*biz.User, error)
 	}
 
 	return &biz.User{
-		ID:      user.ID,
-		Address: user.Address,
+		ID:        user.ID,
+		Address:   user.Address,
+		Undo:      user.Undo,
+		CreatedAt: user.CreatedAt,
 	}, nil
 }
 
@@ -363,7 +372,7 @@ func (u *UserRepo) GetAdminById(ctx context.Context, id int64) (*biz.Admin, erro
 // GetUserInfoByUserId .
 func (ui *UserInfoRepo) GetUserInfoByUserId(ctx context.Context, userId int64) (*biz.UserInfo, error) {
 	var userInfo UserInfo
-	if err := ui.data.db.Where(&UserInfo{UserId: userId}).Table("user_info").First(&userInfo).Error; err != nil {
+	if err := ui.data.DB(ctx).Where(&UserInfo{UserId: userId}).Table("user_info").First(&userInfo).Error; err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
 			return nil, errors.NotFound("USERINFO_NOT_FOUND", "userinfo not found")
 		}
@@ -376,6 +385,8 @@ func (ui *UserInfoRepo) GetUserInfoByUserId(ctx context.Context, userId int64) (
 		UserId:           userInfo.UserId,
 		Vip:              userInfo.Vip,
 		HistoryRecommend: userInfo.HistoryRecommend,
+		VipCompute:       userInfo.VipCompute,
+		VipAdmin:         userInfo.VipAdmin,
 	}, nil
 }
 
@@ -694,6 +705,7 @@ func (u *UserRepo) DeleteAdminAuth(ctx context.Context, adminId int64, authId in
 func (ui *UserInfoRepo) CreateUserInfo(ctx context.Context, u *biz.User) (*biz.UserInfo, error) {
 	var userInfo UserInfo
 	userInfo.UserId = u.ID
+	userInfo.VipAdmin = -1
 
 	res := ui.data.DB(ctx).Table("user_info").Create(&userInfo)
 	if res.Error != nil {
@@ -705,40 +717,33 @@ func (ui *UserInfoRepo) CreateUserInfo(ctx context.Context, u *biz.User) (*biz.U
 		UserId:           userInfo.UserId,
 		Vip:              0,
 		HistoryRecommend: 0,
+		VipAdmin:         userInfo.VipAdmin,
 	}, nil
 }
 
 // UpdateUserInfo .
 func (ui *UserInfoRepo) UpdateUserInfo(ctx context.Context, u *biz.UserInfo) (*biz.UserInfo, error) {
-	var userInfo UserInfo
-	userInfo.Vip = u.Vip
-	userInfo.HistoryRecommend = u.HistoryRecommend
-
-	res := ui.data.DB(ctx).Table("user_info").Where("user_id=?", u.UserId).Updates(&userInfo)
+	// 等级可以降到0，用map更新零值
+	res := ui.data.DB(ctx).Table("user_info").Where("user_id=?", u.UserId).Updates(map[string]interface{}{
+		"vip":               u.Vip,
+		"history_recommend": u.HistoryRecommend,
+		"vip_compute":       u.VipCompute,
+		"vip_admin":         u.VipAdmin,
+	})
 	if res.Error != nil {
 		return nil, errors.New(500, "UPDATE_USER_INFO_ERROR", "用户信息修改失败")
 	}
 
 	return &biz.UserInfo{
-		ID:               userInfo.ID,
-		UserId:           userInfo.UserId,
-		Vip:              userInfo.Vip,
-		HistoryRecommend: userInfo.HistoryRecommend,
+		ID:               u.ID,
+		UserId:           u.UserId,
+		Vip:              u.Vip,
+		HistoryRecommend: u.HistoryRecommend,
+		VipCompute:       u.VipCompute,
+		VipAdmin:         u.VipAdmin,
 	}, nil
 }
 
-// UpdateBalance .
-func (ub *UserBalanceRepo) UpdateBalance(ctx context.Context, userId int64, amount int64) (bool, error) {
-	var err error
-	if err = ub.data.DB(ctx).Table("user_balance").
-		Where("user_id=?", userId).
-		Updates(map[string]interface{}{"balance_usdt": amount}).Error; nil != err {
-		return false, errors.NotFound("user balance err", "user balance not found")
-	}
-
-	return true, nil
-}
-
 // CreateUserArea .
 func (ur *UserRecommendRepo) CreateUserArea(ctx context.Context, u *biz.User) (bool, error) {
 	// 业务上限制了错误的上一级未insert下一级优先insert的情况
@@ -772,7 +777,7 @@ func (u *UserRepo) UpdateAdminPassword(ctx context.Context, account string, pass
 // GetUserRecommendByUserId .
 func (ur *UserRecommendRepo) GetUserRecommendByUserId(ctx context.Context, userId int64) (*biz.UserRecommend, error) {
 	var userRecommend UserRecommend
-	if err := ur.data.db.Where(&UserRecommend{UserId: userId}).Table("user_recommend").First(&userRecommend).Error; err != nil {
+	if err := ur.data.DB(ctx).Where(&UserRecommend{UserId: userId}).Table("user_recommend").First(&userRecommend).Error; err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
 			return nil, errors.NotFound("USER_RECOMMEND_NOT_FOUND", "user recommend not found")
 		}
@@ -1071,23 +1076,16 @@ func (ub UserBalanceRepo) GetUserBalance(ctx context.Context, userId int64) (*bi
 
 // LocationReward .
 func (ub *UserBalanceRepo) LocationReward(ctx context.Context, userId int64, amount int64, locationId int64, myLocationId int64, locationType string, status string) (int64, error) {
-	var err error
-	if "running" == status {
-		if err = ub.data.DB(ctx).Table("user_balance").
-			Where("user_id=?", userId).
-			Updates(map[string]interface{}{"balance_usdt": gorm.Expr("balance_usdt + ?", amount)}).Error; nil != err {
-			return 0, errors.NotFound("user balance err", "user balance not found")
-		}
-	}
-
-	var userBalance UserBalance
-	err = ub.data.DB(ctx).Where(&UserBalance{UserId: userId}).Table("user_balance").First(&userBalance).Error
+	userBalance, err := lockUserBalance(ctx, ub.data, userId)
 	if err != nil {
 		return 0, err
 	}
 
 	var userBalanceRecode UserBalanceRecord
 	userBalanceRecode.Balance = userBalance.BalanceUsdt
+	if "running" == status { // 余额由下面记账时加上
+		userBalanceRecode.Balance += amount
+	}
 	userBalanceRecode.UserId = userBalance.UserId
 	userBalanceRecode.Type = "reward"
 	userBalanceRecode.Amount = amount
@@ -1096,8 +1094,16 @@ func (ub *UserBalanceRepo) LocationReward(ctx context.Context, userId int64, amo
 		return 0, err
 	}
 
+	if "running" == status {
+		err = postLedger(ctx, ub.data, "location_reward", userBalanceRecode.ID, userLedger(userId, "usdt", amount, biz.LedgerAccountPool)...)
+		if err != nil {
+			return 0, err
+		}
+	}
+
 	var reward Reward
 	reward.UserId = userBalance.UserId
+	reward.Status = status
 	reward.Amount = amount
 	reward.BalanceRecordId = userBalanceRecode.ID
 	reward.Type = "location" // 本次分红的行为类型
@@ -1115,24 +1121,16 @@ func (ub *UserBalanceRepo) LocationReward(ctx context.Context, userId int64, amo
 
 // WithdrawReward2 .
 func (ub *UserBalanceRepo) WithdrawReward2(ctx context.Context, userId int64, amount int64, myLocationId int64, status string) (int64, error) {
-	var err error
-	if "running" == status {
-		if err = ub.data.DB(ctx).Table("user_balance").
-			Where("user_id=?", userId).
-			Updates(map[string]interface{}{"balance_usdt": gorm.Expr("balance_usdt + ?", amount)}).Error; nil != err {
-			return 0, errors.NotFound("user balance err", "user balance not found")
-		}
-
-	}
-
-	var userBalance UserBalance
-	err = ub.data.DB(ctx).Where(&UserBalance{UserId: userId}).Table("user_balance").First(&userBalance).Error
+	userBalance, err := lockUserBalance(ctx, ub.data, userId)
 	if err != nil {
 		return 0, err
 	}
 
 	var userBalanceRecode UserBalanceRecord
 	userBalanceRecode.Balance = userBalance.BalanceUsdt
+	if "running" == status { // 余额由下面记账时加上
+		userBalanceRecode.Balance += amount
+	}
 	userBalanceRecode.UserId = userBalance.UserId
 	userBalanceRecode.Type = "reward"
 	userBalanceRecode.Amount = amount
@@ -1141,8 +1139,16 @@ func (ub *UserBalanceRepo) WithdrawReward2(ctx context.Context, userId int64, am
 		return 0, err
 	}
 
+	if "running" == status {
+		err = postLedger(ctx, ub.data, "withdraw_reward", userBalanceRecode.ID, userLedger(userId, "usdt", amount, biz.LedgerAccountPool)...)
+		if err != nil {
+			return 0, err
+		}
+	}
+
 	var reward Reward
 	reward.UserId = userBalance.UserId
+	reward.Status = status
 	reward.Amount = amount
 	reward.BalanceRecordId = userBalanceRecode.ID
 	reward.Type = "withdraw"   // 本次分红的行为类型
@@ -1158,24 +1164,16 @@ func (ub *UserBalanceRepo) WithdrawReward2(ctx context.Context, userId int64, am
 
 // WithdrawReward .
 func (ub *UserBalanceRepo) WithdrawReward(ctx context.Context, userId int64, amount int64, locationId int64, myLocationId int64, locationType string, status string) (int64, error) {
-	var err error
-	if "running" == status {
-		if err = ub.data.DB(ctx).Table("user_balance").
-			Where("user_id=?", userId).
-			Updates(map[string]interface{}{"balance_usdt": gorm.Expr("balance_usdt + ?", amount)}).Error; nil != err {
-			return 0, errors.NotFound("user balance err", "user balance not found")
-		}
-
-	}
-
-	var userBalance UserBalance
-	err = ub.data.DB(ctx).Where(&UserBalance{UserId: userId}).Table("user_balance").First(&userBalance).Error
+	userBalance, err := lockUserBalance(ctx, ub.data, userId)
 	if err != nil {
 		return 0, err
 	}
 
 	var userBalanceRecode UserBalanceRecord
 	userBalanceRecode.Balance = userBalance.BalanceUsdt
+	if "running" == status { // 余额由下面记账时加上
+		userBalanceRecode.Balance += amount
+	}
 	userBalanceRecode.UserId = userBalance.UserId
 	userBalanceRecode.Type = "reward"
 	userBalanceRecode.Amount = amount
@@ -1184,8 +1182,16 @@ func (ub *UserBalanceRepo) WithdrawReward(ctx context.Context, userId int64, amo
 		return 0, err
 	}
 
+	if "running" == status {
+		err = postLedger(ctx, ub.data, "withdraw_reward", userBalanceRecode.ID, userLedger(userId, "usdt", amount, biz.LedgerAccountPool)...)
+		if err != nil {
+			return 0, err
+		}
+	}
+
 	var reward Reward
 	reward.UserId = userBalance.UserId
+	reward.Status = status
 	reward.Amount = amount
 	reward.BalanceRecordId = userBalanceRecode.ID
 	reward.Type = "withdraw" // 本次分红的行为类型
@@ -1203,15 +1209,7 @@ func (ub *UserBalanceRepo) WithdrawReward(ctx context.Context, userId int64, amo
 
 // Deposit .
 func (ub *UserBalanceRepo) Deposit(ctx context.Context, userId int64, amount int64, dhbAmount int64) (int64, error) {
-	var err error
-	if err = ub.data.DB(ctx).Table("user_balance").
-		Where("user_id=?", userId).
-		Updates(map[string]interface{}{"balance_dhb": gorm.Expr("balance_dhb + ?", dhbAmount)}).Error; nil != err {
-		return 0, errors.NotFound("user balance err", "user balance not found")
-	}
-
-	var userBalance UserBalance
-	err = ub.data.DB(ctx).Where(&UserBalance{UserId: userId}).Table("user_balance").First(&userBalance).Error
+	userBalance, err := lockUserBalance(ctx, ub.data, userId)
 	if err != nil {
 		return 0, err
 	}
@@ -1227,28 +1225,28 @@ func (ub *UserBalanceRepo) Deposit(ctx context.Context, userId int64, amount int
 		return 0, err
 	}
 
+	// usdt入金进奖金池，dhb入用户余额
+	err = postLedger(ctx, ub.data, "deposit", userBalanceRecode.ID, append(
+		userLedger(userId, "dhb", dhbAmount, biz.LedgerAccountExternal),
+		&biz.LedgerLeg{AccountType: biz.LedgerAccountExternal, CoinType: "usdt", Amount: -amount},
+		&biz.LedgerLeg{AccountType: biz.LedgerAccountPool, CoinType: "usdt", Amount: amount},
+	)...)
+	if err != nil {
+		return 0, err
+	}
+
 	return userBalanceRecode.ID, nil
 }
 
 // DepositLast .
 func (ub *UserBalanceRepo) DepositLast(ctx context.Context, userId int64, lastAmount int64, locationId int64) (int64, error) {
-	var (
-		err error
-	)
-	if err = ub.data.DB(ctx).Table("user_balance").
-		Where("user_id=?", userId).
-		Updates(map[string]interface{}{"balance_usdt": gorm.Expr("balance_usdt + ?", lastAmount)}).Error; nil != err {
-		return 0, errors.NotFound("user balance err", "user balance not found")
-	}
-
-	var userBalance UserBalance
-	err = ub.data.DB(ctx).Where(&UserBalance{UserId: userId}).Table("user_balance").First(&userBalance).Error
+	userBalance, err := lockUserBalance(ctx, ub.data, userId)
 	if err != nil {
 		return 0, err
 	}
 
 	var userBalanceRecode UserBalanceRecord
-	userBalanceRecode.Balance = userBalance.BalanceUsdt
+	userBalanceRecode.Balance = userBalance.BalanceUsdt + lastAmount
 	userBalanceRecode.UserId = userBalance.UserId
 	userBalanceRecode.Type = "reward"
 	userBalanceRecode.Amount = lastAmount
@@ -1257,8 +1255,14 @@ func (ub *UserBalanceRepo) DepositLast(ctx context.Context, userId int64, lastAm
 		return 0, err
 	}
 
+	err = postLedger(ctx, ub.data, "last_reward", userBalanceRecode.ID, userLedger(userId, "usdt", lastAmount, biz.LedgerAccountPool)...)
+	if err != nil {
+		return 0, err
+	}
+
 	var reward Reward
 	reward.UserId = userBalance.UserId
+	reward.Status = "running"
 	reward.Amount = lastAmount
 	reward.BalanceRecordId = userBalanceRecode.ID
 	reward.Type = "last"          // 本次分红的行为类型
@@ -1278,23 +1282,47 @@ func (ub *UserBalanceRepo) DepositLast(ctx context.Context, userId int64, lastAm
 	return userBalanceRecode.ID, nil
 }
 
-// DepositDhb .
-func (ub *UserBalanceRepo) DepositDhb(ctx context.Context, userId int64, amount int64) (int64, error) {
-	var err error
-	if err = ub.data.DB(ctx).Table("user_balance").
-		Where("user_id=?", userId).
-		Updates(map[string]interface{}{"balance_dhb": gorm.Expr("balance_dhb + ?", amount)}).Error; nil != err {
-		return 0, errors.NotFound("user balance err", "user balance not found")
+// Reinvest 余额复投，usdt从用户余额进奖金池，dhb和充值一样入用户余额
+func (ub *UserBalanceRepo) Reinvest(ctx context.Context, userId int64, amount int64, dhbAmount int64) (int64, error) {
+	userBalance, err := lockUserBalance(ctx, ub.data, userId)
+	if err != nil {
+		return 0, err
+	}
+	if userBalance.BalanceUsdt < amount {
+		return 0, errors.New(500, "REINVEST_BALANCE_NOT_ENOUGH", "余额不足")
 	}
 
-	var userBalance UserBalance
-	err = ub.data.DB(ctx).Where(&UserBalance{UserId: userId}).Table("user_balance").First(&userBalance).Error
+	var userBalanceRecode UserBalanceRecord
+	userBalanceRecode.Balance = userBalance.BalanceUsdt - amount
+	userBalanceRecode.UserId = userBalance.UserId
+	userBalanceRecode.Type = "reinvest"
+	userBalanceRecode.CoinType = "usdt"
+	userBalanceRecode.Amount = amount
+	err = ub.data.DB(ctx).Table("user_balance_record").Create(&userBalanceRecode).Error
+	if err != nil {
+		return 0, err
+	}
+
+	err = postLedger(ctx, ub.data, "reinvest", userBalanceRecode.ID, append(
+		userLedger(userId, "usdt", -amount, biz.LedgerAccountPool),
+		userLedger(userId, "dhb", dhbAmount, biz.LedgerAccountExternal)...,
+	)...)
+	if err != nil {
+		return 0, err
+	}
+
+	return userBalanceRecode.ID, nil
+}
+
+// DepositDhb .
+func (ub *UserBalanceRepo) DepositDhb(ctx context.Context, userId int64, amount int64) (int64, error) {
+	userBalance, err := lockUserBalance(ctx, ub.data, userId)
 	if err != nil {
 		return 0, err
 	}
 
 	var userBalanceRecode UserBalanceRecord
-	userBalanceRecode.Balance = userBalance.BalanceDhb
+	userBalanceRecode.Balance = userBalance.BalanceDhb + amount
 	userBalanceRecode.UserId = userBalance.UserId
 	userBalanceRecode.Type = "deposit"
 	userBalanceRecode.CoinType = "dhb"
@@ -1304,26 +1332,26 @@ func (ub *UserBalanceRepo) DepositDhb(ctx context.Context, userId int64, amount
 		return 0, err
 	}
 
+	err = postLedger(ctx, ub.data, "deposit", userBalanceRecode.ID, userLedger(userId, "dhb", amount, biz.LedgerAccountExternal)...)
+	if err != nil {
+		return 0, err
+	}
+
 	return userBalanceRecode.ID, nil
 }
 
 // WithdrawUsdt .
 func (ub *UserBalanceRepo) WithdrawUsdt(ctx context.Context, userId int64, amount int64) error {
-	var err error
-	if res := ub.data.DB(ctx).Table("user_balance").
-		Where("user_id=? and balance_usdt>=?", userId, amount).
-		Updates(map[string]interface{}{"balance_usdt": gorm.Expr("balance_usdt - ?", amount)}); 0 == res.RowsAffected || nil != res.Error {
-		return errors.NotFound("user balance err", "user balance error")
-	}
-
-	var userBalance UserBalance
-	err = ub.data.DB(ctx).Where(&UserBalance{UserId: userId}).Table("user_balance").First(&userBalance).Error
+	userBalance, err := lockUserBalance(ctx, ub.data, userId)
 	if err != nil {
 		return err
 	}
+	if userBalance.BalanceUsdt < amount {
+		return errors.NotFound("user balance err", "user balance error")
+	}
 
 	var userBalanceRecode UserBalanceRecord
-	userBalanceRecode.Balance = userBalance.BalanceUsdt
+	userBalanceRecode.Balance = userBalance.BalanceUsdt - amount
 	userBalanceRecode.UserId = userBalance.UserId
 	userBalanceRecode.Type = "withdraw"
 	userBalanceRecode.CoinType = "usdt"
@@ -1333,6 +1361,11 @@ func (ub *UserBalanceRepo) WithdrawUsdt(ctx context.Context, userId int64, amoun
 		return err
 	}
 
+	err = postLedger(ctx, ub.data, "withdraw", userBalanceRecode.ID, userLedger(userId, "usdt", -amount, biz.LedgerAccountPayout)...)
+	if err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -1340,7 +1373,7 @@ func (ub *UserBalanceRepo) WithdrawUsdt(ctx context.Context, userId int64, amoun
 func (ub *UserBalanceRepo) GetWithdrawDaily(ctx context.Context, day int) (int64, error) {
 	var total UserWithdrawTotal
 
-	now := time.Now().UTC().AddDate(0, 0, day)
+	now := biz.Now(ctx).AddDate(0, 0, day)
 	var startDate time.Time
 	var endDate time.Time
 	if 14 <= now.Hour() {
@@ -1355,6 +1388,7 @@ func (ub *UserBalanceRepo) GetWithdrawDaily(ctx context.Context, day int) (int64
 
 	if err := ub.data.db.Table("withdraw").
 		Where("type=?", "usdt").
+		Where("status not in (?)", []string{"rejected", "canceled"}).
 		Where("created_at>=?", todayStart).Where("created_at<?", todayEnd).Select("sum(amount) as total").Take(&total).Error; err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
 			return total.Total, errors.NotFound("USER_WITHDRAW_NOT_FOUND", "withdraw not found")
@@ -1366,23 +1400,84 @@ func (ub *UserBalanceRepo) GetWithdrawDaily(ctx context.Context, day int) (int64
 	return total.Total, nil
 }
 
-// WithdrawDhb .
-func (ub *UserBalanceRepo) WithdrawDhb(ctx context.Context, userId int64, amount int64) error {
-	var err error
-	if res := ub.data.DB(ctx).Table("user_balance").
-		Where("user_id=? and balance_dhb>=?", userId, amount).
-		Updates(map[string]interface{}{"balance_dhb": gorm.Expr("balance_dhb - ?", amount)}); 0 == res.RowsAffected || nil != res.Error {
-		return errors.NotFound("user balance err", "user balance error")
+// GetUserWithdrawToday 用户今日提现次数和金额
+func (ub *UserBalanceRepo) GetUserWithdrawToday(ctx context.Context, userId int64, coinType string) (int64, int64, error) {
+	var (
+		total UserWithdrawTotal
+		count int64
+	)
+
+	todayStart, todayEnd := withdrawToday()
+	instance := ub.data.DB(ctx).Table("withdraw").
+		Where("user_id=?", userId).
+		Where("type=?", coinType).
+		Where("status not in (?)", []string{"rejected", "canceled"}).
+		Where("created_at>=?", todayStart).Where("created_at<?", todayEnd)
+
+	if err := instance.Count(&count).Error; err != nil {
+		return 0, 0, errors.New(500, "USER WITHDRAW ERROR", err.Error())
 	}
 
-	var userBalance UserBalance
-	err = ub.data.DB(ctx).Where(&UserBalance{UserId: userId}).Table("user_balance").First(&userBalance).Error
+	if err := instance.Select("coalesce(sum(amount), 0) as total").Take(&total).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return count, 0, nil
+		}
+
+		return 0, 0, errors.New(500, "USER WITHDRAW ERROR", err.Error())
+	}
+
+	return count, total.Total, nil
+}
+
+// GetWithdrawTotalToday 平台今日提现金额
+func (ub *UserBalanceRepo) GetWithdrawTotalToday(ctx context.Context, coinType string) (int64, error) {
+	var total UserWithdrawTotal
+
+	todayStart, todayEnd := withdrawToday()
+	if err := ub.data.DB(ctx).Table("withdraw").
+		Where("type=?", coinType).
+		Where("status not in (?)", []string{"rejected", "canceled"}).
+		Where("created_at>=?", todayStart).Where("created_at<?", todayEnd).
+		Select("coalesce(sum(amount), 0) as total").Take(&total).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return 0, nil
+		}
+
+		return 0, errors.New(500, "USER WITHDRAW ERROR", err.Error())
+	}
+
+	return total.Total, nil
+}
+
+// withdrawToday 与统计一致，以 UTC 14 点为日切
+func withdrawToday() (time.Time, time.Time) {
+	now := time.Now().UTC()
+	var startDate time.Time
+	var endDate time.Time
+	if 14 <= now.Hour() {
+		startDate = now
+		endDate = now.AddDate(0, 0, 1)
+	} else {
+		startDate = now.AddDate(0, 0, -1)
+		endDate = now
+	}
+
+	return time.Date(startDate.Year(), startDate.Month(), startDate.Day(), 14, 0, 0, 0, time.UTC),
+		time.Date(endDate.Year(), endDate.Month(), endDate.Day(), 14, 0, 0, 0, time.UTC)
+}
+
+// WithdrawDhb .
+func (ub *UserBalanceRepo) WithdrawDhb(ctx context.Context, userId int64, amount int64) error {
+	userBalance, err := lockUserBalance(ctx, ub.data, userId)
 	if err != nil {
 		return err
 	}
+	if userBalance.BalanceDhb < amount {
+		return errors.NotFound("user balance err", "user balance error")
+	}
 
 	var userBalanceRecode UserBalanceRecord
-	userBalanceRecode.Balance = userBalance.BalanceDhb
+	userBalanceRecode.Balance = userBalance.BalanceDhb - amount
 	userBalanceRecode.UserId = userBalance.UserId
 	userBalanceRecode.Type = "withdraw"
 	userBalanceRecode.CoinType = "dhb"
@@ -1392,6 +1487,11 @@ func (ub *UserBalanceRepo) WithdrawDhb(ctx context.Context, userId int64, amount
 		return err
 	}
 
+	err = postLedger(ctx, ub.data, "withdraw", userBalanceRecode.ID, userLedger(userId, "dhb", -amount, biz.LedgerAccountPayout)...)
+	if err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -1418,67 +1518,71 @@ func (ub *UserBalanceRepo) GreateWithdraw(ctx context.Context, userId int64, amo
 	}, nil
 }
 
-// UpdateWithdraw .
-func (ub *UserBalanceRepo) UpdateWithdraw(ctx context.Context, id int64, status string) (*biz.Withdraw, error) {
-	var withdraw Withdraw
-	withdraw.Status = status
-	res := ub.data.DB(ctx).Table("withdraw").Where("id=?", id).Updates(&withdraw)
-	if res.Error != nil {
-		return nil, errors.New(500, "CREATE_WITHDRAW_ERROR", "提现记录修改失败")
+// GetWithdrawSuccessByTime 按完成时间取打款成功的提现，[start, end)
+func (ub *UserBalanceRepo) GetWithdrawSuccessByTime(ctx context.Context, start time.Time, end time.Time) ([]*biz.Withdraw, error) {
+	var withdraws []*Withdraw
+	res := make([]*biz.Withdraw, 0)
+	if err := ub.data.db.Table("withdraw").
+		Where("status=?", "success").
+		Where("updated_at>=? and updated_at<?", start, end).
+		Order("updated_at asc").
+		Find(&withdraws).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return res, nil
+		}
+
+		return nil, errors.New(500, "WITHDRAW ERROR", err.Error())
 	}
 
-	return &biz.Withdraw{
-		ID:              withdraw.ID,
-		UserId:          withdraw.UserId,
-		Amount:          withdraw.Amount,
-		RelAmount:       withdraw.RelAmount,
-		BalanceRecordId: withdraw.BalanceRecordId,
-		Status:          withdraw.Status,
-		Type:            withdraw.Type,
-		CreatedAt:       withdraw.CreatedAt,
-	}, nil
+	for _, withdraw := range withdraws {
+		res = append(res, &biz.Withdraw{
+			ID:              withdraw.ID,
+			UserId:          withdraw.UserId,
+			Amount:          withdraw.Amount,
+			RelAmount:       withdraw.RelAmount,
+			BalanceRecordId: withdraw.BalanceRecordId,
+			Status:          withdraw.Status,
+			Type:            withdraw.Type,
+			Reason:          withdraw.Reason,
+			CreatedAt:       withdraw.CreatedAt,
+			UpdatedAt:       withdraw.UpdatedAt,
+		})
+	}
+
+	return res, nil
 }
 
-// UpdateWithdrawDoingToRewarded .
-func (ub *UserBalanceRepo) UpdateWithdrawDoingToRewarded(ctx context.Context) error {
-	var withdraw Withdraw
-	withdraw.Status = "rewarded"
+// GetWithdrawDoingTimeout 打款中超过20分钟的提现
+func (ub *UserBalanceRepo) GetWithdrawDoingTimeout(ctx context.Context) ([]*biz.Withdraw, error) {
+	var withdraws []*Withdraw
+	res := make([]*biz.Withdraw, 0)
 	timeLimit := time.Now().UTC().Add(-20 * time.Minute)
-	res := ub.data.DB(ctx).Table("withdraw").
+	if err := ub.data.db.Table("withdraw").
 		Where("status=?", "doing").
 		Where("created_at<=?", timeLimit).
-		Updates(&withdraw)
-	if res.Error != nil {
-		if errors.Is(res.Error, gorm.ErrRecordNotFound) {
-			return nil
+		Find(&withdraws).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return res, nil
 		}
 
-		return errors.New(500, "CREATE_WITHDRAW_ERROR", "提现记录修改失败")
+		return nil, errors.New(500, "WITHDRAW ERROR", err.Error())
 	}
 
-	return nil
-}
-
-// UpdateWithdrawAmount .
-func (ub *UserBalanceRepo) UpdateWithdrawAmount(ctx context.Context, id int64, status string, amount int64) (*biz.Withdraw, error) {
-	var withdraw Withdraw
-	withdraw.Status = status
-	withdraw.RelAmount = amount
-	res := ub.data.DB(ctx).Table("withdraw").Where("id=?", id).Updates(&withdraw)
-	if res.Error != nil {
-		return nil, errors.New(500, "CREATE_WITHDRAW_ERROR", "提现记录修改失败")
+	for _, withdraw := range withdraws {
+		res = append(res, &biz.Withdraw{
+			ID:              withdraw.ID,
+			UserId:          withdraw.UserId,
+			Amount:          withdraw.Amount,
+			RelAmount:       withdraw.RelAmount,
+			BalanceRecordId: withdraw.BalanceRecordId,
+			Status:          withdraw.Status,
+			Type:            withdraw.Type,
+			Reason:          withdraw.Reason,
+			CreatedAt:       withdraw.CreatedAt,
+		})
 	}
 
-	return &biz.Withdraw{
-		ID:              withdraw.ID,
-		UserId:          withdraw.UserId,
-		Amount:          withdraw.Amount,
-		RelAmount:       withdraw.RelAmount,
-		BalanceRecordId: withdraw.BalanceRecordId,
-		Status:          withdraw.Status,
-		Type:            withdraw.Type,
-		CreatedAt:       withdraw.CreatedAt,
-	}, nil
+	return res, nil
 }
 
 // GetWithdrawByUserId .
@@ -1502,6 +1606,7 @@ func (ub *UserBalanceRepo) GetWithdrawByUserId(ctx context.Context, userId int64
 			BalanceRecordId: withdraw.BalanceRecordId,
 			Status:          withdraw.Status,
 			Type:            withdraw.Type,
+			Reason:          withdraw.Reason,
 			CreatedAt:       withdraw.CreatedAt,
 		})
 	}
@@ -1552,6 +1657,7 @@ func (ub *UserBalanceRepo) GetWithdrawById(ctx context.Context, id int64) (*biz.
 		BalanceRecordId: withdraw.BalanceRecordId,
 		Status:          withdraw.Status,
 		Type:            withdraw.Type,
+		Reason:          withdraw.Reason,
 		CreatedAt:       withdraw.CreatedAt,
 	}, nil
 }
@@ -1592,6 +1698,7 @@ func (ub *UserBalanceRepo) GetWithdraws(ctx context.Context, b *biz.Pagination,
 			BalanceRecordId: withdraw.BalanceRecordId,
 			Status:          withdraw.Status,
 			Type:            withdraw.Type,
+			Reason:          withdraw.Reason,
 			CreatedAt:       withdraw.CreatedAt,
 		})
 	}
@@ -1650,23 +1757,16 @@ func (ub *UserBalanceRepo) GetWithdrawPassOrRewardedFirst(ctx context.Context) (
 
 // RecommendReward .
 func (ub *UserBalanceRepo) RecommendReward(ctx context.Context, userId int64, amount int64, locationId int64, status string) (int64, error) {
-	var err error
-	if "running" == status {
-		if err = ub.data.DB(ctx).Table("user_balance").
-			Where("user_id=?", userId).
-			Updates(map[string]interface{}{"balance_usdt": gorm.Expr("balance_usdt + ?", amount)}).Error; nil != err {
-			return 0, errors.NotFound("user balance err", "user balance not found")
-		}
-	}
-
-	var userBalance UserBalance
-	err = ub.data.DB(ctx).Where(&UserBalance{UserId: userId}).Table("user_balance").First(&userBalance).Error
+	userBalance, err := lockUserBalance(ctx, ub.data, userId)
 	if err != nil {
 		return 0, err
 	}
 
 	var userBalanceRecode UserBalanceRecord
 	userBalanceRecode.Balance = userBalance.BalanceUsdt
+	if "running" == status { // 余额由下面记账时加上
+		userBalanceRecode.Balance += amount
+	}
 	userBalanceRecode.UserId = userBalance.UserId
 	userBalanceRecode.Type = "reward"
 	userBalanceRecode.Amount = amount
@@ -1675,8 +1775,16 @@ func (ub *UserBalanceRepo) RecommendReward(ctx context.Context, userId int64, am
 		return 0, err
 	}
 
+	if "running" == status {
+		err = postLedger(ctx, ub.data, "recommend_reward", userBalanceRecode.ID, userLedger(userId, "usdt", amount, biz.LedgerAccountPool)...)
+		if err != nil {
+			return 0, err
+		}
+	}
+
 	var reward Reward
 	reward.UserId = userBalance.UserId
+	reward.Status = status
 	reward.Amount = amount
 	reward.BalanceRecordId = userBalanceRecode.ID
 	reward.Type = "location" // 本次分红的行为类型
@@ -1692,23 +1800,16 @@ func (ub *UserBalanceRepo) RecommendReward(ctx context.Context, userId int64, am
 
 // RecommendTopReward .
 func (ub *UserBalanceRepo) RecommendTopReward(ctx context.Context, userId int64, amount int64, locationId int64, vip int64, status string) (int64, error) {
-	var err error
-	if "running" == status {
-		if err = ub.data.DB(ctx).Table("user_balance").
-			Where("user_id=?", userId).
-			Updates(map[string]interface{}{"balance_usdt": gorm.Expr("balance_usdt + ?", amount)}).Error; nil != err {
-			return 0, errors.NotFound("user balance err", "user balance not found")
-		}
-	}
-
-	var userBalance UserBalance
-	err = ub.data.DB(ctx).Where(&UserBalance{UserId: userId}).Table("user_balance").First(&userBalance).Error
+	userBalance, err := lockUserBalance(ctx, ub.data, userId)
 	if err != nil {
 		return 0, err
 	}
 
 	var userBalanceRecode UserBalanceRecord
 	userBalanceRecode.Balance = userBalance.BalanceUsdt
+	if "running" == status { // 余额由下面记账时加上
+		userBalanceRecode.Balance += amount
+	}
 	userBalanceRecode.UserId = userBalance.UserId
 	userBalanceRecode.Type = "reward"
 	userBalanceRecode.Amount = amount
@@ -1717,8 +1818,16 @@ func (ub *UserBalanceRepo) RecommendTopReward(ctx context.Context, userId int64,
 		return 0, err
 	}
 
+	if "running" == status {
+		err = postLedger(ctx, ub.data, "recommend_top_reward", userBalanceRecode.ID, userLedger(userId, "usdt", amount, biz.LedgerAccountPool)...)
+		if err != nil {
+			return 0, err
+		}
+	}
+
 	var reward Reward
 	reward.UserId = userBalance.UserId
+	reward.Status = status
 	reward.Amount = amount
 	reward.BalanceRecordId = userBalanceRecode.ID
 	reward.Type = "location" // 本次分红的行为类型
@@ -1750,6 +1859,11 @@ func (ub *UserBalanceRepo) SystemReward(ctx context.Context, amount int64, locat
 		return err
 	}
 
+	err = postLedger(ctx, ub.data, "system_reward", reward.ID, systemLedger("usdt", amount, biz.LedgerAccountPool, biz.LedgerAccountFee)...)
+	if err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -1769,6 +1883,11 @@ func (ub *UserBalanceRepo) SystemDailyReward(ctx context.Context, amount int64,
 		return err
 	}
 
+	err = postLedger(ctx, ub.data, "system_daily_reward", reward.ID, systemLedger("usdt", amount, biz.LedgerAccountPool, biz.LedgerAccountFee)...)
+	if err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -1789,6 +1908,11 @@ func (ub *UserBalanceRepo) SystemWithdrawReward(ctx context.Context, amount int6
 		return err
 	}
 
+	err = postLedger(ctx, ub.data, "system_reward", reward.ID, systemLedger("usdt", amount, biz.LedgerAccountPool, biz.LedgerAccountFee)...)
+	if err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -1796,7 +1920,7 @@ func (ub *UserBalanceRepo) SystemWithdrawReward(ctx context.Context, amount int6
 func (ub *UserBalanceRepo) GetYesterdayDailyReward(ctx context.Context, day int, userIds []int64) (map[int64][]*biz.Reward, error) {
 	var rewards []*Reward
 
-	now := time.Now().UTC().AddDate(0, 0, day)
+	now := biz.Now(ctx).AddDate(0, 0, day)
 	var startDate time.Time
 	var endDate time.Time
 	if 14 <= now.Hour() {
@@ -1851,7 +1975,7 @@ func (ub *UserBalanceRepo) GetYesterdayDailyReward(ctx context.Context, day int,
 func (ub *UserBalanceRepo) GetSystemYesterdayDailyReward(ctx context.Context, day int) (*biz.Reward, error) {
 	var reward Reward
 
-	now := time.Now().UTC().AddDate(0, 0, day)
+	now := biz.Now(ctx).AddDate(0, 0, day)
 	var startDate time.Time
 	var endDate time.Time
 	if 14 <= now.Hour() {
@@ -1907,26 +2031,23 @@ func (ub *UserBalanceRepo) SystemFee(ctx context.Context, amount int64, location
 		return err
 	}
 
+	err = postLedger(ctx, ub.data, "system_fee", reward.ID, systemLedger("usdt", amount, biz.LedgerAccountPayout, biz.LedgerAccountFee)...)
+	if err != nil {
+		return err
+	}
+
 	return nil
 }
 
 // UserFee .
 func (ub *UserBalanceRepo) UserFee(ctx context.Context, userId int64, amount int64) (int64, error) {
-	var err error
-	if err = ub.data.DB(ctx).Table("user_balance").
-		Where("user_id=?", userId).
-		Updates(map[string]interface{}{"balance_usdt": gorm.Expr("balance_usdt + ?", amount)}).Error; nil != err {
-		return 0, errors.NotFound("user balance err", "user balance not found")
-	}
-
-	var userBalance UserBalance
-	err = ub.data.DB(ctx).Where(&UserBalance{UserId: userId}).Table("user_balance").First(&userBalance).Error
+	userBalance, err := lockUserBalance(ctx, ub.data, userId)
 	if err != nil {
 		return 0, err
 	}
 
 	var userBalanceRecode UserBalanceRecord
-	userBalanceRecode.Balance = userBalance.BalanceUsdt
+	userBalanceRecode.Balance = userBalance.BalanceUsdt + amount
 	userBalanceRecode.UserId = userBalance.UserId
 	userBalanceRecode.Type = "reward"
 	userBalanceRecode.Amount = amount
@@ -1935,8 +2056,14 @@ func (ub *UserBalanceRepo) UserFee(ctx context.Context, userId int64, amount int
 		return 0, err
 	}
 
+	err = postLedger(ctx, ub.data, "fee_reward", userBalanceRecode.ID, userLedger(userId, "usdt", amount, biz.LedgerAccountPool)...)
+	if err != nil {
+		return 0, err
+	}
+
 	var reward Reward
 	reward.UserId = userBalance.UserId
+	reward.Status = "running"
 	reward.Amount = amount
 	reward.BalanceRecordId = userBalanceRecode.ID
 	reward.Type = "system_reward" // 本次分红的行为类型
@@ -1951,24 +2078,16 @@ func (ub *UserBalanceRepo) UserFee(ctx context.Context, userId int64, amount int
 
 // UserDailyFee .
 func (ub *UserBalanceRepo) UserDailyFee(ctx context.Context, userId int64, amount int64, status string) (int64, error) {
-	var err error
-	if "running" == status {
-		if err = ub.data.DB(ctx).Table("user_balance").
-			Where("user_id=?", userId).
-			Updates(map[string]interface{}{"balance_usdt": gorm.Expr("balance_usdt + ?", amount)}).Error; nil != err {
-			return 0, errors.NotFound("user balance err", "user balance not found")
-		}
-
-	}
-
-	var userBalance UserBalance
-	err = ub.data.DB(ctx).Where(&UserBalance{UserId: userId}).Table("user_balance").First(&userBalance).Error
+	userBalance, err := lockUserBalance(ctx, ub.data, userId)
 	if err != nil {
 		return 0, err
 	}
 
 	var userBalanceRecode UserBalanceRecord
 	userBalanceRecode.Balance = userBalance.BalanceUsdt
+	if "running" == status { // 余额由下面记账时加上
+		userBalanceRecode.Balance += amount
+	}
 	userBalanceRecode.UserId = userBalance.UserId
 	userBalanceRecode.Type = "reward"
 	userBalanceRecode.Amount = amount
@@ -1977,8 +2096,16 @@ func (ub *UserBalanceRepo) UserDailyFee(ctx context.Context, userId int64, amoun
 		return 0, err
 	}
 
+	if "running" == status {
+		err = postLedger(ctx, ub.data, "daily_fee_reward", userBalanceRecode.ID, userLedger(userId, "usdt", amount, biz.LedgerAccountPool)...)
+		if err != nil {
+			return 0, err
+		}
+	}
+
 	var reward Reward
 	reward.UserId = userBalance.UserId
+	reward.Status = status
 	reward.Amount = amount
 	reward.BalanceRecordId = userBalanceRecode.ID
 	reward.Type = "system_reward_daily" // 本次分红的行为类型
@@ -1993,24 +2120,16 @@ func (ub *UserBalanceRepo) UserDailyFee(ctx context.Context, userId int64, amoun
 
 // UserDailyRecommendArea .
 func (ub *UserBalanceRepo) UserDailyRecommendArea(ctx context.Context, userId int64, amount int64, status string) (int64, error) {
-	var err error
-	if "running" == status {
-		if err = ub.data.DB(ctx).Table("user_balance").
-			Where("user_id=?", userId).
-			Updates(map[string]interface{}{"balance_usdt": gorm.Expr("balance_usdt + ?", amount)}).Error; nil != err {
-			return 0, errors.NotFound("user balance err", "user balance not found")
-		}
-
-	}
-
-	var userBalance UserBalance
-	err = ub.data.DB(ctx).Where(&UserBalance{UserId: userId}).Table("user_balance").First(&userBalance).Error
+	userBalance, err := lockUserBalance(ctx, ub.data, userId)
 	if err != nil {
 		return 0, err
 	}
 
 	var userBalanceRecode UserBalanceRecord
 	userBalanceRecode.Balance = userBalance.BalanceUsdt
+	if "running" == status { // 余额由下面记账时加上
+		userBalanceRecode.Balance += amount
+	}
 	userBalanceRecode.UserId = userBalance.UserId
 	userBalanceRecode.Type = "reward"
 	userBalanceRecode.Amount = amount
@@ -2019,8 +2138,16 @@ func (ub *UserBalanceRepo) UserDailyRecommendArea(ctx context.Context, userId in
 		return 0, err
 	}
 
+	if "running" == status {
+		err = postLedger(ctx, ub.data, "daily_area_reward", userBalanceRecode.ID, userLedger(userId, "usdt", amount, biz.LedgerAccountPool)...)
+		if err != nil {
+			return 0, err
+		}
+	}
+
 	var reward Reward
 	reward.UserId = userBalance.UserId
+	reward.Status = status
 	reward.Amount = amount
 	reward.BalanceRecordId = userBalanceRecode.ID
 	reward.Type = "system_reward_daily"    // 本次分红的行为类型
@@ -2035,23 +2162,16 @@ func (ub *UserBalanceRepo) UserDailyRecommendArea(ctx context.Context, userId in
 
 // RecommendWithdrawReward .
 func (ub *UserBalanceRepo) RecommendWithdrawReward(ctx context.Context, userId int64, amount int64, locationId int64, status string) (int64, error) {
-	var err error
-	if "running" == status {
-		if err = ub.data.DB(ctx).Table("user_balance").
-			Where("user_id=?", userId).
-			Updates(map[string]interface{}{"balance_usdt": gorm.Expr("balance_usdt + ?", amount)}).Error; nil != err {
-			return 0, errors.NotFound("user balance err", "user balance not found")
-		}
-	}
-
-	var userBalance UserBalance
-	err = ub.data.DB(ctx).Where(&UserBalance{UserId: userId}).Table("user_balance").First(&userBalance).Error
+	userBalance, err := lockUserBalance(ctx, ub.data, userId)
 	if err != nil {
 		return 0, err
 	}
 
 	var userBalanceRecode UserBalanceRecord
 	userBalanceRecode.Balance = userBalance.BalanceUsdt
+	if "running" == status { // 余额由下面记账时加上
+		userBalanceRecode.Balance += amount
+	}
 	userBalanceRecode.UserId = userBalance.UserId
 	userBalanceRecode.Type = "reward"
 	userBalanceRecode.Amount = amount
@@ -2060,8 +2180,16 @@ func (ub *UserBalanceRepo) RecommendWithdrawReward(ctx context.Context, userId i
 		return 0, err
 	}
 
+	if "running" == status {
+		err = postLedger(ctx, ub.data, "recommend_withdraw_reward", userBalanceRecode.ID, userLedger(userId, "usdt", amount, biz.LedgerAccountPool)...)
+		if err != nil {
+			return 0, err
+		}
+	}
+
 	var reward Reward
 	reward.UserId = userBalance.UserId
+	reward.Status = status
 	reward.Amount = amount
 	reward.BalanceRecordId = userBalanceRecode.ID
 	reward.Type = "withdraw" // 本次分红的行为类型
@@ -2077,24 +2205,16 @@ func (ub *UserBalanceRepo) RecommendWithdrawReward(ctx context.Context, userId i
 
 // RecommendWithdrawTopReward .
 func (ub *UserBalanceRepo) RecommendWithdrawTopReward(ctx context.Context, userId int64, amount int64, locationId int64, vip int64, status string) (int64, error) {
-	var err error
-	if "running" == status {
-		if err = ub.data.DB(ctx).Table("user_balance").
-			Where("user_id=?", userId).
-			Updates(map[string]interface{}{"balance_usdt": gorm.Expr("balance_usdt + ?", amount)}).Error; nil != err {
-			return 0, errors.NotFound("user balance err", "user balance not found")
-		}
-
-	}
-
-	var userBalance UserBalance
-	err = ub.data.DB(ctx).Where(&UserBalance{UserId: userId}).Table("user_balance").First(&userBalance).Error
+	userBalance, err := lockUserBalance(ctx, ub.data, userId)
 	if err != nil {
 		return 0, err
 	}
 
 	var userBalanceRecode UserBalanceRecord
 	userBalanceRecode.Balance = userBalance.BalanceUsdt
+	if "running" == status { // 余额由下面记账时加上
+		userBalanceRecode.Balance += amount
+	}
 	userBalanceRecode.UserId = userBalance.UserId
 	userBalanceRecode.Type = "reward"
 	userBalanceRecode.Amount = amount
@@ -2103,8 +2223,16 @@ func (ub *UserBalanceRepo) RecommendWithdrawTopReward(ctx context.Context, userI
 		return 0, err
 	}
 
+	if "running" == status {
+		err = postLedger(ctx, ub.data, "recommend_withdraw_top_reward", userBalanceRecode.ID, userLedger(userId, "usdt", amount, biz.LedgerAccountPool)...)
+		if err != nil {
+			return 0, err
+		}
+	}
+
 	var reward Reward
 	reward.UserId = userBalance.UserId
+	reward.Status = status
 	reward.Amount = amount
 	reward.BalanceRecordId = userBalanceRecode.ID
 	reward.Type = "withdraw" // 本次分红的行为类型
@@ -2121,23 +2249,16 @@ func (ub *UserBalanceRepo) RecommendWithdrawTopReward(ctx context.Context, userI
 
 // NormalRecommendTopReward .
 func (ub *UserBalanceRepo) NormalRecommendTopReward(ctx context.Context, userId int64, amount int64, locationId int64, reasonId int64, status string) (int64, error) {
-	var err error
-	if "running" == status {
-		if err = ub.data.DB(ctx).Table("user_balance").
-			Where("user_id=?", userId).
-			Updates(map[string]interface{}{"balance_usdt": gorm.Expr("balance_usdt + ?", amount)}).Error; nil != err {
-			return 0, errors.NotFound("user balance err", "user balance not found")
-		}
-	}
-
-	var userBalance UserBalance
-	err = ub.data.DB(ctx).Where(&UserBalance{UserId: userId}).Table("user_balance").First(&userBalance).Error
+	userBalance, err := lockUserBalance(ctx, ub.data, userId)
 	if err != nil {
 		return 0, err
 	}
 
 	var userBalanceRecode UserBalanceRecord
 	userBalanceRecode.Balance = userBalance.BalanceUsdt
+	if "running" == status { // 余额由下面记账时加上
+		userBalanceRecode.Balance += amount
+	}
 	userBalanceRecode.UserId = userBalance.UserId
 	userBalanceRecode.Type = "reward"
 	userBalanceRecode.Amount = amount
@@ -2146,8 +2267,16 @@ func (ub *UserBalanceRepo) NormalRecommendTopReward(ctx context.Context, userId
 		return 0, err
 	}
 
+	if "running" == status {
+		err = postLedger(ctx, ub.data, "recommend_top_reward", userBalanceRecode.ID, userLedger(userId, "usdt", amount, biz.LedgerAccountPool)...)
+		if err != nil {
+			return 0, err
+		}
+	}
+
 	var reward Reward
 	reward.UserId = userBalance.UserId
+	reward.Status = status
 	reward.Amount = amount
 	reward.BalanceRecordId = userBalanceRecode.ID
 	reward.Type = "location" // 本次分红的行为类型
@@ -2164,23 +2293,16 @@ func (ub *UserBalanceRepo) NormalRecommendTopReward(ctx context.Context, userId
 
 // NormalRecommendReward .
 func (ub *UserBalanceRepo) NormalRecommendReward(ctx context.Context, userId int64, amount int64, locationId int64, status string) (int64, error) {
-	var err error
-	if "running" == status {
-		if err = ub.data.DB(ctx).Table("user_balance").
-			Where("user_id=?", userId).
-			Updates(map[string]interface{}{"balance_usdt": gorm.Expr("balance_usdt + ?", amount)}).Error; nil != err {
-			return 0, errors.NotFound("user balance err", "user balance not found")
-		}
-	}
-
-	var userBalance UserBalance
-	err = ub.data.DB(ctx).Where(&UserBalance{UserId: userId}).Table("user_balance").First(&userBalance).Error
+	userBalance, err := lockUserBalance(ctx, ub.data, userId)
 	if err != nil {
 		return 0, err
 	}
 
 	var userBalanceRecode UserBalanceRecord
 	userBalanceRecode.Balance = userBalance.BalanceUsdt
+	if "running" == status { // 余额由下面记账时加上
+		userBalanceRecode.Balance += amount
+	}
 	userBalanceRecode.UserId = userBalance.UserId
 	userBalanceRecode.Type = "reward"
 	userBalanceRecode.Amount = amount
@@ -2189,8 +2311,16 @@ func (ub *UserBalanceRepo) NormalRecommendReward(ctx context.Context, userId int
 		return 0, err
 	}
 
+	if "running" == status {
+		err = postLedger(ctx, ub.data, "recommend_reward", userBalanceRecode.ID, userLedger(userId, "usdt", amount, biz.LedgerAccountPool)...)
+		if err != nil {
+			return 0, err
+		}
+	}
+
 	var reward Reward
 	reward.UserId = userBalance.UserId
+	reward.Status = status
 	reward.Amount = amount
 	reward.BalanceRecordId = userBalanceRecode.ID
 	reward.Type = "location" // 本次分红的行为类型
@@ -2206,23 +2336,16 @@ func (ub *UserBalanceRepo) NormalRecommendReward(ctx context.Context, userId int
 
 // NormalWithdrawRecommendReward .
 func (ub *UserBalanceRepo) NormalWithdrawRecommendReward(ctx context.Context, userId int64, amount int64, locationId int64, status string) (int64, error) {
-	var err error
-	if "running" == status {
-		if err = ub.data.DB(ctx).Table("user_balance").
-			Where("user_id=?", userId).
-			Updates(map[string]interface{}{"balance_usdt": gorm.Expr("balance_usdt + ?", amount)}).Error; nil != err {
-			return 0, errors.NotFound("user balance err", "user balance not found")
-		}
-	}
-
-	var userBalance UserBalance
-	err = ub.data.DB(ctx).Where(&UserBalance{UserId: userId}).Table("user_balance").First(&userBalance).Error
+	userBalance, err := lockUserBalance(ctx, ub.data, userId)
 	if err != nil {
 		return 0, err
 	}
 
 	var userBalanceRecode UserBalanceRecord
 	userBalanceRecode.Balance = userBalance.BalanceUsdt
+	if "running" == status { // 余额由下面记账时加上
+		userBalanceRecode.Balance += amount
+	}
 	userBalanceRecode.UserId = userBalance.UserId
 	userBalanceRecode.Type = "reward"
 	userBalanceRecode.Amount = amount
@@ -2231,8 +2354,16 @@ func (ub *UserBalanceRepo) NormalWithdrawRecommendReward(ctx context.Context, us
 		return 0, err
 	}
 
+	if "running" == status {
+		err = postLedger(ctx, ub.data, "recommend_withdraw_reward", userBalanceRecode.ID, userLedger(userId, "usdt", amount, biz.LedgerAccountPool)...)
+		if err != nil {
+			return 0, err
+		}
+	}
+
 	var reward Reward
 	reward.UserId = userBalance.UserId
+	reward.Status = status
 	reward.Amount = amount
 	reward.BalanceRecordId = userBalanceRecode.ID
 	reward.Type = "withdraw" // 本次分红的行为类型
@@ -2248,23 +2379,16 @@ func (ub *UserBalanceRepo) NormalWithdrawRecommendReward(ctx context.Context, us
 
 // NormalWithdrawRecommendTopReward .
 func (ub *UserBalanceRepo) NormalWithdrawRecommendTopReward(ctx context.Context, userId int64, amount int64, locationId int64, reasonId int64, status string) (int64, error) {
-	var err error
-	if "running" == status {
-		if err = ub.data.DB(ctx).Table("user_balance").
-			Where("user_id=?", userId).
-			Updates(map[string]interface{}{"balance_usdt": gorm.Expr("balance_usdt + ?", amount)}).Error; nil != err {
-			return 0, errors.NotFound("user balance err", "user balance not found")
-		}
-	}
-
-	var userBalance UserBalance
-	err = ub.data.DB(ctx).Where(&UserBalance{UserId: userId}).Table("user_balance").First(&userBalance).Error
+	userBalance, err := lockUserBalance(ctx, ub.data, userId)
 	if err != nil {
 		return 0, err
 	}
 
 	var userBalanceRecode UserBalanceRecord
 	userBalanceRecode.Balance = userBalance.BalanceUsdt
+	if "running" == status { // 余额由下面记账时加上
+		userBalanceRecode.Balance += amount
+	}
 	userBalanceRecode.UserId = userBalance.UserId
 	userBalanceRecode.Type = "reward"
 	userBalanceRecode.Amount = amount
@@ -2273,8 +2397,16 @@ func (ub *UserBalanceRepo) NormalWithdrawRecommendTopReward(ctx context.Context,
 		return 0, err
 	}
 
+	if "running" == status {
+		err = postLedger(ctx, ub.data, "recommend_withdraw_top_reward", userBalanceRecode.ID, userLedger(userId, "usdt", amount, biz.LedgerAccountPool)...)
+		if err != nil {
+			return 0, err
+		}
+	}
+
 	var reward Reward
 	reward.UserId = userBalance.UserId
+	reward.Status = status
 	reward.Amount = amount
 	reward.BalanceRecordId = userBalanceRecode.ID
 	reward.Type = "withdraw" // 本次分红的行为类型
@@ -2478,7 +2610,7 @@ func (ub *UserBalanceRepo) GetUserRewardRecommendSort(ctx context.Context) ([]*b
 	var total []*UserSortRecommendReward
 	res := make([]*biz.UserSortRecommendReward, 0)
 
-	now := time.Now().UTC().AddDate(0, 0, -1)
+	now := biz.Now(ctx).AddDate(0, 0, -1)
 	var startDate time.Time
 	var endDate time.Time
 	if 14 <= now.Hour() {
@@ -2526,7 +2658,7 @@ func (ub *UserBalanceRepo) GetUserRewardsLastMonthFee(ctx context.Context) ([]*b
 
 	instance := ub.data.db.Table("reward")
 
-	now := time.Now().UTC().Add(8 * time.Hour)
+	now := biz.Now(ctx).Add(8 * time.Hour)
 	lastMonthFirstDay := now.AddDate(0, -1, -now.Day()+1)
 	lastMonthStart := time.Date(lastMonthFirstDay.Year(), lastMonthFirstDay.Month(), lastMonthFirstDay.Day(), 0, 0, 0, 0, time.UTC)
 	lastMonthEndDay := lastMonthFirstDay.AddDate(0, 1, -1)
@@ -2922,6 +3054,8 @@ func (ui *UserInfoRepo) GetUserInfoByUserIds(ctx context.Context, userIds ...int
 			UserId:           userInfo.UserId,
 			Vip:              userInfo.Vip,
 			HistoryRecommend: userInfo.HistoryRecommend,
+			VipCompute:       userInfo.VipCompute,
+			VipAdmin:         userInfo.VipAdmin,
 		}
 	}
 
@@ -2955,7 +3089,7 @@ func (uc *UserCurrentMonthRecommendRepo) GetUserLastMonthRecommend(ctx context.C
 	var userCurrentMonthRecommends []*UserCurrentMonthRecommend
 	res := make([]int64, 0)
 
-	now := time.Now().UTC().Add(8 * time.Hour)
+	now := biz.Now(ctx).Add(8 * time.Hour)
 	lastMonthFirstDay := now.AddDate(0, -1, -now.Day()+1)
 	lastMonthStart := time.Date(lastMonthFirstDay.Year(), lastMonthFirstDay.Month(), lastMonthFirstDay.Day(), 0, 0, 0, 0, time.UTC)
 	lastMonthEndDay := lastMonthFirstDay.AddDate(0, 1, -1)
@@ -0,0 +1,366 @@
+package data
+
+import (
+	"context"
+	"dhb/app/app/internal/biz"
+	"github.com/go-kratos/kratos/v2/errors"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	"time"
+)
+
+type WithdrawReview struct {
+	ID         int64     `gorm:"primarykey;type:int"`
+	WithdrawId int64     `gorm:"type:int;not null;uniqueIndex"`
+	UserId     int64     `gorm:"type:int;not null"`
+	Rule       string    `gorm:"type:varchar(200);not null"`
+	Detail     string    `gorm:"type:varchar(500);not null"`
+	Status     string    `gorm:"type:varchar(45);not null"`
+	AdminId    int64     `gorm:"type:int;not null"`
+	Reason     string    `gorm:"type:varchar(500);not null"`
+	CreatedAt  time.Time `gorm:"type:datetime;not null"`
+	UpdatedAt  time.Time `gorm:"type:datetime;not null"`
+}
+
+// WithdrawDailyLock 每个币种一行，只用来给平台每日提现总额检查加锁
+type WithdrawDailyLock struct {
+	CoinType  string    `gorm:"primarykey;type:varchar(45)"`
+	CreatedAt time.Time `gorm:"type:datetime;not null"`
+	UpdatedAt time.Time `gorm:"type:datetime;not null"`
+}
+
+type WithdrawEvent struct {
+	ID         int64     `gorm:"primarykey;type:int"`
+	WithdrawId int64     `gorm:"type:int;not null;index"`
+	FromStatus string    `gorm:"type:varchar(45);not null"`
+	ToStatus   string    `gorm:"type:varchar(45);not null"`
+	Operator   string    `gorm:"type:varchar(45);not null"`
+	OperatorId int64     `gorm:"type:int;not null"`
+	Remark     string    `gorm:"type:varchar(500);not null"`
+	CreatedAt  time.Time `gorm:"type:datetime;not null"`
+	UpdatedAt  time.Time `gorm:"type:datetime;not null"`
+}
+
+// LockUserBalance 锁用户余额行，需在事务内调用
+func (ub *UserBalanceRepo) LockUserBalance(ctx context.Context, userId int64) error {
+	_, err := lockUserBalance(ctx, ub.data, userId)
+	return err
+}
+
+// LockWithdrawDaily 锁币种计数行，没有时先插入，需在事务内调用
+func (ub *UserBalanceRepo) LockWithdrawDaily(ctx context.Context, coinType string) error {
+	var lock WithdrawDailyLock
+	if err := ub.data.DB(ctx).Table("withdraw_daily_lock").
+		Clauses(clause.OnConflict{DoNothing: true}).
+		Create(&WithdrawDailyLock{CoinType: coinType}).Error; nil != err {
+		return errors.New(500, "WITHDRAW DAILY LOCK ERROR", err.Error())
+	}
+
+	if err := ub.data.DB(ctx).Table("withdraw_daily_lock").
+		Clauses(clause.Locking{Strength: "UPDATE"}).
+		Where("coin_type=?", coinType).
+		Take(&lock).Error; nil != err {
+		return errors.New(500, "WITHDRAW DAILY LOCK ERROR", err.Error())
+	}
+
+	return nil
+}
+
+// UpdateWithdrawStatus 按原状态条件更新，返回是否更新成功，reason 非空时一起写入
+func (ub *UserBalanceRepo) UpdateWithdrawStatus(ctx context.Context, id int64, fromStatus string, toStatus string, reason string) (bool, error) {
+	values := map[string]interface{}{"status": toStatus, "updated_at": time.Now()}
+	if "" != reason {
+		values["reason"] = reason
+	}
+
+	res := ub.data.DB(ctx).Table("withdraw").
+		Where("id=? and status=?", id, fromStatus).
+		Updates(values)
+	if res.Error != nil {
+		return false, errors.New(500, "UPDATE_WITHDRAW_ERROR", "提现记录修改失败")
+	}
+
+	return 0 < res.RowsAffected, nil
+}
+
+// UpdateWithdrawRelAmount 按状态条件更新实际打款金额
+func (ub *UserBalanceRepo) UpdateWithdrawRelAmount(ctx context.Context, id int64, status string, amount int64) (bool, error) {
+	res := ub.data.DB(ctx).Table("withdraw").
+		Where("id=? and status=?", id, status).
+		Updates(map[string]interface{}{"rel_amount": amount, "updated_at": time.Now()})
+	if res.Error != nil {
+		return false, errors.New(500, "UPDATE_WITHDRAW_ERROR", "提现记录修改失败")
+	}
+
+	return 0 < res.RowsAffected, nil
+}
+
+// CreateWithdrawEvent .
+func (ub *UserBalanceRepo) CreateWithdrawEvent(ctx context.Context, e *biz.WithdrawEvent) (*biz.WithdrawEvent, error) {
+	var withdrawEvent WithdrawEvent
+	withdrawEvent.WithdrawId = e.WithdrawId
+	withdrawEvent.FromStatus = e.FromStatus
+	withdrawEvent.ToStatus = e.ToStatus
+	withdrawEvent.Operator = e.Operator
+	withdrawEvent.OperatorId = e.OperatorId
+	withdrawEvent.Remark = e.Remark
+
+	res := ub.data.DB(ctx).Table("withdraw_event").Create(&withdrawEvent)
+	if res.Error != nil {
+		return nil, errors.New(500, "CREATE_WITHDRAW_EVENT_ERROR", "提现事件创建失败")
+	}
+
+	return &biz.WithdrawEvent{
+		ID:         withdrawEvent.ID,
+		WithdrawId: withdrawEvent.WithdrawId,
+		FromStatus: withdrawEvent.FromStatus,
+		ToStatus:   withdrawEvent.ToStatus,
+		Operator:   withdrawEvent.Operator,
+		OperatorId: withdrawEvent.OperatorId,
+		Remark:     withdrawEvent.Remark,
+		CreatedAt:  withdrawEvent.CreatedAt,
+	}, nil
+}
+
+// GetWithdrawEvents .
+func (ub *UserBalanceRepo) GetWithdrawEvents(ctx context.Context, withdrawId int64) ([]*biz.WithdrawEvent, error) {
+	var withdrawEvents []*WithdrawEvent
+	res := make([]*biz.WithdrawEvent, 0)
+	if err := ub.data.db.Table("withdraw_event").Where("withdraw_id=?", withdrawId).Order("id asc").Find(&withdrawEvents).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return res, errors.NotFound("WITHDRAW_EVENT_NOT_FOUND", "withdraw event not found")
+		}
+
+		return nil, errors.New(500, "WITHDRAW EVENT ERROR", err.Error())
+	}
+
+	for _, v := range withdrawEvents {
+		res = append(res, &biz.WithdrawEvent{
+			ID:         v.ID,
+			WithdrawId: v.WithdrawId,
+			FromStatus: v.FromStatus,
+			ToStatus:   v.ToStatus,
+			Operator:   v.Operator,
+			OperatorId: v.OperatorId,
+			Remark:     v.Remark,
+			CreatedAt:  v.CreatedAt,
+		})
+	}
+
+	return res, nil
+}
+
+// CreateWithdrawReview .
+func (ub *UserBalanceRepo) CreateWithdrawReview(ctx context.Context, r *biz.WithdrawReview) (*biz.WithdrawReview, error) {
+	var withdrawReview WithdrawReview
+	withdrawReview.WithdrawId = r.WithdrawId
+	withdrawReview.UserId = r.UserId
+	withdrawReview.Rule = r.Rule
+	withdrawReview.Detail = r.Detail
+	withdrawReview.Status = r.Status
+
+	res := ub.data.DB(ctx).Table("withdraw_review").Create(&withdrawReview)
+	if res.Error != nil {
+		return nil, errors.New(500, "CREATE_WITHDRAW_REVIEW_ERROR", "提现审核记录创建失败")
+	}
+
+	return &biz.WithdrawReview{
+		ID:         withdrawReview.ID,
+		WithdrawId: withdrawReview.WithdrawId,
+		UserId:     withdrawReview.UserId,
+		Rule:       withdrawReview.Rule,
+		Detail:     withdrawReview.Detail,
+		Status:     withdrawReview.Status,
+		CreatedAt:  withdrawReview.CreatedAt,
+		UpdatedAt:  withdrawReview.UpdatedAt,
+	}, nil
+}
+
+// UpdateWithdrawReview .
+func (ub *UserBalanceRepo) UpdateWithdrawReview(ctx context.Context, withdrawId int64, status string, adminId int64, reason string) error {
+	res := ub.data.DB(ctx).Table("withdraw_review").
+		Where("withdraw_id=? and status=?", withdrawId, "review").
+		Updates(map[string]interface{}{"status": status, "admin_id": adminId, "reason": reason, "updated_at": time.Now()})
+	if res.Error != nil {
+		return errors.New(500, "UPDATE_WITHDRAW_REVIEW_ERROR", "提现审核记录修改失败")
+	}
+
+	return nil
+}
+
+// GetWithdrawReviews .
+func (ub *UserBalanceRepo) GetWithdrawReviews(ctx context.Context, b *biz.Pagination, status string) ([]*biz.WithdrawReview, error, int64) {
+	var (
+		withdrawReviews []*WithdrawReview
+		count           int64
+	)
+	res := make([]*biz.WithdrawReview, 0)
+
+	instance := ub.data.db.Table("withdraw_review")
+
+	if "" != status {
+		instance = instance.Where("status=?", status)
+	}
+
+	instance = instance.Count(&count)
+	if err := instance.Scopes(Paginate(b.PageNum, b.PageSize)).Order("id desc").Find(&withdrawReviews).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return res, errors.NotFound("WITHDRAW_REVIEW_NOT_FOUND", "withdraw review not found"), 0
+		}
+
+		return nil, errors.New(500, "WITHDRAW REVIEW ERROR", err.Error()), 0
+	}
+
+	for _, v := range withdrawReviews {
+		res = append(res, &biz.WithdrawReview{
+			ID:         v.ID,
+			WithdrawId: v.WithdrawId,
+			UserId:     v.UserId,
+			Rule:       v.Rule,
+			Detail:     v.Detail,
+			Status:     v.Status,
+			AdminId:    v.AdminId,
+			Reason:     v.Reason,
+			CreatedAt:  v.CreatedAt,
+			UpdatedAt:  v.UpdatedAt,
+		})
+	}
+
+	return res, nil, count
+}
+
+// WithdrawRefund 提现退回，usdt、dhb 记账加回余额，bnb 和 AdjustBalance 一样直接改 bnb_amount 且不入账本
+func (ub *UserBalanceRepo) WithdrawRefund(ctx context.Context, userId int64, amount int64, coinType string) (int64, error) {
+	if "usdt" != coinType && "dhb" != coinType && "bnb" != coinType {
+		return 0, errors.New(500, "COIN_TYPE_ERROR", "不支持的币种")
+	}
+
+	userBalance, err := lockUserBalance(ctx, ub.data, userId)
+	if err != nil {
+		return 0, err
+	}
+
+	var userBalanceRecode UserBalanceRecord
+	userBalanceRecode.Balance = userBalance.BalanceUsdt + amount
+	if "dhb" == coinType {
+		userBalanceRecode.Balance = userBalance.BalanceDhb + amount
+	} else if "bnb" == coinType {
+		if err = ub.data.DB(ctx).Table("user_balance").
+			Where("user_id=?", userId).
+			Updates(map[string]interface{}{"bnb_amount": gorm.Expr("bnb_amount + ?", float64(amount)/float64(10000000000))}).Error; nil != err {
+			return 0, errors.New(500, "UPDATE_USER_BALANCE_ERROR", "用户余额修改失败")
+		}
+		userBalanceRecode.Balance = int64(userBalance.BnbAmount*10000000000) + amount
+	}
+	userBalanceRecode.UserId = userBalance.UserId
+	userBalanceRecode.Type = "withdraw_refund"
+	userBalanceRecode.CoinType = coinType
+	userBalanceRecode.Amount = amount
+	err = ub.data.DB(ctx).Table("user_balance_record").Create(&userBalanceRecode).Error
+	if err != nil {
+		return 0, err
+	}
+
+	if "bnb" == coinType { // bnb 提现扣款不入账本，退回同样不入
+		return userBalanceRecode.ID, nil
+	}
+
+	err = postLedger(ctx, ub.data, "withdraw_refund", userBalanceRecode.ID, userLedger(userId, coinType, amount, biz.LedgerAccountPayout)...)
+	if err != nil {
+		return 0, err
+	}
+
+	return userBalanceRecode.ID, nil
+}
+
+// WithdrawRewardedReverse 已分红的 usdt 提现驳回，记一条负的系统手续费分红冲掉原分红，
+// 手续费和回池部分从手续费、奖金池转回在途，再由 WithdrawRefund 退给用户
+func (ub *UserBalanceRepo) WithdrawRewardedReverse(ctx context.Context, withdrawId int64, fee int64, pool int64) error {
+	var (
+		reward Reward
+		err    error
+	)
+	reward.UserId = 999999999
+	reward.Amount = -fee
+	reward.BalanceRecordId = 999999999
+	reward.Type = "withdraw_reject" // 本次分红的行为类型
+	reward.TypeRecordId = withdrawId
+	reward.Reason = "system_fee" // 给我分红的理由
+	err = ub.data.DB(ctx).Table("reward").Create(&reward).Error
+	if err != nil {
+		return err
+	}
+
+	legs := append(systemLedger("usdt", fee, biz.LedgerAccountFee, biz.LedgerAccountPayout), systemLedger("usdt", pool, biz.LedgerAccountPool, biz.LedgerAccountPayout)...)
+	return postLedger(ctx, ub.data, "withdraw_reject_reverse", withdrawId, legs...)
+}
+
+// GetUserDepositUsdtTotal .
+func (ub *UserBalanceRepo) GetUserDepositUsdtTotal(ctx context.Context, userId int64) (int64, error) {
+	var total UserBalanceTotal
+	if err := ub.data.db.Table("user_balance_record").
+		Where("user_id=?", userId).
+		Where("type=?", "deposit").
+		Where("coin_type=?", "usdt").
+		Select("coalesce(sum(amount), 0) as total").Take(&total).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return 0, nil
+		}
+
+		return 0, errors.New(500, "USER BALANCE RECORD ERROR", err.Error())
+	}
+
+	return total.Total, nil
+}
+
+// AdjustBalance 后台调账，amount 有正负，扣减不足时失败，usdt、dhb 记账改余额，bnb 直接改 bnb_amount 不入账本
+func (ub *UserBalanceRepo) AdjustBalance(ctx context.Context, userId int64, coinType string, amount int64, reason string, remark string) (int64, error) {
+	if "usdt" != coinType && "dhb" != coinType && "bnb" != coinType {
+		return 0, errors.New(500, "COIN_TYPE_ERROR", "不支持的币种")
+	}
+
+	userBalance, err := lockUserBalance(ctx, ub.data, userId)
+	if err != nil {
+		return 0, err
+	}
+
+	var userBalanceRecode UserBalanceRecord
+	userBalanceRecode.Balance = userBalance.BalanceUsdt + amount
+	if "dhb" == coinType {
+		userBalanceRecode.Balance = userBalance.BalanceDhb + amount
+	} else if "bnb" == coinType {
+		bnbAmount := float64(amount) / float64(10000000000)
+		res := ub.data.DB(ctx).Table("user_balance").
+			Where("user_id=? and bnb_amount+?>=0", userId, bnbAmount).
+			Updates(map[string]interface{}{"bnb_amount": gorm.Expr("bnb_amount + ?", bnbAmount)})
+		if nil != res.Error {
+			return 0, errors.New(500, "UPDATE_USER_BALANCE_ERROR", "用户余额修改失败")
+		}
+		if 0 == res.RowsAffected {
+			return 0, errors.New(500, "BALANCE_NOT_ENOUGH", "余额不足")
+		}
+		userBalanceRecode.Balance = int64(userBalance.BnbAmount*10000000000) + amount
+	}
+	if "bnb" != coinType && 0 > userBalanceRecode.Balance {
+		return 0, errors.New(500, "BALANCE_NOT_ENOUGH", "余额不足")
+	}
+	userBalanceRecode.UserId = userBalance.UserId
+	userBalanceRecode.Type = "adjust"
+	userBalanceRecode.CoinType = coinType
+	userBalanceRecode.Amount = amount
+	userBalanceRecode.Reason = reason
+	userBalanceRecode.Remark = remark
+	err = ub.data.DB(ctx).Table("user_balance_record").Create(&userBalanceRecode).Error
+	if err != nil {
+		return 0, err
+	}
+
+	if "bnb" != coinType {
+		err = postLedger(ctx, ub.data, "adjust", userBalanceRecode.ID, userLedger(userId, coinType, amount, biz.LedgerAccountAdjust)...)
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	return userBalanceRecode.ID, nil
+}
@@ -0,0 +1,108 @@
+package clientip
+
+import (
+	"context"
+	"github.com/go-kratos/kratos/v2/middleware"
+	"github.com/go-kratos/kratos/v2/transport"
+	"github.com/go-kratos/kratos/v2/transport/http"
+	"net"
+	"strings"
+)
+
+type clientIpKey struct{}
+
+// NewContext .
+func NewContext(ctx context.Context, ip string) context.Context {
+	return context.WithValue(ctx, clientIpKey{}, ip)
+}
+
+// FromContext 取中间件解析的客户端 ip，没有时为空
+func FromContext(ctx context.Context) string {
+	ip, _ := ctx.Value(clientIpKey{}).(string)
+	return ip
+}
+
+// parseTrusted 可信代理支持单个 ip 或网段，格式错误的忽略
+func parseTrusted(trusted []string) []*net.IPNet {
+	res := make([]*net.IPNet, 0, len(trusted))
+	for _, v := range trusted {
+		v = strings.TrimSpace(v)
+		if !strings.Contains(v, "/") {
+			if ip := net.ParseIP(v); nil != ip {
+				if nil != ip.To4() {
+					v += "/32"
+				} else {
+					v += "/128"
+				}
+			}
+		}
+		if _, ipNet, err := net.ParseCIDR(v); nil == err {
+			res = append(res, ipNet)
+		}
+	}
+
+	return res
+}
+
+func isTrusted(nets []*net.IPNet, ip string) bool {
+	parsed := net.ParseIP(ip)
+	if nil == parsed {
+		return false
+	}
+	for _, v := range nets {
+		if v.Contains(parsed) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Resolve 连接地址是可信代理时，从 X-Forwarded-For 右往左跳过可信代理，第一个不可信的是客户端；
+// 没有 X-Forwarded-For 时取 X-Real-IP，否则一律用连接地址，客户端自己带的头不采信
+func Resolve(remoteAddr string, forwardedFor string, realIp string, nets []*net.IPNet) string {
+	ip, _, err := net.SplitHostPort(remoteAddr)
+	if nil != err {
+		ip = remoteAddr
+	}
+	if !isTrusted(nets, ip) {
+		return ip
+	}
+
+	if "" != forwardedFor {
+		hops := strings.Split(forwardedFor, ",")
+		for i := len(hops) - 1; i >= 0; i-- {
+			hop := strings.TrimSpace(hops[i])
+			if "" == hop {
+				continue
+			}
+			ip = hop
+			if !isTrusted(nets, hop) {
+				return hop
+			}
+		}
+		return ip
+	}
+	if realIp = strings.TrimSpace(realIp); "" != realIp {
+		return realIp
+	}
+
+	return ip
+}
+
+// Server 解析客户端 ip 写入 context，trusted 为可信代理 ip 或网段
+func Server(trusted []string) middleware.Middleware {
+	nets := parseTrusted(trusted)
+	return func(handler middleware.Handler) middleware.Handler {
+		return func(ctx context.Context, req interface{}) (interface{}, error) {
+			if tr, ok := transport.FromServerContext(ctx); ok {
+				if ht, ok := tr.(*http.Transport); ok && nil != ht.Request() {
+					ip := Resolve(ht.Request().RemoteAddr, tr.RequestHeader().Get("X-Forwarded-For"), tr.RequestHeader().Get("X-Real-IP"), nets)
+					ctx = NewContext(ctx, ip)
+				}
+			}
+
+			return handler(ctx, req)
+		}
+	}
+}
@@ -0,0 +1,208 @@
+package idempotency
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/go-kratos/kratos/v2/middleware"
+	"github.com/go-kratos/kratos/v2/middleware/auth/jwt"
+	"github.com/go-kratos/kratos/v2/transport"
+	jwt2 "github.com/golang-jwt/jwt/v4"
+)
+
+// HeaderKey 未从请求体取到 key 时读取该请求头
+const HeaderKey = "Idempotency-Key"
+
+type Record struct {
+	UserId      int64
+	Operation   string
+	Key         string
+	Fingerprint string // 请求体哈希，同一 key 请求内容不同时拒绝
+	Status      string // doing 处理中，done 已完成
+	Reply       string
+}
+
+// Store 幂等记录存储，(user_id, key) 唯一
+type Store interface {
+	// Acquire 占用 key，已被占用时返回已有记录且 created 为 false；处理中的记录不会过期，
+	// 进程中断留下的 key 一直返回处理中，客户端需换 key
+	Acquire(ctx context.Context, userId int64, operation string, key string, fingerprint string) (record *Record, created bool, err error)
+	// Save 处理中改为完成并保存结果，ctx 带事务时和业务写入一起提交
+	Save(ctx context.Context, userId int64, key string, reply string) error
+	Release(ctx context.Context, userId int64, key string) error
+}
+
+// committer 当前请求的幂等 key，业务在事务内调用 Commit 保存结果
+type committer struct {
+	store  Store
+	userId int64
+	key    string
+	done   bool
+}
+
+type committerKey struct{}
+
+// Commit 在业务事务内保存幂等结果，结果和扣款、提现记录同时提交或回滚；
+// 未带幂等 key 的请求直接返回
+func Commit(ctx context.Context, reply interface{}) error {
+	c, ok := ctx.Value(committerKey{}).(*committer)
+	if !ok {
+		return nil
+	}
+
+	b, err := json.Marshal(reply)
+	if nil != err {
+		return errors.New(500, "IDEMPOTENCY_REPLY_ERROR", err.Error())
+	}
+	if err = c.store.Save(ctx, c.userId, c.key, string(b)); nil != err {
+		return err
+	}
+	c.done = true
+
+	return nil
+}
+
+type operation struct {
+	newReply func() interface{}
+	key      func(req interface{}) string
+}
+
+type Option func(*options)
+
+type options struct {
+	operations map[string]*operation
+	logger     log.Logger
+}
+
+// Operation 注册需要幂等的接口，newReply 用于还原首次结果，key 从请求中取幂等 key，可为 nil
+func Operation(name string, newReply func() interface{}, key func(req interface{}) string) Option {
+	return func(o *options) {
+		o.operations[name] = &operation{newReply: newReply, key: key}
+	}
+}
+
+// Logger .
+func Logger(logger log.Logger) Option {
+	return func(o *options) {
+		o.logger = logger
+	}
+}
+
+// fingerprint 请求体哈希
+func fingerprint(req interface{}) (string, error) {
+	b, err := json.Marshal(req)
+	if nil != err {
+		return "", err
+	}
+	sum := sha256.Sum256(b)
+
+	return hex.EncodeToString(sum[:]), nil
+}
+
+// Server 幂等中间件，需放在 jwt 中间件之后
+func Server(store Store, opts ...Option) middleware.Middleware {
+	o := &options{operations: make(map[string]*operation), logger: log.GetLogger()}
+	for _, opt := range opts {
+		opt(o)
+	}
+	l := log.NewHelper(o.logger)
+
+	return func(handler middleware.Handler) middleware.Handler {
+		return func(ctx context.Context, req interface{}) (interface{}, error) {
+			var (
+				tr      transport.Transporter
+				op      *operation
+				ok      bool
+				key     string
+				userId  int64
+				record  *Record
+				created bool
+				reply   interface{}
+				b       []byte
+				hash    string
+				err     error
+			)
+
+			if tr, ok = transport.FromServerContext(ctx); !ok {
+				return handler(ctx, req)
+			}
+			if op, ok = o.operations[tr.Operation()]; !ok {
+				return handler(ctx, req)
+			}
+
+			if nil != op.key {
+				key = op.key(req)
+			}
+			if "" == key {
+				key = tr.RequestHeader().Get(HeaderKey)
+			}
+			if "" == key { // 未带 key 按普通请求处理
+				return handler(ctx, req)
+			}
+			if 64 < len(key) {
+				return nil, errors.BadRequest("IDEMPOTENCY_KEY_ERROR", "幂等key过长")
+			}
+
+			if claims, ok := jwt.FromContext(ctx); ok {
+				c := claims.(jwt2.MapClaims)
+				if c["UserId"] == nil {
+					return nil, errors.New(500, "ERROR_TOKEN", "无效TOKEN")
+				}
+				userId = int64(c["UserId"].(float64))
+			}
+
+			hash, err = fingerprint(req)
+			if nil != err {
+				return nil, errors.New(500, "IDEMPOTENCY_REQUEST_ERROR", err.Error())
+			}
+
+			record, created, err = store.Acquire(ctx, userId, tr.Operation(), key, hash)
+			if nil != err {
+				return nil, err
+			}
+
+			if !created {
+				if tr.Operation() != record.Operation {
+					return nil, errors.Conflict("IDEMPOTENCY_KEY_CONFLICT", "幂等key已被其他接口使用")
+				}
+				if "" != record.Fingerprint && hash != record.Fingerprint {
+					return nil, errors.Conflict("IDEMPOTENCY_KEY_MISMATCH", "幂等key已用于不同的请求")
+				}
+				if "done" != record.Status {
+					return nil, errors.Conflict("IDEMPOTENCY_KEY_DOING", "请求处理中")
+				}
+
+				reply = op.newReply()
+				if err = json.Unmarshal([]byte(record.Reply), reply); nil != err {
+					return nil, errors.New(500, "IDEMPOTENCY_REPLY_ERROR", err.Error())
+				}
+				return reply, nil
+			}
+
+			c := &committer{store: store, userId: userId, key: key}
+			reply, err = handler(context.WithValue(ctx, committerKey{}, c), req)
+			if nil != err { // 失败释放 key，允许客户端重试；事务内已保存的记录是完成状态，不会被释放
+				if releaseErr := store.Release(ctx, userId, key); nil != releaseErr {
+					l.Errorf("idempotency release %d %s: %s", userId, key, releaseErr.Error())
+				}
+				return reply, err
+			}
+
+			if c.done {
+				return reply, nil
+			}
+
+			// 业务未在事务内保存时补存，保存失败时记录保持处理中，重复请求不会再次执行
+			if b, err = json.Marshal(reply); nil != err {
+				l.Errorf("idempotency marshal %d %s: %s", userId, key, err.Error())
+			} else if err = store.Save(ctx, userId, key, string(b)); nil != err {
+				l.Errorf("idempotency save %d %s: %s", userId, key, err.Error())
+			}
+
+			return reply, nil
+		}
+	}
+}
@@ -0,0 +1,172 @@
+package idempotency
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/transport"
+)
+
+type testHeader http.Header
+
+func (h testHeader) Get(key string) string        { return http.Header(h).Get(key) }
+func (h testHeader) Set(key string, value string) { http.Header(h).Set(key, value) }
+func (h testHeader) Keys() []string {
+	keys := make([]string, 0, len(h))
+	for k := range h {
+		keys = append(keys, k)
+	}
+	return keys
+}
+
+type testTransport struct {
+	operation string
+	header    testHeader
+}
+
+func (t *testTransport) Kind() transport.Kind            { return transport.KindHTTP }
+func (t *testTransport) Endpoint() string                { return "" }
+func (t *testTransport) Operation() string               { return t.operation }
+func (t *testTransport) RequestHeader() transport.Header { return t.header }
+func (t *testTransport) ReplyHeader() transport.Header   { return testHeader{} }
+
+// testStore 内存存储，saves 记录 Save 调用次数
+type testStore struct {
+	records map[string]*Record
+	saves   int
+}
+
+func (s *testStore) Acquire(ctx context.Context, userId int64, operation string, key string, fingerprint string) (*Record, bool, error) {
+	if r, ok := s.records[key]; ok {
+		return r, false, nil
+	}
+	s.records[key] = &Record{UserId: userId, Operation: operation, Key: key, Fingerprint: fingerprint, Status: "doing"}
+	return s.records[key], true, nil
+}
+
+func (s *testStore) Save(ctx context.Context, userId int64, key string, reply string) error {
+	r, ok := s.records[key]
+	if !ok || "doing" != r.Status {
+		return errors.New(500, "UPDATE_IDEMPOTENCY_RECORD_ERROR", "幂等记录不是处理中")
+	}
+	r.Status = "done"
+	r.Reply = reply
+	s.saves++
+	return nil
+}
+
+func (s *testStore) Release(ctx context.Context, userId int64, key string) error {
+	if r, ok := s.records[key]; ok && "doing" == r.Status {
+		delete(s.records, key)
+	}
+	return nil
+}
+
+type testReply struct {
+	Status string
+	Seq    int
+}
+
+const testOperation = "/api.App/Withdraw"
+
+func serve(store Store, key string, req interface{}, handler func(ctx context.Context, req interface{}) (interface{}, error)) (interface{}, error) {
+	h := testHeader{}
+	h.Set(HeaderKey, key)
+	ctx := transport.NewServerContext(context.Background(), &testTransport{operation: testOperation, header: h})
+	m := Server(store, Operation(testOperation, func() interface{} { return &testReply{} }, nil))
+
+	return m(handler)(ctx, req)
+}
+
+func TestServerReplaysCommittedReply(t *testing.T) {
+	store := &testStore{records: make(map[string]*Record)}
+	calls := 0
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		calls++
+		reply := &testReply{Status: "ok", Seq: calls}
+		// 业务事务内保存结果
+		if err := Commit(ctx, reply); nil != err {
+			return nil, err
+		}
+		return reply, nil
+	}
+
+	for i := 0; i < 3; i++ {
+		reply, err := serve(store, "k1", map[string]string{"amount": "10"}, handler)
+		if nil != err {
+			t.Fatalf("request %d: %v", i, err)
+		}
+		if 1 != reply.(*testReply).Seq {
+			t.Errorf("request %d reply seq = %d, want 1", i, reply.(*testReply).Seq)
+		}
+	}
+	if 1 != calls {
+		t.Errorf("handler calls = %d, want 1", calls)
+	}
+	if 1 != store.saves {
+		t.Errorf("saves = %d, want 1, middleware must not save again after Commit", store.saves)
+	}
+}
+
+func TestServerDoingNeverExpires(t *testing.T) {
+	store := &testStore{records: make(map[string]*Record)}
+	req := map[string]string{"amount": "10"}
+	fp, _ := fingerprint(req)
+	// 上次请求扣款前进程中断，记录一直是处理中
+	store.records["k1"] = &Record{Operation: testOperation, Key: "k1", Fingerprint: fp, Status: "doing"}
+
+	calls := 0
+	_, err := serve(store, "k1", req, func(ctx context.Context, req interface{}) (interface{}, error) {
+		calls++
+		return &testReply{Status: "ok"}, nil
+	})
+	if "IDEMPOTENCY_KEY_DOING" != errors.Reason(err) {
+		t.Errorf("err = %v, want IDEMPOTENCY_KEY_DOING", err)
+	}
+	if 0 != calls {
+		t.Errorf("handler calls = %d, want 0", calls)
+	}
+}
+
+func TestServerReleasesOnError(t *testing.T) {
+	store := &testStore{records: make(map[string]*Record)}
+	calls := 0
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		calls++
+		if 1 == calls {
+			return nil, errors.New(500, "WITHDRAW_BALANCE_NOT_ENOUGH", "余额不足")
+		}
+		return &testReply{Status: "ok", Seq: calls}, nil
+	}
+
+	if _, err := serve(store, "k1", map[string]string{"amount": "10"}, handler); nil == err {
+		t.Fatal("first request should fail")
+	}
+	reply, err := serve(store, "k1", map[string]string{"amount": "10"}, handler)
+	if nil != err {
+		t.Fatal(err)
+	}
+	if 2 != reply.(*testReply).Seq || 2 != calls {
+		t.Errorf("retry after failure: seq = %d, calls = %d, want 2, 2", reply.(*testReply).Seq, calls)
+	}
+	// 未在事务内保存的接口由中间件补存
+	if r := store.records["k1"]; "done" != r.Status {
+		t.Errorf("status = %s, want done", r.Status)
+	}
+}
+
+func TestServerRejectsDifferentRequest(t *testing.T) {
+	store := &testStore{records: make(map[string]*Record)}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return &testReply{Status: "ok"}, Commit(ctx, &testReply{Status: "ok"})
+	}
+
+	if _, err := serve(store, "k1", map[string]string{"amount": "10"}, handler); nil != err {
+		t.Fatal(err)
+	}
+	if _, err := serve(store, "k1", map[string]string{"amount": "20"}, handler); "IDEMPOTENCY_KEY_MISMATCH" != errors.Reason(err) {
+		t.Errorf("err = %v, want IDEMPOTENCY_KEY_MISMATCH", err)
+	}
+}
@@ -4,7 +4,10 @@ import (
 	"context"
 	v1 "dhb/app/app/api"
 	"dhb/app/app/internal/conf"
+	"dhb/app/app/internal/pkg/middleware/clientip"
+	"dhb/app/app/internal/pkg/middleware/idempotency"
 	"dhb/app/app/internal/service"
+	"github.com/go-kratos/kratos/v2/middleware"
 	"github.com/go-kratos/kratos/v2/middleware/auth/jwt"
 	"github.com/go-kratos/kratos/v2/middleware/recovery"
 	"github.com/go-kratos/kratos/v2/middleware/selector"
@@ -16,18 +19,20 @@ import (
 )
 
 // NewHTTPServer new an HTTP server.
-func NewHTTPServer(c *conf.Server, app *service.AppService, logger log.Logger) *http.Server {
+func NewHTTPServer(c *conf.Server, app *service.AppService, idem idempotency.Store, logger log.Logger) *http.Server {
 	var opts = []http.ServerOption{
 		http.Middleware(
 			recovery.Recovery(),
+			clientip.Server(c.Http.GetTrustedProxies()),
 			selector.Server( // jwt 验证
 				jwt.Server(func(token *jwt2.Token) (interface{}, error) {
 					return []byte("f33933ab18063c2e008115594b901f67"), nil
 				}, jwt.WithSigningMethod(jwt2.SigningMethodHS256)),
 			).Match(NewWhiteListMatcher()).Build(),
+			NewIdempotencyMiddleware(idem, logger),
 		),
 		http.Filter(handlers.CORS(
-			handlers.AllowedHeaders([]string{"X-Requested-With", "Content-Type", "Authorization"}),
+			handlers.AllowedHeaders([]string{"X-Requested-With", "Content-Type", "Authorization", idempotency.HeaderKey}),
 			handlers.AllowedMethods([]string{"GET", "POST", "PUT", "HEAD", "OPTIONS"}),
 			handlers.AllowedOrigins([]string{"*"}),
 		)),
@@ -81,3 +86,20 @@ func NewWhiteListMatcher() selector.MatchFunc {
 		return true
 	}
 }
+
+// NewIdempotencyMiddleware 设置需要幂等的接口
+func NewIdempotencyMiddleware(idem idempotency.Store, logger log.Logger) middleware.Middleware {
+	return idempotency.Server(idem,
+		idempotency.Logger(logger),
+		idempotency.Operation("/api.App/Withdraw", func() interface{} {
+			return &v1.WithdrawReply{}
+		}, func(req interface{}) string {
+			return req.(*v1.WithdrawRequest).GetSendBody().GetIdempotencyKey()
+		}),
+		idempotency.Operation("/api.App/Reinvest", func() interface{} {
+			return &v1.ReinvestReply{}
+		}, func(req interface{}) string {
+			return req.(*v1.ReinvestRequest).GetSendBody().GetIdempotencyKey()
+		}),
+	)
+}
@@ -115,10 +115,6 @@ func (a *AppService) Deposit(ctx context.Context, req *v1.DepositRequest) (*v1.D
 	//	}
 	//}
 
-	//if lock, _ = a.ruc.LockEthUserRecordHandle(ctx); !lock { // 上全局锁简单，防止资源更新抢占
-	//	return &v1.DepositReply{}, nil
-	//}
-
 	// 错开计划任务
 	now := time.Now()
 	if 14 == now.Hour() {
@@ -212,17 +208,7 @@ func (a *AppService) Deposit(ctx context.Context, req *v1.DepositRequest) (*v1.D
 			//	continue
 			//}
 
-			if "100000000000000000000" == vDepositUsdtResult.Value {
-
-			} else if "500000000000000000000" == vDepositUsdtResult.Value {
-
-			} else if "1000000000000000000000" == vDepositUsdtResult.Value {
-
-			} else if "3000000000000000000000" == vDepositUsdtResult.Value {
-
-			} else if "5000000000000000000000" == vDepositUsdtResult.Value {
-
-			} else {
+			if !biz.IsDepositAmount(vDepositUsdtResult.Value) { // 不是入单档位
 				continue
 			}
 
@@ -252,7 +238,6 @@ func (a *AppService) Deposit(ctx context.Context, req *v1.DepositRequest) (*v1.D
 		//time.Sleep(2 * time.Second)
 	}
 
-	//_, _ = a.ruc.UnLockEthUserRecordHandle(ctx)
 	return &v1.DepositReply{}, nil
 }
 
@@ -262,9 +247,29 @@ type eth struct {
 	TokenSymbol string
 	From        string
 	To          string
+	TimeStamp   string
+	BlockNumber string
+	LogIndex    string
 }
 
 func requestEthDepositResult(offset int64, page int64, contractAddress string) (map[string]*eth, error) {
+	result, err := requestEthTransfers(offset, page, contractAddress, "0x5e30db5983170028d09ed5d7cfb25aa6495334c8")
+	if nil != err {
+		return nil, err
+	}
+
+	res := make(map[string]*eth, 0)
+	for _, v := range result {
+		if "0x5e30db5983170028d09ed5d7cfb25aa6495334c8" == v.To { // 接收者
+			res[v.Hash] = v
+		}
+	}
+
+	return res, err
+}
+
+// requestEthTransfers 查询地址的代币转账记录，按时间倒序
+func requestEthTransfers(offset int64, page int64, contractAddress string, address string) ([]*eth, error) {
 	//apiUrl := "https://api-testnet.bscscan.com/api"
 	apiUrl := "https://api.bscscan.com/api"
 	// URL param
@@ -273,7 +278,7 @@ func requestEthDepositResult(offset int64, page int64, contractAddress string) (
 	data.Set("action", "tokentx")
 	data.Set("contractaddress", contractAddress)
 	data.Set("apikey", "CRCSHR2G3WXB1MET3BNA7ZQKQVSNXFYX18")
-	data.Set("address", "0x5e30db5983170028d09ed5d7cfb25aa6495334c8")
+	data.Set("address", address)
 	data.Set("sort", "desc")
 	data.Set("offset", strconv.FormatInt(offset, 10))
 	data.Set("page", strconv.FormatInt(page, 10))
@@ -313,14 +318,273 @@ func requestEthDepositResult(offset int64, page int64, contractAddress string) (
 		return nil, err
 	}
 
-	res := make(map[string]*eth, 0)
-	for _, v := range i.Result {
-		if "0x5e30db5983170028d09ed5d7cfb25aa6495334c8" == v.To { // 接收者
-			res[v.Hash] = v
+	return i.Result, err
+}
+
+// requestBscscan 请求 bscscan 接口，status 不为1时返回接口信息
+func requestBscscan(data url.Values, result interface{}) error {
+	u, err := url.ParseRequestURI("https://api.bscscan.com/api")
+	if err != nil {
+		return err
+	}
+	u.RawQuery = data.Encode() // URL encode
+	client := http.Client{
+		Timeout: 10 * time.Second,
+	}
+
+	resp, err := client.Get(u.String())
+	if err != nil {
+		return err
+	}
+
+	defer func(Body io.ReadCloser) {
+		err := Body.Close()
+		if err != nil {
+
 		}
+	}(resp.Body)
+	b, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return err
 	}
 
-	return res, err
+	var i struct {
+		Status  string          `json:"status"`
+		Message string          `json:"message"`
+		Result  json.RawMessage `json:"result"`
+	}
+	err = json.Unmarshal(b, &i)
+	if err != nil {
+		return err
+	}
+	if "1" != i.Status {
+		if "No transactions found" == i.Message { // 无记录
+			return json.Unmarshal([]byte("[]"), result)
+		}
+		return errors.New(500, "CHAIN_ERROR", i.Message)
+	}
+
+	return json.Unmarshal(i.Result, result)
+}
+
+// requestTokenBalance 地址的代币余额，链上18位精度转为10位
+func requestTokenBalance(apiKey string, contractAddress string, address string) (int64, error) {
+	data := url.Values{}
+	data.Set("module", "account")
+	data.Set("action", "tokenbalance")
+	data.Set("contractaddress", contractAddress)
+	data.Set("address", address)
+	data.Set("tag", "latest")
+	data.Set("apikey", apiKey)
+
+	var result string
+	if err := requestBscscan(data, &result); nil != err {
+		return 0, err
+	}
+
+	balance, ok := new(big.Int).SetString(result, 10)
+	if !ok {
+		return 0, errors.New(500, "CHAIN_ERROR", "链上余额格式错误")
+	}
+
+	return balance.Div(balance, big.NewInt(100000000)).Int64(), nil
+}
+
+// requestBlockByTime 时间对应的区块号，closest 为 before 或 after
+func requestBlockByTime(apiKey string, t time.Time, closest string) (int64, error) {
+	data := url.Values{}
+	data.Set("module", "block")
+	data.Set("action", "getblocknobytime")
+	data.Set("timestamp", strconv.FormatInt(t.Unix(), 10))
+	data.Set("closest", closest)
+	data.Set("apikey", apiKey)
+
+	var result string
+	if err := requestBscscan(data, &result); nil != err {
+		return 0, err
+	}
+
+	return strconv.ParseInt(result, 10, 64)
+}
+
+// chainTransferPageSize 按区块翻页，每次从上一页最后一个区块重新开始
+const chainTransferPageSize = 1000
+
+// requestChainTransfers 取 [start, end) 内的代币转账，按区块区间翻页，不受接口10000条上限限制，
+// 一页的转账都在同一区块时无法往后翻，返回错误
+func requestChainTransfers(apiKey string, contractAddress string, address string, start time.Time, end time.Time) ([]*biz.ChainTransfer, error) {
+	startBlock, err := requestBlockByTime(apiKey, start, "after")
+	if nil != err {
+		return nil, err
+	}
+	endBlock, err := requestBlockByTime(apiKey, end, "before")
+	if nil != err {
+		return nil, err
+	}
+
+	res := make([]*biz.ChainTransfer, 0)
+	seen := make(map[string]bool, 0)
+	for startBlock <= endBlock {
+		data := url.Values{}
+		data.Set("module", "account")
+		data.Set("action", "tokentx")
+		data.Set("contractaddress", contractAddress)
+		data.Set("address", address)
+		data.Set("startblock", strconv.FormatInt(startBlock, 10))
+		data.Set("endblock", strconv.FormatInt(endBlock, 10))
+		data.Set("sort", "asc")
+		data.Set("page", "1")
+		data.Set("offset", strconv.FormatInt(chainTransferPageSize, 10))
+		data.Set("apikey", apiKey)
+
+		var result []*eth
+		if err = requestBscscan(data, &result); nil != err {
+			return nil, err
+		}
+
+		var lastBlock int64
+		for _, v := range result {
+			lastBlock, _ = strconv.ParseInt(v.BlockNumber, 10, 64)
+
+			key := v.Hash + "_" + v.LogIndex
+			if seen[key] { // 上一页最后一个区块会重复取
+				continue
+			}
+			seen[key] = true
+
+			timeStamp, _ := strconv.ParseInt(v.TimeStamp, 10, 64)
+			transferTime := time.Unix(timeStamp, 0).UTC()
+			if transferTime.Before(start) || !transferTime.Before(end) {
+				continue
+			}
+
+			res = append(res, &biz.ChainTransfer{
+				Hash:  v.Hash,
+				From:  v.From,
+				To:    v.To,
+				Token: contractAddress,
+				Value: v.Value,
+				Time:  transferTime,
+			})
+		}
+
+		if chainTransferPageSize > len(result) {
+			break
+		}
+		if lastBlock <= startBlock {
+			return nil, errors.New(500, "CHAIN_ERROR", "单个区块转账超过分页上限")
+		}
+		startBlock = lastBlock
+
+		time.Sleep(200 * time.Millisecond) // 接口限频
+	}
+
+	return res, nil
+}
+
+// AdminChainReconcile 链上对账，日期为北京时间，包含结束日期当天
+func (a *AppService) AdminChainReconcile(ctx context.Context, req *v1.AdminChainReconcileRequest) (*v1.AdminChainReconcileReply, error) {
+	var (
+		depositTransfers []*biz.ChainTransfer
+		payoutTransfers  []*biz.ChainTransfer
+		transfers        []*biz.ChainTransfer
+		depositUsers     map[string]*biz.User
+		fromAccount      []string
+		chainConfig      *biz.ChainConfig
+		items            []*biz.ChainReconcileItem
+		payoutItems      []*biz.ChainReconcileItem
+		err              error
+	)
+
+	start, err := time.Parse("2006-01-02", req.Start)
+	if nil != err {
+		return nil, errors.New(500, "PARAM_ERROR", "开始日期格式错误")
+	}
+	end, err := time.Parse("2006-01-02", req.End)
+	if nil != err {
+		return nil, errors.New(500, "PARAM_ERROR", "结束日期格式错误")
+	}
+	start = start.Add(-8 * time.Hour)
+	end = end.Add(16 * time.Hour)
+	if !start.Before(end) || end.Sub(start) > 31*24*time.Hour {
+		return nil, errors.New(500, "PARAM_ERROR", "日期范围错误，最多31天")
+	}
+
+	chainConfig, err = a.uuc.GetChainConfig(ctx)
+	if nil != err {
+		return nil, err
+	}
+	tokens := chainConfig.Tokens
+
+	// 充值，只核对已注册用户的转入，链上数据前后各放宽一小时，避免入账延迟误报
+	transfers, err = requestChainTransfers(chainConfig.ApiKey, tokens["usdt"], chainConfig.DepositAddress, start.Add(-time.Hour), end.Add(time.Hour))
+	if nil != err {
+		return nil, errors.New(500, "CHAIN_ERROR", "链上数据查询失败："+err.Error())
+	}
+	for _, v := range transfers {
+		if chainConfig.DepositAddress == v.To {
+			fromAccount = append(fromAccount, v.From)
+		}
+	}
+	if 0 < len(fromAccount) {
+		depositUsers, err = a.uuc.GetUserByAddress(ctx, fromAccount...)
+		if nil != err {
+			return nil, err
+		}
+	}
+	for _, v := range transfers {
+		if chainConfig.DepositAddress != v.To {
+			continue
+		}
+		if _, ok := depositUsers[v.From]; !ok { // 用户不存在
+			continue
+		}
+
+		depositTransfers = append(depositTransfers, v)
+	}
+
+	items, err = a.ruc.ChainReconcileDeposits(ctx, start, end, depositTransfers)
+	if nil != err {
+		return nil, err
+	}
+
+	// 打款，bnb为原生币转账不核对
+	for _, token := range tokens {
+		transfers, err = requestChainTransfers(chainConfig.ApiKey, token, chainConfig.PayoutAddress, start.Add(-time.Hour), end.Add(time.Hour))
+		if nil != err {
+			return nil, errors.New(500, "CHAIN_ERROR", "链上数据查询失败："+err.Error())
+		}
+
+		for _, v := range transfers {
+			if chainConfig.PayoutAddress == v.From {
+				payoutTransfers = append(payoutTransfers, v)
+			}
+		}
+	}
+
+	payoutItems, err = a.uuc.ChainReconcilePayouts(ctx, start, end, tokens, payoutTransfers)
+	if nil != err {
+		return nil, err
+	}
+	items = append(items, payoutItems...)
+
+	res := &v1.AdminChainReconcileReply{
+		Items: make([]*v1.AdminChainReconcileReply_List, 0),
+		Count: int64(len(items)),
+	}
+	for _, v := range items {
+		res.Items = append(res.Items, &v1.AdminChainReconcileReply_List{
+			Kind:        v.Kind,
+			Issue:       v.Issue,
+			Hash:        v.Hash,
+			Address:     v.Address,
+			RecordId:    v.RecordId,
+			ChainAmount: v.ChainAmount,
+			DbAmount:    v.DbAmount,
+		})
+	}
+
+	return res, nil
 }
 
 // UserInfo userInfo.
@@ -422,6 +686,188 @@ func (a *AppService) Withdraw(ctx context.Context, req *v1.WithdrawRequest) (*v1
 	})
 }
 
+func (a *AppService) AdminWithdrawEventList(ctx context.Context, req *v1.AdminWithdrawEventListRequest) (*v1.AdminWithdrawEventListReply, error) {
+	return a.uuc.AdminWithdrawEventList(ctx, req)
+}
+
+func (a *AppService) AdminLedgerVerify(ctx context.Context, req *v1.AdminLedgerVerifyRequest) (*v1.AdminLedgerVerifyReply, error) {
+	return a.uuc.AdminLedgerVerify(ctx, req)
+}
+
+func (a *AppService) AdminLedgerOpen(ctx context.Context, req *v1.AdminLedgerOpenRequest) (*v1.AdminLedgerOpenReply, error) {
+	return a.uuc.AdminLedgerOpen(ctx, req)
+}
+
+func (a *AppService) AdminReconcile(ctx context.Context, req *v1.AdminReconcileRequest) (*v1.AdminReconcileReply, error) {
+	return a.uuc.AdminReconcile(ctx, req)
+}
+
+func (a *AppService) AdminDiscrepancyList(ctx context.Context, req *v1.AdminDiscrepancyListRequest) (*v1.AdminDiscrepancyListReply, error) {
+	return a.uuc.AdminDiscrepancyList(ctx, req)
+}
+
+func (a *AppService) AdminBalanceSnapshot(ctx context.Context, req *v1.AdminBalanceSnapshotRequest) (*v1.AdminBalanceSnapshotReply, error) {
+	return a.uuc.AdminBalanceSnapshot(ctx, req)
+}
+
+func (a *AppService) AdminUserBalanceHistory(ctx context.Context, req *v1.AdminUserBalanceHistoryRequest) (*v1.AdminUserBalanceHistoryReply, error) {
+	return a.uuc.AdminUserBalanceHistory(ctx, req)
+}
+
+func (a *AppService) AdminLiabilityList(ctx context.Context, req *v1.AdminLiabilityListRequest) (*v1.AdminLiabilityListReply, error) {
+	return a.uuc.AdminLiabilityList(ctx, req)
+}
+
+func (a *AppService) AdminUserStatement(ctx context.Context, req *v1.AdminUserStatementRequest) (*v1.AdminUserStatementReply, error) {
+	return a.uuc.AdminUserStatement(ctx, req)
+}
+
+// hotWalletBalances 热钱包（充值地址和打款地址）链上usdt和dhb余额，10位精度
+func (a *AppService) hotWalletBalances(ctx context.Context) (int64, int64, error) {
+	var (
+		walletUsdt  int64
+		walletDhb   int64
+		chainConfig *biz.ChainConfig
+		balance     int64
+		err         error
+	)
+
+	chainConfig, err = a.uuc.GetChainConfig(ctx)
+	if nil != err {
+		return 0, 0, err
+	}
+
+	for _, address := range []string{chainConfig.DepositAddress, chainConfig.PayoutAddress} {
+		balance, err = requestTokenBalance(chainConfig.ApiKey, chainConfig.Tokens["usdt"], address)
+		if nil != err {
+			return 0, 0, errors.New(500, "CHAIN_ERROR", "链上余额查询失败")
+		}
+		walletUsdt += balance
+
+		balance, err = requestTokenBalance(chainConfig.ApiKey, chainConfig.Tokens["dhb"], address)
+		if nil != err {
+			return 0, 0, errors.New(500, "CHAIN_ERROR", "链上余额查询失败")
+		}
+		walletDhb += balance
+	}
+
+	return walletUsdt, walletDhb, nil
+}
+
+func (a *AppService) AdminSolvency(ctx context.Context, req *v1.AdminSolvencyRequest) (*v1.AdminSolvencyReply, error) {
+	walletUsdt, walletDhb, err := a.hotWalletBalances(ctx)
+	if nil != err {
+		return nil, err
+	}
+
+	return a.uuc.AdminSolvency(ctx, walletUsdt, walletDhb)
+}
+
+func (a *AppService) AdminSolvencyCheck(ctx context.Context, req *v1.AdminSolvencyCheckRequest) (*v1.AdminSolvencyCheckReply, error) {
+	walletUsdt, walletDhb, err := a.hotWalletBalances(ctx)
+	if nil != err {
+		return nil, err
+	}
+
+	solvency, err := a.uuc.SolvencyCheck(ctx, walletUsdt, walletDhb)
+	if nil != err {
+		return nil, err
+	}
+
+	return &v1.AdminSolvencyCheckReply{Alarm: solvency.Alarm}, nil
+}
+
+func (a *AppService) AdminSolvencyList(ctx context.Context, req *v1.AdminSolvencyListRequest) (*v1.AdminSolvencyListReply, error) {
+	return a.uuc.AdminSolvencyList(ctx, req)
+}
+
+// AdminDepositSimulate 模拟入单，事务回滚不落库
+func (a *AppService) AdminDepositSimulate(ctx context.Context, req *v1.AdminDepositSimulateRequest) (*v1.AdminDepositSimulateReply, error) {
+	return a.ruc.AdminDepositSimulate(ctx, req)
+}
+
+// RewardDetail 分红来源，只能查自己的
+func (a *AppService) RewardDetail(ctx context.Context, req *v1.RewardDetailRequest) (*v1.RewardDetailReply, error) {
+	// 在上下文 context 中取出 claims 对象
+	var userId int64
+	if claims, ok := jwt.FromContext(ctx); ok {
+		c := claims.(jwt2.MapClaims)
+		if c["UserId"] == nil {
+			return nil, errors.New(500, "ERROR_TOKEN", "无效TOKEN")
+		}
+		userId = int64(c["UserId"].(float64))
+	}
+
+	return a.uuc.RewardDetail(ctx, req, &biz.User{
+		ID: userId,
+	})
+}
+
+// AdminRewardDetail 分红来源
+func (a *AppService) AdminRewardDetail(ctx context.Context, req *v1.AdminRewardDetailRequest) (*v1.AdminRewardDetailReply, error) {
+	return a.uuc.AdminRewardDetail(ctx, req)
+}
+
+// AdminVipRecalc 按真实推荐数据重算会员等级
+func (a *AppService) AdminVipRecalc(ctx context.Context, req *v1.AdminVipRecalcRequest) (*v1.AdminVipRecalcReply, error) {
+	return a.uuc.AdminVipRecalc(ctx, req)
+}
+
+// AdminLockList 当前锁的持有者、fencing token 和过期时间
+func (a *AppService) AdminLockList(ctx context.Context, req *v1.AdminLockListRequest) (*v1.AdminLockListReply, error) {
+	return a.uuc.AdminLockList(ctx, req)
+}
+
+// Reinvest 余额复投
+func (a *AppService) Reinvest(ctx context.Context, req *v1.ReinvestRequest) (*v1.ReinvestReply, error) {
+	// 在上下文 context 中取出 claims 对象
+	var userId int64
+	if claims, ok := jwt.FromContext(ctx); ok {
+		c := claims.(jwt2.MapClaims)
+		if c["UserId"] == nil {
+			return nil, errors.New(500, "ERROR_TOKEN", "无效TOKEN")
+		}
+		userId = int64(c["UserId"].(float64))
+	}
+
+	return a.ruc.Reinvest(ctx, req, &biz.User{
+		ID: userId,
+	})
+}
+
+// LocationProgress 占位进度和复投窗口
+func (a *AppService) LocationProgress(ctx context.Context, req *v1.LocationProgressRequest) (*v1.LocationProgressReply, error) {
+	// 在上下文 context 中取出 claims 对象
+	var userId int64
+	if claims, ok := jwt.FromContext(ctx); ok {
+		c := claims.(jwt2.MapClaims)
+		if c["UserId"] == nil {
+			return nil, errors.New(500, "ERROR_TOKEN", "无效TOKEN")
+		}
+		userId = int64(c["UserId"].(float64))
+	}
+
+	return a.uuc.LocationProgress(ctx, req, &biz.User{
+		ID: userId,
+	})
+}
+
+func (a *AppService) WithdrawCancel(ctx context.Context, req *v1.WithdrawCancelRequest) (*v1.WithdrawCancelReply, error) {
+	// 在上下文 context 中取出 claims 对象
+	var userId int64
+	if claims, ok := jwt.FromContext(ctx); ok {
+		c := claims.(jwt2.MapClaims)
+		if c["UserId"] == nil {
+			return nil, errors.New(500, "ERROR_TOKEN", "无效TOKEN")
+		}
+		userId = int64(c["UserId"].(float64))
+	}
+
+	return a.uuc.WithdrawCancel(ctx, req, &biz.User{
+		ID: userId,
+	})
+}
+
 func (a *AppService) AdminRewardList(ctx context.Context, req *v1.AdminRewardListRequest) (*v1.AdminRewardListReply, error) {
 	return a.uuc.AdminRewardList(ctx, req)
 }
@@ -450,6 +896,22 @@ func (a *AppService) AdminWithdraw(ctx context.Context, req *v1.AdminWithdrawReq
 	return a.uuc.AdminWithdraw(ctx, req)
 }
 
+func (a *AppService) AdminWithdrawReviewList(ctx context.Context, req *v1.AdminWithdrawReviewListRequest) (*v1.AdminWithdrawReviewListReply, error) {
+	return a.uuc.AdminWithdrawReviewList(ctx, req)
+}
+
+func (a *AppService) AdminWithdrawReviewApprove(ctx context.Context, req *v1.AdminWithdrawReviewApproveRequest) (*v1.AdminWithdrawReviewApproveReply, error) {
+	return a.uuc.AdminWithdrawReviewApprove(ctx, req)
+}
+
+func (a *AppService) AdminWithdrawReviewReject(ctx context.Context, req *v1.AdminWithdrawReviewRejectRequest) (*v1.AdminWithdrawReviewRejectReply, error) {
+	return a.uuc.AdminWithdrawReviewReject(ctx, req)
+}
+
+func (a *AppService) AdminWithdrawReject(ctx context.Context, req *v1.AdminWithdrawRejectRequest) (*v1.AdminWithdrawRejectReply, error) {
+	return a.uuc.AdminWithdrawReject(ctx, req)
+}
+
 func (a *AppService) AdminDailyWithdrawReward(ctx context.Context, req *v1.AdminDailyWithdrawRewardRequest) (*v1.AdminDailyWithdrawRewardReply, error) {
 	return a.uuc.AdminDailyWithdrawReward(ctx, req)
 }
@@ -539,7 +1001,16 @@ func (a *AppService) AdminAreaLevelUpdate(ctx context.Context, req *v1.AdminArea
 }
 
 func (a *AppService) AdminLocationInsert(ctx context.Context, req *v1.AdminLocationInsertRequest) (*v1.AdminLocationInsertReply, error) {
-	_, err := a.ruc.AdminLocationInsert(ctx, req.SendBody.UserId, req.SendBody.Amount)
+	// 需审批的先生成提案
+	proposalId, err := a.uuc.ProposeAdminLocationInsert(ctx, req)
+	if nil != err {
+		return &v1.AdminLocationInsertReply{}, err
+	}
+	if 0 < proposalId {
+		return &v1.AdminLocationInsertReply{ProposalId: proposalId}, nil
+	}
+
+	_, err = a.ruc.AdminLocationInsert(ctx, req.SendBody.UserId, req.SendBody.Amount)
 	if nil != err {
 		return &v1.AdminLocationInsertReply{}, err
 	}
@@ -547,9 +1018,54 @@ func (a *AppService) AdminLocationInsert(ctx context.Context, req *v1.AdminLocat
 }
 
 func (a *AppService) AdminBalanceUpdate(ctx context.Context, req *v1.AdminBalanceUpdateRequest) (*v1.AdminBalanceUpdateReply, error) {
+	// 需审批的先生成提案
+	proposalId, err := a.uuc.ProposeAdminBalanceUpdate(ctx, req)
+	if nil != err {
+		return &v1.AdminBalanceUpdateReply{}, err
+	}
+	if 0 < proposalId {
+		return &v1.AdminBalanceUpdateReply{ProposalId: proposalId}, nil
+	}
+
 	return a.uuc.AdminBalanceUpdate(ctx, req)
 }
 
+func (a *AppService) AdminProposalList(ctx context.Context, req *v1.AdminProposalListRequest) (*v1.AdminProposalListReply, error) {
+	return a.uuc.AdminProposalList(ctx, req)
+}
+
+func (a *AppService) AdminProposalApprove(ctx context.Context, req *v1.AdminProposalApproveRequest) (*v1.AdminProposalApproveReply, error) {
+	// 审批通过后由审批人执行原操作，和提案状态在同一事务
+	proposal, err := a.uuc.ApproveAdminProposal(ctx, req.SendBody.Id, func(ctx context.Context, proposal *biz.AdminProposal) error {
+		if "AdminBalanceUpdate" == proposal.Operation {
+			sendBody := &v1.AdminBalanceUpdateRequest_SendBody{}
+			if err := json.Unmarshal([]byte(proposal.Payload), sendBody); nil != err {
+				return err
+			}
+			_, err := a.uuc.AdminBalanceUpdate(ctx, &v1.AdminBalanceUpdateRequest{SendBody: sendBody})
+			return err
+		} else if "AdminLocationInsert" == proposal.Operation {
+			sendBody := &v1.AdminLocationInsertRequest_SendBody{}
+			if err := json.Unmarshal([]byte(proposal.Payload), sendBody); nil != err {
+				return err
+			}
+			_, err := a.ruc.AdminLocationInsert(ctx, sendBody.UserId, sendBody.Amount)
+			return err
+		}
+
+		return errors.New(500, "ERROR", "未知操作")
+	})
+	if nil != err {
+		return nil, err
+	}
+
+	return &v1.AdminProposalApproveReply{Status: proposal.Status, Result: proposal.Result}, nil
+}
+
+func (a *AppService) AdminProposalReject(ctx context.Context, req *v1.AdminProposalRejectRequest) (*v1.AdminProposalRejectReply, error) {
+	return a.uuc.AdminProposalReject(ctx, req)
+}
+
 func (a *AppService) FixReward(ctx context.Context, req *v1.FixRewardRequest) (*v1.FixRewardReply, error) {
 	return a.uuc.FixReward(ctx, req)
 }
@@ -558,6 +1074,10 @@ func (a *AppService) FixLocations(ctx context.Context, req *v1.FixLocationsReque
 	return a.uuc.FixLocations(ctx, req)
 }
 
+func (a *AppService) AdminAuditLogList(ctx context.Context, req *v1.AdminAuditLogListRequest) (*v1.AdminAuditLogListReply, error) {
+	return a.uuc.AdminAuditLogList(ctx, req)
+}
+
 func (a *AppService) CheckAndInsertRecommendArea(ctx context.Context, req *v1.CheckAndInsertRecommendAreaRequest) (*v1.CheckAndInsertRecommendAreaReply, error) {
 	return a.uuc.CheckAndInsertRecommendArea(ctx, req)
 }
@@ -597,6 +1117,13 @@ func (a *AppService) AdminWithdrawEth(ctx context.Context, req *v1.AdminWithdraw
 		err          error
 	)
 
+	// 提现锁，和 AdminWithdraw 互斥
+	ctx, release, err := a.uuc.AcquireWithdrawLease(ctx, "AdminWithdrawEth")
+	if nil != err {
+		return nil, err
+	}
+	defer release()
+
 	end := time.Now().Add(50 * time.Second)
 	for {
 		if time.Now().After(end) {
@@ -641,7 +1168,7 @@ func (a *AppService) AdminWithdrawEth(ctx context.Context, req *v1.AdminWithdraw
 			continue
 		}
 
-		_, err = a.uuc.UpdateWithdrawDoing(ctx, withdraw.ID)
+		err = a.uuc.UpdateWithdrawDoing(ctx, withdraw)
 		if nil != err {
 			continue
 		}
@@ -655,7 +1182,7 @@ func (a *AppService) AdminWithdrawEth(ctx context.Context, req *v1.AdminWithdraw
 					time.Sleep(3 * time.Second)
 					continue
 				} else {
-					_, err = a.uuc.UpdateWithdrawSuccess(ctx, withdraw.ID)
+					err = a.uuc.UpdateWithdrawSuccess(ctx, withdraw)
 					//time.Sleep(3 * time.Second)
 					break
 				}
@@ -664,7 +1191,7 @@ func (a *AppService) AdminWithdrawEth(ctx context.Context, req *v1.AdminWithdraw
 				_, _, err = toToken("", users[withdraw.UserId].Address, withDrawAmount, tokenAddress)
 				//fmt.Println(3333, err)
 				if err == nil {
-					_, err = a.uuc.UpdateWithdrawSuccess(ctx, withdraw.ID)
+					err = a.uuc.UpdateWithdrawSuccess(ctx, withdraw)
 					//time.Sleep(3 * time.Second)
 					break
 				} else if "insufficient funds for gas * price + value" == err.Error() {